@@ -7,6 +7,7 @@ import (
 	_ "github.com/go-park-mail-ru/2025_2_Undefined/docs"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/app"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
 )
 
 // @title           Undefined team API documentation of project Telegram
@@ -32,6 +33,16 @@ func main() {
 		logger.WithError(err).Fatal("config error")
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, conf.TracingConfig, "gateway")
+	if err != nil {
+		logger.WithError(err).Fatal("failed to init tracing")
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("failed to shutdown tracing")
+		}
+	}()
+
 	application, err := app.NewApp(conf)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to create app")
@@ -7,17 +7,30 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/health"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/mtls"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository"
 	authRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/auth"
+	botRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/bot"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/geoip"
 	redisClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/redis"
+	redisPhoneChange "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/redis/phonechange"
 	redisSession "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/redis/session"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/sms"
+	tokenRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/token"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
 	grpcHandler "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/auth/grpc"
+	notificationClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/grpc/client"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/middleware"
 	userClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/grpc/client"
 	authUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/auth"
+	botUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/bot"
+	phoneUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/phone"
 	sessionUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/session"
+	tokenUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/token"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 )
@@ -32,6 +45,16 @@ func main() {
 		logger.WithError(err).Fatal("config error")
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, conf.TracingConfig, "auth")
+	if err != nil {
+		logger.WithError(err).Fatal("failed to init tracing")
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("failed to shutdown tracing")
+		}
+	}()
+
 	db, err := repository.NewPgxPool(ctx, conf.DBConfig)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to connect to database")
@@ -43,21 +66,52 @@ func main() {
 		logger.WithError(err).Fatal("failed to connect to redis")
 	}
 
+	signer := identity.NewServiceSigner(conf.IdentityConfig, mtls.ServiceAuth)
+
 	userServiceAddr := conf.GRPCConfig.UserServiceAddr
-	userServiceClient, err := userClient.NewUserServiceClient(userServiceAddr)
+	userServiceClient, err := userClient.NewUserServiceClient(userServiceAddr, conf.GRPCConfig, signer)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to connect to user service")
 		return
 	}
 	defer userServiceClient.Close()
 
+	chatsNotificationClient, err := notificationClient.NewNotificationClient(conf.GRPCConfig.ChatsServiceAddr, conf.GRPCConfig, signer)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to chats service")
+		return
+	}
+	defer chatsNotificationClient.Close()
+
+	var locator authUsecase.GeoIPLocator = geoip.NoopLocator{}
+	if conf.GeoIPConfig.DBPath != "" {
+		csvLocator, err := geoip.NewCSVLocator(conf.GeoIPConfig.DBPath)
+		if err != nil {
+			logger.WithError(err).Warn("failed to load GeoIP database, location lookup disabled")
+		} else {
+			locator = csvLocator
+		}
+	}
+
+	codeSender, err := sms.NewLogSender(conf.SMSConfig.LogFile)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to init sms sender")
+	}
+	defer codeSender.Close()
+
 	authRepository := authRepo.New(db)
 	sessionRepository := redisSession.New(redisClient.Client, conf.SessionConfig.LifeSpan)
+	tokenRepository := tokenRepo.New(db)
+	botRepository := botRepo.New(db)
+	phoneChangeRepository := redisPhoneChange.New(redisClient.Client)
 
-	authUsecaseInstance := authUsecase.New(authRepository, userServiceClient, sessionRepository)
+	authUsecaseInstance := authUsecase.New(authRepository, userServiceClient, sessionRepository, locator, chatsNotificationClient)
 	sessionUsecaseInstance := sessionUsecase.New(sessionRepository)
+	tokenUsecaseInstance := tokenUsecase.New(tokenRepository)
+	botUsecaseInstance := botUsecase.New(botRepository, tokenUsecaseInstance)
+	phoneUsecaseInstance := phoneUsecase.New(phoneChangeRepository, authRepository, userServiceClient, sessionRepository, codeSender)
 
-	authGRPCHandler := grpcHandler.NewAuthGRPCHandler(authUsecaseInstance, sessionUsecaseInstance, conf.CSRFConfig)
+	authGRPCHandler := grpcHandler.NewAuthGRPCHandler(authUsecaseInstance, sessionUsecaseInstance, tokenUsecaseInstance, botUsecaseInstance, phoneUsecaseInstance, conf.CSRFConfig)
 
 	grpcListenAddr := fmt.Sprintf(":%s", conf.GRPCConfig.AuthServicePort)
 	listener, err := net.Listen("tcp", grpcListenAddr)
@@ -74,13 +128,54 @@ func main() {
 		}
 	}()
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.UnaryServerInterceptor()),
-	)
+	tlsOpts, err := mtls.ServerOptions(conf.GRPCConfig)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to configure grpc tls")
+	}
+
+	grpcServer := grpc.NewServer(append(tlsOpts,
+		grpc.ChainUnaryInterceptor(
+			middleware.RequestIDUnaryServerInterceptor(logger.Logger),
+			identity.UnaryServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.RequestIDStreamServerInterceptor(logger.Logger),
+			identity.StreamServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.StreamServerInterceptor(),
+		),
+		tracing.GRPCServerOption(),
+	)...)
 	gen.RegisterAuthServiceServer(grpcServer, authGRPCHandler)
 
-	logger.Info(fmt.Sprintf("Auth gRPC server is running on %s", grpcListenAddr))
-	if err := grpcServer.Serve(listener); err != nil {
-		logger.WithError(err).Fatal("failed to serve")
+	healthMonitor := health.NewMonitor(conf.ShutdownConfig.HealthCheckInterval,
+		[]string{gen.AuthService_ServiceDesc.ServiceName},
+		health.Check{Name: "postgres", Critical: true, Probe: db.Ping},
+		health.Check{Name: "redis", Critical: true, Probe: func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		}},
+	)
+	healthMonitor.Register(grpcServer)
+
+	signalCtx, stop := health.SignalContext(ctx)
+	defer stop()
+
+	go healthMonitor.Run(signalCtx)
+
+	go func() {
+		logger.Info(fmt.Sprintf("Auth gRPC server is running on %s", grpcListenAddr))
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.WithError(err).Fatal("failed to serve")
+		}
+	}()
+
+	<-signalCtx.Done()
+	logger.Info("shutdown signal received, draining connections")
+
+	healthMonitor.Shutdown()
+	if !health.GracefulStop(grpcServer, conf.ShutdownConfig.Timeout) {
+		logger.Warn("graceful stop timed out, remaining connections were closed")
 	}
+
+	logger.Info("Auth gRPC server stopped")
 }
@@ -7,17 +7,35 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/health"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/mtls"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository"
+	botRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/bot"
 	chatsRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/chats"
 	messageRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/message"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
+	outboxRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/outbox"
+	pushRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/push"
+	redisRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/redis"
+	userCacheRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/redis/usercache"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
+	authClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/auth/grpc/client"
 	grpcHandler "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/grpc"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/middleware"
 	userClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/grpc/client"
 	chatsUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/chats"
+	interfacePush "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/push"
+	interfaceUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
 	messageUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/message"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/push"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/usercache"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/webhook"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 )
@@ -33,6 +51,16 @@ func main() {
 		return
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, conf.TracingConfig, "chats")
+	if err != nil {
+		logger.WithError(err).Fatal("failed to init tracing")
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("failed to shutdown tracing")
+		}
+	}()
+
 	db, err := repository.NewPgxPool(ctx, conf.DBConfig)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to connect to database")
@@ -46,23 +74,67 @@ func main() {
 		return
 	}
 
+	signer := identity.NewServiceSigner(conf.IdentityConfig, mtls.ServiceChats)
+
 	userServiceAddr := conf.GRPCConfig.UserServiceAddr
-	userServiceClient, err := userClient.NewUserServiceClient(userServiceAddr)
+	userServiceClient, err := userClient.NewUserServiceClient(userServiceAddr, conf.GRPCConfig, signer)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to connect to user service")
 		return
 	}
 	defer userServiceClient.Close()
 
+	pushTargetsClient, err := authClient.NewPushTargetsClient(conf.GRPCConfig.AuthServiceAddr, conf.GRPCConfig, signer)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to auth service")
+		return
+	}
+	defer pushTargetsClient.Close()
+
+	var userCacheStore interfaceUser.UserCacheStore
+	if conf.UserCacheConfig.RedisEnabled {
+		redisConf := *conf.RedisConfig
+		redisConf.DB = conf.UserCacheConfig.RedisDB
+		redisClient, err := redisRepo.NewClient(&redisConf)
+		if err != nil {
+			// Без Redis кэш работает только в памяти процесса
+			logger.WithError(err).Warn("failed to connect to redis, shared user cache disabled")
+		} else {
+			defer redisClient.Close()
+			userCacheStore = userCacheRepo.New(redisClient.Client, conf.UserCacheConfig.TTL)
+		}
+	}
+	cachedUserClient := usercache.New(userServiceClient, userCacheStore, conf.UserCacheConfig)
+
 	chatsRepository := chatsRepo.NewChatsRepository(db)
 	messageRepository := messageRepo.NewMessageRepository(db)
+	botRepository := botRepo.New(db)
 	listenerMap := messageUsecase.NewListenerMap()
+	prometheus.MustRegister(messageUsecase.NewListenerMapCollector(listenerMap))
 
-	chatsUsecaseInstance := chatsUsecase.NewChatsUsecase(chatsRepository, userServiceClient, messageRepository, minioClient)
-	messageUsecaseInstance := messageUsecase.NewMessageUsecase(messageRepository, userServiceClient, chatsRepository, minioClient, listenerMap)
+	webhookDispatcher := webhook.NewDispatcher(botRepository, nil)
+
+	chatsUsecaseInstance := chatsUsecase.NewChatsUsecase(chatsRepository, cachedUserClient, messageRepository, minioClient)
+	messageUsecaseInstance := messageUsecase.NewMessageUsecase(messageRepository, cachedUserClient, chatsRepository, minioClient, listenerMap)
+
+	pushProviders, closePush, err := newPushProviders(conf.PushConfig)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to configure push providers")
+	}
+	defer closePush()
+	pushDispatcher := push.NewDispatcher(chatsRepository, cachedUserClient, pushTargetsClient, pushProviders, listenerMap, conf.PushConfig)
+
+	// События об изменениях пишутся в outbox в транзакции изменения, relay доставляет их потребителям
+	outboxRelay := outbox.NewRelay(outboxRepo.NewOutboxRepository(db), conf.OutboxConfig,
+		messageUsecaseInstance,
+		messageUsecase.NewMentionsPublisher(messageUsecaseInstance),
+		messageUsecase.NewWebhooksPublisher(messageUsecaseInstance, webhookDispatcher),
+		pushDispatcher,
+	)
 
 	chatsGRPCHandler := grpcHandler.NewChatsGRPCHandler(chatsUsecaseInstance, messageUsecaseInstance)
 	messageGRPCHandler := grpcHandler.NewMessageGRPCHandler(messageUsecaseInstance, chatsUsecaseInstance)
+	userEventsGRPCHandler := grpcHandler.NewUserEventsGRPCHandler(cachedUserClient, messageUsecaseInstance)
 
 	grpcListenAddr := fmt.Sprintf(":%s", conf.GRPCConfig.ChatsServicePort)
 	listener, err := net.Listen("tcp", grpcListenAddr)
@@ -79,14 +151,113 @@ func main() {
 		}
 	}()
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.UnaryServerInterceptor()),
-	)
+	tlsOpts, err := mtls.ServerOptions(conf.GRPCConfig)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to configure grpc tls")
+	}
+
+	grpcServer := grpc.NewServer(append(tlsOpts,
+		grpc.ChainUnaryInterceptor(
+			middleware.RequestIDUnaryServerInterceptor(logger.Logger),
+			identity.UnaryServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.RequestIDStreamServerInterceptor(logger.Logger),
+			identity.StreamServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.StreamServerInterceptor(),
+		),
+		tracing.GRPCServerOption(),
+	)...)
 	gen.RegisterChatServiceServer(grpcServer, chatsGRPCHandler)
 	gen.RegisterMessageServiceServer(grpcServer, messageGRPCHandler)
+	gen.RegisterUserEventsServiceServer(grpcServer, userEventsGRPCHandler)
+
+	healthMonitor := health.NewMonitor(conf.ShutdownConfig.HealthCheckInterval,
+		[]string{gen.ChatService_ServiceDesc.ServiceName, gen.MessageService_ServiceDesc.ServiceName, gen.UserEventsService_ServiceDesc.ServiceName},
+		health.Check{Name: "postgres", Critical: true, Probe: db.Ping},
+		health.Check{Name: "minio", Critical: true, Probe: minioClient.Ping},
+	)
+	healthMonitor.Register(grpcServer)
 
-	logger.Info(fmt.Sprintf("Chats gRPC server is running on %s", grpcListenAddr))
-	if err := grpcServer.Serve(listener); err != nil {
-		logger.WithError(err).Fatal("failed to serve")
+	signalCtx, stop := health.SignalContext(ctx)
+	defer stop()
+
+	go healthMonitor.Run(signalCtx)
+	go cachedUserClient.Run(signalCtx)
+	go outboxRelay.Run(signalCtx)
+	go pushDispatcher.Run(signalCtx)
+	go outboxRepo.Listen(signalCtx, db, outboxRelay.Notify)
+
+	go func() {
+		logger.Info(fmt.Sprintf("Chats gRPC server is running on %s", grpcListenAddr))
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.WithError(err).Fatal("failed to serve")
+		}
+	}()
+
+	<-signalCtx.Done()
+	logger.Info("shutdown signal received, draining connections")
+
+	healthMonitor.Shutdown()
+	// Потоки событий досылают накопленное, предупреждают клиентов и завершаются, иначе GracefulStop ждал бы их до таймаута.
+	// Рассылка останавливается только после сервера: выполняющиеся RPC ещё отправляют через неё события
+	messageUsecaseInstance.ShutdownStreams()
+	if !health.GracefulStop(grpcServer, conf.ShutdownConfig.Timeout) {
+		logger.Warn("graceful stop timed out, remaining connections were closed")
+	}
+	messageUsecaseInstance.Stop()
+	listenerMap.CloseAll()
+
+	// Новых сообщений больше не будет: досылаем webhook из очереди
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), conf.ShutdownConfig.Timeout)
+	webhookDispatcher.Stop(drainCtx)
+	cancelDrain()
+
+	logger.Info("Chats gRPC server stopped")
+}
+
+// newPushProviders подключает провайдеров платформ с настроенными ключами,
+// остальные платформы пишут уведомления в файл или лог
+func newPushProviders(conf *config.PushConfig) (map[string]interfacePush.Provider, func(), error) {
+	logProvider, err := pushRepo.NewLogProvider(conf.LogFile)
+	if err != nil {
+		return nil, nil, err
 	}
+	closeFn := func() { _ = logProvider.Close() }
+
+	providers := map[string]interfacePush.Provider{
+		modelsPush.PlatformFCM:     logProvider,
+		modelsPush.PlatformAPNs:    logProvider,
+		modelsPush.PlatformWebPush: logProvider,
+	}
+
+	if conf.FCMCredentialsFile != "" {
+		fcm, err := pushRepo.NewFCMProvider(conf.FCMCredentialsFile, nil)
+		if err != nil {
+			closeFn()
+			return nil, nil, err
+		}
+		providers[modelsPush.PlatformFCM] = fcm
+	}
+
+	if conf.APNsKeyFile != "" {
+		apns, err := pushRepo.NewAPNsProvider(conf.APNsKeyFile, conf.APNsKeyID, conf.APNsTeamID, conf.APNsTopic, conf.APNsSandbox, nil)
+		if err != nil {
+			closeFn()
+			return nil, nil, err
+		}
+		providers[modelsPush.PlatformAPNs] = apns
+	}
+
+	if conf.VAPIDPrivateKey != "" {
+		webPush, err := pushRepo.NewWebPushProvider(conf.VAPIDPublicKey, conf.VAPIDPrivateKey, conf.VAPIDSubject, nil)
+		if err != nil {
+			closeFn()
+			return nil, nil, err
+		}
+		providers[modelsPush.PlatformWebPush] = webPush
+	}
+
+	return providers, closeFn, nil
 }
@@ -7,17 +7,27 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/health"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/mtls"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository"
+	blockRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/block"
 	contactRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/contact"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch"
 	contactES "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch/contact"
+	userES "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch/user"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
+	privacyRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/privacy"
 	userRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
+	chatsClient "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/grpc/client"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/middleware"
 	grpcHandler "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/grpc"
+	blockUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/block"
 	contactUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/contact"
+	privacyUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/privacy"
 	userUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/user"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
@@ -33,6 +43,16 @@ func main() {
 		logger.WithError(err).Fatal("config error")
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, conf.TracingConfig, "user")
+	if err != nil {
+		logger.WithError(err).Fatal("failed to init tracing")
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("failed to shutdown tracing")
+		}
+	}()
+
 	db, err := repository.NewPgxPool(ctx, conf.DBConfig)
 	if err != nil {
 		logger.WithError(err).Fatal("failed to connect to database")
@@ -45,6 +65,7 @@ func main() {
 	}
 
 	var contactSearchRepo *contactES.ContactSearchRepository
+	var userSearchRepo userES.UserSearchRepositoryInterface
 	esClient, err := elasticsearch.NewClient(
 		conf.ElasticsearchConfig.URL,
 		conf.ElasticsearchConfig.ContactsIndex,
@@ -59,13 +80,45 @@ func main() {
 		if err := contactSearchRepo.CreateIndex(ctx); err != nil {
 			logger.WithError(err).Warn("failed to create elasticsearch index")
 		}
+
+		usersIndexRepo := userES.NewUserSearchRepository(esClient.GetClient(), conf.ElasticsearchConfig.UsersIndex)
+		if err := usersIndexRepo.CreateIndex(ctx); err != nil {
+			logger.WithError(err).Warn("failed to create elasticsearch users index")
+		}
+		userSearchRepo = usersIndexRepo
+	}
+
+	signer := identity.NewServiceSigner(conf.IdentityConfig, mtls.ServiceUser)
+
+	userEventsClient, err := chatsClient.NewUserEventsClient(conf.GRPCConfig.ChatsServiceAddr, conf.GRPCConfig, signer)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to chats service")
+	}
+	defer userEventsClient.Close()
+
+	contactNotificationClient, err := chatsClient.NewNotificationClient(conf.GRPCConfig.ChatsServiceAddr, conf.GRPCConfig, signer)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to chats service")
 	}
+	defer contactNotificationClient.Close()
+
+	groupPeersClient, err := chatsClient.NewGroupPeersClient(conf.GRPCConfig.ChatsServiceAddr, conf.GRPCConfig, signer)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to connect to chats service")
+	}
+	defer groupPeersClient.Close()
 
 	userRepository := userRepo.New(db)
 	contactRepository := contactRepo.New(db)
+	blockRepository := blockRepo.New(db)
+	privacyRepository := privacyRepo.New(db)
 
-	userUsecaseInstance := userUsecase.New(userRepository, minioClient)
-	contactUsecaseInstance := contactUsecase.New(contactRepository, userRepository, minioClient, contactSearchRepo)
+	userUsecaseInstance := userUsecase.New(userRepository, minioClient, userEventsClient, privacyRepository, contactRepository,
+		blockRepository, userSearchRepo, groupPeersClient)
+	contactUsecaseInstance := contactUsecase.New(contactRepository, userRepository, minioClient, contactSearchRepo, blockRepository, contactNotificationClient, privacyRepository,
+		[]byte(conf.ContactImportConfig.Secret))
+	blockUsecaseInstance := blockUsecase.New(blockRepository, userRepository)
+	privacyUsecaseInstance := privacyUsecase.New(privacyRepository, contactRepository)
 
 	// Переиндексация существующих контактов в Elasticsearch
 	if contactSearchRepo != nil {
@@ -77,7 +130,17 @@ func main() {
 		}
 	}
 
-	userGRPCHandler := grpcHandler.NewUserGRPCHandler(userUsecaseInstance, contactUsecaseInstance)
+	// Переиндексация профилей для глобального поиска людей
+	if userSearchRepo != nil {
+		logger.Info("reindexing existing users to elasticsearch")
+		if err := userUsecaseInstance.ReindexAllUsers(ctx); err != nil {
+			logger.WithError(err).Warn("failed to reindex users, search may be incomplete")
+		} else {
+			logger.Info("users reindexed successfully")
+		}
+	}
+
+	userGRPCHandler := grpcHandler.NewUserGRPCHandler(userUsecaseInstance, contactUsecaseInstance, blockUsecaseInstance, privacyUsecaseInstance)
 
 	grpcListenAddr := fmt.Sprintf(":%s", conf.GRPCConfig.UserServicePort)
 	listener, err := net.Listen("tcp", grpcListenAddr)
@@ -94,13 +157,57 @@ func main() {
 		}
 	}()
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.UnaryServerInterceptor()),
-	)
+	tlsOpts, err := mtls.ServerOptions(conf.GRPCConfig)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to configure grpc tls")
+	}
+
+	grpcServer := grpc.NewServer(append(tlsOpts,
+		grpc.ChainUnaryInterceptor(
+			middleware.RequestIDUnaryServerInterceptor(logger.Logger),
+			identity.UnaryServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			middleware.RequestIDStreamServerInterceptor(logger.Logger),
+			identity.StreamServerInterceptor(signer, conf.IdentityConfig.Required),
+			middleware.StreamServerInterceptor(),
+		),
+		tracing.GRPCServerOption(),
+	)...)
 	gen.RegisterUserServiceServer(grpcServer, userGRPCHandler)
 
-	logger.Info(fmt.Sprintf("User gRPC server is running on %s", grpcListenAddr))
-	if err := grpcServer.Serve(listener); err != nil {
-		logger.WithError(err).Fatal("failed to serve")
+	healthChecks := []health.Check{
+		{Name: "postgres", Critical: true, Probe: db.Ping},
+		{Name: "minio", Critical: true, Probe: minioClient.Ping},
+	}
+	if esClient != nil {
+		// Без OpenSearch сервис продолжает работать, отключается только поиск
+		healthChecks = append(healthChecks, health.Check{Name: "opensearch", Critical: false, Probe: esClient.Ping})
+	}
+	healthMonitor := health.NewMonitor(conf.ShutdownConfig.HealthCheckInterval,
+		[]string{gen.UserService_ServiceDesc.ServiceName}, healthChecks...)
+	healthMonitor.Register(grpcServer)
+
+	signalCtx, stop := health.SignalContext(ctx)
+	defer stop()
+
+	go healthMonitor.Run(signalCtx)
+
+	go func() {
+		logger.Info(fmt.Sprintf("User gRPC server is running on %s", grpcListenAddr))
+		if err := grpcServer.Serve(listener); err != nil {
+			logger.WithError(err).Fatal("failed to serve")
+		}
+	}()
+
+	<-signalCtx.Done()
+	logger.Info("shutdown signal received, draining connections")
+
+	healthMonitor.Shutdown()
+	if !health.GracefulStop(grpcServer, conf.ShutdownConfig.Timeout) {
+		logger.Warn("graceful stop timed out, remaining connections were closed")
 	}
+
+	logger.Info("User gRPC server stopped")
 }
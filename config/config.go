@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
@@ -23,6 +25,15 @@ type Config struct {
 	GRPCConfig          *GRPCConfig
 	ElasticsearchConfig *ElasticsearchConfig
 	MetricsConfig       *MetricsConfig
+	GeoIPConfig         *GeoIPConfig
+	TracingConfig       *TracingConfig
+	ShutdownConfig      *ShutdownConfig
+	IdentityConfig      *IdentityConfig
+	UserCacheConfig     *UserCacheConfig
+	OutboxConfig        *OutboxConfig
+	PushConfig          *PushConfig
+	SMSConfig           *SMSConfig
+	ContactImportConfig *ContactImportConfig
 }
 
 type DBConfig struct {
@@ -55,6 +66,8 @@ type MinioConfig struct {
 
 type ServerConfig struct {
 	Port string
+	// TrustedProxies - сети прокси (nginx), которым доверяем X-Forwarded-For и X-Real-IP; пусто - заголовки игнорируются
+	TrustedProxies []*net.IPNet
 }
 
 type SessionConfig struct {
@@ -78,11 +91,31 @@ type GRPCConfig struct {
 	UserServicePort  string
 	ChatsServiceAddr string
 	ChatsServicePort string
+
+	// CallTimeout - дедлайн по умолчанию для unary-вызовов, если вызывающий не задал свой
+	CallTimeout time.Duration
+	// BreakerFailureThreshold - число подряд неудачных вызовов, после которого размыкается circuit breaker
+	BreakerFailureThreshold int
+	// BreakerOpenTimeout - сколько breaker остаётся разомкнутым перед пробным вызовом
+	BreakerOpenTimeout time.Duration
+
+	// TLSEnabled включает mTLS между gateway и внутренними сервисами
+	TLSEnabled bool
+	// TLSCertFile, TLSKeyFile - сертификат и ключ текущего сервиса, CN сертификата - имя сервиса
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSCAFile - корневой сертификат, которым подписаны сертификаты всех сервисов
+	TLSCAFile string
+	// TLSAllowedClients - имена сервисов, которым разрешено обращаться к этому сервису (пусто - любому с валидным сертификатом)
+	TLSAllowedClients []string
+	// TLSDevMode - сгенерировать одноразовый CA и сертификаты сервисов рядом с TLSCAFile, если их ещё нет
+	TLSDevMode bool
 }
 
 type ElasticsearchConfig struct {
 	URL           string
 	ContactsIndex string
+	UsersIndex    string
 	Username      string
 	Password      string
 }
@@ -91,6 +124,91 @@ type MetricsConfig struct {
 	Port string
 }
 
+type GeoIPConfig struct {
+	DBPath string
+}
+
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
+)
+
+type TracingConfig struct {
+	Exporter     string
+	OTLPEndpoint string
+	SampleRatio  float64
+}
+
+type ShutdownConfig struct {
+	// Timeout - сколько ждать завершения активных запросов и стримов после SIGTERM
+	Timeout time.Duration
+	// HealthCheckInterval - период проверки зависимостей для grpc.health.v1
+	HealthCheckInterval time.Duration
+}
+
+type UserCacheConfig struct {
+	// Size - максимальное число профилей в памяти процесса
+	Size int
+	// TTL - время жизни записи; ограничивает устаревание, если событие об изменении профиля потерялось
+	TTL time.Duration
+	// RedisEnabled - второй уровень кэша в Redis, общий для реплик chats_service
+	RedisEnabled bool
+	// RedisDB - номер базы Redis, отдельный от сессий
+	RedisDB int
+}
+
+type OutboxConfig struct {
+	// PollInterval - период опроса таблицы, если уведомление LISTEN/NOTIFY не пришло
+	PollInterval time.Duration
+	// BatchSize - сколько событий relay забирает за один запрос
+	BatchSize int
+	// Lease - на сколько событие скрывается от других экземпляров relay, пока идёт доставка
+	Lease time.Duration
+	// Retention - сколько хранить доставленные события перед удалением
+	Retention time.Duration
+}
+
+type PushConfig struct {
+	// BatchWindow - сколько копить сообщения чата перед отправкой одного уведомления
+	BatchWindow time.Duration
+	// LogFile - куда писать уведомления для платформ без настроенного провайдера; пусто - в лог сервиса
+	LogFile string
+	// FCMCredentialsFile - JSON сервисного аккаунта Firebase
+	FCMCredentialsFile string
+	// APNsKeyFile - ключ .p8 для подписи токенов APNs
+	APNsKeyFile string
+	APNsKeyID   string
+	APNsTeamID  string
+	// APNsTopic - bundle id приложения
+	APNsTopic   string
+	APNsSandbox bool
+	// VAPIDPublicKey и VAPIDPrivateKey - ключи Web Push в base64url (P-256)
+	VAPIDPublicKey  string
+	VAPIDPrivateKey string
+	// VAPIDSubject - контакт отправителя (mailto: или https:)
+	VAPIDSubject string
+}
+
+type SMSConfig struct {
+	// LogFile - куда писать SMS с кодами подтверждения, пока не подключён шлюз; пусто - в лог сервиса
+	LogFile string
+}
+
+type ContactImportConfig struct {
+	// Secret - ключ HMAC, которым хэшируются номера из адресных книг, ожидающие регистрации владельца
+	Secret string
+}
+
+type IdentityConfig struct {
+	// Secret - общий ключ HMAC, которым gateway подписывает личность пользователя для внутренних сервисов
+	Secret string
+	// TTL - время жизни подписи; подпись создаётся на каждый вызов, поэтому хватает нескольких секунд
+	TTL time.Duration
+	// Required - отклонять вызовы от имени пользователя без подписи (false - только для постепенного включения)
+	Required bool
+}
+
 func NewConfig() (*Config, error) {
 	if err := godotenv.Load(); err != nil {
 		return nil, fmt.Errorf("error loading .env file: %v", err)
@@ -143,6 +261,45 @@ func NewConfig() (*Config, error) {
 
 	metricsConfig := newMetricsConfig()
 
+	geoIPConfig := newGeoIPConfig()
+
+	tracingConfig, err := newTracingConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	shutdownConfig, err := newShutdownConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	identityConfig, err := newIdentityConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	userCacheConfig, err := newUserCacheConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	outboxConfig, err := newOutboxConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	pushConfig, err := newPushConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	smsConfig := newSMSConfig()
+
+	contactImportConfig, err := newContactImportConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		DBConfig:            dbConfig,
 		ServerConfig:        serverConfig,
@@ -154,6 +311,15 @@ func NewConfig() (*Config, error) {
 		GRPCConfig:          grpcConfig,
 		ElasticsearchConfig: elasticsearchConfig,
 		MetricsConfig:       metricsConfig,
+		GeoIPConfig:         geoIPConfig,
+		TracingConfig:       tracingConfig,
+		ShutdownConfig:      shutdownConfig,
+		IdentityConfig:      identityConfig,
+		UserCacheConfig:     userCacheConfig,
+		OutboxConfig:        outboxConfig,
+		PushConfig:          pushConfig,
+		SMSConfig:           smsConfig,
+		ContactImportConfig: contactImportConfig,
 	}, nil
 }
 
@@ -191,11 +357,49 @@ func newServerConfig() (*ServerConfig, error) {
 		return nil, errors.New("SERVER_PORT is required")
 	}
 
+	trustedProxies, err := parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		return nil, err
+	}
+
 	return &ServerConfig{
-		Port: port,
+		Port:           port,
+		TrustedProxies: trustedProxies,
 	}, nil
 }
 
+// parseTrustedProxies разбирает список CIDR или отдельных IP через запятую
+func parseTrustedProxies(value string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry: %s", item)
+			}
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES entry: %s", item)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
 func newSessionConfig() (*SessionConfig, error) {
 	signature, signatureExists := os.LookupEnv("SESSION_SIGNATURE")
 	if !signatureExists {
@@ -352,6 +556,69 @@ func newGRPCConfig() (*GRPCConfig, error) {
 		chatsServicePort = "50053" // default порт
 	}
 
+	callTimeout := 5 * time.Second // default
+	if timeoutStr := os.Getenv("GRPC_CALL_TIMEOUT"); timeoutStr != "" {
+		parsed, err := time.ParseDuration(timeoutStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid GRPC_CALL_TIMEOUT value")
+		}
+		callTimeout = parsed
+	}
+
+	breakerFailureThreshold := 5 // default
+	if thresholdStr := os.Getenv("GRPC_BREAKER_FAILURE_THRESHOLD"); thresholdStr != "" {
+		parsed, err := strconv.Atoi(thresholdStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid GRPC_BREAKER_FAILURE_THRESHOLD value")
+		}
+		breakerFailureThreshold = parsed
+	}
+
+	breakerOpenTimeout := 10 * time.Second // default
+	if openTimeoutStr := os.Getenv("GRPC_BREAKER_OPEN_TIMEOUT"); openTimeoutStr != "" {
+		parsed, err := time.ParseDuration(openTimeoutStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid GRPC_BREAKER_OPEN_TIMEOUT value")
+		}
+		breakerOpenTimeout = parsed
+	}
+
+	tlsEnabled := false // default
+	if enabledStr := os.Getenv("GRPC_TLS_ENABLED"); enabledStr != "" {
+		parsed, err := strconv.ParseBool(enabledStr)
+		if err != nil {
+			return nil, errors.New("invalid GRPC_TLS_ENABLED value")
+		}
+		tlsEnabled = parsed
+	}
+
+	tlsDevMode := false // default
+	if devModeStr := os.Getenv("GRPC_TLS_DEV_MODE"); devModeStr != "" {
+		parsed, err := strconv.ParseBool(devModeStr)
+		if err != nil {
+			return nil, errors.New("invalid GRPC_TLS_DEV_MODE value")
+		}
+		tlsDevMode = parsed
+	}
+
+	tlsCAFile := os.Getenv("GRPC_TLS_CA_FILE")
+	if tlsCAFile == "" {
+		tlsCAFile = "certs/ca.crt" // default
+	}
+
+	tlsCertFile := os.Getenv("GRPC_TLS_CERT_FILE")
+	tlsKeyFile := os.Getenv("GRPC_TLS_KEY_FILE")
+	if tlsEnabled && (tlsCertFile == "" || tlsKeyFile == "") {
+		return nil, errors.New("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE are required when GRPC_TLS_ENABLED is set")
+	}
+
+	var allowedClients []string
+	for _, name := range strings.Split(os.Getenv("GRPC_TLS_ALLOWED_CLIENTS"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			allowedClients = append(allowedClients, name)
+		}
+	}
+
 	return &GRPCConfig{
 		AuthServiceAddr:  authServiceAddr,
 		AuthServicePort:  authServicePort,
@@ -359,6 +626,17 @@ func newGRPCConfig() (*GRPCConfig, error) {
 		UserServicePort:  userServicePort,
 		ChatsServiceAddr: chatsServiceAddr,
 		ChatsServicePort: chatsServicePort,
+
+		CallTimeout:             callTimeout,
+		BreakerFailureThreshold: breakerFailureThreshold,
+		BreakerOpenTimeout:      breakerOpenTimeout,
+
+		TLSEnabled:        tlsEnabled,
+		TLSCertFile:       tlsCertFile,
+		TLSKeyFile:        tlsKeyFile,
+		TLSCAFile:         tlsCAFile,
+		TLSAllowedClients: allowedClients,
+		TLSDevMode:        tlsDevMode,
 	}, nil
 }
 
@@ -373,6 +651,11 @@ func newElasticsearchConfig() (*ElasticsearchConfig, error) {
 		contactsIndex = "contacts" // default
 	}
 
+	usersIndex := os.Getenv("ELASTICSEARCH_USERS_INDEX")
+	if usersIndex == "" {
+		usersIndex = "users" // default
+	}
+
 	username := os.Getenv("ELASTICSEARCH_USERNAME")
 	if username == "" {
 		username = "admin" // default
@@ -386,6 +669,7 @@ func newElasticsearchConfig() (*ElasticsearchConfig, error) {
 	return &ElasticsearchConfig{
 		URL:           url,
 		ContactsIndex: contactsIndex,
+		UsersIndex:    usersIndex,
 		Username:      username,
 		Password:      password,
 	}, nil
@@ -401,3 +685,240 @@ func newMetricsConfig() *MetricsConfig {
 		Port: port,
 	}
 }
+
+func newGeoIPConfig() *GeoIPConfig {
+	// Пустой путь отключает определение местоположения
+	return &GeoIPConfig{
+		DBPath: os.Getenv("GEOIP_DB_PATH"),
+	}
+}
+
+func newTracingConfig() (*TracingConfig, error) {
+	exporter := os.Getenv("TRACING_EXPORTER")
+	if exporter == "" {
+		exporter = TracingExporterNone // default - трейсы не отправляются
+	}
+
+	switch exporter {
+	case TracingExporterNone, TracingExporterStdout, TracingExporterOTLP:
+	default:
+		return nil, fmt.Errorf("invalid TRACING_EXPORTER value: %s", exporter)
+	}
+
+	endpoint := os.Getenv("TRACING_OTLP_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "localhost:4317" // default
+	}
+
+	sampleRatio := 1.0
+	if ratioStr := os.Getenv("TRACING_SAMPLE_RATIO"); ratioStr != "" {
+		ratio, err := strconv.ParseFloat(ratioStr, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return nil, errors.New("invalid TRACING_SAMPLE_RATIO value")
+		}
+		sampleRatio = ratio
+	}
+
+	return &TracingConfig{
+		Exporter:     exporter,
+		OTLPEndpoint: endpoint,
+		SampleRatio:  sampleRatio,
+	}, nil
+}
+
+func newShutdownConfig() (*ShutdownConfig, error) {
+	timeout := 30 * time.Second // default
+	if timeoutStr := os.Getenv("SHUTDOWN_TIMEOUT"); timeoutStr != "" {
+		parsed, err := time.ParseDuration(timeoutStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid SHUTDOWN_TIMEOUT value")
+		}
+		timeout = parsed
+	}
+
+	interval := 10 * time.Second // default
+	if intervalStr := os.Getenv("HEALTH_CHECK_INTERVAL"); intervalStr != "" {
+		parsed, err := time.ParseDuration(intervalStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid HEALTH_CHECK_INTERVAL value")
+		}
+		interval = parsed
+	}
+
+	return &ShutdownConfig{
+		Timeout:             timeout,
+		HealthCheckInterval: interval,
+	}, nil
+}
+
+func newIdentityConfig() (*IdentityConfig, error) {
+	secret, secretExists := os.LookupEnv("INTERNAL_IDENTITY_SECRET")
+	if !secretExists || secret == "" {
+		return nil, errors.New("INTERNAL_IDENTITY_SECRET is required")
+	}
+
+	ttl := 30 * time.Second // default
+	if ttlStr := os.Getenv("INTERNAL_IDENTITY_TTL"); ttlStr != "" {
+		parsed, err := time.ParseDuration(ttlStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid INTERNAL_IDENTITY_TTL value")
+		}
+		ttl = parsed
+	}
+
+	required := true // default
+	if requiredStr := os.Getenv("INTERNAL_IDENTITY_REQUIRED"); requiredStr != "" {
+		parsed, err := strconv.ParseBool(requiredStr)
+		if err != nil {
+			return nil, errors.New("invalid INTERNAL_IDENTITY_REQUIRED value")
+		}
+		required = parsed
+	}
+
+	return &IdentityConfig{
+		Secret:   secret,
+		TTL:      ttl,
+		Required: required,
+	}, nil
+}
+
+func newContactImportConfig() (*ContactImportConfig, error) {
+	secret, secretExists := os.LookupEnv("CONTACT_IMPORT_SECRET")
+	if !secretExists || secret == "" {
+		return nil, errors.New("CONTACT_IMPORT_SECRET is required")
+	}
+
+	return &ContactImportConfig{
+		Secret: secret,
+	}, nil
+}
+
+func newUserCacheConfig() (*UserCacheConfig, error) {
+	size := 10000 // default
+	if sizeStr := os.Getenv("USER_CACHE_SIZE"); sizeStr != "" {
+		parsed, err := strconv.Atoi(sizeStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid USER_CACHE_SIZE value")
+		}
+		size = parsed
+	}
+
+	ttl := 5 * time.Minute // default
+	if ttlStr := os.Getenv("USER_CACHE_TTL"); ttlStr != "" {
+		parsed, err := time.ParseDuration(ttlStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid USER_CACHE_TTL value")
+		}
+		ttl = parsed
+	}
+
+	redisEnabled := false // default
+	if enabledStr := os.Getenv("USER_CACHE_REDIS_ENABLED"); enabledStr != "" {
+		parsed, err := strconv.ParseBool(enabledStr)
+		if err != nil {
+			return nil, errors.New("invalid USER_CACHE_REDIS_ENABLED value")
+		}
+		redisEnabled = parsed
+	}
+
+	redisDB := 1 // default
+	if dbStr := os.Getenv("USER_CACHE_REDIS_DB"); dbStr != "" {
+		parsed, err := strconv.Atoi(dbStr)
+		if err != nil || parsed < 0 {
+			return nil, errors.New("invalid USER_CACHE_REDIS_DB value")
+		}
+		redisDB = parsed
+	}
+
+	return &UserCacheConfig{
+		Size:         size,
+		TTL:          ttl,
+		RedisEnabled: redisEnabled,
+		RedisDB:      redisDB,
+	}, nil
+}
+
+func newOutboxConfig() (*OutboxConfig, error) {
+	pollInterval := time.Second // default
+	if intervalStr := os.Getenv("OUTBOX_POLL_INTERVAL"); intervalStr != "" {
+		parsed, err := time.ParseDuration(intervalStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid OUTBOX_POLL_INTERVAL value")
+		}
+		pollInterval = parsed
+	}
+
+	batchSize := 100 // default
+	if sizeStr := os.Getenv("OUTBOX_BATCH_SIZE"); sizeStr != "" {
+		parsed, err := strconv.Atoi(sizeStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid OUTBOX_BATCH_SIZE value")
+		}
+		batchSize = parsed
+	}
+
+	lease := 30 * time.Second // default
+	if leaseStr := os.Getenv("OUTBOX_LEASE"); leaseStr != "" {
+		parsed, err := time.ParseDuration(leaseStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid OUTBOX_LEASE value")
+		}
+		lease = parsed
+	}
+
+	retention := 24 * time.Hour // default
+	if retentionStr := os.Getenv("OUTBOX_RETENTION"); retentionStr != "" {
+		parsed, err := time.ParseDuration(retentionStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid OUTBOX_RETENTION value")
+		}
+		retention = parsed
+	}
+
+	return &OutboxConfig{
+		PollInterval: pollInterval,
+		BatchSize:    batchSize,
+		Lease:        lease,
+		Retention:    retention,
+	}, nil
+}
+
+func newPushConfig() (*PushConfig, error) {
+	batchWindow := 5 * time.Second // default
+	if windowStr := os.Getenv("PUSH_BATCH_WINDOW"); windowStr != "" {
+		parsed, err := time.ParseDuration(windowStr)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid PUSH_BATCH_WINDOW value")
+		}
+		batchWindow = parsed
+	}
+
+	apnsSandbox := false
+	if sandboxStr := os.Getenv("PUSH_APNS_SANDBOX"); sandboxStr != "" {
+		parsed, err := strconv.ParseBool(sandboxStr)
+		if err != nil {
+			return nil, errors.New("invalid PUSH_APNS_SANDBOX value")
+		}
+		apnsSandbox = parsed
+	}
+
+	return &PushConfig{
+		BatchWindow:        batchWindow,
+		LogFile:            os.Getenv("PUSH_LOG_FILE"),
+		FCMCredentialsFile: os.Getenv("PUSH_FCM_CREDENTIALS_FILE"),
+		APNsKeyFile:        os.Getenv("PUSH_APNS_KEY_FILE"),
+		APNsKeyID:          os.Getenv("PUSH_APNS_KEY_ID"),
+		APNsTeamID:         os.Getenv("PUSH_APNS_TEAM_ID"),
+		APNsTopic:          os.Getenv("PUSH_APNS_TOPIC"),
+		APNsSandbox:        apnsSandbox,
+		VAPIDPublicKey:     os.Getenv("PUSH_VAPID_PUBLIC_KEY"),
+		VAPIDPrivateKey:    os.Getenv("PUSH_VAPID_PRIVATE_KEY"),
+		VAPIDSubject:       os.Getenv("PUSH_VAPID_SUBJECT"),
+	}, nil
+}
+
+func newSMSConfig() *SMSConfig {
+	return &SMSConfig{
+		LogFile: os.Getenv("SMS_LOG_FILE"),
+	}
+}
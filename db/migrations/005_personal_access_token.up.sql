@@ -0,0 +1,25 @@
+-- Персональные токены доступа для мобильных клиентов, ботов и CLI
+CREATE TABLE personal_access_token (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_token_name_length CHECK (LENGTH(name) BETWEEN 1 AND 64)
+);
+
+CREATE INDEX idx_personal_access_token_user_id ON personal_access_token(user_id);
+
+CREATE TRIGGER update_personal_access_token_updated_at
+    BEFORE UPDATE ON personal_access_token
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE personal_access_token IS 'Токены для авторизации через заголовок Authorization: Bearer';
+COMMENT ON COLUMN personal_access_token.token_hash IS 'SHA-256 от токена, сам токен не хранится';
+COMMENT ON COLUMN personal_access_token.scopes IS 'Права токена: read, write';
@@ -0,0 +1,2 @@
+-- PostgreSQL не поддерживает удаление значений из enum, значение 'bot' остается в user_type_enum.
+-- Пользователи-боты удаляются при откате миграции 007_bot.
@@ -0,0 +1,2 @@
+-- Новое значение enum нельзя использовать в той же транзакции, поэтому таблица ботов создается в следующей миграции
+ALTER TYPE user_type_enum ADD VALUE IF NOT EXISTS 'bot';
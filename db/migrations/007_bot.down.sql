@@ -0,0 +1,2 @@
+DELETE FROM "user" WHERE user_type = 'bot';
+DROP TABLE IF EXISTS bot;
@@ -0,0 +1,22 @@
+-- Боты: пользователи с типом 'bot', которыми управляет владелец-человек
+CREATE TABLE bot (
+    user_id UUID PRIMARY KEY REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    owner_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    webhook_url TEXT NULL,
+    webhook_secret TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT check_bot_webhook_url CHECK (webhook_url IS NULL OR (webhook_url ~ '^https?://' AND LENGTH(webhook_url) <= 2048)),
+    CONSTRAINT check_bot_webhook_secret CHECK ((webhook_url IS NULL) = (webhook_secret IS NULL))
+);
+
+CREATE INDEX idx_bot_owner_id ON bot(owner_id);
+
+CREATE TRIGGER update_bot_updated_at
+    BEFORE UPDATE ON bot
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE bot IS 'Боты, созданные пользователями для интеграций';
+COMMENT ON COLUMN bot.webhook_url IS 'Адрес, на который отправляются сообщения с упоминанием бота';
+COMMENT ON COLUMN bot.webhook_secret IS 'Ключ для HMAC-подписи тела webhook-запроса';
@@ -0,0 +1,20 @@
+-- Упоминания пользователей (@username) в сообщениях
+CREATE TABLE message_mention (
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    chat_id UUID NOT NULL REFERENCES chat(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    read_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, user_id)
+);
+
+CREATE INDEX idx_message_mention_unread ON message_mention(user_id, chat_id) WHERE read_at IS NULL;
+
+CREATE TRIGGER update_message_mention_updated_at
+    BEFORE UPDATE ON message_mention
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE message_mention IS 'Связь сообщения с упомянутыми в нём участниками чата';
+COMMENT ON COLUMN message_mention.read_at IS 'Момент, когда пользователь просмотрел упоминание; NULL - не прочитано';
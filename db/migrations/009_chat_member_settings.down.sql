@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_chat_member_pinned;
+
+ALTER TABLE chat_member
+    DROP CONSTRAINT IF EXISTS check_chat_member_folder_length,
+    DROP CONSTRAINT IF EXISTS check_chat_member_pin_order,
+    DROP CONSTRAINT IF EXISTS check_chat_member_muted_until,
+    DROP COLUMN IF EXISTS folder,
+    DROP COLUMN IF EXISTS pin_order,
+    DROP COLUMN IF EXISTS is_archived,
+    DROP COLUMN IF EXISTS muted_until,
+    DROP COLUMN IF EXISTS is_muted;
@@ -0,0 +1,18 @@
+-- Персональные настройки чата для каждого участника: заглушение, архив, закрепление и папка
+ALTER TABLE chat_member
+    ADD COLUMN is_muted BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN muted_until TIMESTAMPTZ NULL,
+    ADD COLUMN is_archived BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN pin_order INTEGER NULL,
+    ADD COLUMN folder TEXT NULL,
+    ADD CONSTRAINT check_chat_member_muted_until CHECK (muted_until IS NULL OR is_muted),
+    ADD CONSTRAINT check_chat_member_pin_order CHECK (pin_order IS NULL OR pin_order > 0),
+    ADD CONSTRAINT check_chat_member_folder_length CHECK (folder IS NULL OR (LENGTH(folder) >= 1 AND LENGTH(folder) <= 32));
+
+CREATE INDEX idx_chat_member_pinned ON chat_member(user_id, pin_order) WHERE pin_order IS NOT NULL;
+
+COMMENT ON COLUMN chat_member.is_muted IS 'Уведомления чата отключены';
+COMMENT ON COLUMN chat_member.muted_until IS 'До какого момента чат заглушён; NULL при is_muted - навсегда';
+COMMENT ON COLUMN chat_member.is_archived IS 'Чат перенесён пользователем в архив';
+COMMENT ON COLUMN chat_member.pin_order IS 'Позиция закреплённого чата в списке; NULL - не закреплён';
+COMMENT ON COLUMN chat_member.folder IS 'Пользовательская папка (метка) чата';
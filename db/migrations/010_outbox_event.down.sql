@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS outbox_event_inserted ON outbox_event;
+DROP FUNCTION IF EXISTS notify_outbox_event();
+DROP TABLE IF EXISTS outbox_event;
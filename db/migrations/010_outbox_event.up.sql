@@ -0,0 +1,38 @@
+-- Transactional outbox: доменные события пишутся в той же транзакции, что и изменение,
+-- а relay доставляет их потребителям (realtime, поиск, вебхуки, push) не менее одного раза
+CREATE TABLE outbox_event (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dedup_key TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_outbox_event_dedup_key UNIQUE (dedup_key)
+);
+
+CREATE INDEX idx_outbox_event_pending ON outbox_event(available_at, created_at) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_event_published ON outbox_event(published_at) WHERE published_at IS NOT NULL;
+
+-- Будим relay сразу после коммита, чтобы не ждать очередного опроса таблицы
+CREATE OR REPLACE FUNCTION notify_outbox_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_event', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_event_inserted
+    AFTER INSERT ON outbox_event
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION notify_outbox_event();
+
+COMMENT ON TABLE outbox_event IS 'Доменные события, ожидающие доставки потребителям';
+COMMENT ON COLUMN outbox_event.dedup_key IS 'Ключ идемпотентности: повторная запись того же события игнорируется, потребители по нему отбрасывают дубли';
+COMMENT ON COLUMN outbox_event.aggregate_id IS 'Сущность, к которой относится событие (сообщение или чат)';
+COMMENT ON COLUMN outbox_event.available_at IS 'Не раньше этого момента событие можно забрать: аренда relay или отложенный повтор';
+COMMENT ON COLUMN outbox_event.published_at IS 'Момент успешной доставки всем потребителям; NULL - ещё не доставлено';
@@ -0,0 +1,15 @@
+-- Чёрный список: пользователь user_id заблокировал blocked_user_id
+CREATE TABLE IF NOT EXISTS user_block (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    blocked_user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, blocked_user_id),
+    CONSTRAINT check_user_block_not_self CHECK (user_id <> blocked_user_id)
+);
+
+-- Проверка блокировки в обратную сторону (кто заблокировал меня)
+CREATE INDEX IF NOT EXISTS idx_user_block_blocked_user_id ON user_block(blocked_user_id);
+
+COMMENT ON TABLE user_block IS 'Заблокированные пользователи';
+COMMENT ON COLUMN user_block.user_id IS 'Кто заблокировал';
+COMMENT ON COLUMN user_block.blocked_user_id IS 'Кого заблокировали';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_privacy_exception;
+DROP TABLE IF EXISTS user_privacy;
+ALTER TABLE "user" DROP COLUMN IF EXISTS last_seen_at;
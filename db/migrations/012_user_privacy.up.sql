@@ -0,0 +1,31 @@
+-- Настройки приватности: кто видит поле профиля или может добавить пользователя в группу.
+-- Отсутствие строки означает значение по умолчанию
+CREATE TABLE IF NOT EXISTS user_privacy (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    setting TEXT NOT NULL,
+    visibility TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, setting),
+    CONSTRAINT check_user_privacy_setting CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add')),
+    CONSTRAINT check_user_privacy_visibility CHECK (visibility IN ('everybody', 'contacts', 'nobody'))
+);
+
+-- Исключения из правила: allow = true - разрешить, false - запретить конкретному пользователю
+CREATE TABLE IF NOT EXISTS user_privacy_exception (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    setting TEXT NOT NULL,
+    target_user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    allow BOOLEAN NOT NULL,
+    PRIMARY KEY (user_id, setting, target_user_id),
+    CONSTRAINT check_user_privacy_exception_setting CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add'))
+);
+
+-- Время последнего визита, которое скрывает настройка last_seen
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN "user".last_seen_at IS 'Когда пользователь последний раз был в сети';
+COMMENT ON TABLE user_privacy IS 'Настройки приватности пользователя';
+COMMENT ON COLUMN user_privacy.setting IS 'Поле профиля или действие';
+COMMENT ON COLUMN user_privacy.visibility IS 'Кому разрешено: everybody, contacts или nobody';
+COMMENT ON TABLE user_privacy_exception IS 'Исключения из настроек приватности';
+COMMENT ON COLUMN user_privacy_exception.allow IS 'Разрешить (true) или запретить (false) вопреки правилу';
@@ -0,0 +1,2 @@
+ALTER TABLE contact DROP CONSTRAINT IF EXISTS check_contact_alias_length;
+ALTER TABLE contact DROP COLUMN IF EXISTS alias;
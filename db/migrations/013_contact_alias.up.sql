@@ -0,0 +1,7 @@
+-- Личное имя контакта, видимое только владельцу списка контактов
+ALTER TABLE contact ADD COLUMN IF NOT EXISTS alias TEXT NULL;
+
+ALTER TABLE contact ADD CONSTRAINT check_contact_alias_length
+    CHECK (alias IS NULL OR char_length(alias) BETWEEN 1 AND 64);
+
+COMMENT ON COLUMN contact.alias IS 'Имя контакта, заданное владельцем списка';
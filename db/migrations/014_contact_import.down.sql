@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS contact_import_quota;
+DROP TABLE IF EXISTS contact_import;
+DROP INDEX IF EXISTS idx_user_phone_hash;
+ALTER TABLE "user" DROP COLUMN IF EXISTS phone_hash;
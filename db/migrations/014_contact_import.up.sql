@@ -0,0 +1,30 @@
+-- Хэш нормализованного номера: по нему ищутся пользователи при импорте адресной книги
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS phone_hash TEXT
+    GENERATED ALWAYS AS (encode(sha256(phone_number::bytea), 'hex')) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_user_phone_hash ON "user"(phone_hash);
+
+-- Номера из адресной книги, владельцы которых ещё не зарегистрированы. Хранятся только HMAC хэшей
+CREATE TABLE IF NOT EXISTS contact_import (
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    phone_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, phone_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contact_import_phone_hash ON contact_import(phone_hash);
+
+-- Учёт проверенных номеров для ограничения частоты импорта
+CREATE TABLE IF NOT EXISTS contact_import_quota (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    entries INTEGER NOT NULL CHECK (entries > 0),
+    imported_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_contact_import_quota_user ON contact_import_quota(user_id, imported_at);
+
+COMMENT ON COLUMN "user".phone_hash IS 'SHA-256 нормализованного номера телефона в hex';
+COMMENT ON TABLE contact_import IS 'Номера из адресных книг, ожидающие регистрации владельца';
+COMMENT ON COLUMN contact_import.phone_hash IS 'HMAC-SHA256 хэша номера на секрете сервера в hex';
+COMMENT ON TABLE contact_import_quota IS 'Количество номеров, проверенных пользователем при импорте';
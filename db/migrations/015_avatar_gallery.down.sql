@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_avatar_chat_current;
+DROP INDEX IF EXISTS idx_avatar_user_current;
+ALTER TABLE avatar_chat DROP COLUMN IF EXISTS current_since;
+ALTER TABLE avatar_user DROP COLUMN IF EXISTS current_since;
+ALTER TABLE avatar_chat DROP COLUMN IF EXISTS sizes;
+ALTER TABLE avatar_user DROP COLUMN IF EXISTS sizes;
@@ -0,0 +1,18 @@
+-- Размеры квадратных копий аватарки; самый крупный хранится под id вложения
+ALTER TABLE avatar_user ADD COLUMN IF NOT EXISTS sizes INTEGER[] NOT NULL DEFAULT '{}';
+ALTER TABLE avatar_chat ADD COLUMN IF NOT EXISTS sizes INTEGER[] NOT NULL DEFAULT '{}';
+
+-- Текущей считается аватарка с самым поздним current_since: так из истории можно вернуть прежнюю
+ALTER TABLE avatar_user ADD COLUMN IF NOT EXISTS current_since TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE avatar_chat ADD COLUMN IF NOT EXISTS current_since TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE avatar_user SET current_since = created_at;
+UPDATE avatar_chat SET current_since = created_at;
+
+CREATE INDEX IF NOT EXISTS idx_avatar_user_current ON avatar_user(user_id, current_since DESC);
+CREATE INDEX IF NOT EXISTS idx_avatar_chat_current ON avatar_chat(chat_id, current_since DESC);
+
+COMMENT ON COLUMN avatar_user.sizes IS 'Стороны сохранённых копий аватарки по убыванию';
+COMMENT ON COLUMN avatar_user.current_since IS 'Момент, с которого аватарка стала текущей';
+COMMENT ON COLUMN avatar_chat.sizes IS 'Стороны сохранённых копий аватарки по убыванию';
+COMMENT ON COLUMN avatar_chat.current_since IS 'Момент, с которого аватарка стала текущей';
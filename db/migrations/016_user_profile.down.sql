@@ -0,0 +1,19 @@
+DELETE FROM user_privacy_exception WHERE setting = 'birthday';
+DELETE FROM user_privacy WHERE setting = 'birthday';
+
+ALTER TABLE user_privacy_exception DROP CONSTRAINT IF EXISTS check_user_privacy_exception_setting;
+ALTER TABLE user_privacy_exception ADD CONSTRAINT check_user_privacy_exception_setting
+    CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add'));
+ALTER TABLE user_privacy DROP CONSTRAINT IF EXISTS check_user_privacy_setting;
+ALTER TABLE user_privacy ADD CONSTRAINT check_user_privacy_setting
+    CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add'));
+
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS check_links_count;
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS check_status_text_length;
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS check_status_emoji_length;
+
+ALTER TABLE "user" DROP COLUMN IF EXISTS links;
+ALTER TABLE "user" DROP COLUMN IF EXISTS birthday;
+ALTER TABLE "user" DROP COLUMN IF EXISTS status_expires_at;
+ALTER TABLE "user" DROP COLUMN IF EXISTS status_text;
+ALTER TABLE "user" DROP COLUMN IF EXISTS status_emoji;
@@ -0,0 +1,30 @@
+-- Статус пользователя: эмодзи и текст, истёкший статус не показывается
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS status_emoji TEXT NULL;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS status_text TEXT NULL;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS status_expires_at TIMESTAMPTZ NULL;
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS birthday DATE NULL;
+
+-- Ссылки профиля хранятся списком {title, url} в порядке вывода
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS links JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE "user" ADD CONSTRAINT check_status_emoji_length
+    CHECK (status_emoji IS NULL OR char_length(status_emoji) <= 8);
+ALTER TABLE "user" ADD CONSTRAINT check_status_text_length
+    CHECK (status_text IS NULL OR char_length(status_text) <= 70);
+ALTER TABLE "user" ADD CONSTRAINT check_links_count
+    CHECK (jsonb_typeof(links) = 'array' AND jsonb_array_length(links) <= 5);
+
+-- День рождения скрывается отдельной настройкой приватности
+ALTER TABLE user_privacy DROP CONSTRAINT IF EXISTS check_user_privacy_setting;
+ALTER TABLE user_privacy ADD CONSTRAINT check_user_privacy_setting
+    CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add', 'birthday'));
+ALTER TABLE user_privacy_exception DROP CONSTRAINT IF EXISTS check_user_privacy_exception_setting;
+ALTER TABLE user_privacy_exception ADD CONSTRAINT check_user_privacy_exception_setting
+    CHECK (setting IN ('phone_number', 'avatar', 'last_seen', 'bio', 'group_add', 'birthday'));
+
+COMMENT ON COLUMN "user".status_emoji IS 'Эмодзи статуса';
+COMMENT ON COLUMN "user".status_text IS 'Текст статуса';
+COMMENT ON COLUMN "user".status_expires_at IS 'Момент, после которого статус не показывается; NULL - бессрочно';
+COMMENT ON COLUMN "user".birthday IS 'День рождения';
+COMMENT ON COLUMN "user".links IS 'Ссылки профиля: массив объектов {title, url}';
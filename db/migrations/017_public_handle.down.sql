@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS username_redirect;
+
+DROP INDEX IF EXISTS idx_chat_username_lower;
+ALTER TABLE chat DROP CONSTRAINT IF EXISTS check_chat_username_type;
+ALTER TABLE chat DROP CONSTRAINT IF EXISTS check_chat_username_length;
+ALTER TABLE chat DROP COLUMN IF EXISTS username;
+
+DROP INDEX IF EXISTS idx_user_username_lower;
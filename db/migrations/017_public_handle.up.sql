@@ -0,0 +1,44 @@
+-- Раньше handle сравнивался с учётом регистра. Из совпадающих без учёта регистра имя сохраняет самый ранний,
+-- остальным дописывается суффикс из id: длина остаётся в пределах 20 символов, а повторный запуск ничего не меняет
+WITH duplicates AS (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY LOWER(username) ORDER BY created_at, id) AS position
+    FROM "user"
+)
+UPDATE "user" u
+SET username = LEFT(u.username, 13) || '_' || LEFT(md5(u.id::text), 6)
+FROM duplicates d
+WHERE d.id = u.id AND d.position > 1;
+
+-- Handle (username) уникален без учёта регистра
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_username_lower ON "user"(LOWER(username));
+
+-- Группа или канал с username считается публичным
+ALTER TABLE chat ADD COLUMN IF NOT EXISTS username TEXT NULL;
+ALTER TABLE chat DROP CONSTRAINT IF EXISTS check_chat_username_length;
+ALTER TABLE chat ADD CONSTRAINT check_chat_username_length
+    CHECK (username IS NULL OR (LENGTH(username) >= 3 AND LENGTH(username) <= 20));
+ALTER TABLE chat DROP CONSTRAINT IF EXISTS check_chat_username_type;
+ALTER TABLE chat ADD CONSTRAINT check_chat_username_type
+    CHECK (username IS NULL OR chat_type <> 'dialog');
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_username_lower ON chat(LOWER(username));
+
+-- После переименования прежний handle ещё какое-то время ведёт на владельца и недоступен другим
+CREATE TABLE IF NOT EXISTS username_redirect (
+    username TEXT PRIMARY KEY,
+    user_id UUID NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    chat_id UUID NULL REFERENCES chat(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_username_redirect_owner CHECK (num_nonnulls(user_id, chat_id) = 1),
+    CONSTRAINT check_username_redirect_lower CHECK (username = LOWER(username))
+);
+
+CREATE INDEX IF NOT EXISTS idx_username_redirect_user ON username_redirect(user_id);
+CREATE INDEX IF NOT EXISTS idx_username_redirect_chat ON username_redirect(chat_id);
+
+COMMENT ON COLUMN chat.username IS 'Публичный handle группы или канала; NULL - чат приватный';
+COMMENT ON TABLE username_redirect IS 'Прежние handle пользователей и чатов на время переходного периода';
+COMMENT ON COLUMN username_redirect.username IS 'Прежний handle в нижнем регистре';
+COMMENT ON COLUMN username_redirect.expires_at IS 'Момент, после которого handle освобождается';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS chat_join_request;
+DROP TABLE IF EXISTS chat_invite;
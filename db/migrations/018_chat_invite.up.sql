@@ -0,0 +1,39 @@
+-- Пригласительные ссылки в группы и каналы
+CREATE TABLE IF NOT EXISTS chat_invite (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    chat_id UUID NOT NULL REFERENCES chat(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    creator_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NULL,
+    usage_limit INT NULL,
+    usage_count INT NOT NULL DEFAULT 0,
+    requires_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    revoked_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_chat_invite_name_length CHECK (LENGTH(name) <= 32),
+    CONSTRAINT check_chat_invite_usage_limit CHECK (usage_limit IS NULL OR usage_limit > 0),
+    CONSTRAINT check_chat_invite_usage_count CHECK (usage_count >= 0),
+    -- По ссылке с одобрением вступает столько, сколько одобрят админы
+    CONSTRAINT check_chat_invite_approval_limit CHECK (NOT requires_approval OR usage_limit IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_invite_chat ON chat_invite(chat_id);
+
+-- Заявки на вступление по ссылкам с одобрением
+CREATE TABLE IF NOT EXISTS chat_join_request (
+    chat_id UUID NOT NULL REFERENCES chat(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    user_id UUID NOT NULL REFERENCES "user"(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    invite_id UUID NULL REFERENCES chat_invite(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (chat_id, user_id)
+);
+
+COMMENT ON TABLE chat_invite IS 'Пригласительные ссылки, созданные админами групп и каналов';
+COMMENT ON COLUMN chat_invite.code IS 'Случайный код ссылки';
+COMMENT ON COLUMN chat_invite.expires_at IS 'Момент, после которого ссылка недействительна; NULL - бессрочная';
+COMMENT ON COLUMN chat_invite.usage_limit IS 'Сколько пользователей может вступить по ссылке; NULL - без ограничений';
+COMMENT ON COLUMN chat_invite.requires_approval IS 'Вступление по ссылке требует одобрения админа';
+COMMENT ON COLUMN chat_invite.revoked_at IS 'Момент отзыва ссылки; NULL - ссылка не отозвана';
+COMMENT ON TABLE chat_join_request IS 'Очередь заявок на вступление, ожидающих решения админа';
@@ -23,55 +23,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/chats": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Возвращает список всех чатов текущего пользователя с информацией о последнем сообщении",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "chats"
-                ],
-                "summary": "Получить список чатов",
-                "responses": {
-                    "200": {
-                        "description": "Список чатов",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ChatViewInformationDTO"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Некорректный запрос",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    },
-                    "401": {
-                        "description": "Неавторизованный доступ",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    }
-                }
-            },
+        "/bot/messages": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Создает новый чат с указанными участниками и настройками",
+                "description": "Отправляет текстовое сообщение в чат. Доступно только при авторизации токеном бота (заголовок Authorization: Bearer), бот должен быть участником чата.",
                 "consumes": [
                     "application/json"
                 ],
@@ -79,33 +38,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Создать новый чат",
+                "summary": "Отправить сообщение от имени бота",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "CSRF Token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "description": "Данные для создания чата",
-                        "name": "chat",
+                        "description": "Сообщение",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ChatCreateInformationDTO"
+                            "$ref": "#/definitions/dto.SendMessageRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "ID созданного чата",
-                        "schema": {
-                            "$ref": "#/definitions/dto.IdDTO"
-                        }
+                    "200": {
+                        "description": "Сообщение отправлено"
                     },
                     "400": {
                         "description": "Некорректный запрос",
@@ -118,69 +67,62 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
+                    },
+                    "403": {
+                        "description": "Требуется авторизация токеном бота",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     }
                 }
             }
         },
-        "/chats/avatars/query": {
-            "post": {
+        "/bots": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает аватарки для списка чатов по их ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает ботов, созданных текущим пользователем",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
-                ],
-                "summary": "Получить аватарки чатов",
-                "parameters": [
-                    {
-                        "description": "Список ID чатов",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.GetAvatarsRequest"
-                        }
-                    }
+                    "bots"
                 ],
+                "summary": "Получить список ботов",
                 "responses": {
                     "200": {
-                        "description": "Аватарки чатов",
+                        "description": "Список ботов",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetAvatarsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Bot"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Некорректный запрос",
+                    "401": {
+                        "description": "Неавторизованный доступ",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Неавторизованный доступ",
+                    "403": {
+                        "description": "Управление ботами доступно только через сессию",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/chats/dialog/{otherUserId}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает информацию о личном чате между авторизованным пользователем и указанным пользователем. Если чата нет — возвращается ошибку.",
+                "description": "Создает пользователя-бота и возвращает его токен. Username бота должен заканчиваться на \"bot\". Токен возвращается только один раз",
                 "consumes": [
                     "application/json"
                 ],
@@ -188,28 +130,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Получить личный диалог с пользователем",
+                "summary": "Создать бота",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "ID другого пользователя",
-                        "name": "otherUserId",
-                        "in": "path",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Имя и username бота",
+                        "name": "bot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateBotRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Информация о личном чате",
+                    "201": {
+                        "description": "Созданный бот",
                         "schema": {
-                            "$ref": "#/definitions/dto.IdDTO"
+                            "$ref": "#/definitions/dto.CreatedBot"
                         }
                     },
                     "400": {
-                        "description": "Некорректный ID пользователя",
+                        "description": "Некорректные данные",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -220,8 +170,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "403": {
+                        "description": "Управление ботами доступно только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Username уже занят",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -229,45 +185,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/search": {
-            "get": {
+        "/bots/{bot_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Позволяет искать чаты по части или полному имени",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Удаляет бота вместе с его токенами и членством в чатах",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Поиск чатов по имени",
+                "summary": "Удалить бота",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Имя или часть имени чата для поиска",
-                        "name": "name",
-                        "in": "query",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID бота",
+                        "name": "bot_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список найденных чатов",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ChatViewInformationDTO"
-                            }
-                        }
+                        "description": "бот удален"
                     },
                     "400": {
-                        "description": "Некорректный запрос",
+                        "description": "Некорректный ID бота",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -277,18 +231,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
+                    },
+                    "404": {
+                        "description": "Бот не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     }
                 }
             }
         },
-        "/chats/{chatId}": {
-            "get": {
+        "/bots/{bot_id}/tokens": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает детальную информацию о конкретном чате, включая сообщения и участников. Поддерживает пагинацию сообщений через параметры offset и limit.",
+                "description": "Выпускает дополнительный токен для бота. Значение токена возвращается только один раз",
                 "consumes": [
                     "application/json"
                 ],
@@ -296,42 +256,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Получить информацию о чате",
+                "summary": "Создать токен бота",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chatId",
-                        "in": "path",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение для пагинации сообщений",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ID бота",
+                        "name": "bot_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Количество сообщений на странице",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Название, scope и срок жизни токена",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTokenRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Детальная информация о чате",
+                    "201": {
+                        "description": "Созданный токен",
                         "schema": {
-                            "$ref": "#/definitions/dto.ChatDetailedInformationDTO"
+                            "$ref": "#/definitions/dto.CreatedToken"
                         }
                     },
                     "400": {
-                        "description": "Некорректный запрос",
+                        "description": "Некорректные данные",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -343,30 +304,29 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Не существует такого чата",
+                        "description": "Бот не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/bots/{bot_id}/tokens/{token_id}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Удаляет существующий чат. Только администратор чата может удалить чат.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Отзывает токен бота, после чего он перестает приниматься",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Удалить чат",
+                "summary": "Отозвать токен бота",
                 "parameters": [
                     {
                         "type": "string",
@@ -377,19 +337,25 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chatId",
+                        "description": "ID бота",
+                        "name": "bot_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID токена",
+                        "name": "token_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "токен отозван"
                     },
                     "400": {
-                        "description": "Некорректный запрос",
+                        "description": "Некорректный ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -400,27 +366,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "403": {
-                        "description": "Нет прав для удаления чата",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    },
                     "404": {
-                        "description": "Чат не найден",
+                        "description": "Бот или токен не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/bots/{bot_id}/webhook": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Позволяет администратору изменить название и описание чата",
+                "description": "Задает адрес, на который отправляются новые сообщения с упоминанием бота. Запросы подписываются HMAC-SHA256 ключом из ответа: заголовок X-Webhook-Signature содержит sha256=hex(HMAC(secret, X-Webhook-Timestamp + \".\" + body)). Допускаются только https-адреса вне внутренней сети. Пустой url отключает webhook",
                 "consumes": [
                     "application/json"
                 ],
@@ -428,9 +390,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "chats"
+                    "bots"
                 ],
-                "summary": "Обновить чат",
+                "summary": "Задать webhook бота",
                 "parameters": [
                     {
                         "type": "string",
@@ -441,28 +403,30 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chatId",
+                        "description": "ID бота",
+                        "name": "bot_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Поля для обновления чата",
-                        "name": "chat",
+                        "description": "Адрес webhook",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ChatUpdateDTO"
+                            "$ref": "#/definitions/dto.SetWebhookRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Ключ подписи",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSecret"
+                        }
                     },
                     "400": {
-                        "description": "Некорректный запрос",
+                        "description": "Некорректный адрес",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -473,14 +437,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "403": {
-                        "description": "Нет прав для изменения чата",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    },
                     "404": {
-                        "description": "Чат не найден",
+                        "description": "Бот не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -488,16 +446,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/{chatId}/avatar": {
-            "post": {
+        "/chats": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Загружает новый аватар для чата (только админ)",
+                "description": "Возвращает чаты текущего пользователя с информацией о последнем сообщении и персональными настройками. Закреплённые чаты идут первыми, остальные - по времени последнего сообщения. По умолчанию архивные чаты не возвращаются.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -505,43 +463,34 @@ const docTemplate = `{
                 "tags": [
                     "chats"
                 ],
-                "summary": "Загрузить аватарку чата",
+                "summary": "Получить список чатов",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "CSRF Token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Вернуть архивные (true) или обычные (false) чаты",
+                        "name": "archived",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chatId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Файл аватара",
-                        "name": "avatar",
-                        "in": "formData",
-                        "required": true
+                        "description": "Вернуть только чаты из указанной папки",
+                        "name": "folder",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "URL загруженного аватара",
+                        "description": "Список чатов",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ChatViewInformationDTO"
                             }
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -551,30 +500,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
-                    },
-                    "403": {
-                        "description": "Нет прав для изменения чата",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    },
-                    "404": {
-                        "description": "Чат не найден",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
                     }
                 }
-            }
-        },
-        "/chats/{chatId}/members": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Добавляет указанных пользователей в существующий чат",
+                "description": "Создает новый чат с указанными участниками и настройками",
                 "consumes": [
                     "application/json"
                 ],
@@ -584,7 +519,7 @@ const docTemplate = `{
                 "tags": [
                     "chats"
                 ],
-                "summary": "Добавить пользователей в чат",
+                "summary": "Создать новый чат",
                 "parameters": [
                     {
                         "type": "string",
@@ -594,26 +529,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chatId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Список пользователей для добавления",
-                        "name": "users",
+                        "description": "Данные для создания чата",
+                        "name": "chat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.AddUsersToChatDTO"
+                            "$ref": "#/definitions/dto.ChatCreateInformationDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "ID созданного чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IdDTO"
+                        }
                     },
                     "400": {
                         "description": "Некорректный запрос",
@@ -630,14 +560,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/{chat_id}/messages": {
-            "get": {
+        "/chats/avatars/query": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает список сообщений указанного чата с поддержкой пагинации через параметры offset и limit",
+                "description": "Возвращает аватарки для списка чатов по их ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -645,41 +575,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "Получить сообщения чата",
+                "summary": "Получить аватарки чатов",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "ID чата",
-                        "name": "chat_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение для пагинации",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Количество сообщений на странице",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Список ID чатов",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAvatarsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список сообщений",
+                        "description": "Аватарки чатов",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.MessageDTO"
-                            }
+                            "$ref": "#/definitions/dto.GetAvatarsResponse"
                         }
                     },
                     "400": {
@@ -693,24 +607,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
-                    },
-                    "500": {
-                        "description": "Ошибка сервера",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
                     }
                 }
             }
         },
-        "/chats/{chat_id}/messages/search": {
+        "/chats/dialog/{otherUserId}": {
             "get": {
                 "security": [
                     {
-                        "Cookie": []
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Выполняет поиск сообщений в указанном чате по текстовому запросу.",
+                "description": "Возвращает информацию о личном чате между авторизованным пользователем и указанным пользователем. Если чата нет — возвращается ошибку.",
                 "consumes": [
                     "application/json"
                 ],
@@ -718,49 +626,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "Поиск сообщений в чате",
+                "summary": "Получить личный диалог с пользователем",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID чата",
-                        "name": "chat_id",
+                        "format": "uuid",
+                        "description": "ID другого пользователя",
+                        "name": "otherUserId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Текстовый запрос для поиска сообщений",
-                        "name": "text",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список найденных сообщений",
+                        "description": "Информация о личном чате",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.MessageDTO"
-                            }
+                            "$ref": "#/definitions/dto.IdDTO"
                         }
                     },
                     "400": {
-                        "description": "Некорректный запрос (например, отсутствует текстовый запрос)",
+                        "description": "Некорректный ID пользователя",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
                     "401": {
-                        "description": "Пользователь не авторизован",
+                        "description": "Неавторизованный доступ",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера при поиске сообщений",
+                    "404": {
+                        "description": "Пользователь не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -768,14 +667,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/contacts": {
+        "/chats/search": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает список всех контактов текущего пользователя с полной информацией о пользователях",
+                "description": "Позволяет искать чаты по части или полному имени",
                 "consumes": [
                     "application/json"
                 ],
@@ -783,40 +682,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "chats"
+                ],
+                "summary": "Поиск чатов по имени",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Имя или часть имени чата для поиска",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Получение списка контактов",
                 "responses": {
                     "200": {
-                        "description": "Список контактов успешно получен",
+                        "description": "Список найденных чатов",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.GetContactsDTO"
+                                "$ref": "#/definitions/dto.ChatViewInformationDTO"
                             }
                         }
                     },
-                    "401": {
-                        "description": "Неавторизованный доступ",
+                    "400": {
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                    "401": {
+                        "description": "Неавторизованный доступ",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/chats/{chatId}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Добавляет нового пользователя в список контактов текущего пользователя",
+                "description": "Возвращает детальную информацию о конкретном чате, включая сообщения и участников. Поддерживает пагинацию сообщений через параметры offset и limit.",
                 "consumes": [
                     "application/json"
                 ],
@@ -824,33 +734,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "chats"
                 ],
-                "summary": "Добавление контакта",
+                "summary": "Получить информацию о чате",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "CSRF Token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chatId",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Данные контакта для добавления",
-                        "name": "contact",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.PostContactDTO"
-                        }
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации сообщений",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Количество сообщений на странице",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Контакт успешно добавлен"
+                    "200": {
+                        "description": "Детальная информация о чате",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChatDetailedInformationDTO"
+                        }
                     },
                     "400": {
-                        "description": "Неверные данные запроса",
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -862,28 +781,20 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Пользователь не найден",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
-                    },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Не существует такого чата",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/contacts/search": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает список контактов текущего пользователя, отфильтрованных по поисковому запросу (имя или username)",
+                "description": "Удаляет существующий чат. Только администратор чата может удалить чат.",
                 "consumes": [
                     "application/json"
                 ],
@@ -891,30 +802,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "chats"
                 ],
-                "summary": "Поиск контактов",
+                "summary": "Удалить чат",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Поисковый запрос",
-                        "name": "query",
-                        "in": "query",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chatId",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список найденных контактов",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.GetContactsDTO"
-                            }
-                        }
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "Неверный запрос",
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -925,18 +838,27 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                    "403": {
+                        "description": "Нет прав для удаления чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Чат не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/login": {
-            "post": {
-                "description": "Аутентифицирует пользователя по номеру телефона и паролю через gRPC микросервис, создает сессию",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Позволяет администратору изменить название и описание чата",
                 "consumes": [
                     "application/json"
                 ],
@@ -944,35 +866,59 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "chats"
                 ],
-                "summary": "Аутентификация пользователя",
+                "summary": "Обновить чат",
                 "parameters": [
                     {
-                        "description": "Креденшиалы для входа",
-                        "name": "credentials",
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chatId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Поля для обновления чата",
+                        "name": "chat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
+                            "$ref": "#/definitions/dto.ChatUpdateDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Вход выполнен успешно",
-                        "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "Ошибки валидации",
+                        "description": "Некорректный запрос",
                         "schema": {
-                            "$ref": "#/definitions/dto.ValidationErrorsDTO"
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
                     "401": {
-                        "description": "Неверные креденшиалы",
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав для изменения чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Чат не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -980,24 +926,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/logout": {
+        "/chats/{chatId}/avatar": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Аннулирует текущую сессию через gRPC микросервис и удаляет cookie",
+                "description": "Загружает новый аватар для чата (только админ)",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "chats"
                 ],
-                "summary": "Выход из системы",
+                "summary": "Загрузить аватарку чата",
                 "parameters": [
                     {
                         "type": "string",
@@ -1005,61 +951,68 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chatId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл аватара (JPEG или PNG, сторона не меньше 100px)",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Logout successful"
+                        "description": "URL загруженного аватара",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса или изображения",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     },
                     "401": {
                         "description": "Неавторизованный доступ",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
-                    }
-                }
-            }
-        },
-        "/me": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Возвращает полные данные о текущем авторизованном пользователе",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "user"
-                ],
-                "summary": "Получить информацию о текущем пользователе",
-                "responses": {
-                    "200": {
-                        "description": "Информация о пользователе",
+                    },
+                    "403": {
+                        "description": "Нет прав для изменения чата",
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Неавторизованный доступ",
+                    "404": {
+                        "description": "Чат не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/chats/{chatId}/members": {
             "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Обновляет имя, username или bio текущего пользователя",
+                "description": "Добавляет указанных пользователей в существующий чат",
                 "consumes": [
                     "application/json"
                 ],
@@ -1067,9 +1020,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chats"
                 ],
-                "summary": "Обновить информацию о пользователе",
+                "summary": "Добавить пользователей в чат",
                 "parameters": [
                     {
                         "type": "string",
@@ -1079,21 +1032,29 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Данные для обновления",
-                        "name": "user",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chatId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Список пользователей для добавления",
+                        "name": "users",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserInfo"
+                            "$ref": "#/definitions/dto.AddUsersToChatDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация успешно обновлена"
+                        "description": "OK"
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1107,62 +1068,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/message/attachment": {
-            "post": {
+        "/chats/{chat_id}/avatars": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Загружает файл для последующей отправки в сообщении. Тип вложения (image, document, audio, video) определяется автоматически по Content-Type файла.",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Возвращает аватарки чата, начиная с текущей, со ссылками на все размеры (только участникам)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "Загрузить файл",
+                "summary": "История аватарок чата",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "CSRF Token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
+                        "format": "uuid",
                         "description": "ID чата",
                         "name": "chat_id",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Файл вложения",
-                        "name": "file",
-                        "in": "formData",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Длительность в секундах (для audio/voice/video_note)",
-                        "name": "duration",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о загруженном вложении с автоматически определенным типом",
+                        "description": "История аватарок",
                         "schema": {
-                            "$ref": "#/definitions/dto.AttachmentDTO"
+                            "$ref": "#/definitions/dto.GetAvatarHistoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1172,105 +1111,102 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
+                    },
+                    "403": {
+                        "description": "Пользователь не участник чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     }
                 }
             }
         },
-        "/message/ws": {
-            "get": {
+        "/chats/{chat_id}/avatars/{avatar_id}": {
+            "delete": {
                 "security": [
                     {
-                        "Cookie": []
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Устанавливает WebSocket соединение для отправки и получения сообщений в реальном времени.\n\n**Протокол WebSocket:**\n\n**1. Создание нового сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**1.1. Создание сообщения с вложением (клиент → сервер):**\nДля отправки файлов сначала загрузите файл через POST /messages/attachment, получите attachment_id, затем:\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"text\": \"Текст к вложению (опционально)\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"attachment\": {\n\"attachment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n\"type\": \"image\", // \"image\", \"document\", \"audio\", \"video\", \"sticker\", \"voice\", \"video_note\"\n\"duration\": 45 // для audio/voice/video_note - длительность в секундах (опционально)\n}\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\nДля стикеров используйте sticker_id вместо attachment_id. Поля attachment_id, type и file_url возвращаются из POST /messages/attachment.\n\n**2. Редактирование сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"edit_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\",\n\"text\": \"Обновленный текст\",\n\"updated_at\": \"2025-01-15T10:35:00Z\" // По желанию\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**3. Удаление сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"delete_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Получение событий (сервер → клиент):**\n\n**Новое сообщение:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"789e4567-e89b-12d3-a456-426614174002\",\n\"sender_id\": \"321e4567-e89b-12d3-a456-426614174003\",\n\"sender_name\": \"Иван Иванов\",\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"user\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Редактирование сообщения:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"edit_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\",\n\"text\": \"Обновленный текст\",\n\"updated_at\": \"2025-01-15T10:35:00Z\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Удаление сообщения:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"delete_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Создан новый чат:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"chat_created\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"dialog | group | channel\",\n\"value\": {\n\"id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"name\": \"Название чата\",\n\"last_message\": {\n\"id\": \"789e4567-e89b-12d3-a456-426614174002\",\n\"sender_id\": \"321e4567-e89b-12d3-a456-426614174003\",\n\"sender_name\": \"Иван Иванов\",\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"user\"\n}\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Обработка ошибок:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"error\": \"Описание ошибки\"\n}\n` + "`" + `` + "`" + `` + "`" + `",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Удаляет аватарку из истории чата вместе с файлами всех размеров (только админ)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "messages"
+                    "chats"
                 ],
-                "summary": "Установить WebSocket соединение для сообщений",
-                "responses": {
-                    "101": {
-                        "description": "WebSocket соединение установлено"
-                    },
-                    "401": {
-                        "description": "Пользователь не авторизован",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorDTO"
-                        }
+                "summary": "Удалить аватарку чата",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Ошибка сервера при установке WebSocket соединения",
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID аватарки",
+                        "name": "avatar_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Аватарка удалена"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
-                    }
-                }
-            }
-        },
-        "/register": {
-            "post": {
-                "description": "Регистрирует нового пользователя в системе через gRPC микросервис и создает сессию",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Регистрация пользователя",
-                "parameters": [
-                    {
-                        "description": "Данные для регистрации",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
                         "schema": {
-                            "$ref": "#/definitions/dto.RegisterRequest"
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Пользователь успешно зарегистрирован",
+                    },
+                    "403": {
+                        "description": "Нет прав для изменения чата",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "400": {
-                        "description": "Ошибки валидации",
+                    "404": {
+                        "description": "Аватарка не найдена",
                         "schema": {
-                            "$ref": "#/definitions/dto.ValidationErrorsDTO"
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/session": {
-            "delete": {
+        "/chats/{chat_id}/avatars/{avatar_id}/current": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "удалить конкретную сессию пользователя",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Делает аватарку из истории чата текущей (только админ)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "chats"
                 ],
-                "summary": "удалить сессию пользователя",
+                "summary": "Вернуть прежнюю аватарку чата",
                 "parameters": [
                     {
                         "type": "string",
@@ -1280,18 +1216,31 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Сессию которую надо удалить",
-                        "name": "session",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.DeleteSession"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID аватарки",
+                        "name": "avatar_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "сессия удалена"
+                    "204": {
+                        "description": "Аватарка стала текущей"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     },
                     "401": {
                         "description": "Неавторизованный доступ",
@@ -1299,8 +1248,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
+                    "403": {
+                        "description": "Нет прав для изменения чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
                     "404": {
-                        "description": "Не удалось удалить",
+                        "description": "Аватарка не найдена",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1308,55 +1263,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/sessions": {
+        "/chats/{chat_id}/invites": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает все активные сессии текущего авторизованного пользователя",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Все ссылки чата, включая отозванные и истёкшие, новые первыми (только админ)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "chats"
+                ],
+                "summary": "Список пригласительных ссылок",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получить список сессий пользователя",
                 "responses": {
                     "200": {
-                        "description": "Список сессий пользователя",
+                        "description": "Ссылки чата",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.Session"
+                                "$ref": "#/definitions/dto.InviteDTO"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Некорректный chat_id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
                     "401": {
                         "description": "Неавторизованный доступ",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Внутренняя ошибка сервера",
+                    "403": {
+                        "description": "Нет прав для управления ссылками",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "удалить сессии пользователя, кроме текущей",
+                "description": "Ссылка может иметь срок действия, лимит вступлений и требовать одобрения админа. Лимит вступлений для ссылок с одобрением не задаётся (только админ)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1364,9 +1332,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "chats"
                 ],
-                "summary": "удалить сессии пользователя, кроме текущей",
+                "summary": "Создать пригласительную ссылку",
                 "parameters": [
                     {
                         "type": "string",
@@ -1374,11 +1342,37 @@ const docTemplate = `{
                         "name": "X-CSRF-Token",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Параметры ссылки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InviteCreateDTO"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "сессии удалены"
+                    "201": {
+                        "description": "Созданная ссылка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InviteDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры ссылки либо чат - диалог",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     },
                     "401": {
                         "description": "Неавторизованный доступ",
@@ -1386,8 +1380,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Не удалось удалить сессии",
+                    "403": {
+                        "description": "Нет прав для управления ссылками",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1395,24 +1389,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/avatar": {
-            "post": {
+        "/chats/{chat_id}/invites/{invite_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Загружает новый аватар для текущего пользователя",
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "По отозванной ссылке вступить нельзя; уже поданные по ней заявки остаются в очереди (только админ)",
                 "tags": [
-                    "user"
+                    "chats"
                 ],
-                "summary": "Загрузить аватар пользователя",
+                "summary": "Отозвать пригласительную ссылку",
                 "parameters": [
                     {
                         "type": "string",
@@ -1422,25 +1410,28 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Файл аватара",
-                        "name": "avatar",
-                        "in": "formData",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID ссылки",
+                        "name": "invite_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "URL загруженного аватара",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                    "204": {
+                        "description": "Ссылка отозвана"
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный chat_id или invite_id",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1450,55 +1441,59 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
+                    },
+                    "403": {
+                        "description": "Нет прав для управления ссылками",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Ссылка не найдена или уже отозвана",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     }
                 }
             }
         },
-        "/user/by-phone": {
-            "post": {
+        "/chats/{chat_id}/join-requests": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает полные данные о пользователе по указанному номеру телефона",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Заявки, поданные по ссылкам с одобрением, старые первыми (только админ)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chats"
                 ],
-                "summary": "Получить информацию о пользователе по номеру телефона",
+                "summary": "Заявки на вступление",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "CSRF Token",
-                        "name": "X-CSRF-Token",
-                        "in": "header",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Номер телефона",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.GetUserByPhone"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о пользователе",
+                        "description": "Очередь заявок",
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.JoinRequestDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный chat_id",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1509,8 +1504,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "403": {
+                        "description": "Нет прав для управления заявками",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1518,24 +1513,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/by-username": {
+        "/chats/{chat_id}/join-requests/{user_id}/approve": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает полные данные о пользователе по указанному username",
-                "consumes": [
-                    "application/json"
+                "description": "Пользователь становится участником чата и сразу получает его обновления (только админ)",
+                "tags": [
+                    "chats"
                 ],
-                "produces": [
-                    "application/json"
+                "summary": "Одобрить заявку на вступление",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Заявка одобрена"
+                    },
+                    "400": {
+                        "description": "Некорректный chat_id или user_id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав для управления заявками",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/chats/{chat_id}/join-requests/{user_id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Заявка удаляется из очереди; пользователь может подать её снова (только админ)",
                 "tags": [
-                    "user"
+                    "chats"
                 ],
-                "summary": "Получить информацию о пользователе по username",
+                "summary": "Отклонить заявку на вступление",
                 "parameters": [
                     {
                         "type": "string",
@@ -1545,24 +1602,90 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Username пользователя",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Заявка отклонена"
+                    },
+                    "400": {
+                        "description": "Некорректный chat_id или user_id",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetUserByUsername"
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав для управления заявками",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     }
+                }
+            }
+        },
+        "/chats/{chat_id}/mentions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает сообщения чата, в которых упомянут текущий пользователь и которые он ещё не отметил прочитанными. Новые сообщения идут первыми.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Непрочитанные упоминания в чате",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Информация о пользователе",
+                        "description": "Сообщения с упоминаниями",
                         "schema": {
-                            "$ref": "#/definitions/dto.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MessageDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Неверный формат запроса",
+                        "description": "Некорректный запрос",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1573,8 +1696,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "500": {
+                        "description": "Ошибка сервера",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
@@ -1582,14 +1705,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/avatars/query": {
+        "/chats/{chat_id}/mentions/read": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает аватарки для списка пользователей по их ID",
+                "description": "Отмечает все упоминания текущего пользователя в чате прочитанными",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Прочитать упоминания в чате",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/chats/{chat_id}/messages": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает список сообщений указанного чата с поддержкой пагинации через параметры offset и limit",
                 "consumes": [
                     "application/json"
                 ],
@@ -1597,25 +1777,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "messages"
                 ],
-                "summary": "Получить аватарки пользователей",
+                "summary": "Получить сообщения чата",
                 "parameters": [
                     {
-                        "description": "Список ID пользователей",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.GetAvatarsRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение для пагинации",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Количество сообщений на странице",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Аватарки пользователей",
+                        "description": "Список сообщений",
                         "schema": {
-                            "$ref": "#/definitions/dto.GetAvatarsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MessageDTO"
+                            }
                         }
                     },
                     "400": {
@@ -1629,344 +1825,3310 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorDTO"
                         }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.AddChatMemberDTO": {
-            "type": "object",
-            "properties": {
-                "role": {
-                    "description": "Роль пользователя в чате - админ(писать и добавлять участников), участник(писать), зритель (только просмотр)",
-                    "type": "string"
-                },
-                "user_id": {
-                    "type": "string",
-                    "format": "uuid"
-                }
-            }
+        },
+        "/chats/{chat_id}/messages/search": {
+            "get": {
+                "security": [
+                    {
+                        "Cookie": []
+                    }
+                ],
+                "description": "Выполняет поиск сообщений в указанном чате по текстовому запросу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Поиск сообщений в чате",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Текстовый запрос для поиска сообщений",
+                        "name": "text",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список найденных сообщений",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MessageDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос (например, отсутствует текстовый запрос)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Пользователь не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера при поиске сообщений",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/chats/{chat_id}/settings": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Заглушение (навсегда или до момента muted_until), архивирование, закрепление и папка чата для текущего пользователя. Незаданные поля не меняются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chats"
+                ],
+                "summary": "Изменить настройки чата",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Изменяемые настройки",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChatSettingsUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Новые настройки чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChatSettingsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Чат не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/chats/{chat_id}/username": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Группа или канал с handle находятся по ссылке /resolve/{handle}. Пустой username делает чат приватным. После переименования прежний handle ещё 14 дней ведёт на чат (только админ)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chats"
+                ],
+                "summary": "Изменить публичный handle чата",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый handle",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChatUsernameDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Handle изменён"
+                    },
+                    "400": {
+                        "description": "Некорректный или зарезервированный handle, либо чат - диалог",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет прав для изменения чата",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Чат не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Handle уже занят",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает список всех контактов текущего пользователя с полной информацией о пользователях",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Получение списка контактов",
+                "responses": {
+                    "200": {
+                        "description": "Список контактов успешно получен",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.GetContactsDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет нового пользователя в список контактов текущего пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Добавление контакта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные контакта для добавления",
+                        "name": "contact",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PostContactDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Контакт успешно добавлен"
+                    },
+                    "400": {
+                        "description": "Неверные данные запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Сверяет номера из адресной книги устройства с зарегистрированными пользователями и добавляет найденных в контакты. Номера передаются как есть или SHA-256 нормализованного номера (+7XXXXXXXXXX) в hex. Когда зарегистрируется владелец ненайденного номера, он будет добавлен в контакты и придёт уведомление contact_joined. Число проверяемых номеров ограничено",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Импорт адресной книги",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Номера из адресной книги",
+                        "name": "contacts",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportContactsDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Найденные пользователи",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportContactsResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой или слишком длинный список",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "429": {
+                        "description": "Превышен лимит импорта",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает список контактов текущего пользователя, отфильтрованных по поисковому запросу (имя или username)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Поиск контактов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Поисковый запрос",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список найденных контактов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.GetContactsDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет пользователя из списка контактов текущего пользователя вместе с заданным ему именем",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Удаление контакта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID пользователя из контактов",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Контакт удалён"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Контакт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Задаёт личное имя контакта, которое видит только текущий пользователь: в списке контактов, поиске и названиях диалогов. Пустое значение сбрасывает имя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Переименование контакта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID пользователя из контактов",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Имя контакта",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateContactAliasDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Имя сохранено"
+                    },
+                    "400": {
+                        "description": "Некорректный ID или слишком длинное имя",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Контакт не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/{code}/join": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Если ссылка требует одобрения, создаётся заявка и возвращается pending=true; иначе пользователь сразу становится участником и получает обновления чата",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chats"
+                ],
+                "summary": "Вступить по пригласительной ссылке",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код ссылки",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат вступления",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JoinByInviteDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Ссылка не найдена, отозвана, истекла или исчерпана",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь уже состоит в чате",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Аутентифицирует пользователя по номеру телефона и паролю через gRPC микросервис, создает сессию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Аутентификация пользователя",
+                "parameters": [
+                    {
+                        "description": "Креденшиалы для входа",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Вход выполнен успешно",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибки валидации",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorsDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неверные креденшиалы",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Аннулирует текущую сессию через gRPC микросервис и удаляет cookie",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выход из системы",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Logout successful"
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает полные данные о текущем авторизованном пользователе",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Получить информацию о текущем пользователе",
+                "responses": {
+                    "200": {
+                        "description": "Информация о пользователе",
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Обновляет имя, username, bio, статус, день рождения или ссылки текущего пользователя. Статус с пустыми эмодзи и текстом сбрасывается, пустая строка в birthday удаляет день рождения, links заменяет список целиком",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Обновить информацию о пользователе",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserInfo"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация успешно обновлена"
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/phone": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отправляет код подтверждения на новый номер. Код действует 10 минут, повторно запросить его можно не чаще раза в минуту. Доступно только при авторизации через сессию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Запросить смену номера телефона",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый номер телефона",
+                        "name": "phone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PhoneChangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Код отправлен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PhoneChangeRequested"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный номер",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Смена номера доступна только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Номер уже занят",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "429": {
+                        "description": "Код запрошен слишком часто",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/phone/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Проверяет код и заменяет номер. На прежний номер уходит уведомление о смене. С revoke_sessions завершаются все сессии, кроме текущей. После 5 неверных кодов смену нужно запросить заново",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Подтвердить смену номера телефона",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Код из SMS",
+                        "name": "confirm",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PhoneChangeConfirmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Номер изменён"
+                    },
+                    "400": {
+                        "description": "Неверный код",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Смена номера доступна только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Нет ожидающей смены номера или код истёк",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Номер уже занят",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "429": {
+                        "description": "Исчерпаны попытки ввода кода",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/privacy": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает, кто видит номер телефона, аватар, время последнего входа и bio текущего пользователя и кто может добавлять его в группы",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Настройки приватности",
+                "responses": {
+                    "200": {
+                        "description": "Настройки приватности",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PrivacyRuleDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/privacy/{setting}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Задаёт, кому разрешено: everybody, contacts или nobody. Исключения заменяют прежние; запрет сильнее разрешения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Изменить настройку приватности",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "phone_number, avatar, last_seen, bio, group_add или birthday",
+                        "name": "setting",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Правило",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdatePrivacyRuleDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Настройка сохранена"
+                    },
+                    "400": {
+                        "description": "Некорректное правило",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь из исключений не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/message/attachment": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Загружает файл для последующей отправки в сообщении. Тип вложения (image, document, audio, video) определяется автоматически по Content-Type файла.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Загрузить файл",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID чата",
+                        "name": "chat_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл вложения",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Длительность в секундах (для audio/voice/video_note)",
+                        "name": "duration",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о загруженном вложении с автоматически определенным типом",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AttachmentDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/message/ws": {
+            "get": {
+                "security": [
+                    {
+                        "Cookie": []
+                    }
+                ],
+                "description": "Устанавливает WebSocket соединение для отправки и получения сообщений в реальном времени.\n\n**Протокол WebSocket:**\n\n**1. Создание нового сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**1.1. Создание сообщения с вложением (клиент → сервер):**\nДля отправки файлов сначала загрузите файл через POST /messages/attachment, получите attachment_id, затем:\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"text\": \"Текст к вложению (опционально)\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"attachment\": {\n\"attachment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n\"type\": \"image\", // \"image\", \"document\", \"audio\", \"video\", \"sticker\", \"voice\", \"video_note\"\n\"duration\": 45 // для audio/voice/video_note - длительность в секундах (опционально)\n}\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\nДля стикеров используйте sticker_id вместо attachment_id. Поля attachment_id, type и file_url возвращаются из POST /messages/attachment.\n\n**2. Редактирование сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"edit_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\",\n\"text\": \"Обновленный текст\",\n\"updated_at\": \"2025-01-15T10:35:00Z\" // По желанию\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**3. Удаление сообщения (клиент → сервер):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"delete_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Получение событий (сервер → клиент):**\n\n**Новое сообщение:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"new_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"789e4567-e89b-12d3-a456-426614174002\",\n\"sender_id\": \"321e4567-e89b-12d3-a456-426614174003\",\n\"sender_name\": \"Иван Иванов\",\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"user\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Редактирование сообщения:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"edit_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\",\n\"text\": \"Обновленный текст\",\n\"updated_at\": \"2025-01-15T10:35:00Z\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Удаление сообщения:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"delete_message\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"value\": {\n\"id\": \"456e4567-e89b-12d3-a456-426614174001\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Создан новый чат:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"chat_created\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"dialog | group | channel\",\n\"value\": {\n\"id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"name\": \"Название чата\",\n\"last_message\": {\n\"id\": \"789e4567-e89b-12d3-a456-426614174002\",\n\"sender_id\": \"321e4567-e89b-12d3-a456-426614174003\",\n\"sender_name\": \"Иван Иванов\",\n\"text\": \"Текст сообщения\",\n\"created_at\": \"2025-01-15T10:30:00Z\",\n\"chat_id\": \"123e4567-e89b-12d3-a456-426614174000\",\n\"type\": \"user\"\n}\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Системное уведомление (например, вход с нового устройства):**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"type\": \"system_notification\",\n\"chat_id\": \"00000000-0000-0000-0000-000000000000\",\n\"value\": {\n\"kind\": \"new_device\",\n\"text\": \"Выполнен вход в аккаунт с нового устройства: Chrome 120.0 on Windows 10, Moscow, Russia, IP 5.255.255.10\",\n\"created_at\": \"2025-01-15T10:30:00Z\"\n}\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n**Обработка ошибок:**\n` + "`" + `` + "`" + `` + "`" + `json\n{\n\"error\": \"Описание ошибки\"\n}\n` + "`" + `` + "`" + `` + "`" + `",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "messages"
+                ],
+                "summary": "Установить WebSocket соединение для сообщений",
+                "responses": {
+                    "101": {
+                        "description": "WebSocket соединение установлено"
+                    },
+                    "401": {
+                        "description": "Пользователь не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера при установке WebSocket соединения",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Регистрирует нового пользователя в системе через gRPC микросервис и создает сессию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Регистрация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для регистрации",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Пользователь успешно зарегистрирован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибки валидации",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/resolve/{handle}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает пользователя, публичную группу или канал по handle (без учёта регистра, можно с @). После переименования прежний handle ещё 14 дней ведёт на владельца - тогда redirected = true",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Открыть ссылку по handle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Публичный handle",
+                        "name": "handle",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Владелец handle",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResolveResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный handle",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Handle никому не принадлежит",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/session": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "удалить конкретную сессию пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "удалить сессию пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Сессию которую надо удалить",
+                        "name": "session",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteSession"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "сессия удалена"
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Не удалось удалить",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/session/push-token": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Привязывает токен FCM, APNs или подписку Web Push к текущей сессии. Токен удаляется вместе с сессией",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Зарегистрировать push-токен устройства",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Платформа и токен устройства",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PushToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "токен сохранён"
+                    },
+                    "400": {
+                        "description": "Некорректная платформа или токен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отключает push-уведомления для текущей сессии",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Удалить push-токен устройства",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "токен удалён"
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все активные сессии текущего авторизованного пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Получить список сессий пользователя",
+                "responses": {
+                    "200": {
+                        "description": "Список сессий пользователя",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Session"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "удалить сессии пользователя, кроме текущей",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "удалить сессии пользователя, кроме текущей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "сессии удалены"
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Не удалось удалить сессии",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает неотозванные токены пользователя без их значений",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Получить список персональных токенов",
+                "responses": {
+                    "200": {
+                        "description": "Список токенов",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.Token"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Управление токенами доступно только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает токен для доступа к API через заголовок Authorization: Bearer. Значение токена возвращается только один раз. Доступно только при авторизации через сессию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Создать персональный токен",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Название, scope (read, write) и срок жизни токена в днях",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Созданный токен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedToken"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Управление токенами доступно только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{token_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отзывает токен, после чего он перестает приниматься",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Отозвать персональный токен",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID токена",
+                        "name": "token_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "токен отозван"
+                    },
+                    "400": {
+                        "description": "Некорректный ID токена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Управление токенами доступно только через сессию",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Токен не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/avatar": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Загружает новый аватар для текущего пользователя",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Загрузить аватар пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл аватара (JPEG или PNG, сторона не меньше 100px)",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "URL загруженного аватара",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса или изображения",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/by-phone": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает полные данные о пользователе по указанному номеру телефона",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Получить информацию о пользователе по номеру телефона",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Номер телефона",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserByPhone"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о пользователе",
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/by-username": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает полные данные о пользователе по указанному username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Получить информацию о пользователе по username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Username пользователя",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetUserByUsername"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Информация о пользователе",
+                        "schema": {
+                            "$ref": "#/definitions/dto.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный формат запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/avatars/query": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает аватарки для списка пользователей по их ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Получить аватарки пользователей",
+                "parameters": [
+                    {
+                        "description": "Список ID пользователей",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAvatarsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Аватарки пользователей",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAvatarsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/avatars/{avatar_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет аватарку из истории вместе с файлами всех размеров. Если удалена текущая, текущей становится предыдущая",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Удаление аватарки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID аватарки",
+                        "name": "avatar_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Аватарка удалена"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Аватарка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/avatars/{avatar_id}/current": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Делает аватарку из истории текущего пользователя текущей",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Вернуть прежнюю аватарку",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID аватарки",
+                        "name": "avatar_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Аватарка стала текущей"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Аватарка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/blocked": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает пользователей, заблокированных текущим пользователем, начиная с последних",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blocks"
+                ],
+                "summary": "Список заблокированных пользователей",
+                "responses": {
+                    "200": {
+                        "description": "Список заблокированных пользователей",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.BlockedUserDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Ищет среди всех пользователей по префиксу username (можно с @) и имени. Контакты и участники общих групп идут первыми, заблокированные не показываются, скрытые настройками приватности поля пустые",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Глобальный поиск людей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Поисковый запрос, не короче 2 символов",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Смещение (по умолчанию 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы (по умолчанию 20, не больше 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница найденных пользователей",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SearchUsersResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/avatars": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает аватарки пользователя, начиная с текущей, со ссылками на все размеры. Если владелец скрыл аватарку, список пустой",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "История аватарок пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История аватарок",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GetAvatarHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/block": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет пользователя в чёрный список: он не сможет писать в диалог, начать новый диалог и добавить текущего пользователя в группы",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blocks"
+                ],
+                "summary": "Блокировка пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID блокируемого пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Пользователь заблокирован"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет пользователя из чёрного списка текущего пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blocks"
+                ],
+                "summary": "Разблокировка пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CSRF Token",
+                        "name": "X-CSRF-Token",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID заблокированного пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Блокировка снята"
+                    },
+                    "400": {
+                        "description": "Некорректный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизованный доступ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не заблокирован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AddChatMemberDTO": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "description": "Роль пользователя в чате - админ(писать и добавлять участников), участник(писать), зритель (только просмотр)",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
         },
         "dto.AddUsersToChatDTO": {
             "type": "object",
             "properties": {
-                "members": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AddChatMemberDTO"
+                    }
+                }
+            }
+        },
+        "dto.AttachmentDTO": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Длительность в секундах для voice/video_note",
+                    "type": "integer"
+                },
+                "file_url": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "type": {
+                    "description": "sticker, voice, video_note",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "csrf_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AvatarDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "is_current": {
+                    "type": "boolean"
+                },
+                "sizes": {
+                    "description": "сторона квадрата в пикселях -\u003e url",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BlockedUserDTO": {
+            "type": "object",
+            "properties": {
+                "blocked_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.User"
+                }
+            }
+        },
+        "dto.Bot": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChatCreateInformationDTO": {
+            "type": "object",
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AddChatMemberDTO"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Тип чата - канал, диалог или группа",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChatDetailedInformationDTO": {
+            "type": "object",
+            "properties": {
+                "can_chat": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "is_admin": {
+                    "type": "boolean"
+                },
+                "is_member": {
+                    "type": "boolean"
+                },
+                "is_private": {
+                    "type": "boolean"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserInfoChatDTO"
+                    }
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MessageDTO"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChatSettingsDTO": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "folder": {
+                    "type": "string"
+                },
+                "muted": {
+                    "type": "boolean"
+                },
+                "muted_until": {
+                    "description": "Отсутствует, если чат заглушён навсегда",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "pin_order": {
+                    "description": "Позиция среди закреплённых, 0 - чат не закреплён",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ChatSettingsUpdateDTO": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "folder": {
+                    "description": "Пустая строка - убрать чат из папки",
+                    "type": "string"
+                },
+                "muted": {
+                    "type": "boolean"
+                },
+                "muted_until": {
+                    "description": "Заглушить до указанного момента",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "pin_order": {
+                    "description": "0 - открепить чат",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ChatUpdateDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChatUsernameDTO": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChatViewInformationDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_message": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "settings": {
+                    "$ref": "#/definitions/dto.ChatSettingsDTO"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateBotRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateTokenRequest": {
+            "type": "object",
+            "properties": {
+                "expires_in_days": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreatedBot": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreatedToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.AddChatMemberDTO"
+                        "type": "string"
                     }
+                },
+                "token": {
+                    "type": "string"
                 }
             }
         },
-        "dto.AttachmentDTO": {
+        "dto.DeleteSession": {
             "type": "object",
             "properties": {
-                "duration": {
-                    "description": "Длительность в секундах для voice/video_note",
-                    "type": "integer"
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorDTO": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
                 },
-                "file_url": {
+                "request_id": {
+                    "description": "RequestID - идентификатор запроса для поиска по логам всех сервисов",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GetAvatarHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "avatars": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AvatarDTO"
+                    }
+                }
+            }
+        },
+        "dto.GetAvatarsRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.GetAvatarsResponse": {
+            "type": "object",
+            "properties": {
+                "avatars": {
+                    "description": "map[id]url",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.GetContactsDTO": {
+            "type": "object",
+            "properties": {
+                "alias": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
+                "contact": {
+                    "$ref": "#/definitions/dto.User"
                 },
-                "type": {
-                    "description": "sticker, voice, video_note",
+                "created_at": {
+                    "type": "string"
+                },
+                "update_at": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.AuthResponse": {
+        "dto.GetUserByPhone": {
             "type": "object",
             "properties": {
-                "csrf_token": {
+                "phone_number": {
                     "type": "string"
                 }
             }
         },
-        "dto.ChatCreateInformationDTO": {
+        "dto.GetUserByUsername": {
             "type": "object",
             "properties": {
-                "members": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.IdDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "dto.ImportContactsDTO": {
+            "type": "object",
+            "properties": {
+                "phone_hashes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.AddChatMemberDTO"
+                        "type": "string"
+                    }
+                },
+                "phone_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.ImportContactsResultDTO": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
+                },
+                "found": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportedContactDTO"
                     }
                 },
+                "invalid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ImportedContactDTO": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "boolean"
+                },
+                "phone_hash": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.User"
+                }
+            }
+        },
+        "dto.InviteCreateDTO": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "requires_approval": {
+                    "description": "Вступление только после одобрения админом",
+                    "type": "boolean"
+                },
+                "usage_limit": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.InviteDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "is_revoked": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "Тип чата - канал, диалог или группа",
+                "requires_approval": {
+                    "type": "boolean"
+                },
+                "usage_count": {
+                    "type": "integer"
+                },
+                "usage_limit": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.JoinByInviteDTO": {
+            "type": "object",
+            "properties": {
+                "chat_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "pending": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.JoinRequestDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "invite_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LinkDTO": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "phone_number"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "phone_number": {
                     "type": "string"
                 }
             }
         },
-        "dto.ChatDetailedInformationDTO": {
+        "dto.MessageDTO": {
             "type": "object",
             "properties": {
-                "can_chat": {
-                    "type": "boolean"
-                },
-                "description": {
-                    "type": "string"
+                "attachment": {
+                    "$ref": "#/definitions/dto.AttachmentDTO"
                 },
-                "id": {
+                "chat_id": {
                     "type": "string",
                     "format": "uuid"
                 },
-                "is_admin": {
-                    "type": "boolean"
-                },
-                "is_member": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "is_private": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "members": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.UserInfoChatDTO"
-                    }
+                "sender_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "messages": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.MessageDTO"
-                    }
+                "sender_name": {
+                    "type": "string"
                 },
-                "name": {
+                "text": {
                     "type": "string"
                 },
                 "type": {
+                    "description": "Тип сообщения - системное или пользовательское",
                     "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "dto.ChatUpdateDTO": {
+        "dto.PhoneChangeConfirmRequest": {
             "type": "object",
+            "required": [
+                "code"
+            ],
             "properties": {
-                "description": {
+                "code": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "revoke_sessions": {
+                    "description": "RevokeSessions - завершить все сессии, кроме текущей",
+                    "type": "boolean"
                 }
             }
         },
-        "dto.ChatViewInformationDTO": {
+        "dto.PhoneChangeRequest": {
             "type": "object",
+            "required": [
+                "new_phone"
+            ],
             "properties": {
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
-                },
-                "last_message": {
-                    "type": "object"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "type": {
+                "new_phone": {
                     "type": "string"
                 }
             }
         },
-        "dto.DeleteSession": {
+        "dto.PhoneChangeRequested": {
             "type": "object",
             "properties": {
-                "id": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "resend_after": {
                     "type": "string"
                 }
             }
         },
-        "dto.ErrorDTO": {
+        "dto.PostContactDTO": {
             "type": "object",
             "properties": {
-                "message": {
+                "contact_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.GetAvatarsRequest": {
+        "dto.PrivacyRuleDTO": {
             "type": "object",
-            "required": [
-                "ids"
-            ],
             "properties": {
-                "ids": {
+                "allow_user_ids": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
-                }
-            }
-        },
-        "dto.GetAvatarsResponse": {
-            "type": "object",
-            "properties": {
-                "avatars": {
-                    "description": "map[id]url",
-                    "type": "object",
-                    "additionalProperties": {
+                },
+                "deny_user_ids": {
+                    "type": "array",
+                    "items": {
                         "type": "string"
                     }
+                },
+                "setting": {
+                    "type": "string",
+                    "example": "phone_number"
+                },
+                "visibility": {
+                    "type": "string",
+                    "example": "contacts"
                 }
             }
         },
-        "dto.GetContactsDTO": {
+        "dto.PushToken": {
             "type": "object",
             "properties": {
-                "contact": {
-                    "$ref": "#/definitions/dto.User"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "update_at": {
+                "platform": {
+                    "description": "Platform - fcm, apns или webpush",
                     "type": "string"
                 },
-                "user_id": {
+                "token": {
+                    "description": "Token - токен устройства, для webpush JSON подписки браузера",
                     "type": "string"
                 }
             }
         },
-        "dto.GetUserByPhone": {
+        "dto.RegisterRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "password",
+                "phone_number"
+            ],
             "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
                 "phone_number": {
                     "type": "string"
                 }
             }
         },
-        "dto.GetUserByUsername": {
+        "dto.ResolveResult": {
             "type": "object",
             "properties": {
-                "username": {
-                    "type": "string"
+                "chat": {
+                    "type": "object"
+                },
+                "redirected": {
+                    "description": "Redirected - handle прежний: владелец сменил его, но ссылка ещё действует",
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "group",
+                        "channel"
+                    ]
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.User"
                 }
             }
         },
-        "dto.IdDTO": {
+        "dto.SearchUsersResult": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
+                "total": {
+                    "description": "число совпадений до исключения заблокированных",
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.User"
+                    }
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.SendMessageRequest": {
             "type": "object",
-            "required": [
-                "password",
-                "phone_number"
-            ],
             "properties": {
-                "password": {
+                "chat_id": {
                     "type": "string"
                 },
-                "phone_number": {
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "dto.MessageDTO": {
+        "dto.Session": {
             "type": "object",
             "properties": {
-                "attachment": {
-                    "$ref": "#/definitions/dto.AttachmentDTO"
+                "app_version": {
+                    "type": "string"
                 },
-                "chat_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "browser": {
+                    "type": "string"
                 },
                 "created_at": {
-                    "type": "string",
-                    "format": "date-time"
+                    "type": "string"
                 },
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
+                "device": {
+                    "type": "string"
                 },
-                "sender_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "id": {
+                    "type": "string"
                 },
-                "sender_name": {
+                "ip": {
                     "type": "string"
                 },
-                "text": {
+                "last_seen": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "Тип сообщения - системное или пользовательское",
+                "location": {
                     "type": "string"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "format": "date-time"
+                "os": {
+                    "type": "string"
                 }
             }
         },
-        "dto.PostContactDTO": {
+        "dto.SetWebhookRequest": {
             "type": "object",
             "properties": {
-                "contact_id": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "dto.RegisterRequest": {
+        "dto.StatusDTO": {
             "type": "object",
-            "required": [
-                "name",
-                "password",
-                "phone_number"
-            ],
             "properties": {
-                "name": {
+                "emoji": {
                     "type": "string"
                 },
-                "password": {
+                "expires_at": {
+                    "description": "ExpiresAt - момент, после которого статус не показывается; отсутствует у бессрочного статуса",
                     "type": "string",
-                    "minLength": 6
+                    "format": "date-time"
                 },
-                "phone_number": {
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "dto.Session": {
+        "dto.Token": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "device": {
+                "expires_at": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "last_seen": {
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.UpdateContactAliasDTO": {
+            "type": "object",
+            "properties": {
+                "alias": {
                     "type": "string"
                 }
             }
         },
+        "dto.UpdatePrivacyRuleDTO": {
+            "type": "object",
+            "properties": {
+                "allow_user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "deny_user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "visibility": {
+                    "type": "string",
+                    "example": "contacts"
+                }
+            }
+        },
         "dto.UpdateUserInfo": {
             "type": "object",
             "properties": {
                 "bio": {
                     "type": "string"
                 },
+                "birthday": {
+                    "description": "Birthday в формате YYYY-MM-DD; пустая строка удаляет день рождения",
+                    "type": "string",
+                    "example": "1995-03-14"
+                },
+                "links": {
+                    "description": "Links заменяет ссылки целиком; пустой список удаляет все",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LinkDTO"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "status": {
+                    "description": "Status с пустыми emoji и text сбрасывает статус",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.StatusDTO"
+                        }
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
@@ -1981,6 +5143,11 @@ const docTemplate = `{
                 "bio": {
                     "type": "string"
                 },
+                "birthday": {
+                    "description": "Birthday в формате YYYY-MM-DD; отсутствует, если не задан или скрыт настройками приватности",
+                    "type": "string",
+                    "example": "1995-03-14"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -1988,12 +5155,31 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
+                "last_seen_at": {
+                    "description": "LastSeenAt отсутствует, если пользователь не заходил или скрыл время настройками приватности",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LinkDTO"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
                 "phone_number": {
                     "type": "string"
                 },
+                "status": {
+                    "description": "Status отсутствует, если статус не задан или истёк",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.StatusDTO"
+                        }
+                    ]
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -2042,6 +5228,14 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dto.WebhookSecret": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -10,6 +10,9 @@ import (
 	_ "github.com/go-park-mail-ru/2025_2_Undefined/docs"
 	_ "github.com/lib/pq"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/health"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/middleware"
@@ -20,11 +23,13 @@ import (
 
 	userHttpProxy "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/http"
 
+	healthHttp "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/health/http"
+
 	autht "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/auth/http"
 	authGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
 	userGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	chatsHTTTPProxy "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/http"
 	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
@@ -34,6 +39,10 @@ type App struct {
 	conf   *config.Config
 	db     *sql.DB
 	router *mux.Router
+
+	grpcConns     []*grpc.ClientConn
+	healthHandler *healthHttp.HealthHandler
+	chatsHandler  *chatsHTTTPProxy.ChatsGRPCProxyHandler
 }
 
 func NewApp(conf *config.Config) (*App, error) {
@@ -44,34 +53,32 @@ func NewApp(conf *config.Config) (*App, error) {
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
+	// Подписываем личность пользователя, проверенную AuthGRPCMiddleware, для внутренних сервисов
+	signer := identity.NewSigner(conf.IdentityConfig)
+	identityOpts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+		grpc.WithChainStreamInterceptor(identity.StreamClientInterceptor(signer)),
+	}
+
 	// Подключение к gRPC серверу авторизации
-	authGrpcConn, err := grpc.NewClient(
-		conf.GRPCConfig.AuthServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	authGrpcConn, err := grpcclient.New(conf.GRPCConfig.AuthServiceAddr, grpcclient.AuthDownstream, conf.GRPCConfig, identityOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to auth gRPC service: %v", err)
 	}
 
 	// Подключение к gRPC серверу user+contacts
-	userGrpcConn, err := grpc.NewClient(
-		conf.GRPCConfig.UserServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	userGrpcConn, err := grpcclient.New(conf.GRPCConfig.UserServiceAddr, grpcclient.UserDownstream, conf.GRPCConfig, identityOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to user gRPC service: %v", err)
 	}
 
-	chatsGrpcConn, err := grpc.NewClient(
-		conf.GRPCConfig.ChatsServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	chatsGrpcConn, err := grpcclient.New(conf.GRPCConfig.ChatsServiceAddr, grpcclient.ChatsDownstream, conf.GRPCConfig, identityOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to chats gRPC service: %v", err)
 	}
 
 	authClient := authGen.NewAuthServiceClient(authGrpcConn)
-	authHandler := autht.NewAuthGRPCProxyHandler(authClient, conf.SessionConfig)
+	authHandler := autht.NewAuthGRPCProxyHandler(authClient, conf.SessionConfig, conf.ServerConfig.TrustedProxies)
 
 	userClient := userGen.NewUserServiceClient(userGrpcConn)
 	userHandler := userHttpProxy.NewUserGRPCProxyHandler(userClient)
@@ -80,6 +87,14 @@ func NewApp(conf *config.Config) (*App, error) {
 	messageClient := chatsGen.NewMessageServiceClient(chatsGrpcConn)
 	chatsHandler := chatsHTTTPProxy.NewChatsGRPCProxyHandler(chatsClient, messageClient)
 
+	resolveHandler := userHttpProxy.NewResolveGRPCProxyHandler(userClient, chatsClient)
+
+	healthHandler := healthHttp.NewHealthHandler(map[string]healthpb.HealthClient{
+		"auth":  healthpb.NewHealthClient(authGrpcConn),
+		"user":  healthpb.NewHealthClient(userGrpcConn),
+		"chats": healthpb.NewHealthClient(chatsGrpcConn),
+	})
+
 	// Настройка логгера
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{})
@@ -88,12 +103,15 @@ func NewApp(conf *config.Config) (*App, error) {
 	// Настройка маршрутищатора
 	router := mux.NewRouter()
 	router.Use(middleware.CorsMiddleware)
+	router.Use(middleware.TracingMiddleware)
 	router.Use(func(next http.Handler) http.Handler {
 		return middleware.AccessLogMiddleware(logger, next)
 	})
 	router.Use(middleware.PrometheusMiddleware)
 
 	router.Handle("/metrics", promhttp.Handler())
+	router.HandleFunc("/healthz", healthHandler.Liveness).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", healthHandler.Readiness).Methods(http.MethodGet)
 
 	apiRouter := router.PathPrefix("/api/v1").Subrouter()
 
@@ -113,23 +131,51 @@ func NewApp(conf *config.Config) (*App, error) {
 		chatRouter.HandleFunc("/dialog/{user_id}", chatsHandler.GetUsersDialog).Methods(http.MethodGet)
 		chatRouter.HandleFunc("/avatars/query", chatsHandler.GetChatAvatars).Methods(http.MethodPost)
 		chatRouter.HandleFunc("/{chat_id}/avatar", chatsHandler.UploadChatAvatar).Methods(http.MethodPost)
+		chatRouter.HandleFunc("/{chat_id}/avatars", chatsHandler.GetChatAvatarHistory).Methods(http.MethodGet)
+		chatRouter.HandleFunc("/{chat_id}/avatars/{avatar_id}", chatsHandler.DeleteChatAvatar).Methods(http.MethodDelete)
+		chatRouter.HandleFunc("/{chat_id}/avatars/{avatar_id}/current", chatsHandler.SetCurrentChatAvatar).Methods(http.MethodPut)
 		chatRouter.HandleFunc("/search", chatsHandler.SearchChats).Methods(http.MethodGet)
 		chatRouter.HandleFunc("/{chat_id}", chatsHandler.GetInformationAboutChat).Methods(http.MethodGet)
 		chatRouter.HandleFunc("", chatsHandler.GetChats).Methods(http.MethodGet)
 		chatRouter.HandleFunc("", chatsHandler.PostChats).Methods(http.MethodPost)
 		chatRouter.HandleFunc("/{chat_id}/members", chatsHandler.AddUsersToChat).Methods(http.MethodPatch)
+		chatRouter.HandleFunc("/{chat_id}/settings", chatsHandler.UpdateChatSettings).Methods(http.MethodPatch)
+		chatRouter.HandleFunc("/{chat_id}/username", chatsHandler.SetChatUsername).Methods(http.MethodPut)
+		chatRouter.HandleFunc("/{chat_id}/invites", chatsHandler.GetChatInvites).Methods(http.MethodGet)
+		chatRouter.HandleFunc("/{chat_id}/invites", chatsHandler.CreateInvite).Methods(http.MethodPost)
+		chatRouter.HandleFunc("/{chat_id}/invites/{invite_id}", chatsHandler.RevokeInvite).Methods(http.MethodDelete)
+		chatRouter.HandleFunc("/{chat_id}/join-requests", chatsHandler.GetJoinRequests).Methods(http.MethodGet)
+		chatRouter.HandleFunc("/{chat_id}/join-requests/{user_id}/approve", chatsHandler.ApproveJoinRequest).Methods(http.MethodPost)
+		chatRouter.HandleFunc("/{chat_id}/join-requests/{user_id}/decline", chatsHandler.DeclineJoinRequest).Methods(http.MethodPost)
 		chatRouter.HandleFunc("/{chat_id}", chatsHandler.DeleteChat).Methods(http.MethodDelete)
 		chatRouter.HandleFunc("/{chat_id}", chatsHandler.UpdateChat).Methods(http.MethodPatch)
 	}
 
+	inviteRouter := protectedRouter.PathPrefix("/invites").Subrouter()
+	{
+		inviteRouter.HandleFunc("/{code}/join", chatsHandler.JoinChatByInvite).Methods(http.MethodPost)
+	}
+
 	userRouter := protectedRouter.PathPrefix("").Subrouter()
 	{
 		userRouter.HandleFunc("/me", userHandler.GetCurrentUser).Methods(http.MethodGet)
 		userRouter.HandleFunc("/me", userHandler.UpdateUserInfo).Methods(http.MethodPatch)
+		userRouter.HandleFunc("/me/privacy", userHandler.GetPrivacySettings).Methods(http.MethodGet)
+		userRouter.HandleFunc("/me/privacy/{setting}", userHandler.UpdatePrivacySetting).Methods(http.MethodPut)
+		userRouter.HandleFunc("/me/phone", authHandler.RequestPhoneChange).Methods(http.MethodPost)
+		userRouter.HandleFunc("/me/phone/confirm", authHandler.ConfirmPhoneChange).Methods(http.MethodPost)
 		userRouter.HandleFunc("/user/by-phone", userHandler.GetUserByPhone).Methods(http.MethodPost)
 		userRouter.HandleFunc("/user/by-username", userHandler.GetUserByUsername).Methods(http.MethodPost)
+		userRouter.HandleFunc("/users/search", userHandler.SearchUsers).Methods(http.MethodGet)
+		userRouter.HandleFunc("/resolve/{handle}", resolveHandler.Resolve).Methods(http.MethodGet)
 		userRouter.HandleFunc("/users/avatar", userHandler.UploadUserAvatar).Methods(http.MethodPost)
 		userRouter.HandleFunc("/users/avatars/query", userHandler.GetUserAvatars).Methods(http.MethodPost)
+		userRouter.HandleFunc("/users/avatars/{avatar_id}", userHandler.DeleteUserAvatar).Methods(http.MethodDelete)
+		userRouter.HandleFunc("/users/avatars/{avatar_id}/current", userHandler.SetCurrentUserAvatar).Methods(http.MethodPut)
+		userRouter.HandleFunc("/users/{id}/avatars", userHandler.GetUserAvatarHistory).Methods(http.MethodGet)
+		userRouter.HandleFunc("/users/blocked", userHandler.GetBlockedUsers).Methods(http.MethodGet)
+		userRouter.HandleFunc("/users/{id}/block", userHandler.BlockUser).Methods(http.MethodPost)
+		userRouter.HandleFunc("/users/{id}/block", userHandler.UnblockUser).Methods(http.MethodDelete)
 	}
 
 	sessionRouter := protectedRouter.PathPrefix("").Subrouter()
@@ -137,6 +183,25 @@ func NewApp(conf *config.Config) (*App, error) {
 		sessionRouter.HandleFunc("/sessions", authHandler.GetSessionsByUser).Methods(http.MethodGet)
 		sessionRouter.HandleFunc("/session", authHandler.DeleteSession).Methods(http.MethodDelete)
 		sessionRouter.HandleFunc("/sessions", authHandler.DeleteAllSessionsExceptCurrent).Methods(http.MethodDelete)
+		sessionRouter.HandleFunc("/session/push-token", authHandler.RegisterPushToken).Methods(http.MethodPut)
+		sessionRouter.HandleFunc("/session/push-token", authHandler.UnregisterPushToken).Methods(http.MethodDelete)
+	}
+
+	tokenRouter := protectedRouter.PathPrefix("/tokens").Subrouter()
+	{
+		tokenRouter.HandleFunc("", authHandler.GetTokens).Methods(http.MethodGet)
+		tokenRouter.HandleFunc("", authHandler.CreateToken).Methods(http.MethodPost)
+		tokenRouter.HandleFunc("/{token_id}", authHandler.RevokeToken).Methods(http.MethodDelete)
+	}
+
+	botRouter := protectedRouter.PathPrefix("/bots").Subrouter()
+	{
+		botRouter.HandleFunc("", authHandler.GetBots).Methods(http.MethodGet)
+		botRouter.HandleFunc("", authHandler.CreateBot).Methods(http.MethodPost)
+		botRouter.HandleFunc("/{bot_id}", authHandler.DeleteBot).Methods(http.MethodDelete)
+		botRouter.HandleFunc("/{bot_id}/webhook", authHandler.SetBotWebhook).Methods(http.MethodPut)
+		botRouter.HandleFunc("/{bot_id}/tokens", authHandler.CreateBotToken).Methods(http.MethodPost)
+		botRouter.HandleFunc("/{bot_id}/tokens/{token_id}", authHandler.RevokeBotToken).Methods(http.MethodDelete)
 	}
 
 	messageRouter := protectedRouter.PathPrefix("").Subrouter()
@@ -144,7 +209,10 @@ func NewApp(conf *config.Config) (*App, error) {
 		messageRouter.HandleFunc("/message/ws", chatsHandler.HandleMessages)
 		messageRouter.HandleFunc("/chats/{chat_id}/messages", chatsHandler.GetChatMessages).Methods(http.MethodGet)
 		messageRouter.HandleFunc("/chats/{chat_id}/messages/search", chatsHandler.SearchMessages).Methods(http.MethodGet)
+		messageRouter.HandleFunc("/chats/{chat_id}/mentions", chatsHandler.GetUnreadMentions).Methods(http.MethodGet)
+		messageRouter.HandleFunc("/chats/{chat_id}/mentions/read", chatsHandler.ReadMentions).Methods(http.MethodPost)
 		messageRouter.HandleFunc("/message/attachment", chatsHandler.UploadAttachment).Methods(http.MethodPost)
+		messageRouter.HandleFunc("/bot/messages", chatsHandler.SendBotMessage).Methods(http.MethodPost)
 	}
 
 	contactRouter := protectedRouter.PathPrefix("/contacts").Subrouter()
@@ -152,15 +220,21 @@ func NewApp(conf *config.Config) (*App, error) {
 		contactRouter.HandleFunc("", userHandler.CreateContact).Methods(http.MethodPost)
 		contactRouter.HandleFunc("", userHandler.GetContacts).Methods(http.MethodGet)
 		contactRouter.HandleFunc("/search", userHandler.SearchContacts).Methods(http.MethodGet)
+		contactRouter.HandleFunc("/import", userHandler.ImportContacts).Methods(http.MethodPost)
+		contactRouter.HandleFunc("/{id}", userHandler.DeleteContact).Methods(http.MethodDelete)
+		contactRouter.HandleFunc("/{id}", userHandler.UpdateContactAlias).Methods(http.MethodPatch)
 	}
 
 	// Swagger
 	router.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
 
 	return &App{
-		conf:   conf,
-		db:     db,
-		router: router,
+		conf:          conf,
+		db:            db,
+		router:        router,
+		grpcConns:     []*grpc.ClientConn{authGrpcConn, userGrpcConn, chatsGrpcConn},
+		healthHandler: healthHandler,
+		chatsHandler:  chatsHandler,
 	}, nil
 }
 
@@ -189,7 +263,51 @@ func (a *App) Run() {
 	logger.Infof("Swagger UI available at: http://localhost:%s/swagger/", a.conf.ServerConfig.Port)
 	logger.Logger.SetLevel(domains.LoggingLevel)
 
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		logger.WithError(err).Fatal("Server failed to start")
+	signalCtx, stop := health.SignalContext(ctx)
+	defer stop()
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.WithError(err).Fatal("Server failed to start")
+		}
+	}()
+
+	<-signalCtx.Done()
+	logger.Info("Shutdown signal received, draining connections")
+	a.shutdown(server)
+}
+
+// shutdown снимает шлюз с балансировки, закрывает WebSocket-соединения и дожидается активных запросов
+func (a *App) shutdown(server *http.Server) {
+	const op = "App.shutdown"
+	logger := domains.GetLogger(context.Background()).WithField("operation", op)
+
+	a.healthHandler.SetShuttingDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.conf.ShutdownConfig.Timeout)
+	defer cancel()
+
+	// http.Server.Shutdown не ждёт перехваченные WebSocket-соединения, поэтому закрываем их параллельно
+	wsDone := make(chan error, 1)
+	go func() {
+		wsDone <- a.chatsHandler.Shutdown(ctx)
+	}()
+
+	if err := server.Shutdown(ctx); err != nil {
+		logger.WithError(err).Warn("HTTP server did not finish in time")
+	}
+	if err := <-wsDone; err != nil {
+		logger.WithError(err).Warn("WebSocket connections did not close in time")
+	}
+
+	for _, conn := range a.grpcConns {
+		if err := conn.Close(); err != nil {
+			logger.WithError(err).Warn("failed to close gRPC connection")
+		}
 	}
+	if err := a.db.Close(); err != nil {
+		logger.WithError(err).Warn("failed to close database")
+	}
+
+	logger.Info("Server stopped")
 }
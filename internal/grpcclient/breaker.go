@@ -0,0 +1,133 @@
+package grpcclient
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateHalfOpen
+	stateOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case stateHalfOpen:
+		return "half_open"
+	case stateOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// breaker - circuit breaker на одно downstream-соединение.
+// После failureThreshold подряд неудачных вызовов размыкается и сразу отклоняет вызовы,
+// через openTimeout пропускает один пробный вызов и по его результату замыкается или снова размыкается
+type breaker struct {
+	failureThreshold int
+	openTimeout      time.Duration
+	now              func() time.Time
+	onStateChange    func(breakerState)
+
+	mu               sync.Mutex
+	state            breakerState
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight bool
+}
+
+func newBreaker(failureThreshold int, openTimeout time.Duration, onStateChange func(breakerState)) *breaker {
+	if onStateChange == nil {
+		onStateChange = func(breakerState) {}
+	}
+
+	return &breaker{
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		now:              time.Now,
+		onStateChange:    onStateChange,
+	}
+}
+
+// allow сообщает, можно ли выполнить вызов. Каждый разрешённый вызов должен завершиться вызовом done
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(stateHalfOpen)
+		b.halfOpenInFlight = true
+		return true
+	case stateHalfOpen:
+		// Пока пробный вызов не завершился, остальные отклоняем
+		if b.halfOpenInFlight {
+			return false
+		}
+		b.halfOpenInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+// done учитывает результат вызова
+func (b *breaker) done(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == stateHalfOpen {
+		b.halfOpenInFlight = false
+		if failed {
+			b.open()
+		} else {
+			b.failures = 0
+			b.setState(stateClosed)
+		}
+		return
+	}
+
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == stateClosed && b.failures >= b.failureThreshold {
+		b.open()
+	}
+}
+
+func (b *breaker) open() {
+	b.openedAt = b.now()
+	b.failures = 0
+	b.setState(stateOpen)
+}
+
+func (b *breaker) setState(state breakerState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	b.onStateChange(state)
+}
+
+// isBreakerFailure отделяет сбои downstream-сервиса от бизнес-ошибок (NotFound, InvalidArgument и т.п.),
+// которые не должны размыкать breaker
+func isBreakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
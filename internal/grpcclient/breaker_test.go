@@ -0,0 +1,98 @@
+package grpcclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestBreaker(threshold int, openTimeout time.Duration) (*breaker, *fakeClock, *[]breakerState) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var transitions []breakerState
+	b := newBreaker(threshold, openTimeout, func(state breakerState) {
+		transitions = append(transitions, state)
+	})
+	b.now = clock.Now
+	return b, clock, &transitions
+}
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b, _, transitions := newTestBreaker(3, time.Second)
+
+	for i := 0; i < 2; i++ {
+		assert.True(t, b.allow())
+		b.done(true)
+	}
+	// Успешный вызов сбрасывает счётчик
+	assert.True(t, b.allow())
+	b.done(false)
+
+	for i := 0; i < 3; i++ {
+		assert.True(t, b.allow())
+		b.done(true)
+	}
+
+	assert.False(t, b.allow())
+	assert.Equal(t, []breakerState{stateOpen}, *transitions)
+}
+
+func TestBreaker_HalfOpenProbe(t *testing.T) {
+	tests := []struct {
+		name           string
+		probeFailed    bool
+		expectedState  breakerState
+		allowAfterward bool
+	}{
+		{
+			name:           "Successful probe closes breaker",
+			probeFailed:    false,
+			expectedState:  stateClosed,
+			allowAfterward: true,
+		},
+		{
+			name:           "Failed probe opens breaker again",
+			probeFailed:    true,
+			expectedState:  stateOpen,
+			allowAfterward: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, clock, _ := newTestBreaker(1, time.Second)
+
+			assert.True(t, b.allow())
+			b.done(true)
+			assert.False(t, b.allow())
+
+			clock.now = clock.now.Add(time.Second)
+
+			assert.True(t, b.allow(), "probe call is allowed after open timeout")
+			assert.False(t, b.allow(), "only one probe call at a time")
+
+			b.done(tt.probeFailed)
+
+			assert.Equal(t, tt.expectedState, b.state)
+			assert.Equal(t, tt.allowAfterward, b.allow())
+		})
+	}
+}
+
+func TestIsBreakerFailure(t *testing.T) {
+	assert.True(t, isBreakerFailure(codes.Unavailable))
+	assert.True(t, isBreakerFailure(codes.DeadlineExceeded))
+	assert.False(t, isBreakerFailure(codes.OK))
+	assert.False(t, isBreakerFailure(codes.NotFound))
+	assert.False(t, isBreakerFailure(codes.InvalidArgument))
+	assert.False(t, isBreakerFailure(codes.PermissionDenied))
+}
@@ -0,0 +1,172 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/mtls"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
+	authGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	userGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/middleware"
+	"google.golang.org/grpc"
+)
+
+// uploadTimeout - дедлайн для загрузки файлов, которым не хватает обычного CallTimeout
+const uploadTimeout = 30 * time.Second
+
+// Downstream описывает сервис, к которому подключается клиент
+type Downstream struct {
+	// Name - короткое имя сервиса для метрик и логов
+	Name string
+	// IdempotentMethods - методы без побочных эффектов по сервисам, которые можно безопасно повторять
+	IdempotentMethods map[string][]string
+	// MethodTimeouts - дедлайны для отдельных методов (полное имя "/package.Service/Method") вместо CallTimeout
+	MethodTimeouts map[string]time.Duration
+}
+
+var (
+	AuthDownstream = Downstream{
+		Name: "auth",
+		IdempotentMethods: map[string][]string{
+			authGen.AuthService_ServiceDesc.ServiceName: {
+				"ValidateSession", "GetSessionsByUserID", "ListTokens", "ValidateToken", "ListBots",
+				"RegisterPushToken", "UnregisterPushToken", "GetPushTargets", "DropPushTokens",
+			},
+		},
+	}
+
+	UserDownstream = Downstream{
+		Name: "user",
+		IdempotentMethods: map[string][]string{
+			userGen.UserService_ServiceDesc.ServiceName: {
+				"GetUserById", "GetUserByPhone", "GetUserByUsername", "ResolveUsername", "GetUsersByIDs", "SearchUsers", "GetContacts", "SearchContacts", "GetUserAvatars", "GetUserAvatarHistory", "SetCurrentUserAvatar", "GetBlockedUsers", "GetBlockedPeers", "GetPrivacySettings", "GetGroupAddDenied", "GetContactAliases", "UpdateLastSeen",
+			},
+		},
+		MethodTimeouts: map[string]time.Duration{
+			userGen.UserService_UploadUserAvatar_FullMethodName: uploadTimeout,
+		},
+	}
+
+	ChatsDownstream = Downstream{
+		Name: "chats",
+		IdempotentMethods: map[string][]string{
+			chatsGen.ChatService_ServiceDesc.ServiceName: {
+				"GetChats", "GetChat", "GetChatMessages", "GetChatAvatars", "GetChatAvatarHistory", "SetCurrentChatAvatar", "SearchChats", "GetGroupPeers", "ResolveChatUsername",
+				"GetChatInvites", "GetJoinRequests",
+			},
+			chatsGen.MessageService_ServiceDesc.ServiceName: {
+				"SearchMessages", "GetUnreadMentions",
+			},
+			// повторный сброс кэша безопасен
+			chatsGen.UserEventsService_ServiceDesc.ServiceName: {
+				"UserProfileChanged",
+			},
+		},
+		MethodTimeouts: map[string]time.Duration{
+			chatsGen.ChatService_UploadChatAvatar_FullMethodName:    uploadTimeout,
+			chatsGen.MessageService_UploadAttachment_FullMethodName: uploadTimeout,
+		},
+	}
+)
+
+// New создаёт соединение с downstream-сервисом с общими для всех клиентов настройками:
+// mTLS (если включён), трассировка, передача request id, метрики, circuit breaker, дедлайны и повтор идемпотентных вызовов.
+// opts добавляются после общих настроек
+func New(addr string, downstream Downstream, conf *config.GRPCConfig, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	serviceConfig, err := retryServiceConfig(downstream.IdempotentMethods)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build service config for %s: %w", downstream.Name, err)
+	}
+
+	creds, err := mtls.ClientCredentials(conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transport credentials for %s: %w", downstream.Name, err)
+	}
+
+	b := newBreaker(conf.BreakerFailureThreshold, conf.BreakerOpenTimeout, func(state breakerState) {
+		clientBreakerState.WithLabelValues(downstream.Name).Set(float64(state))
+	})
+	clientBreakerState.WithLabelValues(downstream.Name).Set(float64(stateClosed))
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		tracing.GRPCDialOption(),
+		grpc.WithChainUnaryInterceptor(
+			middleware.RequestIDUnaryClientInterceptor(),
+			metricsUnaryInterceptor(downstream.Name),
+			breakerUnaryInterceptor(downstream.Name, b),
+			deadlineUnaryInterceptor(conf.CallTimeout, downstream.MethodTimeouts),
+		),
+		grpc.WithChainStreamInterceptor(
+			middleware.RequestIDStreamClientInterceptor(),
+			breakerStreamInterceptor(downstream.Name, b),
+		),
+	}
+
+	return grpc.NewClient(addr, append(dialOpts, opts...)...)
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+// retryServiceConfig собирает service config, в котором повторяются только идемпотентные методы
+// и только при UNAVAILABLE, т.е. когда запрос гарантированно не дошёл до обработчика или сервис перезапускается
+func retryServiceConfig(idempotentMethods map[string][]string) (string, error) {
+	services := make([]string, 0, len(idempotentMethods))
+	for service := range idempotentMethods {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var names []methodName
+	for _, service := range services {
+		for _, method := range idempotentMethods[service] {
+			names = append(names, methodName{Service: service, Method: method})
+		}
+	}
+
+	cfg := serviceConfig{MethodConfig: []methodConfig{}}
+	if len(names) > 0 {
+		cfg.MethodConfig = append(cfg.MethodConfig, methodConfig{
+			Name: names,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          3,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		})
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
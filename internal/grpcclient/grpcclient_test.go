@@ -0,0 +1,188 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// flakyUserServer отвечает UNAVAILABLE на первые failures вызовов каждого метода
+type flakyUserServer struct {
+	gen.UnimplementedUserServiceServer
+	failures    int32
+	getCalls    atomic.Int32
+	updateCalls atomic.Int32
+	delay       time.Duration
+}
+
+func (s *flakyUserServer) GetUserById(ctx context.Context, in *gen.GetUserByIdReq) (*gen.GetUserByIdRes, error) {
+	if s.delay > 0 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if s.getCalls.Add(1) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "temporary failure")
+	}
+	return &gen.GetUserByIdRes{}, nil
+}
+
+func (s *flakyUserServer) UpdateUserInfo(ctx context.Context, in *gen.UpdateUserInfoReq) (*emptypb.Empty, error) {
+	if s.updateCalls.Add(1) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "temporary failure")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func testGRPCConfig() *config.GRPCConfig {
+	return &config.GRPCConfig{
+		CallTimeout:             time.Second,
+		BreakerFailureThreshold: 2,
+		BreakerOpenTimeout:      time.Minute,
+	}
+}
+
+func startUserServer(t *testing.T, srv gen.UserServiceServer, conf *config.GRPCConfig) gen.UserServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	gen.RegisterUserServiceServer(server, srv)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	// Сетевое подключение подменяется in-memory, остальные настройки фабрики сохраняются
+	conn, err := New("passthrough:///bufnet", UserDownstream, conf,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return gen.NewUserServiceClient(conn)
+}
+
+func TestNew_ValidServiceConfig(t *testing.T) {
+	for _, downstream := range []Downstream{AuthDownstream, UserDownstream, ChatsDownstream} {
+		conn, err := New("passthrough:///localhost:0", downstream, testGRPCConfig())
+		require.NoError(t, err, downstream.Name)
+		require.NoError(t, conn.Close())
+	}
+}
+
+func TestClient_RetriesIdempotentCalls(t *testing.T) {
+	srv := &flakyUserServer{failures: 1}
+	client := startUserServer(t, srv, testGRPCConfig())
+
+	_, err := client.GetUserById(context.Background(), &gen.GetUserByIdReq{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), srv.getCalls.Load())
+}
+
+func TestClient_DoesNotRetryMutations(t *testing.T) {
+	srv := &flakyUserServer{failures: 1}
+	client := startUserServer(t, srv, testGRPCConfig())
+
+	_, err := client.UpdateUserInfo(context.Background(), &gen.UpdateUserInfoReq{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), srv.updateCalls.Load())
+}
+
+func TestClient_DefaultDeadline(t *testing.T) {
+	conf := testGRPCConfig()
+	conf.CallTimeout = 50 * time.Millisecond
+	srv := &flakyUserServer{delay: time.Second}
+	client := startUserServer(t, srv, conf)
+
+	start := time.Now()
+	_, err := client.GetUserById(context.Background(), &gen.GetUserByIdReq{})
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestClient_CircuitBreakerFailsFast(t *testing.T) {
+	srv := &flakyUserServer{failures: 100}
+	client := startUserServer(t, srv, testGRPCConfig())
+
+	// Два вызова с повторами размыкают breaker (порог - 2 неудачи)
+	for i := 0; i < 2; i++ {
+		_, err := client.UpdateUserInfo(context.Background(), &gen.UpdateUserInfoReq{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	callsBefore := srv.updateCalls.Load()
+
+	_, err := client.UpdateUserInfo(context.Background(), &gen.UpdateUserInfoReq{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "circuit breaker is open")
+	assert.Equal(t, callsBefore, srv.updateCalls.Load(), "request must not reach the server")
+}
+
+func TestDeadlineUnaryInterceptor(t *testing.T) {
+	const method = "/user.UserService/UploadUserAvatar"
+	interceptor := deadlineUnaryInterceptor(time.Second, map[string]time.Duration{method: time.Minute})
+
+	tests := []struct {
+		name     string
+		ctx      func() (context.Context, context.CancelFunc)
+		method   string
+		expected time.Duration
+	}{
+		{
+			name:     "Default timeout",
+			ctx:      func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			method:   "/user.UserService/GetUserById",
+			expected: time.Second,
+		},
+		{
+			name:     "Method timeout override",
+			ctx:      func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			method:   method,
+			expected: time.Minute,
+		},
+		{
+			name: "Caller deadline is kept",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Minute)
+			},
+			method:   method,
+			expected: 10 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			var remaining time.Duration
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				deadline, ok := ctx.Deadline()
+				require.True(t, ok)
+				remaining = time.Until(deadline)
+				return nil
+			}
+
+			require.NoError(t, interceptor(ctx, tt.method, nil, nil, nil, invoker))
+			assert.InDelta(t, tt.expected.Seconds(), remaining.Seconds(), 1)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errCircuitOpen возвращается без обращения к сети, пока breaker разомкнут
+func errCircuitOpen(downstream string) error {
+	return status.Errorf(codes.Unavailable, "%s service is unavailable: circuit breaker is open", downstream)
+}
+
+// metricsUnaryInterceptor считает вызовы и их длительность, включая отклонённые breaker'ом
+func metricsUnaryInterceptor(downstream string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		code := status.Code(err).String()
+		clientRequestsTotal.WithLabelValues(downstream, method, code).Inc()
+		clientRequestDuration.WithLabelValues(downstream, method, code).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+func breakerUnaryInterceptor(downstream string, b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			clientBreakerRejectedTotal.WithLabelValues(downstream).Inc()
+			domains.GetLogger(ctx).WithField("downstream", downstream).Warnf("call %s rejected by circuit breaker", method)
+			return errCircuitOpen(downstream)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.done(isBreakerFailure(status.Code(err)))
+
+		return err
+	}
+}
+
+// breakerStreamInterceptor проверяет breaker только при открытии стрима: обрыв долгого стрима - не сбой вызова
+func breakerStreamInterceptor(downstream string, b *breaker) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !b.allow() {
+			clientBreakerRejectedTotal.WithLabelValues(downstream).Inc()
+			return nil, errCircuitOpen(downstream)
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		b.done(isBreakerFailure(status.Code(err)))
+
+		return stream, err
+	}
+}
+
+// deadlineUnaryInterceptor задаёт дедлайн вызову, если вызывающий не установил свой
+func deadlineUnaryInterceptor(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		timeout := defaultTimeout
+		if methodTimeout, ok := methodTimeouts[method]; ok {
+			timeout = methodTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,41 @@
+package grpcclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clientRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_client_requests_total",
+			Help: "Total number of outgoing gRPC requests per downstream service",
+		},
+		[]string{"downstream", "method", "status"},
+	)
+
+	clientRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_client_request_duration_seconds",
+			Help:    "Outgoing gRPC request duration in seconds per downstream service",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"downstream", "method", "status"},
+	)
+
+	clientBreakerRejectedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_client_breaker_rejected_total",
+			Help: "Total number of outgoing gRPC requests rejected by an open circuit breaker",
+		},
+		[]string{"downstream"},
+	)
+
+	clientBreakerState = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grpc_client_breaker_state",
+			Help: "Circuit breaker state per downstream service: 0 - closed, 1 - half-open, 2 - open",
+		},
+		[]string{"downstream"},
+	)
+)
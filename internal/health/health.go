@@ -0,0 +1,128 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// DependencyServicePrefix - префикс имён, под которыми в grpc.health.v1 публикуется состояние отдельных зависимостей
+const DependencyServicePrefix = "dependency."
+
+const defaultCheckTimeout = 3 * time.Second
+
+// Check описывает проверку одной зависимости сервиса
+type Check struct {
+	Name string
+	// Critical - при недоступности зависимости весь сервис считается NOT_SERVING
+	Critical bool
+	Probe    func(ctx context.Context) error
+}
+
+// Monitor периодически проверяет зависимости и публикует результат через стандартный grpc.health.v1
+type Monitor struct {
+	server   *grpchealth.Server
+	services []string
+	checks   []Check
+	interval time.Duration
+
+	mu     sync.RWMutex
+	status map[string]error
+}
+
+// NewMonitor создаёт монитор. services - полные имена gRPC-сервисов, статус которых зависит от критичных проверок
+func NewMonitor(interval time.Duration, services []string, checks ...Check) *Monitor {
+	m := &Monitor{
+		server:   grpchealth.NewServer(),
+		services: services,
+		checks:   checks,
+		interval: interval,
+		status:   make(map[string]error),
+	}
+
+	// До первой проверки сервис не готов принимать трафик
+	m.setServing(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return m
+}
+
+// Register регистрирует сервис grpc.health.v1 на gRPC-сервере
+func (m *Monitor) Register(server *grpc.Server) {
+	healthpb.RegisterHealthServer(server, m.server)
+}
+
+// Run выполняет проверки сразу и затем с заданным интервалом до отмены контекста
+func (m *Monitor) Run(ctx context.Context) {
+	m.CheckNow(ctx)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CheckNow(ctx)
+		}
+	}
+}
+
+// CheckNow однократно проверяет все зависимости и обновляет статусы
+func (m *Monitor) CheckNow(ctx context.Context) {
+	const op = "health.Monitor.CheckNow"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	serving := true
+	for _, check := range m.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, defaultCheckTimeout)
+		err := check.Probe(checkCtx)
+		cancel()
+
+		m.mu.Lock()
+		prevErr, known := m.status[check.Name]
+		m.status[check.Name] = err
+		m.mu.Unlock()
+
+		dependencyStatus := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			dependencyStatus = healthpb.HealthCheckResponse_NOT_SERVING
+			if check.Critical {
+				serving = false
+			}
+		}
+		m.server.SetServingStatus(DependencyServicePrefix+check.Name, dependencyStatus)
+
+		// Пишем в лог только смену состояния, чтобы не засорять его каждым тиком
+		switch {
+		case err != nil && (!known || prevErr == nil):
+			logger.WithError(err).Warnf("dependency %s is unavailable", check.Name)
+		case err == nil && known && prevErr != nil:
+			logger.Infof("dependency %s is available again", check.Name)
+		}
+	}
+
+	if serving {
+		m.setServing(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		m.setServing(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Shutdown переводит все статусы в NOT_SERVING и игнорирует дальнейшие проверки.
+// Вызывается первым шагом при остановке, чтобы балансировщик перестал отправлять новые запросы
+func (m *Monitor) Shutdown() {
+	m.server.Shutdown()
+}
+
+func (m *Monitor) setServing(status healthpb.HealthCheckResponse_ServingStatus) {
+	m.server.SetServingStatus("", status)
+	for _, service := range m.services {
+		m.server.SetServingStatus(service, status)
+	}
+}
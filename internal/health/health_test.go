@@ -0,0 +1,121 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testService = "chats.ChatService"
+
+func checkStatus(t *testing.T, m *Monitor, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := m.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.GetStatus()
+}
+
+func probe(err error) func(context.Context) error {
+	return func(context.Context) error { return err }
+}
+
+func TestMonitor_CheckNow(t *testing.T) {
+	tests := []struct {
+		name             string
+		checks           []Check
+		expectedOverall  healthpb.HealthCheckResponse_ServingStatus
+		expectedPostgres healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name: "All dependencies available",
+			checks: []Check{
+				{Name: "postgres", Critical: true, Probe: probe(nil)},
+				{Name: "minio", Critical: true, Probe: probe(nil)},
+			},
+			expectedOverall:  healthpb.HealthCheckResponse_SERVING,
+			expectedPostgres: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name: "Critical dependency unavailable",
+			checks: []Check{
+				{Name: "postgres", Critical: true, Probe: probe(errors.New("connection refused"))},
+				{Name: "minio", Critical: true, Probe: probe(nil)},
+			},
+			expectedOverall:  healthpb.HealthCheckResponse_NOT_SERVING,
+			expectedPostgres: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			name: "Optional dependency unavailable",
+			checks: []Check{
+				{Name: "postgres", Critical: true, Probe: probe(nil)},
+				{Name: "opensearch", Critical: false, Probe: probe(errors.New("timeout"))},
+			},
+			expectedOverall:  healthpb.HealthCheckResponse_SERVING,
+			expectedPostgres: healthpb.HealthCheckResponse_SERVING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMonitor(time.Minute, []string{testService}, tt.checks...)
+			m.CheckNow(context.Background())
+
+			assert.Equal(t, tt.expectedOverall, checkStatus(t, m, ""))
+			assert.Equal(t, tt.expectedOverall, checkStatus(t, m, testService))
+			assert.Equal(t, tt.expectedPostgres, checkStatus(t, m, DependencyServicePrefix+"postgres"))
+		})
+	}
+}
+
+func TestMonitor_NotServingBeforeFirstCheck(t *testing.T) {
+	m := NewMonitor(time.Minute, []string{testService}, Check{Name: "postgres", Critical: true, Probe: probe(nil)})
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, m, ""))
+}
+
+func TestMonitor_Shutdown(t *testing.T) {
+	m := NewMonitor(time.Minute, []string{testService}, Check{Name: "postgres", Critical: true, Probe: probe(nil)})
+	m.CheckNow(context.Background())
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, checkStatus(t, m, ""))
+
+	m.Shutdown()
+	// Проверки после остановки не должны возвращать сервис в SERVING
+	m.CheckNow(context.Background())
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, m, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, m, testService))
+}
+
+func TestMonitor_Run(t *testing.T) {
+	m := NewMonitor(10*time.Millisecond, nil, Check{Name: "postgres", Critical: true, Probe: probe(nil)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		m.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return checkStatus(t, m, "") == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("monitor did not stop after context cancel")
+	}
+}
+
+func TestGracefulStop(t *testing.T) {
+	server := grpc.NewServer()
+
+	assert.True(t, GracefulStop(server, time.Second))
+}
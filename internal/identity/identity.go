@@ -0,0 +1,150 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/google/uuid"
+)
+
+const (
+	// MetadataKey - ключ gRPC-метаданных с подписанной личностью пользователя
+	MetadataKey = "x-internal-identity"
+	// ServiceMetadataKey - ключ gRPC-метаданных с подписанным именем вызывающего сервиса
+	ServiceMetadataKey = "x-internal-service"
+)
+
+var (
+	ErrMalformedAssertion = errors.New("malformed identity assertion")
+	ErrInvalidSignature   = errors.New("invalid identity assertion signature")
+	ErrExpiredAssertion   = errors.New("identity assertion expired")
+)
+
+// Signer подписывает и проверяет короткоживущие утверждения вида "<user_id>.<exp>.<hmac>".
+// Gateway подписывает личность после проверки сессии, внутренние сервисы проверяют подпись общим ключом.
+// Внутренний сервис дополнительно подписывает своё имя, чтобы вызывать служебные методы
+type Signer struct {
+	secret  []byte
+	ttl     time.Duration
+	now     func() time.Time
+	service string
+}
+
+func NewSigner(conf *config.IdentityConfig) *Signer {
+	return &Signer{
+		secret: []byte(conf.Secret),
+		ttl:    conf.TTL,
+		now:    time.Now,
+	}
+}
+
+// NewServiceSigner создаёт Signer внутреннего сервиса: исходящие вызовы несут подписанное имя service
+func NewServiceSigner(conf *config.IdentityConfig, service string) *Signer {
+	signer := NewSigner(conf)
+	signer.service = service
+	return signer
+}
+
+// Sign возвращает утверждение о том, что вызов выполняется от имени userID
+func (s *Signer) Sign(userID uuid.UUID) string {
+	payload := userID.String() + "." + strconv.FormatInt(s.now().Add(s.ttl).Unix(), 10)
+	return payload + "." + s.signature(payload)
+}
+
+// Verify проверяет подпись и срок действия утверждения и возвращает id пользователя
+func (s *Signer) Verify(assertion string) (uuid.UUID, error) {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return uuid.Nil, ErrMalformedAssertion
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(payload))) {
+		return uuid.Nil, ErrInvalidSignature
+	}
+
+	exp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return uuid.Nil, ErrMalformedAssertion
+	}
+	if s.now().Unix() > exp {
+		return uuid.Nil, ErrExpiredAssertion
+	}
+
+	userID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, ErrMalformedAssertion
+	}
+
+	return userID, nil
+}
+
+// SignService возвращает утверждение о том, что вызов выполняет внутренний сервис service.
+// Подпись берётся с префиксом, поэтому утверждение о пользователе нельзя выдать за утверждение о сервисе
+func (s *Signer) SignService(service string) string {
+	payload := service + "." + strconv.FormatInt(s.now().Add(s.ttl).Unix(), 10)
+	return payload + "." + s.signature(servicePrefix+payload)
+}
+
+// VerifyService проверяет подпись и срок действия утверждения о сервисе и возвращает его имя
+func (s *Signer) VerifyService(assertion string) (string, error) {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", ErrMalformedAssertion
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(servicePrefix+payload))) {
+		return "", ErrInvalidSignature
+	}
+
+	exp, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", ErrMalformedAssertion
+	}
+	if s.now().Unix() > exp {
+		return "", ErrExpiredAssertion
+	}
+
+	return parts[0], nil
+}
+
+const servicePrefix = "service:"
+
+func (s *Signer) signature(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// UserIDFromContext возвращает id пользователя, проверенный серверным интерцептором
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(verifiedUserIDKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+type verifiedUserIDKey struct{}
+
+func withUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, verifiedUserIDKey{}, userID)
+}
+
+// ServiceFromContext возвращает имя внутреннего сервиса, проверенное серверным интерцептором
+// по сертификату mTLS или по подписи
+func ServiceFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(verifiedServiceKey{}).(string)
+	return service, ok
+}
+
+type verifiedServiceKey struct{}
+
+func withService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, verifiedServiceKey{}, service)
+}
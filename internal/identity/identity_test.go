@@ -0,0 +1,441 @@
+package identity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	authGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	userGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func newTestSigner(secret string) *Signer {
+	return NewSigner(&config.IdentityConfig{Secret: secret, TTL: time.Minute})
+}
+
+func TestSigner_SignVerify(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+
+	got, err := signer.Verify(signer.Sign(userID))
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+}
+
+func TestSigner_Verify_Errors(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	assertion := signer.Sign(userID)
+
+	expired := newTestSigner("secret")
+	expired.now = func() time.Time { return time.Now().Add(-2 * time.Minute) }
+
+	tests := []struct {
+		name      string
+		assertion string
+		wantErr   error
+	}{
+		{name: "malformed", assertion: "not-an-assertion", wantErr: ErrMalformedAssertion},
+		{name: "foreign secret", assertion: newTestSigner("other").Sign(userID), wantErr: ErrInvalidSignature},
+		{name: "substituted user", assertion: uuid.NewString() + assertion[len(userID.String()):], wantErr: ErrInvalidSignature},
+		{name: "expired", assertion: expired.Sign(userID), wantErr: ErrExpiredAssertion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := signer.Verify(tt.assertion)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// outgoingToIncoming переносит метаданные, добавленные клиентским интерцептором, во входящий контекст
+func outgoingToIncoming(t *testing.T, signer *Signer, userID string) context.Context {
+	t.Helper()
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := context.WithValue(context.Background(), domains.UserIDKey{}, userID)
+	require.NoError(t, UnaryClientInterceptor(signer)(ctx, "/test", nil, nil, nil, invoker))
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func callServer(ctx context.Context, signer *Signer, required bool, method string, req interface{}) (context.Context, error) {
+	var handlerCtx context.Context
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerCtx = ctx
+		return nil, nil
+	}
+
+	_, err := UnaryServerInterceptor(signer, required)(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return handlerCtx, err
+}
+
+func TestUnaryServerInterceptor_FillsActingUser(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	ctx := outgoingToIncoming(t, signer, userID.String())
+
+	req := &chatsGen.GetChatsReq{}
+	handlerCtx, err := callServer(ctx, signer, true, chatsGen.ChatService_GetChats_FullMethodName, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, userID.String(), req.UserId)
+	got, ok := UserIDFromContext(handlerCtx)
+	assert.True(t, ok)
+	assert.Equal(t, userID, got)
+}
+
+func TestUnaryServerInterceptor_OwnerField(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	ctx := outgoingToIncoming(t, signer, userID.String())
+
+	req := &authGen.ListBotsReq{}
+	_, err := callServer(ctx, signer, true, authGen.AuthService_ListBots_FullMethodName, req)
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), req.OwnerId)
+}
+
+func TestUnaryServerInterceptor_RejectsImpersonation(t *testing.T) {
+	signer := newTestSigner("secret")
+	ctx := outgoingToIncoming(t, signer, uuid.NewString())
+
+	req := &userGen.GetContactsReq{UserId: uuid.NewString()}
+	_, err := callServer(ctx, signer, true, userGen.UserService_GetContacts_FullMethodName, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUnaryServerInterceptor_GetUsersDialogBindsFirstUser(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	ctx := outgoingToIncoming(t, signer, userID.String())
+
+	req := &chatsGen.GetUsersDialogReq{User1Id: uuid.NewString(), User2Id: userID.String()}
+	_, err := callServer(ctx, signer, true, chatsGen.ChatService_GetUsersDialog_FullMethodName, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUnaryServerInterceptor_CreateChatCreator(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	otherID := uuid.NewString()
+	ctx := outgoingToIncoming(t, signer, userID.String())
+
+	tests := []struct {
+		name    string
+		members []*chatsGen.AddMember
+		code    codes.Code
+	}{
+		{
+			name: "Creator is admin",
+			members: []*chatsGen.AddMember{
+				{UserId: userID.String(), Role: "admin"},
+				{UserId: otherID, Role: "writer"},
+			},
+			code: codes.OK,
+		},
+		{
+			name: "Other user is admin",
+			members: []*chatsGen.AddMember{
+				{UserId: userID.String(), Role: "writer"},
+				{UserId: otherID, Role: "admin"},
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name:    "Creator is not a member",
+			members: []*chatsGen.AddMember{{UserId: otherID, Role: "writer"}},
+			code:    codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &chatsGen.CreateChatReq{Name: "chat", Type: "group", Members: tt.members}
+			_, err := callServer(ctx, signer, true, chatsGen.ChatService_CreateChat_FullMethodName, req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	// Без подписи создатель неизвестен
+	req := &chatsGen.CreateChatReq{Name: "chat", Type: "group", Members: []*chatsGen.AddMember{{UserId: otherID, Role: "admin"}}}
+	_, err := callServer(context.Background(), signer, true, chatsGen.ChatService_CreateChat_FullMethodName, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestUnaryServerInterceptor_DeniesUnlistedMethod(t *testing.T) {
+	signer := newTestSigner("secret")
+	ctx := outgoingToIncoming(t, signer, uuid.NewString())
+
+	_, err := callServer(ctx, signer, true, "/user.UserService/NewMethod", &userGen.GetContactsReq{UserId: uuid.NewString()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Метод без id пользователя тоже должен быть явно отнесён к группе
+	_, err = callServer(ctx, signer, true, "/user.UserService/NewMethod", &userGen.GetUserByUsernameReq{Username: "someone"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Проверки здоровья политика не затрагивает
+	_, err = callServer(context.Background(), signer, true, "/grpc.health.v1.Health/Check", nil)
+	assert.NoError(t, err)
+}
+
+// TestPolicyCoversEveryRPC проходит по всем RPC сервисов: каждый метод должен быть явно отнесён
+// ровно к одной из групп, а привязываемое поле - существовать в запросе
+func TestPolicyCoversEveryRPC(t *testing.T) {
+	files := []protoreflect.FileDescriptor{authGen.File_auth_proto, userGen.File_user_proto, chatsGen.File_chats_proto}
+
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				fullMethod := "/" + string(service.FullName()) + "/" + string(method.Name())
+
+				field, acting := actingUserFields[fullMethod]
+				_, checked := actingUserChecks[fullMethod]
+				_, nonActing := nonActingMethods[fullMethod]
+				_, serviceOnly := serviceMethods[fullMethod]
+				_, public := publicMethods[fullMethod]
+
+				groups := 0
+				for _, in := range []bool{acting, checked, nonActing, serviceOnly, public} {
+					if in {
+						groups++
+					}
+				}
+				assert.Equal(t, 1, groups, "%s must be in exactly one identity group", fullMethod)
+
+				if acting {
+					fd := method.Input().Fields().ByName(field)
+					if assert.NotNil(t, fd, "%s has no field %s", fullMethod, field) {
+						assert.Equal(t, protoreflect.StringKind, fd.Kind(), "%s field %s must be a string", fullMethod, field)
+					}
+				}
+			}
+		}
+	}
+}
+
+// serviceIncoming возвращает входящий контекст вызова от внутреннего сервиса service
+func serviceIncoming(signer *Signer, service string) context.Context {
+	md := metadata.Pairs(ServiceMetadataKey, signer.SignService(service))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestUnaryServerInterceptor_ServiceMethodsRequireService(t *testing.T) {
+	signer := newTestSigner("secret")
+	methods := []struct {
+		method string
+		req    interface{}
+	}{
+		{method: authGen.AuthService_GetPushTargets_FullMethodName, req: &authGen.GetPushTargetsReq{UserIds: []string{uuid.NewString()}}},
+		{method: authGen.AuthService_DropPushTokens_FullMethodName, req: &authGen.DropPushTokensReq{SessionIds: []string{uuid.NewString()}}},
+		{method: chatsGen.ChatService_GetGroupPeers_FullMethodName, req: &chatsGen.GetGroupPeersReq{UserId: uuid.NewString()}},
+		{method: chatsGen.UserEventsService_UserStatusChanged_FullMethodName, req: &chatsGen.UserStatus{UserId: uuid.NewString()}},
+	}
+
+	for _, tt := range methods {
+		t.Run(tt.method, func(t *testing.T) {
+			_, err := callServer(context.Background(), signer, false, tt.method, tt.req)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+			// Подпись пользователя не даёт доступа к служебным методам
+			_, err = callServer(outgoingToIncoming(t, signer, uuid.NewString()), signer, true, tt.method, tt.req)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+			// Gateway внутренним сервисом не считается
+			_, err = callServer(serviceIncoming(signer, "gateway"), signer, true, tt.method, tt.req)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+			_, err = callServer(serviceIncoming(newTestSigner("other"), "chats"), signer, true, tt.method, tt.req)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+			handlerCtx, err := callServer(serviceIncoming(signer, "chats"), signer, true, tt.method, tt.req)
+			require.NoError(t, err)
+			service, ok := ServiceFromContext(handlerCtx)
+			assert.True(t, ok)
+			assert.Equal(t, "chats", service)
+		})
+	}
+}
+
+func TestUnaryServerInterceptor_NonActingMethodsRequireIdentity(t *testing.T) {
+	signer := newTestSigner("secret")
+	req := &userGen.GetUserByIdReq{UserId: uuid.NewString()}
+
+	_, err := callServer(context.Background(), signer, true, userGen.UserService_GetUserById_FullMethodName, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = callServer(outgoingToIncoming(t, signer, uuid.NewString()), signer, true, userGen.UserService_GetUserById_FullMethodName, req)
+	assert.NoError(t, err)
+
+	_, err = callServer(serviceIncoming(signer, "auth"), signer, true, userGen.UserService_GetUserById_FullMethodName, req)
+	assert.NoError(t, err)
+}
+
+func TestUnaryServerInterceptor_PublicMethods(t *testing.T) {
+	signer := newTestSigner("secret")
+
+	_, err := callServer(context.Background(), signer, true, authGen.AuthService_Login_FullMethodName, &authGen.LoginReq{})
+	assert.NoError(t, err)
+}
+
+func TestSigner_ServiceAssertion(t *testing.T) {
+	signer := newTestSigner("secret")
+
+	service, err := signer.VerifyService(signer.SignService("chats"))
+	require.NoError(t, err)
+	assert.Equal(t, "chats", service)
+
+	// Подпись пользователя нельзя выдать за подпись сервиса и наоборот
+	_, err = signer.VerifyService(signer.Sign(uuid.New()))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = signer.Verify(signer.SignService("chats"))
+	assert.Error(t, err)
+
+	expired := newTestSigner("secret")
+	expired.now = func() time.Time { return time.Now().Add(-2 * time.Minute) }
+	_, err = signer.VerifyService(expired.SignService("chats"))
+	assert.ErrorIs(t, err, ErrExpiredAssertion)
+}
+
+func TestUnaryClientInterceptor_SignsService(t *testing.T) {
+	signer := NewServiceSigner(&config.IdentityConfig{Secret: "secret", TTL: time.Minute}, "user")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	require.NoError(t, UnaryClientInterceptor(signer)(context.Background(), "/test", nil, nil, nil, invoker))
+	require.Len(t, md.Get(ServiceMetadataKey), 1)
+	assert.Empty(t, md.Get(MetadataKey))
+
+	service, err := signer.VerifyService(md.Get(ServiceMetadataKey)[0])
+	require.NoError(t, err)
+	assert.Equal(t, "user", service)
+}
+
+func TestUnaryServerInterceptor_MissingAssertion(t *testing.T) {
+	signer := newTestSigner("secret")
+	req := &chatsGen.GetChatsReq{UserId: uuid.NewString()}
+
+	_, err := callServer(context.Background(), signer, true, chatsGen.ChatService_GetChats_FullMethodName, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// при постепенном включении старые вызовы без подписи пропускаются
+	_, err = callServer(context.Background(), signer, false, chatsGen.ChatService_GetChats_FullMethodName, req)
+	assert.NoError(t, err)
+}
+
+func TestUnaryServerInterceptor_InvalidAssertion(t *testing.T) {
+	signer := newTestSigner("secret")
+	ctx := outgoingToIncoming(t, newTestSigner("other"), uuid.NewString())
+
+	_, err := callServer(ctx, signer, false, chatsGen.ChatService_GetChats_FullMethodName, &chatsGen.GetChatsReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestUnaryServerInterceptor_NotifyUserRequiresSignedRecipient(t *testing.T) {
+	signer := newTestSigner("secret")
+	recipientID := uuid.New()
+
+	// Без подписи пуш произвольному пользователю не проходит
+	req := &chatsGen.NotifyUserReq{UserId: recipientID.String()}
+	_, err := callServer(context.Background(), signer, true, chatsGen.MessageService_NotifyUser_FullMethodName, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Вызывающий сервис подписал получателя
+	ctx := outgoingToIncoming(t, signer, recipientID.String())
+	_, err = callServer(ctx, signer, true, chatsGen.MessageService_NotifyUser_FullMethodName, req)
+	assert.NoError(t, err)
+
+	// Подпись на одного пользователя не открывает пуш другому
+	req = &chatsGen.NotifyUserReq{UserId: uuid.NewString()}
+	_, err = callServer(ctx, signer, true, chatsGen.MessageService_NotifyUser_FullMethodName, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUnaryClientInterceptor_NoUserInContext(t *testing.T) {
+	signer := newTestSigner("secret")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	require.NoError(t, UnaryClientInterceptor(signer)(context.Background(), "/test", nil, nil, nil, invoker))
+	assert.Empty(t, md.Get(MetadataKey))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *chatsGen.StreamMessagesForUserReq
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	*m.(*chatsGen.StreamMessagesForUserReq) = chatsGen.StreamMessagesForUserReq{UserId: s.req.UserId}
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	signer := newTestSigner("secret")
+	userID := uuid.New()
+	info := &grpc.StreamServerInfo{FullMethod: chatsGen.MessageService_StreamMessagesForUser_FullMethodName}
+
+	t.Run("fills user id", func(t *testing.T) {
+		stream := &fakeServerStream{ctx: outgoingToIncoming(t, signer, userID.String()), req: &chatsGen.StreamMessagesForUserReq{}}
+
+		err := StreamServerInterceptor(signer, true)(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+			var req chatsGen.StreamMessagesForUserReq
+			require.NoError(t, ss.RecvMsg(&req))
+			assert.Equal(t, userID.String(), req.UserId)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects foreign user", func(t *testing.T) {
+		stream := &fakeServerStream{ctx: outgoingToIncoming(t, signer, userID.String()), req: &chatsGen.StreamMessagesForUserReq{UserId: uuid.NewString()}}
+
+		err := StreamServerInterceptor(signer, true)(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+			var req chatsGen.StreamMessagesForUserReq
+			return ss.RecvMsg(&req)
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("requires assertion", func(t *testing.T) {
+		stream := &fakeServerStream{ctx: context.Background(), req: &chatsGen.StreamMessagesForUserReq{}}
+
+		err := StreamServerInterceptor(signer, true)(nil, stream, info, func(srv interface{}, ss grpc.ServerStream) error {
+			t.Fatal("handler must not be called")
+			return nil
+		})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,364 @@
+package identity
+
+import (
+	"context"
+	"strings"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/mtls"
+	authGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	userGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// actingUserFields - методы, выполняемые от имени пользователя, и поле запроса с его id.
+// Для них значение поля берётся из проверенной подписи, а не из запроса.
+// NotifyUser здесь же: вызывающий сервис подписывает id получателя, так что пуш без подписи не пройдёт.
+// Метод, не попавший ни в одну из групп политики, отклоняется
+var actingUserFields = map[string]protoreflect.Name{
+	authGen.AuthService_GetSessionsByUserID_FullMethodName:            "user_id",
+	authGen.AuthService_DeleteSession_FullMethodName:                  "user_id",
+	authGen.AuthService_DeleteAllSessionsExceptCurrent_FullMethodName: "user_id",
+	authGen.AuthService_RegisterPushToken_FullMethodName:              "user_id",
+	authGen.AuthService_UnregisterPushToken_FullMethodName:            "user_id",
+	authGen.AuthService_CreateToken_FullMethodName:                    "user_id",
+	authGen.AuthService_ListTokens_FullMethodName:                     "user_id",
+	authGen.AuthService_RevokeToken_FullMethodName:                    "user_id",
+	authGen.AuthService_RequestPhoneChange_FullMethodName:             "user_id",
+	authGen.AuthService_ConfirmPhoneChange_FullMethodName:             "user_id",
+	authGen.AuthService_CreateBot_FullMethodName:                      "owner_id",
+	authGen.AuthService_ListBots_FullMethodName:                       "owner_id",
+	authGen.AuthService_DeleteBot_FullMethodName:                      "owner_id",
+	authGen.AuthService_SetBotWebhook_FullMethodName:                  "owner_id",
+	authGen.AuthService_CreateBotToken_FullMethodName:                 "owner_id",
+	authGen.AuthService_RevokeBotToken_FullMethodName:                 "owner_id",
+
+	userGen.UserService_SearchUsers_FullMethodName:          "user_id",
+	userGen.UserService_UpdateUserInfo_FullMethodName:       "user_id",
+	userGen.UserService_UploadUserAvatar_FullMethodName:     "user_id",
+	userGen.UserService_DeleteUserAvatar_FullMethodName:     "user_id",
+	userGen.UserService_SetCurrentUserAvatar_FullMethodName: "user_id",
+	userGen.UserService_CreateContact_FullMethodName:        "user_id",
+	userGen.UserService_GetContacts_FullMethodName:          "user_id",
+	userGen.UserService_SearchContacts_FullMethodName:       "user_id",
+	userGen.UserService_DeleteContact_FullMethodName:        "user_id",
+	userGen.UserService_UpdateContactAlias_FullMethodName:   "user_id",
+	userGen.UserService_ImportContacts_FullMethodName:       "user_id",
+	userGen.UserService_BlockUser_FullMethodName:            "user_id",
+	userGen.UserService_UnblockUser_FullMethodName:          "user_id",
+	userGen.UserService_GetBlockedUsers_FullMethodName:      "user_id",
+	userGen.UserService_GetPrivacySettings_FullMethodName:   "user_id",
+	userGen.UserService_UpdatePrivacySetting_FullMethodName: "user_id",
+
+	chatsGen.ChatService_GetChats_FullMethodName:                 "user_id",
+	chatsGen.ChatService_GetUsersDialog_FullMethodName:           "user1_id",
+	chatsGen.ChatService_RemoveUserFromChat_FullMethodName:       "user_id",
+	chatsGen.ChatService_GetChat_FullMethodName:                  "user_id",
+	chatsGen.ChatService_GetChatMessages_FullMethodName:          "user_id",
+	chatsGen.ChatService_UpdateChat_FullMethodName:               "user_id",
+	chatsGen.ChatService_DeleteChat_FullMethodName:               "user_id",
+	chatsGen.ChatService_AddUserToChat_FullMethodName:            "user_id",
+	chatsGen.ChatService_GetChatAvatars_FullMethodName:           "user_id",
+	chatsGen.ChatService_UploadChatAvatar_FullMethodName:         "user_id",
+	chatsGen.ChatService_GetChatAvatarHistory_FullMethodName:     "user_id",
+	chatsGen.ChatService_DeleteChatAvatar_FullMethodName:         "user_id",
+	chatsGen.ChatService_SetCurrentChatAvatar_FullMethodName:     "user_id",
+	chatsGen.ChatService_SearchChats_FullMethodName:              "user_id",
+	chatsGen.ChatService_UpdateChatSettings_FullMethodName:       "user_id",
+	chatsGen.ChatService_SetChatUsername_FullMethodName:          "user_id",
+	chatsGen.ChatService_ResolveChatUsername_FullMethodName:      "user_id",
+	chatsGen.ChatService_CreateInvite_FullMethodName:             "user_id",
+	chatsGen.ChatService_GetChatInvites_FullMethodName:           "user_id",
+	chatsGen.ChatService_RevokeInvite_FullMethodName:             "user_id",
+	chatsGen.ChatService_JoinChatByInvite_FullMethodName:         "user_id",
+	chatsGen.ChatService_GetJoinRequests_FullMethodName:          "user_id",
+	chatsGen.ChatService_ApproveJoinRequest_FullMethodName:       "user_id",
+	chatsGen.ChatService_DeclineJoinRequest_FullMethodName:       "user_id",
+	chatsGen.MessageService_StreamMessagesForUser_FullMethodName: "user_id",
+	chatsGen.MessageService_HandleSendMessage_FullMethodName:     "user_id",
+	chatsGen.MessageService_SearchMessages_FullMethodName:        "user_id",
+	chatsGen.MessageService_UploadAttachment_FullMethodName:      "user_id",
+	chatsGen.MessageService_GetUnreadMentions_FullMethodName:     "user_id",
+	chatsGen.MessageService_ReadMentions_FullMethodName:          "user_id",
+	chatsGen.MessageService_NotifyUser_FullMethodName:            "user_id",
+}
+
+// actingUserChecks - методы от имени пользователя, где его id не лежит в отдельном поле
+var actingUserChecks = map[string]func(msg proto.Message, userID uuid.UUID) error{
+	chatsGen.ChatService_CreateChat_FullMethodName: checkChatCreator,
+}
+
+// nonActingMethods - чтение чужих профилей: id в запросе означает не вызывающего пользователя.
+// Вызов должен нести подпись пользователя (от его имени применяется приватность) или внутреннего сервиса
+var nonActingMethods = map[string]struct{}{
+	userGen.UserService_GetUserById_FullMethodName:          {},
+	userGen.UserService_GetUsersByIDs_FullMethodName:        {},
+	userGen.UserService_GetUserByPhone_FullMethodName:       {},
+	userGen.UserService_GetUserByUsername_FullMethodName:    {},
+	userGen.UserService_ResolveUsername_FullMethodName:      {},
+	userGen.UserService_GetUserAvatars_FullMethodName:       {},
+	userGen.UserService_GetUserAvatarHistory_FullMethodName: {},
+}
+
+// serviceMethods - служебные вызовы между сервисами, в том числе из фоновых задач без пользователя.
+// Доступны только внутреннему сервису: по CN сертификата mTLS или по подписанному имени сервиса
+var serviceMethods = map[string]struct{}{
+	authGen.AuthService_GetPushTargets_FullMethodName:            {},
+	authGen.AuthService_DropPushTokens_FullMethodName:            {},
+	userGen.UserService_UserRegistered_FullMethodName:            {},
+	userGen.UserService_UserPhoneChanged_FullMethodName:          {},
+	userGen.UserService_UpdateLastSeen_FullMethodName:            {},
+	userGen.UserService_GetContactAliases_FullMethodName:         {},
+	userGen.UserService_GetBlockedPeers_FullMethodName:           {},
+	userGen.UserService_GetGroupAddDenied_FullMethodName:         {},
+	chatsGen.ChatService_GetGroupPeers_FullMethodName:            {},
+	chatsGen.UserEventsService_UserProfileChanged_FullMethodName: {},
+	chatsGen.UserEventsService_UserStatusChanged_FullMethodName:  {},
+}
+
+// publicMethods - вызовы gateway до проверки сессии, личность в них не нужна
+var publicMethods = map[string]struct{}{
+	authGen.AuthService_Register_FullMethodName:        {},
+	authGen.AuthService_Login_FullMethodName:           {},
+	authGen.AuthService_Logout_FullMethodName:          {},
+	authGen.AuthService_ValidateSession_FullMethodName: {},
+	authGen.AuthService_ValidateToken_FullMethodName:   {},
+}
+
+// healthServicePrefix - проверки здоровья не относятся к политике личности
+var healthServicePrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// UnaryClientInterceptor подписывает id пользователя из контекста запроса gateway
+// (его кладёт AuthGRPCMiddleware после проверки сессии или токена)
+func UnaryClientInterceptor(signer *Signer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(signer.outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor - то же для стримов; подпись проверяется один раз при открытии стрима
+func StreamClientInterceptor(signer *Signer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(signer.outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+func (s *Signer) outgoingContext(ctx context.Context) context.Context {
+	if s.service != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, ServiceMetadataKey, s.SignService(s.service))
+	}
+
+	userIDStr, ok := ctx.Value(domains.UserIDKey{}).(string)
+	if !ok {
+		return ctx
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, s.Sign(userID))
+}
+
+// UnaryServerInterceptor проверяет подпись из метаданных и кладёт id пользователя в контекст.
+// Для методов из actingUserFields id в запросе заменяется проверенным:
+// пустое поле заполняется, несовпадающее отклоняется. Без подписи такие вызовы отклоняются, если required
+func UnaryServerInterceptor(signer *Signer, required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := signer.authorize(ctx, info.FullMethod, req, required)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - то же для стримов: проверка выполняется для каждого полученного сообщения
+func StreamServerInterceptor(signer *Signer, required bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := signer.authorize(ss.Context(), info.FullMethod, nil, required)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identityServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			signer:       signer,
+			method:       info.FullMethod,
+			required:     required,
+		})
+	}
+}
+
+type identityServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	signer   *Signer
+	method   string
+	required bool
+}
+
+func (s *identityServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *identityServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := s.signer.authorize(s.ctx, s.method, m, s.required)
+	return err
+}
+
+func (s *Signer) authorize(ctx context.Context, fullMethod string, req interface{}, required bool) (context.Context, error) {
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return ctx, nil
+	}
+
+	userID, verified := UserIDFromContext(ctx)
+	if !verified {
+		if values := metadata.ValueFromIncomingContext(ctx, MetadataKey); len(values) > 0 {
+			parsed, err := s.Verify(values[0])
+			if err != nil {
+				return ctx, status.Error(codes.Unauthenticated, err.Error())
+			}
+			userID, verified = parsed, true
+			ctx = withUserID(ctx, userID)
+		}
+	}
+
+	ctx, isService, err := s.callerService(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if _, ok := publicMethods[fullMethod]; ok {
+		return ctx, nil
+	}
+	if _, ok := serviceMethods[fullMethod]; ok {
+		if !isService {
+			return ctx, status.Error(codes.Unauthenticated, "service identity is required")
+		}
+		return ctx, nil
+	}
+	if _, ok := nonActingMethods[fullMethod]; ok {
+		if !verified && !isService && required {
+			return ctx, status.Error(codes.Unauthenticated, "identity assertion is required")
+		}
+		return ctx, nil
+	}
+
+	field, acting := actingUserFields[fullMethod]
+	check, checked := actingUserChecks[fullMethod]
+	if !acting && !checked {
+		return ctx, status.Errorf(codes.PermissionDenied, "%s is not covered by identity policy", fullMethod)
+	}
+	if !verified {
+		if required {
+			return ctx, status.Error(codes.Unauthenticated, "identity assertion is required")
+		}
+		return ctx, nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ctx, nil
+	}
+
+	if checked {
+		return ctx, check(msg, userID)
+	}
+	if err := bindUserID(msg, field, userID); err != nil {
+		return ctx, err
+	}
+	return ctx, nil
+}
+
+// callerService определяет внутренний сервис, выполняющий вызов. При mTLS имя берётся из CN сертификата,
+// и gateway сервисом не считается. Без mTLS проверяется подписанное имя из метаданных
+func (s *Signer) callerService(ctx context.Context) (context.Context, bool, error) {
+	if _, ok := ServiceFromContext(ctx); ok {
+		return ctx, true, nil
+	}
+
+	if caller, ok := mtls.CallerService(ctx); ok {
+		if caller == mtls.ServiceGateway {
+			return ctx, false, nil
+		}
+		return withService(ctx, caller), true, nil
+	}
+
+	values := metadata.ValueFromIncomingContext(ctx, ServiceMetadataKey)
+	if len(values) == 0 {
+		return ctx, false, nil
+	}
+
+	service, err := s.VerifyService(values[0])
+	if err != nil {
+		return ctx, false, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if service == mtls.ServiceGateway {
+		return ctx, false, nil
+	}
+	return withService(ctx, service), true, nil
+}
+
+// checkChatCreator требует, чтобы создатель был участником чата и администратором мог стать только он
+func checkChatCreator(msg proto.Message, userID uuid.UUID) error {
+	req, ok := msg.(*chatsGen.CreateChatReq)
+	if !ok {
+		return nil
+	}
+
+	isMember := false
+	for _, member := range req.GetMembers() {
+		memberID, err := uuid.Parse(member.GetUserId())
+		if err != nil {
+			// Формат проверит обработчик
+			continue
+		}
+		if memberID == userID {
+			isMember = true
+			continue
+		}
+		if member.GetRole() == modelsChats.RoleAdmin {
+			return status.Error(codes.PermissionDenied, "only the creator can be the chat admin")
+		}
+	}
+
+	if !isMember {
+		return status.Error(codes.PermissionDenied, "creator must be a chat member")
+	}
+	return nil
+}
+
+// bindUserID подставляет проверенный id в поле запроса или отклоняет запрос с чужим id
+func bindUserID(msg proto.Message, field protoreflect.Name, userID uuid.UUID) error {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(field)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return nil
+	}
+
+	current := m.Get(fd).String()
+	if current == "" {
+		m.Set(fd, protoreflect.ValueOfString(userID.String()))
+		return nil
+	}
+
+	parsed, err := uuid.Parse(current)
+	if err != nil || parsed != userID {
+		return status.Errorf(codes.PermissionDenied, "%s does not match caller identity", field)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxFileSize - максимальный размер загружаемого файла аватарки
+	MaxFileSize = 10 << 20
+	// MaxPixels ограничивает площадь исходного изображения, чтобы декодирование не съело память
+	MaxPixels = 40_000_000
+	// MinSide - минимальная сторона исходного изображения в пикселях
+	MinSide = 100
+	// ContentType - формат, в котором хранятся все размеры аватарки
+	ContentType = "image/jpeg"
+)
+
+// Sizes - стороны квадратных копий аватарки по убыванию. Изображение не растягивается,
+// поэтому у маленьких исходников крупные размеры заменяются стороной исходника
+var Sizes = []int{640, 320, 160}
+
+// Avatar - аватарка из истории пользователя или чата
+type Avatar struct {
+	ID        uuid.UUID
+	Sizes     []int // по убыванию; самый крупный размер хранится под ID
+	CreatedAt time.Time
+	IsCurrent bool
+}
+
+// VariantID возвращает идентификатор объекта в хранилище для уменьшенной копии аватарки
+func VariantID(avatarID uuid.UUID, size int) uuid.UUID {
+	return uuid.NewSHA1(avatarID, []byte(strconv.Itoa(size)))
+}
+
+// ObjectIDs сопоставляет каждому размеру объект в хранилище.
+// У аватарок, загруженных до появления размеров, карта пустая - файл лежит только под ID
+func (a Avatar) ObjectIDs() map[int]uuid.UUID {
+	result := make(map[int]uuid.UUID, len(a.Sizes))
+	for i, size := range a.Sizes {
+		if i == 0 {
+			result[size] = a.ID
+			continue
+		}
+		result[size] = VariantID(a.ID, size)
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantID_Deterministic(t *testing.T) {
+	avatarID := uuid.New()
+
+	assert.Equal(t, VariantID(avatarID, 320), VariantID(avatarID, 320))
+	assert.NotEqual(t, VariantID(avatarID, 320), VariantID(avatarID, 160))
+	assert.NotEqual(t, avatarID, VariantID(avatarID, 640))
+}
+
+func TestAvatar_ObjectIDs(t *testing.T) {
+	avatarID := uuid.New()
+	avatar := Avatar{ID: avatarID, Sizes: []int{640, 320, 160}}
+
+	ids := avatar.ObjectIDs()
+
+	assert.Len(t, ids, 3)
+	assert.Equal(t, avatarID, ids[640])
+	assert.Equal(t, VariantID(avatarID, 320), ids[320])
+	assert.Equal(t, VariantID(avatarID, 160), ids[160])
+}
+
+func TestAvatar_ObjectIDs_Legacy(t *testing.T) {
+	avatar := Avatar{ID: uuid.New()}
+
+	assert.Empty(t, avatar.ObjectIDs())
+}
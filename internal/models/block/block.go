@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Block struct {
+	UserID        uuid.UUID
+	BlockedUserID uuid.UUID
+	CreatedAt     time.Time
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Bot struct {
+	UserID     uuid.UUID
+	OwnerID    uuid.UUID
+	Name       string
+	Username   string
+	WebhookURL *string
+	CreatedAt  time.Time
+}
+
+// Webhook описывает адрес бота, на который отправляются сообщения с его упоминанием
+type Webhook struct {
+	BotID    uuid.UUID
+	Username string
+	URL      string
+	Secret   string
+}
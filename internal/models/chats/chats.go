@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -21,6 +23,31 @@ type Chat struct {
 	Type        string
 	Name        string
 	Description string
+	Settings    ChatSettings
+}
+
+// ChatSettings персональные настройки чата для конкретного участника
+type ChatSettings struct {
+	IsMuted    bool
+	MutedUntil *time.Time // nil при IsMuted - заглушён навсегда
+	IsArchived bool
+	PinOrder   *int // nil - чат не закреплён
+	Folder     *string
+}
+
+// MemberNotifySettings участник чата с настройками, влияющими на push-уведомления
+type MemberNotifySettings struct {
+	UserID   uuid.UUID
+	Username string
+	Settings ChatSettings
+}
+
+// IsMutedAt проверяет, заглушён ли чат в момент t с учётом срока заглушения
+func (s ChatSettings) IsMutedAt(t time.Time) bool {
+	if !s.IsMuted {
+		return false
+	}
+	return s.MutedUntil == nil || s.MutedUntil.After(t)
 }
 
 type UserInfo struct {
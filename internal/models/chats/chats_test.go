@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatSettings_IsMutedAt(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		settings ChatSettings
+		expected bool
+	}{
+		{name: "not muted", settings: ChatSettings{}, expected: false},
+		{name: "muted forever", settings: ChatSettings{IsMuted: true}, expected: true},
+		{name: "muted until future", settings: ChatSettings{IsMuted: true, MutedUntil: &future}, expected: true},
+		{name: "mute expired", settings: ChatSettings{IsMuted: true, MutedUntil: &past}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.settings.IsMutedAt(now))
+		})
+	}
+}
+
+func TestInvite_IsUsableAt(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	limit := 2
+
+	tests := []struct {
+		name     string
+		invite   Invite
+		expected bool
+	}{
+		{name: "unlimited", invite: Invite{}, expected: true},
+		{name: "revoked", invite: Invite{RevokedAt: &past}, expected: false},
+		{name: "expired", invite: Invite{ExpiresAt: &past}, expected: false},
+		{name: "not expired yet", invite: Invite{ExpiresAt: &future}, expected: true},
+		{name: "limit not reached", invite: Invite{UsageLimit: &limit, UsageCount: 1}, expected: true},
+		{name: "limit reached", invite: Invite{UsageLimit: &limit, UsageCount: 2}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.invite.IsUsableAt(now))
+		})
+	}
+}
+
+func TestJoinRole(t *testing.T) {
+	assert.Equal(t, RoleViewer, JoinRole(ChatTypeChannel))
+	assert.Equal(t, RoleMember, JoinRole(ChatTypeGroup))
+}
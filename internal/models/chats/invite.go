@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// InviteCodeBytes - длина случайной части кода ссылки до кодирования
+	InviteCodeBytes  = 12
+	InviteNameMaxLen = 32
+)
+
+// Invite пригласительная ссылка в группу или канал
+type Invite struct {
+	ID               uuid.UUID
+	ChatID           uuid.UUID
+	ChatType         string
+	CreatorID        uuid.UUID
+	Code             string
+	Name             string
+	ExpiresAt        *time.Time // nil - бессрочная
+	UsageLimit       *int       // nil - без ограничения числа вступлений
+	UsageCount       int
+	RequiresApproval bool
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
+// IsUsableAt проверяет, можно ли вступить по ссылке в момент t
+func (i Invite) IsUsableAt(t time.Time) bool {
+	if i.RevokedAt != nil {
+		return false
+	}
+	if i.ExpiresAt != nil && !i.ExpiresAt.After(t) {
+		return false
+	}
+	return i.UsageLimit == nil || i.UsageCount < *i.UsageLimit
+}
+
+// JoinRequest заявка на вступление, ожидающая решения админа
+type JoinRequest struct {
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+	UserName  string
+	InviteID  *uuid.UUID // nil - ссылка удалена вместе с историей
+	CreatedAt time.Time
+}
+
+// JoinRole роль, с которой пользователь вступает в чат по ссылке: в канале он только читает
+func JoinRole(chatType string) string {
+	if chatType == ChatTypeChannel {
+		return RoleViewer
+	}
+	return RoleMember
+}
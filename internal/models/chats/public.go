@@ -0,0 +1,14 @@
+package models
+
+import "github.com/google/uuid"
+
+// PublicChat - группа или канал, найденные по публичному handle
+type PublicChat struct {
+	ID           uuid.UUID
+	Type         string
+	Name         string
+	Description  string
+	Username     string
+	MembersCount int
+	IsMember     bool
+}
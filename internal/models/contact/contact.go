@@ -1,14 +1,53 @@
 package models
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// MaxAliasLength - максимальная длина имени контакта
+const MaxAliasLength = 64
+
+const (
+	// MaxImportBatch - сколько номеров можно передать в одном запросе импорта
+	MaxImportBatch = 1000
+	// ImportQuota - сколько номеров пользователь может проверить за ImportQuotaWindow
+	ImportQuota = 3000
+	// ImportQuotaWindow - окно, за которое считается ImportQuota
+	ImportQuotaWindow = 24 * time.Hour
+)
+
 type Contact struct {
-	UserID      uuid.UUID
+	UserID        uuid.UUID
 	ContactUserID uuid.UUID
+	Alias         *string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// HashPhone возвращает SHA-256 нормализованного номера в hex, как в колонке "user".phone_hash
+func HashPhone(normalizedPhone string) string {
+	sum := sha256.Sum256([]byte(normalizedPhone))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsPhoneHash проверяет, что строка похожа на результат HashPhone
+func IsPhoneHash(value string) bool {
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
+// PendingImportKey возвращает HMAC-SHA256 хэша номера на секрете сервера в hex.
+// Ключ хранится в contact_import вместо хэша: без секрета утёкшую таблицу не перебрать по всем номерам
+func PendingImportKey(secret []byte, phoneHash string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(phoneHash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
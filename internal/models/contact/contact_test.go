@@ -0,0 +1,30 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPhone(t *testing.T) {
+	hash := HashPhone("+79991234567")
+
+	assert.True(t, IsPhoneHash(hash))
+	assert.Equal(t, hash, HashPhone("+79991234567"))
+	assert.NotEqual(t, hash, HashPhone("+79991234568"))
+}
+
+func TestIsPhoneHash(t *testing.T) {
+	assert.False(t, IsPhoneHash("+79991234567"))
+	assert.False(t, IsPhoneHash(""))
+	assert.False(t, IsPhoneHash("zz"+HashPhone("+79991234567")[2:]))
+}
+
+func TestPendingImportKey(t *testing.T) {
+	hash := HashPhone("+79991234567")
+	key := PendingImportKey([]byte("secret"), hash)
+
+	assert.Equal(t, key, PendingImportKey([]byte("secret"), hash))
+	assert.NotEqual(t, hash, key)
+	assert.NotEqual(t, key, PendingImportKey([]byte("other"), hash))
+}
@@ -3,4 +3,8 @@ package domains
 type (
 	UserIDKey        struct{}
 	ContextKeyLogger struct{}
+	// TokenScopesKey присутствует в контексте, если запрос авторизован персональным токеном
+	TokenScopesKey struct{}
+	// AccountTypeKey - тип аккаунта владельца персонального токена
+	AccountTypeKey struct{}
 )
@@ -4,18 +4,46 @@ import (
 	"context"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const LoggingLevel = logrus.DebugLevel
 
 func GetLogger(ctx context.Context) *logrus.Entry {
 	if logger, ok := ctx.Value(ContextKeyLogger{}).(*logrus.Entry); ok {
-		return logger
+		return WithTrace(ctx, logger)
 	}
 
 	logger := logrus.New()
 	logger.SetFormatter(&logrus.JSONFormatter{})
 	logger.SetLevel(LoggingLevel)
 
-	return logrus.NewEntry(logger)
+	return WithTrace(ctx, logrus.NewEntry(logger))
+}
+
+// WithTrace добавляет в запись идентификаторы текущего трейса и спана, если они есть в контексте
+func WithTrace(ctx context.Context, entry *logrus.Entry) *logrus.Entry {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return entry
+	}
+
+	return entry.WithFields(logrus.Fields{
+		"trace_id": spanContext.TraceID().String(),
+		"span_id":  spanContext.SpanID().String(),
+	})
+}
+
+// RequestIDField - поле записи логгера, в котором хранится сквозной идентификатор запроса
+const RequestIDField = "request_id"
+
+// GetRequestID возвращает идентификатор запроса из логгера в контексте или пустую строку
+func GetRequestID(ctx context.Context) string {
+	logger, ok := ctx.Value(ContextKeyLogger{}).(*logrus.Entry)
+	if !ok {
+		return ""
+	}
+
+	requestID, _ := logger.Data[RequestIDField].(string)
+	return requestID
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestGetLogger(t *testing.T) {
@@ -36,3 +37,60 @@ func TestGetLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLogger_WithTrace(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	ctx = context.WithValue(ctx, ContextKeyLogger{}, logrus.NewEntry(logrus.New()).WithField("request_id", "abc"))
+
+	logger := GetLogger(ctx)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", logger.Data["trace_id"])
+	assert.Equal(t, "00f067aa0ba902b7", logger.Data["span_id"])
+	assert.Equal(t, "abc", logger.Data["request_id"])
+}
+
+func TestGetLogger_WithoutTrace(t *testing.T) {
+	logger := GetLogger(context.Background())
+
+	assert.NotContains(t, logger.Data, "trace_id")
+	assert.NotContains(t, logger.Data, "span_id")
+}
+
+func TestGetRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{
+			name:     "No logger in context",
+			ctx:      context.Background(),
+			expected: "",
+		},
+		{
+			name:     "Logger without request id",
+			ctx:      context.WithValue(context.Background(), ContextKeyLogger{}, logrus.NewEntry(logrus.New())),
+			expected: "",
+		},
+		{
+			name: "Logger with request id",
+			ctx: context.WithValue(context.Background(), ContextKeyLogger{},
+				logrus.NewEntry(logrus.New()).WithField(RequestIDField, "req-1")),
+			expected: "req-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetRequestID(tt.ctx))
+		})
+	}
+}
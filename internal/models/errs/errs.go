@@ -24,6 +24,16 @@ var (
 	ErrContactAlreadyExists  = errors.New("contact already exists")
 	ErrContactNotFound       = errors.New("contact not found")
 	ErrInvalidInput          = errors.New("invalid input")
+	ErrPushTokenExpired      = errors.New("push token is no longer valid")
+	ErrUserBlocked           = errors.New("user is blocked")
+	ErrBlockNotFound         = errors.New("block not found")
+	ErrPrivacyRestricted     = errors.New("restricted by privacy settings")
+	ErrTooManyRequests       = errors.New("too many requests")
+	ErrInvalidImage          = errors.New("invalid image")
+	ErrInvalidCode           = errors.New("invalid verification code")
+	ErrUsernameReserved      = errors.New("username is reserved")
+	ErrInviteExpired         = errors.New("invite link is expired or revoked")
+	ErrAlreadyMember         = errors.New("user is already a member of the chat")
 )
 
 var (
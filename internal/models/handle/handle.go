@@ -0,0 +1,58 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Handle - публичное имя пользователя, бота, группы или канала. Пространство имён у них общее
+const (
+	OwnerUser = "user"
+	OwnerChat = "chat"
+
+	// RedirectGracePeriod - сколько прежний handle после переименования ведёт на владельца
+	RedirectGracePeriod = 14 * 24 * time.Hour
+
+	// GeneratedPrefix - префикс автоматически выданных username, вручную его занять нельзя
+	GeneratedPrefix = "user_"
+)
+
+// ReservedNames закреплены за самим сервисом: служебные пути и будущие официальные аккаунты
+var ReservedNames = []string{
+	"admin", "administrator", "api", "app", "bot", "bots", "channel", "chats", "contacts",
+	"group", "help", "invite", "join", "login", "logout", "me", "news", "register",
+	"resolve", "root", "security", "settings", "support", "system", "user", "users",
+}
+
+// BlockedWords не допускаются отдельным словом ни в каком handle, чтобы никто не выдавал себя за администрацию.
+// Слова в handle разделяются подчёркиванием, цифры по краям слова не учитываются: admin_2 и 2admin заняты,
+// а badminton и supportive - нет
+var BlockedWords = []string{"100gramm", "admin", "moderator", "official", "support"}
+
+// Normalize приводит handle к виду, в котором он сравнивается: без @ и в нижнем регистре
+func Normalize(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+// IsReserved сообщает, что handle нельзя занять вручную
+func IsReserved(handle string) bool {
+	normalized := Normalize(handle)
+
+	if slices.Contains(ReservedNames, normalized) || strings.HasPrefix(normalized, GeneratedPrefix) {
+		return true
+	}
+
+	for _, word := range strings.Split(normalized, "_") {
+		// 100gramm сам начинается с цифр, поэтому концевые цифры отбрасываются и отдельно от начальных
+		for _, candidate := range []string{word, strings.TrimRight(word, digits), strings.Trim(word, digits)} {
+			if slices.Contains(BlockedWords, candidate) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+const digits = "0123456789"
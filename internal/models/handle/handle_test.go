@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "durov", Normalize(" @Durov "))
+	assert.Equal(t, "news_bot", Normalize("News_Bot"))
+}
+
+func TestIsReserved(t *testing.T) {
+	tests := []struct {
+		handle   string
+		expected bool
+	}{
+		{handle: "Settings", expected: true},
+		{handle: "@resolve", expected: true},
+		{handle: "user_123456789012345", expected: true},
+		{handle: "the_Admin_team", expected: true},
+		{handle: "official_news", expected: true},
+		{handle: "support24", expected: true},
+		{handle: "100gramm_bot", expected: true},
+		{handle: "100gramm2", expected: true},
+		{handle: "2admin", expected: true},
+		{handle: "badminton", expected: false},
+		{handle: "supportive", expected: false},
+		{handle: "adminka_fan", expected: false},
+		{handle: "alice", expected: false},
+		{handle: "users_fan", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.handle, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsReserved(tt.handle))
+		})
+	}
+}
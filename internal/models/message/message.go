@@ -31,4 +31,6 @@ type CreateMessage struct {
 	CreatedAt  time.Time
 	Type       string
 	Attachment *modelsAttachment.CreateAttachment
+	// PendingAttachmentID - ранее загруженное вложение, которое привязывается к сообщению в той же транзакции
+	PendingAttachmentID *uuid.UUID
 }
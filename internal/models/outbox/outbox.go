@@ -0,0 +1,100 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventMessageCreated   = "message.created"
+	EventMessageUpdated   = "message.updated"
+	EventMessageDeleted   = "message.deleted"
+	EventChatCreated      = "chat.created"
+	EventChatMembersAdded = "chat.members_added"
+)
+
+// Event доменное событие из outbox. Доставка не менее одного раза:
+// потребитель должен отбрасывать повторы по DedupKey
+type Event struct {
+	ID          uuid.UUID
+	DedupKey    string
+	Type        string
+	AggregateID uuid.UUID
+	Payload     json.RawMessage
+	Attempts    int
+	CreatedAt   time.Time
+}
+
+type MessagePayload struct {
+	MessageID     uuid.UUID  `json:"message_id"`
+	ChatID        uuid.UUID  `json:"chat_id"`
+	UserID        *uuid.UUID `json:"user_id,omitempty"`
+	Text          string     `json:"text"`
+	Type          string     `json:"type"`
+	HasAttachment bool       `json:"has_attachment,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type ChatMembersPayload struct {
+	ChatID  uuid.UUID   `json:"chat_id"`
+	UserIDs []uuid.UUID `json:"user_ids"`
+}
+
+func NewEvent(eventType string, aggregateID uuid.UUID, dedupKey string, payload any) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("marshal %s payload: %w", eventType, err)
+	}
+
+	return Event{
+		ID:          uuid.New(),
+		DedupKey:    dedupKey,
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     data,
+	}, nil
+}
+
+// MessageEvent событие об изменении сообщения. Ключ включает момент изменения,
+// чтобы каждая правка была отдельным событием, а повтор одной и той же - нет
+func MessageEvent(eventType string, payload MessagePayload) (Event, error) {
+	key := fmt.Sprintf("%s:%s", eventType, payload.MessageID)
+	if eventType == EventMessageUpdated {
+		key = fmt.Sprintf("%s:%d", key, payload.UpdatedAt.UnixNano())
+	}
+
+	return NewEvent(eventType, payload.MessageID, key, payload)
+}
+
+// ChatMembersEvent событие о создании чата или добавлении в него участников
+func ChatMembersEvent(eventType string, payload ChatMembersPayload) (Event, error) {
+	key := fmt.Sprintf("%s:%s", eventType, payload.ChatID)
+	if eventType == EventChatMembersAdded {
+		// Одни и те же пользователи могут снова вступить после выхода, поэтому ключ уникален для каждой вставки
+		key = fmt.Sprintf("%s:%s", key, uuid.New())
+	}
+
+	return NewEvent(eventType, payload.ChatID, key, payload)
+}
+
+func (e Event) MessagePayload() (MessagePayload, error) {
+	var payload MessagePayload
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return MessagePayload{}, fmt.Errorf("unmarshal %s payload: %w", e.Type, err)
+	}
+
+	return payload, nil
+}
+
+func (e Event) ChatMembersPayload() (ChatMembersPayload, error) {
+	var payload ChatMembersPayload
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return ChatMembersPayload{}, fmt.Errorf("unmarshal %s payload: %w", e.Type, err)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// Setting - поле профиля или действие, доступ к которому ограничивает пользователь
+type Setting string
+
+const (
+	SettingPhoneNumber Setting = "phone_number"
+	SettingAvatar      Setting = "avatar"
+	SettingLastSeen    Setting = "last_seen"
+	SettingBio         Setting = "bio"
+	SettingGroupAdd    Setting = "group_add"
+	SettingBirthday    Setting = "birthday"
+)
+
+// Settings перечисляет все настройки в порядке вывода клиенту
+var Settings = []Setting{SettingPhoneNumber, SettingAvatar, SettingLastSeen, SettingBio, SettingGroupAdd, SettingBirthday}
+
+// Visibility - кому разрешено
+type Visibility string
+
+const (
+	VisibilityEverybody Visibility = "everybody"
+	VisibilityContacts  Visibility = "contacts"
+	VisibilityNobody    Visibility = "nobody"
+)
+
+func IsValidSetting(setting Setting) bool {
+	for _, s := range Settings {
+		if s == setting {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidVisibility(visibility Visibility) bool {
+	switch visibility {
+	case VisibilityEverybody, VisibilityContacts, VisibilityNobody:
+		return true
+	}
+	return false
+}
+
+// Rule - правило для одной настройки с исключениями
+type Rule struct {
+	Setting    Setting
+	Visibility Visibility
+	// AllowUserIDs видят поле независимо от Visibility
+	AllowUserIDs []uuid.UUID
+	// DenyUserIDs не видят поле независимо от Visibility; запрет сильнее разрешения
+	DenyUserIDs []uuid.UUID
+}
+
+// DefaultRule - правило для пользователя, который не менял настройку.
+// Номер телефона и день рождения по умолчанию видят только контакты, остальное - все
+func DefaultRule(setting Setting) *Rule {
+	visibility := VisibilityEverybody
+	if setting == SettingPhoneNumber || setting == SettingBirthday {
+		visibility = VisibilityContacts
+	}
+	return &Rule{Setting: setting, Visibility: visibility}
+}
+
+// Allows сообщает, разрешено ли viewerID; isContact - есть ли viewerID в контактах владельца правила
+func (r *Rule) Allows(viewerID uuid.UUID, isContact bool) bool {
+	for _, id := range r.DenyUserIDs {
+		if id == viewerID {
+			return false
+		}
+	}
+	for _, id := range r.AllowUserIDs {
+		if id == viewerID {
+			return true
+		}
+	}
+
+	switch r.Visibility {
+	case VisibilityEverybody:
+		return true
+	case VisibilityContacts:
+		return isContact
+	default:
+		return false
+	}
+}
+
+// UserRules - правила одного пользователя; для отсутствующих настроек действует DefaultRule
+type UserRules map[Setting]*Rule
+
+func (u UserRules) Rule(setting Setting) *Rule {
+	if rule, ok := u[setting]; ok {
+		return rule
+	}
+	return DefaultRule(setting)
+}
+
+// Access отвечает, что из профилей владельцев разрешено одному зрителю
+type Access struct {
+	viewerID  uuid.UUID
+	rules     map[uuid.UUID]UserRules
+	contactOf map[uuid.UUID]bool
+}
+
+// NewAccess собирает проверку для viewerID; contactOwners - владельцы, у которых viewerID есть в контактах
+func NewAccess(viewerID uuid.UUID, rules map[uuid.UUID]UserRules, contactOwners []uuid.UUID) *Access {
+	contactOf := make(map[uuid.UUID]bool, len(contactOwners))
+	for _, id := range contactOwners {
+		contactOf[id] = true
+	}
+	return &Access{viewerID: viewerID, rules: rules, contactOf: contactOf}
+}
+
+// Allows - владелец всегда видит свой профиль полностью
+func (a *Access) Allows(ownerID uuid.UUID, setting Setting) bool {
+	if ownerID == a.viewerID {
+		return true
+	}
+	return a.rules[ownerID].Rule(setting).Allows(a.viewerID, a.contactOf[ownerID])
+}
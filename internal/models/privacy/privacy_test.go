@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRule_Allows(t *testing.T) {
+	viewerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		rule      Rule
+		isContact bool
+		want      bool
+	}{
+		{"everybody", Rule{Visibility: VisibilityEverybody}, false, true},
+		{"contacts, not a contact", Rule{Visibility: VisibilityContacts}, false, false},
+		{"contacts, contact", Rule{Visibility: VisibilityContacts}, true, true},
+		{"nobody, contact", Rule{Visibility: VisibilityNobody}, true, false},
+		{"nobody, allowed", Rule{Visibility: VisibilityNobody, AllowUserIDs: []uuid.UUID{viewerID}}, false, true},
+		{"everybody, denied", Rule{Visibility: VisibilityEverybody, DenyUserIDs: []uuid.UUID{viewerID}}, true, false},
+		{"deny wins over allow", Rule{Visibility: VisibilityEverybody, AllowUserIDs: []uuid.UUID{viewerID}, DenyUserIDs: []uuid.UUID{viewerID}}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Allows(viewerID, tt.isContact))
+		})
+	}
+}
+
+func TestUserRules_Rule_Default(t *testing.T) {
+	var rules UserRules
+
+	assert.Equal(t, VisibilityContacts, rules.Rule(SettingPhoneNumber).Visibility)
+	assert.Equal(t, VisibilityEverybody, rules.Rule(SettingAvatar).Visibility)
+	assert.Equal(t, VisibilityContacts, rules.Rule(SettingBirthday).Visibility)
+}
+
+func TestAccess_Allows(t *testing.T) {
+	viewerID, friendID, strangerID := uuid.New(), uuid.New(), uuid.New()
+
+	access := NewAccess(viewerID, map[uuid.UUID]UserRules{
+		strangerID: {SettingBio: {Setting: SettingBio, Visibility: VisibilityNobody}},
+	}, []uuid.UUID{friendID})
+
+	assert.True(t, access.Allows(friendID, SettingPhoneNumber))
+	assert.False(t, access.Allows(strangerID, SettingPhoneNumber))
+	assert.False(t, access.Allows(strangerID, SettingBio))
+	assert.True(t, access.Allows(strangerID, SettingAvatar))
+	assert.True(t, access.Allows(viewerID, SettingPhoneNumber))
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const (
+	PlatformFCM     = "fcm"
+	PlatformAPNs    = "apns"
+	PlatformWebPush = "webpush"
+)
+
+// Platforms поддерживаемые провайдеры push-уведомлений
+var Platforms = []string{PlatformFCM, PlatformAPNs, PlatformWebPush}
+
+func IsValidPlatform(platform string) bool {
+	return slices.Contains(Platforms, platform)
+}
+
+// Target токен устройства, привязанный к сессии пользователя.
+// Для Web Push в Token хранится JSON подписки браузера (endpoint и ключи)
+type Target struct {
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	Platform  string
+	Token     string
+}
+
+// Notification уведомление, которое провайдер доставляет на устройство
+type Notification struct {
+	ChatID uuid.UUID
+	Title  string
+	Body   string
+	// CollapseKey - уведомления с одним ключом заменяют друг друга на устройстве
+	CollapseKey string
+	// Count - сколько сообщений объединено в уведомлении
+	Count     int
+	Mentioned bool
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,27 @@ type Session struct {
 	ID         uuid.UUID
 	UserID     uuid.UUID
 	Device     string
+	IP         string
+	OS         string
+	Browser    string
+	AppVersion string
+	Location   string
 	Created_at time.Time
 	Last_seen  time.Time
 }
+
+// DeviceInfo метаданные клиента, с которого создаётся сессия
+type DeviceInfo struct {
+	Device     string
+	IP         string
+	OS         string
+	Browser    string
+	AppVersion string
+	Location   string
+}
+
+// Fingerprint возвращает ключ устройства без учёта IP и версий,
+// чтобы смена сети или обновление браузера не считались новым устройством
+func (d DeviceInfo) Fingerprint() string {
+	return strings.ToLower(strings.TrimSpace(d.OS) + "|" + strings.TrimSpace(d.Browser))
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ScopeRead разрешает только читающие запросы (GET, HEAD)
+	ScopeRead = "read"
+	// ScopeWrite разрешает изменяющие запросы
+	ScopeWrite = "write"
+)
+
+var KnownScopes = []string{ScopeRead, ScopeWrite}
+
+type Token struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	// AccountType - тип аккаунта владельца, заполняется только при проверке токена
+	AccountType string
+}
+
+func (t *Token) HasScope(scope string) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+func IsKnownScope(scope string) bool {
+	return slices.Contains(KnownScopes, scope)
+}
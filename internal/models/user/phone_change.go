@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ограничения смены номера телефона
+const (
+	PhoneChangeCodeLength     = 6
+	PhoneChangeCodeTTL        = 10 * time.Minute
+	PhoneChangeMaxAttempts    = 5
+	PhoneChangeResendInterval = time.Minute
+)
+
+// PhoneChange - ожидающая подтверждения смена номера. Сам код не хранится, только его хэш
+type PhoneChange struct {
+	UserID    uuid.UUID
+	NewPhone  string
+	CodeHash  string
+	SentAt    time.Time
+	ExpiresAt time.Time
+}
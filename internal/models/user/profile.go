@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+)
+
+// Ограничения расширенного профиля
+const (
+	MaxStatusEmojiLength = 8 // в рунах: эмодзи с модификаторами состоит из нескольких
+	MaxStatusTextLength  = 70
+	MaxLinks             = 5
+	MaxLinkTitleLength   = 32
+	MaxLinkURLLength     = 256
+	MinBirthdayYear      = 1900
+)
+
+// BirthdayLayout - формат дня рождения в API
+const BirthdayLayout = "2006-01-02"
+
+// Status - статус пользователя: эмодзи и/или текст с необязательным сроком действия
+type Status struct {
+	Emoji     string
+	Text      string
+	ExpiresAt *time.Time // nil - статус бессрочный
+}
+
+// IsEmpty - статус без эмодзи и текста; сохранение такого статуса сбрасывает текущий
+func (s Status) IsEmpty() bool {
+	return s.Emoji == "" && s.Text == ""
+}
+
+// ActiveAt сообщает, показывается ли статус в момент now
+func (s *Status) ActiveAt(now time.Time) bool {
+	if s == nil || s.IsEmpty() {
+		return false
+	}
+	return s.ExpiresAt == nil || s.ExpiresAt.After(now)
+}
+
+// Link - ссылка в профиле
+type Link struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// Profile - поля профиля сверх основных: статус, день рождения, ссылки и время последнего визита
+type Profile struct {
+	Status   *Status
+	Birthday *time.Time
+	Links    []Link
+	// LastSeenAt - когда закрылось последнее соединение пользователя; nil, если он ещё не заходил
+	LastSeenAt *time.Time
+}
+
+// InfoUpdate - изменение профиля; nil-поля остаются прежними
+type InfoUpdate struct {
+	Name     *string
+	Username *string
+	Bio      *string
+	// Status с пустыми эмодзи и текстом сбрасывает статус
+	Status *Status
+	// Birthday с нулевым временем удаляет день рождения
+	Birthday *time.Time
+	// Links заменяет ссылки целиком; пустой список удаляет все
+	Links *[]Link
+}
+
+// IsEmpty - в изменении нет ни одного поля
+func (u InfoUpdate) IsEmpty() bool {
+	return u.Name == nil && u.Username == nil && u.Bio == nil && u.Status == nil && u.Birthday == nil && u.Links == nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_ActiveAt(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	tests := []struct {
+		name   string
+		status *Status
+		want   bool
+	}{
+		{"nil", nil, false},
+		{"empty", &Status{}, false},
+		{"emoji only", &Status{Emoji: "🌴"}, true},
+		{"text without expiry", &Status{Text: "в отпуске"}, true},
+		{"not expired", &Status{Text: "на встрече", ExpiresAt: &future}, true},
+		{"expired", &Status{Text: "на встрече", ExpiresAt: &past}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.status.ActiveAt(now))
+		})
+	}
+}
+
+func TestInfoUpdate_IsEmpty(t *testing.T) {
+	assert.True(t, InfoUpdate{}.IsEmpty())
+	assert.False(t, InfoUpdate{Links: &[]Link{}}.IsEmpty())
+	assert.False(t, InfoUpdate{Status: &Status{}}.IsEmpty())
+}
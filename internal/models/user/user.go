@@ -10,6 +10,15 @@ const (
 	UserAccount     = "user"
 	PremiumAccount  = "premium"
 	VerifiedAccount = "verified"
+	BotAccount      = "bot"
+)
+
+// Ограничения глобального поиска людей
+const (
+	MinSearchQueryLength = 2 // более короткие префиксы в индекс не попадают
+	DefaultSearchLimit   = 20
+	MaxSearchLimit       = 50
+	MaxSearchWindow      = 10000 // offset+limit, дальше OpenSearch выдачу не листает
 )
 
 type User struct {
@@ -23,3 +32,19 @@ type User struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// UnknownUserName подставляется вместо имени пользователя, которого не удалось найти
+const UnknownUserName = "Unknown"
+
+// NamesByIDs возвращает имена в порядке ids; для отсутствующих в users - UnknownUserName
+func NamesByIDs(ids []uuid.UUID, users map[uuid.UUID]*User) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if user, ok := users[id]; ok {
+			names = append(names, user.Name)
+			continue
+		}
+		names = append(names, UnknownUserName)
+	}
+	return names
+}
@@ -0,0 +1,66 @@
+package mtls
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// healthServicePrefix - проверки здоровья доступны любому сервису с валидным сертификатом
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// CallerService возвращает имя вызывающего сервиса из CN его проверенного клиентского сертификата
+func CallerService(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return "", false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	name := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
+}
+
+func authorize(ctx context.Context, fullMethod string, allowed []string) error {
+	caller, ok := CallerService(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+
+	if len(allowed) == 0 || strings.HasPrefix(fullMethod, healthServicePrefix) || slices.Contains(allowed, caller) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "service %q is not allowed to call %s", caller, fullMethod)
+}
+
+// UnaryServerInterceptor пропускает только вызовы от сервисов из allowlist.
+// Пустой allowlist разрешает любой сервис с сертификатом от общего CA
+func UnaryServerInterceptor(allowed []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod, allowed); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - то же для стримов
+func StreamServerInterceptor(allowed []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod, allowed); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
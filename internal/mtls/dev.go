@@ -0,0 +1,181 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	devCAFileName    = "ca.crt"
+	devLockFileName  = ".generating"
+	devCertLifetime  = 30 * 24 * time.Hour
+	devLockWaitLimit = 30 * time.Second
+)
+
+// devServiceHosts - DNS-имена сервисов в docker-compose, которые попадают в SAN их сертификатов
+var devServiceHosts = map[string][]string{
+	ServiceGateway: {"app"},
+	ServiceAuth:    {"auth-service"},
+	ServiceUser:    {"user-service"},
+	ServiceChats:   {"chats-service"},
+}
+
+// DevCertificate - PEM-сертификат и ключ одного сервиса
+type DevCertificate struct {
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// DevBundle - одноразовый CA и подписанные им сертификаты всех сервисов.
+// Ключ CA не сохраняется, поэтому выпустить новые сертификаты этим CA уже нельзя
+type DevBundle struct {
+	CAPEM    []byte
+	Services map[string]DevCertificate
+}
+
+// GenerateDevBundle создаёт одноразовый CA и сертификаты для gateway, auth, user и chats.
+// Каждый сертификат годится и для сервера, и для клиента: CN - имя сервиса, SAN - его хосты и localhost
+func GenerateDevBundle() (*DevBundle, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ca key: %w", err)
+	}
+
+	now := time.Now()
+	caTemplate := &x509.Certificate{
+		SerialNumber:          newSerial(),
+		Subject:               pkix.Name{CommonName: "gramm dev internal CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(devCertLifetime),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ca certificate: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ca certificate: %w", err)
+	}
+
+	bundle := &DevBundle{
+		CAPEM:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		Services: make(map[string]DevCertificate, len(devServiceHosts)),
+	}
+
+	for name, hosts := range devServiceHosts {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate key for %s: %w", name, err)
+		}
+
+		template := &x509.Certificate{
+			SerialNumber: newSerial(),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     append([]string{name, "localhost"}, hosts...),
+			IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     now.Add(devCertLifetime),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create certificate for %s: %w", name, err)
+		}
+
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal key for %s: %w", name, err)
+		}
+
+		bundle.Services[name] = DevCertificate{
+			CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		}
+	}
+
+	return bundle, nil
+}
+
+// WriteFiles сохраняет бандл в dir: ca.crt и <service>.crt/<service>.key.
+// ca.crt пишется последним и служит признаком того, что бандл записан целиком
+func (b *DevBundle) WriteFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for name, cert := range b.Services {
+		if err := os.WriteFile(filepath.Join(dir, name+".crt"), cert.CertPEM, 0o644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".key"), cert.KeyPEM, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(filepath.Join(dir, devCAFileName), b.CAPEM, 0o644)
+}
+
+// EnsureDevCertificates генерирует одноразовый бандл в dir, если его там ещё нет.
+// Сервисы из docker-compose стартуют одновременно с общей директорией,
+// поэтому генерирует только тот, кто первым создал lock-файл, а остальные ждут ca.crt
+func EnsureDevCertificates(dir string) error {
+	caPath := filepath.Join(dir, devCAFileName)
+	if _, err := os.Stat(caPath); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	lockPath := filepath.Join(dir, devLockFileName)
+	lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return waitForFile(caPath, devLockWaitLimit)
+	}
+	if err != nil {
+		return err
+	}
+	lock.Close()
+	defer os.Remove(lockPath)
+
+	bundle, err := GenerateDevBundle()
+	if err != nil {
+		return err
+	}
+	return bundle.WriteFiles(dir)
+}
+
+func waitForFile(path string, limit time.Duration) error {
+	deadline := time.Now().Add(limit)
+	for time.Now().Before(deadline) {
+		if _, err := os.Stat(path); err == nil {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("timed out waiting for %s", path)
+}
+
+func newSerial() *big.Int {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 62))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+	return serial
+}
@@ -0,0 +1,95 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Имена сервисов, которые записываются в CN их сертификатов и используются в allowlist
+const (
+	ServiceGateway = "gateway"
+	ServiceAuth    = "auth"
+	ServiceUser    = "user"
+	ServiceChats   = "chats"
+)
+
+// ClientCredentials возвращает транспортные креды для исходящих вызовов:
+// mTLS, если он включён в конфиге, иначе незашифрованное соединение
+func ClientCredentials(conf *config.GRPCConfig) (credentials.TransportCredentials, error) {
+	if conf == nil || !conf.TLSEnabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	cert, pool, err := loadKeyPair(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// ServerName не задаём - grpc подставит хост из адреса сервиса, он же есть в SAN его сертификата
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ServerOptions возвращает опции gRPC-сервера для mTLS: обязательный клиентский сертификат,
+// подписанный общим CA, и проверку имени вызывающего сервиса по allowlist.
+// Если mTLS выключен, опций нет. Опции нужно передавать первыми, чтобы проверка шла до остальных интерцепторов
+func ServerOptions(conf *config.GRPCConfig) ([]grpc.ServerOption, error) {
+	if conf == nil || !conf.TLSEnabled {
+		return nil, nil
+	}
+
+	cert, pool, err := loadKeyPair(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	})
+
+	return []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor(conf.TLSAllowedClients)),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor(conf.TLSAllowedClients)),
+	}, nil
+}
+
+func loadKeyPair(conf *config.GRPCConfig) (tls.Certificate, *x509.CertPool, error) {
+	if conf.TLSDevMode {
+		if err := EnsureDevCertificates(filepath.Dir(conf.TLSCAFile)); err != nil {
+			return tls.Certificate{}, nil, fmt.Errorf("failed to prepare dev certificates: %w", err)
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(conf.TLSCertFile, conf.TLSKeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load grpc tls key pair: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(conf.TLSCAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read grpc tls ca: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, errors.New("grpc tls ca file contains no certificates")
+	}
+
+	return cert, pool, nil
+}
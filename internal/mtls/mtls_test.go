@@ -0,0 +1,157 @@
+package mtls
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func serviceConfig(dir, name string, allowed ...string) *config.GRPCConfig {
+	return &config.GRPCConfig{
+		TLSEnabled:        true,
+		TLSDevMode:        true,
+		TLSCAFile:         filepath.Join(dir, "ca.crt"),
+		TLSCertFile:       filepath.Join(dir, name+".crt"),
+		TLSKeyFile:        filepath.Join(dir, name+".key"),
+		TLSAllowedClients: allowed,
+	}
+}
+
+// startServer поднимает user service с mTLS на bufconn и возвращает dialer к нему
+func startServer(t *testing.T, conf *config.GRPCConfig) func(context.Context, string) (net.Conn, error) {
+	t.Helper()
+
+	opts, err := ServerOptions(conf)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(opts...)
+	gen.RegisterUserServiceServer(server, &gen.UnimplementedUserServiceServer{})
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	return func(context.Context, string) (net.Conn, error) { return lis.Dial() }
+}
+
+func dial(t *testing.T, dialer func(context.Context, string) (net.Conn, error), conf *config.GRPCConfig) *grpc.ClientConn {
+	t.Helper()
+
+	creds, err := ClientCredentials(conf)
+	require.NoError(t, err)
+
+	conn, err := grpc.NewClient("passthrough:///localhost", grpc.WithTransportCredentials(creds), grpc.WithContextDialer(dialer))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestMTLS_AllowedClient(t *testing.T) {
+	dir := t.TempDir()
+	dialer := startServer(t, serviceConfig(dir, ServiceUser, ServiceGateway))
+
+	client := gen.NewUserServiceClient(dial(t, dialer, serviceConfig(dir, ServiceGateway)))
+	_, err := client.GetUserById(context.Background(), &gen.GetUserByIdReq{})
+
+	// сервер без реализации - если дошли до обработчика, allowlist пропустил вызов
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestMTLS_ClientNotInAllowlist(t *testing.T) {
+	dir := t.TempDir()
+	dialer := startServer(t, serviceConfig(dir, ServiceUser, ServiceGateway))
+
+	conn := dial(t, dialer, serviceConfig(dir, ServiceChats))
+
+	_, err := gen.NewUserServiceClient(conn).GetUserById(context.Background(), &gen.GetUserByIdReq{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// проверка здоровья доступна любому сервису с валидным сертификатом
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+}
+
+func TestMTLS_EmptyAllowlistAcceptsAnyService(t *testing.T) {
+	dir := t.TempDir()
+	dialer := startServer(t, serviceConfig(dir, ServiceUser))
+
+	client := gen.NewUserServiceClient(dial(t, dialer, serviceConfig(dir, ServiceChats)))
+	_, err := client.GetUserById(context.Background(), &gen.GetUserByIdReq{})
+
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestMTLS_RejectsClientWithoutCertificate(t *testing.T) {
+	dir := t.TempDir()
+	dialer := startServer(t, serviceConfig(dir, ServiceUser))
+
+	conn, err := grpc.NewClient("passthrough:///localhost", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(dialer))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = gen.NewUserServiceClient(conn).GetUserById(context.Background(), &gen.GetUserByIdReq{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestMTLS_RejectsCertificateFromOtherCA(t *testing.T) {
+	dialer := startServer(t, serviceConfig(t.TempDir(), ServiceUser))
+
+	// другой одноразовый CA - сертификат клиента не должен пройти проверку
+	client := gen.NewUserServiceClient(dial(t, dialer, serviceConfig(t.TempDir(), ServiceGateway)))
+	_, err := client.GetUserById(context.Background(), &gen.GetUserByIdReq{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestClientCredentials_Disabled(t *testing.T) {
+	creds, err := ClientCredentials(&config.GRPCConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+
+	opts, err := ServerOptions(&config.GRPCConfig{})
+	require.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
+func TestServerOptions_MissingCertificate(t *testing.T) {
+	conf := serviceConfig(t.TempDir(), ServiceUser)
+	conf.TLSDevMode = false
+
+	_, err := ServerOptions(conf)
+	assert.Error(t, err)
+}
+
+func TestEnsureDevCertificates_KeepsExistingBundle(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, EnsureDevCertificates(dir))
+
+	before, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+
+	require.NoError(t, EnsureDevCertificates(dir))
+
+	after, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	for _, name := range []string{ServiceGateway, ServiceAuth, ServiceUser, ServiceChats} {
+		assert.FileExists(t, filepath.Join(dir, name+".crt"))
+		assert.FileExists(t, filepath.Join(dir, name+".key"))
+	}
+	assert.NoFileExists(t, filepath.Join(dir, devLockFileName))
+}
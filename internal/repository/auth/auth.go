@@ -21,6 +21,14 @@ const (
 		INSERT INTO "user" (id, username, name, phone_number, password_hash, user_type, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6::user_type_enum, $7, $8)
 		RETURNING id, username, phone_number, user_type`
+
+	// Старый номер читается из того же снимка строки, поэтому замена и его получение атомарны
+	updatePhoneNumberQuery = `
+		UPDATE "user" u
+		SET phone_number = $2, updated_at = NOW()
+		FROM "user" old
+		WHERE u.id = $1 AND old.id = u.id
+		RETURNING old.phone_number`
 )
 
 const (
@@ -119,6 +127,41 @@ func (r *AuthRepository) CreateUser(ctx context.Context, name string, phone stri
 	return user, nil
 }
 
+// UpdatePhoneNumber заменяет номер телефона пользователя и возвращает прежний номер
+func (r *AuthRepository) UpdatePhoneNumber(ctx context.Context, userID uuid.UUID, phone string) (string, error) {
+	const op = "AuthRepository.UpdatePhoneNumber"
+	const query = "UPDATE user phone_number"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+	queryStatus := "success"
+
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	var oldPhone string
+	err := r.db.QueryRow(ctx, updatePhoneNumberQuery, userID, phone).Scan(&oldPhone)
+	if err != nil {
+		queryStatus = "fail"
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.WithError(err).Errorf("db query: %s: user not found: status: %s", query, queryStatus)
+			return "", errs.ErrUserNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorUniqueViolationCode {
+			logger.WithError(err).Errorf("db query: %s: duplicate key violation: status: %s", query, queryStatus)
+			return "", errs.ErrIsDuplicateKey
+		}
+
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return oldPhone, nil
+}
+
 func (r *AuthRepository) generateUniqueUsername(ctx context.Context, tx pgx.Tx) (string, error) {
 	const op = "AuthRepository.generateUniqueUsername"
 
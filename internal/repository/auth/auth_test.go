@@ -8,6 +8,7 @@ import (
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -212,6 +213,61 @@ func TestAuthRepository_CreateUser_UsernameCollision(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestAuthRepository_UpdatePhoneNumber_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+
+	mock.ExpectQuery(updatePhoneNumberQuery).
+		WithArgs(userID, "+79990001122").
+		WillReturnRows(pgxmock.NewRows([]string{"phone_number"}).AddRow("+79998887766"))
+
+	oldPhone, err := repo.UpdatePhoneNumber(context.Background(), userID, "+79990001122")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+79998887766", oldPhone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthRepository_UpdatePhoneNumber_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		dbErr       error
+		expectedErr error
+	}{
+		{name: "user not found", dbErr: pgx.ErrNoRows, expectedErr: errs.ErrUserNotFound},
+		{name: "phone taken", dbErr: &pgconn.PgError{Code: "23505"}, expectedErr: errs.ErrIsDuplicateKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("failed to create pgxmock pool: %v", err)
+			}
+			defer mock.Close()
+
+			repo := New(mock)
+			userID := uuid.New()
+
+			mock.ExpectQuery(updatePhoneNumberQuery).
+				WithArgs(userID, "+79990001122").
+				WillReturnError(tt.dbErr)
+
+			oldPhone, err := repo.UpdatePhoneNumber(context.Background(), userID, "+79990001122")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Empty(t, oldPhone)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestNew(t *testing.T) {
 	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
 	if err != nil {
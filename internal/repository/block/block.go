@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/block"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// Повторная блокировка не считается ошибкой
+	blockUserQuery = `
+		INSERT INTO user_block (user_id, blocked_user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, blocked_user_id) DO NOTHING`
+
+	unblockUserQuery = `
+		DELETE FROM user_block
+		WHERE user_id = $1 AND blocked_user_id = $2`
+
+	getBlocksByUserIDQuery = `
+		SELECT user_id, blocked_user_id, created_at
+		FROM user_block
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+
+	// Блокировка действует в обе стороны: возвращаем и тех, кого заблокировал пользователь, и тех, кто заблокировал его
+	getBlockedPeersQuery = `
+		SELECT blocked_user_id FROM user_block
+		WHERE user_id = $1 AND blocked_user_id = ANY($2)
+		UNION
+		SELECT user_id FROM user_block
+		WHERE blocked_user_id = $1 AND user_id = ANY($2)`
+)
+
+type BlockRepository struct {
+	db pgxinterface.PgxPool
+}
+
+func New(db pgxinterface.PgxPool) *BlockRepository {
+	return &BlockRepository{
+		db: db,
+	}
+}
+
+func (r *BlockRepository) BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	const op = "BlockRepository.BlockUser"
+	const query = "INSERT user_block"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("blocked_user_id", blockedUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	_, err := r.db.Exec(ctx, blockUserQuery, userID, blockedUserID)
+	if err != nil {
+		queryStatus = "fail"
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorForeignKeyViolationCode {
+			logger.WithError(err).Errorf("db query: %s: foreign key violation: status: %s", query, queryStatus)
+			return errs.ErrUserNotFound
+		}
+
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	return nil
+}
+
+func (r *BlockRepository) UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	const op = "BlockRepository.UnblockUser"
+	const query = "DELETE user_block"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("blocked_user_id", blockedUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	result, err := r.db.Exec(ctx, unblockUserQuery, userID, blockedUserID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		queryStatus = "fail"
+		logger.Errorf("db query: %s: block not found: status: %s", query, queryStatus)
+		return errs.ErrBlockNotFound
+	}
+
+	return nil
+}
+
+func (r *BlockRepository) GetBlocksByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Block, error) {
+	const op = "BlockRepository.GetBlocksByUserID"
+	const query = "SELECT blocks"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getBlocksByUserIDQuery, userID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []*models.Block
+	for rows.Next() {
+		var block models.Block
+		if err := rows.Scan(&block.UserID, &block.BlockedUserID, &block.CreatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		blocks = append(blocks, &block)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// GetBlockedPeers возвращает тех из peerIDs, кто заблокирован пользователем или сам его заблокировал
+func (r *BlockRepository) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "BlockRepository.GetBlockedPeers"
+	const query = "SELECT blocked peers"
+
+	if len(peerIDs) == 0 {
+		return nil, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("peers_count", len(peerIDs))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getBlockedPeersQuery, userID, peerIDs)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocked []uuid.UUID
+	for rows.Next() {
+		var peerID uuid.UUID
+		if err := rows.Scan(&peerID); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		blocked = append(blocked, peerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return blocked, nil
+}
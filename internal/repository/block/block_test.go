@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockRepo(t *testing.T) (pgxmock.PgxPoolIface, *BlockRepository) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	t.Cleanup(mock.Close)
+
+	return mock, New(mock)
+}
+
+func TestBlockRepository_BlockUser_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(blockUserQuery).
+		WithArgs(userID, blockedID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err := repo.BlockUser(context.Background(), userID, blockedID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_BlockUser_UserNotFound(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(blockUserQuery).
+		WithArgs(userID, blockedID).
+		WillReturnError(&pgconn.PgError{Code: errs.PostgresErrorForeignKeyViolationCode})
+
+	err := repo.BlockUser(context.Background(), userID, blockedID)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_UnblockUser_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(unblockUserQuery).
+		WithArgs(userID, blockedID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err := repo.UnblockUser(context.Background(), userID, blockedID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_UnblockUser_NotFound(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(unblockUserQuery).
+		WithArgs(userID, blockedID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.UnblockUser(context.Background(), userID, blockedID)
+
+	assert.ErrorIs(t, err, errs.ErrBlockNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_GetBlocksByUserID_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID := uuid.New(), uuid.New()
+	createdAt := time.Now()
+
+	mock.ExpectQuery(getBlocksByUserIDQuery).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "blocked_user_id", "created_at"}).
+			AddRow(userID, blockedID, createdAt))
+
+	blocks, err := repo.GetBlocksByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, blockedID, blocks[0].BlockedUserID)
+	assert.Equal(t, createdAt, blocks[0].CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_GetBlocksByUserID_QueryError(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID := uuid.New()
+
+	mock.ExpectQuery(getBlocksByUserIDQuery).
+		WithArgs(userID).
+		WillReturnError(errors.New("db error"))
+
+	blocks, err := repo.GetBlocksByUserID(context.Background(), userID)
+
+	assert.Error(t, err)
+	assert.Nil(t, blocks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_GetBlockedPeers_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, blockedID, freeID := uuid.New(), uuid.New(), uuid.New()
+	peers := []uuid.UUID{blockedID, freeID}
+
+	mock.ExpectQuery(getBlockedPeersQuery).
+		WithArgs(userID, peers).
+		WillReturnRows(pgxmock.NewRows([]string{"blocked_user_id"}).AddRow(blockedID))
+
+	blocked, err := repo.GetBlockedPeers(context.Background(), userID, peers)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{blockedID}, blocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlockRepository_GetBlockedPeers_EmptyPeers(t *testing.T) {
+	mock, repo := newMockRepo(t)
+
+	blocked, err := repo.GetBlockedPeers(context.Background(), uuid.New(), nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, blocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
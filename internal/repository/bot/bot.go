@@ -0,0 +1,318 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/bot"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	userModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	handleRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/handle"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	createBotUserQuery = `
+		INSERT INTO "user" (id, username, name, phone_number, password_hash, user_type, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6::user_type_enum, $7, $7)`
+
+	createBotQuery = `
+		INSERT INTO bot (user_id, owner_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)`
+
+	getBotsByOwnerIDQuery = `
+		SELECT b.user_id, b.owner_id, u.name, u.username, b.webhook_url, b.created_at
+		FROM bot b
+		JOIN "user" u ON u.id = b.user_id
+		WHERE b.owner_id = $1
+		ORDER BY b.created_at`
+
+	getBotQuery = `
+		SELECT b.user_id, b.owner_id, u.name, u.username, b.webhook_url, b.created_at
+		FROM bot b
+		JOIN "user" u ON u.id = b.user_id
+		WHERE b.user_id = $1 AND b.owner_id = $2`
+
+	deleteBotQuery = `
+		DELETE FROM "user" u
+		USING bot b
+		WHERE u.id = b.user_id AND b.user_id = $1 AND b.owner_id = $2`
+
+	updateBotWebhookQuery = `
+		UPDATE bot
+		SET webhook_url = $3, webhook_secret = $4
+		WHERE user_id = $1 AND owner_id = $2`
+
+	getChatWebhooksQuery = `
+		SELECT b.user_id, u.username, b.webhook_url, b.webhook_secret
+		FROM chat_member cm
+		JOIN bot b ON b.user_id = cm.user_id
+		JOIN "user" u ON u.id = b.user_id
+		WHERE cm.chat_id = $1 AND b.webhook_url IS NOT NULL`
+)
+
+type BotRepository struct {
+	db pgxinterface.PgxPool
+}
+
+func New(db pgxinterface.PgxPool) *BotRepository {
+	return &BotRepository{
+		db: db,
+	}
+}
+
+// NewWithPool создает репозиторий с конкретным типом *pgxpool.Pool
+func NewWithPool(db *pgxpool.Pool) *BotRepository {
+	return &BotRepository{
+		db: db,
+	}
+}
+
+// CreateBot создает пользователя с типом bot и привязывает его к владельцу в одной транзакции
+func (r *BotRepository) CreateBot(ctx context.Context, bot *models.Bot, phone, passwordHash string) error {
+	const op = "BotRepository.CreateBot"
+	const query = "INSERT bot"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("owner_id", bot.OwnerID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: begin transaction: status: %s", query, queryStatus)
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Пространство handle общее с пользователями и чатами, в том числе с их прежними handle
+	if err := handleRepo.Claim(ctx, tx, bot.UserID, bot.Username); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: claim username: status: %s", query, queryStatus)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, createBotUserQuery,
+		bot.UserID, bot.Username, bot.Name, phone, passwordHash, userModels.BotAccount, bot.CreatedAt)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert user: status: %s", query, queryStatus)
+		return mapInsertError(err)
+	}
+
+	_, err = tx.Exec(ctx, createBotQuery, bot.UserID, bot.OwnerID, bot.CreatedAt)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert bot: status: %s", query, queryStatus)
+		return mapInsertError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: commit transaction: status: %s", query, queryStatus)
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func mapInsertError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case errs.PostgresErrorUniqueViolationCode:
+			return errs.ErrIsDuplicateKey
+		case errs.PostgresErrorForeignKeyViolationCode:
+			return errs.ErrUserNotFound
+		}
+	}
+
+	return err
+}
+
+func (r *BotRepository) GetBotsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*models.Bot, error) {
+	const op = "BotRepository.GetBotsByOwnerID"
+	const query = "SELECT bots by owner"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("owner_id", ownerID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getBotsByOwnerIDQuery, ownerID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	bots := make([]*models.Bot, 0)
+	for rows.Next() {
+		var bot models.Bot
+		if err := rows.Scan(&bot.UserID, &bot.OwnerID, &bot.Name, &bot.Username, &bot.WebhookURL, &bot.CreatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		bots = append(bots, &bot)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return bots, nil
+}
+
+// GetBot возвращает бота, только если он принадлежит ownerID
+func (r *BotRepository) GetBot(ctx context.Context, ownerID, botID uuid.UUID) (*models.Bot, error) {
+	const op = "BotRepository.GetBot"
+	const query = "SELECT bot"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("owner_id", ownerID.String()).
+		WithField("bot_id", botID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	var bot models.Bot
+	err := r.db.QueryRow(ctx, getBotQuery, botID, ownerID).
+		Scan(&bot.UserID, &bot.OwnerID, &bot.Name, &bot.Username, &bot.WebhookURL, &bot.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			queryStatus = "not found"
+			logger.Debugf("db query: %s: bot not found: status: %s", query, queryStatus)
+			return nil, errs.ErrNotFound
+		}
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return &bot, nil
+}
+
+// DeleteBot удаляет пользователя-бота, его токены и членство в чатах удаляются каскадно
+func (r *BotRepository) DeleteBot(ctx context.Context, ownerID, botID uuid.UUID) error {
+	const op = "BotRepository.DeleteBot"
+	const query = "DELETE bot"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("owner_id", ownerID.String()).
+		WithField("bot_id", botID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	result, err := r.db.Exec(ctx, deleteBotQuery, botID, ownerID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		queryStatus = "not found"
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateWebhook задает адрес и ключ webhook бота. nil в обоих параметрах отключает webhook
+func (r *BotRepository) UpdateWebhook(ctx context.Context, ownerID, botID uuid.UUID, url, secret *string) error {
+	const op = "BotRepository.UpdateWebhook"
+	const query = "UPDATE bot webhook"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("owner_id", ownerID.String()).
+		WithField("bot_id", botID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	result, err := r.db.Exec(ctx, updateBotWebhookQuery, botID, ownerID, url, secret)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		queryStatus = "not found"
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetChatWebhooks возвращает webhook всех ботов, состоящих в чате
+func (r *BotRepository) GetChatWebhooks(ctx context.Context, chatID uuid.UUID) ([]models.Webhook, error) {
+	const op = "BotRepository.GetChatWebhooks"
+	const query = "SELECT chat bot webhooks"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	rows, err := r.db.Query(ctx, getChatWebhooksQuery, chatID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]models.Webhook, 0)
+	for rows.Next() {
+		var webhook models.Webhook
+		if err := rows.Scan(&webhook.BotID, &webhook.Username, &webhook.URL, &webhook.Secret); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return webhooks, nil
+}
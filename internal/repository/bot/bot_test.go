@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/bot"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	userModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var botColumns = []string{"user_id", "owner_id", "name", "username", "webhook_url", "created_at"}
+
+func newTestBot() *models.Bot {
+	return &models.Bot{
+		UserID:    uuid.New(),
+		OwnerID:   uuid.New(),
+		Name:      "CI",
+		Username:  "ci_bot",
+		CreatedAt: time.Now(),
+	}
+}
+
+// expectClaimUsername ожидает проверку handle в общем пространстве имён пользователей и чатов
+func expectClaimUsername(mock pgxmock.PgxPoolIface, bot *models.Bot, taken bool) {
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs("handle:" + bot.Username).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(bot.Username, bot.UserID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(taken))
+	if !taken {
+		mock.ExpectExec(`DELETE FROM username_redirect`).
+			WithArgs(bot.Username).
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	}
+}
+
+func TestBotRepository_CreateBot_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	bot := newTestBot()
+
+	mock.ExpectBegin()
+	expectClaimUsername(mock, bot, false)
+	mock.ExpectExec(regexp.QuoteMeta(createBotUserQuery)).
+		WithArgs(bot.UserID, bot.Username, bot.Name, "+00123456789012", "hash", userModels.BotAccount, bot.CreatedAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(regexp.QuoteMeta(createBotQuery)).
+		WithArgs(bot.UserID, bot.OwnerID, bot.CreatedAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	mock.ExpectRollback()
+
+	err = repo.CreateBot(context.Background(), bot, "+00123456789012", "hash")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_CreateBot_DuplicateUsername(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	bot := newTestBot()
+
+	mock.ExpectBegin()
+	expectClaimUsername(mock, bot, false)
+	mock.ExpectExec(regexp.QuoteMeta(createBotUserQuery)).
+		WithArgs(bot.UserID, bot.Username, bot.Name, "+00123456789012", "hash", userModels.BotAccount, bot.CreatedAt).
+		WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+
+	err = repo.CreateBot(context.Background(), bot, "+00123456789012", "hash")
+
+	assert.Equal(t, errs.ErrIsDuplicateKey, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_CreateBot_UsernameHeldByRedirect(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	bot := newTestBot()
+
+	mock.ExpectBegin()
+	expectClaimUsername(mock, bot, true)
+	mock.ExpectRollback()
+
+	err = repo.CreateBot(context.Background(), bot, "+00123456789012", "hash")
+
+	assert.Equal(t, errs.ErrIsDuplicateKey, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_GetBotsByOwnerID_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	bot := newTestBot()
+	url := "https://example.com/hook"
+
+	mock.ExpectQuery(getBotsByOwnerIDQuery).
+		WithArgs(bot.OwnerID).
+		WillReturnRows(pgxmock.NewRows(botColumns).
+			AddRow(bot.UserID, bot.OwnerID, bot.Name, bot.Username, &url, bot.CreatedAt))
+
+	bots, err := repo.GetBotsByOwnerID(context.Background(), bot.OwnerID)
+
+	assert.NoError(t, err)
+	assert.Len(t, bots, 1)
+	assert.Equal(t, bot.Username, bots[0].Username)
+	assert.Equal(t, url, *bots[0].WebhookURL)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_GetBot_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ownerID := uuid.New()
+	botID := uuid.New()
+
+	mock.ExpectQuery(getBotQuery).
+		WithArgs(botID, ownerID).
+		WillReturnError(pgx.ErrNoRows)
+
+	bot, err := repo.GetBot(context.Background(), ownerID, botID)
+
+	assert.Equal(t, errs.ErrNotFound, err)
+	assert.Nil(t, bot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_DeleteBot_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ownerID := uuid.New()
+	botID := uuid.New()
+
+	mock.ExpectExec(deleteBotQuery).
+		WithArgs(botID, ownerID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err = repo.DeleteBot(context.Background(), ownerID, botID)
+
+	assert.Equal(t, errs.ErrNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_UpdateWebhook_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ownerID := uuid.New()
+	botID := uuid.New()
+	url := "https://example.com/hook"
+	secret := "secret"
+
+	mock.ExpectExec(updateBotWebhookQuery).
+		WithArgs(botID, ownerID, &url, &secret).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateWebhook(context.Background(), ownerID, botID, &url, &secret)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBotRepository_GetChatWebhooks_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	chatID := uuid.New()
+	botID := uuid.New()
+
+	mock.ExpectQuery(getChatWebhooksQuery).
+		WithArgs(chatID).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "username", "webhook_url", "webhook_secret"}).
+			AddRow(botID, "ci_bot", "https://example.com/hook", "secret"))
+
+	webhooks, err := repo.GetChatWebhooks(context.Background(), chatID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Webhook{{BotID: botID, Username: "ci_bot", URL: "https://example.com/hook", Secret: "secret"}}, webhooks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,10 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 func (r *ChatsRepository) DeleteChat(ctx context.Context, userId, chatId uuid.UUID) error {
@@ -44,3 +47,26 @@ func (r *ChatsRepository) DeleteChat(ctx context.Context, userId, chatId uuid.UU
 	logger.Info("Database operation completed successfully: chat deleted")
 	return nil
 }
+
+// DeleteChatAvatar удаляет аватарку из истории чата и возвращает её размеры,
+// чтобы вызывающий мог удалить файлы из хранилища
+func (r *ChatsRepository) DeleteChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) ([]int, error) {
+	const op = "ChatsRepository.DeleteChatAvatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("attachment_id", attachmentID.String())
+	logger.Debug("Starting database operation: delete chat avatar")
+
+	var sizes []int
+	err := r.db.QueryRow(ctx, deleteChatAvatarQuery, chatID, attachmentID).Scan(&sizes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Database operation failed: chat avatar not found")
+			return nil, errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: delete chat avatar")
+		return nil, err
+	}
+
+	logger.Info("Database operation completed successfully: chat avatar deleted")
+	return sizes, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 )
@@ -59,3 +61,47 @@ func TestChatsRepository_DeleteChat_NotAdmin(t *testing.T) {
 	assert.Contains(t, err.Error(), "user is not admin")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestChatsRepository_DeleteChatAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mock.ExpectQuery(deleteChatAvatarQuery).
+		WithArgs(chatID, attachmentID).
+		WillReturnRows(pgxmock.NewRows([]string{"sizes"}).AddRow([]int{640, 320, 160}))
+
+	sizes, err := repo.DeleteChatAvatar(context.Background(), chatID, attachmentID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{640, 320, 160}, sizes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_DeleteChatAvatar_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mock.ExpectQuery(deleteChatAvatarQuery).
+		WithArgs(chatID, attachmentID).
+		WillReturnError(pgx.ErrNoRows)
+
+	sizes, err := repo.DeleteChatAvatar(context.Background(), chatID, attachmentID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, sizes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
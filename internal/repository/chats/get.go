@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	modelsAvatar "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/google/uuid"
@@ -24,7 +25,9 @@ func (r *ChatsRepository) GetChats(ctx context.Context, userId uuid.UUID) ([]mod
 	result := make([]modelsChats.Chat, 0)
 	for rows.Next() {
 		var chat modelsChats.Chat
-		if err := rows.Scan(&chat.ID, &chat.Type, &chat.Name, &chat.Description); err != nil {
+		if err := rows.Scan(&chat.ID, &chat.Type, &chat.Name, &chat.Description,
+			&chat.Settings.IsMuted, &chat.Settings.MutedUntil, &chat.Settings.IsArchived,
+			&chat.Settings.PinOrder, &chat.Settings.Folder); err != nil {
 			logger.WithError(err).Error("Database operation failed: scan chat row")
 			return nil, err
 		}
@@ -188,3 +191,91 @@ func (r *ChatsRepository) SearchChats(ctx context.Context, userID uuid.UUID, nam
 	logger.WithField("chats_count", len(result)).Info("Database operation completed successfully: chats searched")
 	return result, nil
 }
+
+// GetGroupPeers возвращает пользователей, состоящих с userID хотя бы в одной общей группе (не больше limit)
+func (r *ChatsRepository) GetGroupPeers(ctx context.Context, userID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	const op = "ChatsRepository.GetGroupPeers"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+	logger.Debug("Starting database operation: get group peers")
+
+	rows, err := r.db.Query(ctx, getGroupPeersQuery, userID, limit)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get group peers query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var peerID uuid.UUID
+		if err := rows.Scan(&peerID); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan group peer row")
+			return nil, err
+		}
+
+		result = append(result, peerID)
+	}
+
+	logger.WithField("peers_count", len(result)).Info("Database operation completed successfully: group peers retrieved")
+	return result, nil
+}
+
+// GetDialogPartners возвращает собеседников userID по личным диалогам: id собеседника -> id диалога
+func (r *ChatsRepository) GetDialogPartners(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	const op = "ChatsRepository.GetDialogPartners"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+	logger.Debug("Starting database operation: get dialog partners")
+
+	rows, err := r.db.Query(ctx, getDialogPartnersQuery, userID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get dialog partners query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[uuid.UUID]uuid.UUID)
+	for rows.Next() {
+		var partnerID, chatID uuid.UUID
+		if err := rows.Scan(&partnerID, &chatID); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan dialog partner row")
+			return nil, err
+		}
+
+		result[partnerID] = chatID
+	}
+
+	logger.WithField("partners_count", len(result)).Info("Database operation completed successfully: dialog partners retrieved")
+	return result, nil
+}
+
+// GetChatAvatarHistory возвращает аватарки чата, начиная с текущей
+func (r *ChatsRepository) GetChatAvatarHistory(ctx context.Context, chatID uuid.UUID) ([]*modelsAvatar.Avatar, error) {
+	const op = "ChatsRepository.GetChatAvatarHistory"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: get chat avatar history")
+
+	rows, err := r.db.Query(ctx, getChatAvatarHistoryQuery, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get chat avatar history query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*modelsAvatar.Avatar, 0)
+	for rows.Next() {
+		avatar := &modelsAvatar.Avatar{}
+		if err := rows.Scan(&avatar.ID, &avatar.Sizes, &avatar.CreatedAt); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan chat avatar row")
+			return nil, err
+		}
+
+		avatar.IsCurrent = len(result) == 0
+		result = append(result, avatar)
+	}
+
+	logger.WithField("avatars_count", len(result)).Info("Database operation completed successfully: chat avatar history retrieved")
+	return result, nil
+}
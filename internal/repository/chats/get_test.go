@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -24,9 +25,12 @@ func TestChatsRepository_GetChats_Success(t *testing.T) {
 	chatID1 := uuid.New()
 	chatID2 := uuid.New()
 
-	rows := pgxmock.NewRows([]string{"id", "chat_type", "name", "description"}).
-		AddRow(chatID1, "dialog", "Chat 1", "Description 1").
-		AddRow(chatID2, "group", "Chat 2", "Description 2")
+	pinOrder := 1
+	folder := "Work"
+	rows := pgxmock.NewRows([]string{"id", "chat_type", "name", "description",
+		"is_muted", "muted_until", "is_archived", "pin_order", "folder"}).
+		AddRow(chatID1, "dialog", "Chat 1", "Description 1", true, nil, false, &pinOrder, &folder).
+		AddRow(chatID2, "group", "Chat 2", "Description 2", false, nil, true, nil, nil)
 
 	mock.ExpectQuery(getChatsQuery).
 		WithArgs(userID).
@@ -41,6 +45,12 @@ func TestChatsRepository_GetChats_Success(t *testing.T) {
 	assert.Equal(t, "dialog", chats[0].Type)
 	assert.Equal(t, "Chat 1", chats[0].Name)
 	assert.Equal(t, "Description 1", chats[0].Description)
+	assert.True(t, chats[0].Settings.IsMuted)
+	assert.Nil(t, chats[0].Settings.MutedUntil)
+	assert.Equal(t, &pinOrder, chats[0].Settings.PinOrder)
+	assert.Equal(t, &folder, chats[0].Settings.Folder)
+	assert.True(t, chats[1].Settings.IsArchived)
+	assert.Nil(t, chats[1].Settings.PinOrder)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -259,3 +269,151 @@ func TestChatsRepository_GetChatAvatars_EmptyInput(t *testing.T) {
 	assert.Empty(t, avatars)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestChatsRepository_GetGroupPeers_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	peerID1 := uuid.New()
+	peerID2 := uuid.New()
+
+	rows := pgxmock.NewRows([]string{"user_id"}).
+		AddRow(peerID1).
+		AddRow(peerID2)
+
+	mock.ExpectQuery(getGroupPeersQuery).
+		WithArgs(userID, 100).
+		WillReturnRows(rows)
+
+	ctx := context.Background()
+	peers, err := repo.GetGroupPeers(ctx, userID, 100)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{peerID1, peerID2}, peers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetGroupPeers_Error(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(getGroupPeersQuery).
+		WithArgs(userID, 100).
+		WillReturnError(fmt.Errorf("database error"))
+
+	ctx := context.Background()
+	peers, err := repo.GetGroupPeers(ctx, userID, 100)
+
+	assert.Error(t, err)
+	assert.Nil(t, peers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetChatAvatarHistory_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	currentID := uuid.New()
+	previousID := uuid.New()
+	createdAt := time.Now()
+
+	mock.ExpectQuery(getChatAvatarHistoryQuery).
+		WithArgs(chatID).
+		WillReturnRows(pgxmock.NewRows([]string{"attachment_id", "sizes", "created_at"}).
+			AddRow(currentID, []int{640, 320, 160}, createdAt).
+			AddRow(previousID, []int{}, createdAt.Add(-time.Hour)))
+
+	result, err := repo.GetChatAvatarHistory(context.Background(), chatID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, currentID, result[0].ID)
+	assert.True(t, result[0].IsCurrent)
+	assert.Equal(t, previousID, result[1].ID)
+	assert.False(t, result[1].IsCurrent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetChatAvatarHistory_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+
+	mock.ExpectQuery(getChatAvatarHistoryQuery).
+		WithArgs(chatID).
+		WillReturnError(fmt.Errorf("database error"))
+
+	result, err := repo.GetChatAvatarHistory(context.Background(), chatID)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetDialogPartners_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	partnerID, dialogID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(getDialogPartnersQuery).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "chat_id"}).AddRow(partnerID, dialogID))
+
+	partners, err := repo.GetDialogPartners(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]uuid.UUID{partnerID: dialogID}, partners)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetDialogPartners_Error(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(getDialogPartnersQuery).
+		WithArgs(userID).
+		WillReturnError(fmt.Errorf("database error"))
+
+	partners, err := repo.GetDialogPartners(context.Background(), userID)
+
+	assert.Error(t, err)
+	assert.Nil(t, partners)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsHandle "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	handleRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/handle"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SetChatUsername делает группу или канал публичными под handle username; пустой username делает чат приватным.
+// При переименовании прежний handle остаётся редиректом на переходный период
+func (r *ChatsRepository) SetChatUsername(ctx context.Context, chatID uuid.UUID, username string) error {
+	const op = "ChatsRepository.SetChatUsername"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: set chat username")
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if username != "" {
+		if err := handleRepo.Claim(ctx, tx, chatID, username); err != nil {
+			logger.WithError(err).Warn("Database operation failed: claim username")
+			return err
+		}
+	}
+
+	var chatType string
+	var oldUsername *string
+	if err := tx.QueryRow(ctx, getChatUsernameForUpdateQuery, chatID).Scan(&chatType, &oldUsername); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Warn("Database operation failed: chat not found")
+			return errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: get chat username")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if chatType == modelsChats.ChatTypeDialog {
+		logger.Warn("Database operation failed: dialog can not be public")
+		return errs.ErrBadRequest
+	}
+
+	var newUsername *string
+	if username != "" {
+		newUsername = &username
+	}
+
+	if _, err := tx.Exec(ctx, setChatUsernameQuery, newUsername, chatID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorUniqueViolationCode {
+			return errs.ErrIsDuplicateKey
+		}
+		logger.WithError(err).Error("Database operation failed: set chat username")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Редирект нужен только при переименовании: закрытый чат по прежнему handle не находится
+	if oldUsername != nil && username != "" && modelsHandle.Normalize(*oldUsername) != modelsHandle.Normalize(username) {
+		until := time.Now().Add(modelsHandle.RedirectGracePeriod)
+		if err := handleRepo.KeepRedirect(ctx, tx, modelsHandle.OwnerChat, chatID, *oldUsername, until); err != nil {
+			logger.WithError(err).Error("Database operation failed: keep redirect")
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.WithError(err).Error("Database operation failed: commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: chat username set")
+	return nil
+}
+
+// ResolveChatUsername ищет публичный чат по текущему или прежнему handle. redirected - найден по прежнему
+func (r *ChatsRepository) ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*modelsChats.PublicChat, bool, error) {
+	const op = "ChatsRepository.ResolveChatUsername"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("username", username)
+	logger.Debug("Starting database operation: resolve chat username")
+
+	chat := &modelsChats.PublicChat{}
+	var redirected bool
+	err := r.db.QueryRow(ctx, resolveChatUsernameQuery, modelsHandle.Normalize(username), userID).
+		Scan(&chat.ID, &chat.Type, &chat.Name, &chat.Description, &chat.Username, &chat.MembersCount, &chat.IsMember, &redirected)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Database operation completed: public chat not found")
+			return nil, false, errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: resolve chat username query")
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: public chat resolved")
+	return chat, redirected, nil
+}
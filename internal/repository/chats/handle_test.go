@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func expectClaimHandle(mock pgxmock.PgxPoolIface, ownerID uuid.UUID, handle string, taken bool) {
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs("handle:" + handle).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(handle, ownerID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(taken))
+	if !taken {
+		mock.ExpectExec(`DELETE FROM username_redirect`).
+			WithArgs(handle).
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	}
+}
+
+func TestChatsRepository_SetChatUsername_RenameKeepsRedirect(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	oldUsername := "Old_Channel"
+	newUsername := "new_channel"
+
+	mock.ExpectBegin()
+	expectClaimHandle(mock, chatID, newUsername, false)
+	mock.ExpectQuery(`SELECT chat_type::text, username FROM chat WHERE id = \$1 FOR UPDATE`).
+		WithArgs(chatID).
+		WillReturnRows(pgxmock.NewRows([]string{"chat_type", "username"}).AddRow(modelsChats.ChatTypeChannel, &oldUsername))
+	mock.ExpectExec(`UPDATE chat SET username = \$1 WHERE id = \$2`).
+		WithArgs(&newUsername, chatID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO username_redirect \(username, chat_id, expires_at\)`).
+		WithArgs("old_channel", chatID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	err = repo.SetChatUsername(context.Background(), chatID, newUsername)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_SetChatUsername_MakePrivate(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	oldUsername := "old_group"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT chat_type::text, username FROM chat WHERE id = \$1 FOR UPDATE`).
+		WithArgs(chatID).
+		WillReturnRows(pgxmock.NewRows([]string{"chat_type", "username"}).AddRow(modelsChats.ChatTypeGroup, &oldUsername))
+	mock.ExpectExec(`UPDATE chat SET username = \$1 WHERE id = \$2`).
+		WithArgs((*string)(nil), chatID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	err = repo.SetChatUsername(context.Background(), chatID, "")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_SetChatUsername_Taken(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+
+	mock.ExpectBegin()
+	expectClaimHandle(mock, chatID, "taken_name", true)
+	mock.ExpectRollback()
+
+	err = repo.SetChatUsername(context.Background(), chatID, "Taken_Name")
+
+	assert.ErrorIs(t, err, errs.ErrIsDuplicateKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_SetChatUsername_Dialog(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+
+	mock.ExpectBegin()
+	expectClaimHandle(mock, chatID, "our_dialog", false)
+	mock.ExpectQuery(`SELECT chat_type::text, username FROM chat WHERE id = \$1 FOR UPDATE`).
+		WithArgs(chatID).
+		WillReturnRows(pgxmock.NewRows([]string{"chat_type", "username"}).AddRow(modelsChats.ChatTypeDialog, nil))
+	mock.ExpectRollback()
+
+	err = repo.SetChatUsername(context.Background(), chatID, "our_dialog")
+
+	assert.ErrorIs(t, err, errs.ErrBadRequest)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_ResolveChatUsername_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	rows := pgxmock.NewRows([]string{"id", "chat_type", "name", "description", "username", "members_count", "is_member", "redirected"}).
+		AddRow(chatID, modelsChats.ChatTypeChannel, "News", "Daily news", "daily_news", 42, false, false)
+
+	mock.ExpectQuery(resolveChatUsernameQuery).
+		WithArgs("daily_news", userID).
+		WillReturnRows(rows)
+
+	chat, redirected, err := repo.ResolveChatUsername(context.Background(), userID, "@Daily_News")
+
+	assert.NoError(t, err)
+	assert.False(t, redirected)
+	assert.Equal(t, chatID, chat.ID)
+	assert.Equal(t, 42, chat.MembersCount)
+	assert.Equal(t, "daily_news", chat.Username)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_ResolveChatUsername_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	userID := uuid.New()
+
+	mock.ExpectQuery(resolveChatUsernameQuery).
+		WithArgs("ghost", userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	chat, _, err := repo.ResolveChatUsername(context.Background(), userID, "ghost")
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, chat)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
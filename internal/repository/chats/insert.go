@@ -7,6 +7,8 @@ import (
 
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/outbox"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -85,6 +87,13 @@ func (r *ChatsRepository) CreateChat(ctx context.Context, chat modelsChats.Chat,
 		}
 	}
 
+	// 4. Событие о создании чата
+	err = insertChatMembersEvent(ctx, tx, modelsOutbox.EventChatCreated, chat.ID, usersInfo)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: insert outbox event")
+		return err
+	}
+
 	logger.Debug("Committing database transaction")
 	if err := tx.Commit(ctx); err != nil {
 		logger.WithError(err).Error("Database operation failed: commit transaction")
@@ -114,6 +123,12 @@ func (r *ChatsRepository) InsertUsersToChat(ctx context.Context, chatID uuid.UUI
 		return err
 	}
 
+	err = insertChatMembersEvent(ctx, tx, modelsOutbox.EventChatMembersAdded, chatID, usersInfo)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: insert outbox event")
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		logger.WithError(err).Error("Database operation failed: commit transaction")
 		return err
@@ -149,3 +164,20 @@ func (r *ChatsRepository) insertUsersToChat(ctx context.Context, tx pgx.Tx, chat
 
 	return nil
 }
+
+func insertChatMembersEvent(ctx context.Context, tx pgx.Tx, eventType string, chatID uuid.UUID, usersInfo []modelsChats.UserInfo) error {
+	userIDs := make([]uuid.UUID, 0, len(usersInfo))
+	for _, userInfo := range usersInfo {
+		userIDs = append(userIDs, userInfo.UserID)
+	}
+
+	event, err := modelsOutbox.ChatMembersEvent(eventType, modelsOutbox.ChatMembersPayload{
+		ChatID:  chatID,
+		UserIDs: userIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return outbox.InsertEvent(ctx, tx, event)
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/outbox"
 	"github.com/google/uuid"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -56,6 +58,11 @@ func TestChatsRepository_CreateChat_Success(t *testing.T) {
 		WithArgs(chatID, nil, "Чат создан", "system", chatID, userID1, "Пользователь User1 вступил в чат", "system", chatID, userID2, "Пользователь User2 вступил в чат", "system").
 		WillReturnResult(pgxmock.NewResult("INSERT", 3))
 
+	// Событие о создании чата пишется в той же транзакции
+	mock.ExpectExec(outbox.InsertEventQuery).
+		WithArgs(pgxmock.AnyArg(), "chat.created:"+chatID.String(), modelsOutbox.EventChatCreated, chatID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
 	// Коммит транзакции
 	mock.ExpectCommit()
 
@@ -119,6 +126,11 @@ func TestChatsRepository_InsertUsersToChat_Success(t *testing.T) {
 		WithArgs(userID1, chatID, "admin", userID2, chatID, "member").
 		WillReturnResult(pgxmock.NewResult("INSERT", 2))
 
+	// Ожидаем событие о новых участниках
+	mock.ExpectExec(outbox.InsertEventQuery).
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), modelsOutbox.EventChatMembersAdded, chatID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
 	// Ожидаем коммит транзакции
 	mock.ExpectCommit()
 
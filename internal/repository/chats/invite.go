@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// CreateInvite сохраняет пригласительную ссылку; CreatedAt заполняется из базы
+func (r *ChatsRepository) CreateInvite(ctx context.Context, invite *modelsChats.Invite) error {
+	const op = "ChatsRepository.CreateInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", invite.ChatID.String())
+	logger.Debug("Starting database operation: create invite")
+
+	err := r.db.QueryRow(ctx, createInviteQuery,
+		invite.ID, invite.ChatID, invite.CreatorID, invite.Code, invite.Name,
+		invite.ExpiresAt, invite.UsageLimit, invite.RequiresApproval).
+		Scan(&invite.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorUniqueViolationCode {
+			logger.Warn("Database operation failed: invite code collision")
+			return errs.ErrIsDuplicateKey
+		}
+		logger.WithError(err).Error("Database operation failed: create invite")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: invite created")
+	return nil
+}
+
+// GetChatInvites возвращает все ссылки чата, включая отозванные и истёкшие, новые первыми
+func (r *ChatsRepository) GetChatInvites(ctx context.Context, chatID uuid.UUID) ([]modelsChats.Invite, error) {
+	const op = "ChatsRepository.GetChatInvites"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: get chat invites")
+
+	rows, err := r.db.Query(ctx, getChatInvitesQuery, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get chat invites query")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	invites := make([]modelsChats.Invite, 0)
+	for rows.Next() {
+		var invite modelsChats.Invite
+		if err := rows.Scan(&invite.ID, &invite.ChatID, &invite.ChatType, &invite.CreatorID, &invite.Code,
+			&invite.Name, &invite.ExpiresAt, &invite.UsageLimit, &invite.UsageCount,
+			&invite.RequiresApproval, &invite.RevokedAt, &invite.CreatedAt); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan invite row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		invites = append(invites, invite)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.WithError(err).Error("Database operation failed: rows iteration")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: chat invites retrieved")
+	return invites, nil
+}
+
+// RevokeInvite отзывает ссылку чата; уже отозванная или чужая ссылка - ErrNotFound
+func (r *ChatsRepository) RevokeInvite(ctx context.Context, chatID, inviteID uuid.UUID) error {
+	const op = "ChatsRepository.RevokeInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("invite_id", inviteID.String())
+	logger.Debug("Starting database operation: revoke invite")
+
+	result, err := r.db.Exec(ctx, revokeInviteQuery, inviteID, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: revoke invite")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Warn("Database operation failed: invite not found")
+		return errs.ErrNotFound
+	}
+
+	logger.Info("Database operation completed successfully: invite revoked")
+	return nil
+}
+
+// GetInviteByCode находит ссылку по коду и сообщает, состоит ли userID в чате уже
+func (r *ChatsRepository) GetInviteByCode(ctx context.Context, userID uuid.UUID, code string) (*modelsChats.Invite, bool, error) {
+	const op = "ChatsRepository.GetInviteByCode"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+	logger.Debug("Starting database operation: get invite by code")
+
+	invite := &modelsChats.Invite{}
+	var isMember bool
+	err := r.db.QueryRow(ctx, getInviteByCodeQuery, code, userID).
+		Scan(&invite.ID, &invite.ChatID, &invite.ChatType, &invite.CreatorID, &invite.Code,
+			&invite.Name, &invite.ExpiresAt, &invite.UsageLimit, &invite.UsageCount,
+			&invite.RequiresApproval, &invite.RevokedAt, &invite.CreatedAt, &isMember)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Database operation completed: invite not found")
+			return nil, false, errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: get invite by code query")
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: invite retrieved")
+	return invite, isMember, nil
+}
+
+// JoinByInvite засчитывает вступление по ссылке и добавляет участника в одной транзакции: если вставка не удалась,
+// использование ссылки откатывается. ErrInviteExpired, если ссылка отозвана, истекла или исчерпана
+func (r *ChatsRepository) JoinByInvite(ctx context.Context, inviteID uuid.UUID, member modelsChats.UserInfo) error {
+	const op = "ChatsRepository.JoinByInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("invite_id", inviteID.String()).WithField("user_id", member.UserID.String())
+	logger.Debug("Starting database operation: join by invite")
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.useInvite(ctx, tx, inviteID); err != nil {
+		return err
+	}
+
+	if err := r.addMember(ctx, tx, member); err != nil {
+		logger.WithError(err).Error("Database operation failed: add member")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.WithError(err).Error("Database operation failed: commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: joined by invite")
+	return nil
+}
+
+// useInvite учитывает вступление по ссылке; лимит и срок проверяются тем же UPDATE
+func (r *ChatsRepository) useInvite(ctx context.Context, tx pgx.Tx, inviteID uuid.UUID) error {
+	const op = "ChatsRepository.useInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("invite_id", inviteID.String())
+
+	result, err := tx.Exec(ctx, useInviteQuery, inviteID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: use invite")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Warn("Database operation failed: invite is no longer usable")
+		return errs.ErrInviteExpired
+	}
+
+	return nil
+}
+
+// CreateJoinRequest ставит заявку в очередь; повторная заявка того же пользователя ничего не меняет
+func (r *ChatsRepository) CreateJoinRequest(ctx context.Context, chatID, userID, inviteID uuid.UUID) error {
+	const op = "ChatsRepository.CreateJoinRequest"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("user_id", userID.String())
+	logger.Debug("Starting database operation: create join request")
+
+	if _, err := r.db.Exec(ctx, createJoinRequestQuery, chatID, userID, inviteID); err != nil {
+		logger.WithError(err).Error("Database operation failed: create join request")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: join request created")
+	return nil
+}
+
+// GetJoinRequests возвращает очередь заявок чата, старые первыми
+func (r *ChatsRepository) GetJoinRequests(ctx context.Context, chatID uuid.UUID) ([]modelsChats.JoinRequest, error) {
+	const op = "ChatsRepository.GetJoinRequests"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: get join requests")
+
+	rows, err := r.db.Query(ctx, getJoinRequestsQuery, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get join requests query")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	requests := make([]modelsChats.JoinRequest, 0)
+	for rows.Next() {
+		var request modelsChats.JoinRequest
+		if err := rows.Scan(&request.ChatID, &request.UserID, &request.UserName, &request.InviteID, &request.CreatedAt); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan join request row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		requests = append(requests, request)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.WithError(err).Error("Database operation failed: rows iteration")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: join requests retrieved")
+	return requests, nil
+}
+
+// ApproveJoinRequest убирает заявку, засчитывает её ссылке, по которой подана, и добавляет участника в одной транзакции
+func (r *ChatsRepository) ApproveJoinRequest(ctx context.Context, member modelsChats.UserInfo) error {
+	const op = "ChatsRepository.ApproveJoinRequest"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", member.ChatID.String()).WithField("user_id", member.UserID.String())
+	logger.Debug("Starting database operation: approve join request")
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	inviteID, err := r.takeJoinRequest(ctx, tx, member.ChatID, member.UserID)
+	if err != nil {
+		return err
+	}
+
+	if inviteID != nil {
+		if _, err := tx.Exec(ctx, countInviteUsageQuery, *inviteID); err != nil {
+			logger.WithError(err).Error("Database operation failed: count invite usage")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := r.addMember(ctx, tx, member); err != nil {
+		logger.WithError(err).Error("Database operation failed: add member")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.WithError(err).Error("Database operation failed: commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: join request approved")
+	return nil
+}
+
+// DeclineJoinRequest убирает заявку из очереди, не засчитывая её ссылке
+func (r *ChatsRepository) DeclineJoinRequest(ctx context.Context, chatID, userID uuid.UUID) error {
+	const op = "ChatsRepository.DeclineJoinRequest"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("user_id", userID.String())
+	logger.Debug("Starting database operation: decline join request")
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := r.takeJoinRequest(ctx, tx, chatID, userID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.WithError(err).Error("Database operation failed: commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.Info("Database operation completed successfully: join request declined")
+	return nil
+}
+
+// takeJoinRequest удаляет заявку и возвращает ссылку, по которой она подана (nil, если ссылку уже удалили)
+func (r *ChatsRepository) takeJoinRequest(ctx context.Context, tx pgx.Tx, chatID, userID uuid.UUID) (*uuid.UUID, error) {
+	const op = "ChatsRepository.takeJoinRequest"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("user_id", userID.String())
+
+	var inviteID *uuid.UUID
+	if err := tx.QueryRow(ctx, deleteJoinRequestQuery, chatID, userID).Scan(&inviteID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Warn("Database operation failed: join request not found")
+			return nil, errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: delete join request")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return inviteID, nil
+}
+
+// addMember добавляет участника и пишет событие о нём в транзакции вызывающего
+func (r *ChatsRepository) addMember(ctx context.Context, tx pgx.Tx, member modelsChats.UserInfo) error {
+	members := []modelsChats.UserInfo{member}
+
+	if err := r.insertUsersToChat(ctx, tx, member.ChatID, members); err != nil {
+		return err
+	}
+
+	return insertChatMembersEvent(ctx, tx, modelsOutbox.EventChatMembersAdded, member.ChatID, members)
+}
@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatsRepository_CreateInvite_CodeCollision(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	invite := &modelsChats.Invite{ID: uuid.New(), ChatID: uuid.New(), CreatorID: uuid.New(), Code: "abc"}
+
+	mock.ExpectQuery(`INSERT INTO chat_invite`).
+		WithArgs(invite.ID, invite.ChatID, invite.CreatorID, invite.Code, invite.Name,
+			invite.ExpiresAt, invite.UsageLimit, invite.RequiresApproval).
+		WillReturnError(&pgconn.PgError{Code: errs.PostgresErrorUniqueViolationCode})
+
+	err = repo.CreateInvite(context.Background(), invite)
+
+	assert.ErrorIs(t, err, errs.ErrIsDuplicateKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_RevokeInvite_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	inviteID := uuid.New()
+
+	mock.ExpectExec(`UPDATE chat_invite SET revoked_at = NOW\(\)`).
+		WithArgs(inviteID, chatID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.RevokeInvite(context.Background(), chatID, inviteID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetInviteByCode(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	userID := uuid.New()
+	inviteID := uuid.New()
+	chatID := uuid.New()
+	limit := 5
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM chat_invite i`).
+		WithArgs("abc", userID).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "chat_id", "chat_type", "creator_id", "code", "name", "expires_at",
+			"usage_limit", "usage_count", "requires_approval", "revoked_at", "created_at", "is_member",
+		}).AddRow(inviteID, chatID, modelsChats.ChatTypeGroup, uuid.New(), "abc", "", (*time.Time)(nil),
+			&limit, 2, false, (*time.Time)(nil), now, true))
+
+	invite, isMember, err := repo.GetInviteByCode(context.Background(), userID, "abc")
+
+	assert.NoError(t, err)
+	assert.True(t, isMember)
+	assert.Equal(t, chatID, invite.ChatID)
+	assert.Equal(t, 5, *invite.UsageLimit)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetInviteByCode_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	userID := uuid.New()
+
+	mock.ExpectQuery(`FROM chat_invite i`).
+		WithArgs("ghost", userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, _, err = repo.GetInviteByCode(context.Background(), userID, "ghost")
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_JoinByInvite_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	inviteID := uuid.New()
+	member := modelsChats.UserInfo{UserID: uuid.New(), ChatID: uuid.New(), Role: modelsChats.RoleMember}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE chat_invite SET usage_count = usage_count \+ 1\s+WHERE id = \$1\s+AND revoked_at IS NULL`).
+		WithArgs(inviteID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO chat_member`).
+		WithArgs(member.UserID, member.ChatID, member.Role).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO outbox`).
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), modelsOutbox.EventChatMembersAdded, member.ChatID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	err = repo.JoinByInvite(context.Background(), inviteID, member)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_JoinByInvite_Exhausted(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	inviteID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE chat_invite SET usage_count = usage_count \+ 1\s+WHERE id = \$1\s+AND revoked_at IS NULL`).
+		WithArgs(inviteID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectRollback()
+
+	err = repo.JoinByInvite(context.Background(), inviteID, modelsChats.UserInfo{UserID: uuid.New(), ChatID: uuid.New()})
+
+	assert.ErrorIs(t, err, errs.ErrInviteExpired)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_JoinByInvite_InsertFailureRollsBackUsage(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	inviteID := uuid.New()
+	member := modelsChats.UserInfo{UserID: uuid.New(), ChatID: uuid.New(), Role: modelsChats.RoleMember}
+
+	// Использование ссылки засчитано, но вставка участника падает: транзакция откатывается без коммита
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE chat_invite SET usage_count = usage_count \+ 1\s+WHERE id = \$1\s+AND revoked_at IS NULL`).
+		WithArgs(inviteID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO chat_member`).
+		WithArgs(member.UserID, member.ChatID, member.Role).
+		WillReturnError(&pgconn.PgError{Code: errs.PostgresErrorUniqueViolationCode})
+	mock.ExpectRollback()
+
+	err = repo.JoinByInvite(context.Background(), inviteID, member)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_ApproveJoinRequest_CountsUsage(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	inviteID := uuid.New()
+	member := modelsChats.UserInfo{UserID: uuid.New(), ChatID: uuid.New(), Role: modelsChats.RoleMember}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM chat_join_request`).
+		WithArgs(member.ChatID, member.UserID).
+		WillReturnRows(pgxmock.NewRows([]string{"invite_id"}).AddRow(&inviteID))
+	mock.ExpectExec(`UPDATE chat_invite SET usage_count = usage_count \+ 1 WHERE id = \$1$`).
+		WithArgs(inviteID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO chat_member`).
+		WithArgs(member.UserID, member.ChatID, member.Role).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO outbox`).
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), modelsOutbox.EventChatMembersAdded, member.ChatID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	err = repo.ApproveJoinRequest(context.Background(), member)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_ApproveJoinRequest_InsertFailureKeepsRequest(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	member := modelsChats.UserInfo{UserID: uuid.New(), ChatID: uuid.New(), Role: modelsChats.RoleMember}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM chat_join_request`).
+		WithArgs(member.ChatID, member.UserID).
+		WillReturnRows(pgxmock.NewRows([]string{"invite_id"}).AddRow((*uuid.UUID)(nil)))
+	mock.ExpectExec(`INSERT INTO chat_member`).
+		WithArgs(member.UserID, member.ChatID, member.Role).
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	err = repo.ApproveJoinRequest(context.Background(), member)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_DeclineJoinRequest_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	userID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM chat_join_request`).
+		WithArgs(chatID, userID).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectRollback()
+
+	err = repo.DeclineJoinRequest(context.Background(), chatID, userID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,11 +1,19 @@
 package repository
 
 const (
+	// Закреплённые чаты идут первыми в порядке pin_order, остальные - по времени последнего сообщения
 	getChatsQuery = `
-		SELECT c.id, c.chat_type::text, c.name, c.description 
+		SELECT c.id, c.chat_type::text, c.name, c.description,
+			cm.is_muted, cm.muted_until, cm.is_archived, cm.pin_order, cm.folder
 		FROM chat c
 		JOIN chat_member cm ON cm.chat_id = c.id
-		WHERE cm.user_id = $1`
+		LEFT JOIN LATERAL (
+			SELECT MAX(m.created_at) AS last_message_at
+			FROM message m
+			WHERE m.chat_id = c.id
+		) lm ON TRUE
+		WHERE cm.user_id = $1
+		ORDER BY cm.pin_order ASC NULLS LAST, COALESCE(lm.last_message_at, c.created_at) DESC`
 
 	getChatQuery = `
 		SELECT c.id, c.chat_type::text, c.name, c.description 
@@ -53,7 +61,7 @@ const (
 			FROM avatar_chat ac
 			JOIN attachment a ON ac.attachment_id = a.id
 			WHERE ac.chat_id = ANY($2)
-			ORDER BY ac.chat_id, ac.created_at DESC
+			ORDER BY ac.chat_id, ac.current_since DESC
 		),
 		latest_user_avatars AS (
 			SELECT DISTINCT ON (au.user_id) 
@@ -61,7 +69,7 @@ const (
 				a.id as attachment_id
 			FROM avatar_user au
 			JOIN attachment a ON au.attachment_id = a.id
-			ORDER BY au.user_id, au.created_at DESC
+			ORDER BY au.user_id, au.current_since DESC
 		),
 		dialog_avatars AS (
 			SELECT 
@@ -84,12 +92,131 @@ const (
 		VALUES ($1, $2, $3, $4)`
 
 	insertChatAvatarInAvatarChatTableQuery = `
-		INSERT INTO avatar_chat (chat_id, attachment_id)
-		VALUES ($1, $2)`
+		INSERT INTO avatar_chat (chat_id, attachment_id, sizes)
+		VALUES ($1, $2, $3)`
+
+	getChatAvatarHistoryQuery = `
+		SELECT attachment_id, sizes, created_at
+		FROM avatar_chat
+		WHERE chat_id = $1
+		ORDER BY current_since DESC`
+
+	// Вложение удаляется целиком, запись в avatar_chat уходит каскадом
+	deleteChatAvatarQuery = `
+		DELETE FROM attachment a
+		USING avatar_chat ac
+		WHERE a.id = ac.attachment_id AND ac.chat_id = $1 AND ac.attachment_id = $2
+		RETURNING ac.sizes`
+
+	setCurrentChatAvatarQuery = `
+		UPDATE avatar_chat SET current_since = NOW()
+		WHERE chat_id = $1 AND attachment_id = $2`
+
+	getChatSettingsQuery = `
+		SELECT is_muted, muted_until, is_archived, pin_order, folder
+		FROM chat_member
+		WHERE user_id = $1 AND chat_id = $2`
+
+	getChatMembersNotifySettingsQuery = `
+		SELECT cm.user_id, usr.username, cm.is_muted, cm.muted_until
+		FROM chat_member cm
+		JOIN "user" usr ON usr.id = cm.user_id
+		WHERE cm.chat_id = $1`
+
+	updateChatSettingsQuery = `
+		UPDATE chat_member
+		SET is_muted = $3, muted_until = $4, is_archived = $5, pin_order = $6, folder = $7
+		WHERE user_id = $1 AND chat_id = $2`
 
 	searchChatsQuery = `
 		SELECT c.id, c.chat_type::text, c.name, c.description 
 		FROM chat c
 		JOIN chat_member cm ON cm.chat_id = c.id
 		WHERE cm.user_id = $1 AND c.name ILIKE '%' || $2 || '%'`
+
+	getGroupPeersQuery = `
+		SELECT DISTINCT other.user_id
+		FROM chat_member own
+		JOIN chat c ON c.id = own.chat_id AND c.chat_type = 'group'
+		JOIN chat_member other ON other.chat_id = own.chat_id AND other.user_id <> own.user_id
+		WHERE own.user_id = $1
+		LIMIT $2`
+
+	getDialogPartnersQuery = `
+		SELECT other.user_id, own.chat_id
+		FROM chat_member own
+		JOIN chat c ON c.id = own.chat_id AND c.chat_type = 'dialog'
+		JOIN chat_member other ON other.chat_id = own.chat_id AND other.user_id <> own.user_id
+		WHERE own.user_id = $1`
+
+	getChatUsernameForUpdateQuery = `SELECT chat_type::text, username FROM chat WHERE id = $1 FOR UPDATE`
+
+	setChatUsernameQuery = `UPDATE chat SET username = $1 WHERE id = $2`
+
+	// Прежний handle ведёт на чат, пока не истёк переходный период; закрытый чат не находится
+	resolveChatUsernameQuery = `
+		SELECT c.id, c.chat_type::text, c.name, COALESCE(c.description, ''), c.username,
+			(SELECT COUNT(*) FROM chat_member m WHERE m.chat_id = c.id),
+			EXISTS (SELECT 1 FROM chat_member m WHERE m.chat_id = c.id AND m.user_id = $2),
+			LOWER(c.username) <> $1
+		FROM chat c
+		WHERE c.username IS NOT NULL
+		  AND (LOWER(c.username) = $1
+		   OR c.id = (
+		       SELECT r.chat_id FROM username_redirect r
+		       WHERE r.username = $1 AND r.chat_id IS NOT NULL AND r.expires_at > NOW()))
+		LIMIT 1`
+
+	createInviteQuery = `
+		INSERT INTO chat_invite (id, chat_id, creator_id, code, name, expires_at, usage_limit, requires_approval)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at`
+
+	getChatInvitesQuery = `
+		SELECT i.id, i.chat_id, c.chat_type::text, i.creator_id, i.code, i.name, i.expires_at,
+			i.usage_limit, i.usage_count, i.requires_approval, i.revoked_at, i.created_at
+		FROM chat_invite i
+		JOIN chat c ON c.id = i.chat_id
+		WHERE i.chat_id = $1
+		ORDER BY i.created_at DESC`
+
+	revokeInviteQuery = `
+		UPDATE chat_invite SET revoked_at = NOW()
+		WHERE id = $1 AND chat_id = $2 AND revoked_at IS NULL`
+
+	// Вместе со ссылкой проверяем, не состоит ли пользователь в чате уже
+	getInviteByCodeQuery = `
+		SELECT i.id, i.chat_id, c.chat_type::text, i.creator_id, i.code, i.name, i.expires_at,
+			i.usage_limit, i.usage_count, i.requires_approval, i.revoked_at, i.created_at,
+			EXISTS (SELECT 1 FROM chat_member m WHERE m.chat_id = i.chat_id AND m.user_id = $2)
+		FROM chat_invite i
+		JOIN chat c ON c.id = i.chat_id
+		WHERE i.code = $1`
+
+	// Проверка и учёт вступления одним запросом, чтобы параллельные вступления не превысили лимит
+	useInviteQuery = `
+		UPDATE chat_invite SET usage_count = usage_count + 1
+		WHERE id = $1
+		  AND revoked_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		  AND (usage_limit IS NULL OR usage_count < usage_limit)`
+
+	createJoinRequestQuery = `
+		INSERT INTO chat_join_request (chat_id, user_id, invite_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (chat_id, user_id) DO NOTHING`
+
+	getJoinRequestsQuery = `
+		SELECT r.chat_id, r.user_id, usr.name, r.invite_id, r.created_at
+		FROM chat_join_request r
+		JOIN "user" usr ON usr.id = r.user_id
+		WHERE r.chat_id = $1
+		ORDER BY r.created_at`
+
+	deleteJoinRequestQuery = `
+		DELETE FROM chat_join_request
+		WHERE chat_id = $1 AND user_id = $2
+		RETURNING invite_id`
+
+	countInviteUsageQuery = `UPDATE chat_invite SET usage_count = usage_count + 1 WHERE id = $1`
 )
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *ChatsRepository) GetChatSettings(ctx context.Context, userID, chatID uuid.UUID) (*modelsChats.ChatSettings, error) {
+	const op = "ChatsRepository.GetChatSettings"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String()).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: get chat settings")
+
+	settings := &modelsChats.ChatSettings{}
+	err := r.db.QueryRow(ctx, getChatSettingsQuery, userID, chatID).
+		Scan(&settings.IsMuted, &settings.MutedUntil, &settings.IsArchived, &settings.PinOrder, &settings.Folder)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Warn("Database operation failed: user is not a member of the chat")
+			return nil, errs.ErrNotFound
+		}
+		logger.WithError(err).Error("Database operation failed: get chat settings query")
+		return nil, err
+	}
+
+	logger.Info("Database operation completed successfully: chat settings retrieved")
+	return settings, nil
+}
+
+func (r *ChatsRepository) UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings modelsChats.ChatSettings) error {
+	const op = "ChatsRepository.UpdateChatSettings"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String()).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: update chat settings")
+
+	result, err := r.db.Exec(ctx, updateChatSettingsQuery, userID, chatID,
+		settings.IsMuted, settings.MutedUntil, settings.IsArchived, settings.PinOrder, settings.Folder)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: update chat settings")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Warn("Database operation failed: user is not a member of the chat")
+		return errs.ErrNotFound
+	}
+
+	logger.Info("Database operation completed successfully: chat settings updated")
+	return nil
+}
+
+// GetChatMembersNotifySettings возвращает участников чата с их username и настройками заглушения
+func (r *ChatsRepository) GetChatMembersNotifySettings(ctx context.Context, chatID uuid.UUID) ([]modelsChats.MemberNotifySettings, error) {
+	const op = "ChatsRepository.GetChatMembersNotifySettings"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+	logger.Debug("Starting database operation: get chat members notify settings")
+
+	rows, err := r.db.Query(ctx, getChatMembersNotifySettingsQuery, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: get chat members notify settings query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]modelsChats.MemberNotifySettings, 0)
+	for rows.Next() {
+		var member modelsChats.MemberNotifySettings
+		if err := rows.Scan(&member.UserID, &member.Username, &member.Settings.IsMuted, &member.Settings.MutedUntil); err != nil {
+			logger.WithError(err).Error("Database operation failed: scan chat member notify settings")
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.WithError(err).Error("Database operation failed: rows iteration")
+		return nil, err
+	}
+
+	logger.Infof("Database operation completed successfully: %d chat members retrieved", len(members))
+	return members, nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatsRepository_GetChatSettings_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	mutedUntil := time.Now().Add(time.Hour)
+	folder := "Work"
+
+	rows := pgxmock.NewRows([]string{"is_muted", "muted_until", "is_archived", "pin_order", "folder"}).
+		AddRow(true, &mutedUntil, false, nil, &folder)
+
+	mock.ExpectQuery(getChatSettingsQuery).
+		WithArgs(userID, chatID).
+		WillReturnRows(rows)
+
+	settings, err := repo.GetChatSettings(context.Background(), userID, chatID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, settings)
+	assert.True(t, settings.IsMuted)
+	assert.Equal(t, &mutedUntil, settings.MutedUntil)
+	assert.False(t, settings.IsArchived)
+	assert.Nil(t, settings.PinOrder)
+	assert.Equal(t, &folder, settings.Folder)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetChatSettings_NotMember(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mock.ExpectQuery(getChatSettingsQuery).
+		WithArgs(userID, chatID).
+		WillReturnError(pgx.ErrNoRows)
+
+	settings, err := repo.GetChatSettings(context.Background(), userID, chatID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, settings)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_UpdateChatSettings_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	pinOrder := 2
+	settings := modelsChats.ChatSettings{IsArchived: true, PinOrder: &pinOrder}
+
+	mock.ExpectExec(updateChatSettingsQuery).
+		WithArgs(userID, chatID, false, (*time.Time)(nil), true, &pinOrder, (*string)(nil)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateChatSettings(context.Background(), userID, chatID, settings)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_UpdateChatSettings_NotMember(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mock.ExpectExec(updateChatSettingsQuery).
+		WithArgs(userID, chatID, false, (*time.Time)(nil), false, (*int)(nil), (*string)(nil)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.UpdateChatSettings(context.Background(), userID, chatID, modelsChats.ChatSettings{})
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_UpdateChatSettings_Error(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mock.ExpectExec(updateChatSettingsQuery).
+		WithArgs(userID, chatID, false, (*time.Time)(nil), false, (*int)(nil), (*string)(nil)).
+		WillReturnError(fmt.Errorf("database error"))
+
+	err = repo.UpdateChatSettings(context.Background(), userID, chatID, modelsChats.ChatSettings{})
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetChatMembersNotifySettings_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	chatID := uuid.New()
+	activeID := uuid.New()
+	mutedID := uuid.New()
+	mutedUntil := time.Now().Add(time.Hour)
+
+	rows := pgxmock.NewRows([]string{"user_id", "username", "is_muted", "muted_until"}).
+		AddRow(activeID, "alice", false, nil).
+		AddRow(mutedID, "bob", true, &mutedUntil)
+
+	mock.ExpectQuery(getChatMembersNotifySettingsQuery).
+		WithArgs(chatID).
+		WillReturnRows(rows)
+
+	members, err := repo.GetChatMembersNotifySettings(context.Background(), chatID)
+
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, activeID, members[0].UserID)
+	assert.Equal(t, "alice", members[0].Username)
+	assert.False(t, members[0].Settings.IsMuted)
+	assert.Equal(t, "bob", members[1].Username)
+	assert.True(t, members[1].Settings.IsMutedAt(time.Now()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_GetChatMembersNotifySettings_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+
+	chatID := uuid.New()
+
+	mock.ExpectQuery(getChatMembersNotifySettingsQuery).
+		WithArgs(chatID).
+		WillReturnError(fmt.Errorf("db error"))
+
+	members, err := repo.GetChatMembersNotifySettings(context.Background(), chatID)
+
+	assert.Error(t, err)
+	assert.Nil(t, members)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
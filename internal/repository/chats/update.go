@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	"github.com/google/uuid"
 )
 
@@ -44,7 +45,7 @@ func (r *ChatsRepository) UpdateChat(ctx context.Context, userId, chatId uuid.UU
 	return nil
 }
 
-func (r *ChatsRepository) UpdateChatAvatar(ctx context.Context, chatID uuid.UUID, attachmentID uuid.UUID, fileSize int64) error {
+func (r *ChatsRepository) UpdateChatAvatar(ctx context.Context, chatID uuid.UUID, attachmentID uuid.UUID, fileSize int64, sizes []int) error {
 	const op = "ChatsRepository.UpdateChatAvatar"
 	const query = "UPDATE chat avatar"
 
@@ -76,7 +77,7 @@ func (r *ChatsRepository) UpdateChatAvatar(ctx context.Context, chatID uuid.UUID
 
 	// Вставляем запись в таблицу avatar_chat
 	logger.WithField("attachment_id", attachmentID.String()).WithField("chat_id", chatID.String()).Debug("Inserting into avatar_chat table")
-	_, err = tx.Exec(ctx, insertChatAvatarInAvatarChatTableQuery, chatID, attachmentID)
+	_, err = tx.Exec(ctx, insertChatAvatarInAvatarChatTableQuery, chatID, attachmentID, sizes)
 	if err != nil {
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: insert chat avatar: status: %s", query, queryStatus)
@@ -92,3 +93,25 @@ func (r *ChatsRepository) UpdateChatAvatar(ctx context.Context, chatID uuid.UUID
 	logger.Info("Chat avatar updated successfully")
 	return nil
 }
+
+// SetCurrentChatAvatar делает аватарку из истории чата текущей
+func (r *ChatsRepository) SetCurrentChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) error {
+	const op = "ChatsRepository.SetCurrentChatAvatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("attachment_id", attachmentID.String())
+	logger.Debug("Starting database operation: set current chat avatar")
+
+	result, err := r.db.Exec(ctx, setCurrentChatAvatarQuery, chatID, attachmentID)
+	if err != nil {
+		logger.WithError(err).Error("Database operation failed: set current chat avatar")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Debug("Database operation failed: chat avatar not found")
+		return errs.ErrNotFound
+	}
+
+	logger.Info("Database operation completed successfully: current chat avatar set")
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	"github.com/google/uuid"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -36,3 +37,73 @@ func TestChatsRepository_UpdateChat_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestChatsRepository_UpdateChatAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+	sizes := []int{640, 320, 160}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(insertChatAvatarInAttachmentTableQuery).
+		WithArgs(attachmentID, "avatar_"+attachmentID.String(), int64(2048), "inline").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(insertChatAvatarInAvatarChatTableQuery).
+		WithArgs(chatID, attachmentID, sizes).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	mock.ExpectRollback()
+
+	err = repo.UpdateChatAvatar(context.Background(), chatID, attachmentID, 2048, sizes)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_SetCurrentChatAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mock.ExpectExec(setCurrentChatAvatarQuery).
+		WithArgs(chatID, attachmentID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.SetCurrentChatAvatar(context.Background(), chatID, attachmentID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatsRepository_SetCurrentChatAvatar_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create pgxmock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewChatsRepository(mock)
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mock.ExpectExec(setCurrentChatAvatarQuery).
+		WithArgs(chatID, attachmentID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.SetCurrentChatAvatar(context.Background(), chatID, attachmentID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -21,13 +21,81 @@ const (
 		 RETURNING user_id`
 
 	getContactsByUserIDQuery = `
-		SELECT user_id, contact_user_id, created_at, updated_at
+		SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`
 
 	getAllContactsQuery = `
-		SELECT user_id, contact_user_id, created_at, updated_at
+		SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact`
+
+	getContactsByContactUserIDQuery = `
+		SELECT user_id, contact_user_id, alias, created_at, updated_at
+		FROM contact
+		WHERE contact_user_id = $1`
+
+	getContactOwnersQuery = `
+		SELECT user_id
+		FROM contact
+		WHERE contact_user_id = $1 AND user_id = ANY($2)`
+
+	deleteContactQuery = `
+		DELETE FROM contact
+		WHERE user_id = $1 AND contact_user_id = $2`
+
+	updateContactAliasQuery = `
+		UPDATE contact
+		SET alias = $3, updated_at = $4
+		WHERE user_id = $1 AND contact_user_id = $2`
+
+	getContactAliasesQuery = `
+		SELECT contact_user_id, alias
+		FROM contact
+		WHERE user_id = $1 AND contact_user_id = ANY($2) AND alias IS NOT NULL`
+
+	reserveImportQuotaQuery = `
+		WITH expired AS (
+			DELETE FROM contact_import_quota
+			WHERE user_id = $1 AND imported_at <= NOW() - make_interval(secs => $4)
+		)
+		INSERT INTO contact_import_quota (user_id, entries, imported_at)
+		SELECT $1, $2, NOW()
+		WHERE (
+			SELECT COALESCE(SUM(entries), 0)
+			FROM contact_import_quota
+			WHERE user_id = $1 AND imported_at > NOW() - make_interval(secs => $4)
+		) + $2 <= $3`
+
+	matchPhoneHashesQuery = `
+		SELECT phone_hash, id
+		FROM "user"
+		WHERE phone_hash = ANY($1) AND user_type <> 'bot'`
+
+	createContactsQuery = `
+		INSERT INTO contact (user_id, contact_user_id, created_at, updated_at)
+		SELECT $1, contact_user_id, $3, $3
+		FROM unnest($2::uuid[]) AS contact_user_id
+		ON CONFLICT DO NOTHING
+		RETURNING contact_user_id`
+
+	savePendingImportsQuery = `
+		INSERT INTO contact_import (user_id, phone_hash)
+		SELECT $1, phone_hash
+		FROM unnest($2::text[]) AS phone_hash
+		ON CONFLICT DO NOTHING`
+
+	claimPendingImportsQuery = `
+		WITH claimed AS (
+			DELETE FROM contact_import
+			WHERE phone_hash = $1
+			RETURNING user_id
+		)
+		INSERT INTO contact (user_id, contact_user_id, created_at, updated_at)
+		SELECT user_id, $2, $3, $3
+		FROM claimed
+		WHERE user_id <> $2
+		ON CONFLICT DO NOTHING
+		RETURNING user_id`
 )
 
 type ContactRepository struct {
@@ -110,7 +178,7 @@ func (r *ContactRepository) GetContactsByUserID(ctx context.Context, user_id uui
 	var contacts []*models.Contact
 	for rows.Next() {
 		var contact models.Contact
-		err := rows.Scan(&contact.UserID, &contact.ContactUserID, &contact.CreatedAt, &contact.UpdatedAt)
+		err := rows.Scan(&contact.UserID, &contact.ContactUserID, &contact.Alias, &contact.CreatedAt, &contact.UpdatedAt)
 		if err != nil {
 			queryStatus = "fail"
 			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
@@ -152,7 +220,7 @@ func (r *ContactRepository) GetAllContacts(ctx context.Context) ([]*models.Conta
 	var contacts []*models.Contact
 	for rows.Next() {
 		var contact models.Contact
-		err := rows.Scan(&contact.UserID, &contact.ContactUserID, &contact.CreatedAt, &contact.UpdatedAt)
+		err := rows.Scan(&contact.UserID, &contact.ContactUserID, &contact.Alias, &contact.CreatedAt, &contact.UpdatedAt)
 		if err != nil {
 			queryStatus = "fail"
 			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
@@ -170,3 +238,396 @@ func (r *ContactRepository) GetAllContacts(ctx context.Context) ([]*models.Conta
 	logger.Infof("retrieved %d contacts for reindexing", len(contacts))
 	return contacts, nil
 }
+
+// GetContactsByContactUserID возвращает записи всех, у кого contactUserID есть в контактах
+func (r *ContactRepository) GetContactsByContactUserID(ctx context.Context, contactUserID uuid.UUID) ([]*models.Contact, error) {
+	const op = "ContactRepository.GetContactsByContactUserID"
+	const query = "SELECT contacts by contact user"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("contact_user_id", contactUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getContactsByContactUserIDQuery, contactUserID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contacts []*models.Contact
+	for rows.Next() {
+		var contact models.Contact
+		if err := rows.Scan(&contact.UserID, &contact.ContactUserID, &contact.Alias, &contact.CreatedAt, &contact.UpdatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		contacts = append(contacts, &contact)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// GetContactOwners возвращает тех из userIDs, у кого contactUserID есть в контактах
+func (r *ContactRepository) GetContactOwners(ctx context.Context, contactUserID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "ContactRepository.GetContactOwners"
+	const query = "SELECT contact owners"
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("contact_user_id", contactUserID.String()).
+		WithField("users_count", len(userIDs))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getContactOwnersQuery, contactUserID, userIDs)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var owners []uuid.UUID
+	for rows.Next() {
+		var ownerID uuid.UUID
+		if err := rows.Scan(&ownerID); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		owners = append(owners, ownerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return owners, nil
+}
+
+func (r *ContactRepository) DeleteContact(ctx context.Context, userID uuid.UUID, contactUserID uuid.UUID) error {
+	const op = "ContactRepository.DeleteContact"
+	const query = "DELETE contact"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("contact_user_id", contactUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tag, err := r.db.Exec(ctx, deleteContactQuery, userID, contactUserID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		queryStatus = "fail"
+		return errs.ErrContactNotFound
+	}
+
+	return nil
+}
+
+// UpdateContactAlias задаёт имя контакта; nil сбрасывает его
+func (r *ContactRepository) UpdateContactAlias(ctx context.Context, userID uuid.UUID, contactUserID uuid.UUID, alias *string) error {
+	const op = "ContactRepository.UpdateContactAlias"
+	const query = "UPDATE contact alias"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("contact_user_id", contactUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tag, err := r.db.Exec(ctx, updateContactAliasQuery, userID, contactUserID, alias, time.Now())
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		queryStatus = "fail"
+		return errs.ErrContactNotFound
+	}
+
+	return nil
+}
+
+// GetContactAliases возвращает заданные userID имена для тех из contactUserIDs, у кого они есть
+func (r *ContactRepository) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	const op = "ContactRepository.GetContactAliases"
+	const query = "SELECT contact aliases"
+
+	aliases := make(map[uuid.UUID]string)
+	if len(contactUserIDs) == 0 {
+		return aliases, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("contacts_count", len(contactUserIDs))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getContactAliasesQuery, userID, contactUserIDs)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contactUserID uuid.UUID
+		var alias string
+		if err := rows.Scan(&contactUserID, &alias); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		aliases[contactUserID] = alias
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+// ReserveImportQuota учитывает count проверяемых номеров; false - лимит за окно исчерпан
+func (r *ContactRepository) ReserveImportQuota(ctx context.Context, userID uuid.UUID, count, limit int, window time.Duration) (bool, error) {
+	const op = "ContactRepository.ReserveImportQuota"
+	const query = "INSERT contact import quota"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("entries", count)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tag, err := r.db.Exec(ctx, reserveImportQuotaQuery, userID, count, limit, window.Seconds())
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// MatchPhoneHashes возвращает зарегистрированных пользователей по хэшам номеров
+func (r *ContactRepository) MatchPhoneHashes(ctx context.Context, phoneHashes []string) (map[string]uuid.UUID, error) {
+	const op = "ContactRepository.MatchPhoneHashes"
+	const query = "SELECT users by phone hashes"
+
+	matched := make(map[string]uuid.UUID)
+	if len(phoneHashes) == 0 {
+		return matched, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("hashes_count", len(phoneHashes))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, matchPhoneHashesQuery, phoneHashes)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var phoneHash string
+		var userID uuid.UUID
+		if err := rows.Scan(&phoneHash, &userID); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		matched[phoneHash] = userID
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return matched, nil
+}
+
+// CreateContacts добавляет контакты пачкой и возвращает только новые, уже существующие пропускаются
+func (r *ContactRepository) CreateContacts(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "ContactRepository.CreateContacts"
+	const query = "INSERT contacts batch"
+
+	if len(contactUserIDs) == 0 {
+		return nil, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("contacts_count", len(contactUserIDs))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, createContactsQuery, userID, contactUserIDs, time.Now())
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var created []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		created = append(created, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// SavePendingImports запоминает хэши номеров, чьи владельцы ещё не зарегистрированы
+func (r *ContactRepository) SavePendingImports(ctx context.Context, userID uuid.UUID, phoneHashes []string) error {
+	const op = "ContactRepository.SavePendingImports"
+	const query = "INSERT contact imports"
+
+	if len(phoneHashes) == 0 {
+		return nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("hashes_count", len(phoneHashes))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	if _, err := r.db.Exec(ctx, savePendingImportsQuery, userID, phoneHashes); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	return nil
+}
+
+// ClaimPendingImports превращает ожидающие записи с хэшем номера нового пользователя в контакты
+// и возвращает владельцев адресных книг, у которых контакт появился
+func (r *ContactRepository) ClaimPendingImports(ctx context.Context, phoneHash string, contactUserID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "ContactRepository.ClaimPendingImports"
+	const query = "DELETE contact imports, INSERT contacts"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("contact_user_id", contactUserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, claimPendingImportsQuery, phoneHash, contactUserID, time.Now())
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var owners []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		owners = append(owners, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return owners, nil
+}
@@ -128,10 +128,10 @@ func TestContactRepository_GetContactsByUserID_Success(t *testing.T) {
 	createdAt := time.Now()
 	updatedAt := time.Now()
 
-	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "created_at", "updated_at"}).
-		AddRow(userID, contactUserID, createdAt, updatedAt)
+	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"}).
+		AddRow(userID, contactUserID, nil, createdAt, updatedAt)
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`).
 		WithArgs(userID).
@@ -157,9 +157,9 @@ func TestContactRepository_GetContactsByUserID_NoContacts(t *testing.T) {
 
 	userID := uuid.New()
 
-	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "created_at", "updated_at"})
+	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"})
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`).
 		WithArgs(userID).
@@ -182,7 +182,7 @@ func TestContactRepository_GetContactsByUserID_QueryError(t *testing.T) {
 
 	userID := uuid.New()
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`).
 		WithArgs(userID).
@@ -206,10 +206,10 @@ func TestContactRepository_GetContactsByUserID_ScanError(t *testing.T) {
 
 	userID := uuid.New()
 
-	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "created_at", "updated_at"}).
-		AddRow("invalid-uuid", uuid.New(), time.Now(), time.Now())
+	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"}).
+		AddRow("invalid-uuid", uuid.New(), nil, time.Now(), time.Now())
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`).
 		WithArgs(userID).
@@ -233,11 +233,11 @@ func TestContactRepository_GetContactsByUserID_RowsError(t *testing.T) {
 	userID := uuid.New()
 	contactUserID := uuid.New()
 
-	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "created_at", "updated_at"}).
-		AddRow(userID, contactUserID, time.Now(), time.Now()).
+	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"}).
+		AddRow(userID, contactUserID, nil, time.Now(), time.Now()).
 		RowError(0, fmt.Errorf("connection error"))
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact
 		WHERE user_id = $1`).
 		WithArgs(userID).
@@ -266,11 +266,11 @@ func TestContactRepository_GetAllContacts_Success(t *testing.T) {
 	createdAt := time.Now()
 	updatedAt := time.Now()
 
-	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "created_at", "updated_at"}).
-		AddRow(userID1, contactUserID1, createdAt, updatedAt).
-		AddRow(userID2, contactUserID2, createdAt, updatedAt)
+	rows := pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"}).
+		AddRow(userID1, contactUserID1, nil, createdAt, updatedAt).
+		AddRow(userID2, contactUserID2, nil, createdAt, updatedAt)
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact`).
 		WillReturnRows(rows)
 
@@ -285,6 +285,52 @@ func TestContactRepository_GetAllContacts_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestContactRepository_GetContactsByContactUserID_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	ownerID := uuid.New()
+	contactUserID := uuid.New()
+	alias := "Мама"
+	now := time.Now()
+
+	mock.ExpectQuery(getContactsByContactUserIDQuery).
+		WithArgs(contactUserID).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "contact_user_id", "alias", "created_at", "updated_at"}).
+			AddRow(ownerID, contactUserID, &alias, now, now))
+
+	contacts, err := repo.GetContactsByContactUserID(ctx, contactUserID)
+
+	assert.NoError(t, err)
+	assert.Len(t, contacts, 1)
+	assert.Equal(t, ownerID, contacts[0].UserID)
+	assert.Equal(t, &alias, contacts[0].Alias)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_GetContactsByContactUserID_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	contactUserID := uuid.New()
+
+	mock.ExpectQuery(getContactsByContactUserIDQuery).
+		WithArgs(contactUserID).
+		WillReturnError(fmt.Errorf("database error"))
+
+	contacts, err := repo.GetContactsByContactUserID(context.Background(), contactUserID)
+
+	assert.Error(t, err)
+	assert.Nil(t, contacts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestContactRepository_GetAllContacts_QueryError(t *testing.T) {
 	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
 	assert.NoError(t, err)
@@ -293,7 +339,7 @@ func TestContactRepository_GetAllContacts_QueryError(t *testing.T) {
 	repo := New(mock)
 	ctx := context.Background()
 
-	mock.ExpectQuery(`SELECT user_id, contact_user_id, created_at, updated_at
+	mock.ExpectQuery(`SELECT user_id, contact_user_id, alias, created_at, updated_at
 		FROM contact`).
 		WillReturnError(fmt.Errorf("database error"))
 
@@ -303,3 +349,234 @@ func TestContactRepository_GetAllContacts_QueryError(t *testing.T) {
 	assert.Nil(t, contacts)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestContactRepository_GetContactOwners_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	contactUserID := uuid.New()
+	ownerID := uuid.New()
+	strangerID := uuid.New()
+
+	mock.ExpectQuery(getContactOwnersQuery).
+		WithArgs(contactUserID, []uuid.UUID{ownerID, strangerID}).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(ownerID))
+
+	owners, err := repo.GetContactOwners(ctx, contactUserID, []uuid.UUID{ownerID, strangerID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ownerID}, owners)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_GetContactOwners_Empty(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	owners, err := repo.GetContactOwners(context.Background(), uuid.New(), nil)
+
+	assert.NoError(t, err)
+	assert.Nil(t, owners)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_DeleteContact_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(deleteContactQuery).
+		WithArgs(userID, contactUserID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err = repo.DeleteContact(context.Background(), userID, contactUserID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_DeleteContact_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(deleteContactQuery).
+		WithArgs(userID, contactUserID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err = repo.DeleteContact(context.Background(), userID, contactUserID)
+
+	assert.ErrorIs(t, err, errs.ErrContactNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_UpdateContactAlias_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "Мама"
+
+	mock.ExpectExec(updateContactAliasQuery).
+		WithArgs(userID, contactUserID, &alias, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateContactAlias(context.Background(), userID, contactUserID, &alias)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_UpdateContactAlias_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(updateContactAliasQuery).
+		WithArgs(userID, contactUserID, (*string)(nil), pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.UpdateContactAlias(context.Background(), userID, contactUserID, nil)
+
+	assert.ErrorIs(t, err, errs.ErrContactNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_GetContactAliases_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, namedID, plainID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(getContactAliasesQuery).
+		WithArgs(userID, []uuid.UUID{namedID, plainID}).
+		WillReturnRows(pgxmock.NewRows([]string{"contact_user_id", "alias"}).AddRow(namedID, "Начальник"))
+
+	aliases, err := repo.GetContactAliases(context.Background(), userID, []uuid.UUID{namedID, plainID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]string{namedID: "Начальник"}, aliases)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_ReserveImportQuota(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+
+	mock.ExpectExec(reserveImportQuotaQuery).
+		WithArgs(userID, 10, 100, float64(3600)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(reserveImportQuotaQuery).
+		WithArgs(userID, 95, 100, float64(3600)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	allowed, err := repo.ReserveImportQuota(context.Background(), userID, 10, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = repo.ReserveImportQuota(context.Background(), userID, 95, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_MatchPhoneHashes_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+	hashes := []string{"known", "unknown"}
+
+	mock.ExpectQuery(matchPhoneHashesQuery).
+		WithArgs(hashes).
+		WillReturnRows(pgxmock.NewRows([]string{"phone_hash", "id"}).AddRow("known", userID))
+
+	matched, err := repo.MatchPhoneHashes(context.Background(), hashes)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uuid.UUID{"known": userID}, matched)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_CreateContacts_SkipsExisting(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID, newID, existingID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(createContactsQuery).
+		WithArgs(userID, []uuid.UUID{newID, existingID}, pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"contact_user_id"}).AddRow(newID))
+
+	created, err := repo.CreateContacts(context.Background(), userID, []uuid.UUID{newID, existingID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{newID}, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_SavePendingImports_Error(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+
+	mock.ExpectExec(savePendingImportsQuery).
+		WithArgs(userID, []string{"hash"}).
+		WillReturnError(fmt.Errorf("database error"))
+
+	err = repo.SavePendingImports(context.Background(), userID, []string{"hash"})
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactRepository_ClaimPendingImports_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	newUserID, ownerID := uuid.New(), uuid.New()
+
+	mock.ExpectQuery(claimPendingImportsQuery).
+		WithArgs("hash", newUserID, pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(ownerID))
+
+	owners, err := repo.ClaimPendingImports(context.Background(), "hash", newUserID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{ownerID}, owners)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
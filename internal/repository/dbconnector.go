@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/exaring/otelpgx"
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -26,6 +27,7 @@ func NewPgxPool(ctx context.Context, conf *config.DBConfig) (*pgxpool.Pool, erro
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse connection string: %w", err)
 	}
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer()
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
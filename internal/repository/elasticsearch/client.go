@@ -1,11 +1,14 @@
 package elasticsearch
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
 	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
 type Client struct {
@@ -18,11 +21,11 @@ func NewClient(url, index, username, password string) (*Client, error) {
 		Addresses: []string{url},
 		Username:  username,
 		Password:  password,
-		Transport: &http.Transport{
+		Transport: tracing.HTTPTransport(&http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: true,
 			},
-		},
+		}),
 	}
 
 	client, err := opensearch.NewClient(cfg)
@@ -41,3 +44,17 @@ func NewClient(url, index, username, password string) (*Client, error) {
 func (c *Client) GetClient() *opensearch.Client {
 	return c.client
 }
+
+// Ping проверяет доступность кластера OpenSearch, используется проверкой здоровья сервиса
+func (c *Client) Ping(ctx context.Context) error {
+	res, err := opensearchapi.PingRequest{}.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("opensearch is unavailable: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("opensearch ping failed: %s", res.Status())
+	}
+	return nil
+}
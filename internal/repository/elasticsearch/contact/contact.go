@@ -93,6 +93,11 @@ func (r *ContactSearchRepository) CreateIndex(ctx context.Context) error {
 					"analyzer": "edge_ngram_analyzer",
 					"search_analyzer": "standard"
 				},
+				"alias": {
+					"type": "text",
+					"analyzer": "edge_ngram_analyzer",
+					"search_analyzer": "standard"
+				},
 				"phone_number": {
 					"type": "keyword"
 				}
@@ -128,7 +133,7 @@ func (r *ContactSearchRepository) CreateIndex(ctx context.Context) error {
 	return nil
 }
 
-func (r *ContactSearchRepository) IndexContact(ctx context.Context, userID, contactUserID, username, name, phoneNumber string) error {
+func (r *ContactSearchRepository) IndexContact(ctx context.Context, userID, contactUserID, username, name, phoneNumber, alias string) error {
 	const op = "ContactSearchRepository.IndexContact"
 	logger := domains.GetLogger(ctx).WithField("operation", op)
 
@@ -138,6 +143,7 @@ func (r *ContactSearchRepository) IndexContact(ctx context.Context, userID, cont
 		"username":        username,
 		"name":            name,
 		"phone_number":    phoneNumber,
+		"alias":           alias,
 	}
 
 	data, err := json.Marshal(doc)
@@ -190,6 +196,14 @@ func (r *ContactSearchRepository) SearchContacts(ctx context.Context, userID, qu
 					map[string]interface{}{
 						"bool": map[string]interface{}{
 							"should": []interface{}{
+								map[string]interface{}{
+									"match": map[string]interface{}{
+										"alias": map[string]interface{}{
+											"query": query,
+											"boost": 3.0,
+										},
+									},
+								},
 								map[string]interface{}{
 									"match": map[string]interface{}{
 										"username": map[string]interface{}{
@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+)
+
+type UserSearchRepositoryInterface interface {
+	CreateIndex(ctx context.Context) error
+	IndexUser(ctx context.Context, userID, username, name string) error
+	SearchUsers(ctx context.Context, query string, params SearchParams) ([]string, int, error)
+}
@@ -0,0 +1,312 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+const (
+	// Прибавка к релевантности: контакты выше участников общих групп, те выше остальных
+	contactBoost   = 10.0
+	groupPeerBoost = 5.0
+)
+
+// SearchParams - параметры глобального поиска людей
+type SearchParams struct {
+	ExcludeIDs []string // не попадают в выдачу (сам ищущий)
+	ContactIDs []string
+	PeerIDs    []string // участники общих групп
+	Offset     int
+	Limit      int
+}
+
+type UserSearchRepository struct {
+	client *opensearch.Client
+	index  string
+}
+
+func NewUserSearchRepository(client *opensearch.Client, index string) *UserSearchRepository {
+	return &UserSearchRepository{
+		client: client,
+		index:  index,
+	}
+}
+
+func (r *UserSearchRepository) CreateIndex(ctx context.Context) error {
+	const op = "UserSearchRepository.CreateIndex"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	logger.Info("checking if index exists")
+	existsReq := opensearchapi.IndicesExistsRequest{
+		Index: []string{r.index},
+	}
+
+	existsRes, err := existsReq.Do(ctx, r.client)
+	if err != nil {
+		logger.WithError(err).Error("failed to check if index exists")
+		return fmt.Errorf("%s: failed to check if index exists: %w", op, err)
+	}
+	defer existsRes.Body.Close()
+
+	if existsRes.StatusCode == 200 {
+		logger.Warning("deleting existing index for clean state")
+		deleteReq := opensearchapi.IndicesDeleteRequest{
+			Index: []string{r.index},
+		}
+		deleteRes, err := deleteReq.Do(ctx, r.client)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete existing index")
+			return fmt.Errorf("%s: failed to delete existing index: %w", op, err)
+		}
+		defer deleteRes.Body.Close()
+	}
+
+	mapping := `{
+		"settings": {
+			"number_of_shards": 1,
+			"number_of_replicas": 0,
+			"analysis": {
+				"tokenizer": {
+					"edge_ngram_tokenizer": {
+						"type": "edge_ngram",
+						"min_gram": 2,
+						"max_gram": 20,
+						"token_chars": ["letter", "digit"]
+					}
+				},
+				"analyzer": {
+					"edge_ngram_analyzer": {
+						"type": "custom",
+						"tokenizer": "edge_ngram_tokenizer",
+						"filter": ["lowercase"]
+					}
+				}
+			}
+		},
+		"mappings": {
+			"properties": {
+				"user_id": {
+					"type": "keyword"
+				},
+				"username": {
+					"type": "text",
+					"analyzer": "edge_ngram_analyzer",
+					"search_analyzer": "standard"
+				},
+				"name": {
+					"type": "text",
+					"analyzer": "edge_ngram_analyzer",
+					"search_analyzer": "standard"
+				}
+			}
+		}
+	}`
+
+	createReq := opensearchapi.IndicesCreateRequest{
+		Index: r.index,
+		Body:  strings.NewReader(mapping),
+	}
+
+	logger.WithField("index", r.index).Info("creating elasticsearch index")
+	createRes, err := createReq.Do(ctx, r.client)
+	if err != nil {
+		logger.WithError(err).Error("failed to create index")
+		return fmt.Errorf("%s: failed to create index: %w", op, err)
+	}
+	defer createRes.Body.Close()
+
+	if createRes.IsError() {
+		var errBody map[string]interface{}
+		if err := json.NewDecoder(createRes.Body).Decode(&errBody); err == nil {
+			errBytes, _ := json.Marshal(errBody)
+			logger.WithField("error_body", string(errBytes)).Error("failed to create index")
+			return fmt.Errorf("%s: failed to create index: %s", op, string(errBytes))
+		}
+		logger.WithField("status", createRes.Status()).Error("failed to create index")
+		return fmt.Errorf("%s: failed to create index: %s", op, createRes.Status())
+	}
+
+	logger.Info("elasticsearch index created successfully")
+	return nil
+}
+
+// IndexUser добавляет профиль в индекс или заменяет уже проиндексированный
+func (r *UserSearchRepository) IndexUser(ctx context.Context, userID, username, name string) error {
+	const op = "UserSearchRepository.IndexUser"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	doc := map[string]interface{}{
+		"user_id":  userID,
+		"username": username,
+		"name":     name,
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal document")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("doc_id", userID).Info("indexing user")
+
+	req := opensearchapi.IndexRequest{
+		Index:      r.index,
+		DocumentID: userID,
+		Body:       bytes.NewReader(data),
+		Refresh:    "true",
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		logger.WithError(err).Error("failed to index document")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		logger.WithField("response", res.String()).Error("failed to index document")
+		return fmt.Errorf("%s: failed to index document: %s", op, res.String())
+	}
+
+	logger.Info("user indexed successfully")
+	return nil
+}
+
+// SearchUsers ищет людей по префиксу username и имени.
+// Возвращает id найденных пользователей в порядке релевантности и общее число совпадений
+func (r *UserSearchRepository) SearchUsers(ctx context.Context, query string, params SearchParams) ([]string, int, error) {
+	const op = "UserSearchRepository.SearchUsers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	logger.WithField("query", query).Info("searching users")
+
+	boolQuery := map[string]interface{}{
+		"must": []interface{}{
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"should": []interface{}{
+						map[string]interface{}{
+							"match": map[string]interface{}{
+								"username": map[string]interface{}{
+									"query": query,
+									"boost": 2.0,
+								},
+							},
+						},
+						map[string]interface{}{
+							"match": map[string]interface{}{
+								"name": map[string]interface{}{
+									"query":    query,
+									"operator": "and",
+								},
+							},
+						},
+					},
+					"minimum_should_match": 1,
+				},
+			},
+		},
+	}
+
+	if len(params.ExcludeIDs) > 0 {
+		boolQuery["must_not"] = []interface{}{
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"user_id": params.ExcludeIDs,
+				},
+			},
+		}
+	}
+
+	// should рядом с must не сужает выдачу, а только поднимает совпавших выше
+	should := make([]interface{}, 0, 2)
+	if len(params.ContactIDs) > 0 {
+		should = append(should, boostByIDs(params.ContactIDs, contactBoost))
+	}
+	if len(params.PeerIDs) > 0 {
+		should = append(should, boostByIDs(params.PeerIDs, groupPeerBoost))
+	}
+	if len(should) > 0 {
+		boolQuery["should"] = should
+	}
+
+	searchQuery := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": boolQuery,
+		},
+		"from":             params.Offset,
+		"size":             params.Limit,
+		"track_total_hits": true,
+		"_source":          []string{"user_id"},
+	}
+
+	queryBody, err := json.Marshal(searchQuery)
+	if err != nil {
+		logger.WithError(err).Error("failed to marshal search query")
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	req := opensearchapi.SearchRequest{
+		Index: []string{r.index},
+		Body:  bytes.NewReader(queryBody),
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		logger.WithError(err).Error("failed to execute search")
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		logger.WithField("response", res.String()).Error("search returned error")
+		return nil, 0, fmt.Errorf("%s: search error: %s", op, res.String())
+	}
+
+	var result struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source struct {
+					UserID string `json:"user_id"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		logger.WithError(err).Error("failed to decode search response")
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := make([]string, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		if hit.Source.UserID != "" {
+			ids = append(ids, hit.Source.UserID)
+		}
+	}
+
+	logger.WithField("results_count", len(ids)).WithField("total", result.Hits.Total.Value).Info("search completed")
+	return ids, result.Hits.Total.Value, nil
+}
+
+func boostByIDs(ids []string, boost float64) map[string]interface{} {
+	return map[string]interface{}{
+		"constant_score": map[string]interface{}{
+			"filter": map[string]interface{}{
+				"terms": map[string]interface{}{
+					"user_id": ids,
+				},
+			},
+			"boost": boost,
+		},
+	}
+}
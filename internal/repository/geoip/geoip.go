@@ -0,0 +1,132 @@
+package geoip
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Locator определяет примерное местоположение по IP адресу
+type Locator interface {
+	Lookup(ip string) string
+}
+
+// NoopLocator используется, когда база GeoIP не настроена
+type NoopLocator struct{}
+
+func (NoopLocator) Lookup(string) string {
+	return ""
+}
+
+type ipRange struct {
+	from     netip.Addr
+	to       netip.Addr
+	location string
+}
+
+// CSVLocator ищет местоположение в офлайн базе диапазонов IP.
+// Формат строки файла: ip_from,ip_to,country[,city]
+type CSVLocator struct {
+	ranges []ipRange
+}
+
+func NewCSVLocator(path string) (*CSVLocator, error) {
+	const op = "geoip.NewCSVLocator"
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer file.Close()
+
+	locator, err := newCSVLocatorFromReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return locator, nil
+}
+
+func newCSVLocatorFromReader(r io.Reader) (*CSVLocator, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var ranges []ipRange
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) < 3 {
+			return nil, fmt.Errorf("invalid record: %v", record)
+		}
+
+		from, err := netip.ParseAddr(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip_from %q: %w", record[0], err)
+		}
+
+		to, err := netip.ParseAddr(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip_to %q: %w", record[1], err)
+		}
+
+		if to.Less(from) {
+			return nil, fmt.Errorf("invalid range %s-%s", from, to)
+		}
+
+		parts := make([]string, 0, 2)
+		if len(record) > 3 && record[3] != "" {
+			parts = append(parts, record[3])
+		}
+		if record[2] != "" {
+			parts = append(parts, record[2])
+		}
+
+		ranges = append(ranges, ipRange{
+			from:     from.Unmap(),
+			to:       to.Unmap(),
+			location: strings.Join(parts, ", "),
+		})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].from.Less(ranges[j].from)
+	})
+
+	return &CSVLocator{ranges: ranges}, nil
+}
+
+func (l *CSVLocator) Lookup(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	// Первый диапазон, начало которого больше адреса
+	idx := sort.Search(len(l.ranges), func(i int) bool {
+		return addr.Less(l.ranges[i].from)
+	})
+	if idx == 0 {
+		return ""
+	}
+
+	candidate := l.ranges[idx-1]
+	if candidate.to.Less(addr) || candidate.from.BitLen() != addr.BitLen() {
+		return ""
+	}
+
+	return candidate.location
+}
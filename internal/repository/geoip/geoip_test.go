@@ -0,0 +1,67 @@
+package geoip
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDB = `# ip_from,ip_to,country,city
+5.255.255.0,5.255.255.255,Russia,Moscow
+77.88.0.0,77.88.63.255,Russia,
+2a02:6b8::,2a02:6b8:ffff:ffff:ffff:ffff:ffff:ffff,Russia,Saint Petersburg
+`
+
+func TestCSVLocator_Lookup(t *testing.T) {
+	locator, err := newCSVLocatorFromReader(strings.NewReader(testDB))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ip   string
+		want string
+	}{
+		{name: "city and country", ip: "5.255.255.10", want: "Moscow, Russia"},
+		{name: "country only", ip: "77.88.10.1", want: "Russia"},
+		{name: "range end", ip: "77.88.63.255", want: "Russia"},
+		{name: "ipv4 mapped", ip: "::ffff:5.255.255.1", want: "Moscow, Russia"},
+		{name: "ipv6", ip: "2a02:6b8::1", want: "Saint Petersburg, Russia"},
+		{name: "not found", ip: "10.0.0.1", want: ""},
+		{name: "before first range", ip: "1.1.1.1", want: ""},
+		{name: "invalid ip", ip: "not-an-ip", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, locator.Lookup(tt.ip))
+		})
+	}
+}
+
+func TestCSVLocator_InvalidRecord(t *testing.T) {
+	_, err := newCSVLocatorFromReader(strings.NewReader("1.1.1.1,bad,Russia\n"))
+	assert.Error(t, err)
+
+	_, err = newCSVLocatorFromReader(strings.NewReader("2.2.2.2,1.1.1.1,Russia\n"))
+	assert.Error(t, err)
+}
+
+func TestNewCSVLocator_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	require.NoError(t, os.WriteFile(path, []byte(testDB), 0o600))
+
+	locator, err := NewCSVLocator(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Moscow, Russia", locator.Lookup("5.255.255.1"))
+
+	_, err = NewCSVLocator(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.Error(t, err)
+}
+
+func TestNoopLocator_Lookup(t *testing.T) {
+	assert.Equal(t, "", NoopLocator{}.Lookup("5.255.255.1"))
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Handle пользователей и чатов лежат в разных таблицах, поэтому общая уникальность
+// обеспечивается блокировкой на время транзакции и проверкой обеих таблиц и редиректов
+const (
+	lockHandleQuery = `SELECT pg_advisory_xact_lock(hashtext($1))`
+
+	handleTakenQuery = `
+		SELECT EXISTS (SELECT 1 FROM "user" WHERE LOWER(username) = $1 AND id <> $2)
+		    OR EXISTS (SELECT 1 FROM chat WHERE LOWER(username) = $1 AND id <> $2)
+		    OR EXISTS (
+		        SELECT 1 FROM username_redirect
+		        WHERE username = $1 AND expires_at > NOW() AND COALESCE(user_id, chat_id) <> $2)`
+
+	releaseRedirectQuery = `DELETE FROM username_redirect WHERE username = $1`
+
+	keepUserRedirectQuery = `
+		INSERT INTO username_redirect (username, user_id, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (username) DO UPDATE SET user_id = EXCLUDED.user_id, chat_id = NULL, expires_at = EXCLUDED.expires_at`
+
+	keepChatRedirectQuery = `
+		INSERT INTO username_redirect (username, chat_id, expires_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (username) DO UPDATE SET chat_id = EXCLUDED.chat_id, user_id = NULL, expires_at = EXCLUDED.expires_at`
+)
+
+// Claim проверяет, что handle свободен для владельца, и снимает с него устаревший или собственный редирект.
+// Блокировка держится до конца транзакции, поэтому handle нужно записать в той же транзакции
+func Claim(ctx context.Context, tx pgx.Tx, ownerID uuid.UUID, handle string) error {
+	const op = "handle.Claim"
+
+	normalized := models.Normalize(handle)
+
+	if _, err := tx.Exec(ctx, lockHandleQuery, "handle:"+normalized); err != nil {
+		return fmt.Errorf("%s: lock: %w", op, err)
+	}
+
+	var taken bool
+	if err := tx.QueryRow(ctx, handleTakenQuery, normalized, ownerID).Scan(&taken); err != nil {
+		return fmt.Errorf("%s: check: %w", op, err)
+	}
+	if taken {
+		return errs.ErrIsDuplicateKey
+	}
+
+	if _, err := tx.Exec(ctx, releaseRedirectQuery, normalized); err != nil {
+		return fmt.Errorf("%s: release redirect: %w", op, err)
+	}
+
+	return nil
+}
+
+// KeepRedirect оставляет прежний handle за владельцем до until
+func KeepRedirect(ctx context.Context, tx pgx.Tx, ownerKind string, ownerID uuid.UUID, oldHandle string, until time.Time) error {
+	const op = "handle.KeepRedirect"
+
+	query := keepUserRedirectQuery
+	if ownerKind == models.OwnerChat {
+		query = keepChatRedirectQuery
+	}
+
+	if _, err := tx.Exec(ctx, query, models.Normalize(oldHandle), ownerID, until); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaim_Free(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	ownerID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockHandleQuery).WithArgs("handle:alice").WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(handleTakenQuery).WithArgs("alice", ownerID).
+		WillReturnRows(pgxmock.NewRows([]string{"taken"}).AddRow(false))
+	mock.ExpectExec(releaseRedirectQuery).WithArgs("alice").WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	tx, err := mock.Begin(context.Background())
+	require.NoError(t, err)
+
+	assert.NoError(t, Claim(context.Background(), tx, ownerID, "@Alice"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaim_Taken(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	ownerID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockHandleQuery).WithArgs("handle:alice").WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(handleTakenQuery).WithArgs("alice", ownerID).
+		WillReturnRows(pgxmock.NewRows([]string{"taken"}).AddRow(true))
+
+	tx, err := mock.Begin(context.Background())
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, Claim(context.Background(), tx, ownerID, "alice"), errs.ErrIsDuplicateKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaim_LockError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(lockHandleQuery).WithArgs("handle:alice").WillReturnError(errors.New("db down"))
+
+	tx, err := mock.Begin(context.Background())
+	require.NoError(t, err)
+
+	assert.Error(t, Claim(context.Background(), tx, uuid.New(), "alice"))
+}
+
+func TestKeepRedirect_Chat(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer mock.Close()
+
+	chatID := uuid.New()
+	until := time.Now().Add(models.RedirectGracePeriod)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(keepChatRedirectQuery).WithArgs("old_news", chatID, until).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	tx, err := mock.Begin(context.Background())
+	require.NoError(t, err)
+
+	assert.NoError(t, KeepRedirect(context.Background(), tx, models.OwnerChat, chatID, "Old_News", until))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,12 +2,16 @@ package messages
 
 import (
 	"context"
+	"errors"
 
 	modelsAttachment "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/attachment"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/outbox"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -16,6 +20,12 @@ const (
 						($1, $2, $3, $4, $5::message_type_enum)
 						RETURNING id`
 
+	updateMessageQuery = `
+		UPDATE message SET text = $1, updated_at = NOW() WHERE id = $2
+		RETURNING chat_id, user_id, created_at, updated_at, message_type::text`
+
+	deleteMessageQuery = `DELETE FROM message WHERE id = $1 RETURNING chat_id`
+
 	insertAttachmentQuery = `
 		INSERT INTO attachment (id, attachment_type, file_name, file_size, content_disposition, duration)
 		VALUES ($1, $2::attachment_type_enum, $3, $4, $5, $6)`
@@ -109,6 +119,36 @@ const (
 		LEFT JOIN attachment a ON a.id = ma.attachment_id
 		WHERE cm.user_id = $1 AND msg.chat_id = $2 AND msg.text ILIKE '%' || $3 || '%'
 		ORDER BY msg.created_at DESC`
+
+	insertMentionsQuery = `
+		INSERT INTO message_mention (message_id, chat_id, user_id)
+		SELECT $1, cm.chat_id, cm.user_id
+		FROM chat_member cm
+		WHERE cm.chat_id = $2 AND cm.user_id = ANY($3)
+		ON CONFLICT (message_id, user_id) DO NOTHING
+		RETURNING user_id`
+
+	deleteMentionsExceptQuery = `
+		DELETE FROM message_mention
+		WHERE message_id = $1 AND NOT (user_id = ANY($2))`
+
+	getUnreadMentionsQuery = `
+		SELECT 
+			msg.id, msg.chat_id, msg.user_id, usr.name, 
+			msg.text, msg.created_at, msg.updated_at, msg.message_type::text,
+			a.id, a.attachment_type::text, a.file_name, a.file_size, a.content_disposition, a.duration
+		FROM message_mention mm
+		JOIN message msg ON msg.id = mm.message_id
+		LEFT JOIN "user" usr ON usr.id = msg.user_id
+		LEFT JOIN message_attachment ma ON ma.message_id = msg.id
+		LEFT JOIN attachment a ON a.id = ma.attachment_id
+		WHERE mm.user_id = $1 AND mm.chat_id = $2 AND mm.read_at IS NULL
+		ORDER BY msg.created_at DESC`
+
+	markMentionsReadQuery = `
+		UPDATE message_mention
+		SET read_at = NOW()
+		WHERE user_id = $1 AND chat_id = $2 AND read_at IS NULL`
 )
 
 type MessageRepository struct {
@@ -177,8 +217,16 @@ func (r *MessageRepository) InsertMessage(ctx context.Context, msg modelsMessage
 
 	logger.Debugf("starting: %s", query)
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: begin transaction error: status: %s", query, queryStatus)
+		return uuid.Nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	var id uuid.UUID
-	err := r.db.QueryRow(ctx, insertMessageQuery, msg.ChatID, msg.UserID, msg.Text, msg.CreatedAt, msg.Type).
+	err = tx.QueryRow(ctx, insertMessageQuery, msg.ChatID, msg.UserID, msg.Text, msg.CreatedAt, msg.Type).
 		Scan(&id)
 	if err != nil {
 		queryStatus = "fail"
@@ -186,9 +234,60 @@ func (r *MessageRepository) InsertMessage(ctx context.Context, msg modelsMessage
 		return uuid.Nil, err
 	}
 
+	// Привязываем загруженное заранее вложение, чтобы событие о сообщении ушло уже с ним
+	if msg.PendingAttachmentID != nil {
+		_, err = tx.Exec(ctx, deletePendingAttachmentQuery, *msg.PendingAttachmentID)
+		if err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: delete pending_attachment error: status: %s", query, queryStatus)
+			return uuid.Nil, err
+		}
+
+		_, err = tx.Exec(ctx, insertMessageAttachmentQuery, id, *msg.PendingAttachmentID, msg.UserID)
+		if err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: insert message_attachment error: status: %s", query, queryStatus)
+			return uuid.Nil, err
+		}
+	}
+
+	if err := insertMessageEvent(ctx, tx, modelsOutbox.EventMessageCreated, createdMessagePayload(id, msg)); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert outbox event error: status: %s", query, queryStatus)
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: commit transaction error: status: %s", query, queryStatus)
+		return uuid.Nil, err
+	}
+
 	return id, nil
 }
 
+func createdMessagePayload(id uuid.UUID, msg modelsMessage.CreateMessage) modelsOutbox.MessagePayload {
+	return modelsOutbox.MessagePayload{
+		MessageID:     id,
+		ChatID:        msg.ChatID,
+		UserID:        msg.UserID,
+		Text:          msg.Text,
+		Type:          msg.Type,
+		HasAttachment: msg.Attachment != nil || msg.PendingAttachmentID != nil,
+		CreatedAt:     msg.CreatedAt,
+		UpdatedAt:     msg.CreatedAt,
+	}
+}
+
+func insertMessageEvent(ctx context.Context, tx pgx.Tx, eventType string, payload modelsOutbox.MessagePayload) error {
+	event, err := modelsOutbox.MessageEvent(eventType, payload)
+	if err != nil {
+		return err
+	}
+
+	return outbox.InsertEvent(ctx, tx, event)
+}
+
 func (r *MessageRepository) GetLastMessagesOfChats(ctx context.Context, userId uuid.UUID) ([]modelsMessage.Message, error) {
 	const op = "MessageRepository.GetLastMessagesOfChats"
 	const query = "SELECT last messages"
@@ -311,16 +410,39 @@ func (r *MessageRepository) UpdateMessage(ctx context.Context, messageID uuid.UU
 
 	logger.Debugf("starting: %s", query)
 
-	_, err := r.db.Exec(ctx,
-		`UPDATE message SET text = $1, updated_at = NOW() WHERE id = $2`,
-		newText, messageID,
-	)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: begin transaction error: status: %s", query, queryStatus)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	payload := modelsOutbox.MessagePayload{MessageID: messageID, Text: newText}
+	err = tx.QueryRow(ctx, updateMessageQuery, newText, messageID).
+		Scan(&payload.ChatID, &payload.UserID, &payload.CreatedAt, &payload.UpdatedAt, &payload.Type)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Сообщение уже удалено: менять нечего, событие не нужно
+		return nil
+	}
 	if err != nil {
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
 		return err
 	}
 
+	if err := insertMessageEvent(ctx, tx, modelsOutbox.EventMessageUpdated, payload); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert outbox event error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: commit transaction error: status: %s", query, queryStatus)
+		return err
+	}
+
 	return nil
 }
 
@@ -338,16 +460,37 @@ func (r *MessageRepository) DeleteMessage(ctx context.Context, messageID uuid.UU
 
 	logger.Debugf("starting: %s", query)
 
-	_, err := r.db.Exec(ctx,
-		`DELETE FROM message WHERE id = $1`,
-		messageID,
-	)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: begin transaction error: status: %s", query, queryStatus)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	payload := modelsOutbox.MessagePayload{MessageID: messageID}
+	err = tx.QueryRow(ctx, deleteMessageQuery, messageID).Scan(&payload.ChatID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
 	if err != nil {
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
 		return err
 	}
 
+	if err := insertMessageEvent(ctx, tx, modelsOutbox.EventMessageDeleted, payload); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert outbox event error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: commit transaction error: status: %s", query, queryStatus)
+		return err
+	}
+
 	return nil
 }
 
@@ -483,6 +626,12 @@ func (r *MessageRepository) InsertMessageWithAttachment(ctx context.Context, msg
 		}
 	}
 
+	if err := insertMessageEvent(ctx, tx, modelsOutbox.EventMessageCreated, createdMessagePayload(messageID, msg)); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert outbox event error: status: %s", query, queryStatus)
+		return uuid.Nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: commit transaction error: status: %s", query, queryStatus)
@@ -632,13 +781,12 @@ func (r *MessageRepository) CheckAttachmentOwnership(ctx context.Context, attach
 	return exists, nil
 }
 
-func (r *MessageRepository) LinkAttachmentToMessage(ctx context.Context, messageID, attachmentID, userID uuid.UUID) error {
-	const op = "MessageRepository.LinkAttachmentToMessage"
-	const query = "LINK attachment to message"
+func (r *MessageRepository) GetMessageAttachments(ctx context.Context, messageID uuid.UUID) (*modelsAttachment.Attachment, error) {
+	const op = "MessageRepository.GetMessageAttachments"
+	const query = "SELECT message attachments"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op).
-		WithField("message_id", messageID.String()).
-		WithField("attachment_id", attachmentID.String())
+		WithField("message_id", messageID.String())
 
 	queryStatus := "success"
 	defer func() {
@@ -647,45 +795,140 @@ func (r *MessageRepository) LinkAttachmentToMessage(ctx context.Context, message
 
 	logger.Debugf("starting: %s", query)
 
-	tx, err := r.db.Begin(ctx)
+	var attachment modelsAttachment.Attachment
+	err := r.db.QueryRow(ctx, getMessageAttachmentsQuery, messageID).
+		Scan(&attachment.ID, &attachment.Type, &attachment.FileName, &attachment.FileSize,
+			&attachment.ContentDisposition, &attachment.Duration, &attachment.CreatedAt, &attachment.UpdatedAt)
 	if err != nil {
-		queryStatus = "fail"
-		logger.WithError(err).Errorf("db query: %s: begin transaction error: status: %s", query, queryStatus)
-		return err
+		// Если вложений нет, возвращаем nil
+		return nil, nil
 	}
-	defer tx.Rollback(ctx)
 
-	// Удаляем из pending_attachment
-	_, err = tx.Exec(ctx, deletePendingAttachmentQuery, attachmentID)
+	return &attachment, nil
+}
+
+// InsertMentions сохраняет упоминания участников чата в сообщении и возвращает
+// пользователей, для которых упоминание добавлено впервые. Не участники чата пропускаются.
+func (r *MessageRepository) InsertMentions(ctx context.Context, messageID, chatID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "MessageRepository.InsertMentions"
+	const query = "INSERT mentions"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("message_id", messageID.String()).
+		WithField("chat_id", chatID.String())
+
+	queryStatus := "success"
+	count := 0
+	defer func() {
+		logger.Debugf("db query: %s: status: %s, count: %d", query, queryStatus, count)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, insertMentionsQuery, messageID, chatID, userIDs)
 	if err != nil {
 		queryStatus = "fail"
-		logger.WithError(err).Errorf("db query: %s: delete pending_attachment error: status: %s", query, queryStatus)
-		return err
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]uuid.UUID, 0, len(userIDs))
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+
+		result = append(result, userID)
 	}
 
-	// Добавляем в message_attachment
-	_, err = tx.Exec(ctx, insertMessageAttachmentQuery, messageID, attachmentID, userID)
-	if err != nil {
+	if err := rows.Err(); err != nil {
 		queryStatus = "fail"
-		logger.WithError(err).Errorf("db query: %s: insert message_attachment error: status: %s", query, queryStatus)
-		return err
+		logger.WithError(err).Errorf("db query: %s: rows error: status: %s", query, queryStatus)
+		return nil, err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
+	count = len(result)
+
+	return result, nil
+}
+
+// DeleteMentionsExcept удаляет упоминания пользователей, которых больше нет в тексте сообщения
+func (r *MessageRepository) DeleteMentionsExcept(ctx context.Context, messageID uuid.UUID, userIDs []uuid.UUID) error {
+	const op = "MessageRepository.DeleteMentionsExcept"
+	const query = "DELETE mentions"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("message_id", messageID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	_, err := r.db.Exec(ctx, deleteMentionsExceptQuery, messageID, userIDs)
+	if err != nil {
 		queryStatus = "fail"
-		logger.WithError(err).Errorf("db query: %s: commit transaction error: status: %s", query, queryStatus)
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
 		return err
 	}
 
 	return nil
 }
 
-func (r *MessageRepository) GetMessageAttachments(ctx context.Context, messageID uuid.UUID) (*modelsAttachment.Attachment, error) {
-	const op = "MessageRepository.GetMessageAttachments"
-	const query = "SELECT message attachments"
+func (r *MessageRepository) GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]modelsMessage.Message, error) {
+	const op = "MessageRepository.GetUnreadMentions"
+	const query = "SELECT unread mentions"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op).
-		WithField("message_id", messageID.String())
+		WithField("user_id", userID.String()).
+		WithField("chat_id", chatID.String())
+
+	queryStatus := "success"
+	count := 0
+	defer func() {
+		logger.Debugf("db query: %s: status: %s, count: %d", query, queryStatus, count)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getUnreadMentionsQuery, userID, chatID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]modelsMessage.Message, 0)
+	for rows.Next() {
+		var message modelsMessage.Message
+		if err := scanMessageWithAttachment(rows, &message); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+
+		result = append(result, message)
+	}
+
+	count = len(result)
+
+	return result, nil
+}
+
+func (r *MessageRepository) MarkMentionsRead(ctx context.Context, userID, chatID uuid.UUID) error {
+	const op = "MessageRepository.MarkMentionsRead"
+	const query = "UPDATE mentions read"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("chat_id", chatID.String())
 
 	queryStatus := "success"
 	defer func() {
@@ -694,14 +937,12 @@ func (r *MessageRepository) GetMessageAttachments(ctx context.Context, messageID
 
 	logger.Debugf("starting: %s", query)
 
-	var attachment modelsAttachment.Attachment
-	err := r.db.QueryRow(ctx, getMessageAttachmentsQuery, messageID).
-		Scan(&attachment.ID, &attachment.Type, &attachment.FileName, &attachment.FileSize,
-			&attachment.ContentDisposition, &attachment.Duration, &attachment.CreatedAt, &attachment.UpdatedAt)
+	_, err := r.db.Exec(ctx, markMentionsReadQuery, userID, chatID)
 	if err != nil {
-		// Если вложений нет, возвращаем nil
-		return nil, nil
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
 	}
 
-	return &attachment, nil
+	return nil
 }
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/outbox"
 	"github.com/google/uuid"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
@@ -35,11 +37,17 @@ func TestMessageRepository_InsertMessage_Success(t *testing.T) {
 
 	rows := pgxmock.NewRows([]string{"id"}).AddRow(expectedID)
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO message (chat_id, user_id, text, created_at, message_type) VALUES
 						($1, $2, $3, $4, $5::message_type_enum)
 						RETURNING id`).
 		WithArgs(msg.ChatID, msg.UserID, msg.Text, msg.CreatedAt, msg.Type).
 		WillReturnRows(rows)
+	// Событие пишется в той же транзакции, что и сообщение
+	mock.ExpectExec(outbox.InsertEventQuery).
+		WithArgs(pgxmock.AnyArg(), "message.created:"+expectedID.String(), modelsOutbox.EventMessageCreated, expectedID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
 
 	ctx := context.Background()
 	gotID, err := repo.InsertMessage(ctx, msg)
@@ -69,11 +77,13 @@ func TestMessageRepository_InsertMessage_DBError(t *testing.T) {
 		Type:      "text",
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(`INSERT INTO message (chat_id, user_id, text, created_at, message_type) VALUES
 						($1, $2, $3, $4, $5::message_type_enum)
 						RETURNING id`).
 		WithArgs(msg.ChatID, msg.UserID, msg.Text, msg.CreatedAt, msg.Type).
 		WillReturnError(fmt.Errorf("db error"))
+	mock.ExpectRollback()
 
 	ctx := context.Background()
 	gotID, err := repo.InsertMessage(ctx, msg)
@@ -84,6 +94,50 @@ func TestMessageRepository_InsertMessage_DBError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMessageRepository_InsertMessage_LinksPendingAttachment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+
+	userID := uuid.New()
+	attachmentID := uuid.New()
+	expectedID := uuid.New()
+
+	msg := modelsMessage.CreateMessage{
+		ChatID:              uuid.New(),
+		UserID:              &userID,
+		Text:                "photo",
+		CreatedAt:           time.Now(),
+		Type:                "user",
+		PendingAttachmentID: &attachmentID,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO message`).
+		WithArgs(msg.ChatID, msg.UserID, msg.Text, msg.CreatedAt, msg.Type).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(expectedID))
+	mock.ExpectExec(`DELETE FROM pending_attachment`).
+		WithArgs(attachmentID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mock.ExpectExec(`INSERT INTO message_attachment`).
+		WithArgs(expectedID, attachmentID, msg.UserID).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO outbox_event`).
+		WithArgs(pgxmock.AnyArg(), "message.created:"+expectedID.String(), modelsOutbox.EventMessageCreated, expectedID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	gotID, err := repo.InsertMessage(context.Background(), msg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedID, gotID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestMessageRepository_GetLastMessagesOfChats_Success(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	if err != nil {
@@ -187,9 +241,20 @@ func TestMessageRepository_UpdateMessage_Success(t *testing.T) {
 	messageID := uuid.New()
 	newText := "Updated text"
 
-	mock.ExpectExec(`UPDATE message SET text = \$1, updated_at = NOW\(\) WHERE id = \$2`).
+	chatID := uuid.New()
+	userID := uuid.New()
+	updatedAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE message SET text = \$1, updated_at = NOW\(\) WHERE id = \$2`).
 		WithArgs(newText, messageID).
-		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		WillReturnRows(pgxmock.NewRows([]string{"chat_id", "user_id", "created_at", "updated_at", "message_type"}).
+			AddRow(chatID, &userID, updatedAt.Add(-time.Hour), updatedAt, "user"))
+	// Каждая правка - отдельное событие, ключ включает момент изменения
+	mock.ExpectExec(`INSERT INTO outbox_event`).
+		WithArgs(pgxmock.AnyArg(), fmt.Sprintf("message.updated:%s:%d", messageID, updatedAt.UnixNano()), modelsOutbox.EventMessageUpdated, messageID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
 
 	err = repo.UpdateMessage(ctx, messageID, newText)
 
@@ -208,9 +273,14 @@ func TestMessageRepository_DeleteMessage_Success(t *testing.T) {
 	ctx := context.Background()
 	messageID := uuid.New()
 
-	mock.ExpectExec(`DELETE FROM message WHERE id = \$1`).
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM message WHERE id = \$1`).
 		WithArgs(messageID).
-		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		WillReturnRows(pgxmock.NewRows([]string{"chat_id"}).AddRow(uuid.New()))
+	mock.ExpectExec(`INSERT INTO outbox_event`).
+		WithArgs(pgxmock.AnyArg(), "message.deleted:"+messageID.String(), modelsOutbox.EventMessageDeleted, messageID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
 
 	err = repo.DeleteMessage(ctx, messageID)
 
@@ -218,6 +288,29 @@ func TestMessageRepository_DeleteMessage_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestMessageRepository_DeleteMessage_AlreadyDeleted(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	messageID := uuid.New()
+
+	// Сообщения уже нет - событие не пишется
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM message WHERE id = \$1`).
+		WithArgs(messageID).
+		WillReturnRows(pgxmock.NewRows([]string{"chat_id"}))
+	mock.ExpectRollback()
+
+	err = repo.DeleteMessage(context.Background(), messageID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestMessageRepository_GetLastMessagesOfChatsByIDs_Success(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	if err != nil {
@@ -281,3 +374,128 @@ func TestMessageRepository_SearchMessagesInChat_Success(t *testing.T) {
 	assert.Len(t, messages, 2)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestMessageRepository_InsertMentions_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	ctx := context.Background()
+	messageID := uuid.New()
+	chatID := uuid.New()
+	memberID := uuid.New()
+	outsiderID := uuid.New()
+	userIDs := []uuid.UUID{memberID, outsiderID}
+
+	// Не участник чата не попадает в RETURNING
+	mock.ExpectQuery(insertMentionsQuery).
+		WithArgs(messageID, chatID, userIDs).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(memberID))
+
+	inserted, err := repo.InsertMentions(ctx, messageID, chatID, userIDs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{memberID}, inserted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_InsertMentions_DBError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	ctx := context.Background()
+	messageID := uuid.New()
+	chatID := uuid.New()
+	userIDs := []uuid.UUID{uuid.New()}
+
+	mock.ExpectQuery(insertMentionsQuery).
+		WithArgs(messageID, chatID, userIDs).
+		WillReturnError(fmt.Errorf("db error"))
+
+	inserted, err := repo.InsertMentions(ctx, messageID, chatID, userIDs)
+
+	assert.Error(t, err)
+	assert.Nil(t, inserted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_DeleteMentionsExcept_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	ctx := context.Background()
+	messageID := uuid.New()
+	userIDs := []uuid.UUID{uuid.New()}
+
+	mock.ExpectExec(deleteMentionsExceptQuery).
+		WithArgs(messageID, userIDs).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err = repo.DeleteMentionsExcept(ctx, messageID, userIDs)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_GetUnreadMentions_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	senderID := uuid.New()
+	senderName := "Sender"
+	now := time.Now()
+
+	rows := pgxmock.NewRows([]string{"id", "chat_id", "user_id", "name", "text", "created_at", "updated_at", "message_type", "attachment_id", "attachment_type", "file_name", "file_size", "content_disposition", "duration"}).
+		AddRow(uuid.New(), chatID, &senderID, &senderName, "@user привет", now, now, "user", nil, nil, nil, nil, nil, nil)
+
+	mock.ExpectQuery(getUnreadMentionsQuery).
+		WithArgs(userID, chatID).
+		WillReturnRows(rows)
+
+	messages, err := repo.GetUnreadMentions(ctx, userID, chatID)
+
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "@user привет", messages[0].Text)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMessageRepository_MarkMentionsRead_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewMessageRepository(mock)
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mock.ExpectExec(markMentionsReadQuery).
+		WithArgs(userID, chatID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+	err = repo.MarkMentionsRead(ctx, userID, chatID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
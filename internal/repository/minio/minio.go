@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/tracing"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -23,8 +24,9 @@ func NewMinioProvider(cfg config.MinioConfig) (*MinioProvider, error) {
 
 	endpoint := fmt.Sprintf("%s:%s", cfg.Host, cfg.PORT)
 	client, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(cfg.RootUser, cfg.RootPassword, ""),
-		Secure: false,
+		Creds:     credentials.NewStaticV4(cfg.RootUser, cfg.RootPassword, ""),
+		Secure:    false,
+		Transport: tracing.HTTPTransport(nil),
 	})
 
 	if err != nil {
@@ -179,3 +181,15 @@ type OperationError struct {
 	ObjectID string
 	Error    error
 }
+
+// Ping проверяет доступность MinIO и наличие бакета, используется проверкой здоровья сервиса
+func (m *MinioProvider) Ping(ctx context.Context) error {
+	exists, err := m.mc.BucketExists(ctx, m.bucketName)
+	if err != nil {
+		return fmt.Errorf("minio is unavailable: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("minio bucket %s does not exist", m.bucketName)
+	}
+	return nil
+}
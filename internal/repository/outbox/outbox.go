@@ -0,0 +1,209 @@
+package outbox
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotifyChannel канал LISTEN/NOTIFY, в который триггер сообщает о новых событиях
+const NotifyChannel = "outbox_event"
+
+// InsertEventQuery экспортирован для тестов репозиториев, пишущих события в своих транзакциях
+const InsertEventQuery = `
+		INSERT INTO outbox_event (id, dedup_key, event_type, aggregate_id, payload)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (dedup_key) DO NOTHING`
+
+const (
+	// Забираем событие в аренду: пока не истёк срок, другие экземпляры relay его не видят.
+	// Если экземпляр упадёт, не успев отметить доставку, событие снова станет доступно
+	claimEventsQuery = `
+		UPDATE outbox_event
+		SET available_at = NOW() + make_interval(secs => $2), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM outbox_event
+			WHERE published_at IS NULL AND available_at <= NOW()
+			ORDER BY created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, dedup_key, event_type, aggregate_id, payload, attempts, created_at`
+
+	markPublishedQuery = `
+		UPDATE outbox_event SET published_at = NOW(), last_error = NULL
+		WHERE id = ANY($1)`
+
+	markFailedQuery = `
+		UPDATE outbox_event SET last_error = $2, available_at = $3
+		WHERE id = $1`
+
+	deletePublishedQuery = `
+		DELETE FROM outbox_event
+		WHERE published_at IS NOT NULL AND published_at < $1`
+)
+
+// Execer часть pgx.Tx, нужная для записи события в транзакции другого репозитория
+type Execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// InsertEvent записывает событие в outbox. Вызывается внутри транзакции, меняющей данные,
+// чтобы событие появилось тогда и только тогда, когда изменение закоммичено
+func InsertEvent(ctx context.Context, tx Execer, event modelsOutbox.Event) error {
+	_, err := tx.Exec(ctx, InsertEventQuery, event.ID, event.DedupKey, event.Type, event.AggregateID, []byte(event.Payload))
+	return err
+}
+
+type OutboxRepository struct {
+	db pgxinterface.PgxPool
+}
+
+func NewOutboxRepository(db pgxinterface.PgxPool) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+func (r *OutboxRepository) ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]modelsOutbox.Event, error) {
+	const op = "OutboxRepository.ClaimEvents"
+	const query = "CLAIM outbox events"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	queryStatus := "success"
+	count := 0
+	defer func() {
+		logger.Debugf("db query: %s: status: %s, count: %d", query, queryStatus, count)
+	}()
+
+	rows, err := r.db.Query(ctx, claimEventsQuery, limit, lease.Seconds())
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]modelsOutbox.Event, 0, limit)
+	for rows.Next() {
+		var event modelsOutbox.Event
+		var payload []byte
+		if err := rows.Scan(&event.ID, &event.DedupKey, &event.Type, &event.AggregateID, &payload, &event.Attempts, &event.CreatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		event.Payload = payload
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	// RETURNING не сохраняет порядок подзапроса
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	count = len(events)
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, ids []uuid.UUID) error {
+	const op = "OutboxRepository.MarkPublished"
+	const query = "UPDATE outbox events published"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if _, err := r.db.Exec(ctx, markPublishedQuery, ids); err != nil {
+		logger.WithError(err).Errorf("db query: %s: execution error", query)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	const op = "OutboxRepository.MarkFailed"
+	const query = "UPDATE outbox event failed"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("event_id", id.String())
+
+	if _, err := r.db.Exec(ctx, markFailedQuery, id, reason, retryAt); err != nil {
+		logger.WithError(err).Errorf("db query: %s: execution error", query)
+		return err
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	const op = "OutboxRepository.DeletePublishedBefore"
+	const query = "DELETE published outbox events"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	tag, err := r.db.Exec(ctx, deletePublishedQuery, before)
+	if err != nil {
+		logger.WithError(err).Errorf("db query: %s: execution error", query)
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Listen держит отдельное соединение с LISTEN и вызывает onNotify на каждое уведомление о новых событиях.
+// При обрыве соединения переподключается; relay в это время продолжает опрашивать таблицу по таймеру
+func Listen(ctx context.Context, pool *pgxpool.Pool, onNotify func()) {
+	const op = "outbox.Listen"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	for ctx.Err() == nil {
+		if err := listen(ctx, pool, onNotify); err != nil && ctx.Err() == nil {
+			logger.WithError(err).Warn("outbox listener failed, reconnecting")
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+func listen(ctx context.Context, pool *pgxpool.Pool, onNotify func()) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// Соединение с активным LISTEN нельзя возвращать в пул
+	listenConn := conn.Hijack()
+	defer listenConn.Close(context.Background())
+
+	if _, err := listenConn.Exec(ctx, "LISTEN "+pgx.Identifier{NotifyChannel}.Sanitize()); err != nil {
+		return err
+	}
+
+	for {
+		if _, err := listenConn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		onNotify()
+	}
+}
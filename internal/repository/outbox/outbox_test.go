@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/google/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertEvent(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	messageID := uuid.New()
+	event, err := modelsOutbox.MessageEvent(modelsOutbox.EventMessageDeleted, modelsOutbox.MessagePayload{MessageID: messageID})
+	assert.NoError(t, err)
+
+	mock.ExpectExec(InsertEventQuery).
+		WithArgs(event.ID, "message.deleted:"+messageID.String(), modelsOutbox.EventMessageDeleted, messageID, []byte(event.Payload)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err = InsertEvent(context.Background(), mock, event)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_ClaimEvents_SortsByCreation(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewOutboxRepository(mock)
+
+	now := time.Now()
+	first, second := uuid.New(), uuid.New()
+
+	rows := pgxmock.NewRows([]string{"id", "dedup_key", "event_type", "aggregate_id", "payload", "attempts", "created_at"}).
+		AddRow(second, "k2", modelsOutbox.EventMessageCreated, uuid.New(), []byte(`{}`), 1, now).
+		AddRow(first, "k1", modelsOutbox.EventMessageCreated, uuid.New(), []byte(`{}`), 2, now.Add(-time.Second))
+
+	mock.ExpectQuery(`UPDATE outbox_event`).
+		WithArgs(10, float64(30)).
+		WillReturnRows(rows)
+
+	events, err := repo.ClaimEvents(context.Background(), 10, 30*time.Second)
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, first, events[0].ID)
+	assert.Equal(t, 2, events[0].Attempts)
+	assert.Equal(t, second, events[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_ClaimEvents_Error(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewOutboxRepository(mock)
+
+	mock.ExpectQuery(`UPDATE outbox_event`).
+		WithArgs(10, float64(30)).
+		WillReturnError(errors.New("db error"))
+
+	events, err := repo.ClaimEvents(context.Background(), 10, 30*time.Second)
+
+	assert.Error(t, err)
+	assert.Nil(t, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_MarkPublished(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewOutboxRepository(mock)
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mock.ExpectExec(`UPDATE outbox_event SET published_at = NOW\(\)`).
+		WithArgs(ids).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+	assert.NoError(t, repo.MarkPublished(context.Background(), ids))
+	// Пустой список не ходит в базу
+	assert.NoError(t, repo.MarkPublished(context.Background(), nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_MarkFailed(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewOutboxRepository(mock)
+	id := uuid.New()
+	retryAt := time.Now().Add(time.Minute)
+
+	mock.ExpectExec(`UPDATE outbox_event SET last_error = \$2, available_at = \$3`).
+		WithArgs(id, "boom", retryAt).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	assert.NoError(t, repo.MarkFailed(context.Background(), id, "boom", retryAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_DeletePublishedBefore(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewOutboxRepository(mock)
+	before := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectExec(`DELETE FROM outbox_event`).
+		WithArgs(before).
+		WillReturnResult(pgxmock.NewResult("DELETE", 7))
+
+	deleted, err := repo.DeletePublishedBefore(context.Background(), before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
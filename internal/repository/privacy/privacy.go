@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	getPrivacyRulesQuery = `
+		SELECT user_id, setting, visibility
+		FROM user_privacy
+		WHERE user_id = ANY($1)`
+
+	getPrivacyExceptionsQuery = `
+		SELECT user_id, setting, target_user_id, allow
+		FROM user_privacy_exception
+		WHERE user_id = ANY($1)`
+
+	upsertPrivacyRuleQuery = `
+		INSERT INTO user_privacy (user_id, setting, visibility, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id, setting) DO UPDATE
+		SET visibility = EXCLUDED.visibility, updated_at = NOW()`
+
+	deletePrivacyExceptionsQuery = `
+		DELETE FROM user_privacy_exception
+		WHERE user_id = $1 AND setting = $2`
+
+	insertPrivacyExceptionQuery = `
+		INSERT INTO user_privacy_exception (user_id, setting, target_user_id, allow)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, setting, target_user_id) DO UPDATE SET allow = EXCLUDED.allow`
+)
+
+type PrivacyRepository struct {
+	db pgxinterface.PgxPool
+}
+
+func New(db pgxinterface.PgxPool) *PrivacyRepository {
+	return &PrivacyRepository{
+		db: db,
+	}
+}
+
+// GetRules возвращает изменённые пользователями правила; пользователей без изменений в ответе нет
+func (r *PrivacyRepository) GetRules(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]models.UserRules, error) {
+	const op = "PrivacyRepository.GetRules"
+	const query = "SELECT privacy rules"
+
+	result := make(map[uuid.UUID]models.UserRules)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("users_count", len(userIDs))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rule := func(userID uuid.UUID, setting models.Setting) *models.Rule {
+		rules, ok := result[userID]
+		if !ok {
+			rules = make(models.UserRules)
+			result[userID] = rules
+		}
+		if _, ok := rules[setting]; !ok {
+			rules[setting] = models.DefaultRule(setting)
+		}
+		return rules[setting]
+	}
+
+	rows, err := r.db.Query(ctx, getPrivacyRulesQuery, userIDs)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID     uuid.UUID
+			setting    string
+			visibility string
+		)
+		if err := rows.Scan(&userID, &setting, &visibility); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		rule(userID, models.Setting(setting)).Visibility = models.Visibility(visibility)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	exceptions, err := r.db.Query(ctx, getPrivacyExceptionsQuery, userIDs)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: select exceptions: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer exceptions.Close()
+
+	for exceptions.Next() {
+		var (
+			userID       uuid.UUID
+			setting      string
+			targetUserID uuid.UUID
+			allow        bool
+		)
+		if err := exceptions.Scan(&userID, &setting, &targetUserID, &allow); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan exception error: status: %s", query, queryStatus)
+			return nil, err
+		}
+
+		rl := rule(userID, models.Setting(setting))
+		if allow {
+			rl.AllowUserIDs = append(rl.AllowUserIDs, targetUserID)
+		} else {
+			rl.DenyUserIDs = append(rl.DenyUserIDs, targetUserID)
+		}
+	}
+
+	if err = exceptions.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: exceptions iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SetRule заменяет правило пользователя вместе со всеми его исключениями
+func (r *PrivacyRepository) SetRule(ctx context.Context, userID uuid.UUID, rule *models.Rule) error {
+	const op = "PrivacyRepository.SetRule"
+	const query = "UPSERT privacy rule"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("setting", string(rule.Setting))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: begin transaction: status: %s", query, queryStatus)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, upsertPrivacyRuleQuery, userID, string(rule.Setting), string(rule.Visibility)); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: upsert rule: status: %s", query, queryStatus)
+		return err
+	}
+
+	if _, err = tx.Exec(ctx, deletePrivacyExceptionsQuery, userID, string(rule.Setting)); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: delete exceptions: status: %s", query, queryStatus)
+		return err
+	}
+
+	insert := func(targetIDs []uuid.UUID, allow bool) error {
+		for _, targetID := range targetIDs {
+			if _, err := tx.Exec(ctx, insertPrivacyExceptionQuery, userID, string(rule.Setting), targetID, allow); err != nil {
+				var pgErr *pgconn.PgError
+				if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorForeignKeyViolationCode {
+					return errs.ErrUserNotFound
+				}
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Запреты вставляются последними: пользователь в обоих списках остаётся запрещённым
+	if err = insert(rule.AllowUserIDs, true); err == nil {
+		err = insert(rule.DenyUserIDs, false)
+	}
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: insert exception: status: %s", query, queryStatus)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: commit transaction: status: %s", query, queryStatus)
+		return err
+	}
+
+	return nil
+}
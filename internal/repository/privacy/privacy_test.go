@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockRepo(t *testing.T) (pgxmock.PgxPoolIface, *PrivacyRepository) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	t.Cleanup(mock.Close)
+
+	return mock, New(mock)
+}
+
+func TestPrivacyRepository_GetRules_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, friendID, enemyID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectQuery(getPrivacyRulesQuery).
+		WithArgs([]uuid.UUID{userID}).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "setting", "visibility"}).
+			AddRow(userID, "phone_number", "nobody"))
+	mock.ExpectQuery(getPrivacyExceptionsQuery).
+		WithArgs([]uuid.UUID{userID}).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "setting", "target_user_id", "allow"}).
+			AddRow(userID, "phone_number", friendID, true).
+			AddRow(userID, "bio", enemyID, false))
+
+	rules, err := repo.GetRules(context.Background(), []uuid.UUID{userID})
+
+	assert.NoError(t, err)
+	phone := rules[userID].Rule(models.SettingPhoneNumber)
+	assert.Equal(t, models.VisibilityNobody, phone.Visibility)
+	assert.Equal(t, []uuid.UUID{friendID}, phone.AllowUserIDs)
+	// Исключение без изменённого правила дополняет правило по умолчанию
+	bio := rules[userID].Rule(models.SettingBio)
+	assert.Equal(t, models.VisibilityEverybody, bio.Visibility)
+	assert.Equal(t, []uuid.UUID{enemyID}, bio.DenyUserIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_GetRules_Empty(t *testing.T) {
+	mock, repo := newMockRepo(t)
+
+	rules, err := repo.GetRules(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_GetRules_QueryError(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID := uuid.New()
+
+	mock.ExpectQuery(getPrivacyRulesQuery).
+		WithArgs([]uuid.UUID{userID}).
+		WillReturnError(errors.New("db error"))
+
+	rules, err := repo.GetRules(context.Background(), []uuid.UUID{userID})
+
+	assert.Error(t, err)
+	assert.Nil(t, rules)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_SetRule_Success(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, friendID, enemyID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(upsertPrivacyRuleQuery).
+		WithArgs(userID, "avatar", "contacts").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(deletePrivacyExceptionsQuery).
+		WithArgs(userID, "avatar").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec(insertPrivacyExceptionQuery).
+		WithArgs(userID, "avatar", friendID, true).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(insertPrivacyExceptionQuery).
+		WithArgs(userID, "avatar", enemyID, false).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+
+	err := repo.SetRule(context.Background(), userID, &models.Rule{
+		Setting:      models.SettingAvatar,
+		Visibility:   models.VisibilityContacts,
+		AllowUserIDs: []uuid.UUID{friendID},
+		DenyUserIDs:  []uuid.UUID{enemyID},
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_SetRule_UnknownException(t *testing.T) {
+	mock, repo := newMockRepo(t)
+	userID, unknownID := uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(upsertPrivacyRuleQuery).
+		WithArgs(userID, "group_add", "nobody").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(deletePrivacyExceptionsQuery).
+		WithArgs(userID, "group_add").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectExec(insertPrivacyExceptionQuery).
+		WithArgs(userID, "group_add", unknownID, true).
+		WillReturnError(&pgconn.PgError{Code: errs.PostgresErrorForeignKeyViolationCode})
+	mock.ExpectRollback()
+
+	err := repo.SetRule(context.Background(), userID, &models.Rule{
+		Setting:      models.SettingGroupAdd,
+		Visibility:   models.VisibilityNobody,
+		AllowUserIDs: []uuid.UUID{unknownID},
+	})
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,164 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+)
+
+const (
+	apnsProductionURL = "https://api.push.apple.com"
+	apnsSandboxURL    = "https://api.sandbox.push.apple.com"
+	// apnsTokenLifetime - APNs принимает токен не старше часа и не чаще смены раз в 20 минут
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// APNsProvider отправляет уведомления в Apple Push Notification service по HTTP/2 с токеном ES256
+type APNsProvider struct {
+	client  *http.Client
+	baseURL string
+	keyID   string
+	teamID  string
+	topic   string
+	key     crypto.Signer
+
+	mu       sync.Mutex
+	jwt      string
+	issuedAt time.Time
+}
+
+func NewAPNsProvider(keyFile, keyID, teamID, topic string, sandbox bool, client *http.Client) (*APNsProvider, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read apns key: %w", err)
+	}
+
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse apns key: %w", err)
+	}
+
+	if client == nil {
+		// Клиент по умолчанию договаривается об HTTP/2 через ALPN
+		client = &http.Client{Timeout: RequestTimeout}
+	}
+
+	baseURL := apnsProductionURL
+	if sandbox {
+		baseURL = apnsSandboxURL
+	}
+
+	return &APNsProvider{
+		client:  client,
+		baseURL: baseURL,
+		keyID:   keyID,
+		teamID:  teamID,
+		topic:   topic,
+		key:     key,
+	}, nil
+}
+
+type apnsPayload struct {
+	APS struct {
+		Alert struct {
+			Title string `json:"title"`
+			Body  string `json:"body"`
+		} `json:"alert"`
+		Sound    string `json:"sound"`
+		ThreadID string `json:"thread-id"`
+	} `json:"aps"`
+	ChatID    string `json:"chat_id"`
+	Count     int    `json:"count"`
+	Mentioned bool   `json:"mentioned"`
+}
+
+func (p *APNsProvider) Send(ctx context.Context, target modelsPush.Target, notification modelsPush.Notification) error {
+	const op = "APNsProvider.Send"
+
+	token, err := p.token()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var payload apnsPayload
+	payload.APS.Alert.Title = notification.Title
+	payload.APS.Alert.Body = notification.Body
+	payload.APS.Sound = "default"
+	payload.APS.ThreadID = notification.ChatID.String()
+	payload.ChatID = notification.ChatID.String()
+	payload.Count = notification.Count
+	payload.Mentioned = notification.Mentioned
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/3/device/"+target.Token, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("apns-topic", p.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+	// Уведомления чата заменяют друг друга в центре уведомлений
+	req.Header.Set("apns-collapse-id", notification.CollapseKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var apnsErr struct {
+		Reason string `json:"reason"`
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	_ = json.Unmarshal(respBody, &apnsErr)
+
+	if resp.StatusCode == http.StatusGone || apnsErr.Reason == "BadDeviceToken" || apnsErr.Reason == "Unregistered" {
+		return errs.ErrPushTokenExpired
+	}
+
+	return fmt.Errorf("%s: apns responded with status %d: %s", op, resp.StatusCode, apnsErr.Reason)
+}
+
+// token возвращает подписанный токен провайдера, обновляя его раз в apnsTokenLifetime
+func (p *APNsProvider) token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.jwt != "" && time.Since(p.issuedAt) < apnsTokenLifetime {
+		return p.jwt, nil
+	}
+
+	now := time.Now()
+	jwt, err := signJWT(
+		map[string]any{"alg": "ES256", "kid": p.keyID},
+		map[string]any{"iss": p.teamID, "iat": now.Unix()},
+		p.key,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	p.jwt = jwt
+	p.issuedAt = now
+
+	return p.jwt, nil
+}
@@ -0,0 +1,239 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+)
+
+const (
+	fcmBaseURL = "https://fcm.googleapis.com"
+	fcmScope   = "https://www.googleapis.com/auth/firebase.messaging"
+	// fcmTokenLifetime - срок жизни запрошенного OAuth токена, обновляем его с запасом
+	fcmTokenLifetime = time.Hour
+	fcmTokenMargin   = time.Minute
+
+	RequestTimeout = 10 * time.Second
+)
+
+// fcmCredentials нужные поля JSON сервисного аккаунта Firebase
+type fcmCredentials struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// FCMProvider отправляет уведомления через FCM HTTP v1 API
+type FCMProvider struct {
+	client      *http.Client
+	baseURL     string
+	projectID   string
+	clientEmail string
+	tokenURI    string
+	key         crypto.Signer
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewFCMProvider(credentialsFile string, client *http.Client) (*FCMProvider, error) {
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fcm credentials: %w", err)
+	}
+
+	var creds fcmCredentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse fcm credentials: %w", err)
+	}
+
+	key, err := parsePrivateKey([]byte(creds.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fcm private key: %w", err)
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: RequestTimeout}
+	}
+
+	return &FCMProvider{
+		client:      client,
+		baseURL:     fcmBaseURL,
+		projectID:   creds.ProjectID,
+		clientEmail: creds.ClientEmail,
+		tokenURI:    creds.TokenURI,
+		key:         key,
+	}, nil
+}
+
+// fcmMessage тело запроса messages:send
+type fcmMessage struct {
+	Message struct {
+		Token        string            `json:"token"`
+		Notification fcmNotification   `json:"notification"`
+		Data         map[string]string `json:"data"`
+		Android      struct {
+			CollapseKey string `json:"collapse_key"`
+		} `json:"android"`
+	} `json:"message"`
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+func (p *FCMProvider) Send(ctx context.Context, target modelsPush.Target, notification modelsPush.Notification) error {
+	const op = "FCMProvider.Send"
+
+	accessToken, err := p.token(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var msg fcmMessage
+	msg.Message.Token = target.Token
+	msg.Message.Notification = fcmNotification{Title: notification.Title, Body: notification.Body}
+	msg.Message.Data = notificationData(notification)
+	msg.Message.Android.CollapseKey = notification.CollapseKey
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", p.baseURL, p.projectID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	// Приложение удалено или токен отозван
+	case resp.StatusCode == http.StatusNotFound || bytes.Contains(respBody, []byte("UNREGISTERED")):
+		return errs.ErrPushTokenExpired
+	default:
+		return fmt.Errorf("%s: fcm responded with status %d: %s", op, resp.StatusCode, respBody)
+	}
+}
+
+// token возвращает OAuth токен сервисного аккаунта, запрашивая новый по истечении старого
+func (p *FCMProvider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.accessToken != "" && time.Now().Before(p.expiresAt) {
+		return p.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := signJWT(
+		map[string]any{"alg": "RS256", "typ": "JWT"},
+		map[string]any{
+			"iss":   p.clientEmail,
+			"scope": fcmScope,
+			"aud":   p.tokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(fcmTokenLifetime).Unix(),
+		},
+		p.key,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oauth token request failed with status %d", resp.StatusCode)
+	}
+
+	var tokenRes struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenRes); err != nil {
+		return "", err
+	}
+	if tokenRes.AccessToken == "" {
+		return "", errors.New("oauth response has no access token")
+	}
+
+	p.accessToken = tokenRes.AccessToken
+	p.expiresAt = now.Add(time.Duration(tokenRes.ExpiresIn)*time.Second - fcmTokenMargin)
+
+	return p.accessToken, nil
+}
+
+// notificationData служебные поля уведомления, по которым клиент открывает нужный чат
+func notificationData(notification modelsPush.Notification) map[string]string {
+	return map[string]string{
+		"chat_id":   notification.ChatID.String(),
+		"count":     strconv.Itoa(notification.Count),
+		"mentioned": strconv.FormatBool(notification.Mentioned),
+	}
+}
+
+// parsePrivateKey разбирает PEM ключ в PKCS#8 (ключи Firebase и APNs .p8)
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,92 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	"github.com/google/uuid"
+)
+
+// LogProvider вместо отправки записывает уведомления строками JSON в файл или в лог сервиса.
+// Используется при разработке и в тестах, а также для платформ без настроенных ключей
+type LogProvider struct {
+	mu   sync.Mutex
+	out  io.Writer
+	file *os.File
+}
+
+// logRecord строка файла уведомлений
+type logRecord struct {
+	Time        time.Time `json:"time"`
+	Platform    string    `json:"platform"`
+	SessionID   uuid.UUID `json:"session_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	ChatID      uuid.UUID `json:"chat_id"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	CollapseKey string    `json:"collapse_key"`
+	Count       int       `json:"count"`
+	Mentioned   bool      `json:"mentioned,omitempty"`
+}
+
+// NewLogProvider открывает файл на дозапись. Пустой path - уведомления пишутся в лог сервиса
+func NewLogProvider(path string) (*LogProvider, error) {
+	if path == "" {
+		return &LogProvider{}, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open push log file: %w", err)
+	}
+
+	return &LogProvider{out: file, file: file}, nil
+}
+
+func (p *LogProvider) Send(ctx context.Context, target modelsPush.Target, notification modelsPush.Notification) error {
+	const op = "LogProvider.Send"
+
+	record, err := json.Marshal(logRecord{
+		Time:        time.Now(),
+		Platform:    target.Platform,
+		SessionID:   target.SessionID,
+		UserID:      target.UserID,
+		ChatID:      notification.ChatID,
+		Title:       notification.Title,
+		Body:        notification.Body,
+		CollapseKey: notification.CollapseKey,
+		Count:       notification.Count,
+		Mentioned:   notification.Mentioned,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if p.out == nil {
+		domains.GetLogger(ctx).WithField("operation", op).Infof("push notification: %s", record)
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.out.Write(append(record, '\n')); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (p *LogProvider) Close() error {
+	if p.file == nil {
+		return nil
+	}
+	return p.file.Close()
+}
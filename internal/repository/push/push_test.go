@@ -0,0 +1,285 @@
+package push
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNotification() modelsPush.Notification {
+	chatID := uuid.New()
+	return modelsPush.Notification{
+		ChatID:      chatID,
+		Title:       "Alice",
+		Body:        "hello",
+		CollapseKey: chatID.String(),
+		Count:       2,
+	}
+}
+
+func writePKCS8(t *testing.T, key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path
+}
+
+// verifyES256 проверяет подпись JWT открытым ключом и возвращает claims
+func verifyES256(t *testing.T, jwt string, pub *ecdsa.PublicKey) map[string]any {
+	parts := strings.Split(jwt, ".")
+	require.Len(t, parts, 3)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	assert.True(t, ecdsa.Verify(pub, digest[:], r, s))
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	return claims
+}
+
+func TestLogProvider_WritesJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "push.log")
+	provider, err := NewLogProvider(path)
+	require.NoError(t, err)
+
+	target := modelsPush.Target{SessionID: uuid.New(), UserID: uuid.New(), Platform: modelsPush.PlatformAPNs, Token: "token"}
+	notification := testNotification()
+
+	require.NoError(t, provider.Send(context.Background(), target, notification))
+	require.NoError(t, provider.Send(context.Background(), target, notification))
+	require.NoError(t, provider.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []logRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record logRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 2)
+	assert.Equal(t, target.SessionID, records[0].SessionID)
+	assert.Equal(t, notification.ChatID, records[0].ChatID)
+	assert.Equal(t, "hello", records[0].Body)
+	assert.Equal(t, 2, records[0].Count)
+}
+
+func TestAPNsProvider_Send(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	notification := testNotification()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "com.example.app", r.Header.Get("apns-topic"))
+		assert.Equal(t, notification.CollapseKey, r.Header.Get("apns-collapse-id"))
+
+		claims := verifyES256(t, strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "), &key.PublicKey)
+		assert.Equal(t, "TEAM", claims["iss"])
+
+		var payload apnsPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "Alice", payload.APS.Alert.Title)
+
+		if r.URL.Path == "/3/device/gone" {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+			return
+		}
+		assert.Equal(t, "/3/device/valid", r.URL.Path)
+	}))
+	defer server.Close()
+
+	provider, err := NewAPNsProvider(writePKCS8(t, key), "KEY", "TEAM", "com.example.app", true, server.Client())
+	require.NoError(t, err)
+	provider.baseURL = server.URL
+
+	ctx := context.Background()
+	assert.NoError(t, provider.Send(ctx, modelsPush.Target{Token: "valid"}, notification))
+	assert.ErrorIs(t, provider.Send(ctx, modelsPush.Target{Token: "gone"}, notification), errs.ErrPushTokenExpired)
+}
+
+func TestFCMProvider_Send(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tokenRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+		_, _ = w.Write([]byte(`{"access_token":"access","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v1/projects/project/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+
+		var msg fcmMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		if msg.Message.Token == "unregistered" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`))
+			return
+		}
+		assert.Equal(t, "2", msg.Message.Data["count"])
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	creds, err := json.Marshal(fcmCredentials{
+		ProjectID:   "project",
+		ClientEmail: "push@project.iam.gserviceaccount.com",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		TokenURI:    server.URL + "/token",
+	})
+	require.NoError(t, err)
+	credsPath := filepath.Join(t.TempDir(), "fcm.json")
+	require.NoError(t, os.WriteFile(credsPath, creds, 0o600))
+
+	provider, err := NewFCMProvider(credsPath, server.Client())
+	require.NoError(t, err)
+	provider.baseURL = server.URL
+
+	ctx := context.Background()
+	notification := testNotification()
+	assert.NoError(t, provider.Send(ctx, modelsPush.Target{Token: "valid"}, notification))
+	assert.ErrorIs(t, provider.Send(ctx, modelsPush.Target{Token: "unregistered"}, notification), errs.ErrPushTokenExpired)
+	// OAuth токен переиспользуется до истечения
+	assert.Equal(t, 1, tokenRequests)
+}
+
+// decryptWebPush расшифровывает тело так, как это делает браузер
+func decryptWebPush(t *testing.T, body []byte, uaPrivate *ecdh.PrivateKey, auth []byte) []byte {
+	salt := body[:16]
+	recordSize := binary.BigEndian.Uint32(body[16:20])
+	assert.Equal(t, uint32(webPushRecordSize), recordSize)
+	keyLen := int(body[20])
+	asPublicRaw := body[21 : 21+keyLen]
+
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicRaw)
+	require.NoError(t, err)
+	sharedSecret, err := uaPrivate.ECDH(asPublic)
+	require.NoError(t, err)
+
+	cek, nonce, err := webPushKeys(sharedSecret, auth, salt, uaPrivate.PublicKey().Bytes(), asPublicRaw)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plaintext, err := gcm.Open(nil, nonce, body[21+keyLen:], nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1])
+
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestWebPushProvider_Send(t *testing.T) {
+	vapidKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	uaKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+
+	vapidPublic := base64.RawURLEncoding.EncodeToString(vapidKey.PublicKey().Bytes())
+	vapidPrivate := base64.RawURLEncoding.EncodeToString(vapidKey.Bytes())
+	notification := testNotification()
+
+	var received webPushMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+
+		assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, strings.ReplaceAll(notification.CollapseKey, "-", ""), r.Header.Get("Topic"))
+		assert.Contains(t, r.Header.Get("Authorization"), "k="+vapidPublic)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(decryptWebPush(t, body, uaKey, auth), &received))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	provider, err := NewWebPushProvider(vapidPublic, vapidPrivate, "mailto:admin@example.com", server.Client())
+	require.NoError(t, err)
+
+	subscription := func(endpoint string) string {
+		sub := webPushSubscription{Endpoint: endpoint}
+		sub.Keys.P256dh = base64.RawURLEncoding.EncodeToString(uaKey.PublicKey().Bytes())
+		sub.Keys.Auth = base64.RawURLEncoding.EncodeToString(auth)
+		data, _ := json.Marshal(sub)
+		return string(data)
+	}
+
+	ctx := context.Background()
+	assert.NoError(t, provider.Send(ctx, modelsPush.Target{Token: subscription(server.URL + "/push")}, notification))
+	assert.Equal(t, "hello", received.Body)
+	assert.Equal(t, notification.ChatID.String(), received.ChatID)
+
+	assert.ErrorIs(t, provider.Send(ctx, modelsPush.Target{Token: subscription(server.URL + "/gone")}, notification), errs.ErrPushTokenExpired)
+	assert.ErrorIs(t, provider.Send(ctx, modelsPush.Target{Token: "not json"}, notification), errs.ErrPushTokenExpired)
+}
+
+func TestNewWebPushProvider_KeyMismatch(t *testing.T) {
+	first, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	second, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = NewWebPushProvider(
+		base64.RawURLEncoding.EncodeToString(second.PublicKey().Bytes()),
+		base64.RawURLEncoding.EncodeToString(first.Bytes()),
+		"mailto:admin@example.com",
+		nil,
+	)
+
+	assert.Error(t, err)
+}
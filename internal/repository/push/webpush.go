@@ -0,0 +1,270 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+)
+
+const (
+	// webPushRecordSize - размер записи aes128gcm, сообщение всегда помещается в одну запись
+	webPushRecordSize = 4096
+	webPushTTL        = 24 * time.Hour
+	vapidLifetime     = 12 * time.Hour
+)
+
+// WebPushProvider отправляет уведомления в браузеры по протоколу Web Push с шифрованием RFC 8291 и VAPID
+type WebPushProvider struct {
+	client    *http.Client
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+}
+
+// webPushSubscription подписка браузера (PushSubscription.toJSON), хранится в Target.Token
+type webPushSubscription struct {
+	Endpoint string `json:"endpoint"`
+	Keys     struct {
+		P256dh string `json:"p256dh"`
+		Auth   string `json:"auth"`
+	} `json:"keys"`
+}
+
+// webPushMessage данные, которые получает service worker
+type webPushMessage struct {
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	ChatID    string `json:"chat_id"`
+	Tag       string `json:"tag"`
+	Count     int    `json:"count"`
+	Mentioned bool   `json:"mentioned"`
+}
+
+func NewWebPushProvider(publicKey, privateKey, subject string, client *http.Client) (*WebPushProvider, error) {
+	rawPrivate, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(privateKey, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+
+	ecdhKey, err := ecdh.P256().NewPrivateKey(rawPrivate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vapid private key: %w", err)
+	}
+
+	// Открытый ключ в несжатом виде: 0x04 || X || Y
+	rawPublic := ecdhKey.PublicKey().Bytes()
+	if encoded := base64.RawURLEncoding.EncodeToString(rawPublic); strings.TrimRight(publicKey, "=") != encoded {
+		return nil, errors.New("vapid public key does not match private key")
+	}
+
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(rawPublic[1:33]),
+			Y:     new(big.Int).SetBytes(rawPublic[33:]),
+		},
+		D: new(big.Int).SetBytes(rawPrivate),
+	}
+
+	if client == nil {
+		client = &http.Client{Timeout: RequestTimeout}
+	}
+
+	return &WebPushProvider{
+		client:    client,
+		key:       key,
+		publicKey: base64.RawURLEncoding.EncodeToString(rawPublic),
+		subject:   subject,
+	}, nil
+}
+
+func (p *WebPushProvider) Send(ctx context.Context, target modelsPush.Target, notification modelsPush.Notification) error {
+	const op = "WebPushProvider.Send"
+
+	var sub webPushSubscription
+	if err := json.Unmarshal([]byte(target.Token), &sub); err != nil || sub.Endpoint == "" {
+		// Сломанная подписка не станет рабочей, её можно удалить
+		return errs.ErrPushTokenExpired
+	}
+
+	endpoint, err := url.Parse(sub.Endpoint)
+	if err != nil {
+		return errs.ErrPushTokenExpired
+	}
+
+	message, err := json.Marshal(webPushMessage{
+		Title:     notification.Title,
+		Body:      notification.Body,
+		ChatID:    notification.ChatID.String(),
+		Tag:       notification.CollapseKey,
+		Count:     notification.Count,
+		Mentioned: notification.Mentioned,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	body, err := encryptWebPush(message, sub.Keys.P256dh, sub.Keys.Auth)
+	if err != nil {
+		return errs.ErrPushTokenExpired
+	}
+
+	vapid, err := signJWT(
+		map[string]any{"alg": "ES256", "typ": "JWT"},
+		map[string]any{
+			"aud": endpoint.Scheme + "://" + endpoint.Host,
+			"exp": time.Now().Add(vapidLifetime).Unix(),
+			"sub": p.subject,
+		},
+		p.key,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", fmt.Sprintf("%d", int(webPushTTL.Seconds())))
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", vapid, p.publicKey))
+	// Topic до 32 символов base64url: неотправленное уведомление с тем же topic заменяется новым
+	req.Header.Set("Topic", webPushTopic(notification.CollapseKey))
+	if notification.Mentioned {
+		req.Header.Set("Urgency", "high")
+	} else {
+		req.Header.Set("Urgency", "normal")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return errs.ErrPushTokenExpired
+	default:
+		return fmt.Errorf("%s: push service responded with status %d", op, resp.StatusCode)
+	}
+}
+
+func webPushTopic(collapseKey string) string {
+	topic := strings.ReplaceAll(collapseKey, "-", "")
+	if len(topic) > 32 {
+		topic = topic[:32]
+	}
+	return topic
+}
+
+// encryptWebPush шифрует сообщение для подписки по RFC 8291 (Content-Encoding: aes128gcm)
+func encryptWebPush(plaintext []byte, p256dh, authSecret string) ([]byte, error) {
+	uaPublicRaw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(p256dh, "="))
+	if err != nil {
+		return nil, err
+	}
+	auth, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(authSecret, "="))
+	if err != nil {
+		return nil, err
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicRaw := asPrivate.PublicKey().Bytes()
+
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	cek, nonce, err := webPushKeys(sharedSecret, auth, salt, uaPublicRaw, asPublicRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Единственная запись заканчивается разделителем 0x02
+	record := append(append([]byte{}, plaintext...), 0x02)
+	if len(record)+gcm.Overhead() > webPushRecordSize {
+		return nil, errors.New("web push message is too large")
+	}
+
+	header := make([]byte, 0, 16+4+1+len(asPublicRaw))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublicRaw)))
+	header = append(header, asPublicRaw...)
+
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// webPushKeys выводит ключ шифрования и nonce из общего секрета ECDH и auth-секрета подписки
+func webPushKeys(sharedSecret, auth, salt, uaPublic, asPublic []byte) ([]byte, []byte, error) {
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, auth, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cek, nonce, nil
+}
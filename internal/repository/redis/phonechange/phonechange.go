@@ -0,0 +1,189 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	phoneChangePrefix         = "phone_change"
+	phoneChangeAttemptsPrefix = "phone_change_attempts"
+)
+
+// PhoneChangeRepository хранит ожидающие подтверждения смены номера; запись живёт, пока действует код
+type PhoneChangeRepository struct {
+	client *redis.Client
+}
+
+func New(client *redis.Client) *PhoneChangeRepository {
+	return &PhoneChangeRepository{
+		client: client,
+	}
+}
+
+type phoneChangeData struct {
+	NewPhone  string    `json:"new_phone"`
+	CodeHash  string    `json:"code_hash"`
+	SentAt    time.Time `json:"sent_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func phoneChangeKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", phoneChangePrefix, userID)
+}
+
+func phoneChangeAttemptsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", phoneChangeAttemptsPrefix, userID)
+}
+
+func (r *PhoneChangeRepository) SavePhoneChange(ctx context.Context, change *models.PhoneChange) error {
+	const op = "PhoneChangeRepository.SavePhoneChange"
+	const query = "SET phone change"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", change.UserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	ttl := time.Until(change.ExpiresAt)
+	if ttl <= 0 {
+		queryStatus = "fail"
+		return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	raw, err := json.Marshal(phoneChangeData{
+		NewPhone:  change.NewPhone,
+		CodeHash:  change.CodeHash,
+		SentAt:    change.SentAt,
+		ExpiresAt: change.ExpiresAt,
+	})
+	if err != nil {
+		queryStatus = "fail"
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.client.Set(ctx, phoneChangeKey(change.UserID), raw, ttl).Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *PhoneChangeRepository) GetPhoneChange(ctx context.Context, userID uuid.UUID) (*models.PhoneChange, error) {
+	const op = "PhoneChangeRepository.GetPhoneChange"
+	const query = "GET phone change"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	raw, err := r.client.Get(ctx, phoneChangeKey(userID)).Bytes()
+	if err != nil {
+		queryStatus = "fail"
+		if errors.Is(err, redis.Nil) {
+			return nil, errs.ErrNotFound
+		}
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var data phoneChangeData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: unmarshal error: status: %s", query, queryStatus)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.PhoneChange{
+		UserID:    userID,
+		NewPhone:  data.NewPhone,
+		CodeHash:  data.CodeHash,
+		SentAt:    data.SentAt,
+		ExpiresAt: data.ExpiresAt,
+	}, nil
+}
+
+func (r *PhoneChangeRepository) DeletePhoneChange(ctx context.Context, userID uuid.UUID) error {
+	const op = "PhoneChangeRepository.DeletePhoneChange"
+	const query = "DEL phone change"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	if err := r.client.Del(ctx, phoneChangeKey(userID)).Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IncrPhoneChangeAttempts атомарно засчитывает попытку ввода кода и возвращает их число.
+// Счётчик отделён от заявки: повторная отправка кода его не сбрасывает, а ttl отсчитывается от первой попытки
+func (r *PhoneChangeRepository) IncrPhoneChangeAttempts(ctx context.Context, userID uuid.UUID, ttl time.Duration) (int, error) {
+	const op = "PhoneChangeRepository.IncrPhoneChangeAttempts"
+	const query = "INCR phone change attempts"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	key := phoneChangeAttemptsKey(userID)
+
+	pipe := r.client.TxPipeline()
+	attempts := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: pipeline execution error: status: %s", query, queryStatus)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(attempts.Val()), nil
+}
+
+// ResetPhoneChangeAttempts сбрасывает счётчик после успешной смены номера
+func (r *PhoneChangeRepository) ResetPhoneChangeAttempts(ctx context.Context, userID uuid.UUID) error {
+	const op = "PhoneChangeRepository.ResetPhoneChangeAttempts"
+	const query = "DEL phone change attempts"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	if err := r.client.Del(ctx, phoneChangeAttemptsKey(userID)).Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneChangeRepository_GetPhoneChange(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+	raw, err := json.Marshal(phoneChangeData{NewPhone: "+79990001122", CodeHash: "hash", ExpiresAt: expiresAt})
+	require.NoError(t, err)
+
+	mock.ExpectGet(phoneChangeKey(userID)).SetVal(string(raw))
+
+	change, err := repo.GetPhoneChange(context.Background(), userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, change.UserID)
+	assert.Equal(t, "+79990001122", change.NewPhone)
+	assert.True(t, expiresAt.Equal(change.ExpiresAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPhoneChangeRepository_GetPhoneChange_NotFound(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	userID := uuid.New()
+	mock.ExpectGet(phoneChangeKey(userID)).RedisNil()
+
+	change, err := repo.GetPhoneChange(context.Background(), userID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, change)
+}
+
+func TestPhoneChangeRepository_SavePhoneChange_Expired(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	err := repo.SavePhoneChange(context.Background(), &models.PhoneChange{UserID: uuid.New(), ExpiresAt: time.Now().Add(-time.Second)})
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPhoneChangeRepository_DeletePhoneChange(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	userID := uuid.New()
+	mock.ExpectDel(phoneChangeKey(userID)).SetVal(1)
+
+	assert.NoError(t, repo.DeletePhoneChange(context.Background(), userID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPhoneChangeRepository_IncrPhoneChangeAttempts(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	userID := uuid.New()
+	key := phoneChangeAttemptsKey(userID)
+
+	mock.ExpectTxPipeline()
+	mock.ExpectIncr(key).SetVal(3)
+	mock.ExpectExpireNX(key, time.Minute).SetVal(false)
+	mock.ExpectTxPipelineExec()
+
+	attempts, err := repo.IncrPhoneChangeAttempts(context.Background(), userID, time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPhoneChangeRepository_IncrPhoneChangeAttempts_Error(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client)
+
+	userID := uuid.New()
+
+	mock.ExpectTxPipeline()
+	mock.ExpectIncr(phoneChangeAttemptsKey(userID)).SetErr(errors.New("redis down"))
+
+	_, err := repo.IncrPhoneChangeAttempts(context.Background(), userID, time.Minute)
+
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -15,6 +16,8 @@ import (
 const (
 	sessionPrefix      = "session"
 	userSessionsPrefix = "user_sessions"
+	userDevicesPrefix  = "user_devices"
+	sessionPushPrefix  = "session_push"
 )
 
 type SessionRepository struct {
@@ -31,13 +34,18 @@ func New(client *redis.Client, sessionTTL time.Duration) *SessionRepository {
 
 // sessionData структура для хранения в Redis
 type sessionData struct {
-	UserID    uuid.UUID `json:"user_id"`
-	Device    string    `json:"device"`
-	CreatedAt time.Time `json:"created_at"`
-	LastSeen  time.Time `json:"last_seen"`
+	UserID     uuid.UUID `json:"user_id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip,omitempty"`
+	OS         string    `json:"os,omitempty"`
+	Browser    string    `json:"browser,omitempty"`
+	AppVersion string    `json:"app_version,omitempty"`
+	Location   string    `json:"location,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeen   time.Time `json:"last_seen"`
 }
 
-func (r *SessionRepository) AddSession(ctx context.Context, userID uuid.UUID, device string) (uuid.UUID, error) {
+func (r *SessionRepository) AddSession(ctx context.Context, userID uuid.UUID, info models.DeviceInfo) (uuid.UUID, error) {
 	const op = "SessionRepository.AddSession"
 	const query = "ADD session"
 
@@ -54,10 +62,15 @@ func (r *SessionRepository) AddSession(ctx context.Context, userID uuid.UUID, de
 	now := time.Now()
 
 	sessionData := sessionData{
-		UserID:    userID,
-		Device:    device,
-		CreatedAt: now,
-		LastSeen:  now,
+		UserID:     userID,
+		Device:     info.Device,
+		IP:         info.IP,
+		OS:         info.OS,
+		Browser:    info.Browser,
+		AppVersion: info.AppVersion,
+		Location:   info.Location,
+		CreatedAt:  now,
+		LastSeen:   now,
 	}
 
 	sessionJSON, err := json.Marshal(sessionData)
@@ -86,6 +99,37 @@ func (r *SessionRepository) AddSession(ctx context.Context, userID uuid.UUID, de
 	return sessionID, nil
 }
 
+// RegisterDevice запоминает устройство пользователя и сообщает, встречалось ли оно раньше.
+// Самое первое устройство пользователя новым не считается.
+func (r *SessionRepository) RegisterDevice(ctx context.Context, userID uuid.UUID, fingerprint string) (bool, error) {
+	const op = "SessionRepository.RegisterDevice"
+	const query = "ADD user device"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	userDevicesKey := fmt.Sprintf("%s:%s", userDevicesPrefix, userID.String())
+
+	pipe := r.client.TxPipeline()
+	added := pipe.SAdd(ctx, userDevicesKey, fingerprint)
+	total := pipe.SCard(ctx, userDevicesKey)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: pipeline execution error: status: %s", query, queryStatus)
+		return false, fmt.Errorf("%s: failed to execute redis pipeline: %w", op, err)
+	}
+
+	return added.Val() == 1 && total.Val() > 1, nil
+}
+
 func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
 	const op = "SessionRepository.DeleteSession"
 	const query = "DELETE session"
@@ -123,9 +167,9 @@ func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID uuid.UU
 
 	userSessionsKey := fmt.Sprintf("%s:%s", userSessionsPrefix, data.UserID.String())
 
-	// Удаляем сессию и убираем её из списка пользователя
+	// Удаляем сессию вместе с push-токеном и убираем её из списка пользователя
 	pipe := r.client.Pipeline()
-	pipe.Del(ctx, sessionKey)
+	pipe.Del(ctx, sessionKey, fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String()))
 	pipe.SRem(ctx, userSessionsKey, sessionID.String())
 
 	_, err = pipe.Exec(ctx)
@@ -189,6 +233,7 @@ func (r *SessionRepository) UpdateSession(ctx context.Context, sessionID uuid.UU
 	// Обновляем данные сессии и продлеваем TTL
 	pipe.Set(ctx, sessionKey, updatedJSON, r.ttl)
 	pipe.Expire(ctx, userSessionsKey, r.ttl)
+	pipe.Expire(ctx, fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String()), r.ttl)
 
 	_, err = pipe.Exec(ctx)
 	if err != nil {
@@ -238,6 +283,11 @@ func (r *SessionRepository) GetSession(ctx context.Context, sessionID uuid.UUID)
 		ID:         sessionID,
 		UserID:     data.UserID,
 		Device:     data.Device,
+		IP:         data.IP,
+		OS:         data.OS,
+		Browser:    data.Browser,
+		AppVersion: data.AppVersion,
+		Location:   data.Location,
 		Created_at: data.CreatedAt,
 		Last_seen:  data.LastSeen,
 	}
@@ -337,3 +387,151 @@ func (r *SessionRepository) DeleteAllSessionWithoutCurrent(ctx context.Context,
 
 	return nil
 }
+
+// pushTokenData push-токен устройства. Хранится отдельным ключом с TTL сессии,
+// чтобы не конкурировать с обновлением last_seen
+type pushTokenData struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Platform string    `json:"platform"`
+	Token    string    `json:"token"`
+}
+
+func (r *SessionRepository) SetPushToken(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, platform, token string) error {
+	const op = "SessionRepository.SetPushToken"
+	const query = "SET session push token"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("session_id", sessionID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tokenJSON, err := json.Marshal(pushTokenData{UserID: userID, Platform: platform, Token: token})
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: marshal error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: failed to marshal push token: %w", op, err)
+	}
+
+	pushKey := fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String())
+	if err := r.client.Set(ctx, pushKey, tokenJSON, r.ttl).Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: set error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: failed to set push token: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeletePushTokens отвязывает push-токены от сессий
+func (r *SessionRepository) DeletePushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	const op = "SessionRepository.DeletePushTokens"
+	const query = "DELETE session push tokens"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	logger.Debugf("starting: %s", query)
+
+	keys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String()))
+	}
+
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: delete error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: failed to delete push tokens: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetPushTargets возвращает push-токены всех активных сессий пользователей
+func (r *SessionRepository) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error) {
+	const op = "SessionRepository.GetPushTargets"
+	const query = "GET push targets by users"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	pipe := r.client.Pipeline()
+	members := make([]*redis.StringSliceCmd, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, pipe.SMembers(ctx, fmt.Sprintf("%s:%s", userSessionsPrefix, userID.String())))
+	}
+
+	if len(members) == 0 {
+		return []modelsPush.Target{}, nil
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: pipeline execution error: status: %s", query, queryStatus)
+		return nil, fmt.Errorf("%s: failed to get user session IDs: %w", op, err)
+	}
+
+	var sessionIDs []uuid.UUID
+	var keys []string
+	for _, cmd := range members {
+		for _, sessionIDStr := range cmd.Val() {
+			sessionID, err := uuid.Parse(sessionIDStr)
+			if err != nil {
+				continue
+			}
+			sessionIDs = append(sessionIDs, sessionID)
+			keys = append(keys, fmt.Sprintf("%s:%s", sessionPushPrefix, sessionIDStr))
+		}
+	}
+
+	if len(keys) == 0 {
+		return []modelsPush.Target{}, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: mget error: status: %s", query, queryStatus)
+		return nil, fmt.Errorf("%s: failed to get push tokens: %w", op, err)
+	}
+
+	targets := make([]modelsPush.Target, 0, len(values))
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var data pushTokenData
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			logger.WithError(err).Warnf("redis query: %s: invalid push token for session %s", query, sessionIDs[i])
+			continue
+		}
+
+		targets = append(targets, modelsPush.Target{
+			SessionID: sessionIDs[i],
+			UserID:    data.UserID,
+			Platform:  data.Platform,
+			Token:     data.Token,
+		})
+	}
+
+	return targets, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	"github.com/go-redis/redismock/v9"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -21,7 +22,7 @@ func TestSessionRepository_AddSession_Success(t *testing.T) {
 	userID := uuid.New()
 	device := "Chrome on Windows"
 
-	sessionID, err := repo.AddSession(ctx, userID, device)
+	sessionID, err := repo.AddSession(ctx, userID, models.DeviceInfo{Device: device})
 
 	if err != nil {
 		assert.Contains(t, err.Error(), "was not expected")
@@ -39,7 +40,7 @@ func TestSessionRepository_AddSession_PipelineError(t *testing.T) {
 	userID := uuid.New()
 	device := "Chrome on Windows"
 
-	_, err := repo.AddSession(ctx, userID, device)
+	_, err := repo.AddSession(ctx, userID, models.DeviceInfo{Device: device})
 
 	assert.Error(t, err)
 }
@@ -78,6 +79,91 @@ func TestSessionRepository_GetSession_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestSessionRepository_GetSession_WithMetadata(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Hour)
+
+	ctx := context.Background()
+	sessionID := uuid.New()
+	now := time.Now()
+
+	sessionData := sessionData{
+		UserID:     uuid.New(),
+		Device:     "Chrome 120.0 on Linux x86_64",
+		IP:         "5.255.255.10",
+		OS:         "Linux x86_64",
+		Browser:    "Chrome",
+		AppVersion: "1.4.2",
+		Location:   "Moscow, Russia",
+		CreatedAt:  now,
+		LastSeen:   now,
+	}
+
+	sessionJSON, _ := json.Marshal(sessionData)
+	sessionKey := fmt.Sprintf("%s:%s", sessionPrefix, sessionID.String())
+
+	mock.ExpectGet(sessionKey).SetVal(string(sessionJSON))
+
+	session, err := repo.GetSession(ctx, sessionID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "5.255.255.10", session.IP)
+	assert.Equal(t, "Linux x86_64", session.OS)
+	assert.Equal(t, "Chrome", session.Browser)
+	assert.Equal(t, "1.4.2", session.AppVersion)
+	assert.Equal(t, "Moscow, Russia", session.Location)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_RegisterDevice(t *testing.T) {
+	tests := []struct {
+		name   string
+		added  int64
+		total  int64
+		expect bool
+	}{
+		{name: "first device", added: 1, total: 1, expect: false},
+		{name: "new device", added: 1, total: 2, expect: true},
+		{name: "known device", added: 0, total: 2, expect: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repo := New(client, time.Hour)
+
+			userID := uuid.New()
+			key := fmt.Sprintf("%s:%s", userDevicesPrefix, userID.String())
+
+			mock.ExpectTxPipeline()
+			mock.ExpectSAdd(key, "linux|chrome").SetVal(tt.added)
+			mock.ExpectSCard(key).SetVal(tt.total)
+			mock.ExpectTxPipelineExec()
+
+			isNew, err := repo.RegisterDevice(context.Background(), userID, "linux|chrome")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, isNew)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_RegisterDevice_Error(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Hour)
+
+	userID := uuid.New()
+	key := fmt.Sprintf("%s:%s", userDevicesPrefix, userID.String())
+
+	mock.ExpectTxPipeline()
+	mock.ExpectSAdd(key, "linux|chrome").SetErr(fmt.Errorf("redis error"))
+
+	_, err := repo.RegisterDevice(context.Background(), userID, "linux|chrome")
+
+	assert.Error(t, err)
+}
+
 func TestSessionRepository_GetSession_NotFound(t *testing.T) {
 	client, mock := redismock.NewClientMock()
 	repo := New(client, time.Hour)
@@ -151,7 +237,7 @@ func TestSessionRepository_DeleteSession_Success(t *testing.T) {
 	userSessionsKey := fmt.Sprintf("%s:%s", userSessionsPrefix, userID.String())
 
 	mock.ExpectGet(sessionKey).SetVal(string(sessionJSON))
-	mock.ExpectDel(sessionKey).SetVal(1)
+	mock.ExpectDel(sessionKey, fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String())).SetVal(1)
 	mock.ExpectSRem(userSessionsKey, sessionID.String()).SetVal(1)
 
 	err := repo.DeleteSession(ctx, sessionID)
@@ -300,3 +386,70 @@ func TestSessionRepository_GetSessionsByUserID_RedisError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to get user session IDs")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestSessionRepository_SetPushToken(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Hour)
+
+	ctx := context.Background()
+	sessionID := uuid.New()
+	userID := uuid.New()
+
+	tokenJSON, _ := json.Marshal(pushTokenData{UserID: userID, Platform: "fcm", Token: "device-token"})
+	mock.ExpectSet(fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID.String()), tokenJSON, time.Hour).SetVal("OK")
+
+	err := repo.SetPushToken(ctx, sessionID, userID, "fcm", "device-token")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_DeletePushTokens(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Hour)
+
+	ctx := context.Background()
+	sessionID1 := uuid.New()
+	sessionID2 := uuid.New()
+
+	mock.ExpectDel(
+		fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID1.String()),
+		fmt.Sprintf("%s:%s", sessionPushPrefix, sessionID2.String()),
+	).SetVal(2)
+
+	err := repo.DeletePushTokens(ctx, []uuid.UUID{sessionID1, sessionID2})
+	assert.NoError(t, err)
+
+	err = repo.DeletePushTokens(ctx, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_GetPushTargets(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Hour)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	withToken := uuid.New()
+	withoutToken := uuid.New()
+
+	tokenJSON, _ := json.Marshal(pushTokenData{UserID: userID, Platform: "apns", Token: "apns-token"})
+
+	mock.ExpectSMembers(fmt.Sprintf("%s:%s", userSessionsPrefix, userID.String())).
+		SetVal([]string{withToken.String(), withoutToken.String()})
+	mock.ExpectMGet(
+		fmt.Sprintf("%s:%s", sessionPushPrefix, withToken.String()),
+		fmt.Sprintf("%s:%s", sessionPushPrefix, withoutToken.String()),
+	).SetVal([]interface{}{string(tokenJSON), nil})
+
+	targets, err := repo.GetPushTargets(ctx, []uuid.UUID{userID})
+
+	assert.NoError(t, err)
+	assert.Len(t, targets, 1)
+	assert.Equal(t, withToken, targets[0].SessionID)
+	assert.Equal(t, userID, targets[0].UserID)
+	assert.Equal(t, "apns", targets[0].Platform)
+	assert.Equal(t, "apns-token", targets[0].Token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
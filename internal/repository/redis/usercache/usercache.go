@@ -0,0 +1,220 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	userProfilePrefix = "user_profile"
+	// InvalidationChannel - канал, через который реплики сообщают друг другу о сброшенных профилях
+	InvalidationChannel = "user_profile_invalidations"
+)
+
+// UserCacheRepository - общий для реплик уровень кэша профилей пользователей
+type UserCacheRepository struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func New(client *redis.Client, ttl time.Duration) *UserCacheRepository {
+	return &UserCacheRepository{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// profileData - данные профиля в Redis; хеш пароля в кэш не попадает
+type profileData struct {
+	ID          uuid.UUID `json:"id"`
+	PhoneNumber string    `json:"phone_number"`
+	Name        string    `json:"name"`
+	Username    string    `json:"username"`
+	Bio         *string   `json:"bio,omitempty"`
+	AccountType string    `json:"account_type"`
+}
+
+func profileKey(id uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", userProfilePrefix, id)
+}
+
+func (r *UserCacheRepository) GetUsers(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.User, error) {
+	const op = "UserCacheRepository.GetUsers"
+	const query = "MGET user profiles"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("users_count", len(ids))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	users := make(map[uuid.UUID]*models.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, profileKey(id))
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var data profileData
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			// битая запись равносильна промаху - профиль перезапросится из user_service
+			logger.WithError(err).Warn("failed to unmarshal cached profile")
+			continue
+		}
+
+		users[data.ID] = &models.User{
+			ID:          data.ID,
+			PhoneNumber: data.PhoneNumber,
+			Name:        data.Name,
+			Username:    data.Username,
+			Bio:         data.Bio,
+			AccountType: data.AccountType,
+		}
+	}
+
+	return users, nil
+}
+
+func (r *UserCacheRepository) SetUsers(ctx context.Context, users []*models.User) error {
+	const op = "UserCacheRepository.SetUsers"
+	const query = "SET user profiles"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("users_count", len(users))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	if len(users) == 0 {
+		return nil
+	}
+
+	pipe := r.client.Pipeline()
+	for _, user := range users {
+		raw, err := json.Marshal(profileData{
+			ID:          user.ID,
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+			Username:    user.Username,
+			Bio:         user.Bio,
+			AccountType: user.AccountType,
+		})
+		if err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("redis query: %s: marshal error: status: %s", query, queryStatus)
+			return fmt.Errorf("%s: failed to marshal profile: %w", op, err)
+		}
+		pipe.Set(ctx, profileKey(user.ID), raw, r.ttl)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *UserCacheRepository) DeleteUsers(ctx context.Context, ids []uuid.UUID) error {
+	const op = "UserCacheRepository.DeleteUsers"
+	const query = "DEL user profiles"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("users_count", len(ids))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("redis query: %s: status: %s", query, queryStatus)
+	}()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(ids))
+	idStrings := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, profileKey(id))
+		idStrings = append(idStrings, id.String())
+	}
+
+	pipe := r.client.Pipeline()
+	pipe.Del(ctx, keys...)
+	pipe.Publish(ctx, InvalidationChannel, strings.Join(idStrings, ","))
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("redis query: %s: execution error: status: %s", query, queryStatus)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SubscribeInvalidations слушает канал сброса до отмены ctx
+func (r *UserCacheRepository) SubscribeInvalidations(ctx context.Context, handler func(ids []uuid.UUID)) error {
+	const op = "UserCacheRepository.SubscribeInvalidations"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	pubsub := r.client.Subscribe(ctx, InvalidationChannel)
+	defer pubsub.Close()
+
+	if _, err := pubsub.Receive(ctx); err != nil {
+		logger.WithError(err).Error("failed to subscribe to invalidation channel")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			handler(parseInvalidation(msg.Payload))
+		}
+	}
+}
+
+// parseInvalidation разбирает сообщение канала сброса; некорректные id пропускаются
+func parseInvalidation(payload string) []uuid.UUID {
+	parts := strings.Split(payload, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts {
+		id, err := uuid.Parse(part)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
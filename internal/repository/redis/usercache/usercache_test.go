@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCacheRepository_GetUsers(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Minute)
+
+	cachedID := uuid.New()
+	missingID := uuid.New()
+	raw, err := json.Marshal(profileData{ID: cachedID, Name: "Cached"})
+	require.NoError(t, err)
+
+	mock.ExpectMGet(profileKey(cachedID), profileKey(missingID)).SetVal([]interface{}{string(raw), nil})
+
+	users, err := repo.GetUsers(context.Background(), []uuid.UUID{cachedID, missingID})
+
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "Cached", users[cachedID].Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserCacheRepository_GetUsers_Error(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Minute)
+
+	id := uuid.New()
+	mock.ExpectMGet(profileKey(id)).SetErr(errors.New("redis down"))
+
+	users, err := repo.GetUsers(context.Background(), []uuid.UUID{id})
+
+	assert.Error(t, err)
+	assert.Nil(t, users)
+}
+
+func TestUserCacheRepository_SetUsers_OmitsPasswordHash(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Minute)
+
+	user := &models.User{ID: uuid.New(), Name: "User", PasswordHash: "secret-hash"}
+	raw, err := json.Marshal(profileData{ID: user.ID, Name: user.Name})
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "secret-hash")
+
+	mock.ExpectSet(profileKey(user.ID), raw, time.Minute).SetVal("OK")
+
+	assert.NoError(t, repo.SetUsers(context.Background(), []*models.User{user}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserCacheRepository_DeleteUsers_Publishes(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	repo := New(client, time.Minute)
+
+	id1, id2 := uuid.New(), uuid.New()
+	mock.ExpectDel(profileKey(id1), profileKey(id2)).SetVal(2)
+	mock.ExpectPublish(InvalidationChannel, id1.String()+","+id2.String()).SetVal(1)
+
+	assert.NoError(t, repo.DeleteUsers(context.Background(), []uuid.UUID{id1, id2}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseInvalidation(t *testing.T) {
+	id := uuid.New()
+
+	assert.Equal(t, []uuid.UUID{id}, parseInvalidation(id.String()+",not-a-uuid"))
+	assert.Empty(t, parseInvalidation(""))
+}
@@ -0,0 +1,76 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+)
+
+// LogSender вместо отправки SMS записывает сообщения строками JSON в файл или в лог сервиса.
+// Используется при разработке и в тестах, пока не подключён настоящий SMS-шлюз
+type LogSender struct {
+	mu   sync.Mutex
+	out  io.Writer
+	file *os.File
+}
+
+// logRecord строка файла сообщений
+type logRecord struct {
+	Time  time.Time `json:"time"`
+	Phone string    `json:"phone"`
+	Text  string    `json:"text"`
+}
+
+// NewLogSender открывает файл на дозапись. Пустой path - сообщения пишутся в лог сервиса
+func NewLogSender(path string) (*LogSender, error) {
+	if path == "" {
+		return &LogSender{}, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sms log file: %w", err)
+	}
+
+	return &LogSender{out: file, file: file}, nil
+}
+
+func (s *LogSender) Send(ctx context.Context, phone, text string) error {
+	const op = "LogSender.Send"
+
+	record, err := json.Marshal(logRecord{
+		Time:  time.Now(),
+		Phone: phone,
+		Text:  text,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if s.out == nil {
+		domains.GetLogger(ctx).WithField("operation", op).Infof("sms: %s", record)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.out.Write(append(record, '\n')); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *LogSender) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,46 @@
+package sms
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSender_WritesJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.log")
+	sender, err := NewLogSender(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send(context.Background(), "+79990001122", "code 123456"))
+	require.NoError(t, sender.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []logRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record logRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 1)
+	assert.Equal(t, "+79990001122", records[0].Phone)
+	assert.Equal(t, "code 123456", records[0].Text)
+}
+
+func TestLogSender_WithoutFile(t *testing.T) {
+	sender, err := NewLogSender("")
+	require.NoError(t, err)
+
+	assert.NoError(t, sender.Send(context.Background(), "+79990001122", "code 123456"))
+	assert.NoError(t, sender.Close())
+}
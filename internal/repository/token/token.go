@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	createTokenQuery = `
+		INSERT INTO personal_access_token (id, user_id, name, token_hash, scopes, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)`
+
+	getTokensByUserIDQuery = `
+		SELECT id, user_id, name, scopes, expires_at, last_used_at, created_at
+		FROM personal_access_token
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC`
+
+	getActiveTokenByHashQuery = `
+		SELECT t.id, t.user_id, t.name, t.scopes, t.expires_at, t.last_used_at, t.created_at, u.user_type
+		FROM personal_access_token t
+		JOIN "user" u ON u.id = t.user_id
+		WHERE t.token_hash = $1 AND t.revoked_at IS NULL AND (t.expires_at IS NULL OR t.expires_at > NOW())`
+
+	revokeTokenQuery = `
+		UPDATE personal_access_token
+		SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`
+
+	touchTokenQuery = `
+		UPDATE personal_access_token
+		SET last_used_at = NOW()
+		WHERE id = $1`
+)
+
+type TokenRepository struct {
+	db pgxinterface.PgxPool
+}
+
+func New(db pgxinterface.PgxPool) *TokenRepository {
+	return &TokenRepository{
+		db: db,
+	}
+}
+
+// NewWithPool создает репозиторий с конкретным типом *pgxpool.Pool
+func NewWithPool(db *pgxpool.Pool) *TokenRepository {
+	return &TokenRepository{
+		db: db,
+	}
+}
+
+func (r *TokenRepository) CreateToken(ctx context.Context, token *models.Token, tokenHash string) error {
+	const op = "TokenRepository.CreateToken"
+	const query = "INSERT personal_access_token"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", token.UserID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	_, err := r.db.Exec(ctx, createTokenQuery,
+		token.ID, token.UserID, token.Name, tokenHash, token.Scopes, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		queryStatus = "fail"
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case errs.PostgresErrorUniqueViolationCode:
+				logger.WithError(err).Errorf("db query: %s: duplicate key violation: status: %s", query, queryStatus)
+				return errs.ErrIsDuplicateKey
+			case errs.PostgresErrorForeignKeyViolationCode:
+				logger.WithError(err).Errorf("db query: %s: foreign key violation: status: %s", query, queryStatus)
+				return errs.ErrUserNotFound
+			}
+		}
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	return nil
+}
+
+func (r *TokenRepository) GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Token, error) {
+	const op = "TokenRepository.GetTokensByUserID"
+	const query = "SELECT personal_access_tokens"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getTokensByUserIDQuery, userID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*models.Token, 0)
+	for rows.Next() {
+		var token models.Token
+		err := rows.Scan(&token.ID, &token.UserID, &token.Name, &token.Scopes,
+			&token.ExpiresAt, &token.LastUsedAt, &token.CreatedAt)
+		if err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+
+	if err = rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// GetActiveTokenByHash возвращает неотозванный и непросроченный токен по его хэшу
+func (r *TokenRepository) GetActiveTokenByHash(ctx context.Context, tokenHash string) (*models.Token, error) {
+	const op = "TokenRepository.GetActiveTokenByHash"
+	const query = "SELECT personal_access_token by hash"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	var token models.Token
+	err := r.db.QueryRow(ctx, getActiveTokenByHashQuery, tokenHash).
+		Scan(&token.ID, &token.UserID, &token.Name, &token.Scopes,
+			&token.ExpiresAt, &token.LastUsedAt, &token.CreatedAt, &token.AccountType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			queryStatus = "not found"
+			logger.Debugf("db query: %s: token not found: status: %s", query, queryStatus)
+			return nil, errs.ErrInvalidToken
+		}
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *TokenRepository) RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	const op = "TokenRepository.RevokeToken"
+	const query = "UPDATE personal_access_token revoke"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("token_id", tokenID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	result, err := r.db.Exec(ctx, revokeTokenQuery, tokenID, userID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		queryStatus = "not found"
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// TouchToken обновляет время последнего использования токена
+func (r *TokenRepository) TouchToken(ctx context.Context, tokenID uuid.UUID) error {
+	const op = "TokenRepository.TouchToken"
+	const query = "UPDATE personal_access_token last_used_at"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("token_id", tokenID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	_, err := r.db.Exec(ctx, touchTokenQuery, tokenID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	return nil
+}
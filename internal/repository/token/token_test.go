@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var tokenColumns = []string{"id", "user_id", "name", "scopes", "expires_at", "last_used_at", "created_at"}
+
+var activeTokenColumns = []string{"id", "user_id", "name", "scopes", "expires_at", "last_used_at", "created_at", "user_type"}
+
+func TestTokenRepository_CreateToken_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	token := &models.Token{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Name:      "ci",
+		Scopes:    []string{models.ScopeRead},
+		CreatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(createTokenQuery).
+		WithArgs(token.ID, token.UserID, token.Name, "hash", token.Scopes, token.ExpiresAt, token.CreatedAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err = repo.CreateToken(context.Background(), token, "hash")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_CreateToken_UserNotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	token := &models.Token{ID: uuid.New(), UserID: uuid.New(), Name: "ci", Scopes: []string{models.ScopeRead}}
+
+	mock.ExpectExec(createTokenQuery).
+		WithArgs(token.ID, token.UserID, token.Name, "hash", token.Scopes, token.ExpiresAt, token.CreatedAt).
+		WillReturnError(&pgconn.PgError{Code: "23503"})
+
+	err = repo.CreateToken(context.Background(), token, "hash")
+
+	assert.Equal(t, errs.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetTokensByUserID_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(getTokensByUserIDQuery).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows(tokenColumns).
+			AddRow(tokenID, userID, "ci", []string{models.ScopeRead, models.ScopeWrite}, nil, nil, now))
+
+	tokens, err := repo.GetTokensByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, tokenID, tokens[0].ID)
+	assert.Equal(t, []string{models.ScopeRead, models.ScopeWrite}, tokens[0].Scopes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetTokensByUserID_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+
+	mock.ExpectQuery(getTokensByUserIDQuery).
+		WithArgs(userID).
+		WillReturnError(errors.New("db error"))
+
+	tokens, err := repo.GetTokensByUserID(context.Background(), userID)
+
+	assert.Error(t, err)
+	assert.Nil(t, tokens)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetActiveTokenByHash_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mock.ExpectQuery(getActiveTokenByHashQuery).
+		WithArgs("hash").
+		WillReturnRows(pgxmock.NewRows(activeTokenColumns).
+			AddRow(tokenID, userID, "ci", []string{models.ScopeRead}, nil, nil, time.Now(), "bot"))
+
+	token, err := repo.GetActiveTokenByHash(context.Background(), "hash")
+
+	assert.NoError(t, err)
+	assert.Equal(t, tokenID, token.ID)
+	assert.Equal(t, userID, token.UserID)
+	assert.Equal(t, "bot", token.AccountType)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_GetActiveTokenByHash_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	mock.ExpectQuery(getActiveTokenByHashQuery).
+		WithArgs("hash").
+		WillReturnError(pgx.ErrNoRows)
+
+	token, err := repo.GetActiveTokenByHash(context.Background(), "hash")
+
+	assert.Equal(t, errs.ErrInvalidToken, err)
+	assert.Nil(t, token)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_RevokeToken_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mock.ExpectExec(revokeTokenQuery).
+		WithArgs(tokenID, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.RevokeToken(context.Background(), userID, tokenID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_RevokeToken_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mock.ExpectExec(revokeTokenQuery).
+		WithArgs(tokenID, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.RevokeToken(context.Background(), userID, tokenID)
+
+	assert.Equal(t, errs.ErrNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTokenRepository_TouchToken_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	tokenID := uuid.New()
+
+	mock.ExpectExec(touchTokenQuery).
+		WithArgs(tokenID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.TouchToken(context.Background(), tokenID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,13 +2,18 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	AvatarModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	HandleModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	handleRepo "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/handle"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/pgxinterface"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -27,7 +32,20 @@ const (
         SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
                u.created_at, u.updated_at
         FROM "user" u
-        WHERE u.username = $1`
+        WHERE LOWER(u.username) = LOWER($1)`
+
+	// Прежний handle ведёт на владельца, пока не истёк переходный период
+	resolveUsernameQuery = `
+        SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
+               u.created_at, u.updated_at, LOWER(u.username) <> $1 AS redirected
+        FROM "user" u
+        WHERE LOWER(u.username) = $1
+           OR u.id = (
+               SELECT r.user_id FROM username_redirect r
+               WHERE r.username = $1 AND r.user_id IS NOT NULL AND r.expires_at > NOW())
+        LIMIT 1`
+
+	getUsernameForUpdateQuery = `SELECT username FROM "user" WHERE id = $1 FOR UPDATE`
 
 	getUserByIDQuery = `
         SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
@@ -35,13 +53,34 @@ const (
         FROM "user" u
         WHERE u.id = $1`
 
+	getUsersByIDsQuery = `
+        SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
+               u.created_at, u.updated_at
+        FROM "user" u
+        WHERE u.id = ANY($1)`
+
+	getUserProfileQuery = `
+        SELECT u.status_emoji, u.status_text, u.status_expires_at, u.birthday, u.links, u.last_seen_at
+        FROM "user" u
+        WHERE u.id = $1`
+
+	// GREATEST не даёт запоздавшему обновлению вернуть время назад; NULL он пропускает
+	updateLastSeenQuery = `
+        UPDATE "user" SET last_seen_at = GREATEST(last_seen_at, $2)
+        WHERE id = $1`
+
+	getAllUsersQuery = `
+        SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
+               u.created_at, u.updated_at
+        FROM "user" u`
+
 	insertUserAvatarInAttachmentTableQuery = `
 		INSERT INTO attachment (id, file_name, file_size, content_disposition)
 		VALUES ($1, $2, $3, $4)`
 
 	insertUserAvatarInUserAvatarTableQuery = `
-		INSERT INTO avatar_user (user_id, attachment_id)
-		VALUES ($1, $2)`
+		INSERT INTO avatar_user (user_id, attachment_id, sizes)
+		VALUES ($1, $2, $3)`
 
 	getUserAvatarsQuery = `
 		WITH latest_avatars AS (
@@ -51,10 +90,27 @@ const (
 			FROM avatar_user au
 			JOIN attachment a ON au.attachment_id = a.id
 			WHERE au.user_id = ANY($1)
-			ORDER BY au.user_id, au.created_at DESC
+			ORDER BY au.user_id, au.current_since DESC
 		)
 		SELECT user_id, attachment_id 
 		FROM latest_avatars`
+
+	getUserAvatarHistoryQuery = `
+		SELECT attachment_id, sizes, created_at
+		FROM avatar_user
+		WHERE user_id = $1
+		ORDER BY current_since DESC`
+
+	// Вложение удаляется целиком, запись в avatar_user уходит каскадом
+	deleteUserAvatarQuery = `
+		DELETE FROM attachment a
+		USING avatar_user au
+		WHERE a.id = au.attachment_id AND au.user_id = $1 AND au.attachment_id = $2
+		RETURNING au.sizes`
+
+	setCurrentUserAvatarQuery = `
+		UPDATE avatar_user SET current_since = NOW()
+		WHERE user_id = $1 AND attachment_id = $2`
 )
 
 type UserRepository struct {
@@ -100,7 +156,7 @@ func (r *UserRepository) GetUserByPhone(ctx context.Context, phone string) (*mod
 		if errors.Is(err, pgx.ErrNoRows) {
 			queryStatus = "not found"
 			logger.Debugf("db query: %s: user not found: status: %s", query, queryStatus)
-			return nil, errors.New("user not found")
+			return nil, errs.ErrUserNotFound
 		}
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
@@ -136,7 +192,7 @@ func (r *UserRepository) GetUserByUsername(ctx context.Context, username string)
 		if errors.Is(err, pgx.ErrNoRows) {
 			queryStatus = "not found"
 			logger.Debugf("db query: %s: user not found: status: %s", query, queryStatus)
-			return nil, errors.New("user not found")
+			return nil, errs.ErrUserNotFound
 		}
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
@@ -146,6 +202,37 @@ func (r *UserRepository) GetUserByUsername(ctx context.Context, username string)
 	return &user, nil
 }
 
+// ResolveUsername ищет пользователя по текущему или прежнему handle. redirected - найден по прежнему
+func (r *UserRepository) ResolveUsername(ctx context.Context, username string) (*models.User, bool, error) {
+	const op = "UserRepository.ResolveUsername"
+	const query = "SELECT user by handle"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("username", username)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	var user models.User
+	var redirected bool
+	err := r.db.QueryRow(ctx, resolveUsernameQuery, HandleModels.Normalize(username)).
+		Scan(&user.ID, &user.Username, &user.Name, &user.PhoneNumber, &user.PasswordHash, &user.Bio, &user.AccountType, &user.CreatedAt, &user.UpdatedAt, &redirected)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			queryStatus = "not found"
+			return nil, false, errs.ErrUserNotFound
+		}
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &user, redirected, nil
+}
+
 func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	const op = "UserRepository.GetUserByID"
 	const query = "SELECT user by ID"
@@ -182,6 +269,94 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models
 	return &user, nil
 }
 
+// GetUsersByIDs возвращает пользователей одним запросом; ненайденные id пропускаются
+func (r *UserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.User, error) {
+	const op = "UserRepository.GetUsersByIDs"
+	const query = "SELECT users by IDs"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("users_count", len(ids))
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	users := make(map[uuid.UUID]*models.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	rows, err := r.db.Query(ctx, getUsersByIDsQuery, ids)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Name, &user.PhoneNumber, &user.PasswordHash, &user.Bio, &user.AccountType, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		users[user.ID] = &user
+	}
+
+	if err := rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetAllUsers возвращает всех пользователей; используется для переиндексации поиска
+func (r *UserRepository) GetAllUsers(ctx context.Context) ([]*models.User, error) {
+	const op = "UserRepository.GetAllUsers"
+	const query = "SELECT all users"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getAllUsersQuery)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*models.User, 0)
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Name, &user.PhoneNumber, &user.PasswordHash, &user.Bio, &user.AccountType, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (r *UserRepository) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
 	const op = "UserRepository.GetUsersNames"
 	const query = "SELECT users names"
@@ -234,7 +409,7 @@ func (r *UserRepository) GetUsersNames(ctx context.Context, usersIds []uuid.UUID
 	return result, nil
 }
 
-func (r *UserRepository) UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatarID uuid.UUID, file_size int64) error {
+func (r *UserRepository) UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatarID uuid.UUID, file_size int64, sizes []int) error {
 	const op = "UserRepository.UpdateUserAvatar"
 	const query = "UPDATE user avatar"
 
@@ -262,7 +437,7 @@ func (r *UserRepository) UpdateUserAvatar(ctx context.Context, userID uuid.UUID,
 		return err
 	}
 
-	_, err = tx.Exec(ctx, insertUserAvatarInUserAvatarTableQuery, userID, avatarID)
+	_, err = tx.Exec(ctx, insertUserAvatarInUserAvatarTableQuery, userID, avatarID, sizes)
 	if err != nil {
 		queryStatus = "fail"
 		logger.WithError(err).Errorf("db query: %s: insert user avatar: status: %s", query, queryStatus)
@@ -278,7 +453,64 @@ func (r *UserRepository) UpdateUserAvatar(ctx context.Context, userID uuid.UUID,
 	return nil
 }
 
-func (r *UserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error {
+// GetUserProfile возвращает статус, день рождения и ссылки пользователя. Статус отдаётся как есть,
+// проверять срок его действия - дело вызывающего
+func (r *UserRepository) GetUserProfile(ctx context.Context, userID uuid.UUID) (*models.Profile, error) {
+	const op = "UserRepository.GetUserProfile"
+	const query = "SELECT user profile"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	var (
+		emoji, text *string
+		expiresAt   *time.Time
+		birthday    *time.Time
+		links       []byte
+		lastSeenAt  *time.Time
+	)
+	err := r.db.QueryRow(ctx, getUserProfileQuery, userID).Scan(&emoji, &text, &expiresAt, &birthday, &links, &lastSeenAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			queryStatus = "not found"
+			return nil, errs.ErrUserNotFound
+		}
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	profile := &models.Profile{Birthday: birthday, LastSeenAt: lastSeenAt}
+
+	status := models.Status{ExpiresAt: expiresAt}
+	if emoji != nil {
+		status.Emoji = *emoji
+	}
+	if text != nil {
+		status.Text = *text
+	}
+	if !status.IsEmpty() {
+		profile.Status = &status
+	}
+
+	if len(links) > 0 {
+		if err := json.Unmarshal(links, &profile.Links); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: could not decode links: status: %s", query, queryStatus)
+			return nil, err
+		}
+	}
+
+	return profile, nil
+}
+
+func (r *UserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, update models.InfoUpdate) error {
 	const op = "UserRepository.UpdateUserInfo"
 	const query = "UPDATE user info"
 
@@ -295,22 +527,54 @@ func (r *UserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, n
 	var args []interface{}
 	argIndex := 1
 
-	if name != nil {
-		setParts = append(setParts, fmt.Sprintf("name = $%d", argIndex))
-		args = append(args, *name)
+	set := func(column string, value interface{}) {
+		setParts = append(setParts, fmt.Sprintf("%s = $%d", column, argIndex))
+		args = append(args, value)
 		argIndex++
 	}
 
-	if username != nil {
-		setParts = append(setParts, fmt.Sprintf("username = $%d", argIndex))
-		args = append(args, *username)
-		argIndex++
+	if update.Name != nil {
+		set("name", *update.Name)
 	}
 
-	if bio != nil {
-		setParts = append(setParts, fmt.Sprintf("description = $%d", argIndex))
-		args = append(args, *bio)
-		argIndex++
+	if update.Username != nil {
+		set("username", *update.Username)
+	}
+
+	if update.Bio != nil {
+		set("description", *update.Bio)
+	}
+
+	if update.Status != nil {
+		if update.Status.IsEmpty() {
+			setParts = append(setParts, "status_emoji = NULL", "status_text = NULL", "status_expires_at = NULL")
+		} else {
+			set("status_emoji", update.Status.Emoji)
+			set("status_text", update.Status.Text)
+			set("status_expires_at", update.Status.ExpiresAt)
+		}
+	}
+
+	if update.Birthday != nil {
+		if update.Birthday.IsZero() {
+			setParts = append(setParts, "birthday = NULL")
+		} else {
+			set("birthday", *update.Birthday)
+		}
+	}
+
+	if update.Links != nil {
+		links := *update.Links
+		if links == nil {
+			links = []models.Link{}
+		}
+		encoded, err := json.Marshal(links)
+		if err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: could not encode links: status: %s", query, queryStatus)
+			return err
+		}
+		set("links", string(encoded))
 	}
 
 	if len(setParts) == 0 {
@@ -323,18 +587,20 @@ func (r *UserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, n
 
 	querySQL := fmt.Sprintf("UPDATE \"user\" SET %s WHERE id = $%d", strings.Join(setParts, ", "), argIndex)
 
+	if update.Username != nil {
+		if err := r.updateUserInfoWithUsername(ctx, userID, *update.Username, querySQL, args); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: status: %s", query, queryStatus)
+			return err
+		}
+		return nil
+	}
+
 	result, err := r.db.Exec(ctx, querySQL, args...)
 	if err != nil {
 		queryStatus = "fail"
-
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorUniqueViolationCode {
-			logger.WithError(err).Errorf("db query: %s: duplicate key violation: status: %s", query, queryStatus)
-			return errs.ErrIsDuplicateKey
-		}
-
 		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
-		return errors.New("error update user")
+		return updateUserError(err)
 	}
 
 	rowsAffected := result.RowsAffected()
@@ -347,6 +613,53 @@ func (r *UserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, n
 	return nil
 }
 
+// updateUserInfoWithUsername меняет профиль вместе с handle: новый handle занимается под блокировкой,
+// прежний остаётся редиректом на переходный период
+func (r *UserRepository) updateUserInfoWithUsername(ctx context.Context, userID uuid.UUID, username, querySQL string, args []interface{}) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := handleRepo.Claim(ctx, tx, userID, username); err != nil {
+		return err
+	}
+
+	var oldUsername string
+	if err := tx.QueryRow(ctx, getUsernameForUpdateQuery, userID).Scan(&oldUsername); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New("user not updated")
+		}
+		return fmt.Errorf("get current username: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, querySQL, args...); err != nil {
+		return updateUserError(err)
+	}
+
+	if HandleModels.Normalize(oldUsername) != HandleModels.Normalize(username) {
+		until := time.Now().Add(HandleModels.RedirectGracePeriod)
+		if err := handleRepo.KeepRedirect(ctx, tx, HandleModels.OwnerUser, userID, oldUsername, until); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func updateUserError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == errs.PostgresErrorUniqueViolationCode {
+		return errs.ErrIsDuplicateKey
+	}
+	return errors.New("error update user")
+}
+
 func (r *UserRepository) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]uuid.UUID, error) {
 	const op = "UserRepository.GetUserAvatars"
 	const query = "SELECT user avatars"
@@ -394,3 +707,135 @@ func (r *UserRepository) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID
 	logger.WithField("avatars_count", len(result)).Debugf("db query: %s: status: %s", query, queryStatus)
 	return result, nil
 }
+
+// GetUserAvatarHistory возвращает аватарки пользователя, начиная с текущей
+func (r *UserRepository) GetUserAvatarHistory(ctx context.Context, userID uuid.UUID) ([]*AvatarModels.Avatar, error) {
+	const op = "UserRepository.GetUserAvatarHistory"
+	const query = "SELECT user avatar history"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	rows, err := r.db.Query(ctx, getUserAvatarHistoryQuery, userID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]*AvatarModels.Avatar, 0)
+	for rows.Next() {
+		avatar := &AvatarModels.Avatar{}
+		if err := rows.Scan(&avatar.ID, &avatar.Sizes, &avatar.CreatedAt); err != nil {
+			queryStatus = "fail"
+			logger.WithError(err).Errorf("db query: %s: scan row error: status: %s", query, queryStatus)
+			return nil, err
+		}
+		avatar.IsCurrent = len(result) == 0
+		result = append(result, avatar)
+	}
+
+	if err := rows.Err(); err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: rows iteration error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteUserAvatar удаляет аватарку из истории пользователя и возвращает её размеры,
+// чтобы вызывающий мог удалить файлы из хранилища
+func (r *UserRepository) DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) ([]int, error) {
+	const op = "UserRepository.DeleteUserAvatar"
+	const query = "DELETE user avatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String()).WithField("avatar_id", avatarID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	var sizes []int
+	err := r.db.QueryRow(ctx, deleteUserAvatarQuery, userID, avatarID).Scan(&sizes)
+	if err != nil {
+		queryStatus = "fail"
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debugf("db query: %s: avatar not found: status: %s", query, queryStatus)
+			return nil, errs.ErrNotFound
+		}
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return nil, err
+	}
+
+	return sizes, nil
+}
+
+// SetCurrentUserAvatar делает аватарку из истории пользователя текущей
+func (r *UserRepository) SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	const op = "UserRepository.SetCurrentUserAvatar"
+	const query = "UPDATE current user avatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String()).WithField("avatar_id", avatarID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tag, err := r.db.Exec(ctx, setCurrentUserAvatarQuery, userID, avatarID)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		queryStatus = "fail"
+		logger.Debugf("db query: %s: avatar not found: status: %s", query, queryStatus)
+		return errs.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateLastSeen сохраняет время последнего визита пользователя
+func (r *UserRepository) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	const op = "UserRepository.UpdateLastSeen"
+	const query = "UPDATE user last seen"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	queryStatus := "success"
+	defer func() {
+		logger.Debugf("db query: %s: status: %s", query, queryStatus)
+	}()
+
+	logger.Debugf("starting: %s", query)
+
+	tag, err := r.db.Exec(ctx, updateLastSeenQuery, userID, at)
+	if err != nil {
+		queryStatus = "fail"
+		logger.WithError(err).Errorf("db query: %s: execution error: status: %s", query, queryStatus)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		queryStatus = "not found"
+		return errs.ErrUserNotFound
+	}
+
+	return nil
+}
@@ -10,7 +10,6 @@ import (
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 )
@@ -76,9 +75,8 @@ func TestUserRepository_GetUserByPhone_NotFound(t *testing.T) {
 
 	user, err := repo.GetUserByPhone(ctx, phone)
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
 	assert.Nil(t, user)
-	assert.Equal(t, "user not found", err.Error())
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -132,7 +130,7 @@ func TestUserRepository_GetUserByUsername_Success(t *testing.T) {
         SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
                u.created_at, u.updated_at
         FROM "user" u
-        WHERE u.username = $1`).
+        WHERE LOWER(u.username) = LOWER($1)`).
 		WithArgs(username).
 		WillReturnRows(rows)
 
@@ -163,7 +161,7 @@ func TestUserRepository_GetUserByUsername_NotFound(t *testing.T) {
         SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
                u.created_at, u.updated_at
         FROM "user" u
-        WHERE u.username = $1`).
+        WHERE LOWER(u.username) = LOWER($1)`).
 		WithArgs(username).
 		WillReturnError(pgx.ErrNoRows)
 
@@ -189,7 +187,7 @@ func TestUserRepository_GetUserByUsername_QueryError(t *testing.T) {
         SELECT u.id, u.username, u.name, u.phone_number, u.password_hash, u.description, u.user_type, 
                u.created_at, u.updated_at
         FROM "user" u
-        WHERE u.username = $1`).
+        WHERE LOWER(u.username) = LOWER($1)`).
 		WithArgs(username).
 		WillReturnError(fmt.Errorf("connection error"))
 
@@ -201,6 +199,53 @@ func TestUserRepository_GetUserByUsername_QueryError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUserRepository_ResolveUsername_Redirected(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	rows := pgxmock.NewRows([]string{"id", "username", "name", "phone_number", "password_hash", "description", "user_type", "created_at", "updated_at", "redirected"}).
+		AddRow(userID, "new_user", "Test User", "+79998887766", "hash", nil, UserModels.UserAccount, now, now, true)
+
+	mock.ExpectQuery(`FROM "user" u\s+WHERE LOWER\(u.username\) = \$1\s+OR u.id = \(`).
+		WithArgs("old_user").
+		WillReturnRows(rows)
+
+	user, redirected, err := repo.ResolveUsername(ctx, "@Old_User")
+
+	assert.NoError(t, err)
+	assert.True(t, redirected)
+	assert.Equal(t, userID, user.ID)
+	assert.Equal(t, "new_user", user.Username)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_ResolveUsername_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`FROM "user" u`).
+		WithArgs("ghost").
+		WillReturnError(pgx.ErrNoRows)
+
+	user, redirected, err := repo.ResolveUsername(ctx, "ghost")
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.False(t, redirected)
+	assert.Nil(t, user)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_GetUserByID_Success(t *testing.T) {
 	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
 	assert.NoError(t, err)
@@ -294,6 +339,94 @@ func TestUserRepository_GetUserByID_QueryError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUserRepository_GetUsersByIDs_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	bio := "about"
+	now := time.Now()
+
+	rows := pgxmock.NewRows([]string{"id", "username", "name", "phone_number", "password_hash", "description", "user_type", "created_at", "updated_at"}).
+		AddRow(userIDs[0], "first", "First", "+79990000001", "hash", &bio, UserModels.UserAccount, now, now).
+		AddRow(userIDs[1], "second", "Second", "+79990000002", "hash", nil, UserModels.UserAccount, now, now)
+
+	mock.ExpectQuery(`WHERE u.id = ANY\(\$1\)`).
+		WithArgs(userIDs).
+		WillReturnRows(rows)
+
+	users, err := repo.GetUsersByIDs(ctx, userIDs)
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+	assert.Equal(t, "First", users[userIDs[0]].Name)
+	assert.Equal(t, &bio, users[userIDs[0]].Bio)
+	assert.Nil(t, users[userIDs[1]].Bio)
+	assert.NotContains(t, users, userIDs[2])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetAllUsers_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	now := time.Now()
+	userID := uuid.New()
+
+	rows := pgxmock.NewRows([]string{"id", "username", "name", "phone_number", "password_hash", "description", "user_type", "created_at", "updated_at"}).
+		AddRow(userID, "first", "First", "+79990000001", "hash", nil, UserModels.UserAccount, now, now)
+
+	mock.ExpectQuery(getAllUsersQuery).
+		WillReturnRows(rows)
+
+	users, err := repo.GetAllUsers(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, userID, users[0].ID)
+	assert.Equal(t, "first", users[0].Username)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUsersByIDs_EmptyList(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	users, err := repo.GetUsersByIDs(context.Background(), nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUsersByIDs_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userIDs := []uuid.UUID{uuid.New()}
+
+	mock.ExpectQuery(`WHERE u.id = ANY\(\$1\)`).
+		WithArgs(userIDs).
+		WillReturnError(fmt.Errorf("db error"))
+
+	users, err := repo.GetUsersByIDs(context.Background(), userIDs)
+
+	assert.Error(t, err)
+	assert.Nil(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_GetUsersNames_Success(t *testing.T) {
 	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
 	assert.NoError(t, err)
@@ -396,11 +529,28 @@ func TestUserRepository_UpdateUserInfo_Success(t *testing.T) {
 	username := "updated_user"
 	bio := "Updated bio"
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs("handle:" + username).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(username, userID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(`DELETE FROM username_redirect`).
+		WithArgs(username).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectQuery(`SELECT username FROM "user" WHERE id = \$1 FOR UPDATE`).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"username"}).AddRow("Old_User"))
 	mock.ExpectExec(`UPDATE "user" SET name = \$1, username = \$2, description = \$3 WHERE id = \$4`).
 		WithArgs(name, username, bio, userID).
 		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`INSERT INTO username_redirect \(username, user_id, expires_at\)`).
+		WithArgs("old_user", userID, pgxmock.AnyArg()).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
 
-	err = repo.UpdateUserInfo(ctx, userID, &name, &username, &bio)
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name, Username: &username, Bio: &bio})
 
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -416,7 +566,7 @@ func TestUserRepository_UpdateUserInfo_NoFieldsToUpdate(t *testing.T) {
 
 	userID := uuid.New()
 
-	err = repo.UpdateUserInfo(ctx, userID, nil, nil, nil)
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{})
 
 	assert.Error(t, err)
 	assert.Equal(t, "no fields to update", err.Error())
@@ -434,18 +584,57 @@ func TestUserRepository_UpdateUserInfo_DuplicateKey(t *testing.T) {
 	userID := uuid.New()
 	username := "existing_user"
 
-	pgErr := &pgconn.PgError{Code: "23505", Message: "duplicate key value"}
-	mock.ExpectExec(`UPDATE "user" SET username = \$1 WHERE id = \$2`).
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs("handle:" + username).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
 		WithArgs(username, userID).
-		WillReturnError(pgErr)
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
 
-	err = repo.UpdateUserInfo(ctx, userID, nil, &username, nil)
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Username: &username})
 
 	assert.Error(t, err)
 	assert.Equal(t, errs.ErrIsDuplicateKey, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUserRepository_UpdateUserInfo_SameUsernameKeepsNoRedirect(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	username := "Test_User"
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+		WithArgs("handle:test_user").
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs("test_user", userID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(`DELETE FROM username_redirect`).
+		WithArgs("test_user").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectQuery(`SELECT username FROM "user" WHERE id = \$1 FOR UPDATE`).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"username"}).AddRow("test_user"))
+	mock.ExpectExec(`UPDATE "user" SET username = \$1 WHERE id = \$2`).
+		WithArgs(username, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Username: &username})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_UpdateUserInfo_UserNotUpdated(t *testing.T) {
 	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherRegexp))
 	assert.NoError(t, err)
@@ -461,13 +650,137 @@ func TestUserRepository_UpdateUserInfo_UserNotUpdated(t *testing.T) {
 		WithArgs(name, userID).
 		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
 
-	err = repo.UpdateUserInfo(ctx, userID, &name, nil, nil)
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name})
 
 	assert.Error(t, err)
 	assert.Equal(t, "user not updated", err.Error())
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUserRepository_UpdateUserInfo_Profile(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	expiresAt := time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC)
+	birthday := time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC)
+	links := []UserModels.Link{{Title: "GitHub", URL: "https://github.com/undefined"}}
+
+	mock.ExpectExec(`UPDATE "user" SET status_emoji = $1, status_text = $2, status_expires_at = $3, birthday = $4, links = $5 WHERE id = $6`).
+		WithArgs("🌴", "в отпуске", &expiresAt, birthday, `[{"title":"GitHub","url":"https://github.com/undefined"}]`, userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{
+		Status:   &UserModels.Status{Emoji: "🌴", Text: "в отпуске", ExpiresAt: &expiresAt},
+		Birthday: &birthday,
+		Links:    &links,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_UpdateUserInfo_ClearProfile(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	var noLinks []UserModels.Link
+
+	mock.ExpectExec(`UPDATE "user" SET status_emoji = NULL, status_text = NULL, status_expires_at = NULL, birthday = NULL, links = $1 WHERE id = $2`).
+		WithArgs("[]", userID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{
+		Status:   &UserModels.Status{},
+		Birthday: &time.Time{},
+		Links:    &noLinks,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUserProfile_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	emoji, text := "🌴", "в отпуске"
+	birthday := time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC)
+	lastSeenAt := time.Date(2025, 11, 2, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery(getUserProfileQuery).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"status_emoji", "status_text", "status_expires_at", "birthday", "links", "last_seen_at"}).
+			AddRow(&emoji, &text, nil, &birthday, []byte(`[{"title":"GitHub","url":"https://github.com/undefined"}]`), &lastSeenAt))
+
+	profile, err := repo.GetUserProfile(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &UserModels.Status{Emoji: emoji, Text: text}, profile.Status)
+	assert.Equal(t, &birthday, profile.Birthday)
+	assert.Equal(t, []UserModels.Link{{Title: "GitHub", URL: "https://github.com/undefined"}}, profile.Links)
+	assert.Equal(t, &lastSeenAt, profile.LastSeenAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUserProfile_Empty(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(getUserProfileQuery).
+		WithArgs(userID).
+		WillReturnRows(pgxmock.NewRows([]string{"status_emoji", "status_text", "status_expires_at", "birthday", "links", "last_seen_at"}).
+			AddRow(nil, nil, nil, nil, []byte(`[]`), nil))
+
+	profile, err := repo.GetUserProfile(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, profile.Status)
+	assert.Nil(t, profile.Birthday)
+	assert.Empty(t, profile.Links)
+	assert.Nil(t, profile.LastSeenAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUserProfile_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+
+	mock.ExpectQuery(getUserProfileQuery).
+		WithArgs(userID).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err = repo.GetUserProfile(context.Background(), userID)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_GetUserAvatars_Success(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
@@ -510,3 +823,201 @@ func TestUserRepository_GetUserAvatars_EmptyList(t *testing.T) {
 	assert.Empty(t, result)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUserRepository_UpdateUserAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	avatarID := uuid.New()
+	sizes := []int{640, 320, 160}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(insertUserAvatarInAttachmentTableQuery).
+		WithArgs(avatarID, "avatar_"+avatarID.String(), int64(1024), "inline").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(insertUserAvatarInUserAvatarTableQuery).
+		WithArgs(userID, avatarID, sizes).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectCommit()
+	mock.ExpectRollback()
+
+	err = repo.UpdateUserAvatar(ctx, userID, avatarID, 1024, sizes)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUserAvatarHistory_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	currentID := uuid.New()
+	previousID := uuid.New()
+	createdAt := time.Now()
+
+	rows := pgxmock.NewRows([]string{"attachment_id", "sizes", "created_at"}).
+		AddRow(currentID, []int{640, 320, 160}, createdAt).
+		AddRow(previousID, []int{}, createdAt.Add(-time.Hour))
+
+	mock.ExpectQuery(getUserAvatarHistoryQuery).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	result, err := repo.GetUserAvatarHistory(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, currentID, result[0].ID)
+	assert.True(t, result[0].IsCurrent)
+	assert.Equal(t, []int{640, 320, 160}, result[0].Sizes)
+	assert.Equal(t, previousID, result[1].ID)
+	assert.False(t, result[1].IsCurrent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetUserAvatarHistory_QueryError(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+
+	mock.ExpectQuery(getUserAvatarHistoryQuery).
+		WithArgs(userID).
+		WillReturnError(fmt.Errorf("database error"))
+
+	result, err := repo.GetUserAvatarHistory(context.Background(), userID)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_DeleteUserAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mock.ExpectQuery(deleteUserAvatarQuery).
+		WithArgs(userID, avatarID).
+		WillReturnRows(pgxmock.NewRows([]string{"sizes"}).AddRow([]int{320, 160}))
+
+	sizes, err := repo.DeleteUserAvatar(context.Background(), userID, avatarID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{320, 160}, sizes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_DeleteUserAvatar_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mock.ExpectQuery(deleteUserAvatarQuery).
+		WithArgs(userID, avatarID).
+		WillReturnError(pgx.ErrNoRows)
+
+	sizes, err := repo.DeleteUserAvatar(context.Background(), userID, avatarID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, sizes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_SetCurrentUserAvatar_Success(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mock.ExpectExec(setCurrentUserAvatarQuery).
+		WithArgs(userID, avatarID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.SetCurrentUserAvatar(context.Background(), userID, avatarID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_SetCurrentUserAvatar_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mock.ExpectExec(setCurrentUserAvatarQuery).
+		WithArgs(userID, avatarID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.SetCurrentUserAvatar(context.Background(), userID, avatarID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_UpdateLastSeen(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	at := time.Date(2025, 11, 2, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectExec(updateLastSeenQuery).
+		WithArgs(userID, at).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	err = repo.UpdateLastSeen(context.Background(), userID, at)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_UpdateLastSeen_NotFound(t *testing.T) {
+	mock, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := New(mock)
+
+	userID := uuid.New()
+	at := time.Now()
+
+	mock.ExpectExec(updateLastSeenQuery).
+		WithArgs(userID, at).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err = repo.UpdateLastSeen(context.Background(), userID, at)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestInit(t *testing.T) {
+	tests := []struct {
+		name         string
+		conf         *config.TracingConfig
+		wantProvider bool
+	}{
+		{
+			name:         "No config",
+			conf:         nil,
+			wantProvider: false,
+		},
+		{
+			name:         "No-op exporter",
+			conf:         &config.TracingConfig{Exporter: config.TracingExporterNone, SampleRatio: 1},
+			wantProvider: false,
+		},
+		{
+			name:         "Stdout exporter",
+			conf:         &config.TracingConfig{Exporter: config.TracingExporterStdout, SampleRatio: 1},
+			wantProvider: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prevProvider := otel.GetTracerProvider()
+			t.Cleanup(func() { otel.SetTracerProvider(prevProvider) })
+
+			shutdown, err := Init(context.Background(), tt.conf, "test")
+			require.NoError(t, err)
+			require.NotNil(t, shutdown)
+
+			_, isSDK := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+			assert.Equal(t, tt.wantProvider, isSDK)
+			assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent")
+
+			assert.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	botDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	tokenDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=bot_interface.go -destination=../../usecase/mocks/mock_bot_usecase_mock.go -package=mocks IBotUsecase
+type IBotUsecase interface {
+	CreateBot(ctx context.Context, ownerID uuid.UUID, name, username string) (*botDTO.CreatedBot, error)
+	GetBots(ctx context.Context, ownerID uuid.UUID) ([]*botDTO.Bot, error)
+	DeleteBot(ctx context.Context, ownerID, botID uuid.UUID) error
+	SetWebhook(ctx context.Context, ownerID, botID uuid.UUID, webhookURL string) (*botDTO.WebhookSecret, error)
+	CreateBotToken(ctx context.Context, ownerID, botID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*tokenDTO.CreatedToken, error)
+	RevokeBotToken(ctx context.Context, ownerID, botID, tokenID uuid.UUID) error
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	SessionModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/auth"
 	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
@@ -22,13 +23,19 @@ type AuthGRPCHandler struct {
 
 	authUsecase    auth.IAuthUsecase
 	sessionUsecase auth.ISessionUsecase
+	tokenUsecase   auth.ITokenUsecase
+	botUsecase     auth.IBotUsecase
+	phoneUsecase   auth.IPhoneUsecase
 	csrfConfig     *config.CSRFConfig
 }
 
-func NewAuthGRPCHandler(uc auth.IAuthUsecase, sessionUC auth.ISessionUsecase, csrfConfig *config.CSRFConfig) *AuthGRPCHandler {
+func NewAuthGRPCHandler(uc auth.IAuthUsecase, sessionUC auth.ISessionUsecase, tokenUC auth.ITokenUsecase, botUC auth.IBotUsecase, phoneUC auth.IPhoneUsecase, csrfConfig *config.CSRFConfig) *AuthGRPCHandler {
 	return &AuthGRPCHandler{
 		authUsecase:    uc,
 		sessionUsecase: sessionUC,
+		tokenUsecase:   tokenUC,
+		botUsecase:     botUC,
+		phoneUsecase:   phoneUC,
 		csrfConfig:     csrfConfig,
 	}
 }
@@ -52,9 +59,7 @@ func (h *AuthGRPCHandler) Register(ctx context.Context, in *gen.RegisterReq) (*g
 		return nil, status.Error(codes.InvalidArgument, errorMsg)
 	}
 
-	device := in.Device
-
-	sessionID, validationErr := h.authUsecase.Register(ctx, request, device)
+	sessionID, validationErr := h.authUsecase.Register(ctx, request, deviceInfoFromProto(in.Device, in.ClientInfo))
 	if validationErr != nil {
 		logger.WithError(errs.ErrBadRequest).Error("registration failed")
 		// Проверяем, является ли ошибка конфликтом (пользователь уже существует)
@@ -93,9 +98,7 @@ func (h *AuthGRPCHandler) Login(ctx context.Context, in *gen.LoginReq) (*gen.Log
 		return nil, status.Error(codes.InvalidArgument, "validation failed")
 	}
 
-	device := in.Device
-
-	sessionID, err := h.authUsecase.Login(ctx, request, device)
+	sessionID, err := h.authUsecase.Login(ctx, request, deviceInfoFromProto(in.Device, in.ClientInfo))
 	if err != nil {
 		logger.WithError(err).Error("login failed")
 		return nil, status.Error(codes.Unauthenticated, errs.ErrInvalidCredentials.Error())
@@ -123,3 +126,13 @@ func (h *AuthGRPCHandler) Logout(ctx context.Context, req *gen.LogoutReq) (*empt
 
 	return &emptypb.Empty{}, nil
 }
+
+func deviceInfoFromProto(device string, client *gen.ClientInfo) SessionModels.DeviceInfo {
+	return SessionModels.DeviceInfo{
+		Device:     device,
+		IP:         client.GetIp(),
+		OS:         client.GetOs(),
+		Browser:    client.GetBrowser(),
+		AppVersion: client.GetAppVersion(),
+	}
+}
@@ -0,0 +1,192 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	botDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *AuthGRPCHandler) CreateBot(ctx context.Context, req *gen.CreateBotReq) (*gen.CreateBotRes, error) {
+	const op = "AuthGRPCHandler.CreateBot"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, err := uuid.Parse(req.OwnerId)
+	if err != nil {
+		logger.WithError(err).Error("invalid owner ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+
+	created, err := h.botUsecase.CreateBot(ctx, ownerID, req.Name, req.Username)
+	if err != nil {
+		logger.WithError(err).Error("failed to create bot")
+		return nil, botError(err)
+	}
+
+	return &gen.CreateBotRes{
+		Bot:   botToProto(&created.Bot),
+		Token: created.Token,
+	}, nil
+}
+
+func (h *AuthGRPCHandler) ListBots(ctx context.Context, req *gen.ListBotsReq) (*gen.ListBotsRes, error) {
+	const op = "AuthGRPCHandler.ListBots"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, err := uuid.Parse(req.OwnerId)
+	if err != nil {
+		logger.WithError(err).Error("invalid owner ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+
+	bots, err := h.botUsecase.GetBots(ctx, ownerID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get bots")
+		return nil, botError(err)
+	}
+
+	grpcBots := make([]*gen.Bot, 0, len(bots))
+	for _, b := range bots {
+		grpcBots = append(grpcBots, botToProto(b))
+	}
+
+	return &gen.ListBotsRes{Bots: grpcBots}, nil
+}
+
+func (h *AuthGRPCHandler) DeleteBot(ctx context.Context, req *gen.DeleteBotReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.DeleteBot"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, botID, err := parseOwnerAndBot(req.OwnerId, req.BotId)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	if err := h.botUsecase.DeleteBot(ctx, ownerID, botID); err != nil {
+		logger.WithError(err).Error("failed to delete bot")
+		return nil, botError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthGRPCHandler) SetBotWebhook(ctx context.Context, req *gen.SetBotWebhookReq) (*gen.SetBotWebhookRes, error) {
+	const op = "AuthGRPCHandler.SetBotWebhook"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, botID, err := parseOwnerAndBot(req.OwnerId, req.BotId)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	secret, err := h.botUsecase.SetWebhook(ctx, ownerID, botID, req.Url)
+	if err != nil {
+		logger.WithError(err).Error("failed to set webhook")
+		return nil, botError(err)
+	}
+
+	return &gen.SetBotWebhookRes{Secret: secret.Secret}, nil
+}
+
+func (h *AuthGRPCHandler) CreateBotToken(ctx context.Context, req *gen.CreateBotTokenReq) (*gen.CreateTokenRes, error) {
+	const op = "AuthGRPCHandler.CreateBotToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, botID, err := parseOwnerAndBot(req.OwnerId, req.BotId)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	if req.ExpiresInDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "expires_in_days must not be negative")
+	}
+
+	expiresIn := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	created, err := h.botUsecase.CreateBotToken(ctx, ownerID, botID, req.Name, req.Scopes, expiresIn)
+	if err != nil {
+		logger.WithError(err).Error("failed to create bot token")
+		return nil, botError(err)
+	}
+
+	return &gen.CreateTokenRes{
+		Token: tokenToProto(&created.Token),
+		Value: created.Value,
+	}, nil
+}
+
+func (h *AuthGRPCHandler) RevokeBotToken(ctx context.Context, req *gen.RevokeBotTokenReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.RevokeBotToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, botID, err := parseOwnerAndBot(req.OwnerId, req.BotId)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	tokenID, err := uuid.Parse(req.TokenId)
+	if err != nil {
+		logger.WithError(err).Error("invalid token ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid token ID")
+	}
+
+	if err := h.botUsecase.RevokeBotToken(ctx, ownerID, botID, tokenID); err != nil {
+		logger.WithError(err).Error("failed to revoke bot token")
+		return nil, botError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func parseOwnerAndBot(ownerIDStr, botIDStr string) (uuid.UUID, uuid.UUID, error) {
+	ownerID, err := uuid.Parse(ownerIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid owner ID")
+	}
+
+	botID, err := uuid.Parse(botIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid bot ID")
+	}
+
+	return ownerID, botID, nil
+}
+
+func botToProto(b *botDTO.Bot) *gen.Bot {
+	res := &gen.Bot{
+		Id:        b.ID.String(),
+		Name:      b.Name,
+		Username:  b.Username,
+		CreatedAt: b.CreatedAt.Format(time.RFC3339),
+	}
+	if b.WebhookURL != nil {
+		res.WebhookUrl = *b.WebhookURL
+	}
+	return res
+}
+
+func botError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrIsDuplicateKey):
+		return status.Error(codes.AlreadyExists, "username is already taken")
+	case errors.Is(err, errs.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, "invalid bot parameters")
+	case errors.Is(err, errs.ErrUsernameReserved):
+		return status.Error(codes.InvalidArgument, "username is reserved")
+	case errors.Is(err, errs.ErrNotFound):
+		return status.Error(codes.NotFound, "bot not found")
+	default:
+		return tokenError(err)
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// PushTargetsClient - gRPC клиент auth_service для получения push-токенов сессий
+type PushTargetsClient struct {
+	client gen.AuthServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewPushTargetsClient(addr string, conf *config.GRPCConfig, signer *identity.Signer) (*PushTargetsClient, error) {
+	conn, err := grpcclient.New(addr, grpcclient.AuthDownstream, conf,
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PushTargetsClient{
+		client: gen.NewAuthServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *PushTargetsClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *PushTargetsClient) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error) {
+	const op = "PushTargetsClient.GetPushTargets"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		ids = append(ids, id.String())
+	}
+
+	res, err := c.client.GetPushTargets(ctx, &gen.GetPushTargetsReq{UserIds: ids})
+	if err != nil {
+		logger.WithError(err).Error("failed to get push targets")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	targets := make([]modelsPush.Target, 0, len(res.Targets))
+	for _, t := range res.Targets {
+		sessionID, err := uuid.Parse(t.SessionId)
+		if err != nil {
+			logger.WithError(err).Warnf("invalid session id %s in push target", t.SessionId)
+			continue
+		}
+
+		userID, err := uuid.Parse(t.UserId)
+		if err != nil {
+			logger.WithError(err).Warnf("invalid user id %s in push target", t.UserId)
+			continue
+		}
+
+		targets = append(targets, modelsPush.Target{
+			SessionID: sessionID,
+			UserID:    userID,
+			Platform:  t.Platform,
+			Token:     t.Token,
+		})
+	}
+
+	return targets, nil
+}
+
+func (c *PushTargetsClient) DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	const op = "PushTargetsClient.DropPushTokens"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	ids := make([]string, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		ids = append(ids, id.String())
+	}
+
+	_, err := c.client.DropPushTokens(ctx, &gen.DropPushTokensReq{SessionIds: ids})
+	if err != nil {
+		logger.WithError(err).Error("failed to drop push tokens")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
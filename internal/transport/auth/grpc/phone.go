@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *AuthGRPCHandler) RequestPhoneChange(ctx context.Context, req *gen.RequestPhoneChangeReq) (*gen.RequestPhoneChangeRes, error) {
+	const op = "AuthGRPCHandler.RequestPhoneChange"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	requested, err := h.phoneUsecase.RequestPhoneChange(ctx, userID, req.NewPhone)
+	if err != nil {
+		logger.WithError(err).Error("failed to request phone change")
+		return nil, phoneChangeError(err)
+	}
+
+	return &gen.RequestPhoneChangeRes{
+		ExpiresAt:   requested.ExpiresAt.Format(time.RFC3339),
+		ResendAfter: requested.ResendAfter.Format(time.RFC3339),
+	}, nil
+}
+
+func (h *AuthGRPCHandler) ConfirmPhoneChange(ctx context.Context, req *gen.ConfirmPhoneChangeReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.ConfirmPhoneChange"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		logger.WithError(err).Error("invalid session ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	if err := h.phoneUsecase.ConfirmPhoneChange(ctx, userID, sessionID, req.Code, req.RevokeSessions); err != nil {
+		logger.WithError(err).Error("failed to confirm phone change")
+		return nil, phoneChangeError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func phoneChangeError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, "invalid phone number")
+	case errors.Is(err, errs.ErrInvalidCode):
+		return status.Error(codes.InvalidArgument, errs.ErrInvalidCode.Error())
+	case errors.Is(err, errs.ErrIsDuplicateKey):
+		return status.Error(codes.AlreadyExists, errs.ValidateUserAlreadyExists)
+	case errors.Is(err, errs.ErrTooManyRequests):
+		return status.Error(codes.ResourceExhausted, errs.ErrTooManyRequests.Error())
+	case errors.Is(err, errs.ErrNotFound):
+		return status.Error(codes.NotFound, "no pending phone change")
+	case errors.Is(err, errs.ErrUserNotFound):
+		return status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	default:
+		return status.Error(codes.Internal, "failed to change phone number")
+	}
+}
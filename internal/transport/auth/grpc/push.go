@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *AuthGRPCHandler) RegisterPushToken(ctx context.Context, req *gen.RegisterPushTokenReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.RegisterPushToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		logger.WithError(err).Error("invalid session ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	if err := h.sessionUsecase.RegisterPushToken(ctx, userID, sessionID, req.Platform, req.Token); err != nil {
+		logger.WithError(err).Error("failed to register push token")
+		return nil, pushTokenError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthGRPCHandler) UnregisterPushToken(ctx context.Context, req *gen.UnregisterPushTokenReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.UnregisterPushToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	sessionID, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		logger.WithError(err).Error("invalid session ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	if err := h.sessionUsecase.UnregisterPushToken(ctx, userID, sessionID); err != nil {
+		logger.WithError(err).Error("failed to unregister push token")
+		return nil, pushTokenError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthGRPCHandler) GetPushTargets(ctx context.Context, req *gen.GetPushTargetsReq) (*gen.GetPushTargetsRes, error) {
+	const op = "AuthGRPCHandler.GetPushTargets"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userIDs, err := parseUUIDs(req.UserIds)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	targets, err := h.sessionUsecase.GetPushTargets(ctx, userIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get push targets")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	grpcTargets := make([]*gen.PushTarget, 0, len(targets))
+	for _, t := range targets {
+		grpcTargets = append(grpcTargets, &gen.PushTarget{
+			SessionId: t.SessionID.String(),
+			UserId:    t.UserID.String(),
+			Platform:  t.Platform,
+			Token:     t.Token,
+		})
+	}
+
+	return &gen.GetPushTargetsRes{Targets: grpcTargets}, nil
+}
+
+func (h *AuthGRPCHandler) DropPushTokens(ctx context.Context, req *gen.DropPushTokensReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.DropPushTokens"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	sessionIDs, err := parseUUIDs(req.SessionIds)
+	if err != nil {
+		logger.WithError(err).Error("invalid session ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid session ID")
+	}
+
+	if err := h.sessionUsecase.DropPushTokens(ctx, sessionIDs); err != nil {
+		logger.WithError(err).Error("failed to drop push tokens")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func pushTokenError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, "invalid push platform or token")
+	case errors.Is(err, errs.ErrSessionNotFound):
+		return status.Error(codes.NotFound, errs.ErrSessionNotFound.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
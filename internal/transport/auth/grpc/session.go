@@ -57,11 +57,16 @@ func (h *AuthGRPCHandler) GetSessionsByUserID(ctx context.Context, req *gen.GetS
 	var grpcSessions []*gen.Session
 	for _, s := range sessions {
 		grpcSessions = append(grpcSessions, &gen.Session{
-			Id:        s.ID.String(),
-			UserId:    s.UserID.String(),
-			Device:    s.Device,
-			CreatedAt: s.Created_at.Format("2006-01-02 15:04:05"),
-			LastSeen:  s.Last_seen.Format("2006-01-02 15:04:05"),
+			Id:         s.ID.String(),
+			UserId:     s.UserID.String(),
+			Device:     s.Device,
+			CreatedAt:  s.Created_at.Format("2006-01-02 15:04:05"),
+			LastSeen:   s.Last_seen.Format("2006-01-02 15:04:05"),
+			Ip:         s.IP,
+			Os:         s.OS,
+			Browser:    s.Browser,
+			AppVersion: s.AppVersion,
+			Location:   s.Location,
 		})
 	}
 
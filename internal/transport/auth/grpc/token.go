@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	tokenDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *AuthGRPCHandler) CreateToken(ctx context.Context, req *gen.CreateTokenReq) (*gen.CreateTokenRes, error) {
+	const op = "AuthGRPCHandler.CreateToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.ExpiresInDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "expires_in_days must not be negative")
+	}
+
+	expiresIn := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	created, err := h.tokenUsecase.CreateToken(ctx, userID, req.Name, req.Scopes, expiresIn)
+	if err != nil {
+		logger.WithError(err).Error("failed to create token")
+		return nil, tokenError(err)
+	}
+
+	return &gen.CreateTokenRes{
+		Token: tokenToProto(&created.Token),
+		Value: created.Value,
+	}, nil
+}
+
+func (h *AuthGRPCHandler) ListTokens(ctx context.Context, req *gen.ListTokensReq) (*gen.ListTokensRes, error) {
+	const op = "AuthGRPCHandler.ListTokens"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	tokens, err := h.tokenUsecase.GetTokensByUserID(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get tokens")
+		return nil, tokenError(err)
+	}
+
+	grpcTokens := make([]*gen.Token, 0, len(tokens))
+	for _, t := range tokens {
+		grpcTokens = append(grpcTokens, tokenToProto(t))
+	}
+
+	return &gen.ListTokensRes{Tokens: grpcTokens}, nil
+}
+
+func (h *AuthGRPCHandler) RevokeToken(ctx context.Context, req *gen.RevokeTokenReq) (*emptypb.Empty, error) {
+	const op = "AuthGRPCHandler.RevokeToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	tokenID, err := uuid.Parse(req.TokenId)
+	if err != nil {
+		logger.WithError(err).Error("invalid token ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid token ID")
+	}
+
+	if err := h.tokenUsecase.RevokeToken(ctx, userID, tokenID); err != nil {
+		logger.WithError(err).Error("failed to revoke token")
+		return nil, tokenError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthGRPCHandler) ValidateToken(ctx context.Context, req *gen.ValidateTokenReq) (*gen.ValidateTokenRes, error) {
+	const op = "AuthGRPCHandler.ValidateToken"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	token, err := h.tokenUsecase.ValidateToken(ctx, req.Token)
+	if err != nil {
+		logger.WithError(err).Debug("token not found or invalid")
+		return &gen.ValidateTokenRes{Valid: false}, nil
+	}
+
+	return &gen.ValidateTokenRes{
+		Valid:       true,
+		UserId:      token.UserID.String(),
+		Scopes:      token.Scopes,
+		AccountType: token.AccountType,
+	}, nil
+}
+
+func tokenToProto(t *tokenDTO.Token) *gen.Token {
+	res := &gen.Token{
+		Id:        t.ID.String(),
+		UserId:    t.UserID.String(),
+		Name:      t.Name,
+		Scopes:    t.Scopes,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+	if t.ExpiresAt != nil {
+		res.ExpiresAt = t.ExpiresAt.Format(time.RFC3339)
+	}
+	if t.LastUsedAt != nil {
+		res.LastUsedAt = t.LastUsedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func tokenError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, "invalid token name or scopes")
+	case errors.Is(err, errs.ErrNotFound):
+		return status.Error(codes.NotFound, "token not found")
+	case errors.Is(err, errs.ErrUserNotFound):
+		return status.Error(codes.NotFound, errs.ErrUserNotFound.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
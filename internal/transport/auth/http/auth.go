@@ -3,7 +3,9 @@ package transport
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
@@ -18,12 +20,15 @@ import (
 type AuthGRPCProxyHandler struct {
 	authClient    gen.AuthServiceClient
 	sessionConfig *config.SessionConfig
+	// Прокси, которым доверяем заголовки с адресом клиента
+	trustedProxies []*net.IPNet
 }
 
-func NewAuthGRPCProxyHandler(authClient gen.AuthServiceClient, sessionConfig *config.SessionConfig) *AuthGRPCProxyHandler {
+func NewAuthGRPCProxyHandler(authClient gen.AuthServiceClient, sessionConfig *config.SessionConfig, trustedProxies []*net.IPNet) *AuthGRPCProxyHandler {
 	return &AuthGRPCProxyHandler{
-		authClient:    authClient,
-		sessionConfig: sessionConfig,
+		authClient:     authClient,
+		sessionConfig:  sessionConfig,
+		trustedProxies: trustedProxies,
 	}
 }
 
@@ -48,6 +53,74 @@ func getDeviceFromUserAgent(r *http.Request) string {
 	return fmt.Sprintf("%s %s on %s", name, version, os)
 }
 
+// getClientInfo собирает метаданные клиента для сессии: IP, ОС, браузер и версию приложения
+func (h *AuthGRPCProxyHandler) getClientInfo(r *http.Request) *gen.ClientInfo {
+	info := &gen.ClientInfo{
+		Ip:         h.getClientIP(r),
+		AppVersion: r.Header.Get("X-App-Version"),
+	}
+
+	userAgent := r.Header.Get("User-Agent")
+	if userAgent == "" {
+		return info
+	}
+
+	ua := user_agent.New(userAgent)
+	name, _ := ua.Browser()
+	info.Os = ua.OS()
+	info.Browser = name
+
+	return info
+}
+
+// getClientIP возвращает IP клиента. Заголовки проксирующего nginx учитываются, только если
+// соединение пришло от доверенного прокси: иначе клиент подделал бы адрес для обхода лимитов
+func (h *AuthGRPCProxyHandler) getClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !h.isTrustedProxy(host) {
+		return host
+	}
+
+	// Справа налево: первый адрес не из доверенных прокси добавлен последним честным звеном цепочки
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(hops[i])
+			if net.ParseIP(ip) == nil {
+				break
+			}
+			if !h.isTrustedProxy(ip) || i == 0 {
+				return ip
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+
+	return host
+}
+
+func (h *AuthGRPCProxyHandler) isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range h.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Register регистрирует нового пользователя через gRPC
 // @Summary      Регистрация пользователя
 // @Description  Регистрирует нового пользователя в системе через gRPC микросервис и создает сессию
@@ -76,6 +149,7 @@ func (h *AuthGRPCProxyHandler) Register(w http.ResponseWriter, r *http.Request)
 		Password:    req.Password,
 		Name:        req.Name,
 		Device:      device,
+		ClientInfo:  h.getClientInfo(r),
 	})
 	if err != nil {
 		logger.WithError(err).Error("grpc register failed")
@@ -120,6 +194,7 @@ func (h *AuthGRPCProxyHandler) Login(w http.ResponseWriter, r *http.Request) {
 		PhoneNumber: req.PhoneNumber,
 		Password:    req.Password,
 		Device:      device,
+		ClientInfo:  h.getClientInfo(r),
 	})
 	if err != nil {
 		logger.WithError(err).Error("grpc login failed")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -80,10 +81,138 @@ func (m *MockAuthServiceClient) DeleteAllSessionsExceptCurrent(ctx context.Conte
 	return args.Get(0).(*emptypb.Empty), args.Error(1)
 }
 
+func (m *MockAuthServiceClient) RequestPhoneChange(ctx context.Context, in *gen.RequestPhoneChangeReq, opts ...grpc.CallOption) (*gen.RequestPhoneChangeRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.RequestPhoneChangeRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ConfirmPhoneChange(ctx context.Context, in *gen.ConfirmPhoneChangeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) RegisterPushToken(ctx context.Context, in *gen.RegisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) UnregisterPushToken(ctx context.Context, in *gen.UnregisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) GetPushTargets(ctx context.Context, in *gen.GetPushTargetsReq, opts ...grpc.CallOption) (*gen.GetPushTargetsRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.GetPushTargetsRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) DropPushTokens(ctx context.Context, in *gen.DropPushTokensReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) CreateToken(ctx context.Context, in *gen.CreateTokenReq, opts ...grpc.CallOption) (*gen.CreateTokenRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.CreateTokenRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ListTokens(ctx context.Context, in *gen.ListTokensReq, opts ...grpc.CallOption) (*gen.ListTokensRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.ListTokensRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) RevokeToken(ctx context.Context, in *gen.RevokeTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ValidateToken(ctx context.Context, in *gen.ValidateTokenReq, opts ...grpc.CallOption) (*gen.ValidateTokenRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.ValidateTokenRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) CreateBot(ctx context.Context, in *gen.CreateBotReq, opts ...grpc.CallOption) (*gen.CreateBotRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.CreateBotRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) ListBots(ctx context.Context, in *gen.ListBotsReq, opts ...grpc.CallOption) (*gen.ListBotsRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.ListBotsRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) DeleteBot(ctx context.Context, in *gen.DeleteBotReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) SetBotWebhook(ctx context.Context, in *gen.SetBotWebhookReq, opts ...grpc.CallOption) (*gen.SetBotWebhookRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.SetBotWebhookRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) CreateBotToken(ctx context.Context, in *gen.CreateBotTokenReq, opts ...grpc.CallOption) (*gen.CreateTokenRes, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.CreateTokenRes), args.Error(1)
+}
+
+func (m *MockAuthServiceClient) RevokeBotToken(ctx context.Context, in *gen.RevokeBotTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
 func TestAuthHandler_Register_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	req := AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
@@ -129,7 +258,7 @@ func TestAuthHandler_Register_Success(t *testing.T) {
 func TestAuthHandler_Register_InvalidJSON(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	request := httptest.NewRequest(http.MethodPost, "/register", bytes.NewBufferString("invalid json"))
 	request.Header.Set("Content-Type", "application/json")
@@ -143,7 +272,7 @@ func TestAuthHandler_Register_InvalidJSON(t *testing.T) {
 func TestAuthHandler_Register_GRPCError(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	req := AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
@@ -168,7 +297,7 @@ func TestAuthHandler_Register_GRPCError(t *testing.T) {
 func TestAuthHandler_Login_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	req := AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
@@ -205,7 +334,7 @@ func TestAuthHandler_Login_Success(t *testing.T) {
 func TestAuthHandler_Login_InvalidCredentials(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	req := AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
@@ -229,7 +358,7 @@ func TestAuthHandler_Login_InvalidCredentials(t *testing.T) {
 func TestAuthHandler_Logout_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	sessionID := uuid.New().String()
 
@@ -253,7 +382,7 @@ func TestAuthHandler_Logout_Success(t *testing.T) {
 func TestAuthHandler_Logout_NoSession(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	request := httptest.NewRequest(http.MethodPost, "/logout", nil)
 	recorder := httptest.NewRecorder()
@@ -261,3 +390,51 @@ func TestAuthHandler_Logout_NoSession(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 }
+
+func TestGetClientInfo(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	req.RemoteAddr = "10.0.0.5:43210"
+	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36")
+	req.Header.Set("X-App-Version", "1.4.2")
+
+	handler := NewAuthGRPCProxyHandler(nil, &config.SessionConfig{}, nil)
+	info := handler.getClientInfo(req)
+
+	assert.Equal(t, "10.0.0.5", info.GetIp())
+	assert.Equal(t, "Linux x86_64", info.GetOs())
+	assert.Equal(t, "Chrome", info.GetBrowser())
+	assert.Equal(t, "1.4.2", info.GetAppVersion())
+}
+
+func TestGetClientIP_Forwarded(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	handler := NewAuthGRPCProxyHandler(nil, &config.SessionConfig{}, []*net.IPNet{proxies})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	req.RemoteAddr = "10.0.0.2:43210"
+	req.Header.Set("X-Forwarded-For", "5.255.255.10, 10.0.0.1")
+	assert.Equal(t, "5.255.255.10", handler.getClientIP(req))
+
+	// Клиент дописал поддельный адрес в начало цепочки - берём адрес, добавленный доверенным прокси
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	req.RemoteAddr = "10.0.0.2:43210"
+	req.Header.Set("X-Forwarded-For", "1.1.1.1, 5.255.255.10")
+	assert.Equal(t, "5.255.255.10", handler.getClientIP(req))
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	req.RemoteAddr = "10.0.0.2:43210"
+	req.Header.Set("X-Real-IP", "77.88.10.1")
+	assert.Equal(t, "77.88.10.1", handler.getClientIP(req))
+}
+
+func TestGetClientIP_UntrustedPeerIgnoresHeaders(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	handler := NewAuthGRPCProxyHandler(nil, &config.SessionConfig{}, []*net.IPNet{proxies})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+	req.RemoteAddr = "93.184.216.34:43210"
+	req.Header.Set("X-Forwarded-For", "5.255.255.10")
+	req.Header.Set("X-Real-IP", "77.88.10.1")
+
+	assert.Equal(t, "93.184.216.34", handler.getClientIP(req))
+}
@@ -0,0 +1,293 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	tokenDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// CreateBot создает бота текущего пользователя через gRPC
+// @Summary      Создать бота
+// @Description  Создает пользователя-бота и возвращает его токен. Username бота должен заканчиваться на "bot". Токен возвращается только один раз
+// @Tags         bots
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        bot body dto.CreateBotRequest true "Имя и username бота"
+// @Success      201  {object}  dto.CreatedBot  "Созданный бот"
+// @Failure      400  {object}  dto.ErrorDTO    "Некорректные данные"
+// @Failure      401  {object}  dto.ErrorDTO    "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO    "Управление ботами доступно только через сессию"
+// @Failure      409  {object}  dto.ErrorDTO    "Username уже занят"
+// @Router       /bots [post]
+func (h *AuthGRPCProxyHandler) CreateBot(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.CreateBot"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateBotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	res, err := h.authClient.CreateBot(r.Context(), &gen.CreateBotReq{
+		OwnerId:  ownerID,
+		Name:     req.Name,
+		Username: req.Username,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc CreateBot failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusCreated, dto.CreatedBot{
+		Bot:   protoBotToDTO(res.Bot),
+		Token: res.Token,
+	})
+}
+
+// GetBots получает ботов текущего пользователя через gRPC
+// @Summary      Получить список ботов
+// @Description  Возвращает ботов, созданных текущим пользователем
+// @Tags         bots
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {array}   dto.Bot       "Список ботов"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Управление ботами доступно только через сессию"
+// @Router       /bots [get]
+func (h *AuthGRPCProxyHandler) GetBots(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.GetBots"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	res, err := h.authClient.ListBots(r.Context(), &gen.ListBotsReq{OwnerId: ownerID})
+	if err != nil {
+		logger.WithError(err).Error("grpc ListBots failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	bots := make([]dto.Bot, 0, len(res.Bots))
+	for _, b := range res.Bots {
+		bots = append(bots, protoBotToDTO(b))
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, bots)
+}
+
+// DeleteBot удаляет бота через gRPC
+// @Summary      Удалить бота
+// @Description  Удаляет бота вместе с его токенами и членством в чатах
+// @Tags         bots
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        bot_id path string true "ID бота"
+// @Success      200  "бот удален"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный ID бота"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404  {object}  dto.ErrorDTO  "Бот не найден"
+// @Router       /bots/{bot_id} [delete]
+func (h *AuthGRPCProxyHandler) DeleteBot(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.DeleteBot"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, botID, ok := ownerAndBotID(w, r, op)
+	if !ok {
+		return
+	}
+
+	_, err := h.authClient.DeleteBot(r.Context(), &gen.DeleteBotReq{OwnerId: ownerID, BotId: botID})
+	if err != nil {
+		logger.WithError(err).Error("grpc DeleteBot failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
+
+// SetBotWebhook задает webhook бота через gRPC
+// @Summary      Задать webhook бота
+// @Description  Задает адрес, на который отправляются новые сообщения с упоминанием бота. Запросы подписываются HMAC-SHA256 ключом из ответа: заголовок X-Webhook-Signature содержит sha256=hex(HMAC(secret, X-Webhook-Timestamp + "." + body)). Допускаются только https-адреса вне внутренней сети. Пустой url отключает webhook
+// @Tags         bots
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        bot_id path string true "ID бота"
+// @Param        webhook body dto.SetWebhookRequest true "Адрес webhook"
+// @Success      200  {object}  dto.WebhookSecret  "Ключ подписи"
+// @Failure      400  {object}  dto.ErrorDTO       "Некорректный адрес"
+// @Failure      401  {object}  dto.ErrorDTO       "Неавторизованный доступ"
+// @Failure      404  {object}  dto.ErrorDTO       "Бот не найден"
+// @Router       /bots/{bot_id}/webhook [put]
+func (h *AuthGRPCProxyHandler) SetBotWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.SetBotWebhook"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, botID, ok := ownerAndBotID(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.SetWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	res, err := h.authClient.SetBotWebhook(r.Context(), &gen.SetBotWebhookReq{
+		OwnerId: ownerID,
+		BotId:   botID,
+		Url:     req.URL,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc SetBotWebhook failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dto.WebhookSecret{Secret: res.Secret})
+}
+
+// CreateBotToken выпускает токен бота через gRPC
+// @Summary      Создать токен бота
+// @Description  Выпускает дополнительный токен для бота. Значение токена возвращается только один раз
+// @Tags         bots
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        bot_id path string true "ID бота"
+// @Param        token body dto.CreateTokenRequest true "Название, scope и срок жизни токена"
+// @Success      201  {object}  dto.CreatedToken  "Созданный токен"
+// @Failure      400  {object}  dto.ErrorDTO           "Некорректные данные"
+// @Failure      401  {object}  dto.ErrorDTO           "Неавторизованный доступ"
+// @Failure      404  {object}  dto.ErrorDTO           "Бот не найден"
+// @Router       /bots/{bot_id}/tokens [post]
+func (h *AuthGRPCProxyHandler) CreateBotToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.CreateBotToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, botID, ok := ownerAndBotID(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req tokenDTO.CreateTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	res, err := h.authClient.CreateBotToken(r.Context(), &gen.CreateBotTokenReq{
+		OwnerId:       ownerID,
+		BotId:         botID,
+		Name:          req.Name,
+		Scopes:        req.Scopes,
+		ExpiresInDays: req.ExpiresInDays,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc CreateBotToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusCreated, tokenDTO.CreatedToken{
+		Token: protoTokenToDTO(res.Token),
+		Value: res.Value,
+	})
+}
+
+// RevokeBotToken отзывает токен бота через gRPC
+// @Summary      Отозвать токен бота
+// @Description  Отзывает токен бота, после чего он перестает приниматься
+// @Tags         bots
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        bot_id path string true "ID бота"
+// @Param        token_id path string true "ID токена"
+// @Success      200  "токен отозван"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404  {object}  dto.ErrorDTO  "Бот или токен не найден"
+// @Router       /bots/{bot_id}/tokens/{token_id} [delete]
+func (h *AuthGRPCProxyHandler) RevokeBotToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.RevokeBotToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	ownerID, botID, ok := ownerAndBotID(w, r, op)
+	if !ok {
+		return
+	}
+
+	tokenID, err := uuid.Parse(mux.Vars(r)["token_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid token ID")
+		return
+	}
+
+	_, err = h.authClient.RevokeBotToken(r.Context(), &gen.RevokeBotTokenReq{
+		OwnerId: ownerID,
+		BotId:   botID,
+		TokenId: tokenID.String(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc RevokeBotToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
+
+func ownerAndBotID(w http.ResponseWriter, r *http.Request, op string) (string, string, bool) {
+	ownerID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return "", "", false
+	}
+
+	botID, err := uuid.Parse(mux.Vars(r)["bot_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid bot ID")
+		return "", "", false
+	}
+
+	return ownerID, botID.String(), true
+}
+
+func protoBotToDTO(b *gen.Bot) dto.Bot {
+	bot := dto.Bot{
+		Name:     b.GetName(),
+		Username: b.GetUsername(),
+	}
+	bot.ID, _ = uuid.Parse(b.GetId())
+	bot.CreatedAt, _ = time.Parse(time.RFC3339, b.GetCreatedAt())
+	if webhookURL := b.GetWebhookUrl(); webhookURL != "" {
+		bot.WebhookURL = &webhookURL
+	}
+	return bot
+}
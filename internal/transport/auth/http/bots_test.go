@@ -0,0 +1,159 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestBotHandler_CreateBot_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	ownerID := uuid.New()
+	botID := uuid.New()
+
+	mockAuthClient.On("CreateBot", mock.Anything, &gen.CreateBotReq{OwnerId: ownerID.String(), Name: "CI", Username: "ci_bot"}).
+		Return(&gen.CreateBotRes{
+			Bot:   &gen.Bot{Id: botID.String(), Name: "CI", Username: "ci_bot", CreatedAt: "2025-01-01T00:00:00Z"},
+			Token: "gt_secret",
+		}, nil)
+
+	body, _ := json.Marshal(dto.CreateBotRequest{Name: "CI", Username: "ci_bot"})
+	request := httptest.NewRequest(http.MethodPost, "/bots", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, ownerID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateBot(recorder, request)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var res dto.CreatedBot
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, botID, res.ID)
+	assert.Equal(t, "gt_secret", res.Token)
+	assert.Nil(t, res.WebhookURL)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestBotHandler_CreateBot_UsernameTaken(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	mockAuthClient.On("CreateBot", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.AlreadyExists, "username is already taken"))
+
+	body, _ := json.Marshal(dto.CreateBotRequest{Name: "CI", Username: "ci_bot"})
+	request := httptest.NewRequest(http.MethodPost, "/bots", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateBot(recorder, request)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestBotHandler_GetBots_ForbiddenWithToken(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/bots", nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	ctx = context.WithValue(ctx, domains.TokenScopesKey{}, []string{"read"})
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.GetBots(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	mockAuthClient.AssertNotCalled(t, "ListBots", mock.Anything, mock.Anything)
+}
+
+func TestBotHandler_SetBotWebhook_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	ownerID := uuid.New()
+	botID := uuid.New()
+
+	mockAuthClient.On("SetBotWebhook", mock.Anything, &gen.SetBotWebhookReq{
+		OwnerId: ownerID.String(),
+		BotId:   botID.String(),
+		Url:     "https://example.com/hook",
+	}).Return(&gen.SetBotWebhookRes{Secret: "secret"}, nil)
+
+	body, _ := json.Marshal(dto.SetWebhookRequest{URL: "https://example.com/hook"})
+	request := httptest.NewRequest(http.MethodPut, "/bots/"+botID.String()+"/webhook", bytes.NewBuffer(body))
+	request = mux.SetURLVars(request, map[string]string{"bot_id": botID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, ownerID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.SetBotWebhook(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var res dto.WebhookSecret
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, "secret", res.Secret)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestBotHandler_DeleteBot_InvalidID(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/bots/bad", nil)
+	request = mux.SetURLVars(request, map[string]string{"bot_id": "bad"})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteBot(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestBotHandler_RevokeBotToken_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	ownerID := uuid.New()
+	botID := uuid.New()
+	tokenID := uuid.New()
+
+	mockAuthClient.On("RevokeBotToken", mock.Anything, &gen.RevokeBotTokenReq{
+		OwnerId: ownerID.String(),
+		BotId:   botID.String(),
+		TokenId: tokenID.String(),
+	}).Return(&emptypb.Empty{}, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/bots/"+botID.String()+"/tokens/"+tokenID.String(), nil)
+	request = mux.SetURLVars(request, map[string]string{"bot_id": botID.String(), "token_id": tokenID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, ownerID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.RevokeBotToken(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockAuthClient.AssertExpectations(t)
+}
@@ -0,0 +1,117 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+)
+
+// RequestPhoneChange отправляет код подтверждения на новый номер через gRPC
+// @Summary      Запросить смену номера телефона
+// @Description  Отправляет код подтверждения на новый номер. Код действует 10 минут, повторно запросить его можно не чаще раза в минуту. Доступно только при авторизации через сессию
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        phone  body  dto.PhoneChangeRequest  true  "Новый номер телефона"
+// @Success      202  {object}  dto.PhoneChangeRequested  "Код отправлен"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный номер"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Смена номера доступна только через сессию"
+// @Failure      409  {object}  dto.ErrorDTO  "Номер уже занят"
+// @Failure      429  {object}  dto.ErrorDTO  "Код запрошен слишком часто"
+// @Failure      500  {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Router       /me/phone [post]
+func (h *AuthGRPCProxyHandler) RequestPhoneChange(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.RequestPhoneChange"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req AuthDTO.PhoneChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.NewPhone == "" {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	res, err := h.authClient.RequestPhoneChange(r.Context(), &gen.RequestPhoneChangeReq{
+		UserId:   userID,
+		NewPhone: req.NewPhone,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc RequestPhoneChange failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	response := AuthDTO.PhoneChangeRequested{}
+	response.ExpiresAt, _ = time.Parse(time.RFC3339, res.GetExpiresAt())
+	response.ResendAfter, _ = time.Parse(time.RFC3339, res.GetResendAfter())
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusAccepted, response)
+}
+
+// ConfirmPhoneChange подтверждает смену номера кодом через gRPC
+// @Summary      Подтвердить смену номера телефона
+// @Description  Проверяет код и заменяет номер. На прежний номер уходит уведомление о смене. С revoke_sessions завершаются все сессии, кроме текущей. После 5 неверных кодов смену нужно запросить заново
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        confirm  body  dto.PhoneChangeConfirmRequest  true  "Код из SMS"
+// @Success      200  "Номер изменён"
+// @Failure      400  {object}  dto.ErrorDTO  "Неверный код"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Смена номера доступна только через сессию"
+// @Failure      404  {object}  dto.ErrorDTO  "Нет ожидающей смены номера или код истёк"
+// @Failure      409  {object}  dto.ErrorDTO  "Номер уже занят"
+// @Failure      429  {object}  dto.ErrorDTO  "Исчерпаны попытки ввода кода"
+// @Failure      500  {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Router       /me/phone/confirm [post]
+func (h *AuthGRPCProxyHandler) ConfirmPhoneChange(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.ConfirmPhoneChange"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	sessionCookie, err := r.Cookie(h.sessionConfig.Signature)
+	if err != nil {
+		logger.WithError(err).Error("session cookie not found")
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "session not found")
+		return
+	}
+
+	var req AuthDTO.PhoneChangeConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Code == "" {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	_, err = h.authClient.ConfirmPhoneChange(r.Context(), &gen.ConfirmPhoneChangeReq{
+		UserId:         userID,
+		SessionId:      sessionCookie.Value,
+		Code:           req.Code,
+		RevokeSessions: req.RevokeSessions,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc ConfirmPhoneChange failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
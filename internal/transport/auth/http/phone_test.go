@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestPhoneHandler_RequestPhoneChange_Success(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, &config.SessionConfig{Signature: "test_signature"}, nil)
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second)
+
+	mockAuthClient.On("RequestPhoneChange", mock.Anything, &gen.RequestPhoneChangeReq{
+		UserId:   userID.String(),
+		NewPhone: "+79990001122",
+	}).Return(&gen.RequestPhoneChangeRes{
+		ExpiresAt:   expiresAt.Format(time.RFC3339),
+		ResendAfter: expiresAt.Format(time.RFC3339),
+	}, nil)
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeRequest{NewPhone: "+79990001122"})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone", bytes.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.RequestPhoneChange(recorder, request)
+
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+	var response AuthDTO.PhoneChangeRequested
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+	assert.True(t, expiresAt.Equal(response.ExpiresAt))
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestPhoneHandler_RequestPhoneChange_PhoneTaken(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, &config.SessionConfig{Signature: "test_signature"}, nil)
+
+	mockAuthClient.On("RequestPhoneChange", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.AlreadyExists, "a user with such a phone already exists"))
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeRequest{NewPhone: "+79990001122"})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone", bytes.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.RequestPhoneChange(recorder, request)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestPhoneHandler_RequestPhoneChange_ForbiddenWithToken(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, &config.SessionConfig{Signature: "test_signature"}, nil)
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeRequest{NewPhone: "+79990001122"})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone", bytes.NewReader(body))
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	ctx = context.WithValue(ctx, domains.TokenScopesKey{}, []string{"write"})
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.RequestPhoneChange(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	mockAuthClient.AssertNotCalled(t, "RequestPhoneChange", mock.Anything, mock.Anything)
+}
+
+func TestPhoneHandler_ConfirmPhoneChange_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	mockAuthClient.On("ConfirmPhoneChange", mock.Anything, &gen.ConfirmPhoneChangeReq{
+		UserId:         userID.String(),
+		SessionId:      sessionID.String(),
+		Code:           "123456",
+		RevokeSessions: true,
+	}).Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeConfirmRequest{Code: "123456", RevokeSessions: true})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone/confirm", bytes.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+	request.AddCookie(&http.Cookie{Name: sessionConfig.Signature, Value: sessionID.String()})
+
+	recorder := httptest.NewRecorder()
+	handler.ConfirmPhoneChange(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestPhoneHandler_ConfirmPhoneChange_InvalidCode(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	mockAuthClient.On("ConfirmPhoneChange", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid verification code"))
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeConfirmRequest{Code: "000000"})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone/confirm", bytes.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+	request.AddCookie(&http.Cookie{Name: sessionConfig.Signature, Value: uuid.New().String()})
+
+	recorder := httptest.NewRecorder()
+	handler.ConfirmPhoneChange(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestPhoneHandler_ConfirmPhoneChange_NoSession(t *testing.T) {
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, &config.SessionConfig{Signature: "test_signature"}, nil)
+
+	body, _ := json.Marshal(AuthDTO.PhoneChangeConfirmRequest{Code: "123456"})
+	request := httptest.NewRequest(http.MethodPost, "/me/phone/confirm", bytes.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.ConfirmPhoneChange(recorder, request)
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
@@ -22,7 +22,7 @@ import (
 func TestSessionHandler_GetSessionsByUser_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 	sessionID1 := uuid.New()
@@ -61,7 +61,7 @@ func TestSessionHandler_GetSessionsByUser_Success(t *testing.T) {
 func TestSessionHandler_GetSessionsByUser_Unauthorized(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	request := httptest.NewRequest(http.MethodGet, "/sessions", nil)
 	recorder := httptest.NewRecorder()
@@ -73,7 +73,7 @@ func TestSessionHandler_GetSessionsByUser_Unauthorized(t *testing.T) {
 func TestSessionHandler_DeleteSession_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 	sessionID := uuid.New()
@@ -99,7 +99,7 @@ func TestSessionHandler_DeleteSession_Success(t *testing.T) {
 func TestSessionHandler_DeleteSession_InvalidJSON(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 
@@ -117,7 +117,7 @@ func TestSessionHandler_DeleteSession_InvalidJSON(t *testing.T) {
 func TestSessionHandler_DeleteAllSessionsExceptCurrent_Success(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 	currentSessionID := uuid.New()
@@ -144,7 +144,7 @@ func TestSessionHandler_DeleteAllSessionsExceptCurrent_Success(t *testing.T) {
 func TestSessionHandler_DeleteAllSessionsExceptCurrent_NoSession(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 
@@ -161,7 +161,7 @@ func TestSessionHandler_DeleteAllSessionsExceptCurrent_NoSession(t *testing.T) {
 func TestSessionHandler_DeleteSession_GRPCError(t *testing.T) {
 	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
 	mockAuthClient := new(MockAuthServiceClient)
-	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
 
 	userID := uuid.New()
 	sessionID := uuid.New()
@@ -182,3 +182,71 @@ func TestSessionHandler_DeleteSession_GRPCError(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, recorder.Code)
 	mockAuthClient.AssertExpectations(t)
 }
+
+func TestSessionHandler_RegisterPushToken_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	mockAuthClient.On("RegisterPushToken", mock.Anything, mock.MatchedBy(func(r *gen.RegisterPushTokenReq) bool {
+		return r.UserId == userID.String() && r.SessionId == sessionID.String() &&
+			r.Platform == "fcm" && r.Token == "device-token"
+	})).Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(map[string]string{"platform": "fcm", "token": "device-token"})
+	request := httptest.NewRequest(http.MethodPut, "/session/push-token", bytes.NewBuffer(body))
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	request = request.WithContext(ctx)
+	request.AddCookie(&http.Cookie{
+		Name:  sessionConfig.Signature,
+		Value: sessionID.String(),
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.RegisterPushToken(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestSessionHandler_RegisterPushToken_InvalidPlatform(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	mockAuthClient.On("RegisterPushToken", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid push platform or token"))
+
+	body, _ := json.Marshal(map[string]string{"platform": "sms", "token": "device-token"})
+	request := httptest.NewRequest(http.MethodPut, "/session/push-token", bytes.NewBuffer(body))
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+	request.AddCookie(&http.Cookie{
+		Name:  sessionConfig.Signature,
+		Value: uuid.New().String(),
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.RegisterPushToken(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestSessionHandler_UnregisterPushToken_NoSession(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/session/push-token", nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UnregisterPushToken(recorder, request)
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/session"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
 	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
@@ -146,3 +147,109 @@ func (h *AuthGRPCProxyHandler) DeleteAllSessionsExceptCurrent(w http.ResponseWri
 
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
 }
+
+// RegisterPushToken привязывает push-токен устройства к текущей сессии через gRPC
+// @Summary      Зарегистрировать push-токен устройства
+// @Description  Привязывает токен FCM, APNs или подписку Web Push к текущей сессии. Токен удаляется вместе с сессией
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        token body dto.PushToken  true "Платформа и токен устройства"
+// @Success      200  "токен сохранён"
+// @Failure      400  {object}  dto.ErrorDTO     "Некорректная платформа или токен"
+// @Failure      401  {object}  dto.ErrorDTO     "Неавторизованный доступ"
+// @Failure      500  {object}  dto.ErrorDTO     "Внутренняя ошибка сервера"
+// @Router       /session/push-token [put]
+func (h *AuthGRPCProxyHandler) RegisterPushToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.RegisterPushToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userIDVal := r.Context().Value(domains.UserIDKey{})
+	if userIDVal == nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "user_id not found in context")
+		return
+	}
+
+	userID, ok := userIDVal.(string)
+	if !ok {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "invalid user_id in context")
+		return
+	}
+
+	sessionCookie, err := r.Cookie(h.sessionConfig.Signature)
+	if err != nil {
+		logger.WithError(err).Error("session cookie not found")
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "session not found")
+		return
+	}
+
+	var req dto.PushToken
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	_, err = h.authClient.RegisterPushToken(r.Context(), &gen.RegisterPushTokenReq{
+		UserId:    userID,
+		SessionId: sessionCookie.Value,
+		Platform:  req.Platform,
+		Token:     req.Token,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc RegisterPushToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
+
+// UnregisterPushToken отвязывает push-токен от текущей сессии через gRPC
+// @Summary      Удалить push-токен устройства
+// @Description  Отключает push-уведомления для текущей сессии
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Success      200  "токен удалён"
+// @Failure      401  {object}  dto.ErrorDTO     "Неавторизованный доступ"
+// @Failure      500  {object}  dto.ErrorDTO     "Внутренняя ошибка сервера"
+// @Router       /session/push-token [delete]
+func (h *AuthGRPCProxyHandler) UnregisterPushToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.UnregisterPushToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userIDVal := r.Context().Value(domains.UserIDKey{})
+	if userIDVal == nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "user_id not found in context")
+		return
+	}
+
+	userID, ok := userIDVal.(string)
+	if !ok {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "invalid user_id in context")
+		return
+	}
+
+	sessionCookie, err := r.Cookie(h.sessionConfig.Signature)
+	if err != nil {
+		logger.WithError(err).Error("session cookie not found")
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "session not found")
+		return
+	}
+
+	_, err = h.authClient.UnregisterPushToken(r.Context(), &gen.UnregisterPushTokenReq{
+		UserId:    userID,
+		SessionId: sessionCookie.Value,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc UnregisterPushToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
@@ -0,0 +1,172 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// CreateToken выпускает персональный токен доступа через gRPC
+// @Summary      Создать персональный токен
+// @Description  Создает токен для доступа к API через заголовок Authorization: Bearer. Значение токена возвращается только один раз. Доступно только при авторизации через сессию
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        token body dto.CreateTokenRequest true "Название, scope (read, write) и срок жизни токена в днях"
+// @Success      201  {object}  dto.CreatedToken  "Созданный токен"
+// @Failure      400  {object}  dto.ErrorDTO      "Некорректные данные"
+// @Failure      401  {object}  dto.ErrorDTO      "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO      "Управление токенами доступно только через сессию"
+// @Failure      500  {object}  dto.ErrorDTO      "Внутренняя ошибка сервера"
+// @Router       /tokens [post]
+func (h *AuthGRPCProxyHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.CreateToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	res, err := h.authClient.CreateToken(r.Context(), &gen.CreateTokenReq{
+		UserId:        userID,
+		Name:          req.Name,
+		Scopes:        req.Scopes,
+		ExpiresInDays: req.ExpiresInDays,
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc CreateToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusCreated, dto.CreatedToken{
+		Token: protoTokenToDTO(res.Token),
+		Value: res.Value,
+	})
+}
+
+// GetTokens получает персональные токены текущего пользователя через gRPC
+// @Summary      Получить список персональных токенов
+// @Description  Возвращает неотозванные токены пользователя без их значений
+// @Tags         auth
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Success      200  {array}   dto.Token     "Список токенов"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Управление токенами доступно только через сессию"
+// @Failure      500  {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Router       /tokens [get]
+func (h *AuthGRPCProxyHandler) GetTokens(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.GetTokens"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	res, err := h.authClient.ListTokens(r.Context(), &gen.ListTokensReq{UserId: userID})
+	if err != nil {
+		logger.WithError(err).Error("grpc ListTokens failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	tokens := make([]dto.Token, 0, len(res.Tokens))
+	for _, t := range res.Tokens {
+		tokens = append(tokens, protoTokenToDTO(t))
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, tokens)
+}
+
+// RevokeToken отзывает персональный токен через gRPC
+// @Summary      Отозвать персональный токен
+// @Description  Отзывает токен, после чего он перестает приниматься
+// @Tags         auth
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        token_id path string true "ID токена"
+// @Success      200  "токен отозван"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный ID токена"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Управление токенами доступно только через сессию"
+// @Failure      404  {object}  dto.ErrorDTO  "Токен не найден"
+// @Router       /tokens/{token_id} [delete]
+func (h *AuthGRPCProxyHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	const op = "AuthGRPCProxyHandler.RevokeToken"
+	logger := domains.GetLogger(r.Context()).WithField("op", op)
+
+	userID, ok := sessionUserID(w, r, op)
+	if !ok {
+		return
+	}
+
+	tokenID, err := uuid.Parse(mux.Vars(r)["token_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid token ID")
+		return
+	}
+
+	_, err = h.authClient.RevokeToken(r.Context(), &gen.RevokeTokenReq{
+		UserId:  userID,
+		TokenId: tokenID.String(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("grpc RevokeToken failed")
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
+
+// sessionUserID достает user_id из контекста и запрещает управление токенами и ботами по самому токену
+func sessionUserID(w http.ResponseWriter, r *http.Request, op string) (string, bool) {
+	if r.Context().Value(domains.TokenScopesKey{}) != nil {
+		utils.SendError(r.Context(), op, w, http.StatusForbidden, "Tokens can be managed only with a session")
+		return "", false
+	}
+
+	userID, ok := r.Context().Value(domains.UserIDKey{}).(string)
+	if !ok || userID == "" {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "user_id not found in context")
+		return "", false
+	}
+
+	return userID, true
+}
+
+func protoTokenToDTO(t *gen.Token) dto.Token {
+	token := dto.Token{
+		Name:   t.GetName(),
+		Scopes: t.GetScopes(),
+	}
+	token.ID, _ = uuid.Parse(t.GetId())
+	token.CreatedAt, _ = time.Parse(time.RFC3339, t.GetCreatedAt())
+	if expiresAt, err := time.Parse(time.RFC3339, t.GetExpiresAt()); err == nil {
+		token.ExpiresAt = &expiresAt
+	}
+	if lastUsedAt, err := time.Parse(time.RFC3339, t.GetLastUsedAt()); err == nil {
+		token.LastUsedAt = &lastUsedAt
+	}
+	return token
+}
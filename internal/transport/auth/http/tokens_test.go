@@ -0,0 +1,160 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestTokenHandler_CreateToken_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mockAuthClient.On("CreateToken", mock.Anything, mock.MatchedBy(func(r *gen.CreateTokenReq) bool {
+		return r.UserId == userID.String() && r.Name == "ci" && r.ExpiresInDays == 30
+	})).Return(&gen.CreateTokenRes{
+		Token: &gen.Token{
+			Id:        tokenID.String(),
+			Name:      "ci",
+			Scopes:    []string{"read"},
+			CreatedAt: "2025-01-01T00:00:00Z",
+			ExpiresAt: "2025-01-31T00:00:00Z",
+		},
+		Value: "gt_secret",
+	}, nil)
+
+	body, _ := json.Marshal(dto.CreateTokenRequest{Name: "ci", Scopes: []string{"read"}, ExpiresInDays: 30})
+	request := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateToken(recorder, request)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var res dto.CreatedToken
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, "gt_secret", res.Value)
+	assert.Equal(t, tokenID, res.ID)
+	assert.NotNil(t, res.ExpiresAt)
+	assert.Nil(t, res.LastUsedAt)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestTokenHandler_CreateToken_ForbiddenWithToken(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	body, _ := json.Marshal(dto.CreateTokenRequest{Name: "ci", Scopes: []string{"read"}})
+	request := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBuffer(body))
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	ctx = context.WithValue(ctx, domains.TokenScopesKey{}, []string{"read", "write"})
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.CreateToken(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	mockAuthClient.AssertNotCalled(t, "CreateToken", mock.Anything, mock.Anything)
+}
+
+func TestTokenHandler_CreateToken_InvalidArgument(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	mockAuthClient.On("CreateToken", mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid token name or scopes"))
+
+	body, _ := json.Marshal(dto.CreateTokenRequest{Name: "ci", Scopes: []string{"admin"}})
+	request := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateToken(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestTokenHandler_GetTokens_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	userID := uuid.New()
+
+	mockAuthClient.On("ListTokens", mock.Anything, &gen.ListTokensReq{UserId: userID.String()}).
+		Return(&gen.ListTokensRes{Tokens: []*gen.Token{
+			{Id: uuid.New().String(), Name: "ci", Scopes: []string{"read"}, CreatedAt: "2025-01-01T00:00:00Z"},
+		}}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/tokens", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.GetTokens(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var res []dto.Token
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Len(t, res, 1)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestTokenHandler_RevokeToken_Success(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mockAuthClient.On("RevokeToken", mock.Anything, &gen.RevokeTokenReq{UserId: userID.String(), TokenId: tokenID.String()}).
+		Return(&emptypb.Empty{}, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/tokens/"+tokenID.String(), nil)
+	request = mux.SetURLVars(request, map[string]string{"token_id": tokenID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.RevokeToken(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	mockAuthClient.AssertExpectations(t)
+}
+
+func TestTokenHandler_RevokeToken_InvalidID(t *testing.T) {
+	sessionConfig := &config.SessionConfig{Signature: "test_signature"}
+	mockAuthClient := new(MockAuthServiceClient)
+	handler := NewAuthGRPCProxyHandler(mockAuthClient, sessionConfig, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/tokens/bad", nil)
+	request = mux.SetURLVars(request, map[string]string{"token_id": "bad"})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.RevokeToken(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"context"
+
+	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=phone_interface.go -destination=../../usecase/mocks/mock_phone_usecase_mock.go -package=mocks IPhoneUsecase
+type IPhoneUsecase interface {
+	RequestPhoneChange(ctx context.Context, userID uuid.UUID, newPhone string) (*AuthDTO.PhoneChangeRequested, error)
+	ConfirmPhoneChange(ctx context.Context, userID, currentSessionID uuid.UUID, code string, revokeSessions bool) error
+}
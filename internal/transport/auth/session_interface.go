@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
 	sessionDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/session"
 	"github.com/google/uuid"
 )
@@ -14,4 +15,8 @@ type ISessionUsecase interface {
 	UpdateSession(ctx context.Context, sessionID uuid.UUID) error
 	DeleteSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
 	DeleteAllSessionWithoutCurrent(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) error
+	RegisterPushToken(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, platform, token string) error
+	UnregisterPushToken(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
+	GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error)
+	DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error
 }
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	tokenDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=token_interface.go -destination=../../usecase/mocks/mock_token_usecase_mock.go -package=mocks ITokenUsecase
+type ITokenUsecase interface {
+	CreateToken(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*tokenDTO.CreatedToken, error)
+	GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*tokenDTO.Token, error)
+	RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	ValidateToken(ctx context.Context, value string) (*tokenDTO.Token, error)
+}
@@ -2,9 +2,12 @@ package chats
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	mappers "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/mappers"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	chatsInterface "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/interface/chats"
@@ -39,7 +42,12 @@ func (h *ChatsGRPCHandler) GetChats(ctx context.Context, in *gen.GetChatsReq) (*
 		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
 	}
 
-	chatsDTO, err := h.chatsUsecase.GetChats(ctx, userID)
+	filter := dtoChats.ChatsFilterDTO{
+		Archived: in.Archived,
+		Folder:   in.Folder,
+	}
+
+	chatsDTO, err := h.chatsUsecase.GetChats(ctx, userID, filter)
 	if err != nil {
 		logger.WithError(err).Errorf("error getting chats for user %s: %v", in.GetUserId(), err)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -70,6 +78,9 @@ func (h *ChatsGRPCHandler) CreateChat(ctx context.Context, in *gen.CreateChatReq
 	chatID, err := h.chatsUsecase.CreateChat(ctx, *chatDTO)
 	if err != nil {
 		logger.WithError(err).Errorf("error creating chat")
+		if errors.Is(err, errs.ErrUserBlocked) || errors.Is(err, errs.ErrPrivacyRestricted) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -141,6 +152,9 @@ func (h *ChatsGRPCHandler) GetUsersDialog(ctx context.Context, in *gen.GetUsersD
 	dialogDTO, err := h.chatsUsecase.GetUsersDialog(ctx, user1ID, user2ID)
 	if err != nil {
 		logger.WithError(err).Errorf("error getting dialog between users %s and %s: %v", in.GetUser1Id(), in.GetUser2Id(), err)
+		if errors.Is(err, errs.ErrUserBlocked) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -182,6 +196,13 @@ func (h *ChatsGRPCHandler) AddUserToChat(ctx context.Context, in *gen.AddUserToC
 	err = h.chatsUsecase.AddUsersToChat(ctx, chatID, userID, membersDTO)
 	if err != nil {
 		logger.WithError(err).Warningf("error add messages about adding users to chat %s: %v", chatID, err)
+		// Без добавления в чат нельзя подписывать пользователей и рассылать сообщение о вступлении
+		switch {
+		case errors.Is(err, errs.ErrUserBlocked), errors.Is(err, errs.ErrPrivacyRestricted), errors.Is(err, errs.ErrNoRights):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "can't add users to chat")
+		}
 	}
 
 	err = h.messageUsecase.SubscribeUsersOnChat(ctx, chatID, membersDTO)
@@ -316,7 +337,15 @@ func (h *ChatsGRPCHandler) UploadChatAvatar(ctx context.Context, in *gen.UploadC
 	avatarURL, err := h.chatsUsecase.UploadChatAvatar(ctx, userID, chatID, fileData)
 	if err != nil {
 		logger.WithError(err).Error("error uploading chat avatar")
-		return nil, status.Error(codes.Internal, err.Error())
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidImage):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, errs.ErrNoRights):
+			return nil, status.Error(codes.PermissionDenied, "only admins can change chat avatar")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	response := &gen.UploadChatAvatarRes{
@@ -326,6 +355,102 @@ func (h *ChatsGRPCHandler) UploadChatAvatar(ctx context.Context, in *gen.UploadC
 	return response, nil
 }
 
+func (h *ChatsGRPCHandler) GetChatAvatarHistory(ctx context.Context, in *gen.GetChatAvatarHistoryReq) (*gen.GetChatAvatarHistoryRes, error) {
+	const op = "ChatsGRPCHandler.GetChatAvatarHistory"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing chatId: %s", in.GetChatId())
+		return nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	avatars, err := h.chatsUsecase.GetChatAvatarHistory(ctx, userID, chatID)
+	if err != nil {
+		logger.WithError(err).Errorf("error getting avatar history of chat %s", in.GetChatId())
+		if errors.Is(err, errs.ErrNoRights) {
+			return nil, status.Error(codes.PermissionDenied, "user is not a member of the chat")
+		}
+		return nil, status.Error(codes.Internal, "failed to get chat avatar history")
+	}
+
+	return &gen.GetChatAvatarHistoryRes{Avatars: mappers.DTOChatAvatarsToProto(avatars)}, nil
+}
+
+func (h *ChatsGRPCHandler) DeleteChatAvatar(ctx context.Context, in *gen.ChatAvatarReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.DeleteChatAvatar"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, avatarID, err := parseChatAvatarReq(in)
+	if err != nil {
+		logger.WithError(err).Error("error parsing chat avatar request")
+		return nil, err
+	}
+
+	if err := h.chatsUsecase.DeleteChatAvatar(ctx, userID, chatID, avatarID); err != nil {
+		logger.WithError(err).Errorf("error deleting avatar of chat %s", in.GetChatId())
+		return nil, chatAvatarStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatsGRPCHandler) SetCurrentChatAvatar(ctx context.Context, in *gen.ChatAvatarReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.SetCurrentChatAvatar"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, avatarID, err := parseChatAvatarReq(in)
+	if err != nil {
+		logger.WithError(err).Error("error parsing chat avatar request")
+		return nil, err
+	}
+
+	if err := h.chatsUsecase.SetCurrentChatAvatar(ctx, userID, chatID, avatarID); err != nil {
+		logger.WithError(err).Errorf("error setting current avatar of chat %s", in.GetChatId())
+		return nil, chatAvatarStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// parseChatAvatarReq разбирает id из запроса, возвращая статус InvalidArgument при ошибке
+func parseChatAvatarReq(in *gen.ChatAvatarReq) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(in.GetChatId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	avatarID, err := uuid.Parse(in.GetAvatarId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong avatar id format")
+	}
+
+	return userID, chatID, avatarID, nil
+}
+
+// chatAvatarStatus переводит ошибки изменения истории аватарок чата в gRPC-статус
+func chatAvatarStatus(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrNoRights):
+		return status.Error(codes.PermissionDenied, "only admins can change chat avatar")
+	case errors.Is(err, errs.ErrNotFound):
+		return status.Error(codes.NotFound, "avatar not found")
+	default:
+		return status.Error(codes.Internal, "failed to change chat avatar")
+	}
+}
+
 func (h *ChatsGRPCHandler) SearchChats(ctx context.Context, in *gen.SearchChatsReq) (*gen.GetChatsRes, error) {
 	const op = "ChatsGRPCHandler.SearchChats"
 	logger := domains.GetLogger(ctx).WithField("operation", op)
@@ -351,6 +476,31 @@ func (h *ChatsGRPCHandler) SearchChats(ctx context.Context, in *gen.SearchChatsR
 	return response, nil
 }
 
+// GetGroupPeers - межсервисный вызов user_service для ранжирования поиска людей
+func (h *ChatsGRPCHandler) GetGroupPeers(ctx context.Context, in *gen.GetGroupPeersReq) (*gen.GetGroupPeersRes, error) {
+	const op = "ChatsGRPCHandler.GetGroupPeers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	peers, err := h.chatsUsecase.GetGroupPeers(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get group peers")
+		return nil, status.Error(codes.Internal, "can't get group peers")
+	}
+
+	userIDs := make([]string, 0, len(peers))
+	for _, peerID := range peers {
+		userIDs = append(userIDs, peerID.String())
+	}
+
+	return &gen.GetGroupPeersRes{UserIds: userIDs}, nil
+}
+
 func (h *ChatsGRPCHandler) GetChatMessages(ctx context.Context, in *gen.GetChatMessagesReq) (*gen.GetChatMessagesRes, error) {
 	const op = "ChatsGRPCHandler.GetChatMessages"
 	logger := domains.GetLogger(ctx).WithField("operation", op)
@@ -388,3 +538,126 @@ func (h *ChatsGRPCHandler) GetChatMessages(ctx context.Context, in *gen.GetChatM
 
 	return response, nil
 }
+
+func (h *ChatsGRPCHandler) UpdateChatSettings(ctx context.Context, in *gen.UpdateChatSettingsReq) (*gen.ChatSettings, error) {
+	const op = "ChatsGRPCHandler.UpdateChatSettings"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing chatId: %s", in.GetChatId())
+		return nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	update := dtoChats.ChatSettingsUpdateDTO{
+		Muted:    in.Muted,
+		Archived: in.Archived,
+		Folder:   in.Folder,
+	}
+	if in.MutedUntil != nil {
+		mutedUntil := in.GetMutedUntil().AsTime()
+		update.MutedUntil = &mutedUntil
+	}
+	if in.PinOrder != nil {
+		pinOrder := int(in.GetPinOrder())
+		update.PinOrder = &pinOrder
+	}
+
+	settings, err := h.chatsUsecase.UpdateChatSettings(ctx, userID, chatID, update)
+	if err != nil {
+		logger.WithError(err).Errorf("error updating settings of chat %s", in.GetChatId())
+
+		switch {
+		case errors.Is(err, errs.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "chat not found")
+		case errors.Is(err, errs.ErrBadRequest):
+			return nil, status.Error(codes.InvalidArgument, "invalid chat settings")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update chat settings")
+		}
+	}
+
+	// Остальные устройства пользователя и его поток событий узнают о новых настройках
+	if err := h.messageUsecase.NotifyChatSettings(ctx, userID, chatID, *settings); err != nil {
+		logger.WithError(err).Warn("failed to notify user about chat settings")
+	}
+
+	return mappers.DTOChatSettingsToProto(*settings), nil
+}
+
+func (h *ChatsGRPCHandler) SetChatUsername(ctx context.Context, in *gen.SetChatUsernameReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.SetChatUsername"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing chatId: %s", in.GetChatId())
+		return nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	if err := h.chatsUsecase.SetChatUsername(ctx, userID, chatID, in.GetUsername()); err != nil {
+		logger.WithError(err).Errorf("error setting username of chat %s", in.GetChatId())
+
+		switch {
+		case errors.Is(err, errs.ErrNoRights):
+			return nil, status.Error(codes.PermissionDenied, "only admin can change chat username")
+		case errors.Is(err, errs.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "chat not found")
+		case errors.Is(err, errs.ErrIsDuplicateKey):
+			return nil, status.Error(codes.AlreadyExists, "username already exist")
+		case errors.Is(err, errs.ErrUsernameReserved):
+			return nil, status.Error(codes.InvalidArgument, "username is reserved")
+		case errors.Is(err, errs.ErrBadRequest):
+			return nil, status.Error(codes.InvalidArgument, "username must be 3-20 characters and contain only Latin letters, digits, and underscores; dialogs can not be public")
+		default:
+			return nil, status.Error(codes.Internal, "failed to set chat username")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatsGRPCHandler) ResolveChatUsername(ctx context.Context, in *gen.ResolveChatUsernameReq) (*gen.ResolveChatUsernameRes, error) {
+	const op = "ChatsGRPCHandler.ResolveChatUsername"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chat, redirected, err := h.chatsUsecase.ResolveChatUsername(ctx, userID, in.GetUsername())
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "chat not found")
+		}
+		logger.WithError(err).Error("Failed to resolve chat username")
+		return nil, status.Error(codes.Internal, "failed to resolve chat username")
+	}
+
+	return &gen.ResolveChatUsernameRes{
+		Chat: &gen.PublicChat{
+			Id:           chat.ID.String(),
+			Type:         chat.Type,
+			Name:         chat.Name,
+			Description:  chat.Description,
+			Username:     chat.Username,
+			MembersCount: int32(chat.MembersCount),
+			IsMember:     chat.IsMember,
+		},
+		Redirected: redirected,
+	}, nil
+}
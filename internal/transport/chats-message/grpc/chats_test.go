@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
 	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
@@ -22,8 +23,8 @@ type MockChatsUsecase struct {
 	mock.Mock
 }
 
-func (m *MockChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID) ([]dtoChats.ChatViewInformationDTO, error) {
-	args := m.Called(ctx, userId)
+func (m *MockChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID, filter dtoChats.ChatsFilterDTO) ([]dtoChats.ChatViewInformationDTO, error) {
+	args := m.Called(ctx, userId, filter)
 	return args.Get(0).([]dtoChats.ChatViewInformationDTO), args.Error(1)
 }
 
@@ -76,13 +77,111 @@ func (m *MockChatsUsecase) UploadChatAvatar(ctx context.Context, userID, chatID
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockChatsUsecase) GetChatAvatarHistory(ctx context.Context, userID, chatID uuid.UUID) ([]dtoUtils.AvatarDTO, error) {
+	args := m.Called(ctx, userID, chatID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dtoUtils.AvatarDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) DeleteChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	args := m.Called(ctx, userID, chatID, attachmentID)
+	return args.Error(0)
+}
+
+func (m *MockChatsUsecase) SetCurrentChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	args := m.Called(ctx, userID, chatID, attachmentID)
+	return args.Error(0)
+}
+
 func (m *MockChatsUsecase) SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]dtoChats.ChatViewInformationDTO, error) {
 	args := m.Called(ctx, userID, name)
 	return args.Get(0).([]dtoChats.ChatViewInformationDTO), args.Error(1)
 }
 
+func (m *MockChatsUsecase) UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, update dtoChats.ChatSettingsUpdateDTO) (*dtoChats.ChatSettingsDTO, error) {
+	args := m.Called(ctx, userID, chatID, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoChats.ChatSettingsDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockChatsUsecase) SetChatUsername(ctx context.Context, userID, chatID uuid.UUID, username string) error {
+	args := m.Called(ctx, userID, chatID, username)
+	return args.Error(0)
+}
+
+func (m *MockChatsUsecase) ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*dtoChats.PublicChatDTO, bool, error) {
+	args := m.Called(ctx, userID, username)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*dtoChats.PublicChatDTO), args.Bool(1), args.Error(2)
+}
+
+func (m *MockChatsUsecase) CreateInvite(ctx context.Context, userID, chatID uuid.UUID, req dtoChats.InviteCreateDTO) (*dtoChats.InviteDTO, error) {
+	args := m.Called(ctx, userID, chatID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoChats.InviteDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) GetChatInvites(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.InviteDTO, error) {
+	args := m.Called(ctx, userID, chatID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dtoChats.InviteDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) RevokeInvite(ctx context.Context, userID, chatID, inviteID uuid.UUID) error {
+	args := m.Called(ctx, userID, chatID, inviteID)
+	return args.Error(0)
+}
+
+func (m *MockChatsUsecase) JoinChatByInvite(ctx context.Context, userID uuid.UUID, code string) (*dtoChats.JoinByInviteDTO, error) {
+	args := m.Called(ctx, userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoChats.JoinByInviteDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) GetJoinRequests(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.JoinRequestDTO, error) {
+	args := m.Called(ctx, userID, chatID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dtoChats.JoinRequestDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) ApproveJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) (*dtoChats.AddChatMemberDTO, error) {
+	args := m.Called(ctx, adminID, chatID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoChats.AddChatMemberDTO), args.Error(1)
+}
+
+func (m *MockChatsUsecase) DeclineJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) error {
+	args := m.Called(ctx, adminID, chatID, userID)
+	return args.Error(0)
+}
+
 type MockMessageUsecase struct {
 	mock.Mock
+	shutdown chan struct{}
 }
 
 func (m *MockMessageUsecase) AddMessage(ctx context.Context, message dtoMessage.CreateMessageDTO, userID uuid.UUID) error {
@@ -132,6 +231,39 @@ func (m *MockMessageUsecase) UploadAttachment(ctx context.Context, userID, chatI
 	return args.Get(0).(*dtoMessage.AttachmentDTO), args.Error(1)
 }
 
+func (m *MockMessageUsecase) NotifyUser(ctx context.Context, userID uuid.UUID, notification dtoMessage.SystemNotificationDTO) error {
+	args := m.Called(ctx, userID, notification)
+	return args.Error(0)
+}
+
+func (m *MockMessageUsecase) NotifyChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings dtoChats.ChatSettingsDTO) error {
+	args := m.Called(ctx, userID, chatID, settings)
+	return args.Error(0)
+}
+
+func (m *MockMessageUsecase) NotifyUserStatus(ctx context.Context, userStatus dtoMessage.UserStatusDTO) error {
+	args := m.Called(ctx, userStatus)
+	return args.Error(0)
+}
+
+func (m *MockMessageUsecase) GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]dtoMessage.MessageDTO, error) {
+	args := m.Called(ctx, userID, chatID)
+	return args.Get(0).([]dtoMessage.MessageDTO), args.Error(1)
+}
+
+func (m *MockMessageUsecase) ShuttingDown() <-chan struct{} {
+	return m.shutdown
+}
+
+func (m *MockMessageUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID) {
+	m.Called(ctx, userID)
+}
+
+func (m *MockMessageUsecase) ReadMentions(ctx context.Context, userID, chatID uuid.UUID) error {
+	args := m.Called(ctx, userID, chatID)
+	return args.Error(0)
+}
+
 func setupContext() context.Context {
 	ctx := context.Background()
 	_ = domains.GetLogger(ctx)
@@ -147,7 +279,7 @@ func TestGetChats_Success(t *testing.T) {
 	ctx := setupContext()
 
 	expectedChats := []dtoChats.ChatViewInformationDTO{}
-	mockChatsUC.On("GetChats", ctx, userID).Return(expectedChats, nil)
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{}).Return(expectedChats, nil)
 
 	req := &gen.GetChatsReq{UserId: userID.String()}
 	resp, err := handler.GetChats(ctx, req)
@@ -179,7 +311,7 @@ func TestGetChats_UsecaseError(t *testing.T) {
 	userID := uuid.New()
 	ctx := setupContext()
 
-	mockChatsUC.On("GetChats", ctx, userID).Return([]dtoChats.ChatViewInformationDTO{}, errors.New("database error"))
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{}).Return([]dtoChats.ChatViewInformationDTO{}, errors.New("database error"))
 
 	req := &gen.GetChatsReq{UserId: userID.String()}
 	resp, err := handler.GetChats(ctx, req)
@@ -285,6 +417,50 @@ func TestGetUsersDialog_Success(t *testing.T) {
 	mockChatsUC.AssertExpectations(t)
 }
 
+func TestGetUsersDialog_Blocked(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	user1ID := uuid.New()
+	user2ID := uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("GetUsersDialog", ctx, user1ID, user2ID).Return(nil, errs.ErrUserBlocked)
+
+	req := &gen.GetUsersDialogReq{User1Id: user1ID.String(), User2Id: user2ID.String()}
+	resp, err := handler.GetUsersDialog(ctx, req)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAddUserToChat_Blocked(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	memberID := uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("AddUsersToChat", ctx, chatID, userID, mock.Anything).Return(errs.ErrUserBlocked)
+
+	req := &gen.AddUserToChatReq{
+		UserId:  userID.String(),
+		ChatId:  chatID.String(),
+		Members: []*gen.AddMember{{UserId: memberID.String(), Role: "writer"}},
+	}
+	resp, err := handler.AddUserToChat(ctx, req)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	// Ни подписки, ни сообщения о вступлении при отказе
+	mockMessageUC.AssertNotCalled(t, "SubscribeUsersOnChat", mock.Anything, mock.Anything, mock.Anything)
+	mockMessageUC.AssertNotCalled(t, "AddMessageJoinUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestGetUsersDialog_InvalidUser1ID(t *testing.T) {
 	mockChatsUC := new(MockChatsUsecase)
 	mockMessageUC := new(MockMessageUsecase)
@@ -340,6 +516,137 @@ func TestSearchChats_UsecaseError(t *testing.T) {
 	assert.Equal(t, codes.Internal, status.Code(err))
 }
 
+func TestGetGroupPeers_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID := uuid.New()
+	peerID := uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("GetGroupPeers", ctx, userID).Return([]uuid.UUID{peerID}, nil)
+
+	resp, err := handler.GetGroupPeers(ctx, &gen.GetGroupPeersReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{peerID.String()}, resp.UserIds)
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestGetGroupPeers_InvalidUserID(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	resp, err := handler.GetGroupPeers(setupContext(), &gen.GetGroupPeersReq{UserId: "invalid"})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetChatAvatarHistory_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	avatarID := uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("GetChatAvatarHistory", ctx, userID, chatID).Return([]dtoUtils.AvatarDTO{
+		{ID: avatarID, URL: "http://example.com/avatar.jpg", Sizes: map[int]string{160: "http://example.com/avatar_160.jpg"}, IsCurrent: true},
+	}, nil)
+
+	resp, err := handler.GetChatAvatarHistory(ctx, &gen.GetChatAvatarHistoryReq{UserId: userID.String(), ChatId: chatID.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Avatars, 1)
+	assert.Equal(t, avatarID.String(), resp.Avatars[0].Id)
+	assert.Equal(t, "http://example.com/avatar_160.jpg", resp.Avatars[0].Sizes[160])
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestGetChatAvatarHistory_NotMember(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("GetChatAvatarHistory", ctx, userID, chatID).Return(nil, errs.ErrNoRights)
+
+	resp, err := handler.GetChatAvatarHistory(ctx, &gen.GetChatAvatarHistoryReq{UserId: userID.String(), ChatId: chatID.String()})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestDeleteChatAvatar_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID, chatID, avatarID := uuid.New(), uuid.New(), uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("DeleteChatAvatar", ctx, userID, chatID, avatarID).Return(nil)
+
+	resp, err := handler.DeleteChatAvatar(ctx, &gen.ChatAvatarReq{UserId: userID.String(), ChatId: chatID.String(), AvatarId: avatarID.String()})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestDeleteChatAvatar_InvalidAvatarID(t *testing.T) {
+	handler := NewChatsGRPCHandler(new(MockChatsUsecase), new(MockMessageUsecase))
+
+	resp, err := handler.DeleteChatAvatar(setupContext(), &gen.ChatAvatarReq{UserId: uuid.New().String(), ChatId: uuid.New().String(), AvatarId: "invalid"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetCurrentChatAvatar_NotAdmin(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID, chatID, avatarID := uuid.New(), uuid.New(), uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("SetCurrentChatAvatar", ctx, userID, chatID, avatarID).Return(errs.ErrNoRights)
+
+	resp, err := handler.SetCurrentChatAvatar(ctx, &gen.ChatAvatarReq{UserId: userID.String(), ChatId: chatID.String(), AvatarId: avatarID.String()})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestSetCurrentChatAvatar_NotFound(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	userID, chatID, avatarID := uuid.New(), uuid.New(), uuid.New()
+	ctx := setupContext()
+
+	mockChatsUC.On("SetCurrentChatAvatar", ctx, userID, chatID, avatarID).Return(errs.ErrNotFound)
+
+	resp, err := handler.SetCurrentChatAvatar(ctx, &gen.ChatAvatarReq{UserId: userID.String(), ChatId: chatID.String(), AvatarId: avatarID.String()})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockChatsUC.AssertExpectations(t)
+}
+
 func TestGetChatAvatars_Success(t *testing.T) {
 	mockChatsUC := new(MockChatsUsecase)
 	mockMessageUC := new(MockMessageUsecase)
@@ -389,3 +696,176 @@ func TestGetChatAvatars_EmptyChatIDs(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, 0, len(resp.Avatars))
 }
+
+func TestGetChats_WithFilter(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	ctx := setupContext()
+	userID := uuid.New()
+	archived := true
+	folder := "Work"
+
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{Archived: &archived, Folder: &folder}).
+		Return([]dtoChats.ChatViewInformationDTO{{ID: uuid.New(), Settings: dtoChats.ChatSettingsDTO{Archived: true, Folder: folder}}}, nil)
+
+	resp, err := handler.GetChats(ctx, &gen.GetChatsReq{UserId: userID.String(), Archived: &archived, Folder: &folder})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Chats, 1)
+	assert.True(t, resp.Chats[0].GetSettings().GetArchived())
+	assert.Equal(t, folder, resp.Chats[0].GetSettings().GetFolder())
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestUpdateChatSettings_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	ctx := setupContext()
+	userID := uuid.New()
+	chatID := uuid.New()
+	muted := true
+	pinOrder := int32(2)
+	pinOrderInt := 2
+
+	settings := &dtoChats.ChatSettingsDTO{Muted: true, PinOrder: 2}
+	mockChatsUC.On("UpdateChatSettings", ctx, userID, chatID, dtoChats.ChatSettingsUpdateDTO{Muted: &muted, PinOrder: &pinOrderInt}).
+		Return(settings, nil)
+	mockMessageUC.On("NotifyChatSettings", ctx, userID, chatID, *settings).Return(nil)
+
+	resp, err := handler.UpdateChatSettings(ctx, &gen.UpdateChatSettingsReq{
+		UserId:   userID.String(),
+		ChatId:   chatID.String(),
+		Muted:    &muted,
+		PinOrder: &pinOrder,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetMuted())
+	assert.Equal(t, int32(2), resp.GetPinOrder())
+	mockChatsUC.AssertExpectations(t)
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestUpdateChatSettings_InvalidChatID(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	resp, err := handler.UpdateChatSettings(setupContext(), &gen.UpdateChatSettingsReq{
+		UserId: uuid.New().String(),
+		ChatId: "invalid-uuid",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateChatSettings_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "not member", err: errs.ErrNotFound, code: codes.NotFound},
+		{name: "bad request", err: errs.ErrBadRequest, code: codes.InvalidArgument},
+		{name: "internal", err: errors.New("database error"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatsUC := new(MockChatsUsecase)
+			mockMessageUC := new(MockMessageUsecase)
+			handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+			ctx := setupContext()
+			userID := uuid.New()
+			chatID := uuid.New()
+
+			mockChatsUC.On("UpdateChatSettings", ctx, userID, chatID, dtoChats.ChatSettingsUpdateDTO{}).Return(nil, tt.err)
+
+			resp, err := handler.UpdateChatSettings(ctx, &gen.UpdateChatSettingsReq{
+				UserId: userID.String(),
+				ChatId: chatID.String(),
+			})
+
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.code, status.Code(err))
+			mockMessageUC.AssertNotCalled(t, "NotifyChatSettings", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSetChatUsername_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "success", err: nil, code: codes.OK},
+		{name: "not admin", err: errs.ErrNoRights, code: codes.PermissionDenied},
+		{name: "taken", err: errs.ErrIsDuplicateKey, code: codes.AlreadyExists},
+		{name: "reserved", err: errs.ErrUsernameReserved, code: codes.InvalidArgument},
+		{name: "dialog", err: errs.ErrBadRequest, code: codes.InvalidArgument},
+		{name: "internal", err: errors.New("database error"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatsUC := new(MockChatsUsecase)
+			handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+			ctx := setupContext()
+			userID := uuid.New()
+			chatID := uuid.New()
+
+			mockChatsUC.On("SetChatUsername", ctx, userID, chatID, "daily_news").Return(tt.err)
+
+			_, err := handler.SetChatUsername(ctx, &gen.SetChatUsernameReq{
+				UserId:   userID.String(),
+				ChatId:   chatID.String(),
+				Username: "daily_news",
+			})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			mockChatsUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolveChatUsername_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+	ctx := setupContext()
+	userID := uuid.New()
+	chat := &dtoChats.PublicChatDTO{ID: uuid.New(), Type: "channel", Name: "News", Username: "daily_news", MembersCount: 3}
+
+	mockChatsUC.On("ResolveChatUsername", ctx, userID, "old_news").Return(chat, true, nil)
+
+	resp, err := handler.ResolveChatUsername(ctx, &gen.ResolveChatUsernameReq{UserId: userID.String(), Username: "old_news"})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetRedirected())
+	assert.Equal(t, chat.ID.String(), resp.GetChat().GetId())
+	assert.Equal(t, int32(3), resp.GetChat().GetMembersCount())
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestResolveChatUsername_NotFound(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+	ctx := setupContext()
+	userID := uuid.New()
+
+	mockChatsUC.On("ResolveChatUsername", ctx, userID, "ghost").Return(nil, false, errs.ErrNotFound)
+
+	resp, err := handler.ResolveChatUsername(ctx, &gen.ResolveChatUsernameReq{UserId: userID.String(), Username: "ghost"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
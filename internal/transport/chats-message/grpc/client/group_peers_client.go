@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// GroupPeersClient - gRPC клиент для получения участников общих групп из chats_service
+type GroupPeersClient struct {
+	client gen.ChatServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewGroupPeersClient(addr string, conf *config.GRPCConfig, signer *identity.Signer) (*GroupPeersClient, error) {
+	conn, err := grpcclient.New(addr, grpcclient.ChatsDownstream, conf,
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupPeersClient{
+		client: gen.NewChatServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *GroupPeersClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetGroupPeers возвращает пользователей, с которыми userID состоит в общих группах
+func (c *GroupPeersClient) GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "GroupPeersClient.GetGroupPeers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	res, err := c.client.GetGroupPeers(ctx, &gen.GetGroupPeersReq{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to get group peers of user %s", userID)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	peers := make([]uuid.UUID, 0, len(res.GetUserIds()))
+	for _, id := range res.GetUserIds() {
+		peerID, err := uuid.Parse(id)
+		if err != nil {
+			logger.WithError(err).Warnf("skipping invalid peer id %s", id)
+			continue
+		}
+		peers = append(peers, peerID)
+	}
+
+	return peers, nil
+}
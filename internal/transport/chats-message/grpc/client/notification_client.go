@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	SessionModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// NotificationClient - gRPC клиент для отправки системных уведомлений через chats_service
+type NotificationClient struct {
+	client gen.MessageServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewNotificationClient создаёт клиент, подписывающий id получателя уведомления:
+// chats_service принимает NotifyUser только с подписью внутреннего сервиса
+func NewNotificationClient(addr string, conf *config.GRPCConfig, signer *identity.Signer) (*NotificationClient, error) {
+	conn, err := grpcclient.New(addr, grpcclient.ChatsDownstream, conf,
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationClient{
+		client: gen.NewMessageServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *NotificationClient) Close() error {
+	return c.conn.Close()
+}
+
+// NotifyNewDevice оповещает активные соединения пользователя о входе с нового устройства
+func (c *NotificationClient) NotifyNewDevice(ctx context.Context, userID uuid.UUID, info SessionModels.DeviceInfo) error {
+	const op = "NotificationClient.NotifyNewDevice"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.NotifyUser(recipientContext(ctx, userID), &gen.NotifyUserReq{
+		UserId: userID.String(),
+		Notification: &gen.SystemNotification{
+			Kind:      dtoMessage.SystemNotificationKindNewDevice,
+			Text:      newDeviceText(info),
+			CreatedAt: time.Now().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to notify user %s", userID)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// NotifyContactJoined сообщает пользователю, что человек из его адресной книги зарегистрировался
+func (c *NotificationClient) NotifyContactJoined(ctx context.Context, userID uuid.UUID, joined *UserModels.User) error {
+	const op = "NotificationClient.NotifyContactJoined"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.NotifyUser(recipientContext(ctx, userID), &gen.NotifyUserReq{
+		UserId: userID.String(),
+		Notification: &gen.SystemNotification{
+			Kind:      dtoMessage.SystemNotificationKindContactJoined,
+			Text:      fmt.Sprintf("%s теперь в мессенджере", joined.Name),
+			CreatedAt: time.Now().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to notify user %s", userID)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// recipientContext подменяет пользователя контекста получателем, чтобы подпись относилась к нему
+func recipientContext(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+}
+
+func newDeviceText(info SessionModels.DeviceInfo) string {
+	details := make([]string, 0, 3)
+	if info.Device != "" {
+		details = append(details, info.Device)
+	}
+	if info.Location != "" {
+		details = append(details, info.Location)
+	}
+	if info.IP != "" {
+		details = append(details, "IP "+info.IP)
+	}
+
+	if len(details) == 0 {
+		return "Выполнен вход в аккаунт с нового устройства"
+	}
+
+	return "Выполнен вход в аккаунт с нового устройства: " + strings.Join(details, ", ")
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+)
+
+// UserEventsClient - gRPC клиент для отправки событий об изменении профилей в chats_service
+type UserEventsClient struct {
+	client gen.UserEventsServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewUserEventsClient(addr string, conf *config.GRPCConfig, signer *identity.Signer) (*UserEventsClient, error) {
+	conn, err := grpcclient.New(addr, grpcclient.ChatsDownstream, conf,
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserEventsClient{
+		client: gen.NewUserEventsServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+func (c *UserEventsClient) Close() error {
+	return c.conn.Close()
+}
+
+// PublishProfileChanged сообщает chats_service, что закэшированный профиль пользователя устарел
+func (c *UserEventsClient) PublishProfileChanged(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserEventsClient.PublishProfileChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.UserProfileChanged(ctx, &gen.UserProfileChangedReq{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to publish profile change of user %s", userID)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PublishStatusChanged передаёт chats_service новый статус пользователя для рассылки собеседникам
+func (c *UserEventsClient) PublishStatusChanged(ctx context.Context, userID uuid.UUID, status UserModels.Status) error {
+	const op = "UserEventsClient.PublishStatusChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	req := &gen.UserStatus{
+		UserId: userID.String(),
+		Emoji:  status.Emoji,
+		Text:   status.Text,
+	}
+	if status.ExpiresAt != nil {
+		expiresAt := status.ExpiresAt.Format(time.RFC3339)
+		req.ExpiresAt = &expiresAt
+	}
+
+	if _, err := c.client.UserStatusChanged(ctx, req); err != nil {
+		logger.WithError(err).Errorf("failed to publish status change of user %s", userID)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
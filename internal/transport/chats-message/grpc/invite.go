@@ -0,0 +1,247 @@
+package chats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	mappers "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/mappers"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *ChatsGRPCHandler) CreateInvite(ctx context.Context, in *gen.CreateInviteReq) (*gen.Invite, error) {
+	const op = "ChatsGRPCHandler.CreateInvite"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseUserAndChat(in.GetUserId(), in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	req := dtoChats.InviteCreateDTO{
+		Name:             in.GetName(),
+		RequiresApproval: in.GetRequiresApproval(),
+	}
+	if in.ExpiresAt != nil {
+		expiresAt := in.GetExpiresAt().AsTime()
+		req.ExpiresAt = &expiresAt
+	}
+	if in.UsageLimit != nil {
+		usageLimit := int(in.GetUsageLimit())
+		req.UsageLimit = &usageLimit
+	}
+
+	invite, err := h.chatsUsecase.CreateInvite(ctx, userID, chatID, req)
+	if err != nil {
+		logger.WithError(err).Errorf("error creating invite for chat %s", chatID)
+		return nil, inviteStatusError(err, "failed to create invite")
+	}
+
+	return mappers.DTOInviteToProto(*invite), nil
+}
+
+func (h *ChatsGRPCHandler) GetChatInvites(ctx context.Context, in *gen.GetChatReq) (*gen.GetChatInvitesRes, error) {
+	const op = "ChatsGRPCHandler.GetChatInvites"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseUserAndChat(in.GetUserId(), in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	invites, err := h.chatsUsecase.GetChatInvites(ctx, userID, chatID)
+	if err != nil {
+		logger.WithError(err).Errorf("error getting invites of chat %s", chatID)
+		return nil, inviteStatusError(err, "failed to get invites")
+	}
+
+	result := make([]*gen.Invite, len(invites))
+	for i, invite := range invites {
+		result[i] = mappers.DTOInviteToProto(invite)
+	}
+
+	return &gen.GetChatInvitesRes{Invites: result}, nil
+}
+
+func (h *ChatsGRPCHandler) RevokeInvite(ctx context.Context, in *gen.RevokeInviteReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.RevokeInvite"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseUserAndChat(in.GetUserId(), in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	inviteID, err := uuid.Parse(in.GetInviteId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing inviteId: %s", in.GetInviteId())
+		return nil, status.Error(codes.InvalidArgument, "wrong invite id format")
+	}
+
+	if err := h.chatsUsecase.RevokeInvite(ctx, userID, chatID, inviteID); err != nil {
+		logger.WithError(err).Errorf("error revoking invite %s", inviteID)
+		return nil, inviteStatusError(err, "failed to revoke invite")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatsGRPCHandler) JoinChatByInvite(ctx context.Context, in *gen.JoinChatByInviteReq) (*gen.JoinChatByInviteRes, error) {
+	const op = "ChatsGRPCHandler.JoinChatByInvite"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	if in.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invite code is required")
+	}
+
+	result, err := h.chatsUsecase.JoinChatByInvite(ctx, userID, in.GetCode())
+	if err != nil {
+		logger.WithError(err).Warn("error joining chat by invite")
+		return nil, inviteStatusError(err, "failed to join chat")
+	}
+
+	if !result.Pending {
+		h.notifyJoined(ctx, result.ChatID, []dtoChats.AddChatMemberDTO{{UserId: userID, Role: result.Role}})
+	}
+
+	return &gen.JoinChatByInviteRes{
+		ChatId:  result.ChatID.String(),
+		Pending: result.Pending,
+	}, nil
+}
+
+func (h *ChatsGRPCHandler) GetJoinRequests(ctx context.Context, in *gen.GetChatReq) (*gen.GetJoinRequestsRes, error) {
+	const op = "ChatsGRPCHandler.GetJoinRequests"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseUserAndChat(in.GetUserId(), in.GetChatId())
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	requests, err := h.chatsUsecase.GetJoinRequests(ctx, userID, chatID)
+	if err != nil {
+		logger.WithError(err).Errorf("error getting join requests of chat %s", chatID)
+		return nil, inviteStatusError(err, "failed to get join requests")
+	}
+
+	result := make([]*gen.JoinRequest, len(requests))
+	for i, request := range requests {
+		result[i] = mappers.DTOJoinRequestToProto(request)
+	}
+
+	return &gen.GetJoinRequestsRes{Requests: result}, nil
+}
+
+func (h *ChatsGRPCHandler) ApproveJoinRequest(ctx context.Context, in *gen.JoinRequestDecisionReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.ApproveJoinRequest"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	adminID, chatID, requesterID, err := parseJoinRequestDecision(in)
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	member, err := h.chatsUsecase.ApproveJoinRequest(ctx, adminID, chatID, requesterID)
+	if err != nil {
+		logger.WithError(err).Errorf("error approving join request to chat %s", chatID)
+		return nil, inviteStatusError(err, "failed to approve join request")
+	}
+
+	h.notifyJoined(ctx, chatID, []dtoChats.AddChatMemberDTO{*member})
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatsGRPCHandler) DeclineJoinRequest(ctx context.Context, in *gen.JoinRequestDecisionReq) (*emptypb.Empty, error) {
+	const op = "ChatsGRPCHandler.DeclineJoinRequest"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	adminID, chatID, requesterID, err := parseJoinRequestDecision(in)
+	if err != nil {
+		logger.WithError(err).Error("error parsing ids")
+		return nil, err
+	}
+
+	if err := h.chatsUsecase.DeclineJoinRequest(ctx, adminID, chatID, requesterID); err != nil {
+		logger.WithError(err).Errorf("error declining join request to chat %s", chatID)
+		return nil, inviteStatusError(err, "failed to decline join request")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// notifyJoined подписывает вступивших на поток чата и пишет системное сообщение, как при добавлении админом
+func (h *ChatsGRPCHandler) notifyJoined(ctx context.Context, chatID uuid.UUID, members []dtoChats.AddChatMemberDTO) {
+	logger := domains.GetLogger(ctx).WithField("chat_id", chatID.String())
+
+	if err := h.messageUsecase.SubscribeUsersOnChat(ctx, chatID, members); err != nil {
+		logger.WithError(err).Warn("can't subscribe joined users to chat")
+	}
+
+	if err := h.messageUsecase.AddMessageJoinUsers(ctx, chatID, members); err != nil {
+		logger.WithError(err).Warningf("error sending messages about joining chat %s: %v", chatID, err)
+	}
+}
+
+func parseUserAndChat(rawUserID, rawChatID string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(rawChatID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	return userID, chatID, nil
+}
+
+func parseJoinRequestDecision(in *gen.JoinRequestDecisionReq) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	adminID, chatID, err := parseUserAndChat(in.GetUserId(), in.GetChatId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	requesterID, err := uuid.Parse(in.GetRequesterId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong requester id format")
+	}
+
+	return adminID, chatID, requesterID, nil
+}
+
+func inviteStatusError(err error, fallback string) error {
+	switch {
+	case errors.Is(err, errs.ErrNoRights):
+		return status.Error(codes.PermissionDenied, "only admin can manage invites and join requests")
+	case errors.Is(err, errs.ErrInviteExpired):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errs.ErrNotFound):
+		return status.Error(codes.NotFound, "invite or join request not found")
+	case errors.Is(err, errs.ErrAlreadyMember):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, errs.ErrBadRequest):
+		return status.Error(codes.InvalidArgument, "invalid invite: name up to 32 characters, expiry in the future, positive usage limit without approval; dialogs have no invites")
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
@@ -0,0 +1,199 @@
+package chats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateInvite_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+	ctx := setupContext()
+	userID := uuid.New()
+	chatID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	limit := 5
+
+	invite := &dtoChats.InviteDTO{ID: uuid.New(), Code: "abc", CreatorID: userID, UsageLimit: &limit, CreatedAt: time.Now()}
+	mockChatsUC.On("CreateInvite", ctx, userID, chatID, mock.MatchedBy(func(req dtoChats.InviteCreateDTO) bool {
+		return req.Name == "friends" && req.ExpiresAt.Equal(expiresAt) && *req.UsageLimit == limit && !req.RequiresApproval
+	})).Return(invite, nil)
+
+	usageLimit := int32(limit)
+	resp, err := handler.CreateInvite(ctx, &gen.CreateInviteReq{
+		UserId:     userID.String(),
+		ChatId:     chatID.String(),
+		Name:       "friends",
+		ExpiresAt:  timestamppb.New(expiresAt),
+		UsageLimit: &usageLimit,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", resp.GetCode())
+	assert.Equal(t, usageLimit, resp.GetUsageLimit())
+	mockChatsUC.AssertExpectations(t)
+}
+
+func TestCreateInvite_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "not admin", err: errs.ErrNoRights, code: codes.PermissionDenied},
+		{name: "invalid", err: errs.ErrBadRequest, code: codes.InvalidArgument},
+		{name: "internal", err: errors.New("database error"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatsUC := new(MockChatsUsecase)
+			handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+			ctx := setupContext()
+			userID := uuid.New()
+			chatID := uuid.New()
+
+			mockChatsUC.On("CreateInvite", ctx, userID, chatID, mock.Anything).Return(nil, tt.err)
+
+			_, err := handler.CreateInvite(ctx, &gen.CreateInviteReq{UserId: userID.String(), ChatId: chatID.String()})
+
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestJoinChatByInvite_JoinedSubscribes(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	ctx := setupContext()
+	userID := uuid.New()
+	chatID := uuid.New()
+	members := []dtoChats.AddChatMemberDTO{{UserId: userID, Role: "viewer"}}
+
+	mockChatsUC.On("JoinChatByInvite", ctx, userID, "abc").Return(&dtoChats.JoinByInviteDTO{ChatID: chatID, Role: "viewer"}, nil)
+	mockMessageUC.On("SubscribeUsersOnChat", ctx, chatID, members).Return(nil)
+
+	resp, err := handler.JoinChatByInvite(ctx, &gen.JoinChatByInviteReq{UserId: userID.String(), Code: "abc"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, chatID.String(), resp.GetChatId())
+	assert.False(t, resp.GetPending())
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestJoinChatByInvite_PendingDoesNotSubscribe(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	ctx := setupContext()
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsUC.On("JoinChatByInvite", ctx, userID, "abc").Return(&dtoChats.JoinByInviteDTO{ChatID: chatID, Pending: true}, nil)
+
+	resp, err := handler.JoinChatByInvite(ctx, &gen.JoinChatByInviteReq{UserId: userID.String(), Code: "abc"})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetPending())
+	mockMessageUC.AssertNotCalled(t, "SubscribeUsersOnChat", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestJoinChatByInvite_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "unknown code", err: errs.ErrNotFound, code: codes.NotFound},
+		{name: "expired", err: errs.ErrInviteExpired, code: codes.NotFound},
+		{name: "already member", err: errs.ErrAlreadyMember, code: codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockChatsUC := new(MockChatsUsecase)
+			handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+			ctx := setupContext()
+			userID := uuid.New()
+
+			mockChatsUC.On("JoinChatByInvite", ctx, userID, "abc").Return(nil, tt.err)
+
+			_, err := handler.JoinChatByInvite(ctx, &gen.JoinChatByInviteReq{UserId: userID.String(), Code: "abc"})
+
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestApproveJoinRequest_Subscribes(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, mockMessageUC)
+
+	ctx := setupContext()
+	adminID := uuid.New()
+	chatID := uuid.New()
+	requesterID := uuid.New()
+	member := &dtoChats.AddChatMemberDTO{UserId: requesterID, Role: "writer"}
+
+	mockChatsUC.On("ApproveJoinRequest", ctx, adminID, chatID, requesterID).Return(member, nil)
+	mockMessageUC.On("SubscribeUsersOnChat", ctx, chatID, []dtoChats.AddChatMemberDTO{*member}).Return(nil)
+
+	_, err := handler.ApproveJoinRequest(ctx, &gen.JoinRequestDecisionReq{
+		UserId:      adminID.String(),
+		ChatId:      chatID.String(),
+		RequesterId: requesterID.String(),
+	})
+
+	assert.NoError(t, err)
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestDeclineJoinRequest_InvalidRequesterID(t *testing.T) {
+	handler := NewChatsGRPCHandler(new(MockChatsUsecase), new(MockMessageUsecase))
+
+	_, err := handler.DeclineJoinRequest(setupContext(), &gen.JoinRequestDecisionReq{
+		UserId:      uuid.New().String(),
+		ChatId:      uuid.New().String(),
+		RequesterId: "invalid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetJoinRequests_Success(t *testing.T) {
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewChatsGRPCHandler(mockChatsUC, new(MockMessageUsecase))
+
+	ctx := setupContext()
+	adminID := uuid.New()
+	chatID := uuid.New()
+	inviteID := uuid.New()
+
+	mockChatsUC.On("GetJoinRequests", ctx, adminID, chatID).Return([]dtoChats.JoinRequestDTO{
+		{UserID: uuid.New(), UserName: "Alice", InviteID: &inviteID, CreatedAt: time.Now()},
+	}, nil)
+
+	resp, err := handler.GetJoinRequests(ctx, &gen.GetChatReq{UserId: adminID.String(), ChatId: chatID.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetRequests(), 1)
+	assert.Equal(t, inviteID.String(), resp.GetRequests()[0].GetInviteId())
+}
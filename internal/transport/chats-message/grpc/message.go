@@ -2,20 +2,28 @@ package chats
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	mappers "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/mappers"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/utils"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	chatsInterface "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/interface/chats"
 	messageInterface "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/interface/message"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// lastSeenTimeout ограничивает сохранение времени визита после закрытия потока
+const lastSeenTimeout = 5 * time.Second
+
 type MessageGRPCHandler struct {
 	gen.UnimplementedMessageServiceServer
 
@@ -42,37 +50,89 @@ func (h *MessageGRPCHandler) StreamMessagesForUser(in *gen.StreamMessagesForUser
 
 	logger.Debugf("start stream messages from user %s ", userID)
 
-	chatsViewDTO, err := h.chatsUsecase.GetChats(stream.Context(), userID)
+	// Подписываемся на все чаты пользователя, включая архивные
+	chatsViewDTO, err := h.chatsUsecase.GetChats(stream.Context(), userID, dtoChats.ChatsFilterDTO{})
 	if err != nil {
 		logger.WithError(err).Error("Failed to get chats for user")
 		return status.Error(codes.InvalidArgument, "can't get chats for user")
 	}
 
+	mutes := newChatMutes(chatsViewDTO)
+
 	connectionID := uuid.New()
 	msgChan := h.messageUsecase.SubscribeConnectionToChats(stream.Context(), connectionID, userID, chatsViewDTO)
 
+	// Контекст потока к этому моменту уже отменён, поэтому время визита сохраняется в отвязанном от него
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(stream.Context()), lastSeenTimeout)
+		defer cancel()
+		h.messageUsecase.UpdateLastSeen(ctx, userID)
+	}()
+
 	for {
 		select {
 		case <-stream.Context().Done():
 			return stream.Context().Err()
+		case <-h.messageUsecase.ShuttingDown():
+			h.finishStreamOnShutdown(stream, msgChan, mutes, logger)
+			return nil
 		case msg, ok := <-msgChan:
 			if !ok {
 				return nil
 			}
 
-			protoMsg, err := mappers.DTOWebSocketMessageToProtoEventRes(msg)
-			if err != nil {
-				logger.WithError(err).Error("error converting dto to proto")
-				continue
-			}
+			h.sendStreamEvent(stream, msg, mutes, logger)
+		}
+	}
+}
+
+func (h *MessageGRPCHandler) sendStreamEvent(stream gen.MessageService_StreamMessagesForUserServer, msg dtoMessage.WebSocketMessageDTO, mutes chatMutes, logger *logrus.Entry) {
+	if msg.Type == dtoMessage.WebSocketMessageTypeChatSettings {
+		if settings, ok := msg.Value.(dtoChats.ChatSettingsDTO); ok {
+			mutes.update(msg.ChatID, settings)
+		}
+	}
 
-			err = stream.Send(protoMsg)
-			if err != nil {
-				logger.WithError(err).Error("error sending proto message")
-				continue
+	protoMsg, err := mappers.DTOWebSocketMessageToProtoEventRes(msg)
+	if err != nil {
+		logger.WithError(err).Error("error converting dto to proto")
+		return
+	}
+	protoMsg.Muted = mutes.isMuted(msg, time.Now())
+
+	if err := stream.Send(protoMsg); err != nil {
+		logger.WithError(err).Error("error sending proto message")
+		return
+	}
+	observeDelivery(msg, time.Now())
+}
+
+// finishStreamOnShutdown досылает уже накопленные события и предупреждает клиента об остановке сервера,
+// чтобы он переподключился к другому экземпляру, а не считал обрыв ошибкой
+func (h *MessageGRPCHandler) finishStreamOnShutdown(stream gen.MessageService_StreamMessagesForUserServer, msgChan <-chan dtoMessage.WebSocketMessageDTO, mutes chatMutes, logger *logrus.Entry) {
+flush:
+	for {
+		select {
+		case msg, ok := <-msgChan:
+			if !ok {
+				break flush
 			}
+			h.sendStreamEvent(stream, msg, mutes, logger)
+		default:
+			break flush
 		}
 	}
+
+	h.sendStreamEvent(stream, dtoMessage.WebSocketMessageDTO{
+		Type: dtoMessage.WebSocketMessageTypeSystemNotification,
+		Value: dtoMessage.SystemNotificationDTO{
+			Kind:      dtoMessage.SystemNotificationKindServerShutdown,
+			Text:      "Сервер перезапускается, переподключитесь",
+			CreatedAt: time.Now(),
+		},
+	}, mutes, logger)
+
+	logger.Info("stream finished due to server shutdown")
 }
 
 func (h *MessageGRPCHandler) HandleSendMessage(ctx context.Context, in *gen.MessageEventReq) (*emptypb.Empty, error) {
@@ -126,6 +186,9 @@ func (h *MessageGRPCHandler) HandleSendMessage(ctx context.Context, in *gen.Mess
 
 	if processingErr != nil {
 		logger.WithError(processingErr).Errorf("failed to process message type: %s", websocketMessageDTO.Type)
+		if errors.Is(processingErr, errs.ErrUserBlocked) {
+			return nil, status.Error(codes.PermissionDenied, processingErr.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, processingErr.Error())
 	}
 
@@ -222,3 +285,81 @@ func (h *MessageGRPCHandler) UploadAttachment(ctx context.Context, in *gen.Uploa
 		Duration:     durationPtr,
 	}, nil
 }
+
+func (h *MessageGRPCHandler) NotifyUser(ctx context.Context, in *gen.NotifyUserReq) (*emptypb.Empty, error) {
+	const op = "MessageGRPCHandler.NotifyUser"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	if in.GetNotification() == nil || in.GetNotification().GetText() == "" {
+		logger.Error("empty notification")
+		return nil, status.Error(codes.InvalidArgument, "notification text is required")
+	}
+
+	notification := mappers.ProtoSystemNotificationToDTO(in.GetNotification())
+
+	if err := h.messageUsecase.NotifyUser(ctx, userID, notification); err != nil {
+		logger.WithError(err).Error("failed to notify user")
+		return nil, status.Error(codes.Internal, "can't notify user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *MessageGRPCHandler) GetUnreadMentions(ctx context.Context, in *gen.ChatMentionsReq) (*gen.GetChatMessagesRes, error) {
+	const op = "MessageGRPCHandler.GetUnreadMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseChatMentionsReq(in)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	messagesDTO, err := h.messageUsecase.GetUnreadMentions(ctx, userID, chatID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get unread mentions")
+		return nil, status.Error(codes.Internal, "can't get unread mentions")
+	}
+
+	return &gen.GetChatMessagesRes{
+		Messages: mappers.DTOMessagesToProto(messagesDTO),
+	}, nil
+}
+
+func (h *MessageGRPCHandler) ReadMentions(ctx context.Context, in *gen.ChatMentionsReq) (*emptypb.Empty, error) {
+	const op = "MessageGRPCHandler.ReadMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, chatID, err := parseChatMentionsReq(in)
+	if err != nil {
+		logger.WithError(err).Error("invalid request")
+		return nil, err
+	}
+
+	if err := h.messageUsecase.ReadMentions(ctx, userID, chatID); err != nil {
+		logger.WithError(err).Error("failed to read mentions")
+		return nil, status.Error(codes.Internal, "can't read mentions")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func parseChatMentionsReq(in *gen.ChatMentionsReq) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	chatID, err := uuid.Parse(in.GetChatId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "wrong chat id format")
+	}
+
+	return userID, chatID, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -480,7 +481,7 @@ func TestStreamMessagesForUser_GetChatsError(t *testing.T) {
 	ctx := setupContext()
 	stream := &MockStreamServer{ctx: ctx}
 
-	mockChatsUC.On("GetChats", ctx, userID).Return([]dtoChats.ChatViewInformationDTO{}, errors.New("database error"))
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{}).Return([]dtoChats.ChatViewInformationDTO{}, errors.New("database error"))
 
 	req := &gen.StreamMessagesForUserReq{
 		UserId: userID.String(),
@@ -492,3 +493,144 @@ func TestStreamMessagesForUser_GetChatsError(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	mockChatsUC.AssertExpectations(t)
 }
+
+func TestStreamMessagesForUser_MarksMutedChats(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewMessageGRPCHandler(mockMessageUC, mockChatsUC)
+
+	userID := uuid.New()
+	mutedChatID := uuid.New()
+	otherChatID := uuid.New()
+	ctx := setupContext()
+	stream := &MockStreamServer{ctx: ctx}
+
+	chats := []dtoChats.ChatViewInformationDTO{
+		{ID: mutedChatID, Settings: dtoChats.ChatSettingsDTO{Muted: true}},
+		{ID: otherChatID},
+	}
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{}).Return(chats, nil)
+
+	events := make(chan dtoMessage.WebSocketMessageDTO, 5)
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeDeleteChatMessage, ChatID: mutedChatID, Value: dtoMessage.DeleteMessageDTO{ID: uuid.New()}}
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeDeleteChatMessage, ChatID: otherChatID, Value: dtoMessage.DeleteMessageDTO{ID: uuid.New()}}
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeMention, ChatID: mutedChatID, Value: dtoMessage.MessageDTO{ChatID: mutedChatID}}
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeChatSettings, ChatID: mutedChatID, Value: dtoChats.ChatSettingsDTO{Muted: false}}
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeDeleteChatMessage, ChatID: mutedChatID, Value: dtoMessage.DeleteMessageDTO{ID: uuid.New()}}
+	close(events)
+
+	mockMessageUC.On("SubscribeConnectionToChats", ctx, mock.Anything, userID, chats).
+		Return((<-chan dtoMessage.WebSocketMessageDTO)(events))
+
+	mockMessageUC.On("UpdateLastSeen", mock.Anything, userID).Return()
+
+	var sent []*gen.MessageEventRes
+	stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(0).(*gen.MessageEventRes))
+	}).Return(nil)
+
+	err := handler.StreamMessagesForUser(&gen.StreamMessagesForUserReq{UserId: userID.String()}, stream)
+
+	assert.NoError(t, err)
+	assert.Len(t, sent, 5)
+	assert.True(t, sent[0].GetMuted())
+	assert.False(t, sent[1].GetMuted())
+	assert.False(t, sent[2].GetMuted(), "mentions are delivered even in muted chats")
+	assert.False(t, sent[3].GetMuted())
+	assert.False(t, sent[4].GetMuted(), "chat was unmuted by settings event")
+	mockMessageUC.AssertCalled(t, "UpdateLastSeen", mock.Anything, userID)
+}
+
+func TestGetUnreadMentions_Success(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewMessageGRPCHandler(mockMessageUC, mockChatsUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	ctx := setupContext()
+
+	mentions := []dtoMessage.MessageDTO{{ID: uuid.New(), ChatID: chatID, Text: "@user привет"}}
+	mockMessageUC.On("GetUnreadMentions", ctx, userID, chatID).Return(mentions, nil)
+
+	resp, err := handler.GetUnreadMentions(ctx, &gen.ChatMentionsReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "@user привет", resp.Messages[0].Text)
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestGetUnreadMentions_InvalidChatID(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewMessageGRPCHandler(mockMessageUC, mockChatsUC)
+
+	resp, err := handler.GetUnreadMentions(setupContext(), &gen.ChatMentionsReq{
+		UserId: uuid.New().String(),
+		ChatId: "invalid-uuid",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReadMentions_UsecaseError(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewMessageGRPCHandler(mockMessageUC, mockChatsUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	ctx := setupContext()
+
+	mockMessageUC.On("ReadMentions", ctx, userID, chatID).Return(errors.New("db error"))
+
+	resp, err := handler.ReadMentions(ctx, &gen.ChatMentionsReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestStreamMessagesForUser_Shutdown(t *testing.T) {
+	mockMessageUC := &MockMessageUsecase{shutdown: make(chan struct{})}
+	mockChatsUC := new(MockChatsUsecase)
+	handler := NewMessageGRPCHandler(mockMessageUC, mockChatsUC)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	ctx := setupContext()
+	stream := &MockStreamServer{ctx: ctx}
+
+	chats := []dtoChats.ChatViewInformationDTO{{ID: chatID}}
+	mockChatsUC.On("GetChats", ctx, userID, dtoChats.ChatsFilterDTO{}).Return(chats, nil)
+
+	// Событие уже в буфере на момент остановки и должно быть доставлено до уведомления
+	events := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	events <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeDeleteChatMessage, ChatID: chatID, Value: dtoMessage.DeleteMessageDTO{ID: uuid.New()}}
+	mockMessageUC.On("SubscribeConnectionToChats", ctx, mock.Anything, userID, chats).
+		Return((<-chan dtoMessage.WebSocketMessageDTO)(events))
+
+	mockMessageUC.On("UpdateLastSeen", mock.Anything, userID).Return()
+
+	var sent []*gen.MessageEventRes
+	stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(0).(*gen.MessageEventRes))
+	}).Return(nil)
+
+	close(mockMessageUC.shutdown)
+	err := handler.StreamMessagesForUser(&gen.StreamMessagesForUserReq{UserId: userID.String()}, stream)
+
+	assert.NoError(t, err)
+	require.NotEmpty(t, sent)
+	last := sent[len(sent)-1].GetSystemNotification()
+	require.NotNil(t, last)
+	assert.Equal(t, dtoMessage.SystemNotificationKindServerShutdown, last.GetKind())
+}
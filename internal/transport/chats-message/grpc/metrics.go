@@ -0,0 +1,27 @@
+package chats
+
+import (
+	"time"
+
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var fanoutLatency = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "chats_fanout_latency_seconds",
+		Help:    "Time from enqueueing a chat event for distribution to sending it into the user's stream",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	},
+	[]string{"type"},
+)
+
+// observeDelivery учитывает задержку доставки события, прошедшего через очередь рассылки.
+// Уведомления, отправленные напрямую в соединение, времени постановки в очередь не имеют
+func observeDelivery(msg dtoMessage.WebSocketMessageDTO, deliveredAt time.Time) {
+	if msg.EnqueuedAt.IsZero() {
+		return
+	}
+	fanoutLatency.WithLabelValues(msg.Type).Observe(deliveredAt.Sub(msg.EnqueuedAt).Seconds())
+}
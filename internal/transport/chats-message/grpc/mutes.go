@@ -0,0 +1,47 @@
+package chats
+
+import (
+	"time"
+
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/google/uuid"
+)
+
+// chatMutes хранит заглушённые чаты одного потока событий.
+// Используется только из горутины потока, поэтому без блокировок
+type chatMutes map[uuid.UUID]*time.Time
+
+func newChatMutes(chats []dtoChats.ChatViewInformationDTO) chatMutes {
+	mutes := make(chatMutes)
+	for _, chat := range chats {
+		mutes.update(chat.ID, chat.Settings)
+	}
+	return mutes
+}
+
+func (m chatMutes) update(chatID uuid.UUID, settings dtoChats.ChatSettingsDTO) {
+	if !settings.Muted {
+		delete(m, chatID)
+		return
+	}
+	m[chatID] = settings.MutedUntil
+}
+
+// isMuted сообщает, нужно ли пометить событие как заглушённое.
+// Упоминания, изменения настроек и статусы собеседников доставляются всегда, поэтому не помечаются
+func (m chatMutes) isMuted(msg dtoMessage.WebSocketMessageDTO, now time.Time) bool {
+	switch msg.Type {
+	case dtoMessage.WebSocketMessageTypeMention,
+		dtoMessage.WebSocketMessageTypeChatSettings,
+		dtoMessage.WebSocketMessageTypeSystemNotification,
+		dtoMessage.WebSocketMessageTypeUserStatus:
+		return false
+	}
+
+	mutedUntil, ok := m[msg.ChatID]
+	if !ok {
+		return false
+	}
+	return mutedUntil == nil || mutedUntil.After(now)
+}
@@ -0,0 +1,82 @@
+package chats
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	messageInterface "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/interface/message"
+	interfaceUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/interface/user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserEventsGRPCHandler принимает события user_service об изменении профилей
+type UserEventsGRPCHandler struct {
+	gen.UnimplementedUserEventsServiceServer
+
+	userCache      interfaceUser.UserCacheInvalidator
+	messageUsecase messageInterface.MessageUsecase
+}
+
+func NewUserEventsGRPCHandler(userCache interfaceUser.UserCacheInvalidator, messageUsecase messageInterface.MessageUsecase) *UserEventsGRPCHandler {
+	return &UserEventsGRPCHandler{
+		userCache:      userCache,
+		messageUsecase: messageUsecase,
+	}
+}
+
+func (h *UserEventsGRPCHandler) UserProfileChanged(ctx context.Context, in *gen.UserProfileChangedReq) (*emptypb.Empty, error) {
+	const op = "UserEventsGRPCHandler.UserProfileChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	if err := h.userCache.Invalidate(ctx, userID); err != nil {
+		logger.WithError(err).Error("failed to invalidate user cache")
+		return nil, status.Error(codes.Unavailable, "can't invalidate user cache")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UserStatusChanged рассылает новый статус пользователя собеседникам по диалогам
+func (h *UserEventsGRPCHandler) UserStatusChanged(ctx context.Context, in *gen.UserStatus) (*emptypb.Empty, error) {
+	const op = "UserEventsGRPCHandler.UserStatusChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		logger.WithError(err).Errorf("error parsing userId: %s", in.GetUserId())
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	userStatus := dtoMessage.UserStatusDTO{
+		UserID: userID,
+		Emoji:  in.GetEmoji(),
+		Text:   in.GetText(),
+	}
+	if in.ExpiresAt != nil {
+		expiresAt, err := time.Parse(time.RFC3339, in.GetExpiresAt())
+		if err != nil {
+			logger.WithError(err).Errorf("error parsing expires_at: %s", in.GetExpiresAt())
+			return nil, status.Error(codes.InvalidArgument, "wrong expires_at format")
+		}
+		userStatus.ExpiresAt = &expiresAt
+	}
+
+	if err := h.messageUsecase.NotifyUserStatus(ctx, userStatus); err != nil {
+		logger.WithError(err).Error("failed to notify dialog partners about user status")
+		return nil, status.Error(codes.Internal, "can't notify about user status")
+	}
+
+	return &emptypb.Empty{}, nil
+}
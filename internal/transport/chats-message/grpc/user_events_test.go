@@ -0,0 +1,109 @@
+package chats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockUserCacheInvalidator struct {
+	mock.Mock
+}
+
+func (m *MockUserCacheInvalidator) Invalidate(ctx context.Context, ids ...uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func TestUserProfileChanged_Success(t *testing.T) {
+	mockCache := new(MockUserCacheInvalidator)
+	handler := NewUserEventsGRPCHandler(mockCache, new(MockMessageUsecase))
+	ctx := setupContext()
+	userID := uuid.New()
+
+	mockCache.On("Invalidate", ctx, []uuid.UUID{userID}).Return(nil)
+
+	res, err := handler.UserProfileChanged(ctx, &gen.UserProfileChangedReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	mockCache.AssertExpectations(t)
+}
+
+func TestUserProfileChanged_InvalidUserID(t *testing.T) {
+	mockCache := new(MockUserCacheInvalidator)
+	handler := NewUserEventsGRPCHandler(mockCache, new(MockMessageUsecase))
+
+	_, err := handler.UserProfileChanged(setupContext(), &gen.UserProfileChangedReq{UserId: "invalid"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockCache.AssertNotCalled(t, "Invalidate")
+}
+
+func TestUserProfileChanged_InvalidateError(t *testing.T) {
+	mockCache := new(MockUserCacheInvalidator)
+	handler := NewUserEventsGRPCHandler(mockCache, new(MockMessageUsecase))
+	ctx := setupContext()
+
+	mockCache.On("Invalidate", ctx, mock.Anything).Return(errors.New("redis down"))
+
+	_, err := handler.UserProfileChanged(ctx, &gen.UserProfileChangedReq{UserId: uuid.NewString()})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestUserStatusChanged_Success(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewUserEventsGRPCHandler(new(MockUserCacheInvalidator), mockMessageUC)
+	ctx := setupContext()
+	userID := uuid.New()
+	expiresAt := "2030-01-02T03:04:05Z"
+	expectedExpiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mockMessageUC.On("NotifyUserStatus", ctx, mock.MatchedBy(func(userStatus dtoMessage.UserStatusDTO) bool {
+		return userStatus.UserID == userID && userStatus.Emoji == "🌴" && userStatus.Text == "В отпуске" &&
+			userStatus.ExpiresAt != nil && userStatus.ExpiresAt.Equal(expectedExpiresAt)
+	})).Return(nil)
+
+	res, err := handler.UserStatusChanged(ctx, &gen.UserStatus{
+		UserId:    userID.String(),
+		Emoji:     "🌴",
+		Text:      "В отпуске",
+		ExpiresAt: &expiresAt,
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	mockMessageUC.AssertExpectations(t)
+}
+
+func TestUserStatusChanged_InvalidUserID(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewUserEventsGRPCHandler(new(MockUserCacheInvalidator), mockMessageUC)
+
+	_, err := handler.UserStatusChanged(setupContext(), &gen.UserStatus{UserId: "invalid"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockMessageUC.AssertNotCalled(t, "NotifyUserStatus")
+}
+
+func TestUserStatusChanged_NotifyError(t *testing.T) {
+	mockMessageUC := new(MockMessageUsecase)
+	handler := NewUserEventsGRPCHandler(new(MockUserCacheInvalidator), mockMessageUC)
+	ctx := setupContext()
+
+	mockMessageUC.On("NotifyUserStatus", ctx, mock.Anything).Return(errors.New("db down"))
+
+	_, err := handler.UserStatusChanged(ctx, &gen.UserStatus{UserId: uuid.NewString()})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -0,0 +1,75 @@
+package chats
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	userModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoBot "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+)
+
+// SendBotMessage отправляет сообщение от имени бота
+// @Summary      Отправить сообщение от имени бота
+// @Description  Отправляет текстовое сообщение в чат. Доступно только при авторизации токеном бота (заголовок Authorization: Bearer), бот должен быть участником чата.
+// @Tags         bots
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        request  body  dto.SendMessageRequest  true  "Сообщение"
+// @Success      200  "Сообщение отправлено"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный запрос"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Требуется авторизация токеном бота"
+// @Router       /bot/messages [post]
+func (h *ChatsGRPCProxyHandler) SendBotMessage(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.SendBotMessage"
+
+	if _, ok := r.Context().Value(domains.TokenScopesKey{}).([]string); !ok {
+		utils.SendError(r.Context(), op, w, http.StatusForbidden, "token authentication required")
+		return
+	}
+
+	// Токен обычного пользователя не дает права писать от имени бота
+	if accountType, _ := r.Context().Value(domains.AccountTypeKey{}).(string); accountType != userModels.BotAccount {
+		utils.SendError(r.Context(), op, w, http.StatusForbidden, "bot token required")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req dtoBot.SendMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.ChatID == uuid.Nil || req.Text == "" {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "chat_id and text are required")
+		return
+	}
+
+	_, err = h.messageClient.HandleSendMessage(r.Context(), &gen.MessageEventReq{
+		UserId: userID.String(),
+		Event: &gen.MessageEventReq_NewChatMessage{
+			NewChatMessage: &gen.CreateMessage{
+				ChatId: req.ChatID.String(),
+				Text:   req.Text,
+			},
+		},
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
@@ -0,0 +1,122 @@
+package chats
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func setupBotContext(botID uuid.UUID) context.Context {
+	ctx := setupMessageContext(botID)
+	ctx = context.WithValue(ctx, domains.TokenScopesKey{}, []string{"read", "write"})
+	return context.WithValue(ctx, domains.AccountTypeKey{}, "bot")
+}
+
+func TestSendBotMessage_Success(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	botID := uuid.New()
+	chatID := uuid.New()
+
+	mockMessageClient.On("HandleSendMessage", mock.Anything, mock.MatchedBy(func(req *gen.MessageEventReq) bool {
+		msg := req.GetNewChatMessage()
+		return req.UserId == botID.String() && msg != nil && msg.ChatId == chatID.String() && msg.Text == "привет"
+	}), mock.Anything).Return(&emptypb.Empty{}, nil)
+
+	body := `{"chat_id":"` + chatID.String() + `","text":"привет"}`
+	req := httptest.NewRequest(http.MethodPost, "/bot/messages", strings.NewReader(body))
+	req = req.WithContext(setupBotContext(botID))
+
+	w := httptest.NewRecorder()
+	handler.SendBotMessage(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockMessageClient.AssertExpectations(t)
+}
+
+func TestSendBotMessage_SessionAuth(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	body := `{"chat_id":"` + uuid.New().String() + `","text":"привет"}`
+	req := httptest.NewRequest(http.MethodPost, "/bot/messages", strings.NewReader(body))
+	req = req.WithContext(setupMessageContext(uuid.New()))
+
+	w := httptest.NewRecorder()
+	handler.SendBotMessage(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockMessageClient.AssertNotCalled(t, "HandleSendMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendBotMessage_UserToken(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	ctx := context.WithValue(setupMessageContext(uuid.New()), domains.TokenScopesKey{}, []string{"read", "write"})
+	ctx = context.WithValue(ctx, domains.AccountTypeKey{}, "user")
+
+	body := `{"chat_id":"` + uuid.New().String() + `","text":"привет"}`
+	req := httptest.NewRequest(http.MethodPost, "/bot/messages", strings.NewReader(body))
+	req = req.WithContext(ctx)
+
+	w := httptest.NewRecorder()
+	handler.SendBotMessage(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockMessageClient.AssertNotCalled(t, "HandleSendMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSendBotMessage_EmptyText(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	body := `{"chat_id":"` + uuid.New().String() + `","text":""}`
+	req := httptest.NewRequest(http.MethodPost, "/bot/messages", strings.NewReader(body))
+	req = req.WithContext(setupBotContext(uuid.New()))
+
+	w := httptest.NewRecorder()
+	handler.SendBotMessage(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSendBotMessage_GRPCError(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	mockMessageClient.On("HandleSendMessage", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.InvalidArgument, "no rights"))
+
+	body := `{"chat_id":"` + uuid.New().String() + `","text":"привет"}`
+	req := httptest.NewRequest(http.MethodPost, "/bot/messages", strings.NewReader(body))
+	req = req.WithContext(setupBotContext(uuid.New()))
+
+	w := httptest.NewRecorder()
+	handler.SendBotMessage(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockMessageClient.AssertExpectations(t)
+}
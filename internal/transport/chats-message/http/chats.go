@@ -18,11 +18,14 @@ import (
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ChatsGRPCProxyHandler struct {
 	chatsClient   gen.ChatServiceClient
 	messageClient gen.MessageServiceClient
+
+	connections wsConnections
 }
 
 func NewChatsGRPCProxyHandler(chatsClient gen.ChatServiceClient, messageClient gen.MessageServiceClient) *ChatsGRPCProxyHandler {
@@ -32,13 +35,15 @@ func NewChatsGRPCProxyHandler(chatsClient gen.ChatServiceClient, messageClient g
 	}
 }
 
-// GetChats получает список всех чатов пользователя
+// GetChats получает список чатов пользователя
 // @Summary      Получить список чатов
-// @Description  Возвращает список всех чатов текущего пользователя с информацией о последнем сообщении
+// @Description  Возвращает чаты текущего пользователя с информацией о последнем сообщении и персональными настройками. Закреплённые чаты идут первыми, остальные - по времени последнего сообщения. По умолчанию архивные чаты не возвращаются.
 // @Tags         chats
 // @Accept       json
 // @Produce      json
 // @Security     ApiKeyAuth
+// @Param        archived  query     bool    false  "Вернуть архивные (true) или обычные (false) чаты"  default(false)
+// @Param        folder    query     string  false  "Вернуть только чаты из указанной папки"
 // @Success      200  {array}   dto.ChatViewInformationDTO  "Список чатов"
 // @Failure      400  {object}  dto.ErrorDTO                "Некорректный запрос"
 // @Failure      401  {object}  dto.ErrorDTO                "Неавторизованный доступ"
@@ -52,7 +57,22 @@ func (h *ChatsGRPCProxyHandler) GetChats(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	request := &gen.GetChatsReq{UserId: userUUID.String()}
+	archived := false
+	if archivedStr := r.URL.Query().Get("archived"); archivedStr != "" {
+		archived, err = strconv.ParseBool(archivedStr)
+		if err != nil {
+			utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format archived")
+			return
+		}
+	}
+
+	request := &gen.GetChatsReq{
+		UserId:   userUUID.String(),
+		Archived: &archived,
+	}
+	if folder := r.URL.Query().Get("folder"); folder != "" {
+		request.Folder = &folder
+	}
 
 	chats, err := h.chatsClient.GetChats(r.Context(), request)
 	if err != nil {
@@ -471,9 +491,9 @@ func (h *ChatsGRPCProxyHandler) GetChatAvatars(w http.ResponseWriter, r *http.Re
 // @Param X-CSRF-Token header string true "CSRF Token"
 // @Security     ApiKeyAuth
 // @Param        chatId path string true "ID чата" format(uuid)
-// @Param        avatar formData file true "Файл аватара"
+// @Param        avatar formData file true "Файл аватара (JPEG или PNG, сторона не меньше 100px)"
 // @Success      200  {object}  map[string]string  "URL загруженного аватара"
-// @Failure      400  {object}  dto.ErrorDTO      "Неверный формат запроса"
+// @Failure      400  {object}  dto.ErrorDTO      "Неверный формат запроса или изображения"
 // @Failure      401  {object}  dto.ErrorDTO      "Неавторизованный доступ"
 // @Failure      403  {object}  dto.ErrorDTO      "Нет прав для изменения чата"
 // @Failure      404  {object}  dto.ErrorDTO      "Чат не найден"
@@ -552,6 +572,138 @@ func (h *ChatsGRPCProxyHandler) UploadChatAvatar(w http.ResponseWriter, r *http.
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, map[string]string{"avatar_url": res.AvatarUrl})
 }
 
+// GetChatAvatarHistory возвращает историю аватарок чата
+// @Summary      История аватарок чата
+// @Description  Возвращает аватарки чата, начиная с текущей, со ссылками на все размеры (только участникам)
+// @Tags         chats
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        chat_id path string true "ID чата" format(uuid)
+// @Success      200  {object}  dto.GetAvatarHistoryResponse  "История аватарок"
+// @Failure      400  {object}  dto.ErrorDTO                  "Некорректный ID"
+// @Failure      401  {object}  dto.ErrorDTO                  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO                  "Пользователь не участник чата"
+// @Router       /chats/{chat_id}/avatars [get]
+func (h *ChatsGRPCProxyHandler) GetChatAvatarHistory(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.GetChatAvatarHistory"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.chatsClient.GetChatAvatarHistory(r.Context(), &gen.GetChatAvatarHistoryReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dtoUtils.GetAvatarHistoryResponse{
+		Avatars: mappers.ProtoChatAvatarsToDTO(res.GetAvatars()),
+	})
+}
+
+// DeleteChatAvatar удаляет аватарку из истории чата
+// @Summary      Удалить аватарку чата
+// @Description  Удаляет аватарку из истории чата вместе с файлами всех размеров (только админ)
+// @Tags         chats
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id   path string true "ID чата" format(uuid)
+// @Param        avatar_id path string true "ID аватарки" format(uuid)
+// @Success      204  "Аватарка удалена"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для изменения чата"
+// @Failure      404  {object}  dto.ErrorDTO  "Аватарка не найдена"
+// @Router       /chats/{chat_id}/avatars/{avatar_id} [delete]
+func (h *ChatsGRPCProxyHandler) DeleteChatAvatar(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.DeleteChatAvatar"
+
+	req, ok := chatAvatarReq(w, r, op)
+	if !ok {
+		return
+	}
+
+	if _, err := h.chatsClient.DeleteChatAvatar(r.Context(), req); err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetCurrentChatAvatar делает аватарку из истории чата текущей
+// @Summary      Вернуть прежнюю аватарку чата
+// @Description  Делает аватарку из истории чата текущей (только админ)
+// @Tags         chats
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id   path string true "ID чата" format(uuid)
+// @Param        avatar_id path string true "ID аватарки" format(uuid)
+// @Success      204  "Аватарка стала текущей"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для изменения чата"
+// @Failure      404  {object}  dto.ErrorDTO  "Аватарка не найдена"
+// @Router       /chats/{chat_id}/avatars/{avatar_id}/current [put]
+func (h *ChatsGRPCProxyHandler) SetCurrentChatAvatar(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.SetCurrentChatAvatar"
+
+	req, ok := chatAvatarReq(w, r, op)
+	if !ok {
+		return
+	}
+
+	if _, err := h.chatsClient.SetCurrentChatAvatar(r.Context(), req); err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// chatAvatarReq собирает запрос к аватарке чата из пути, отвечая ошибкой при неудаче
+func chatAvatarReq(w http.ResponseWriter, r *http.Request, op string) (*gen.ChatAvatarReq, bool) {
+	vars := mux.Vars(r)
+
+	chatID, err := uuid.Parse(vars["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return nil, false
+	}
+
+	avatarID, err := uuid.Parse(vars["avatar_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format avatar_id")
+		return nil, false
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return nil, false
+	}
+
+	return &gen.ChatAvatarReq{
+		UserId:   userID.String(),
+		ChatId:   chatID.String(),
+		AvatarId: avatarID.String(),
+	}, true
+}
+
 // SearchChats ищет чаты по имени
 // @Summary      Поиск чатов по имени
 // @Description  Позволяет искать чаты по части или полному имени
@@ -661,3 +813,114 @@ func (h *ChatsGRPCProxyHandler) GetChatMessages(w http.ResponseWriter, r *http.R
 	dtoMessages := mappers.ProtoGetChatMessagesResToDTO(response)
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dtoMessages)
 }
+
+// UpdateChatSettings изменяет персональные настройки чата
+// @Summary      Изменить настройки чата
+// @Description  Заглушение (навсегда или до момента muted_until), архивирование, закрепление и папка чата для текущего пользователя. Незаданные поля не меняются.
+// @Tags         chats
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id   path      string                     true  "ID чата"  format(uuid)
+// @Param        settings  body      dto.ChatSettingsUpdateDTO  true  "Изменяемые настройки"
+// @Success      200       {object}  dto.ChatSettingsDTO        "Новые настройки чата"
+// @Failure      400       {object}  dto.ErrorDTO               "Некорректный запрос"
+// @Failure      401       {object}  dto.ErrorDTO               "Неавторизованный доступ"
+// @Failure      404       {object}  dto.ErrorDTO               "Чат не найден"
+// @Router       /chats/{chat_id}/settings [patch]
+func (h *ChatsGRPCProxyHandler) UpdateChatSettings(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.UpdateChatSettings"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var updateDTO dtoChats.ChatSettingsUpdateDTO
+	if err := json.NewDecoder(r.Body).Decode(&updateDTO); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	request := &gen.UpdateChatSettingsReq{
+		UserId:   userID.String(),
+		ChatId:   chatID.String(),
+		Muted:    updateDTO.Muted,
+		Archived: updateDTO.Archived,
+		Folder:   updateDTO.Folder,
+	}
+	if updateDTO.MutedUntil != nil {
+		request.MutedUntil = timestamppb.New(*updateDTO.MutedUntil)
+	}
+	if updateDTO.PinOrder != nil {
+		pinOrder := int32(*updateDTO.PinOrder)
+		request.PinOrder = &pinOrder
+	}
+
+	settings, err := h.chatsClient.UpdateChatSettings(r.Context(), request)
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, mappers.ProtoChatSettingsToDTO(settings))
+}
+
+// SetChatUsername меняет публичный handle группы или канала
+// @Summary      Изменить публичный handle чата
+// @Description  Группа или канал с handle находятся по ссылке /resolve/{handle}. Пустой username делает чат приватным. После переименования прежний handle ещё 14 дней ведёт на чат (только админ)
+// @Tags         chats
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id  path  string               true  "ID чата"  format(uuid)
+// @Param        request  body  dto.ChatUsernameDTO  true  "Новый handle"
+// @Success      204  "Handle изменён"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный или зарезервированный handle, либо чат - диалог"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для изменения чата"
+// @Failure      404  {object}  dto.ErrorDTO  "Чат не найден"
+// @Failure      409  {object}  dto.ErrorDTO  "Handle уже занят"
+// @Router       /chats/{chat_id}/username [put]
+func (h *ChatsGRPCProxyHandler) SetChatUsername(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.SetChatUsername"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req dtoChats.ChatUsernameDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = h.chatsClient.SetChatUsername(r.Context(), &gen.SetChatUsernameReq{
+		UserId:   userID.String(),
+		ChatId:   chatID.String(),
+		Username: req.Username,
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
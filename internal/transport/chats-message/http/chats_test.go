@@ -16,6 +16,7 @@ import (
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/http/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +33,7 @@ func TestGRPCGetChats_Success(t *testing.T) {
 
 	userID := uuid.New()
 	chatID := uuid.New()
+	archived := false
 
 	expectedResponse := &gen.GetChatsRes{
 		Chats: []*gen.Chat{
@@ -44,7 +46,7 @@ func TestGRPCGetChats_Success(t *testing.T) {
 	}
 
 	mockClient.EXPECT().
-		GetChats(gomock.Any(), &gen.GetChatsReq{UserId: userID.String()}).
+		GetChats(gomock.Any(), &gen.GetChatsReq{UserId: userID.String(), Archived: &archived}).
 		Return(expectedResponse, nil)
 
 	request := httptest.NewRequest(http.MethodGet, "/chats", nil)
@@ -590,3 +592,292 @@ func TestGRPCSearchChats_Unauthorized(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 }
+
+func TestGRPCGetChats_ArchivedFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	userID := uuid.New()
+	archived := true
+	folder := "Work"
+
+	mockClient.EXPECT().
+		GetChats(gomock.Any(), &gen.GetChatsReq{UserId: userID.String(), Archived: &archived, Folder: &folder}).
+		Return(&gen.GetChatsRes{}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/chats?archived=true&folder=Work", nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.GetChats(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGRPCGetChats_BadArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	request := httptest.NewRequest(http.MethodGet, "/chats?archived=maybe", nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.GetChats(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGRPCUpdateChatSettings_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	archived := true
+	pinOrder := int32(1)
+
+	mockClient.EXPECT().
+		UpdateChatSettings(gomock.Any(), &gen.UpdateChatSettingsReq{
+			UserId:   userID.String(),
+			ChatId:   chatID.String(),
+			Archived: &archived,
+			PinOrder: &pinOrder,
+		}).
+		Return(&gen.ChatSettings{Archived: true, PinOrder: 1}, nil)
+
+	body := []byte(`{"archived": true, "pin_order": 1}`)
+	request := httptest.NewRequest(http.MethodPatch, "/chats/"+chatID.String()+"/settings", bytes.NewReader(body))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateChatSettings(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var settings dtoChats.ChatSettingsDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&settings))
+	assert.True(t, settings.Archived)
+	assert.Equal(t, 1, settings.PinOrder)
+}
+
+func TestGRPCUpdateChatSettings_InvalidChatID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	request := httptest.NewRequest(http.MethodPatch, "/chats/invalid/settings", bytes.NewReader([]byte(`{}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": "invalid"})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateChatSettings(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGRPCUpdateChatSettings_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	chatID := uuid.New()
+
+	mockClient.EXPECT().
+		UpdateChatSettings(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "chat not found"))
+
+	request := httptest.NewRequest(http.MethodPatch, "/chats/"+chatID.String()+"/settings", bytes.NewReader([]byte(`{"muted": true}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateChatSettings(recorder, request)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGRPCGetChatAvatarHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	userID, chatID, avatarID := uuid.New(), uuid.New(), uuid.New()
+
+	mockClient.EXPECT().
+		GetChatAvatarHistory(gomock.Any(), &gen.GetChatAvatarHistoryReq{UserId: userID.String(), ChatId: chatID.String()}).
+		Return(&gen.GetChatAvatarHistoryRes{Avatars: []*gen.ChatAvatar{{
+			Id:        avatarID.String(),
+			Url:       "http://avatar/640",
+			Sizes:     map[int32]string{640: "http://avatar/640"},
+			IsCurrent: true,
+		}}}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/chats/"+chatID.String()+"/avatars", nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.GetChatAvatarHistory(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var res dtoUtils.GetAvatarHistoryResponse
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	assert.Len(t, res.Avatars, 1)
+	assert.Equal(t, avatarID, res.Avatars[0].ID)
+	assert.Equal(t, "http://avatar/640", res.Avatars[0].Sizes[640])
+}
+
+func TestGRPCDeleteChatAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	userID, chatID, avatarID := uuid.New(), uuid.New(), uuid.New()
+
+	mockClient.EXPECT().
+		DeleteChatAvatar(gomock.Any(), &gen.ChatAvatarReq{
+			UserId:   userID.String(),
+			ChatId:   chatID.String(),
+			AvatarId: avatarID.String(),
+		}).
+		Return(&emptypb.Empty{}, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/chats/"+chatID.String()+"/avatars/"+avatarID.String(), nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String(), "avatar_id": avatarID.String()})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteChatAvatar(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestGRPCDeleteChatAvatar_InvalidAvatarID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	chatID := uuid.New()
+
+	request := httptest.NewRequest(http.MethodDelete, "/chats/"+chatID.String()+"/avatars/bad", nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String(), "avatar_id": "bad"})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteChatAvatar(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGRPCSetCurrentChatAvatar_NoRights(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	mockMessageClient := mocks.NewMockMessageServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mockMessageClient)
+
+	chatID, avatarID := uuid.New(), uuid.New()
+
+	mockClient.EXPECT().
+		SetCurrentChatAvatar(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.PermissionDenied, "no rights"))
+
+	request := httptest.NewRequest(http.MethodPut, "/chats/"+chatID.String()+"/avatars/"+avatarID.String()+"/current", nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String(), "avatar_id": avatarID.String()})
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.SetCurrentChatAvatar(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestGRPCSetChatUsername_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockClient.EXPECT().
+		SetChatUsername(gomock.Any(), &gen.SetChatUsernameReq{
+			UserId:   userID.String(),
+			ChatId:   chatID.String(),
+			Username: "daily_news",
+		}).
+		Return(&emptypb.Empty{}, nil)
+
+	request := httptest.NewRequest(http.MethodPut, "/chats/"+chatID.String()+"/username", bytes.NewReader([]byte(`{"username": "daily_news"}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.SetChatUsername(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestGRPCSetChatUsername_Taken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	chatID := uuid.New()
+
+	mockClient.EXPECT().
+		SetChatUsername(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.AlreadyExists, "username already exist"))
+
+	request := httptest.NewRequest(http.MethodPut, "/chats/"+chatID.String()+"/username", bytes.NewReader([]byte(`{"username": "taken"}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.SetChatUsername(recorder, request)
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+}
@@ -0,0 +1,335 @@
+package chats
+
+import (
+	"encoding/json"
+	"net/http"
+
+	mappers "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/mappers"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateInvite создаёт пригласительную ссылку в группу или канал
+// @Summary      Создать пригласительную ссылку
+// @Description  Ссылка может иметь срок действия, лимит вступлений и требовать одобрения админа. Лимит вступлений для ссылок с одобрением не задаётся (только админ)
+// @Tags         chats
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id  path      string               true  "ID чата"  format(uuid)
+// @Param        request  body      dto.InviteCreateDTO  true  "Параметры ссылки"
+// @Success      201      {object}  dto.InviteDTO        "Созданная ссылка"
+// @Failure      400      {object}  dto.ErrorDTO         "Некорректные параметры ссылки либо чат - диалог"
+// @Failure      401      {object}  dto.ErrorDTO         "Неавторизованный доступ"
+// @Failure      403      {object}  dto.ErrorDTO         "Нет прав для управления ссылками"
+// @Router       /chats/{chat_id}/invites [post]
+func (h *ChatsGRPCProxyHandler) CreateInvite(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.CreateInvite"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req dtoChats.InviteCreateDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	request := &gen.CreateInviteReq{
+		UserId:           userID.String(),
+		ChatId:           chatID.String(),
+		Name:             req.Name,
+		RequiresApproval: req.RequiresApproval,
+	}
+	if req.ExpiresAt != nil {
+		request.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+	if req.UsageLimit != nil {
+		usageLimit := int32(*req.UsageLimit)
+		request.UsageLimit = &usageLimit
+	}
+
+	invite, err := h.chatsClient.CreateInvite(r.Context(), request)
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusCreated, mappers.ProtoInviteToDTO(invite))
+}
+
+// GetChatInvites возвращает пригласительные ссылки чата
+// @Summary      Список пригласительных ссылок
+// @Description  Все ссылки чата, включая отозванные и истёкшие, новые первыми (только админ)
+// @Tags         chats
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        chat_id  path      string           true  "ID чата"  format(uuid)
+// @Success      200      {array}   dto.InviteDTO    "Ссылки чата"
+// @Failure      400      {object}  dto.ErrorDTO     "Некорректный chat_id"
+// @Failure      401      {object}  dto.ErrorDTO     "Неавторизованный доступ"
+// @Failure      403      {object}  dto.ErrorDTO     "Нет прав для управления ссылками"
+// @Router       /chats/{chat_id}/invites [get]
+func (h *ChatsGRPCProxyHandler) GetChatInvites(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.GetChatInvites"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.chatsClient.GetChatInvites(r.Context(), &gen.GetChatReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	invites := make([]dtoChats.InviteDTO, len(res.GetInvites()))
+	for i, invite := range res.GetInvites() {
+		invites[i] = mappers.ProtoInviteToDTO(invite)
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, invites)
+}
+
+// RevokeInvite отзывает пригласительную ссылку
+// @Summary      Отозвать пригласительную ссылку
+// @Description  По отозванной ссылке вступить нельзя; уже поданные по ней заявки остаются в очереди (только админ)
+// @Tags         chats
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id    path  string  true  "ID чата"    format(uuid)
+// @Param        invite_id  path  string  true  "ID ссылки"  format(uuid)
+// @Success      204  "Ссылка отозвана"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный chat_id или invite_id"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для управления ссылками"
+// @Failure      404  {object}  dto.ErrorDTO  "Ссылка не найдена или уже отозвана"
+// @Router       /chats/{chat_id}/invites/{invite_id} [delete]
+func (h *ChatsGRPCProxyHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.RevokeInvite"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	inviteID, err := uuid.Parse(mux.Vars(r)["invite_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format invite_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	_, err = h.chatsClient.RevokeInvite(r.Context(), &gen.RevokeInviteReq{
+		UserId:   userID.String(),
+		ChatId:   chatID.String(),
+		InviteId: inviteID.String(),
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JoinChatByInvite вступает в чат по пригласительной ссылке
+// @Summary      Вступить по пригласительной ссылке
+// @Description  Если ссылка требует одобрения, создаётся заявка и возвращается pending=true; иначе пользователь сразу становится участником и получает обновления чата
+// @Tags         chats
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        code  path      string               true  "Код ссылки"
+// @Success      200   {object}  dto.JoinByInviteDTO  "Результат вступления"
+// @Failure      401   {object}  dto.ErrorDTO         "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO         "Ссылка не найдена, отозвана, истекла или исчерпана"
+// @Failure      409   {object}  dto.ErrorDTO         "Пользователь уже состоит в чате"
+// @Router       /invites/{code}/join [post]
+func (h *ChatsGRPCProxyHandler) JoinChatByInvite(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.JoinChatByInvite"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.chatsClient.JoinChatByInvite(r.Context(), &gen.JoinChatByInviteReq{
+		UserId: userID.String(),
+		Code:   mux.Vars(r)["code"],
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	chatID, _ := uuid.Parse(res.GetChatId())
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dtoChats.JoinByInviteDTO{
+		ChatID:  chatID,
+		Pending: res.GetPending(),
+	})
+}
+
+// GetJoinRequests возвращает очередь заявок на вступление
+// @Summary      Заявки на вступление
+// @Description  Заявки, поданные по ссылкам с одобрением, старые первыми (только админ)
+// @Tags         chats
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        chat_id  path      string                true  "ID чата"  format(uuid)
+// @Success      200      {array}   dto.JoinRequestDTO    "Очередь заявок"
+// @Failure      400      {object}  dto.ErrorDTO          "Некорректный chat_id"
+// @Failure      401      {object}  dto.ErrorDTO          "Неавторизованный доступ"
+// @Failure      403      {object}  dto.ErrorDTO          "Нет прав для управления заявками"
+// @Router       /chats/{chat_id}/join-requests [get]
+func (h *ChatsGRPCProxyHandler) GetJoinRequests(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.GetJoinRequests"
+
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.chatsClient.GetJoinRequests(r.Context(), &gen.GetChatReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	})
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	requests := make([]dtoChats.JoinRequestDTO, len(res.GetRequests()))
+	for i, request := range res.GetRequests() {
+		requests[i] = mappers.ProtoJoinRequestToDTO(request)
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, requests)
+}
+
+// ApproveJoinRequest одобряет заявку на вступление
+// @Summary      Одобрить заявку на вступление
+// @Description  Пользователь становится участником чата и сразу получает его обновления (только админ)
+// @Tags         chats
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id  path  string  true  "ID чата"          format(uuid)
+// @Param        user_id  path  string  true  "ID пользователя"  format(uuid)
+// @Success      204  "Заявка одобрена"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный chat_id или user_id"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для управления заявками"
+// @Failure      404  {object}  dto.ErrorDTO  "Заявка не найдена"
+// @Router       /chats/{chat_id}/join-requests/{user_id}/approve [post]
+func (h *ChatsGRPCProxyHandler) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.ApproveJoinRequest"
+
+	request, ok := parseJoinRequestDecision(w, r, op)
+	if !ok {
+		return
+	}
+
+	if _, err := h.chatsClient.ApproveJoinRequest(r.Context(), request); err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeclineJoinRequest отклоняет заявку на вступление
+// @Summary      Отклонить заявку на вступление
+// @Description  Заявка удаляется из очереди; пользователь может подать её снова (только админ)
+// @Tags         chats
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id  path  string  true  "ID чата"          format(uuid)
+// @Param        user_id  path  string  true  "ID пользователя"  format(uuid)
+// @Success      204  "Заявка отклонена"
+// @Failure      400  {object}  dto.ErrorDTO  "Некорректный chat_id или user_id"
+// @Failure      401  {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      403  {object}  dto.ErrorDTO  "Нет прав для управления заявками"
+// @Failure      404  {object}  dto.ErrorDTO  "Заявка не найдена"
+// @Router       /chats/{chat_id}/join-requests/{user_id}/decline [post]
+func (h *ChatsGRPCProxyHandler) DeclineJoinRequest(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.DeclineJoinRequest"
+
+	request, ok := parseJoinRequestDecision(w, r, op)
+	if !ok {
+		return
+	}
+
+	if _, err := h.chatsClient.DeclineJoinRequest(r.Context(), request); err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseJoinRequestDecision собирает запрос решения по заявке; при ошибке ответ уже отправлен
+func parseJoinRequestDecision(w http.ResponseWriter, r *http.Request, op string) (*gen.JoinRequestDecisionReq, bool) {
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return nil, false
+	}
+
+	requesterID, err := uuid.Parse(mux.Vars(r)["user_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format user_id")
+		return nil, false
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return nil, false
+	}
+
+	return &gen.JoinRequestDecisionReq{
+		UserId:      userID.String(),
+		ChatId:      chatID.String(),
+		RequesterId: requesterID.String(),
+	}, true
+}
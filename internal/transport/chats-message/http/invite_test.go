@@ -0,0 +1,189 @@
+package chats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/http/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGRPCCreateInvite_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	inviteID := uuid.New()
+	usageLimit := int32(10)
+
+	mockClient.EXPECT().
+		CreateInvite(gomock.Any(), &gen.CreateInviteReq{
+			UserId:     userID.String(),
+			ChatId:     chatID.String(),
+			Name:       "friends",
+			UsageLimit: &usageLimit,
+		}).
+		Return(&gen.Invite{
+			Id:         inviteID.String(),
+			Code:       "abc",
+			Name:       "friends",
+			CreatorId:  userID.String(),
+			UsageLimit: &usageLimit,
+			CreatedAt:  timestamppb.Now(),
+		}, nil)
+
+	request := httptest.NewRequest(http.MethodPost, "/chats/"+chatID.String()+"/invites", bytes.NewReader([]byte(`{"name": "friends", "usage_limit": 10}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateInvite(recorder, request)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	var invite dtoChats.InviteDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&invite))
+	assert.Equal(t, inviteID, invite.ID)
+	assert.Equal(t, "abc", invite.Code)
+	assert.Equal(t, 10, *invite.UsageLimit)
+	assert.Nil(t, invite.ExpiresAt)
+}
+
+func TestGRPCCreateInvite_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	chatID := uuid.New()
+
+	mockClient.EXPECT().
+		CreateInvite(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.PermissionDenied, "only admin can manage invites and join requests"))
+
+	request := httptest.NewRequest(http.MethodPost, "/chats/"+chatID.String()+"/invites", bytes.NewReader([]byte(`{}`)))
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.CreateInvite(recorder, request)
+
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestGRPCJoinChatByInvite_Pending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockClient.EXPECT().
+		JoinChatByInvite(gomock.Any(), &gen.JoinChatByInviteReq{UserId: userID.String(), Code: "abc"}).
+		Return(&gen.JoinChatByInviteRes{ChatId: chatID.String(), Pending: true}, nil)
+
+	request := httptest.NewRequest(http.MethodPost, "/invites/abc/join", nil)
+	request = mux.SetURLVars(request, map[string]string{"code": "abc"})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.JoinChatByInvite(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var result dtoChats.JoinByInviteDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&result))
+	assert.Equal(t, chatID, result.ChatID)
+	assert.True(t, result.Pending)
+}
+
+func TestGRPCJoinChatByInvite_Expired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	mockClient.EXPECT().
+		JoinChatByInvite(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "invite link is expired or revoked"))
+
+	request := httptest.NewRequest(http.MethodPost, "/invites/abc/join", nil)
+	request = mux.SetURLVars(request, map[string]string{"code": "abc"})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.JoinChatByInvite(recorder, request)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGRPCApproveJoinRequest_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mocks.NewMockChatServiceClient(ctrl)
+	handler := NewChatsGRPCProxyHandler(mockClient, mocks.NewMockMessageServiceClient(ctrl))
+
+	adminID := uuid.New()
+	chatID := uuid.New()
+	requesterID := uuid.New()
+
+	mockClient.EXPECT().
+		ApproveJoinRequest(gomock.Any(), &gen.JoinRequestDecisionReq{
+			UserId:      adminID.String(),
+			ChatId:      chatID.String(),
+			RequesterId: requesterID.String(),
+		}).
+		Return(&emptypb.Empty{}, nil)
+
+	request := httptest.NewRequest(http.MethodPost, "/chats/"+chatID.String()+"/join-requests/"+requesterID.String()+"/approve", nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String(), "user_id": requesterID.String()})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, adminID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.ApproveJoinRequest(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestGRPCDeclineJoinRequest_InvalidUserID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewChatsGRPCProxyHandler(mocks.NewMockChatServiceClient(ctrl), mocks.NewMockMessageServiceClient(ctrl))
+
+	chatID := uuid.New()
+
+	request := httptest.NewRequest(http.MethodPost, "/chats/"+chatID.String()+"/join-requests/invalid/decline", nil)
+	request = mux.SetURLVars(request, map[string]string{"chat_id": chatID.String(), "user_id": "invalid"})
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.DeclineJoinRequest(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
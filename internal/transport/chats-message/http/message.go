@@ -8,11 +8,13 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	tokenModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
 	mappers "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/mappers"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
@@ -177,6 +179,19 @@ var upgrader = websocket.Upgrader{
 // @Description  }
 // @Description  ```
 // @Description
+// @Description  **Системное уведомление (например, вход с нового устройства):**
+// @Description  ```json
+// @Description  {
+// @Description    "type": "system_notification",
+// @Description    "chat_id": "00000000-0000-0000-0000-000000000000",
+// @Description    "value": {
+// @Description      "kind": "new_device",
+// @Description      "text": "Выполнен вход в аккаунт с нового устройства: Chrome 120.0 on Windows 10, Moscow, Russia, IP 5.255.255.10",
+// @Description      "created_at": "2025-01-15T10:30:00Z"
+// @Description    }
+// @Description  }
+// @Description  ```
+// @Description
 // @Description  **Обработка ошибок:**
 // @Description  ```json
 // @Description  {
@@ -199,6 +214,13 @@ func (h *ChatsGRPCProxyHandler) HandleMessages(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	shutdown, ok := h.connections.acquire()
+	if !ok {
+		utils.SendError(r.Context(), op, w, http.StatusServiceUnavailable, "server is shutting down")
+		return
+	}
+	defer h.connections.release()
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		utils.SendError(r.Context(), op, w, http.StatusInternalServerError, err.Error())
@@ -206,14 +228,26 @@ func (h *ChatsGRPCProxyHandler) HandleMessages(w http.ResponseWriter, r *http.Re
 	}
 	defer conn.Close()
 
+	connectedAt := time.Now()
+	wsActiveConnections.Inc()
+	defer func() {
+		wsActiveConnections.Dec()
+		wsConnectionDuration.Observe(time.Since(connectedAt).Seconds())
+	}()
+
 	ctx, cancel := context.WithCancel(r.Context())
 	defer cancel()
 
 	// Горутины для отправки и приёма сообщений по WebSocket.
-	go h.sendMessages(ctx, cancel, conn, userID)
+	go h.sendMessages(ctx, cancel, conn, userID, canWriteWebSocket(r.Context()))
 	go h.readMessages(ctx, cancel, conn, userID)
 
-	<-ctx.Done()
+	select {
+	case <-ctx.Done():
+	case <-shutdown:
+		// Шлюз останавливается: клиент должен переподключиться к другому экземпляру
+		writeCloseFrame(conn, websocket.CloseGoingAway, "server is shutting down")
+	}
 }
 
 func (h *ChatsGRPCProxyHandler) readMessages(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, userID uuid.UUID) {
@@ -255,7 +289,9 @@ func (h *ChatsGRPCProxyHandler) readMessages(ctx context.Context, cancel context
 	for {
 		protoMessage, err := stream.Recv()
 		if err == io.EOF {
+			// Сервис чатов штатно завершил поток (например, при перезапуске) - просим клиента переподключиться
 			logger.Info("Stream ended")
+			writeCloseFrame(conn, websocket.CloseServiceRestart, "stream ended")
 			break
 		}
 
@@ -272,10 +308,21 @@ func (h *ChatsGRPCProxyHandler) readMessages(ctx context.Context, cancel context
 			logger.WithError(err).Error("Failed to write message to user")
 			return
 		}
+		countWebSocketMessage(wsDirectionOut, dtoMessage.Type)
 	}
 }
 
-func (h *ChatsGRPCProxyHandler) sendMessages(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, userID uuid.UUID) {
+// canWriteWebSocket сообщает, может ли сессия отправлять изменяющие кадры.
+// Сессия по cookie может всё, токен - только при наличии write в scopes.
+func canWriteWebSocket(ctx context.Context) bool {
+	scopes, ok := ctx.Value(domains.TokenScopesKey{}).([]string)
+	if !ok {
+		return true
+	}
+	return slices.Contains(scopes, tokenModels.ScopeWrite)
+}
+
+func (h *ChatsGRPCProxyHandler) sendMessages(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, userID uuid.UUID, canWrite bool) {
 	const op = "ChatsGRPCProxyHandler.sendMessages"
 	defer cancel()
 
@@ -296,6 +343,13 @@ func (h *ChatsGRPCProxyHandler) sendMessages(ctx context.Context, cancel context
 			logger.WithError(err).Error("WebSocket read error")
 			continue
 		}
+		countWebSocketMessage(wsDirectionIn, msg.Type)
+
+		// Все входящие кадры изменяют данные (отправка, правка, удаление)
+		if !canWrite {
+			h.writeJSONErrorWebSocket(conn, "token scope is insufficient")
+			continue
+		}
 
 		_, err := h.messageClient.HandleSendMessage(ctx, mappers.DTOWebSocketMessageToProto(userID, msg))
 		if err != nil {
@@ -471,3 +525,80 @@ func (h *ChatsGRPCProxyHandler) UploadAttachment(w http.ResponseWriter, r *http.
 
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dto)
 }
+
+// GetUnreadMentions возвращает непрочитанные упоминания пользователя в чате
+// @Summary      Непрочитанные упоминания в чате
+// @Description  Возвращает сообщения чата, в которых упомянут текущий пользователь и которые он ещё не отметил прочитанными. Новые сообщения идут первыми.
+// @Tags         messages
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        chat_id  path      string  true  "ID чата"  format(uuid)
+// @Success      200      {array}   dto.MessageDTO  "Сообщения с упоминаниями"
+// @Failure      400      {object}  dto.ErrorDTO    "Некорректный запрос"
+// @Failure      401      {object}  dto.ErrorDTO    "Неавторизованный доступ"
+// @Failure      500      {object}  dto.ErrorDTO    "Ошибка сервера"
+// @Router       /chats/{chat_id}/mentions [get]
+func (h *ChatsGRPCProxyHandler) GetUnreadMentions(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.GetUnreadMentions"
+
+	req, ok := chatMentionsRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	response, err := h.messageClient.GetUnreadMentions(r.Context(), req)
+	if err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, mappers.ProtoGetChatMessagesResToDTO(response))
+}
+
+// ReadMentions отмечает упоминания пользователя в чате прочитанными
+// @Summary      Прочитать упоминания в чате
+// @Description  Отмечает все упоминания текущего пользователя в чате прочитанными
+// @Tags         messages
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Security     ApiKeyAuth
+// @Param        chat_id  path      string  true  "ID чата"  format(uuid)
+// @Success      200
+// @Failure      400      {object}  dto.ErrorDTO  "Некорректный запрос"
+// @Failure      401      {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      500      {object}  dto.ErrorDTO  "Ошибка сервера"
+// @Router       /chats/{chat_id}/mentions/read [post]
+func (h *ChatsGRPCProxyHandler) ReadMentions(w http.ResponseWriter, r *http.Request) {
+	const op = "ChatsGRPCProxyHandler.ReadMentions"
+
+	req, ok := chatMentionsRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	if _, err := h.messageClient.ReadMentions(r.Context(), req); err != nil {
+		utils.HandleGRPCError(r.Context(), w, err, op)
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, nil)
+}
+
+func chatMentionsRequest(w http.ResponseWriter, r *http.Request, op string) (*gen.ChatMentionsReq, bool) {
+	chatID, err := uuid.Parse(mux.Vars(r)["chat_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "bad format chat_id")
+		return nil, false
+	}
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return nil, false
+	}
+
+	return &gen.ChatMentionsReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	}, true
+}
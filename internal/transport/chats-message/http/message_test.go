@@ -3,18 +3,22 @@ package chats
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -47,6 +51,14 @@ func (m *MockMessageClient) SearchMessages(ctx context.Context, in *gen.SearchMe
 	return args.Get(0).(*gen.SearchMessagesRes), args.Error(1)
 }
 
+func (m *MockMessageClient) NotifyUser(ctx context.Context, in *gen.NotifyUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
 func (m *MockMessageClient) UploadAttachment(ctx context.Context, in *gen.UploadAttachmentReq, opts ...grpc.CallOption) (*gen.UploadAttachmentRes, error) {
 	args := m.Called(ctx, in, opts)
 	if args.Get(0) == nil {
@@ -55,6 +67,22 @@ func (m *MockMessageClient) UploadAttachment(ctx context.Context, in *gen.Upload
 	return args.Get(0).(*gen.UploadAttachmentRes), args.Error(1)
 }
 
+func (m *MockMessageClient) GetUnreadMentions(ctx context.Context, in *gen.ChatMentionsReq, opts ...grpc.CallOption) (*gen.GetChatMessagesRes, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.GetChatMessagesRes), args.Error(1)
+}
+
+func (m *MockMessageClient) ReadMentions(ctx context.Context, in *gen.ChatMentionsReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*emptypb.Empty), args.Error(1)
+}
+
 func setupMessageContext(userID uuid.UUID) context.Context {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
@@ -245,6 +273,62 @@ func TestHandleMessages_Unauthorized(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, w.Code)
 }
 
+// idleMessageStream - поток событий, который штатно завершается по окончании теста
+type idleMessageStream struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (s *idleMessageStream) Recv() (*gen.MessageEventRes, error) {
+	<-s.ctx.Done()
+	return nil, io.EOF
+}
+
+func TestHandleMessages_ReadScopeCannotSend(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "development")
+
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	streamCtx, stopStream := context.WithCancel(context.Background())
+	defer stopStream()
+	mockMessageClient.On("StreamMessagesForUser", mock.Anything, mock.Anything, mock.Anything).
+		Return(&idleMessageStream{ctx: streamCtx}, nil)
+
+	userID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(setupMessageContext(userID), domains.TokenScopesKey{}, []string{"read"})
+		handler.HandleMessages(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeNewChatMessage,
+		ChatID: uuid.New(),
+		Value:  map[string]string{"text": "привет"},
+	}))
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	var response map[string]string
+	require.NoError(t, conn.ReadJSON(&response))
+
+	assert.Equal(t, "token scope is insufficient", response["error"])
+	mockMessageClient.AssertNotCalled(t, "HandleSendMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCanWriteWebSocket(t *testing.T) {
+	ctx := setupMessageContext(uuid.New())
+	assert.True(t, canWriteWebSocket(ctx), "cookie session may write")
+	assert.False(t, canWriteWebSocket(context.WithValue(ctx, domains.TokenScopesKey{}, []string{"read"})))
+	assert.True(t, canWriteWebSocket(context.WithValue(ctx, domains.TokenScopesKey{}, []string{"read", "write"})))
+}
+
 func TestWebSocketMessageTypes(t *testing.T) {
 	// Проверяем константы типов сообщений
 	assert.Equal(t, "new_message", dtoMessage.WebSocketMessageTypeNewChatMessage)
@@ -352,3 +436,74 @@ func TestSearchMessages_WithSpecialCharacters(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	mockMessageClient.AssertExpectations(t)
 }
+
+func TestGetUnreadMentions_Success(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	expectedRes := &gen.GetChatMessagesRes{
+		Messages: []*gen.Message{{Id: uuid.New().String(), ChatId: chatID.String(), Text: "@user привет"}},
+	}
+
+	mockMessageClient.On("GetUnreadMentions", mock.Anything, &gen.ChatMentionsReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	}, mock.Anything).Return(expectedRes, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/chats/"+chatID.String()+"/mentions", nil)
+	req = req.WithContext(setupMessageContext(userID))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+
+	w := httptest.NewRecorder()
+	handler.GetUnreadMentions(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "@user привет")
+	mockMessageClient.AssertExpectations(t)
+}
+
+func TestGetUnreadMentions_InvalidChatID(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/chats/invalid-uuid/mentions", nil)
+	req = req.WithContext(setupMessageContext(uuid.New()))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": "invalid-uuid"})
+
+	w := httptest.NewRecorder()
+	handler.GetUnreadMentions(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestReadMentions_Success(t *testing.T) {
+	mockMessageClient := new(MockMessageClient)
+	handler := &ChatsGRPCProxyHandler{
+		messageClient: mockMessageClient,
+	}
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockMessageClient.On("ReadMentions", mock.Anything, &gen.ChatMentionsReq{
+		UserId: userID.String(),
+		ChatId: chatID.String(),
+	}, mock.Anything).Return(&emptypb.Empty{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/chats/"+chatID.String()+"/mentions/read", nil)
+	req = req.WithContext(setupMessageContext(userID))
+	req = mux.SetURLVars(req, map[string]string{"chat_id": chatID.String()})
+
+	w := httptest.NewRecorder()
+	handler.ReadMentions(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockMessageClient.AssertExpectations(t)
+}
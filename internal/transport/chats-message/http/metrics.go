@@ -0,0 +1,58 @@
+package chats
+
+import (
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	wsDirectionIn  = "in"
+	wsDirectionOut = "out"
+
+	// wsTypeUnknown подставляется вместо типа от клиента, чтобы он не мог раздуть число серий
+	wsTypeUnknown = "unknown"
+)
+
+var (
+	wsActiveConnections = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "websocket_active_connections",
+			Help: "Number of currently open WebSocket connections",
+		},
+	)
+
+	wsConnectionDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "websocket_connection_duration_seconds",
+			Help:    "WebSocket connection lifetime in seconds",
+			Buckets: []float64{1, 10, 60, 300, 900, 1800, 3600, 4 * 3600, 12 * 3600},
+		},
+	)
+
+	wsMessagesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "websocket_messages_total",
+			Help: "Total number of WebSocket messages by direction and type",
+		},
+		[]string{"direction", "type"},
+	)
+)
+
+var knownWebSocketTypes = map[string]struct{}{
+	dtoMessage.WebSocketMessageTypeNewChatMessage:     {},
+	dtoMessage.WebSocketMessageTypeEditChatMessage:    {},
+	dtoMessage.WebSocketMessageTypeDeleteChatMessage:  {},
+	dtoMessage.WebSocketMessageTypeCreatedNewChat:     {},
+	dtoMessage.WebSocketMessageTypeSystemNotification: {},
+	dtoMessage.WebSocketMessageTypeMention:            {},
+	dtoMessage.WebSocketMessageTypeChatSettings:       {},
+	dtoMessage.WebSocketMessageTypeUserStatus:         {},
+}
+
+func countWebSocketMessage(direction, messageType string) {
+	if _, ok := knownWebSocketTypes[messageType]; !ok {
+		messageType = wsTypeUnknown
+	}
+	wsMessagesTotal.WithLabelValues(direction, messageType).Inc()
+}
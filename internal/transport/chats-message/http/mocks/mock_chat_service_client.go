@@ -57,6 +57,26 @@ func (mr *MockChatServiceClientMockRecorder) AddUserToChat(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToChat", reflect.TypeOf((*MockChatServiceClient)(nil).AddUserToChat), varargs...)
 }
 
+// ApproveJoinRequest mocks base method.
+func (m *MockChatServiceClient) ApproveJoinRequest(arg0 context.Context, arg1 *chats.JoinRequestDecisionReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockChatServiceClientMockRecorder) ApproveJoinRequest(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockChatServiceClient)(nil).ApproveJoinRequest), varargs...)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatServiceClient) CreateChat(arg0 context.Context, arg1 *chats.CreateChatReq, arg2 ...grpc.CallOption) (*chats.IdRes, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +97,46 @@ func (mr *MockChatServiceClientMockRecorder) CreateChat(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatServiceClient)(nil).CreateChat), varargs...)
 }
 
+// CreateInvite mocks base method.
+func (m *MockChatServiceClient) CreateInvite(arg0 context.Context, arg1 *chats.CreateInviteReq, arg2 ...grpc.CallOption) (*chats.Invite, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateInvite", varargs...)
+	ret0, _ := ret[0].(*chats.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockChatServiceClientMockRecorder) CreateInvite(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockChatServiceClient)(nil).CreateInvite), varargs...)
+}
+
+// DeclineJoinRequest mocks base method.
+func (m *MockChatServiceClient) DeclineJoinRequest(arg0 context.Context, arg1 *chats.JoinRequestDecisionReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclineJoinRequest", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineJoinRequest indicates an expected call of DeclineJoinRequest.
+func (mr *MockChatServiceClientMockRecorder) DeclineJoinRequest(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineJoinRequest", reflect.TypeOf((*MockChatServiceClient)(nil).DeclineJoinRequest), varargs...)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatServiceClient) DeleteChat(arg0 context.Context, arg1 *chats.GetChatReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +157,26 @@ func (mr *MockChatServiceClientMockRecorder) DeleteChat(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatServiceClient)(nil).DeleteChat), varargs...)
 }
 
+// DeleteChatAvatar mocks base method.
+func (m *MockChatServiceClient) DeleteChatAvatar(arg0 context.Context, arg1 *chats.ChatAvatarReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteChatAvatar", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChatAvatar indicates an expected call of DeleteChatAvatar.
+func (mr *MockChatServiceClientMockRecorder) DeleteChatAvatar(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatAvatar", reflect.TypeOf((*MockChatServiceClient)(nil).DeleteChatAvatar), varargs...)
+}
+
 // GetChat mocks base method.
 func (m *MockChatServiceClient) GetChat(arg0 context.Context, arg1 *chats.GetChatReq, arg2 ...grpc.CallOption) (*chats.ChatDetailedInformation, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +197,26 @@ func (mr *MockChatServiceClientMockRecorder) GetChat(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatServiceClient)(nil).GetChat), varargs...)
 }
 
+// GetChatAvatarHistory mocks base method.
+func (m *MockChatServiceClient) GetChatAvatarHistory(arg0 context.Context, arg1 *chats.GetChatAvatarHistoryReq, arg2 ...grpc.CallOption) (*chats.GetChatAvatarHistoryRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatAvatarHistory", varargs...)
+	ret0, _ := ret[0].(*chats.GetChatAvatarHistoryRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAvatarHistory indicates an expected call of GetChatAvatarHistory.
+func (mr *MockChatServiceClientMockRecorder) GetChatAvatarHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatarHistory", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatAvatarHistory), varargs...)
+}
+
 // GetChatAvatars mocks base method.
 func (m *MockChatServiceClient) GetChatAvatars(arg0 context.Context, arg1 *chats.GetChatAvatarsReq, arg2 ...grpc.CallOption) (*chats.GetChatAvatarsRes, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +237,26 @@ func (mr *MockChatServiceClientMockRecorder) GetChatAvatars(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatars", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatAvatars), varargs...)
 }
 
+// GetChatInvites mocks base method.
+func (m *MockChatServiceClient) GetChatInvites(arg0 context.Context, arg1 *chats.GetChatReq, arg2 ...grpc.CallOption) (*chats.GetChatInvitesRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChatInvites", varargs...)
+	ret0, _ := ret[0].(*chats.GetChatInvitesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatServiceClientMockRecorder) GetChatInvites(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatServiceClient)(nil).GetChatInvites), varargs...)
+}
+
 // GetChatMessages mocks base method.
 func (m *MockChatServiceClient) GetChatMessages(arg0 context.Context, arg1 *chats.GetChatMessagesReq, arg2 ...grpc.CallOption) (*chats.GetChatMessagesRes, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +297,46 @@ func (mr *MockChatServiceClientMockRecorder) GetChats(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockChatServiceClient)(nil).GetChats), varargs...)
 }
 
+// GetGroupPeers mocks base method.
+func (m *MockChatServiceClient) GetGroupPeers(arg0 context.Context, arg1 *chats.GetGroupPeersReq, arg2 ...grpc.CallOption) (*chats.GetGroupPeersRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupPeers", varargs...)
+	ret0, _ := ret[0].(*chats.GetGroupPeersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupPeers indicates an expected call of GetGroupPeers.
+func (mr *MockChatServiceClientMockRecorder) GetGroupPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPeers", reflect.TypeOf((*MockChatServiceClient)(nil).GetGroupPeers), varargs...)
+}
+
+// GetJoinRequests mocks base method.
+func (m *MockChatServiceClient) GetJoinRequests(arg0 context.Context, arg1 *chats.GetChatReq, arg2 ...grpc.CallOption) (*chats.GetJoinRequestsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJoinRequests", varargs...)
+	ret0, _ := ret[0].(*chats.GetJoinRequestsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequests indicates an expected call of GetJoinRequests.
+func (mr *MockChatServiceClientMockRecorder) GetJoinRequests(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequests", reflect.TypeOf((*MockChatServiceClient)(nil).GetJoinRequests), varargs...)
+}
+
 // GetUsersDialog mocks base method.
 func (m *MockChatServiceClient) GetUsersDialog(arg0 context.Context, arg1 *chats.GetUsersDialogReq, arg2 ...grpc.CallOption) (*chats.IdRes, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +357,26 @@ func (mr *MockChatServiceClientMockRecorder) GetUsersDialog(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersDialog", reflect.TypeOf((*MockChatServiceClient)(nil).GetUsersDialog), varargs...)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatServiceClient) JoinChatByInvite(arg0 context.Context, arg1 *chats.JoinChatByInviteReq, arg2 ...grpc.CallOption) (*chats.JoinChatByInviteRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinChatByInvite", varargs...)
+	ret0, _ := ret[0].(*chats.JoinChatByInviteRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatServiceClientMockRecorder) JoinChatByInvite(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatServiceClient)(nil).JoinChatByInvite), varargs...)
+}
+
 // RemoveUserFromChat mocks base method.
 func (m *MockChatServiceClient) RemoveUserFromChat(arg0 context.Context, arg1 *chats.RemoveUserFromChatReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +397,46 @@ func (mr *MockChatServiceClientMockRecorder) RemoveUserFromChat(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromChat", reflect.TypeOf((*MockChatServiceClient)(nil).RemoveUserFromChat), varargs...)
 }
 
+// ResolveChatUsername mocks base method.
+func (m *MockChatServiceClient) ResolveChatUsername(arg0 context.Context, arg1 *chats.ResolveChatUsernameReq, arg2 ...grpc.CallOption) (*chats.ResolveChatUsernameRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveChatUsername", varargs...)
+	ret0, _ := ret[0].(*chats.ResolveChatUsernameRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveChatUsername indicates an expected call of ResolveChatUsername.
+func (mr *MockChatServiceClientMockRecorder) ResolveChatUsername(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveChatUsername", reflect.TypeOf((*MockChatServiceClient)(nil).ResolveChatUsername), varargs...)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockChatServiceClient) RevokeInvite(arg0 context.Context, arg1 *chats.RevokeInviteReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeInvite", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockChatServiceClientMockRecorder) RevokeInvite(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockChatServiceClient)(nil).RevokeInvite), varargs...)
+}
+
 // SearchChats mocks base method.
 func (m *MockChatServiceClient) SearchChats(arg0 context.Context, arg1 *chats.SearchChatsReq, arg2 ...grpc.CallOption) (*chats.GetChatsRes, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +457,46 @@ func (mr *MockChatServiceClientMockRecorder) SearchChats(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChats", reflect.TypeOf((*MockChatServiceClient)(nil).SearchChats), varargs...)
 }
 
+// SetChatUsername mocks base method.
+func (m *MockChatServiceClient) SetChatUsername(arg0 context.Context, arg1 *chats.SetChatUsernameReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetChatUsername", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChatUsername indicates an expected call of SetChatUsername.
+func (mr *MockChatServiceClientMockRecorder) SetChatUsername(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatUsername", reflect.TypeOf((*MockChatServiceClient)(nil).SetChatUsername), varargs...)
+}
+
+// SetCurrentChatAvatar mocks base method.
+func (m *MockChatServiceClient) SetCurrentChatAvatar(arg0 context.Context, arg1 *chats.ChatAvatarReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCurrentChatAvatar", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCurrentChatAvatar indicates an expected call of SetCurrentChatAvatar.
+func (mr *MockChatServiceClientMockRecorder) SetCurrentChatAvatar(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentChatAvatar", reflect.TypeOf((*MockChatServiceClient)(nil).SetCurrentChatAvatar), varargs...)
+}
+
 // UpdateChat mocks base method.
 func (m *MockChatServiceClient) UpdateChat(arg0 context.Context, arg1 *chats.UpdateChatReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -257,6 +517,26 @@ func (mr *MockChatServiceClientMockRecorder) UpdateChat(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatServiceClient)(nil).UpdateChat), varargs...)
 }
 
+// UpdateChatSettings mocks base method.
+func (m *MockChatServiceClient) UpdateChatSettings(arg0 context.Context, arg1 *chats.UpdateChatSettingsReq, arg2 ...grpc.CallOption) (*chats.ChatSettings, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChatSettings", varargs...)
+	ret0, _ := ret[0].(*chats.ChatSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChatSettings indicates an expected call of UpdateChatSettings.
+func (mr *MockChatServiceClientMockRecorder) UpdateChatSettings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatSettings", reflect.TypeOf((*MockChatServiceClient)(nil).UpdateChatSettings), varargs...)
+}
+
 // UploadChatAvatar mocks base method.
 func (m *MockChatServiceClient) UploadChatAvatar(arg0 context.Context, arg1 *chats.UploadChatAvatarReq, arg2 ...grpc.CallOption) (*chats.UploadChatAvatarRes, error) {
 	m.ctrl.T.Helper()
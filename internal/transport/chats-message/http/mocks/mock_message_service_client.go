@@ -37,6 +37,26 @@ func (m *MockMessageServiceClient) EXPECT() *MockMessageServiceClientMockRecorde
 	return m.recorder
 }
 
+// GetUnreadMentions mocks base method.
+func (m *MockMessageServiceClient) GetUnreadMentions(arg0 context.Context, arg1 *chats.ChatMentionsReq, arg2 ...grpc.CallOption) (*chats.GetChatMessagesRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUnreadMentions", varargs...)
+	ret0, _ := ret[0].(*chats.GetChatMessagesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadMentions indicates an expected call of GetUnreadMentions.
+func (mr *MockMessageServiceClientMockRecorder) GetUnreadMentions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadMentions", reflect.TypeOf((*MockMessageServiceClient)(nil).GetUnreadMentions), varargs...)
+}
+
 // HandleSendMessage mocks base method.
 func (m *MockMessageServiceClient) HandleSendMessage(arg0 context.Context, arg1 *chats.MessageEventReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +77,46 @@ func (mr *MockMessageServiceClientMockRecorder) HandleSendMessage(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSendMessage", reflect.TypeOf((*MockMessageServiceClient)(nil).HandleSendMessage), varargs...)
 }
 
+// NotifyUser mocks base method.
+func (m *MockMessageServiceClient) NotifyUser(arg0 context.Context, arg1 *chats.NotifyUserReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NotifyUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyUser indicates an expected call of NotifyUser.
+func (mr *MockMessageServiceClientMockRecorder) NotifyUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUser", reflect.TypeOf((*MockMessageServiceClient)(nil).NotifyUser), varargs...)
+}
+
+// ReadMentions mocks base method.
+func (m *MockMessageServiceClient) ReadMentions(arg0 context.Context, arg1 *chats.ChatMentionsReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadMentions", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMentions indicates an expected call of ReadMentions.
+func (mr *MockMessageServiceClientMockRecorder) ReadMentions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMentions", reflect.TypeOf((*MockMessageServiceClient)(nil).ReadMentions), varargs...)
+}
+
 // SearchMessages mocks base method.
 func (m *MockMessageServiceClient) SearchMessages(arg0 context.Context, arg1 *chats.SearchMessagesReq, arg2 ...grpc.CallOption) (*chats.SearchMessagesRes, error) {
 	m.ctrl.T.Helper()
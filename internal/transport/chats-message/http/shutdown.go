@@ -0,0 +1,77 @@
+package chats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const closeFrameTimeout = time.Second
+
+// wsConnections отслеживает открытые WebSocket-соединения, чтобы при остановке
+// предупредить клиентов и дождаться их закрытия. Нулевое значение готово к использованию
+type wsConnections struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	closing bool
+	done    chan struct{}
+}
+
+func (c *wsConnections) doneChan() chan struct{} {
+	if c.done == nil {
+		c.done = make(chan struct{})
+	}
+	return c.done
+}
+
+// acquire регистрирует новое соединение. Возвращает false, если сервер уже останавливается
+func (c *wsConnections) acquire() (<-chan struct{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closing {
+		return nil, false
+	}
+
+	c.wg.Add(1)
+	return c.doneChan(), true
+}
+
+func (c *wsConnections) release() {
+	c.wg.Done()
+}
+
+// shutdown сигнализирует всем соединениям о закрытии и ждёт их завершения, но не дольше ctx
+func (c *wsConnections) shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.closing {
+		c.closing = true
+		close(c.doneChan())
+	}
+	c.mu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown закрывает все WebSocket-соединения с кодом GoingAway и ждёт завершения их обработчиков.
+// Вызывается при остановке шлюза, так как http.Server.Shutdown не управляет перехваченными соединениями
+func (h *ChatsGRPCProxyHandler) Shutdown(ctx context.Context) error {
+	return h.connections.shutdown(ctx)
+}
+
+func writeCloseFrame(conn *websocket.Conn, code int, reason string) {
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(closeFrameTimeout))
+}
@@ -0,0 +1,52 @@
+package chats
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSConnections_Shutdown(t *testing.T) {
+	var connections wsConnections
+
+	done, ok := connections.acquire()
+	require.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Соединение ещё открыто - ждём до таймаута
+	err := connections.shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-done:
+	default:
+		t.Fatal("connections should be notified about shutdown")
+	}
+
+	_, ok = connections.acquire()
+	assert.False(t, ok, "new connections are rejected during shutdown")
+
+	connections.release()
+	assert.NoError(t, connections.shutdown(context.Background()))
+}
+
+func TestHandleMessages_ShuttingDown(t *testing.T) {
+	handler := &ChatsGRPCProxyHandler{}
+	require.NoError(t, handler.Shutdown(context.Background()))
+
+	req := httptest.NewRequest(http.MethodGet, "/message/ws", nil)
+	req = req.WithContext(setupMessageContext(uuid.New()))
+
+	w := httptest.NewRecorder()
+	handler.HandleMessages(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
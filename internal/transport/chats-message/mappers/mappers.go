@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/utils"
 	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -185,6 +186,7 @@ func ProtoChatToDTO(chat *gen.Chat) dtoChats.ChatViewInformationDTO {
 		Name:        chat.GetName(),
 		Type:        chat.GetType(),
 		LastMessage: ProtoMessageToDTO(chat.GetLastMessage()),
+		Settings:    ProtoChatSettingsToDTO(chat.GetSettings()),
 	}
 }
 
@@ -194,9 +196,40 @@ func DTOChatViewToProto(chatDTO dtoChats.ChatViewInformationDTO) *gen.Chat {
 		Name:        chatDTO.Name,
 		Type:        chatDTO.Type,
 		LastMessage: DTOMessageToProto(chatDTO.LastMessage),
+		Settings:    DTOChatSettingsToProto(chatDTO.Settings),
 	}
 }
 
+// DTOChatSettingsToProto конвертирует ChatSettingsDTO в protobuf ChatSettings
+func DTOChatSettingsToProto(settings dtoChats.ChatSettingsDTO) *gen.ChatSettings {
+	result := &gen.ChatSettings{
+		Muted:    settings.Muted,
+		Archived: settings.Archived,
+		PinOrder: int32(settings.PinOrder),
+		Folder:   settings.Folder,
+	}
+	if settings.MutedUntil != nil {
+		result.MutedUntil = stringToPtr(settings.MutedUntil.Format(time.RFC3339))
+	}
+	return result
+}
+
+// ProtoChatSettingsToDTO конвертирует protobuf ChatSettings в ChatSettingsDTO
+func ProtoChatSettingsToDTO(settings *gen.ChatSettings) dtoChats.ChatSettingsDTO {
+	result := dtoChats.ChatSettingsDTO{
+		Muted:    settings.GetMuted(),
+		Archived: settings.GetArchived(),
+		PinOrder: int(settings.GetPinOrder()),
+		Folder:   settings.GetFolder(),
+	}
+	if settings.GetMutedUntil() != "" {
+		if mutedUntil, err := time.Parse(time.RFC3339, settings.GetMutedUntil()); err == nil {
+			result.MutedUntil = &mutedUntil
+		}
+	}
+	return result
+}
+
 func DTOChatsViewToProto(chats []dtoChats.ChatViewInformationDTO) []*gen.Chat {
 	result := make([]*gen.Chat, len(chats))
 	for i, chatDTO := range chats {
@@ -331,6 +364,12 @@ func ProtoCreateChatToDTO(in *gen.CreateChatReq) (*dtoChats.ChatCreateInformatio
 
 // ProtoMessageEventResToDTO конвертирует protobuf MessageEventRes в WebSocketMessageDTO
 func ProtoMessageEventResToDTO(event *gen.MessageEventRes) dtoMessage.WebSocketMessageDTO {
+	result := protoMessageEventToDTO(event)
+	result.Muted = event.GetMuted()
+	return result
+}
+
+func protoMessageEventToDTO(event *gen.MessageEventRes) dtoMessage.WebSocketMessageDTO {
 	switch e := event.Event.(type) {
 	case *gen.MessageEventRes_NewChatMessage:
 		msg := ProtoMessageToDTO(e.NewChatMessage)
@@ -385,6 +424,36 @@ func ProtoMessageEventResToDTO(event *gen.MessageEventRes) dtoMessage.WebSocketM
 			},
 		}
 
+	case *gen.MessageEventRes_SystemNotification:
+		return dtoMessage.WebSocketMessageDTO{
+			Type:  dtoMessage.WebSocketMessageTypeSystemNotification,
+			Value: ProtoSystemNotificationToDTO(e.SystemNotification),
+		}
+
+	case *gen.MessageEventRes_Mention:
+		chatID, _ := uuid.Parse(event.GetChatId())
+		return dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeMention,
+			ChatID: chatID,
+			Value:  ProtoMessageToDTO(e.Mention),
+		}
+
+	case *gen.MessageEventRes_ChatSettings:
+		chatID, _ := uuid.Parse(event.GetChatId())
+		return dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeChatSettings,
+			ChatID: chatID,
+			Value:  ProtoChatSettingsToDTO(e.ChatSettings),
+		}
+
+	case *gen.MessageEventRes_UserStatus:
+		chatID, _ := uuid.Parse(event.GetChatId())
+		return dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeUserStatus,
+			ChatID: chatID,
+			Value:  ProtoUserStatusToDTO(e.UserStatus),
+		}
+
 	default:
 		return dtoMessage.WebSocketMessageDTO{
 			Type: "unknown",
@@ -656,11 +725,103 @@ func DTOWebSocketMessageToProtoEventRes(wsMsg dtoMessage.WebSocketMessageDTO) (*
 		}
 		return nil, status.Errorf(codes.InvalidArgument, "invalid value type for user_joined: expected UserJoinedDTO")
 
+	case dtoMessage.WebSocketMessageTypeSystemNotification:
+		if notificationDTO, ok := wsMsg.Value.(dtoMessage.SystemNotificationDTO); ok {
+			return &gen.MessageEventRes{
+				Event: &gen.MessageEventRes_SystemNotification{
+					SystemNotification: DTOSystemNotificationToProto(notificationDTO),
+				},
+			}, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value type for system_notification: expected SystemNotificationDTO")
+
+	case dtoMessage.WebSocketMessageTypeMention:
+		if msgDTO, ok := wsMsg.Value.(dtoMessage.MessageDTO); ok {
+			return &gen.MessageEventRes{
+				ChatId: wsMsg.ChatID.String(),
+				Event: &gen.MessageEventRes_Mention{
+					Mention: DTOMessageToProto(msgDTO),
+				},
+			}, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value type for mention: expected MessageDTO")
+
+	case dtoMessage.WebSocketMessageTypeChatSettings:
+		if settingsDTO, ok := wsMsg.Value.(dtoChats.ChatSettingsDTO); ok {
+			return &gen.MessageEventRes{
+				ChatId: wsMsg.ChatID.String(),
+				Event: &gen.MessageEventRes_ChatSettings{
+					ChatSettings: DTOChatSettingsToProto(settingsDTO),
+				},
+			}, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value type for chat_settings: expected ChatSettingsDTO")
+
+	case dtoMessage.WebSocketMessageTypeUserStatus:
+		if statusDTO, ok := wsMsg.Value.(dtoMessage.UserStatusDTO); ok {
+			return &gen.MessageEventRes{
+				ChatId: wsMsg.ChatID.String(),
+				Event: &gen.MessageEventRes_UserStatus{
+					UserStatus: DTOUserStatusToProto(statusDTO),
+				},
+			}, nil
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value type for user_status: expected UserStatusDTO")
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unknown websocket message type: %s", wsMsg.Type)
 	}
 }
 
+// DTOSystemNotificationToProto конвертирует SystemNotificationDTO в protobuf SystemNotification
+func DTOSystemNotificationToProto(notification dtoMessage.SystemNotificationDTO) *gen.SystemNotification {
+	return &gen.SystemNotification{
+		Kind:      notification.Kind,
+		Text:      notification.Text,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ProtoSystemNotificationToDTO конвертирует protobuf SystemNotification в SystemNotificationDTO
+func ProtoSystemNotificationToDTO(notification *gen.SystemNotification) dtoMessage.SystemNotificationDTO {
+	createdAt, _ := time.Parse(time.RFC3339, notification.GetCreatedAt())
+	return dtoMessage.SystemNotificationDTO{
+		Kind:      notification.GetKind(),
+		Text:      notification.GetText(),
+		CreatedAt: createdAt,
+	}
+}
+
+// DTOUserStatusToProto конвертирует UserStatusDTO в protobuf UserStatus
+func DTOUserStatusToProto(userStatus dtoMessage.UserStatusDTO) *gen.UserStatus {
+	res := &gen.UserStatus{
+		UserId: userStatus.UserID.String(),
+		Emoji:  userStatus.Emoji,
+		Text:   userStatus.Text,
+	}
+	if userStatus.ExpiresAt != nil {
+		expiresAt := userStatus.ExpiresAt.Format(time.RFC3339)
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
+
+// ProtoUserStatusToDTO конвертирует protobuf UserStatus в UserStatusDTO
+func ProtoUserStatusToDTO(userStatus *gen.UserStatus) dtoMessage.UserStatusDTO {
+	userID, _ := uuid.Parse(userStatus.GetUserId())
+	res := dtoMessage.UserStatusDTO{
+		UserID: userID,
+		Emoji:  userStatus.GetEmoji(),
+		Text:   userStatus.GetText(),
+	}
+	if userStatus.ExpiresAt != nil {
+		if expiresAt, err := time.Parse(time.RFC3339, userStatus.GetExpiresAt()); err == nil {
+			res.ExpiresAt = &expiresAt
+		}
+	}
+	return res
+}
+
 // ProtoUploadChatAvatarReqToFileData конвертирует proto запрос в FileData для MinIO
 func ProtoUploadChatAvatarReqToFileData(in *gen.UploadChatAvatarReq) minio.FileData {
 	return minio.FileData{
@@ -670,6 +831,50 @@ func ProtoUploadChatAvatarReqToFileData(in *gen.UploadChatAvatarReq) minio.FileD
 	}
 }
 
+// DTOChatAvatarsToProto конвертирует историю аватарок чата в protobuf
+func DTOChatAvatarsToProto(avatars []dtoUtils.AvatarDTO) []*gen.ChatAvatar {
+	result := make([]*gen.ChatAvatar, 0, len(avatars))
+	for _, avatar := range avatars {
+		sizes := make(map[int32]string, len(avatar.Sizes))
+		for size, url := range avatar.Sizes {
+			sizes[int32(size)] = url
+		}
+
+		result = append(result, &gen.ChatAvatar{
+			Id:        avatar.ID.String(),
+			Url:       avatar.URL,
+			Sizes:     sizes,
+			IsCurrent: avatar.IsCurrent,
+			CreatedAt: timestamppb.New(avatar.CreatedAt),
+		})
+	}
+	return result
+}
+
+// ProtoChatAvatarsToDTO конвертирует историю аватарок чата из protobuf
+func ProtoChatAvatarsToDTO(avatars []*gen.ChatAvatar) []dtoUtils.AvatarDTO {
+	result := make([]dtoUtils.AvatarDTO, 0, len(avatars))
+	for _, avatar := range avatars {
+		var sizes map[int]string
+		if len(avatar.GetSizes()) > 0 {
+			sizes = make(map[int]string, len(avatar.GetSizes()))
+			for size, url := range avatar.GetSizes() {
+				sizes[int(size)] = url
+			}
+		}
+
+		id, _ := uuid.Parse(avatar.GetId())
+		result = append(result, dtoUtils.AvatarDTO{
+			ID:        id,
+			URL:       avatar.GetUrl(),
+			Sizes:     sizes,
+			IsCurrent: avatar.GetIsCurrent(),
+			CreatedAt: avatar.GetCreatedAt().AsTime(),
+		})
+	}
+	return result
+}
+
 func ProtoSearchMessagesResToDTO(res *gen.SearchMessagesRes) []dtoMessage.MessageDTO {
 	if res == nil || res.GetMessages() == nil {
 		return []dtoMessage.MessageDTO{}
@@ -714,3 +919,77 @@ func ProtoUploadAttachmentResToDTO(res *gen.UploadAttachmentRes) *dtoMessage.Att
 		Duration: duration,
 	}
 }
+
+// DTOInviteToProto конвертирует InviteDTO в protobuf Invite
+func DTOInviteToProto(invite dtoChats.InviteDTO) *gen.Invite {
+	result := &gen.Invite{
+		Id:               invite.ID.String(),
+		Code:             invite.Code,
+		Name:             invite.Name,
+		CreatorId:        invite.CreatorID.String(),
+		UsageCount:       int32(invite.UsageCount),
+		RequiresApproval: invite.RequiresApproval,
+		IsRevoked:        invite.IsRevoked,
+		CreatedAt:        timestamppb.New(invite.CreatedAt),
+	}
+	if invite.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+	if invite.UsageLimit != nil {
+		usageLimit := int32(*invite.UsageLimit)
+		result.UsageLimit = &usageLimit
+	}
+	return result
+}
+
+// ProtoInviteToDTO конвертирует protobuf Invite в InviteDTO
+func ProtoInviteToDTO(invite *gen.Invite) dtoChats.InviteDTO {
+	inviteID, _ := uuid.Parse(invite.GetId())
+	creatorID, _ := uuid.Parse(invite.GetCreatorId())
+
+	result := dtoChats.InviteDTO{
+		ID:               inviteID,
+		Code:             invite.GetCode(),
+		Name:             invite.GetName(),
+		CreatorID:        creatorID,
+		UsageCount:       int(invite.GetUsageCount()),
+		RequiresApproval: invite.GetRequiresApproval(),
+		IsRevoked:        invite.GetIsRevoked(),
+		CreatedAt:        invite.GetCreatedAt().AsTime(),
+	}
+	if invite.ExpiresAt != nil {
+		expiresAt := invite.GetExpiresAt().AsTime()
+		result.ExpiresAt = &expiresAt
+	}
+	if invite.UsageLimit != nil {
+		usageLimit := int(invite.GetUsageLimit())
+		result.UsageLimit = &usageLimit
+	}
+	return result
+}
+
+// DTOJoinRequestToProto конвертирует JoinRequestDTO в protobuf JoinRequest
+func DTOJoinRequestToProto(request dtoChats.JoinRequestDTO) *gen.JoinRequest {
+	result := &gen.JoinRequest{
+		UserId:    request.UserID.String(),
+		UserName:  request.UserName,
+		CreatedAt: timestamppb.New(request.CreatedAt),
+	}
+	if request.InviteID != nil {
+		inviteID := request.InviteID.String()
+		result.InviteId = &inviteID
+	}
+	return result
+}
+
+// ProtoJoinRequestToDTO конвертирует protobuf JoinRequest в JoinRequestDTO
+func ProtoJoinRequestToDTO(request *gen.JoinRequest) dtoChats.JoinRequestDTO {
+	userID, _ := uuid.Parse(request.GetUserId())
+
+	return dtoChats.JoinRequestDTO{
+		UserID:    userID,
+		UserName:  request.GetUserName(),
+		InviteID:  parseOptionalUUID(request.InviteId),
+		CreatedAt: request.GetCreatedAt().AsTime(),
+	}
+}
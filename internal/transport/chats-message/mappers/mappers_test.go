@@ -6,6 +6,7 @@ import (
 
 	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -518,3 +519,139 @@ func TestDTOMessagesToProtoMessage(t *testing.T) {
 	assert.Equal(t, msgID.String(), result[0].Id)
 	assert.Equal(t, "Test message", result[0].Text)
 }
+
+func TestSystemNotificationEventRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	wsMsg := dtoMessage.WebSocketMessageDTO{
+		Type: dtoMessage.WebSocketMessageTypeSystemNotification,
+		Value: dtoMessage.SystemNotificationDTO{
+			Kind:      dtoMessage.SystemNotificationKindNewDevice,
+			Text:      "Выполнен вход в аккаунт с нового устройства",
+			CreatedAt: createdAt,
+		},
+	}
+
+	protoEvent, err := DTOWebSocketMessageToProtoEventRes(wsMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, dtoMessage.SystemNotificationKindNewDevice, protoEvent.GetSystemNotification().GetKind())
+
+	result := ProtoMessageEventResToDTO(protoEvent)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeSystemNotification, result.Type)
+	assert.Equal(t, wsMsg.Value, result.Value)
+}
+
+func TestMentionEventRoundTrip(t *testing.T) {
+	senderID := uuid.New()
+	senderName := "Sender"
+	createdAt := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	msg := dtoMessage.MessageDTO{
+		ID:         uuid.New(),
+		SenderID:   &senderID,
+		SenderName: &senderName,
+		Text:       "@user привет",
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+		ChatID:     uuid.New(),
+		Type:       "user",
+	}
+	wsMsg := dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeMention,
+		ChatID: msg.ChatID,
+		Value:  msg,
+	}
+
+	protoEvent, err := DTOWebSocketMessageToProtoEventRes(wsMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, msg.Text, protoEvent.GetMention().GetText())
+
+	result := ProtoMessageEventResToDTO(protoEvent)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeMention, result.Type)
+	assert.Equal(t, msg.ChatID, result.ChatID)
+	assert.Equal(t, msg, result.Value)
+}
+
+func TestChatSettingsEventRoundTrip(t *testing.T) {
+	mutedUntil := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	settings := dtoChats.ChatSettingsDTO{
+		Muted:      true,
+		MutedUntil: &mutedUntil,
+		PinOrder:   2,
+		Folder:     "Работа",
+	}
+	wsMsg := dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeChatSettings,
+		ChatID: uuid.New(),
+		Value:  settings,
+	}
+
+	protoEvent, err := DTOWebSocketMessageToProtoEventRes(wsMsg)
+	assert.NoError(t, err)
+	assert.True(t, protoEvent.GetChatSettings().GetMuted())
+	assert.Equal(t, "2025-01-15T10:30:00Z", protoEvent.GetChatSettings().GetMutedUntil())
+
+	result := ProtoMessageEventResToDTO(protoEvent)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeChatSettings, result.Type)
+	assert.Equal(t, wsMsg.ChatID, result.ChatID)
+	assert.Equal(t, settings, result.Value)
+}
+
+func TestUserStatusEventRoundTrip(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	userStatus := dtoMessage.UserStatusDTO{
+		UserID:    uuid.New(),
+		Emoji:     "🌴",
+		Text:      "В отпуске",
+		ExpiresAt: &expiresAt,
+	}
+	wsMsg := dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeUserStatus,
+		ChatID: uuid.New(),
+		Value:  userStatus,
+	}
+
+	protoEvent, err := DTOWebSocketMessageToProtoEventRes(wsMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, userStatus.UserID.String(), protoEvent.GetUserStatus().GetUserId())
+	assert.Equal(t, "2030-01-02T03:04:05Z", protoEvent.GetUserStatus().GetExpiresAt())
+
+	result := ProtoMessageEventResToDTO(protoEvent)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeUserStatus, result.Type)
+	assert.Equal(t, wsMsg.ChatID, result.ChatID)
+	assert.Equal(t, userStatus, result.Value)
+}
+
+func TestProtoMessageEventResToDTO_Muted(t *testing.T) {
+	chatID := uuid.New()
+	event := &gen.MessageEventRes{
+		ChatId: chatID.String(),
+		Event: &gen.MessageEventRes_DeleteChatMessage{
+			DeleteChatMessage: &gen.DeleteMessage{MessageId: uuid.New().String()},
+		},
+		Muted: true,
+	}
+
+	result := ProtoMessageEventResToDTO(event)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeDeleteChatMessage, result.Type)
+	assert.True(t, result.Muted)
+}
+
+func TestChatAvatarsRoundTrip(t *testing.T) {
+	avatars := []dtoUtils.AvatarDTO{
+		{
+			ID:        uuid.New(),
+			URL:       "https://example.com/avatar.jpg",
+			Sizes:     map[int]string{640: "https://example.com/avatar.jpg", 160: "https://example.com/avatar_160.jpg"},
+			IsCurrent: true,
+			CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			ID:        uuid.New(),
+			URL:       "https://example.com/legacy.jpg",
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	result := ProtoChatAvatarsToDTO(DTOChatAvatarsToProto(avatars))
+
+	assert.Equal(t, avatars, result)
+}
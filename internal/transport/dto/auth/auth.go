@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type RegisterRequest struct {
 	PhoneNumber string `json:"phone_number" validate:"required"`
 	Password    string `json:"password" validate:"required,min=6"`
@@ -14,3 +16,19 @@ type LoginRequest struct {
 type AuthResponse struct {
 	CSRFToken string `json:"csrf_token"`
 }
+
+type PhoneChangeRequest struct {
+	NewPhone string `json:"new_phone" validate:"required"`
+}
+
+// PhoneChangeRequested - код отправлен на новый номер
+type PhoneChangeRequested struct {
+	ExpiresAt   time.Time `json:"expires_at"`
+	ResendAfter time.Time `json:"resend_after"`
+}
+
+type PhoneChangeConfirmRequest struct {
+	Code string `json:"code" validate:"required"`
+	// RevokeSessions - завершить все сессии, кроме текущей
+	RevokeSessions bool `json:"revoke_sessions"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"time"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+)
+
+type BlockedUserDTO struct {
+	User      *dto.User `json:"user"`
+	BlockedAt time.Time `json:"blocked_at"`
+}
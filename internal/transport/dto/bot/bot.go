@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Bot struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Username   string    `json:"username"`
+	WebhookURL *string   `json:"webhook_url,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type CreateBotRequest struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+// CreatedBot содержит токен бота, который возвращается только при создании
+type CreatedBot struct {
+	Bot
+	Token string `json:"token"`
+}
+
+type SetWebhookRequest struct {
+	URL string `json:"url"`
+}
+
+// WebhookSecret содержит ключ, которым подписываются запросы к webhook бота
+type WebhookSecret struct {
+	Secret string `json:"secret,omitempty"`
+}
+
+type SendMessageRequest struct {
+	ChatID uuid.UUID `json:"chat_id"`
+	Text   string    `json:"text"`
+}
@@ -1,15 +1,42 @@
 package dto
 
 import (
+	"time"
+
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
 	"github.com/google/uuid"
 )
 
 type ChatViewInformationDTO struct {
-	ID          uuid.UUID      `json:"id" swaggertype:"string" format:"uuid"`
-	Name        string         `json:"name"`
-	LastMessage dto.MessageDTO `json:"last_message" swaggertype:"object"`
-	Type        string         `json:"type"`
+	ID          uuid.UUID       `json:"id" swaggertype:"string" format:"uuid"`
+	Name        string          `json:"name"`
+	LastMessage dto.MessageDTO  `json:"last_message" swaggertype:"object"`
+	Type        string          `json:"type"`
+	Settings    ChatSettingsDTO `json:"settings"`
+}
+
+// ChatSettingsDTO персональные настройки чата текущего пользователя
+type ChatSettingsDTO struct {
+	Muted      bool       `json:"muted"`
+	MutedUntil *time.Time `json:"muted_until,omitempty" swaggertype:"string" format:"date-time"` // Отсутствует, если чат заглушён навсегда
+	Archived   bool       `json:"archived"`
+	PinOrder   int        `json:"pin_order"` // Позиция среди закреплённых, 0 - чат не закреплён
+	Folder     string     `json:"folder"`
+}
+
+// ChatSettingsUpdateDTO частичное изменение настроек чата: незаданные поля остаются прежними
+type ChatSettingsUpdateDTO struct {
+	Muted      *bool      `json:"muted,omitempty"`
+	MutedUntil *time.Time `json:"muted_until,omitempty" swaggertype:"string" format:"date-time"` // Заглушить до указанного момента
+	Archived   *bool      `json:"archived,omitempty"`
+	PinOrder   *int       `json:"pin_order,omitempty"` // 0 - открепить чат
+	Folder     *string    `json:"folder,omitempty"`    // Пустая строка - убрать чат из папки
+}
+
+// ChatsFilterDTO фильтр списка чатов; nil-поля выборку не ограничивают
+type ChatsFilterDTO struct {
+	Archived *bool
+	Folder   *string
 }
 
 type ChatDetailedInformationDTO struct {
@@ -50,3 +77,56 @@ type ChatUpdateDTO struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 }
+
+// ChatUsernameDTO публичный handle группы или канала; пустая строка делает чат приватным
+type ChatUsernameDTO struct {
+	Username string `json:"username"`
+}
+
+// PublicChatDTO группа или канал, найденные по публичному handle
+type PublicChatDTO struct {
+	ID           uuid.UUID `json:"id" swaggertype:"string" format:"uuid"`
+	Type         string    `json:"type"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Username     string    `json:"username"`
+	MembersCount int       `json:"members_count"`
+	IsMember     bool      `json:"is_member"`
+}
+
+// InviteCreateDTO параметры новой пригласительной ссылки; nil-поля - без ограничений
+type InviteCreateDTO struct {
+	Name             string     `json:"name"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	UsageLimit       *int       `json:"usage_limit,omitempty"`
+	RequiresApproval bool       `json:"requires_approval"` // Вступление только после одобрения админом
+}
+
+// InviteDTO пригласительная ссылка в группу или канал
+type InviteDTO struct {
+	ID               uuid.UUID  `json:"id" swaggertype:"string" format:"uuid"`
+	Code             string     `json:"code"`
+	Name             string     `json:"name"`
+	CreatorID        uuid.UUID  `json:"creator_id" swaggertype:"string" format:"uuid"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	UsageLimit       *int       `json:"usage_limit,omitempty"`
+	UsageCount       int        `json:"usage_count"`
+	RequiresApproval bool       `json:"requires_approval"`
+	IsRevoked        bool       `json:"is_revoked"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// JoinByInviteDTO итог вступления по ссылке; Pending - заявка ждёт одобрения админа
+type JoinByInviteDTO struct {
+	ChatID  uuid.UUID `json:"chat_id" swaggertype:"string" format:"uuid"`
+	Role    string    `json:"role,omitempty"`
+	Pending bool      `json:"pending"`
+}
+
+// JoinRequestDTO заявка на вступление в чат
+type JoinRequestDTO struct {
+	UserID    uuid.UUID  `json:"user_id" swaggertype:"string" format:"uuid"`
+	UserName  string     `json:"user_name"`
+	InviteID  *uuid.UUID `json:"invite_id,omitempty" swaggertype:"string" format:"uuid"`
+	CreatedAt time.Time  `json:"created_at"`
+}
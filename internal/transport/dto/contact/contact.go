@@ -21,6 +21,30 @@ type PostContactDTO struct {
 type GetContactsDTO struct {
 	UserID      uuid.UUID `json:"user_id"`
 	ContactUser *dto.User `json:"contact"`
+	Alias       *string   `json:"alias,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"update_at"`
 }
+
+// UpdateContactAliasDTO задаёт имя контакта; пустое или отсутствующее значение сбрасывает его
+type UpdateContactAliasDTO struct {
+	Alias *string `json:"alias"`
+}
+
+// ImportContactsDTO номера из адресной книги устройства: как есть или SHA-256 нормализованного номера в hex
+type ImportContactsDTO struct {
+	PhoneNumbers []string `json:"phone_numbers"`
+	PhoneHashes  []string `json:"phone_hashes"`
+}
+
+type ImportedContactDTO struct {
+	User      *dto.User `json:"user"`
+	PhoneHash string    `json:"phone_hash"`
+	Added     bool      `json:"added"`
+}
+
+type ImportContactsResultDTO struct {
+	Found   []*ImportedContactDTO `json:"found"`
+	Added   int                   `json:"added"`
+	Invalid int                   `json:"invalid"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+const (
+	StatusOK       = "ok"
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+)
+
+// HealthDTO ответ проб живости и готовности шлюза
+type HealthDTO struct {
+	Status string `json:"status"`
+	// Services - состояние бэкендов по данным grpc.health.v1 (SERVING, NOT_SERVING, UNKNOWN, UNAVAILABLE)
+	Services map[string]string `json:"services,omitempty"`
+}
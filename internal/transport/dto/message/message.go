@@ -40,15 +40,45 @@ type UserJoinedDTO struct {
 	ChatID uuid.UUID `json:"chat_id" swaggertype:"string" format:"uuid"`
 }
 
+// SystemNotificationDTO служебное уведомление пользователю, не привязанное к чату
+type SystemNotificationDTO struct {
+	Kind      string    `json:"kind"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at" swaggertype:"string" format:"date-time"`
+}
+
+// UserStatusDTO новый статус собеседника по диалогу; пустые эмодзи и текст - статус сброшен
+type UserStatusDTO struct {
+	UserID    uuid.UUID  `json:"user_id" swaggertype:"string" format:"uuid"`
+	Emoji     string     `json:"emoji,omitempty"`
+	Text      string     `json:"text,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" swaggertype:"string" format:"date-time"`
+}
+
+const (
+	SystemNotificationKindNewDevice = "new_device"
+	// SystemNotificationKindServerShutdown отправляется перед закрытием потока при остановке сервера, клиенту нужно переподключиться
+	SystemNotificationKindServerShutdown = "server_shutdown"
+	// SystemNotificationKindContactJoined отправляется, когда зарегистрировался человек из адресной книги пользователя
+	SystemNotificationKindContactJoined = "contact_joined"
+)
+
 const (
-	WebSocketMessageTypeNewChatMessage    = "new_message"
-	WebSocketMessageTypeEditChatMessage   = "edit_message"
-	WebSocketMessageTypeDeleteChatMessage = "delete_message"
-	WebSocketMessageTypeCreatedNewChat    = "chat_created"
+	WebSocketMessageTypeNewChatMessage     = "new_message"
+	WebSocketMessageTypeEditChatMessage    = "edit_message"
+	WebSocketMessageTypeDeleteChatMessage  = "delete_message"
+	WebSocketMessageTypeCreatedNewChat     = "chat_created"
+	WebSocketMessageTypeSystemNotification = "system_notification"
+	WebSocketMessageTypeMention            = "mention"
+	WebSocketMessageTypeChatSettings       = "chat_settings"
+	WebSocketMessageTypeUserStatus         = "user_status"
 )
 
 type WebSocketMessageDTO struct {
 	Type   string    `json:"type"`
 	ChatID uuid.UUID `json:"chat_id"`
 	Value  any       `json:"value"`
+	Muted  bool      `json:"muted,omitempty"` // Чат заглушён получателем: клиенту не нужно показывать уведомление
+	// EnqueuedAt - момент постановки события в очередь рассылки, по нему считается задержка доставки
+	EnqueuedAt time.Time `json:"-"`
 }
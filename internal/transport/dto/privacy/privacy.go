@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type PrivacyRuleDTO struct {
+	Setting      string      `json:"setting" example:"phone_number"`
+	Visibility   string      `json:"visibility" example:"contacts"`
+	AllowUserIDs []uuid.UUID `json:"allow_user_ids" swaggertype:"array,string"`
+	DenyUserIDs  []uuid.UUID `json:"deny_user_ids" swaggertype:"array,string"`
+}
+
+type UpdatePrivacyRuleDTO struct {
+	Visibility   string      `json:"visibility" example:"contacts"`
+	AllowUserIDs []uuid.UUID `json:"allow_user_ids,omitempty" swaggertype:"array,string"`
+	DenyUserIDs  []uuid.UUID `json:"deny_user_ids,omitempty" swaggertype:"array,string"`
+}
@@ -10,6 +10,11 @@ type Session struct {
 	ID         uuid.UUID `json:"id"`
 	UserID     uuid.UUID `json:"-"`
 	Device     string    `json:"device"`
+	IP         string    `json:"ip,omitempty"`
+	OS         string    `json:"os,omitempty"`
+	Browser    string    `json:"browser,omitempty"`
+	AppVersion string    `json:"app_version,omitempty"`
+	Location   string    `json:"location,omitempty"`
 	Created_at time.Time `json:"created_at"`
 	Last_seen  time.Time `json:"last_seen"`
 }
@@ -17,3 +22,10 @@ type Session struct {
 type DeleteSession struct {
 	ID uuid.UUID `json:"id"`
 }
+
+type PushToken struct {
+	// Platform - fcm, apns или webpush
+	Platform string `json:"platform"`
+	// Token - токен устройства, для webpush JSON подписки браузера
+	Token string `json:"token"`
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	"github.com/google/uuid"
 )
 
@@ -16,6 +17,27 @@ type User struct {
 	AccountType  string    `json:"account_type"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	// Status отсутствует, если статус не задан или истёк
+	Status *StatusDTO `json:"status,omitempty"`
+	// Birthday в формате YYYY-MM-DD; отсутствует, если не задан или скрыт настройками приватности
+	Birthday *string   `json:"birthday,omitempty" example:"1995-03-14"`
+	Links    []LinkDTO `json:"links,omitempty"`
+	// LastSeenAt отсутствует, если пользователь не заходил или скрыл время настройками приватности
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty" swaggertype:"string" format:"date-time"`
+}
+
+// StatusDTO - статус пользователя: эмодзи и/или текст
+type StatusDTO struct {
+	Emoji string `json:"emoji,omitempty"`
+	Text  string `json:"text,omitempty"`
+	// ExpiresAt - момент, после которого статус не показывается; отсутствует у бессрочного статуса
+	ExpiresAt *time.Time `json:"expires_at,omitempty" swaggertype:"string" format:"date-time"`
+}
+
+// LinkDTO - ссылка в профиле
+type LinkDTO struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
 }
 
 type GetUserByPhone struct {
@@ -30,4 +52,28 @@ type UpdateUserInfo struct {
 	Name     *string `json:"name,omitempty"`
 	Username *string `json:"username,omitempty"`
 	Bio      *string `json:"bio,omitempty"`
+	// Status с пустыми emoji и text сбрасывает статус
+	Status *StatusDTO `json:"status,omitempty"`
+	// Birthday в формате YYYY-MM-DD; пустая строка удаляет день рождения
+	Birthday *string `json:"birthday,omitempty" example:"1995-03-14"`
+	// Links заменяет ссылки целиком; пустой список удаляет все
+	Links *[]LinkDTO `json:"links,omitempty"`
+}
+
+// SearchUsersResult - страница глобального поиска людей
+type SearchUsersResult struct {
+	Users []*User `json:"users"`
+	Total int     `json:"total"` // число совпадений до исключения заблокированных
+}
+
+// ResolvedUser - тип результата для пользователя; для группы и канала тип совпадает с типом чата
+const ResolvedUser = "user"
+
+// ResolveResult - владелец публичного handle: пользователь (в том числе бот), группа или канал
+type ResolveResult struct {
+	Type string `json:"type" enums:"user,group,channel"`
+	// Redirected - handle прежний: владелец сменил его, но ссылка ещё действует
+	Redirected bool                    `json:"redirected"`
+	User       *User                   `json:"user,omitempty"`
+	Chat       *dtoChats.PublicChatDTO `json:"chat,omitempty" swaggertype:"object"`
 }
@@ -1,5 +1,11 @@
 package dto
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 // GetAvatarsRequest запрос для получения аватарок по списку ID
 type GetAvatarsRequest struct {
 	IDs []string `json:"ids" binding:"required"`
@@ -10,6 +16,20 @@ type GetAvatarsResponse struct {
 	Avatars map[string]*string `json:"avatars"` // map[id]url
 }
 
+// AvatarDTO аватарка из истории пользователя или чата
+type AvatarDTO struct {
+	ID        uuid.UUID      `json:"id" swaggertype:"string" format:"uuid"`
+	URL       string         `json:"url"`
+	Sizes     map[int]string `json:"sizes,omitempty"` // сторона квадрата в пикселях -> url
+	IsCurrent bool           `json:"is_current"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// GetAvatarHistoryResponse ответ со списком аватарок, начиная с текущей
+type GetAvatarHistoryResponse struct {
+	Avatars []AvatarDTO `json:"avatars"`
+}
+
 func StringMapToPointerMap(m map[string]string) map[string]*string {
 	if m == nil {
 		return nil
@@ -16,6 +16,8 @@ type ValidationErrorsDTO struct {
 
 type ErrorDTO struct {
 	Message string `json:"message"`
+	// RequestID - идентификатор запроса для поиска по логам всех сервисов
+	RequestID string `json:"request_id,omitempty"`
 }
 
 type IdDTO struct {
@@ -22,6 +22,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ############### ClientInfo ###############
+type ClientInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Os            string                 `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	Browser       string                 `protobuf:"bytes,3,opt,name=browser,proto3" json:"browser,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,4,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
+	mi := &file_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInfo) ProtoMessage() {}
+
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *ClientInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
 // ############### Register ###############
 type RegisterReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -29,13 +98,14 @@ type RegisterReq struct {
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	ClientInfo    *ClientInfo            `protobuf:"bytes,5,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterReq) Reset() {
 	*x = RegisterReq{}
-	mi := &file_auth_proto_msgTypes[0]
+	mi := &file_auth_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47,7 +117,7 @@ func (x *RegisterReq) String() string {
 func (*RegisterReq) ProtoMessage() {}
 
 func (x *RegisterReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[0]
+	mi := &file_auth_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +130,7 @@ func (x *RegisterReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
 func (*RegisterReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{0}
+	return file_auth_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *RegisterReq) GetPhoneNumber() string {
@@ -91,6 +161,13 @@ func (x *RegisterReq) GetDevice() string {
 	return ""
 }
 
+func (x *RegisterReq) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
 type RegisterRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -101,7 +178,7 @@ type RegisterRes struct {
 
 func (x *RegisterRes) Reset() {
 	*x = RegisterRes{}
-	mi := &file_auth_proto_msgTypes[1]
+	mi := &file_auth_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -113,7 +190,7 @@ func (x *RegisterRes) String() string {
 func (*RegisterRes) ProtoMessage() {}
 
 func (x *RegisterRes) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[1]
+	mi := &file_auth_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +203,7 @@ func (x *RegisterRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRes.ProtoReflect.Descriptor instead.
 func (*RegisterRes) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{1}
+	return file_auth_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RegisterRes) GetSessionId() string {
@@ -149,13 +226,14 @@ type LoginReq struct {
 	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Device        string                 `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	ClientInfo    *ClientInfo            `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginReq) Reset() {
 	*x = LoginReq{}
-	mi := &file_auth_proto_msgTypes[2]
+	mi := &file_auth_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -167,7 +245,7 @@ func (x *LoginReq) String() string {
 func (*LoginReq) ProtoMessage() {}
 
 func (x *LoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[2]
+	mi := &file_auth_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +258,7 @@ func (x *LoginReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
 func (*LoginReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{2}
+	return file_auth_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *LoginReq) GetPhoneNumber() string {
@@ -204,6 +282,13 @@ func (x *LoginReq) GetDevice() string {
 	return ""
 }
 
+func (x *LoginReq) GetClientInfo() *ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
 type LoginRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -214,7 +299,7 @@ type LoginRes struct {
 
 func (x *LoginRes) Reset() {
 	*x = LoginRes{}
-	mi := &file_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +311,7 @@ func (x *LoginRes) String() string {
 func (*LoginRes) ProtoMessage() {}
 
 func (x *LoginRes) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[3]
+	mi := &file_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +324,7 @@ func (x *LoginRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRes.ProtoReflect.Descriptor instead.
 func (*LoginRes) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{3}
+	return file_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginRes) GetSessionId() string {
@@ -266,7 +351,7 @@ type LogoutReq struct {
 
 func (x *LogoutReq) Reset() {
 	*x = LogoutReq{}
-	mi := &file_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +363,7 @@ func (x *LogoutReq) String() string {
 func (*LogoutReq) ProtoMessage() {}
 
 func (x *LogoutReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +376,7 @@ func (x *LogoutReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
 func (*LogoutReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LogoutReq) GetSessionId() string {
@@ -311,7 +396,7 @@ type ValidateSessionReq struct {
 
 func (x *ValidateSessionReq) Reset() {
 	*x = ValidateSessionReq{}
-	mi := &file_auth_proto_msgTypes[5]
+	mi := &file_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +408,7 @@ func (x *ValidateSessionReq) String() string {
 func (*ValidateSessionReq) ProtoMessage() {}
 
 func (x *ValidateSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[5]
+	mi := &file_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +421,7 @@ func (x *ValidateSessionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateSessionReq.ProtoReflect.Descriptor instead.
 func (*ValidateSessionReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidateSessionReq) GetSessionId() string {
@@ -356,7 +441,7 @@ type ValidateSessionRes struct {
 
 func (x *ValidateSessionRes) Reset() {
 	*x = ValidateSessionRes{}
-	mi := &file_auth_proto_msgTypes[6]
+	mi := &file_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +453,7 @@ func (x *ValidateSessionRes) String() string {
 func (*ValidateSessionRes) ProtoMessage() {}
 
 func (x *ValidateSessionRes) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[6]
+	mi := &file_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +466,7 @@ func (x *ValidateSessionRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateSessionRes.ProtoReflect.Descriptor instead.
 func (*ValidateSessionRes) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidateSessionRes) GetValid() bool {
@@ -408,7 +493,7 @@ type GetSessionsByUserIDReq struct {
 
 func (x *GetSessionsByUserIDReq) Reset() {
 	*x = GetSessionsByUserIDReq{}
-	mi := &file_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +505,7 @@ func (x *GetSessionsByUserIDReq) String() string {
 func (*GetSessionsByUserIDReq) ProtoMessage() {}
 
 func (x *GetSessionsByUserIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +518,7 @@ func (x *GetSessionsByUserIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionsByUserIDReq.ProtoReflect.Descriptor instead.
 func (*GetSessionsByUserIDReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSessionsByUserIDReq) GetUserId() string {
@@ -450,13 +535,18 @@ type Session struct {
 	Device        string                 `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastSeen      string                 `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	Os            string                 `protobuf:"bytes,7,opt,name=os,proto3" json:"os,omitempty"`
+	Browser       string                 `protobuf:"bytes,8,opt,name=browser,proto3" json:"browser,omitempty"`
+	AppVersion    string                 `protobuf:"bytes,9,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Location      string                 `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
 	*x = Session{}
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +558,7 @@ func (x *Session) String() string {
 func (*Session) ProtoMessage() {}
 
 func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +571,7 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Session.ProtoReflect.Descriptor instead.
 func (*Session) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Session) GetId() string {
@@ -519,6 +609,41 @@ func (x *Session) GetLastSeen() string {
 	return ""
 }
 
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *Session) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *Session) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *Session) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
 type GetSessionsByUserIDRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
@@ -528,7 +653,7 @@ type GetSessionsByUserIDRes struct {
 
 func (x *GetSessionsByUserIDRes) Reset() {
 	*x = GetSessionsByUserIDRes{}
-	mi := &file_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +665,7 @@ func (x *GetSessionsByUserIDRes) String() string {
 func (*GetSessionsByUserIDRes) ProtoMessage() {}
 
 func (x *GetSessionsByUserIDRes) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +678,7 @@ func (x *GetSessionsByUserIDRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionsByUserIDRes.ProtoReflect.Descriptor instead.
 func (*GetSessionsByUserIDRes) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSessionsByUserIDRes) GetSessions() []*Session {
@@ -574,7 +699,7 @@ type DeleteSessionReq struct {
 
 func (x *DeleteSessionReq) Reset() {
 	*x = DeleteSessionReq{}
-	mi := &file_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +711,7 @@ func (x *DeleteSessionReq) String() string {
 func (*DeleteSessionReq) ProtoMessage() {}
 
 func (x *DeleteSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +724,7 @@ func (x *DeleteSessionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSessionReq.ProtoReflect.Descriptor instead.
 func (*DeleteSessionReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteSessionReq) GetUserId() string {
@@ -627,7 +752,7 @@ type DeleteAllSessionsExceptCurrentReq struct {
 
 func (x *DeleteAllSessionsExceptCurrentReq) Reset() {
 	*x = DeleteAllSessionsExceptCurrentReq{}
-	mi := &file_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +764,7 @@ func (x *DeleteAllSessionsExceptCurrentReq) String() string {
 func (*DeleteAllSessionsExceptCurrentReq) ProtoMessage() {}
 
 func (x *DeleteAllSessionsExceptCurrentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +777,7 @@ func (x *DeleteAllSessionsExceptCurrentReq) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteAllSessionsExceptCurrentReq.ProtoReflect.Descriptor instead.
 func (*DeleteAllSessionsExceptCurrentReq) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteAllSessionsExceptCurrentReq) GetUserId() string {
@@ -669,66 +794,1737 @@ func (x *DeleteAllSessionsExceptCurrentReq) GetCurrentSessionId() string {
 	return ""
 }
 
-var File_auth_proto protoreflect.FileDescriptor
+// ############### PhoneChange ###############
+type RequestPhoneChangeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewPhone      string                 `protobuf:"bytes,2,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_auth_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\"x\n" +
-	"\vRegisterReq\x12!\n" +
-	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
-	"\x06device\x18\x04 \x01(\tR\x06device\"K\n" +
-	"\vRegisterRes\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
-	"\n" +
-	"csrf_token\x18\x02 \x01(\tR\tcsrfToken\"a\n" +
-	"\bLoginReq\x12!\n" +
-	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06device\x18\x03 \x01(\tR\x06device\"H\n" +
-	"\bLoginRes\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
-	"\n" +
-	"csrf_token\x18\x02 \x01(\tR\tcsrfToken\"*\n" +
-	"\tLogoutReq\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"3\n" +
-	"\x12ValidateSessionReq\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"C\n" +
-	"\x12ValidateSessionRes\x12\x14\n" +
-	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
-	"\x16GetSessionsByUserIDReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x86\x01\n" +
-	"\aSession\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06device\x18\x03 \x01(\tR\x06device\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
-	"\tlast_seen\x18\x05 \x01(\tR\blastSeen\"C\n" +
-	"\x16GetSessionsByUserIDRes\x12)\n" +
-	"\bsessions\x18\x01 \x03(\v2\r.auth.SessionR\bsessions\"J\n" +
-	"\x10DeleteSessionReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x02 \x01(\tR\tsessionId\"j\n" +
-	"!DeleteAllSessionsExceptCurrentReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
-	"\x12current_session_id\x18\x02 \x01(\tR\x10currentSessionId2\xd9\x03\n" +
-	"\vAuthService\x120\n" +
-	"\bRegister\x12\x11.auth.RegisterReq\x1a\x11.auth.RegisterRes\x12'\n" +
-	"\x05Login\x12\x0e.auth.LoginReq\x1a\x0e.auth.LoginRes\x121\n" +
-	"\x06Logout\x12\x0f.auth.LogoutReq\x1a\x16.google.protobuf.Empty\x12E\n" +
-	"\x0fValidateSession\x12\x18.auth.ValidateSessionReq\x1a\x18.auth.ValidateSessionRes\x12Q\n" +
-	"\x13GetSessionsByUserID\x12\x1c.auth.GetSessionsByUserIDReq\x1a\x1c.auth.GetSessionsByUserIDRes\x12?\n" +
-	"\rDeleteSession\x12\x16.auth.DeleteSessionReq\x1a\x16.google.protobuf.Empty\x12a\n" +
-	"\x1eDeleteAllSessionsExceptCurrent\x12'.auth.DeleteAllSessionsExceptCurrentReq\x1a\x16.google.protobuf.EmptyBOZMgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/authb\x06proto3"
+func (x *RequestPhoneChangeReq) Reset() {
+	*x = RequestPhoneChangeReq{}
+	mi := &file_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneChangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneChangeReq) ProtoMessage() {}
+
+func (x *RequestPhoneChangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneChangeReq.ProtoReflect.Descriptor instead.
+func (*RequestPhoneChangeReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequestPhoneChangeReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestPhoneChangeReq) GetNewPhone() string {
+	if x != nil {
+		return x.NewPhone
+	}
+	return ""
+}
+
+type RequestPhoneChangeRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt     string                 `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ResendAfter   string                 `protobuf:"bytes,2,opt,name=resend_after,json=resendAfter,proto3" json:"resend_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPhoneChangeRes) Reset() {
+	*x = RequestPhoneChangeRes{}
+	mi := &file_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneChangeRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneChangeRes) ProtoMessage() {}
+
+func (x *RequestPhoneChangeRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneChangeRes.ProtoReflect.Descriptor instead.
+func (*RequestPhoneChangeRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPhoneChangeRes) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *RequestPhoneChangeRes) GetResendAfter() string {
+	if x != nil {
+		return x.ResendAfter
+	}
+	return ""
+}
+
+type ConfirmPhoneChangeReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	RevokeSessions bool                   `protobuf:"varint,4,opt,name=revoke_sessions,json=revokeSessions,proto3" json:"revoke_sessions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneChangeReq) Reset() {
+	*x = ConfirmPhoneChangeReq{}
+	mi := &file_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneChangeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneChangeReq) ProtoMessage() {}
+
+func (x *ConfirmPhoneChangeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneChangeReq.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneChangeReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfirmPhoneChangeReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneChangeReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneChangeReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneChangeReq) GetRevokeSessions() bool {
+	if x != nil {
+		return x.RevokeSessions
+	}
+	return false
+}
+
+// ############### PushToken ###############
+type RegisterPushTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterPushTokenReq) Reset() {
+	*x = RegisterPushTokenReq{}
+	mi := &file_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterPushTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterPushTokenReq) ProtoMessage() {}
+
+func (x *RegisterPushTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterPushTokenReq.ProtoReflect.Descriptor instead.
+func (*RegisterPushTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegisterPushTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenReq) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *RegisterPushTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterPushTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterPushTokenReq) Reset() {
+	*x = UnregisterPushTokenReq{}
+	mi := &file_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterPushTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterPushTokenReq) ProtoMessage() {}
+
+func (x *UnregisterPushTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterPushTokenReq.ProtoReflect.Descriptor instead.
+func (*UnregisterPushTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnregisterPushTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnregisterPushTokenReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type PushTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushTarget) Reset() {
+	*x = PushTarget{}
+	mi := &file_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTarget) ProtoMessage() {}
+
+func (x *PushTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTarget.ProtoReflect.Descriptor instead.
+func (*PushTarget) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PushTarget) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PushTarget) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PushTarget) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PushTarget) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetPushTargetsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushTargetsReq) Reset() {
+	*x = GetPushTargetsReq{}
+	mi := &file_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTargetsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTargetsReq) ProtoMessage() {}
+
+func (x *GetPushTargetsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTargetsReq.ProtoReflect.Descriptor instead.
+func (*GetPushTargetsReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPushTargetsReq) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPushTargetsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*PushTarget          `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushTargetsRes) Reset() {
+	*x = GetPushTargetsRes{}
+	mi := &file_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushTargetsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushTargetsRes) ProtoMessage() {}
+
+func (x *GetPushTargetsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushTargetsRes.ProtoReflect.Descriptor instead.
+func (*GetPushTargetsRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPushTargetsRes) GetTargets() []*PushTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type DropPushTokensReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionIds    []string               `protobuf:"bytes,1,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropPushTokensReq) Reset() {
+	*x = DropPushTokensReq{}
+	mi := &file_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropPushTokensReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropPushTokensReq) ProtoMessage() {}
+
+func (x *DropPushTokensReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropPushTokensReq.ProtoReflect.Descriptor instead.
+func (*DropPushTokensReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DropPushTokensReq) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+// ############### PersonalAccessToken ###############
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Token) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Token) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *Token) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Token) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *Token) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresInDays int32                  `protobuf:"varint,4,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenReq) Reset() {
+	*x = CreateTokenReq{}
+	mi := &file_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenReq) ProtoMessage() {}
+
+func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenReq.ProtoReflect.Descriptor instead.
+func (*CreateTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateTokenReq) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+type CreateTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenRes) Reset() {
+	*x = CreateTokenRes{}
+	mi := &file_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenRes) ProtoMessage() {}
+
+func (x *CreateTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenRes.ProtoReflect.Descriptor instead.
+func (*CreateTokenRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateTokenRes) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateTokenRes) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListTokensReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensReq) Reset() {
+	*x = ListTokensReq{}
+	mi := &file_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensReq) ProtoMessage() {}
+
+func (x *ListTokensReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensReq.ProtoReflect.Descriptor instead.
+func (*ListTokensReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTokensReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTokensRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*Token               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensRes) Reset() {
+	*x = ListTokensRes{}
+	mi := &file_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensRes) ProtoMessage() {}
+
+func (x *ListTokensRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensRes.ProtoReflect.Descriptor instead.
+func (*ListTokensRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTokensRes) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenReq) Reset() {
+	*x = RevokeTokenReq{}
+	mi := &file_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenReq) ProtoMessage() {}
+
+func (x *RevokeTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenReq.ProtoReflect.Descriptor instead.
+func (*RevokeTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type ValidateTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenReq) Reset() {
+	*x = ValidateTokenReq{}
+	mi := &file_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenReq) ProtoMessage() {}
+
+func (x *ValidateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenReq.ProtoReflect.Descriptor instead.
+func (*ValidateTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ValidateTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ValidateTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	AccountType   string                 `protobuf:"bytes,4,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenRes) Reset() {
+	*x = ValidateTokenRes{}
+	mi := &file_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenRes) ProtoMessage() {}
+
+func (x *ValidateTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenRes.ProtoReflect.Descriptor instead.
+func (*ValidateTokenRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ValidateTokenRes) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateTokenRes) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateTokenRes) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ValidateTokenRes) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+// ############### Bot ###############
+type Bot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	WebhookUrl    string                 `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bot) Reset() {
+	*x = Bot{}
+	mi := &file_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bot) ProtoMessage() {}
+
+func (x *Bot) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bot.ProtoReflect.Descriptor instead.
+func (*Bot) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Bot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bot) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Bot) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *Bot) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateBotReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBotReq) Reset() {
+	*x = CreateBotReq{}
+	mi := &file_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotReq) ProtoMessage() {}
+
+func (x *CreateBotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotReq.ProtoReflect.Descriptor instead.
+func (*CreateBotReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateBotReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateBotReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBotReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateBotRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bot           *Bot                   `protobuf:"bytes,1,opt,name=bot,proto3" json:"bot,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBotRes) Reset() {
+	*x = CreateBotRes{}
+	mi := &file_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBotRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotRes) ProtoMessage() {}
+
+func (x *CreateBotRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotRes.ProtoReflect.Descriptor instead.
+func (*CreateBotRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateBotRes) GetBot() *Bot {
+	if x != nil {
+		return x.Bot
+	}
+	return nil
+}
+
+func (x *CreateBotRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListBotsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBotsReq) Reset() {
+	*x = ListBotsReq{}
+	mi := &file_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBotsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBotsReq) ProtoMessage() {}
+
+func (x *ListBotsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBotsReq.ProtoReflect.Descriptor instead.
+func (*ListBotsReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBotsReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ListBotsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bots          []*Bot                 `protobuf:"bytes,1,rep,name=bots,proto3" json:"bots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBotsRes) Reset() {
+	*x = ListBotsRes{}
+	mi := &file_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBotsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBotsRes) ProtoMessage() {}
+
+func (x *ListBotsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBotsRes.ProtoReflect.Descriptor instead.
+func (*ListBotsRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListBotsRes) GetBots() []*Bot {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+type DeleteBotReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BotId         string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBotReq) Reset() {
+	*x = DeleteBotReq{}
+	mi := &file_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBotReq) ProtoMessage() {}
+
+func (x *DeleteBotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBotReq.ProtoReflect.Descriptor instead.
+func (*DeleteBotReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteBotReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *DeleteBotReq) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type SetBotWebhookReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BotId         string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBotWebhookReq) Reset() {
+	*x = SetBotWebhookReq{}
+	mi := &file_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBotWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBotWebhookReq) ProtoMessage() {}
+
+func (x *SetBotWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBotWebhookReq.ProtoReflect.Descriptor instead.
+func (*SetBotWebhookReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetBotWebhookReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetBotWebhookReq) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *SetBotWebhookReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SetBotWebhookRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBotWebhookRes) Reset() {
+	*x = SetBotWebhookRes{}
+	mi := &file_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBotWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBotWebhookRes) ProtoMessage() {}
+
+func (x *SetBotWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBotWebhookRes.ProtoReflect.Descriptor instead.
+func (*SetBotWebhookRes) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetBotWebhookRes) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateBotTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BotId         string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresInDays int32                  `protobuf:"varint,5,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBotTokenReq) Reset() {
+	*x = CreateBotTokenReq{}
+	mi := &file_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBotTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBotTokenReq) ProtoMessage() {}
+
+func (x *CreateBotTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBotTokenReq.ProtoReflect.Descriptor instead.
+func (*CreateBotTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateBotTokenReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateBotTokenReq) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *CreateBotTokenReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBotTokenReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateBotTokenReq) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+type RevokeBotTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BotId         string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	TokenId       string                 `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeBotTokenReq) Reset() {
+	*x = RevokeBotTokenReq{}
+	mi := &file_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeBotTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeBotTokenReq) ProtoMessage() {}
+
+func (x *RevokeBotTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeBotTokenReq.ProtoReflect.Descriptor instead.
+func (*RevokeBotTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevokeBotTokenReq) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RevokeBotTokenReq) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *RevokeBotTokenReq) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+var File_auth_proto protoreflect.FileDescriptor
+
+const file_auth_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"auth.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\"g\n" +
+	"\n" +
+	"ClientInfo\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x0e\n" +
+	"\x02os\x18\x02 \x01(\tR\x02os\x12\x18\n" +
+	"\abrowser\x18\x03 \x01(\tR\abrowser\x12\x1f\n" +
+	"\vapp_version\x18\x04 \x01(\tR\n" +
+	"appVersion\"\xab\x01\n" +
+	"\vRegisterReq\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x121\n" +
+	"\vclient_info\x18\x05 \x01(\v2\x10.auth.ClientInfoR\n" +
+	"clientInfo\"K\n" +
+	"\vRegisterRes\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"csrf_token\x18\x02 \x01(\tR\tcsrfToken\"\x94\x01\n" +
+	"\bLoginReq\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\x121\n" +
+	"\vclient_info\x18\x04 \x01(\v2\x10.auth.ClientInfoR\n" +
+	"clientInfo\"H\n" +
+	"\bLoginRes\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"csrf_token\x18\x02 \x01(\tR\tcsrfToken\"*\n" +
+	"\tLogoutReq\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"3\n" +
+	"\x12ValidateSessionReq\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"C\n" +
+	"\x12ValidateSessionRes\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
+	"\x16GetSessionsByUserIDReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xfd\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tlast_seen\x18\x05 \x01(\tR\blastSeen\x12\x0e\n" +
+	"\x02ip\x18\x06 \x01(\tR\x02ip\x12\x0e\n" +
+	"\x02os\x18\a \x01(\tR\x02os\x12\x18\n" +
+	"\abrowser\x18\b \x01(\tR\abrowser\x12\x1f\n" +
+	"\vapp_version\x18\t \x01(\tR\n" +
+	"appVersion\x12\x1a\n" +
+	"\blocation\x18\n" +
+	" \x01(\tR\blocation\"C\n" +
+	"\x16GetSessionsByUserIDRes\x12)\n" +
+	"\bsessions\x18\x01 \x03(\v2\r.auth.SessionR\bsessions\"J\n" +
+	"\x10DeleteSessionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"j\n" +
+	"!DeleteAllSessionsExceptCurrentReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
+	"\x12current_session_id\x18\x02 \x01(\tR\x10currentSessionId\"M\n" +
+	"\x15RequestPhoneChangeReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tnew_phone\x18\x02 \x01(\tR\bnewPhone\"Y\n" +
+	"\x15RequestPhoneChangeRes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\tR\texpiresAt\x12!\n" +
+	"\fresend_after\x18\x02 \x01(\tR\vresendAfter\"\x8c\x01\n" +
+	"\x15ConfirmPhoneChangeReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12'\n" +
+	"\x0frevoke_sessions\x18\x04 \x01(\bR\x0erevokeSessions\"\x80\x01\n" +
+	"\x14RegisterPushTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"P\n" +
+	"\x16UnregisterPushTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"v\n" +
+	"\n" +
+	"PushTarget\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\".\n" +
+	"\x11GetPushTargetsReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"?\n" +
+	"\x11GetPushTargetsRes\x12*\n" +
+	"\atargets\x18\x01 \x03(\v2\x10.auth.PushTargetR\atargets\"4\n" +
+	"\x11DropPushTokensReq\x12\x1f\n" +
+	"\vsession_ids\x18\x01 \x03(\tR\n" +
+	"sessionIds\"\xbc\x01\n" +
+	"\x05Token\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\x06 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"}\n" +
+	"\x0eCreateTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x04 \x01(\x05R\rexpiresInDays\"I\n" +
+	"\x0eCreateTokenRes\x12!\n" +
+	"\x05token\x18\x01 \x01(\v2\v.auth.TokenR\x05token\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"(\n" +
+	"\rListTokensReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\rListTokensRes\x12#\n" +
+	"\x06tokens\x18\x01 \x03(\v2\v.auth.TokenR\x06tokens\"D\n" +
+	"\x0eRevokeTokenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"(\n" +
+	"\x10ValidateTokenReq\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"|\n" +
+	"\x10ValidateTokenRes\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12!\n" +
+	"\faccount_type\x18\x04 \x01(\tR\vaccountType\"\x85\x01\n" +
+	"\x03Bot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"Y\n" +
+	"\fCreateBotReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"A\n" +
+	"\fCreateBotRes\x12\x1b\n" +
+	"\x03bot\x18\x01 \x01(\v2\t.auth.BotR\x03bot\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"(\n" +
+	"\vListBotsReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\",\n" +
+	"\vListBotsRes\x12\x1d\n" +
+	"\x04bots\x18\x01 \x03(\v2\t.auth.BotR\x04bots\"@\n" +
+	"\fDeleteBotReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\"V\n" +
+	"\x10SetBotWebhookReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"*\n" +
+	"\x10SetBotWebhookRes\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\"\x99\x01\n" +
+	"\x11CreateBotTokenReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x05 \x01(\x05R\rexpiresInDays\"`\n" +
+	"\x11RevokeBotTokenReq\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\x12\x19\n" +
+	"\btoken_id\x18\x03 \x01(\tR\atokenId2\xe7\v\n" +
+	"\vAuthService\x120\n" +
+	"\bRegister\x12\x11.auth.RegisterReq\x1a\x11.auth.RegisterRes\x12'\n" +
+	"\x05Login\x12\x0e.auth.LoginReq\x1a\x0e.auth.LoginRes\x121\n" +
+	"\x06Logout\x12\x0f.auth.LogoutReq\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x0fValidateSession\x12\x18.auth.ValidateSessionReq\x1a\x18.auth.ValidateSessionRes\x12Q\n" +
+	"\x13GetSessionsByUserID\x12\x1c.auth.GetSessionsByUserIDReq\x1a\x1c.auth.GetSessionsByUserIDRes\x12?\n" +
+	"\rDeleteSession\x12\x16.auth.DeleteSessionReq\x1a\x16.google.protobuf.Empty\x12a\n" +
+	"\x1eDeleteAllSessionsExceptCurrent\x12'.auth.DeleteAllSessionsExceptCurrentReq\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x12RequestPhoneChange\x12\x1b.auth.RequestPhoneChangeReq\x1a\x1b.auth.RequestPhoneChangeRes\x12I\n" +
+	"\x12ConfirmPhoneChange\x12\x1b.auth.ConfirmPhoneChangeReq\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x11RegisterPushToken\x12\x1a.auth.RegisterPushTokenReq\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x13UnregisterPushToken\x12\x1c.auth.UnregisterPushTokenReq\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x0eGetPushTargets\x12\x17.auth.GetPushTargetsReq\x1a\x17.auth.GetPushTargetsRes\x12A\n" +
+	"\x0eDropPushTokens\x12\x17.auth.DropPushTokensReq\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\vCreateToken\x12\x14.auth.CreateTokenReq\x1a\x14.auth.CreateTokenRes\x126\n" +
+	"\n" +
+	"ListTokens\x12\x13.auth.ListTokensReq\x1a\x13.auth.ListTokensRes\x12;\n" +
+	"\vRevokeToken\x12\x14.auth.RevokeTokenReq\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\rValidateToken\x12\x16.auth.ValidateTokenReq\x1a\x16.auth.ValidateTokenRes\x123\n" +
+	"\tCreateBot\x12\x12.auth.CreateBotReq\x1a\x12.auth.CreateBotRes\x120\n" +
+	"\bListBots\x12\x11.auth.ListBotsReq\x1a\x11.auth.ListBotsRes\x127\n" +
+	"\tDeleteBot\x12\x12.auth.DeleteBotReq\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\rSetBotWebhook\x12\x16.auth.SetBotWebhookReq\x1a\x16.auth.SetBotWebhookRes\x12?\n" +
+	"\x0eCreateBotToken\x12\x17.auth.CreateBotTokenReq\x1a\x14.auth.CreateTokenRes\x12A\n" +
+	"\x0eRevokeBotToken\x12\x17.auth.RevokeBotTokenReq\x1a\x16.google.protobuf.EmptyBOZMgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/authb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -742,43 +2538,110 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_auth_proto_goTypes = []any{
-	(*RegisterReq)(nil),                       // 0: auth.RegisterReq
-	(*RegisterRes)(nil),                       // 1: auth.RegisterRes
-	(*LoginReq)(nil),                          // 2: auth.LoginReq
-	(*LoginRes)(nil),                          // 3: auth.LoginRes
-	(*LogoutReq)(nil),                         // 4: auth.LogoutReq
-	(*ValidateSessionReq)(nil),                // 5: auth.ValidateSessionReq
-	(*ValidateSessionRes)(nil),                // 6: auth.ValidateSessionRes
-	(*GetSessionsByUserIDReq)(nil),            // 7: auth.GetSessionsByUserIDReq
-	(*Session)(nil),                           // 8: auth.Session
-	(*GetSessionsByUserIDRes)(nil),            // 9: auth.GetSessionsByUserIDRes
-	(*DeleteSessionReq)(nil),                  // 10: auth.DeleteSessionReq
-	(*DeleteAllSessionsExceptCurrentReq)(nil), // 11: auth.DeleteAllSessionsExceptCurrentReq
-	(*emptypb.Empty)(nil),                     // 12: google.protobuf.Empty
+	(*ClientInfo)(nil),                        // 0: auth.ClientInfo
+	(*RegisterReq)(nil),                       // 1: auth.RegisterReq
+	(*RegisterRes)(nil),                       // 2: auth.RegisterRes
+	(*LoginReq)(nil),                          // 3: auth.LoginReq
+	(*LoginRes)(nil),                          // 4: auth.LoginRes
+	(*LogoutReq)(nil),                         // 5: auth.LogoutReq
+	(*ValidateSessionReq)(nil),                // 6: auth.ValidateSessionReq
+	(*ValidateSessionRes)(nil),                // 7: auth.ValidateSessionRes
+	(*GetSessionsByUserIDReq)(nil),            // 8: auth.GetSessionsByUserIDReq
+	(*Session)(nil),                           // 9: auth.Session
+	(*GetSessionsByUserIDRes)(nil),            // 10: auth.GetSessionsByUserIDRes
+	(*DeleteSessionReq)(nil),                  // 11: auth.DeleteSessionReq
+	(*DeleteAllSessionsExceptCurrentReq)(nil), // 12: auth.DeleteAllSessionsExceptCurrentReq
+	(*RequestPhoneChangeReq)(nil),             // 13: auth.RequestPhoneChangeReq
+	(*RequestPhoneChangeRes)(nil),             // 14: auth.RequestPhoneChangeRes
+	(*ConfirmPhoneChangeReq)(nil),             // 15: auth.ConfirmPhoneChangeReq
+	(*RegisterPushTokenReq)(nil),              // 16: auth.RegisterPushTokenReq
+	(*UnregisterPushTokenReq)(nil),            // 17: auth.UnregisterPushTokenReq
+	(*PushTarget)(nil),                        // 18: auth.PushTarget
+	(*GetPushTargetsReq)(nil),                 // 19: auth.GetPushTargetsReq
+	(*GetPushTargetsRes)(nil),                 // 20: auth.GetPushTargetsRes
+	(*DropPushTokensReq)(nil),                 // 21: auth.DropPushTokensReq
+	(*Token)(nil),                             // 22: auth.Token
+	(*CreateTokenReq)(nil),                    // 23: auth.CreateTokenReq
+	(*CreateTokenRes)(nil),                    // 24: auth.CreateTokenRes
+	(*ListTokensReq)(nil),                     // 25: auth.ListTokensReq
+	(*ListTokensRes)(nil),                     // 26: auth.ListTokensRes
+	(*RevokeTokenReq)(nil),                    // 27: auth.RevokeTokenReq
+	(*ValidateTokenReq)(nil),                  // 28: auth.ValidateTokenReq
+	(*ValidateTokenRes)(nil),                  // 29: auth.ValidateTokenRes
+	(*Bot)(nil),                               // 30: auth.Bot
+	(*CreateBotReq)(nil),                      // 31: auth.CreateBotReq
+	(*CreateBotRes)(nil),                      // 32: auth.CreateBotRes
+	(*ListBotsReq)(nil),                       // 33: auth.ListBotsReq
+	(*ListBotsRes)(nil),                       // 34: auth.ListBotsRes
+	(*DeleteBotReq)(nil),                      // 35: auth.DeleteBotReq
+	(*SetBotWebhookReq)(nil),                  // 36: auth.SetBotWebhookReq
+	(*SetBotWebhookRes)(nil),                  // 37: auth.SetBotWebhookRes
+	(*CreateBotTokenReq)(nil),                 // 38: auth.CreateBotTokenReq
+	(*RevokeBotTokenReq)(nil),                 // 39: auth.RevokeBotTokenReq
+	(*emptypb.Empty)(nil),                     // 40: google.protobuf.Empty
 }
 var file_auth_proto_depIdxs = []int32{
-	8,  // 0: auth.GetSessionsByUserIDRes.sessions:type_name -> auth.Session
-	0,  // 1: auth.AuthService.Register:input_type -> auth.RegisterReq
-	2,  // 2: auth.AuthService.Login:input_type -> auth.LoginReq
-	4,  // 3: auth.AuthService.Logout:input_type -> auth.LogoutReq
-	5,  // 4: auth.AuthService.ValidateSession:input_type -> auth.ValidateSessionReq
-	7,  // 5: auth.AuthService.GetSessionsByUserID:input_type -> auth.GetSessionsByUserIDReq
-	10, // 6: auth.AuthService.DeleteSession:input_type -> auth.DeleteSessionReq
-	11, // 7: auth.AuthService.DeleteAllSessionsExceptCurrent:input_type -> auth.DeleteAllSessionsExceptCurrentReq
-	1,  // 8: auth.AuthService.Register:output_type -> auth.RegisterRes
-	3,  // 9: auth.AuthService.Login:output_type -> auth.LoginRes
-	12, // 10: auth.AuthService.Logout:output_type -> google.protobuf.Empty
-	6,  // 11: auth.AuthService.ValidateSession:output_type -> auth.ValidateSessionRes
-	9,  // 12: auth.AuthService.GetSessionsByUserID:output_type -> auth.GetSessionsByUserIDRes
-	12, // 13: auth.AuthService.DeleteSession:output_type -> google.protobuf.Empty
-	12, // 14: auth.AuthService.DeleteAllSessionsExceptCurrent:output_type -> google.protobuf.Empty
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	0,  // 0: auth.RegisterReq.client_info:type_name -> auth.ClientInfo
+	0,  // 1: auth.LoginReq.client_info:type_name -> auth.ClientInfo
+	9,  // 2: auth.GetSessionsByUserIDRes.sessions:type_name -> auth.Session
+	18, // 3: auth.GetPushTargetsRes.targets:type_name -> auth.PushTarget
+	22, // 4: auth.CreateTokenRes.token:type_name -> auth.Token
+	22, // 5: auth.ListTokensRes.tokens:type_name -> auth.Token
+	30, // 6: auth.CreateBotRes.bot:type_name -> auth.Bot
+	30, // 7: auth.ListBotsRes.bots:type_name -> auth.Bot
+	1,  // 8: auth.AuthService.Register:input_type -> auth.RegisterReq
+	3,  // 9: auth.AuthService.Login:input_type -> auth.LoginReq
+	5,  // 10: auth.AuthService.Logout:input_type -> auth.LogoutReq
+	6,  // 11: auth.AuthService.ValidateSession:input_type -> auth.ValidateSessionReq
+	8,  // 12: auth.AuthService.GetSessionsByUserID:input_type -> auth.GetSessionsByUserIDReq
+	11, // 13: auth.AuthService.DeleteSession:input_type -> auth.DeleteSessionReq
+	12, // 14: auth.AuthService.DeleteAllSessionsExceptCurrent:input_type -> auth.DeleteAllSessionsExceptCurrentReq
+	13, // 15: auth.AuthService.RequestPhoneChange:input_type -> auth.RequestPhoneChangeReq
+	15, // 16: auth.AuthService.ConfirmPhoneChange:input_type -> auth.ConfirmPhoneChangeReq
+	16, // 17: auth.AuthService.RegisterPushToken:input_type -> auth.RegisterPushTokenReq
+	17, // 18: auth.AuthService.UnregisterPushToken:input_type -> auth.UnregisterPushTokenReq
+	19, // 19: auth.AuthService.GetPushTargets:input_type -> auth.GetPushTargetsReq
+	21, // 20: auth.AuthService.DropPushTokens:input_type -> auth.DropPushTokensReq
+	23, // 21: auth.AuthService.CreateToken:input_type -> auth.CreateTokenReq
+	25, // 22: auth.AuthService.ListTokens:input_type -> auth.ListTokensReq
+	27, // 23: auth.AuthService.RevokeToken:input_type -> auth.RevokeTokenReq
+	28, // 24: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenReq
+	31, // 25: auth.AuthService.CreateBot:input_type -> auth.CreateBotReq
+	33, // 26: auth.AuthService.ListBots:input_type -> auth.ListBotsReq
+	35, // 27: auth.AuthService.DeleteBot:input_type -> auth.DeleteBotReq
+	36, // 28: auth.AuthService.SetBotWebhook:input_type -> auth.SetBotWebhookReq
+	38, // 29: auth.AuthService.CreateBotToken:input_type -> auth.CreateBotTokenReq
+	39, // 30: auth.AuthService.RevokeBotToken:input_type -> auth.RevokeBotTokenReq
+	2,  // 31: auth.AuthService.Register:output_type -> auth.RegisterRes
+	4,  // 32: auth.AuthService.Login:output_type -> auth.LoginRes
+	40, // 33: auth.AuthService.Logout:output_type -> google.protobuf.Empty
+	7,  // 34: auth.AuthService.ValidateSession:output_type -> auth.ValidateSessionRes
+	10, // 35: auth.AuthService.GetSessionsByUserID:output_type -> auth.GetSessionsByUserIDRes
+	40, // 36: auth.AuthService.DeleteSession:output_type -> google.protobuf.Empty
+	40, // 37: auth.AuthService.DeleteAllSessionsExceptCurrent:output_type -> google.protobuf.Empty
+	14, // 38: auth.AuthService.RequestPhoneChange:output_type -> auth.RequestPhoneChangeRes
+	40, // 39: auth.AuthService.ConfirmPhoneChange:output_type -> google.protobuf.Empty
+	40, // 40: auth.AuthService.RegisterPushToken:output_type -> google.protobuf.Empty
+	40, // 41: auth.AuthService.UnregisterPushToken:output_type -> google.protobuf.Empty
+	20, // 42: auth.AuthService.GetPushTargets:output_type -> auth.GetPushTargetsRes
+	40, // 43: auth.AuthService.DropPushTokens:output_type -> google.protobuf.Empty
+	24, // 44: auth.AuthService.CreateToken:output_type -> auth.CreateTokenRes
+	26, // 45: auth.AuthService.ListTokens:output_type -> auth.ListTokensRes
+	40, // 46: auth.AuthService.RevokeToken:output_type -> google.protobuf.Empty
+	29, // 47: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenRes
+	32, // 48: auth.AuthService.CreateBot:output_type -> auth.CreateBotRes
+	34, // 49: auth.AuthService.ListBots:output_type -> auth.ListBotsRes
+	40, // 50: auth.AuthService.DeleteBot:output_type -> google.protobuf.Empty
+	37, // 51: auth.AuthService.SetBotWebhook:output_type -> auth.SetBotWebhookRes
+	24, // 52: auth.AuthService.CreateBotToken:output_type -> auth.CreateTokenRes
+	40, // 53: auth.AuthService.RevokeBotToken:output_type -> google.protobuf.Empty
+	31, // [31:54] is the sub-list for method output_type
+	8,  // [8:31] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -792,7 +2655,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,22 @@ const (
 	AuthService_GetSessionsByUserID_FullMethodName            = "/auth.AuthService/GetSessionsByUserID"
 	AuthService_DeleteSession_FullMethodName                  = "/auth.AuthService/DeleteSession"
 	AuthService_DeleteAllSessionsExceptCurrent_FullMethodName = "/auth.AuthService/DeleteAllSessionsExceptCurrent"
+	AuthService_RequestPhoneChange_FullMethodName             = "/auth.AuthService/RequestPhoneChange"
+	AuthService_ConfirmPhoneChange_FullMethodName             = "/auth.AuthService/ConfirmPhoneChange"
+	AuthService_RegisterPushToken_FullMethodName              = "/auth.AuthService/RegisterPushToken"
+	AuthService_UnregisterPushToken_FullMethodName            = "/auth.AuthService/UnregisterPushToken"
+	AuthService_GetPushTargets_FullMethodName                 = "/auth.AuthService/GetPushTargets"
+	AuthService_DropPushTokens_FullMethodName                 = "/auth.AuthService/DropPushTokens"
+	AuthService_CreateToken_FullMethodName                    = "/auth.AuthService/CreateToken"
+	AuthService_ListTokens_FullMethodName                     = "/auth.AuthService/ListTokens"
+	AuthService_RevokeToken_FullMethodName                    = "/auth.AuthService/RevokeToken"
+	AuthService_ValidateToken_FullMethodName                  = "/auth.AuthService/ValidateToken"
+	AuthService_CreateBot_FullMethodName                      = "/auth.AuthService/CreateBot"
+	AuthService_ListBots_FullMethodName                       = "/auth.AuthService/ListBots"
+	AuthService_DeleteBot_FullMethodName                      = "/auth.AuthService/DeleteBot"
+	AuthService_SetBotWebhook_FullMethodName                  = "/auth.AuthService/SetBotWebhook"
+	AuthService_CreateBotToken_FullMethodName                 = "/auth.AuthService/CreateBotToken"
+	AuthService_RevokeBotToken_FullMethodName                 = "/auth.AuthService/RevokeBotToken"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -42,6 +58,22 @@ type AuthServiceClient interface {
 	GetSessionsByUserID(ctx context.Context, in *GetSessionsByUserIDReq, opts ...grpc.CallOption) (*GetSessionsByUserIDRes, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteAllSessionsExceptCurrent(ctx context.Context, in *DeleteAllSessionsExceptCurrentReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequestPhoneChange(ctx context.Context, in *RequestPhoneChangeReq, opts ...grpc.CallOption) (*RequestPhoneChangeRes, error)
+	ConfirmPhoneChange(ctx context.Context, in *ConfirmPhoneChangeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegisterPushToken(ctx context.Context, in *RegisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPushTargets(ctx context.Context, in *GetPushTargetsReq, opts ...grpc.CallOption) (*GetPushTargetsRes, error)
+	DropPushTokens(ctx context.Context, in *DropPushTokensReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenRes, error)
+	ListTokens(ctx context.Context, in *ListTokensReq, opts ...grpc.CallOption) (*ListTokensRes, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ValidateToken(ctx context.Context, in *ValidateTokenReq, opts ...grpc.CallOption) (*ValidateTokenRes, error)
+	CreateBot(ctx context.Context, in *CreateBotReq, opts ...grpc.CallOption) (*CreateBotRes, error)
+	ListBots(ctx context.Context, in *ListBotsReq, opts ...grpc.CallOption) (*ListBotsRes, error)
+	DeleteBot(ctx context.Context, in *DeleteBotReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetBotWebhook(ctx context.Context, in *SetBotWebhookReq, opts ...grpc.CallOption) (*SetBotWebhookRes, error)
+	CreateBotToken(ctx context.Context, in *CreateBotTokenReq, opts ...grpc.CallOption) (*CreateTokenRes, error)
+	RevokeBotToken(ctx context.Context, in *RevokeBotTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type authServiceClient struct {
@@ -122,6 +154,166 @@ func (c *authServiceClient) DeleteAllSessionsExceptCurrent(ctx context.Context,
 	return out, nil
 }
 
+func (c *authServiceClient) RequestPhoneChange(ctx context.Context, in *RequestPhoneChangeReq, opts ...grpc.CallOption) (*RequestPhoneChangeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPhoneChangeRes)
+	err := c.cc.Invoke(ctx, AuthService_RequestPhoneChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmPhoneChange(ctx context.Context, in *ConfirmPhoneChangeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmPhoneChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RegisterPushToken(ctx context.Context, in *RegisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RegisterPushToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnregisterPushToken(ctx context.Context, in *UnregisterPushTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_UnregisterPushToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetPushTargets(ctx context.Context, in *GetPushTargetsReq, opts ...grpc.CallOption) (*GetPushTargetsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushTargetsRes)
+	err := c.cc.Invoke(ctx, AuthService_GetPushTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DropPushTokens(ctx context.Context, in *DropPushTokensReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DropPushTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTokenRes)
+	err := c.cc.Invoke(ctx, AuthService_CreateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListTokens(ctx context.Context, in *ListTokensReq, opts ...grpc.CallOption) (*ListTokensRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTokensRes)
+	err := c.cc.Invoke(ctx, AuthService_ListTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ValidateToken(ctx context.Context, in *ValidateTokenReq, opts ...grpc.CallOption) (*ValidateTokenRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateTokenRes)
+	err := c.cc.Invoke(ctx, AuthService_ValidateToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateBot(ctx context.Context, in *CreateBotReq, opts ...grpc.CallOption) (*CreateBotRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBotRes)
+	err := c.cc.Invoke(ctx, AuthService_CreateBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListBots(ctx context.Context, in *ListBotsReq, opts ...grpc.CallOption) (*ListBotsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBotsRes)
+	err := c.cc.Invoke(ctx, AuthService_ListBots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteBot(ctx context.Context, in *DeleteBotReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SetBotWebhook(ctx context.Context, in *SetBotWebhookReq, opts ...grpc.CallOption) (*SetBotWebhookRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBotWebhookRes)
+	err := c.cc.Invoke(ctx, AuthService_SetBotWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateBotToken(ctx context.Context, in *CreateBotTokenReq, opts ...grpc.CallOption) (*CreateTokenRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTokenRes)
+	err := c.cc.Invoke(ctx, AuthService_CreateBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeBotToken(ctx context.Context, in *RevokeBotTokenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RevokeBotToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -135,6 +327,22 @@ type AuthServiceServer interface {
 	GetSessionsByUserID(context.Context, *GetSessionsByUserIDReq) (*GetSessionsByUserIDRes, error)
 	DeleteSession(context.Context, *DeleteSessionReq) (*emptypb.Empty, error)
 	DeleteAllSessionsExceptCurrent(context.Context, *DeleteAllSessionsExceptCurrentReq) (*emptypb.Empty, error)
+	RequestPhoneChange(context.Context, *RequestPhoneChangeReq) (*RequestPhoneChangeRes, error)
+	ConfirmPhoneChange(context.Context, *ConfirmPhoneChangeReq) (*emptypb.Empty, error)
+	RegisterPushToken(context.Context, *RegisterPushTokenReq) (*emptypb.Empty, error)
+	UnregisterPushToken(context.Context, *UnregisterPushTokenReq) (*emptypb.Empty, error)
+	GetPushTargets(context.Context, *GetPushTargetsReq) (*GetPushTargetsRes, error)
+	DropPushTokens(context.Context, *DropPushTokensReq) (*emptypb.Empty, error)
+	CreateToken(context.Context, *CreateTokenReq) (*CreateTokenRes, error)
+	ListTokens(context.Context, *ListTokensReq) (*ListTokensRes, error)
+	RevokeToken(context.Context, *RevokeTokenReq) (*emptypb.Empty, error)
+	ValidateToken(context.Context, *ValidateTokenReq) (*ValidateTokenRes, error)
+	CreateBot(context.Context, *CreateBotReq) (*CreateBotRes, error)
+	ListBots(context.Context, *ListBotsReq) (*ListBotsRes, error)
+	DeleteBot(context.Context, *DeleteBotReq) (*emptypb.Empty, error)
+	SetBotWebhook(context.Context, *SetBotWebhookReq) (*SetBotWebhookRes, error)
+	CreateBotToken(context.Context, *CreateBotTokenReq) (*CreateTokenRes, error)
+	RevokeBotToken(context.Context, *RevokeBotTokenReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -166,6 +374,54 @@ func (UnimplementedAuthServiceServer) DeleteSession(context.Context, *DeleteSess
 func (UnimplementedAuthServiceServer) DeleteAllSessionsExceptCurrent(context.Context, *DeleteAllSessionsExceptCurrentReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllSessionsExceptCurrent not implemented")
 }
+func (UnimplementedAuthServiceServer) RequestPhoneChange(context.Context, *RequestPhoneChangeReq) (*RequestPhoneChangeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPhoneChange not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmPhoneChange(context.Context, *ConfirmPhoneChangeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPhoneChange not implemented")
+}
+func (UnimplementedAuthServiceServer) RegisterPushToken(context.Context, *RegisterPushTokenReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPushToken not implemented")
+}
+func (UnimplementedAuthServiceServer) UnregisterPushToken(context.Context, *UnregisterPushTokenReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterPushToken not implemented")
+}
+func (UnimplementedAuthServiceServer) GetPushTargets(context.Context, *GetPushTargetsReq) (*GetPushTargetsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushTargets not implemented")
+}
+func (UnimplementedAuthServiceServer) DropPushTokens(context.Context, *DropPushTokensReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropPushTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateToken(context.Context, *CreateTokenReq) (*CreateTokenRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ListTokens(context.Context, *ListTokensReq) (*ListTokensRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeToken(context.Context, *RevokeTokenReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ValidateToken(context.Context, *ValidateTokenReq) (*ValidateTokenRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateBot(context.Context, *CreateBotReq) (*CreateBotRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBot not implemented")
+}
+func (UnimplementedAuthServiceServer) ListBots(context.Context, *ListBotsReq) (*ListBotsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBots not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteBot(context.Context, *DeleteBotReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBot not implemented")
+}
+func (UnimplementedAuthServiceServer) SetBotWebhook(context.Context, *SetBotWebhookReq) (*SetBotWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBotWebhook not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateBotToken(context.Context, *CreateBotTokenReq) (*CreateTokenRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBotToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeBotToken(context.Context, *RevokeBotTokenReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBotToken not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -313,6 +569,294 @@ func _AuthService_DeleteAllSessionsExceptCurrent_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RequestPhoneChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPhoneChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPhoneChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RequestPhoneChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPhoneChange(ctx, req.(*RequestPhoneChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmPhoneChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPhoneChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmPhoneChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmPhoneChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmPhoneChange(ctx, req.(*ConfirmPhoneChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RegisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPushTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegisterPushToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegisterPushToken(ctx, req.(*RegisterPushTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnregisterPushToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterPushTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnregisterPushToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnregisterPushToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnregisterPushToken(ctx, req.(*UnregisterPushTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetPushTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushTargetsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetPushTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetPushTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetPushTargets(ctx, req.(*GetPushTargetsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DropPushTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropPushTokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DropPushTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DropPushTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DropPushTokens(ctx, req.(*DropPushTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateToken(ctx, req.(*CreateTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListTokens(ctx, req.(*ListTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeToken(ctx, req.(*RevokeTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ValidateToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ValidateToken(ctx, req.(*ValidateTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateBot(ctx, req.(*CreateBotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListBots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListBots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListBots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListBots(ctx, req.(*ListBotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteBot(ctx, req.(*DeleteBotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SetBotWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBotWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SetBotWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_SetBotWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SetBotWebhook(ctx, req.(*SetBotWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBotTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateBotToken(ctx, req.(*CreateBotTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeBotToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBotTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeBotToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeBotToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeBotToken(ctx, req.(*RevokeBotTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -348,6 +892,70 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAllSessionsExceptCurrent",
 			Handler:    _AuthService_DeleteAllSessionsExceptCurrent_Handler,
 		},
+		{
+			MethodName: "RequestPhoneChange",
+			Handler:    _AuthService_RequestPhoneChange_Handler,
+		},
+		{
+			MethodName: "ConfirmPhoneChange",
+			Handler:    _AuthService_ConfirmPhoneChange_Handler,
+		},
+		{
+			MethodName: "RegisterPushToken",
+			Handler:    _AuthService_RegisterPushToken_Handler,
+		},
+		{
+			MethodName: "UnregisterPushToken",
+			Handler:    _AuthService_UnregisterPushToken_Handler,
+		},
+		{
+			MethodName: "GetPushTargets",
+			Handler:    _AuthService_GetPushTargets_Handler,
+		},
+		{
+			MethodName: "DropPushTokens",
+			Handler:    _AuthService_DropPushTokens_Handler,
+		},
+		{
+			MethodName: "CreateToken",
+			Handler:    _AuthService_CreateToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _AuthService_ListTokens_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _AuthService_RevokeToken_Handler,
+		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _AuthService_ValidateToken_Handler,
+		},
+		{
+			MethodName: "CreateBot",
+			Handler:    _AuthService_CreateBot_Handler,
+		},
+		{
+			MethodName: "ListBots",
+			Handler:    _AuthService_ListBots_Handler,
+		},
+		{
+			MethodName: "DeleteBot",
+			Handler:    _AuthService_DeleteBot_Handler,
+		},
+		{
+			MethodName: "SetBotWebhook",
+			Handler:    _AuthService_SetBotWebhook_Handler,
+		},
+		{
+			MethodName: "CreateBotToken",
+			Handler:    _AuthService_CreateBotToken_Handler,
+		},
+		{
+			MethodName: "RevokeBotToken",
+			Handler:    _AuthService_RevokeBotToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -31,6 +31,7 @@ type Chat struct {
 	LastMessage   *Message               `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
 	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 	AvatarUrl     *string                `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	Settings      *ChatSettings          `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -100,6 +101,89 @@ func (x *Chat) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *Chat) GetSettings() *ChatSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ChatSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Muted         bool                   `protobuf:"varint,1,opt,name=muted,proto3" json:"muted,omitempty"`
+	MutedUntil    *string                `protobuf:"bytes,2,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"` // RFC3339; отсутствует, если чат заглушён навсегда
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	PinOrder      int32                  `protobuf:"varint,4,opt,name=pin_order,json=pinOrder,proto3" json:"pin_order,omitempty"` // 0 - чат не закреплён
+	Folder        string                 `protobuf:"bytes,5,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatSettings) Reset() {
+	*x = ChatSettings{}
+	mi := &file_chats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatSettings) ProtoMessage() {}
+
+func (x *ChatSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatSettings.ProtoReflect.Descriptor instead.
+func (*ChatSettings) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChatSettings) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *ChatSettings) GetMutedUntil() string {
+	if x != nil && x.MutedUntil != nil {
+		return *x.MutedUntil
+	}
+	return ""
+}
+
+func (x *ChatSettings) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ChatSettings) GetPinOrder() int32 {
+	if x != nil {
+		return x.PinOrder
+	}
+	return 0
+}
+
+func (x *ChatSettings) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
 type UserInfoChat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -112,7 +196,7 @@ type UserInfoChat struct {
 
 func (x *UserInfoChat) Reset() {
 	*x = UserInfoChat{}
-	mi := &file_chats_proto_msgTypes[1]
+	mi := &file_chats_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -124,7 +208,7 @@ func (x *UserInfoChat) String() string {
 func (*UserInfoChat) ProtoMessage() {}
 
 func (x *UserInfoChat) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[1]
+	mi := &file_chats_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,7 +221,7 @@ func (x *UserInfoChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoChat.ProtoReflect.Descriptor instead.
 func (*UserInfoChat) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{1}
+	return file_chats_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UserInfoChat) GetUserId() string {
@@ -187,7 +271,7 @@ type ChatDetailedInformation struct {
 
 func (x *ChatDetailedInformation) Reset() {
 	*x = ChatDetailedInformation{}
-	mi := &file_chats_proto_msgTypes[2]
+	mi := &file_chats_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +283,7 @@ func (x *ChatDetailedInformation) String() string {
 func (*ChatDetailedInformation) ProtoMessage() {}
 
 func (x *ChatDetailedInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[2]
+	mi := &file_chats_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +296,7 @@ func (x *ChatDetailedInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatDetailedInformation.ProtoReflect.Descriptor instead.
 func (*ChatDetailedInformation) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{2}
+	return file_chats_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ChatDetailedInformation) GetId() string {
@@ -295,13 +379,15 @@ func (x *ChatDetailedInformation) GetAvatarUrl() string {
 type GetChatsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Archived      *bool                  `protobuf:"varint,2,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	Folder        *string                `protobuf:"bytes,3,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetChatsReq) Reset() {
 	*x = GetChatsReq{}
-	mi := &file_chats_proto_msgTypes[3]
+	mi := &file_chats_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +399,7 @@ func (x *GetChatsReq) String() string {
 func (*GetChatsReq) ProtoMessage() {}
 
 func (x *GetChatsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[3]
+	mi := &file_chats_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +412,7 @@ func (x *GetChatsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatsReq.ProtoReflect.Descriptor instead.
 func (*GetChatsReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{3}
+	return file_chats_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetChatsReq) GetUserId() string {
@@ -336,6 +422,20 @@ func (x *GetChatsReq) GetUserId() string {
 	return ""
 }
 
+func (x *GetChatsReq) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *GetChatsReq) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
 type GetChatsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Chats         []*Chat                `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
@@ -345,7 +445,7 @@ type GetChatsRes struct {
 
 func (x *GetChatsRes) Reset() {
 	*x = GetChatsRes{}
-	mi := &file_chats_proto_msgTypes[4]
+	mi := &file_chats_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +457,7 @@ func (x *GetChatsRes) String() string {
 func (*GetChatsRes) ProtoMessage() {}
 
 func (x *GetChatsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[4]
+	mi := &file_chats_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +470,7 @@ func (x *GetChatsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatsRes.ProtoReflect.Descriptor instead.
 func (*GetChatsRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{4}
+	return file_chats_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetChatsRes) GetChats() []*Chat {
@@ -392,7 +492,7 @@ type GetChatReq struct {
 
 func (x *GetChatReq) Reset() {
 	*x = GetChatReq{}
-	mi := &file_chats_proto_msgTypes[5]
+	mi := &file_chats_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +504,7 @@ func (x *GetChatReq) String() string {
 func (*GetChatReq) ProtoMessage() {}
 
 func (x *GetChatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[5]
+	mi := &file_chats_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +517,7 @@ func (x *GetChatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatReq.ProtoReflect.Descriptor instead.
 func (*GetChatReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{5}
+	return file_chats_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetChatReq) GetUserId() string {
@@ -460,7 +560,7 @@ type GetChatMessagesReq struct {
 
 func (x *GetChatMessagesReq) Reset() {
 	*x = GetChatMessagesReq{}
-	mi := &file_chats_proto_msgTypes[6]
+	mi := &file_chats_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +572,7 @@ func (x *GetChatMessagesReq) String() string {
 func (*GetChatMessagesReq) ProtoMessage() {}
 
 func (x *GetChatMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[6]
+	mi := &file_chats_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +585,7 @@ func (x *GetChatMessagesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatMessagesReq.ProtoReflect.Descriptor instead.
 func (*GetChatMessagesReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{6}
+	return file_chats_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetChatMessagesReq) GetUserId() string {
@@ -525,7 +625,7 @@ type GetChatMessagesRes struct {
 
 func (x *GetChatMessagesRes) Reset() {
 	*x = GetChatMessagesRes{}
-	mi := &file_chats_proto_msgTypes[7]
+	mi := &file_chats_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +637,7 @@ func (x *GetChatMessagesRes) String() string {
 func (*GetChatMessagesRes) ProtoMessage() {}
 
 func (x *GetChatMessagesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[7]
+	mi := &file_chats_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +650,7 @@ func (x *GetChatMessagesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatMessagesRes.ProtoReflect.Descriptor instead.
 func (*GetChatMessagesRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{7}
+	return file_chats_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetChatMessagesRes) GetMessages() []*Message {
@@ -570,7 +670,7 @@ type GetUsersDialogReq struct {
 
 func (x *GetUsersDialogReq) Reset() {
 	*x = GetUsersDialogReq{}
-	mi := &file_chats_proto_msgTypes[8]
+	mi := &file_chats_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +682,7 @@ func (x *GetUsersDialogReq) String() string {
 func (*GetUsersDialogReq) ProtoMessage() {}
 
 func (x *GetUsersDialogReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[8]
+	mi := &file_chats_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +695,7 @@ func (x *GetUsersDialogReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersDialogReq.ProtoReflect.Descriptor instead.
 func (*GetUsersDialogReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{8}
+	return file_chats_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUsersDialogReq) GetUser1Id() string {
@@ -622,7 +722,7 @@ type AddMember struct {
 
 func (x *AddMember) Reset() {
 	*x = AddMember{}
-	mi := &file_chats_proto_msgTypes[9]
+	mi := &file_chats_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +734,7 @@ func (x *AddMember) String() string {
 func (*AddMember) ProtoMessage() {}
 
 func (x *AddMember) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[9]
+	mi := &file_chats_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +747,7 @@ func (x *AddMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMember.ProtoReflect.Descriptor instead.
 func (*AddMember) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{9}
+	return file_chats_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AddMember) GetUserId() string {
@@ -675,7 +775,7 @@ type CreateChatReq struct {
 
 func (x *CreateChatReq) Reset() {
 	*x = CreateChatReq{}
-	mi := &file_chats_proto_msgTypes[10]
+	mi := &file_chats_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +787,7 @@ func (x *CreateChatReq) String() string {
 func (*CreateChatReq) ProtoMessage() {}
 
 func (x *CreateChatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[10]
+	mi := &file_chats_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +800,7 @@ func (x *CreateChatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateChatReq.ProtoReflect.Descriptor instead.
 func (*CreateChatReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{10}
+	return file_chats_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateChatReq) GetName() string {
@@ -733,7 +833,7 @@ type IdRes struct {
 
 func (x *IdRes) Reset() {
 	*x = IdRes{}
-	mi := &file_chats_proto_msgTypes[11]
+	mi := &file_chats_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +845,7 @@ func (x *IdRes) String() string {
 func (*IdRes) ProtoMessage() {}
 
 func (x *IdRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[11]
+	mi := &file_chats_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +858,7 @@ func (x *IdRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdRes.ProtoReflect.Descriptor instead.
 func (*IdRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{11}
+	return file_chats_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IdRes) GetId() string {
@@ -780,7 +880,7 @@ type UpdateChatReq struct {
 
 func (x *UpdateChatReq) Reset() {
 	*x = UpdateChatReq{}
-	mi := &file_chats_proto_msgTypes[12]
+	mi := &file_chats_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +892,7 @@ func (x *UpdateChatReq) String() string {
 func (*UpdateChatReq) ProtoMessage() {}
 
 func (x *UpdateChatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[12]
+	mi := &file_chats_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +905,7 @@ func (x *UpdateChatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChatReq.ProtoReflect.Descriptor instead.
 func (*UpdateChatReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{12}
+	return file_chats_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateChatReq) GetUserId() string {
@@ -847,7 +947,7 @@ type AddUserToChatReq struct {
 
 func (x *AddUserToChatReq) Reset() {
 	*x = AddUserToChatReq{}
-	mi := &file_chats_proto_msgTypes[13]
+	mi := &file_chats_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +959,7 @@ func (x *AddUserToChatReq) String() string {
 func (*AddUserToChatReq) ProtoMessage() {}
 
 func (x *AddUserToChatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[13]
+	mi := &file_chats_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +972,7 @@ func (x *AddUserToChatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserToChatReq.ProtoReflect.Descriptor instead.
 func (*AddUserToChatReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{13}
+	return file_chats_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddUserToChatReq) GetUserId() string {
@@ -906,7 +1006,7 @@ type RemoveUserFromChatReq struct {
 
 func (x *RemoveUserFromChatReq) Reset() {
 	*x = RemoveUserFromChatReq{}
-	mi := &file_chats_proto_msgTypes[14]
+	mi := &file_chats_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -918,7 +1018,7 @@ func (x *RemoveUserFromChatReq) String() string {
 func (*RemoveUserFromChatReq) ProtoMessage() {}
 
 func (x *RemoveUserFromChatReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[14]
+	mi := &file_chats_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +1031,7 @@ func (x *RemoveUserFromChatReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserFromChatReq.ProtoReflect.Descriptor instead.
 func (*RemoveUserFromChatReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{14}
+	return file_chats_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoveUserFromChatReq) GetChatId() string {
@@ -963,7 +1063,7 @@ type MessageEventReq struct {
 
 func (x *MessageEventReq) Reset() {
 	*x = MessageEventReq{}
-	mi := &file_chats_proto_msgTypes[15]
+	mi := &file_chats_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -975,7 +1075,7 @@ func (x *MessageEventReq) String() string {
 func (*MessageEventReq) ProtoMessage() {}
 
 func (x *MessageEventReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[15]
+	mi := &file_chats_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,7 +1088,7 @@ func (x *MessageEventReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageEventReq.ProtoReflect.Descriptor instead.
 func (*MessageEventReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{15}
+	return file_chats_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MessageEventReq) GetUserId() string {
@@ -1064,14 +1164,19 @@ type MessageEventRes struct {
 	//	*MessageEventRes_EditChatMessage
 	//	*MessageEventRes_DeleteChatMessage
 	//	*MessageEventRes_UserJoined
+	//	*MessageEventRes_SystemNotification
+	//	*MessageEventRes_Mention
+	//	*MessageEventRes_ChatSettings
+	//	*MessageEventRes_UserStatus
 	Event         isMessageEventRes_Event `protobuf_oneof:"event"`
+	Muted         bool                    `protobuf:"varint,10,opt,name=muted,proto3" json:"muted,omitempty"` // чат заглушён получателем события
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageEventRes) Reset() {
 	*x = MessageEventRes{}
-	mi := &file_chats_proto_msgTypes[16]
+	mi := &file_chats_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1083,7 +1188,7 @@ func (x *MessageEventRes) String() string {
 func (*MessageEventRes) ProtoMessage() {}
 
 func (x *MessageEventRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[16]
+	mi := &file_chats_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,7 +1201,7 @@ func (x *MessageEventRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageEventRes.ProtoReflect.Descriptor instead.
 func (*MessageEventRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{16}
+	return file_chats_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MessageEventRes) GetChatId() string {
@@ -1158,6 +1263,49 @@ func (x *MessageEventRes) GetUserJoined() *UserJoined {
 	return nil
 }
 
+func (x *MessageEventRes) GetSystemNotification() *SystemNotification {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEventRes_SystemNotification); ok {
+			return x.SystemNotification
+		}
+	}
+	return nil
+}
+
+func (x *MessageEventRes) GetMention() *Message {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEventRes_Mention); ok {
+			return x.Mention
+		}
+	}
+	return nil
+}
+
+func (x *MessageEventRes) GetChatSettings() *ChatSettings {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEventRes_ChatSettings); ok {
+			return x.ChatSettings
+		}
+	}
+	return nil
+}
+
+func (x *MessageEventRes) GetUserStatus() *UserStatus {
+	if x != nil {
+		if x, ok := x.Event.(*MessageEventRes_UserStatus); ok {
+			return x.UserStatus
+		}
+	}
+	return nil
+}
+
+func (x *MessageEventRes) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
 type isMessageEventRes_Event interface {
 	isMessageEventRes_Event()
 }
@@ -1182,6 +1330,22 @@ type MessageEventRes_UserJoined struct {
 	UserJoined *UserJoined `protobuf:"bytes,6,opt,name=user_joined,json=userJoined,proto3,oneof"`
 }
 
+type MessageEventRes_SystemNotification struct {
+	SystemNotification *SystemNotification `protobuf:"bytes,7,opt,name=system_notification,json=systemNotification,proto3,oneof"`
+}
+
+type MessageEventRes_Mention struct {
+	Mention *Message `protobuf:"bytes,8,opt,name=mention,proto3,oneof"`
+}
+
+type MessageEventRes_ChatSettings struct {
+	ChatSettings *ChatSettings `protobuf:"bytes,9,opt,name=chat_settings,json=chatSettings,proto3,oneof"`
+}
+
+type MessageEventRes_UserStatus struct {
+	UserStatus *UserStatus `protobuf:"bytes,11,opt,name=user_status,json=userStatus,proto3,oneof"` // собеседник по диалогу chat_id сменил статус
+}
+
 func (*MessageEventRes_NewChatMessage) isMessageEventRes_Event() {}
 
 func (*MessageEventRes_NewChatCreated) isMessageEventRes_Event() {}
@@ -1192,6 +1356,14 @@ func (*MessageEventRes_DeleteChatMessage) isMessageEventRes_Event() {}
 
 func (*MessageEventRes_UserJoined) isMessageEventRes_Event() {}
 
+func (*MessageEventRes_SystemNotification) isMessageEventRes_Event() {}
+
+func (*MessageEventRes_Mention) isMessageEventRes_Event() {}
+
+func (*MessageEventRes_ChatSettings) isMessageEventRes_Event() {}
+
+func (*MessageEventRes_UserStatus) isMessageEventRes_Event() {}
+
 type CreateMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
@@ -1203,7 +1375,7 @@ type CreateMessage struct {
 
 func (x *CreateMessage) Reset() {
 	*x = CreateMessage{}
-	mi := &file_chats_proto_msgTypes[17]
+	mi := &file_chats_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1215,7 +1387,7 @@ func (x *CreateMessage) String() string {
 func (*CreateMessage) ProtoMessage() {}
 
 func (x *CreateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[17]
+	mi := &file_chats_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +1400,7 @@ func (x *CreateMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMessage.ProtoReflect.Descriptor instead.
 func (*CreateMessage) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{17}
+	return file_chats_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateMessage) GetChatId() string {
@@ -1263,7 +1435,7 @@ type CreateAttachment struct {
 
 func (x *CreateAttachment) Reset() {
 	*x = CreateAttachment{}
-	mi := &file_chats_proto_msgTypes[18]
+	mi := &file_chats_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1447,7 @@ func (x *CreateAttachment) String() string {
 func (*CreateAttachment) ProtoMessage() {}
 
 func (x *CreateAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[18]
+	mi := &file_chats_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1460,7 @@ func (x *CreateAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAttachment.ProtoReflect.Descriptor instead.
 func (*CreateAttachment) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{18}
+	return file_chats_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateAttachment) GetType() string {
@@ -1323,7 +1495,7 @@ type Attachment struct {
 
 func (x *Attachment) Reset() {
 	*x = Attachment{}
-	mi := &file_chats_proto_msgTypes[19]
+	mi := &file_chats_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1335,7 +1507,7 @@ func (x *Attachment) String() string {
 func (*Attachment) ProtoMessage() {}
 
 func (x *Attachment) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[19]
+	mi := &file_chats_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +1520,7 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
 func (*Attachment) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{19}
+	return file_chats_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Attachment) GetType() string {
@@ -1389,7 +1561,7 @@ type Message struct {
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_chats_proto_msgTypes[20]
+	mi := &file_chats_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1401,7 +1573,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[20]
+	mi := &file_chats_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +1586,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{20}
+	return file_chats_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Message) GetId() string {
@@ -1491,7 +1663,7 @@ type EditMessage struct {
 
 func (x *EditMessage) Reset() {
 	*x = EditMessage{}
-	mi := &file_chats_proto_msgTypes[21]
+	mi := &file_chats_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1503,7 +1675,7 @@ func (x *EditMessage) String() string {
 func (*EditMessage) ProtoMessage() {}
 
 func (x *EditMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[21]
+	mi := &file_chats_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,7 +1688,7 @@ func (x *EditMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditMessage.ProtoReflect.Descriptor instead.
 func (*EditMessage) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{21}
+	return file_chats_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *EditMessage) GetMessageId() string {
@@ -1549,7 +1721,7 @@ type DeleteMessage struct {
 
 func (x *DeleteMessage) Reset() {
 	*x = DeleteMessage{}
-	mi := &file_chats_proto_msgTypes[22]
+	mi := &file_chats_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1561,7 +1733,7 @@ func (x *DeleteMessage) String() string {
 func (*DeleteMessage) ProtoMessage() {}
 
 func (x *DeleteMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[22]
+	mi := &file_chats_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1574,7 +1746,7 @@ func (x *DeleteMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessage.ProtoReflect.Descriptor instead.
 func (*DeleteMessage) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{22}
+	return file_chats_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteMessage) GetMessageId() string {
@@ -1594,7 +1766,7 @@ type UserJoined struct {
 
 func (x *UserJoined) Reset() {
 	*x = UserJoined{}
-	mi := &file_chats_proto_msgTypes[23]
+	mi := &file_chats_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1778,7 @@ func (x *UserJoined) String() string {
 func (*UserJoined) ProtoMessage() {}
 
 func (x *UserJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[23]
+	mi := &file_chats_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1791,7 @@ func (x *UserJoined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserJoined.ProtoReflect.Descriptor instead.
 func (*UserJoined) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{23}
+	return file_chats_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UserJoined) GetChatId() string {
@@ -1636,28 +1808,140 @@ func (x *UserJoined) GetUserId() string {
 	return ""
 }
 
-type StreamMessagesForUserReq struct {
+type SystemNotification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamMessagesForUserReq) Reset() {
-	*x = StreamMessagesForUserReq{}
-	mi := &file_chats_proto_msgTypes[24]
+func (x *SystemNotification) Reset() {
+	*x = SystemNotification{}
+	mi := &file_chats_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamMessagesForUserReq) String() string {
+func (x *SystemNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamMessagesForUserReq) ProtoMessage() {}
+func (*SystemNotification) ProtoMessage() {}
 
-func (x *StreamMessagesForUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[24]
+func (x *SystemNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemNotification.ProtoReflect.Descriptor instead.
+func (*SystemNotification) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SystemNotification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SystemNotification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type NotifyUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Notification  *SystemNotification    `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyUserReq) Reset() {
+	*x = NotifyUserReq{}
+	mi := &file_chats_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyUserReq) ProtoMessage() {}
+
+func (x *NotifyUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyUserReq.ProtoReflect.Descriptor instead.
+func (*NotifyUserReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NotifyUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotifyUserReq) GetNotification() *SystemNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+type StreamMessagesForUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMessagesForUserReq) Reset() {
+	*x = StreamMessagesForUserReq{}
+	mi := &file_chats_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMessagesForUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMessagesForUserReq) ProtoMessage() {}
+
+func (x *StreamMessagesForUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +1954,7 @@ func (x *StreamMessagesForUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMessagesForUserReq.ProtoReflect.Descriptor instead.
 func (*StreamMessagesForUserReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{24}
+	return file_chats_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *StreamMessagesForUserReq) GetUserId() string {
@@ -1690,7 +1974,7 @@ type GetChatAvatarsReq struct {
 
 func (x *GetChatAvatarsReq) Reset() {
 	*x = GetChatAvatarsReq{}
-	mi := &file_chats_proto_msgTypes[25]
+	mi := &file_chats_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1702,7 +1986,7 @@ func (x *GetChatAvatarsReq) String() string {
 func (*GetChatAvatarsReq) ProtoMessage() {}
 
 func (x *GetChatAvatarsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[25]
+	mi := &file_chats_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,7 +1999,7 @@ func (x *GetChatAvatarsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatAvatarsReq.ProtoReflect.Descriptor instead.
 func (*GetChatAvatarsReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{25}
+	return file_chats_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetChatAvatarsReq) GetUserId() string {
@@ -1741,7 +2025,7 @@ type GetChatAvatarsRes struct {
 
 func (x *GetChatAvatarsRes) Reset() {
 	*x = GetChatAvatarsRes{}
-	mi := &file_chats_proto_msgTypes[26]
+	mi := &file_chats_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1753,7 +2037,7 @@ func (x *GetChatAvatarsRes) String() string {
 func (*GetChatAvatarsRes) ProtoMessage() {}
 
 func (x *GetChatAvatarsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[26]
+	mi := &file_chats_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +2050,7 @@ func (x *GetChatAvatarsRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatAvatarsRes.ProtoReflect.Descriptor instead.
 func (*GetChatAvatarsRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{26}
+	return file_chats_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetChatAvatarsRes) GetAvatars() map[string]string {
@@ -1786,7 +2070,7 @@ type SearchChatsReq struct {
 
 func (x *SearchChatsReq) Reset() {
 	*x = SearchChatsReq{}
-	mi := &file_chats_proto_msgTypes[27]
+	mi := &file_chats_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1798,7 +2082,7 @@ func (x *SearchChatsReq) String() string {
 func (*SearchChatsReq) ProtoMessage() {}
 
 func (x *SearchChatsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[27]
+	mi := &file_chats_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +2095,7 @@ func (x *SearchChatsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchChatsReq.ProtoReflect.Descriptor instead.
 func (*SearchChatsReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{27}
+	return file_chats_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SearchChatsReq) GetUserId() string {
@@ -1828,30 +2112,1327 @@ func (x *SearchChatsReq) GetName() string {
 	return ""
 }
 
-type SearchMessagesReq struct {
+type SearchMessagesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesReq) Reset() {
+	*x = SearchMessagesReq{}
+	mi := &file_chats_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesReq) ProtoMessage() {}
+
+func (x *SearchMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesReq.ProtoReflect.Descriptor instead.
+func (*SearchMessagesReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SearchMessagesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchMessagesReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *SearchMessagesReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SearchMessagesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRes) Reset() {
+	*x = SearchMessagesRes{}
+	mi := &file_chats_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRes) ProtoMessage() {}
+
+func (x *SearchMessagesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRes.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchMessagesRes) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ChatMentionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMentionsReq) Reset() {
+	*x = ChatMentionsReq{}
+	mi := &file_chats_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMentionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMentionsReq) ProtoMessage() {}
+
+func (x *ChatMentionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMentionsReq.ProtoReflect.Descriptor instead.
+func (*ChatMentionsReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChatMentionsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChatMentionsReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+type UpdateChatSettingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Muted         *bool                  `protobuf:"varint,3,opt,name=muted,proto3,oneof" json:"muted,omitempty"`
+	MutedUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"`
+	Archived      *bool                  `protobuf:"varint,5,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	PinOrder      *int32                 `protobuf:"varint,6,opt,name=pin_order,json=pinOrder,proto3,oneof" json:"pin_order,omitempty"`
+	Folder        *string                `protobuf:"bytes,7,opt,name=folder,proto3,oneof" json:"folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChatSettingsReq) Reset() {
+	*x = UpdateChatSettingsReq{}
+	mi := &file_chats_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChatSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChatSettingsReq) ProtoMessage() {}
+
+func (x *UpdateChatSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChatSettingsReq.ProtoReflect.Descriptor instead.
+func (*UpdateChatSettingsReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateChatSettingsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateChatSettingsReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *UpdateChatSettingsReq) GetMuted() bool {
+	if x != nil && x.Muted != nil {
+		return *x.Muted
+	}
+	return false
+}
+
+func (x *UpdateChatSettingsReq) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *UpdateChatSettingsReq) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *UpdateChatSettingsReq) GetPinOrder() int32 {
+	if x != nil && x.PinOrder != nil {
+		return *x.PinOrder
+	}
+	return 0
+}
+
+func (x *UpdateChatSettingsReq) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
+// Пустой username делает группу или канал приватными
+type SetChatUsernameReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChatUsernameReq) Reset() {
+	*x = SetChatUsernameReq{}
+	mi := &file_chats_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatUsernameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatUsernameReq) ProtoMessage() {}
+
+func (x *SetChatUsernameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatUsernameReq.ProtoReflect.Descriptor instead.
+func (*SetChatUsernameReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetChatUsernameReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetChatUsernameReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *SetChatUsernameReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ResolveChatUsernameReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveChatUsernameReq) Reset() {
+	*x = ResolveChatUsernameReq{}
+	mi := &file_chats_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveChatUsernameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveChatUsernameReq) ProtoMessage() {}
+
+func (x *ResolveChatUsernameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveChatUsernameReq.ProtoReflect.Descriptor instead.
+func (*ResolveChatUsernameReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResolveChatUsernameReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResolveChatUsernameReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type PublicChat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	MembersCount  int32                  `protobuf:"varint,6,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	IsMember      bool                   `protobuf:"varint,7,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicChat) Reset() {
+	*x = PublicChat{}
+	mi := &file_chats_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicChat) ProtoMessage() {}
+
+func (x *PublicChat) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicChat.ProtoReflect.Descriptor instead.
+func (*PublicChat) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PublicChat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublicChat) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PublicChat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicChat) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PublicChat) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PublicChat) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *PublicChat) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type ResolveChatUsernameRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chat          *PublicChat            `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	Redirected    bool                   `protobuf:"varint,2,opt,name=redirected,proto3" json:"redirected,omitempty"` // найден по прежнему handle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveChatUsernameRes) Reset() {
+	*x = ResolveChatUsernameRes{}
+	mi := &file_chats_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveChatUsernameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveChatUsernameRes) ProtoMessage() {}
+
+func (x *ResolveChatUsernameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveChatUsernameRes.ProtoReflect.Descriptor instead.
+func (*ResolveChatUsernameRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResolveChatUsernameRes) GetChat() *PublicChat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *ResolveChatUsernameRes) GetRedirected() bool {
+	if x != nil {
+		return x.Redirected
+	}
+	return false
+}
+
+// Пригласительная ссылка; отсутствующие ограничения - ссылка без срока и лимита
+type CreateInviteReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId           string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	UsageLimit       *int32                 `protobuf:"varint,5,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,6,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateInviteReq) Reset() {
+	*x = CreateInviteReq{}
+	mi := &file_chats_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteReq) ProtoMessage() {}
+
+func (x *CreateInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteReq.ProtoReflect.Descriptor instead.
+func (*CreateInviteReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateInviteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateInviteReq) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *CreateInviteReq) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+type Invite struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatorId        string                 `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	UsageLimit       *int32                 `protobuf:"varint,6,opt,name=usage_limit,json=usageLimit,proto3,oneof" json:"usage_limit,omitempty"`
+	UsageCount       int32                  `protobuf:"varint,7,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,8,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	IsRevoked        bool                   `protobuf:"varint,9,opt,name=is_revoked,json=isRevoked,proto3" json:"is_revoked,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_chats_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetUsageLimit() int32 {
+	if x != nil && x.UsageLimit != nil {
+		return *x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Invite) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *Invite) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Invite) GetIsRevoked() bool {
+	if x != nil {
+		return x.IsRevoked
+	}
+	return false
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetChatInvitesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatInvitesRes) Reset() {
+	*x = GetChatInvitesRes{}
+	mi := &file_chats_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatInvitesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatInvitesRes) ProtoMessage() {}
+
+func (x *GetChatInvitesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatInvitesRes.ProtoReflect.Descriptor instead.
+func (*GetChatInvitesRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetChatInvitesRes) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,3,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteReq) Reset() {
+	*x = RevokeInviteReq{}
+	mi := &file_chats_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteReq) ProtoMessage() {}
+
+func (x *RevokeInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteReq.ProtoReflect.Descriptor instead.
+func (*RevokeInviteReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RevokeInviteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeInviteReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *RevokeInviteReq) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type JoinChatByInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinChatByInviteReq) Reset() {
+	*x = JoinChatByInviteReq{}
+	mi := &file_chats_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinChatByInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChatByInviteReq) ProtoMessage() {}
+
+func (x *JoinChatByInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChatByInviteReq.ProtoReflect.Descriptor instead.
+func (*JoinChatByInviteReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JoinChatByInviteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinChatByInviteReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinChatByInviteRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Pending       bool                   `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"` // заявка ждёт одобрения админа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinChatByInviteRes) Reset() {
+	*x = JoinChatByInviteRes{}
+	mi := &file_chats_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinChatByInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinChatByInviteRes) ProtoMessage() {}
+
+func (x *JoinChatByInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinChatByInviteRes.ProtoReflect.Descriptor instead.
+func (*JoinChatByInviteRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JoinChatByInviteRes) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *JoinChatByInviteRes) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type JoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	InviteId      *string                `protobuf:"bytes,3,opt,name=invite_id,json=inviteId,proto3,oneof" json:"invite_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_chats_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *JoinRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetInviteId() string {
+	if x != nil && x.InviteId != nil {
+		return *x.InviteId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetJoinRequestsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*JoinRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinRequestsRes) Reset() {
+	*x = GetJoinRequestsRes{}
+	mi := &file_chats_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinRequestsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinRequestsRes) ProtoMessage() {}
+
+func (x *GetJoinRequestsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinRequestsRes.ProtoReflect.Descriptor instead.
+func (*GetJoinRequestsRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetJoinRequestsRes) GetRequests() []*JoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type JoinRequestDecisionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // админ, принимающий решение
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	RequesterId   string                 `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequestDecisionReq) Reset() {
+	*x = JoinRequestDecisionReq{}
+	mi := &file_chats_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestDecisionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestDecisionReq) ProtoMessage() {}
+
+func (x *JoinRequestDecisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestDecisionReq.ProtoReflect.Descriptor instead.
+func (*JoinRequestDecisionReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *JoinRequestDecisionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinRequestDecisionReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *JoinRequestDecisionReq) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+// Межсервисный запрос user_service: участники общих с user_id групп для ранжирования поиска людей
+type GetGroupPeersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPeersReq) Reset() {
+	*x = GetGroupPeersReq{}
+	mi := &file_chats_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPeersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPeersReq) ProtoMessage() {}
+
+func (x *GetGroupPeersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPeersReq.ProtoReflect.Descriptor instead.
+func (*GetGroupPeersReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetGroupPeersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetGroupPeersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPeersRes) Reset() {
+	*x = GetGroupPeersRes{}
+	mi := &file_chats_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPeersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPeersRes) ProtoMessage() {}
+
+func (x *GetGroupPeersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupPeersRes.ProtoReflect.Descriptor instead.
+func (*GetGroupPeersRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetGroupPeersRes) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type UploadChatAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChatAvatarReq) Reset() {
+	*x = UploadChatAvatarReq{}
+	mi := &file_chats_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChatAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChatAvatarReq) ProtoMessage() {}
+
+func (x *UploadChatAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChatAvatarReq.ProtoReflect.Descriptor instead.
+func (*UploadChatAvatarReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UploadChatAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadChatAvatarReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *UploadChatAvatarReq) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadChatAvatarReq) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadChatAvatarReq) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadChatAvatarRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChatAvatarRes) Reset() {
+	*x = UploadChatAvatarRes{}
+	mi := &file_chats_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChatAvatarRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChatAvatarRes) ProtoMessage() {}
+
+func (x *UploadChatAvatarRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChatAvatarRes.ProtoReflect.Descriptor instead.
+func (*UploadChatAvatarRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UploadChatAvatarRes) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ChatAvatar struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Sizes         map[int32]string       `protobuf:"bytes,3,rep,name=sizes,proto3" json:"sizes,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // сторона квадрата в пикселях -> url
+	IsCurrent     bool                   `protobuf:"varint,4,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchMessagesReq) Reset() {
-	*x = SearchMessagesReq{}
-	mi := &file_chats_proto_msgTypes[28]
+func (x *ChatAvatar) Reset() {
+	*x = ChatAvatar{}
+	mi := &file_chats_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchMessagesReq) String() string {
+func (x *ChatAvatar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessagesReq) ProtoMessage() {}
+func (*ChatAvatar) ProtoMessage() {}
 
-func (x *SearchMessagesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[28]
+func (x *ChatAvatar) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,54 +3443,69 @@ func (x *SearchMessagesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessagesReq.ProtoReflect.Descriptor instead.
-func (*SearchMessagesReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ChatAvatar.ProtoReflect.Descriptor instead.
+func (*ChatAvatar) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SearchMessagesReq) GetUserId() string {
+func (x *ChatAvatar) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchMessagesReq) GetChatId() string {
+func (x *ChatAvatar) GetUrl() string {
 	if x != nil {
-		return x.ChatId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *SearchMessagesReq) GetText() string {
+func (x *ChatAvatar) GetSizes() map[int32]string {
 	if x != nil {
-		return x.Text
+		return x.Sizes
 	}
-	return ""
+	return nil
 }
 
-type SearchMessagesRes struct {
+func (x *ChatAvatar) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *ChatAvatar) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetChatAvatarHistoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchMessagesRes) Reset() {
-	*x = SearchMessagesRes{}
-	mi := &file_chats_proto_msgTypes[29]
+func (x *GetChatAvatarHistoryReq) Reset() {
+	*x = GetChatAvatarHistoryReq{}
+	mi := &file_chats_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchMessagesRes) String() string {
+func (x *GetChatAvatarHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessagesRes) ProtoMessage() {}
+func (*GetChatAvatarHistoryReq) ProtoMessage() {}
 
-func (x *SearchMessagesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[29]
+func (x *GetChatAvatarHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,44 +3516,47 @@ func (x *SearchMessagesRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessagesRes.ProtoReflect.Descriptor instead.
-func (*SearchMessagesRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetChatAvatarHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetChatAvatarHistoryReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *SearchMessagesRes) GetMessages() []*Message {
+func (x *GetChatAvatarHistoryReq) GetUserId() string {
 	if x != nil {
-		return x.Messages
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type UploadChatAvatarReq struct {
+func (x *GetChatAvatarHistoryReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+type GetChatAvatarHistoryRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Avatars       []*ChatAvatar          `protobuf:"bytes,1,rep,name=avatars,proto3" json:"avatars,omitempty"` // начиная с текущей
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadChatAvatarReq) Reset() {
-	*x = UploadChatAvatarReq{}
-	mi := &file_chats_proto_msgTypes[30]
+func (x *GetChatAvatarHistoryRes) Reset() {
+	*x = GetChatAvatarHistoryRes{}
+	mi := &file_chats_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadChatAvatarReq) String() string {
+func (x *GetChatAvatarHistoryRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadChatAvatarReq) ProtoMessage() {}
+func (*GetChatAvatarHistoryRes) ProtoMessage() {}
 
-func (x *UploadChatAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[30]
+func (x *GetChatAvatarHistoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,68 +3567,42 @@ func (x *UploadChatAvatarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadChatAvatarReq.ProtoReflect.Descriptor instead.
-func (*UploadChatAvatarReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *UploadChatAvatarReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UploadChatAvatarReq) GetChatId() string {
-	if x != nil {
-		return x.ChatId
-	}
-	return ""
+// Deprecated: Use GetChatAvatarHistoryRes.ProtoReflect.Descriptor instead.
+func (*GetChatAvatarHistoryRes) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UploadChatAvatarReq) GetData() []byte {
+func (x *GetChatAvatarHistoryRes) GetAvatars() []*ChatAvatar {
 	if x != nil {
-		return x.Data
+		return x.Avatars
 	}
 	return nil
 }
 
-func (x *UploadChatAvatarReq) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *UploadChatAvatarReq) GetContentType() string {
-	if x != nil {
-		return x.ContentType
-	}
-	return ""
-}
-
-type UploadChatAvatarRes struct {
+type ChatAvatarReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChatId        string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	AvatarId      string                 `protobuf:"bytes,3,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadChatAvatarRes) Reset() {
-	*x = UploadChatAvatarRes{}
-	mi := &file_chats_proto_msgTypes[31]
+func (x *ChatAvatarReq) Reset() {
+	*x = ChatAvatarReq{}
+	mi := &file_chats_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadChatAvatarRes) String() string {
+func (x *ChatAvatarReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadChatAvatarRes) ProtoMessage() {}
+func (*ChatAvatarReq) ProtoMessage() {}
 
-func (x *UploadChatAvatarRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[31]
+func (x *ChatAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,14 +3613,28 @@ func (x *UploadChatAvatarRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadChatAvatarRes.ProtoReflect.Descriptor instead.
-func (*UploadChatAvatarRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ChatAvatarReq.ProtoReflect.Descriptor instead.
+func (*ChatAvatarReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UploadChatAvatarRes) GetAvatarUrl() string {
+func (x *ChatAvatarReq) GetUserId() string {
 	if x != nil {
-		return x.AvatarUrl
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChatAvatarReq) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *ChatAvatarReq) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
 	}
 	return ""
 }
@@ -2066,7 +3653,7 @@ type UploadAttachmentReq struct {
 
 func (x *UploadAttachmentReq) Reset() {
 	*x = UploadAttachmentReq{}
-	mi := &file_chats_proto_msgTypes[32]
+	mi := &file_chats_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +3665,7 @@ func (x *UploadAttachmentReq) String() string {
 func (*UploadAttachmentReq) ProtoMessage() {}
 
 func (x *UploadAttachmentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[32]
+	mi := &file_chats_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +3678,7 @@ func (x *UploadAttachmentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadAttachmentReq.ProtoReflect.Descriptor instead.
 func (*UploadAttachmentReq) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{32}
+	return file_chats_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *UploadAttachmentReq) GetUserId() string {
@@ -2148,7 +3735,7 @@ type UploadAttachmentRes struct {
 
 func (x *UploadAttachmentRes) Reset() {
 	*x = UploadAttachmentRes{}
-	mi := &file_chats_proto_msgTypes[33]
+	mi := &file_chats_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2160,7 +3747,7 @@ func (x *UploadAttachmentRes) String() string {
 func (*UploadAttachmentRes) ProtoMessage() {}
 
 func (x *UploadAttachmentRes) ProtoReflect() protoreflect.Message {
-	mi := &file_chats_proto_msgTypes[33]
+	mi := &file_chats_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,7 +3760,7 @@ func (x *UploadAttachmentRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadAttachmentRes.ProtoReflect.Descriptor instead.
 func (*UploadAttachmentRes) Descriptor() ([]byte, []int) {
-	return file_chats_proto_rawDescGZIP(), []int{33}
+	return file_chats_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *UploadAttachmentRes) GetAttachmentId() string {
@@ -2204,19 +3791,142 @@ func (x *UploadAttachmentRes) GetDuration() int32 {
 	return 0
 }
 
+// Событие user_service об изменении профиля: chats_service сбрасывает кэш пользователя
+type UserProfileChangedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfileChangedReq) Reset() {
+	*x = UserProfileChangedReq{}
+	mi := &file_chats_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfileChangedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfileChangedReq) ProtoMessage() {}
+
+func (x *UserProfileChangedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfileChangedReq.ProtoReflect.Descriptor instead.
+func (*UserProfileChangedReq) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UserProfileChangedReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Статус пользователя; пустые emoji и text - статус сброшен
+type UserStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // RFC3339; по истечении срока событие не присылается
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatus) Reset() {
+	*x = UserStatus{}
+	mi := &file_chats_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatus) ProtoMessage() {}
+
+func (x *UserStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_chats_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatus.ProtoReflect.Descriptor instead.
+func (*UserStatus) Descriptor() ([]byte, []int) {
+	return file_chats_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UserStatus) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserStatus) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *UserStatus) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UserStatus) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
 var File_chats_proto protoreflect.FileDescriptor
 
 const file_chats_proto_rawDesc = "" +
 	"\n" +
-	"\vchats.proto\x12\x05chats\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x01\n" +
+	"\vchats.proto\x12\x05chats\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x01\n" +
 	"\x04Chat\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
 	"\flast_message\x18\x03 \x01(\v2\x0e.chats.MessageR\vlastMessage\x12\x12\n" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12\"\n" +
 	"\n" +
-	"avatar_url\x18\x05 \x01(\tH\x00R\tavatarUrl\x88\x01\x01B\r\n" +
-	"\v_avatar_url\"\x8e\x01\n" +
+	"avatar_url\x18\x05 \x01(\tH\x00R\tavatarUrl\x88\x01\x01\x12/\n" +
+	"\bsettings\x18\x06 \x01(\v2\x13.chats.ChatSettingsR\bsettingsB\r\n" +
+	"\v_avatar_url\"\xab\x01\n" +
+	"\fChatSettings\x12\x14\n" +
+	"\x05muted\x18\x01 \x01(\bR\x05muted\x12$\n" +
+	"\vmuted_until\x18\x02 \x01(\tH\x00R\n" +
+	"mutedUntil\x88\x01\x01\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\x12\x1b\n" +
+	"\tpin_order\x18\x04 \x01(\x05R\bpinOrder\x12\x16\n" +
+	"\x06folder\x18\x05 \x01(\tR\x06folderB\x0e\n" +
+	"\f_muted_until\"\x8e\x01\n" +
 	"\fUserInfoChat\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tuser_name\x18\x02 \x01(\tR\buserName\x12$\n" +
@@ -2240,9 +3950,13 @@ const file_chats_proto_rawDesc = "" +
 	"\n" +
 	"avatar_url\x18\v \x01(\tH\x01R\tavatarUrl\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\r\n" +
-	"\v_avatar_url\"&\n" +
+	"\v_avatar_url\"|\n" +
 	"\vGetChatsReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\barchived\x18\x02 \x01(\bH\x00R\barchived\x88\x01\x01\x12\x1b\n" +
+	"\x06folder\x18\x03 \x01(\tH\x01R\x06folder\x88\x01\x01B\v\n" +
+	"\t_archivedB\t\n" +
+	"\a_folder\"0\n" +
 	"\vGetChatsRes\x12!\n" +
 	"\x05chats\x18\x01 \x03(\v2\v.chats.ChatR\x05chats\"\x8b\x01\n" +
 	"\n" +
@@ -2293,7 +4007,7 @@ const file_chats_proto_rawDesc = "" +
 	"\x10new_chat_message\x18\x02 \x01(\v2\x14.chats.CreateMessageH\x00R\x0enewChatMessage\x12@\n" +
 	"\x11edit_chat_message\x18\x03 \x01(\v2\x12.chats.EditMessageH\x00R\x0feditChatMessage\x12F\n" +
 	"\x13delete_chat_message\x18\x04 \x01(\v2\x14.chats.DeleteMessageH\x00R\x11deleteChatMessageB\a\n" +
-	"\x05event\"\xe8\x02\n" +
+	"\x05event\"\xea\x04\n" +
 	"\x0fMessageEventRes\x12\x17\n" +
 	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12:\n" +
 	"\x10new_chat_message\x18\x02 \x01(\v2\x0e.chats.MessageH\x00R\x0enewChatMessage\x127\n" +
@@ -2301,7 +4015,14 @@ const file_chats_proto_rawDesc = "" +
 	"\x11edit_chat_message\x18\x04 \x01(\v2\x12.chats.EditMessageH\x00R\x0feditChatMessage\x12F\n" +
 	"\x13delete_chat_message\x18\x05 \x01(\v2\x14.chats.DeleteMessageH\x00R\x11deleteChatMessage\x124\n" +
 	"\vuser_joined\x18\x06 \x01(\v2\x11.chats.UserJoinedH\x00R\n" +
-	"userJoinedB\a\n" +
+	"userJoined\x12L\n" +
+	"\x13system_notification\x18\a \x01(\v2\x19.chats.SystemNotificationH\x00R\x12systemNotification\x12*\n" +
+	"\amention\x18\b \x01(\v2\x0e.chats.MessageH\x00R\amention\x12:\n" +
+	"\rchat_settings\x18\t \x01(\v2\x13.chats.ChatSettingsH\x00R\fchatSettings\x124\n" +
+	"\vuser_status\x18\v \x01(\v2\x11.chats.UserStatusH\x00R\n" +
+	"userStatus\x12\x14\n" +
+	"\x05muted\x18\n" +
+	" \x01(\bR\x05mutedB\a\n" +
 	"\x05event\"\x89\x01\n" +
 	"\rCreateMessage\x12\x17\n" +
 	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x12\n" +
@@ -2351,7 +4072,15 @@ const file_chats_proto_rawDesc = "" +
 	"\n" +
 	"UserJoined\x12\x17\n" +
 	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"[\n" +
+	"\x12SystemNotification\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"g\n" +
+	"\rNotifyUserReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\fnotification\x18\x02 \x01(\v2\x19.chats.SystemNotificationR\fnotification\"3\n" +
 	"\x18StreamMessagesForUserReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"G\n" +
 	"\x11GetChatAvatarsReq\x12\x17\n" +
@@ -2370,7 +4099,107 @@ const file_chats_proto_rawDesc = "" +
 	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x12\n" +
 	"\x04text\x18\x03 \x01(\tR\x04text\"?\n" +
 	"\x11SearchMessagesRes\x12*\n" +
-	"\bmessages\x18\x01 \x03(\v2\x0e.chats.MessageR\bmessages\"\x9a\x01\n" +
+	"\bmessages\x18\x01 \x03(\v2\x0e.chats.MessageR\bmessages\"C\n" +
+	"\x0fChatMentionsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\"\xc6\x02\n" +
+	"\x15UpdateChatSettingsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x19\n" +
+	"\x05muted\x18\x03 \x01(\bH\x00R\x05muted\x88\x01\x01\x12@\n" +
+	"\vmuted_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"mutedUntil\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x05 \x01(\bH\x02R\barchived\x88\x01\x01\x12 \n" +
+	"\tpin_order\x18\x06 \x01(\x05H\x03R\bpinOrder\x88\x01\x01\x12\x1b\n" +
+	"\x06folder\x18\a \x01(\tH\x04R\x06folder\x88\x01\x01B\b\n" +
+	"\x06_mutedB\x0e\n" +
+	"\f_muted_untilB\v\n" +
+	"\t_archivedB\f\n" +
+	"\n" +
+	"_pin_orderB\t\n" +
+	"\a_folder\"b\n" +
+	"\x12SetChatUsernameReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"M\n" +
+	"\x16ResolveChatUsernameReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xc4\x01\n" +
+	"\n" +
+	"PublicChat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12#\n" +
+	"\rmembers_count\x18\x06 \x01(\x05R\fmembersCount\x12\x1b\n" +
+	"\tis_member\x18\a \x01(\bR\bisMember\"_\n" +
+	"\x16ResolveChatUsernameRes\x12%\n" +
+	"\x04chat\x18\x01 \x01(\v2\x11.chats.PublicChatR\x04chat\x12\x1e\n" +
+	"\n" +
+	"redirected\x18\x02 \x01(\bR\n" +
+	"redirected\"\x89\x02\n" +
+	"\x0fCreateInviteReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12$\n" +
+	"\vusage_limit\x18\x05 \x01(\x05H\x01R\n" +
+	"usageLimit\x88\x01\x01\x12+\n" +
+	"\x11requires_approval\x18\x06 \x01(\bR\x10requiresApprovalB\r\n" +
+	"\v_expires_atB\x0e\n" +
+	"\f_usage_limit\"\x8c\x03\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tR\tcreatorId\x12>\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x12$\n" +
+	"\vusage_limit\x18\x06 \x01(\x05H\x01R\n" +
+	"usageLimit\x88\x01\x01\x12\x1f\n" +
+	"\vusage_count\x18\a \x01(\x05R\n" +
+	"usageCount\x12+\n" +
+	"\x11requires_approval\x18\b \x01(\bR\x10requiresApproval\x12\x1d\n" +
+	"\n" +
+	"is_revoked\x18\t \x01(\bR\tisRevoked\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_expires_atB\x0e\n" +
+	"\f_usage_limit\"<\n" +
+	"\x11GetChatInvitesRes\x12'\n" +
+	"\ainvites\x18\x01 \x03(\v2\r.chats.InviteR\ainvites\"`\n" +
+	"\x0fRevokeInviteReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x1b\n" +
+	"\tinvite_id\x18\x03 \x01(\tR\binviteId\"B\n" +
+	"\x13JoinChatByInviteReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"H\n" +
+	"\x13JoinChatByInviteRes\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x18\n" +
+	"\apending\x18\x02 \x01(\bR\apending\"\xae\x01\n" +
+	"\vJoinRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12 \n" +
+	"\tinvite_id\x18\x03 \x01(\tH\x00R\binviteId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\f\n" +
+	"\n" +
+	"_invite_id\"D\n" +
+	"\x12GetJoinRequestsRes\x12.\n" +
+	"\brequests\x18\x01 \x03(\v2\x12.chats.JoinRequestR\brequests\"m\n" +
+	"\x16JoinRequestDecisionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\tR\vrequesterId\"+\n" +
+	"\x10GetGroupPeersReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"-\n" +
+	"\x10GetGroupPeersRes\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\x9a\x01\n" +
 	"\x13UploadChatAvatarReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x12\n" +
@@ -2379,7 +4208,29 @@ const file_chats_proto_rawDesc = "" +
 	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\"4\n" +
 	"\x13UploadChatAvatarRes\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\"\xc8\x01\n" +
+	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\"\xf6\x01\n" +
+	"\n" +
+	"ChatAvatar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x122\n" +
+	"\x05sizes\x18\x03 \x03(\v2\x1c.chats.ChatAvatar.SizesEntryR\x05sizes\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x04 \x01(\bR\tisCurrent\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a8\n" +
+	"\n" +
+	"SizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x17GetChatAvatarHistoryReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\"F\n" +
+	"\x17GetChatAvatarHistoryRes\x12+\n" +
+	"\aavatars\x18\x01 \x03(\v2\x11.chats.ChatAvatarR\aavatars\"^\n" +
+	"\rChatAvatarReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x1b\n" +
+	"\tavatar_id\x18\x03 \x01(\tR\bavatarId\"\xc8\x01\n" +
 	"\x13UploadAttachmentReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12\x12\n" +
@@ -2393,7 +4244,17 @@ const file_chats_proto_rawDesc = "" +
 	"\bfile_url\x18\x02 \x01(\tR\afileUrl\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1f\n" +
 	"\bduration\x18\x04 \x01(\x05H\x00R\bduration\x88\x01\x01B\v\n" +
-	"\t_duration2\x83\x06\n" +
+	"\t_duration\"0\n" +
+	"\x15UserProfileChangedReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x82\x01\n" +
+	"\n" +
+	"UserStatus\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at2\xe7\r\n" +
 	"\vChatService\x122\n" +
 	"\bGetChats\x12\x12.chats.GetChatsReq\x1a\x12.chats.GetChatsRes\x12<\n" +
 	"\aGetChat\x12\x11.chats.GetChatReq\x1a\x1e.chats.ChatDetailedInformation\x12G\n" +
@@ -2408,13 +4269,34 @@ const file_chats_proto_rawDesc = "" +
 	"\rAddUserToChat\x12\x17.chats.AddUserToChatReq\x1a\x16.google.protobuf.Empty\x12J\n" +
 	"\x12RemoveUserFromChat\x12\x1c.chats.RemoveUserFromChatReq\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\x0eGetChatAvatars\x12\x18.chats.GetChatAvatarsReq\x1a\x18.chats.GetChatAvatarsRes\x12J\n" +
-	"\x10UploadChatAvatar\x12\x1a.chats.UploadChatAvatarReq\x1a\x1a.chats.UploadChatAvatarRes\x128\n" +
-	"\vSearchChats\x12\x15.chats.SearchChatsReq\x1a\x12.chats.GetChatsRes2\xbb\x02\n" +
+	"\x10UploadChatAvatar\x12\x1a.chats.UploadChatAvatarReq\x1a\x1a.chats.UploadChatAvatarRes\x12V\n" +
+	"\x14GetChatAvatarHistory\x12\x1e.chats.GetChatAvatarHistoryReq\x1a\x1e.chats.GetChatAvatarHistoryRes\x12@\n" +
+	"\x10DeleteChatAvatar\x12\x14.chats.ChatAvatarReq\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\x14SetCurrentChatAvatar\x12\x14.chats.ChatAvatarReq\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\vSearchChats\x12\x15.chats.SearchChatsReq\x1a\x12.chats.GetChatsRes\x12G\n" +
+	"\x12UpdateChatSettings\x12\x1c.chats.UpdateChatSettingsReq\x1a\x13.chats.ChatSettings\x12A\n" +
+	"\rGetGroupPeers\x12\x17.chats.GetGroupPeersReq\x1a\x17.chats.GetGroupPeersRes\x12D\n" +
+	"\x0fSetChatUsername\x12\x19.chats.SetChatUsernameReq\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x13ResolveChatUsername\x12\x1d.chats.ResolveChatUsernameReq\x1a\x1d.chats.ResolveChatUsernameRes\x125\n" +
+	"\fCreateInvite\x12\x16.chats.CreateInviteReq\x1a\r.chats.Invite\x12=\n" +
+	"\x0eGetChatInvites\x12\x11.chats.GetChatReq\x1a\x18.chats.GetChatInvitesRes\x12>\n" +
+	"\fRevokeInvite\x12\x16.chats.RevokeInviteReq\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x10JoinChatByInvite\x12\x1a.chats.JoinChatByInviteReq\x1a\x1a.chats.JoinChatByInviteRes\x12?\n" +
+	"\x0fGetJoinRequests\x12\x11.chats.GetChatReq\x1a\x19.chats.GetJoinRequestsRes\x12K\n" +
+	"\x12ApproveJoinRequest\x12\x1d.chats.JoinRequestDecisionReq\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x12DeclineJoinRequest\x12\x1d.chats.JoinRequestDecisionReq\x1a\x16.google.protobuf.Empty2\xff\x03\n" +
 	"\x0eMessageService\x12R\n" +
 	"\x15StreamMessagesForUser\x12\x1f.chats.StreamMessagesForUserReq\x1a\x16.chats.MessageEventRes0\x01\x12C\n" +
 	"\x11HandleSendMessage\x12\x16.chats.MessageEventReq\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\x0eSearchMessages\x12\x18.chats.SearchMessagesReq\x1a\x18.chats.SearchMessagesRes\x12J\n" +
-	"\x10UploadAttachment\x12\x1a.chats.UploadAttachmentReq\x1a\x1a.chats.UploadAttachmentResBPZNgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chatsb\x06proto3"
+	"\x10UploadAttachment\x12\x1a.chats.UploadAttachmentReq\x1a\x1a.chats.UploadAttachmentRes\x12:\n" +
+	"\n" +
+	"NotifyUser\x12\x14.chats.NotifyUserReq\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x11GetUnreadMentions\x12\x16.chats.ChatMentionsReq\x1a\x19.chats.GetChatMessagesRes\x12>\n" +
+	"\fReadMentions\x12\x16.chats.ChatMentionsReq\x1a\x16.google.protobuf.Empty2\x9f\x01\n" +
+	"\x11UserEventsService\x12J\n" +
+	"\x12UserProfileChanged\x12\x1c.chats.UserProfileChangedReq\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\x11UserStatusChanged\x12\x11.chats.UserStatus\x1a\x16.google.protobuf.EmptyBPZNgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chatsb\x06proto3"
 
 var (
 	file_chats_proto_rawDescOnce sync.Once
@@ -2428,104 +4310,186 @@ func file_chats_proto_rawDescGZIP() []byte {
 	return file_chats_proto_rawDescData
 }
 
-var file_chats_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_chats_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_chats_proto_goTypes = []any{
 	(*Chat)(nil),                     // 0: chats.Chat
-	(*UserInfoChat)(nil),             // 1: chats.UserInfoChat
-	(*ChatDetailedInformation)(nil),  // 2: chats.ChatDetailedInformation
-	(*GetChatsReq)(nil),              // 3: chats.GetChatsReq
-	(*GetChatsRes)(nil),              // 4: chats.GetChatsRes
-	(*GetChatReq)(nil),               // 5: chats.GetChatReq
-	(*GetChatMessagesReq)(nil),       // 6: chats.GetChatMessagesReq
-	(*GetChatMessagesRes)(nil),       // 7: chats.GetChatMessagesRes
-	(*GetUsersDialogReq)(nil),        // 8: chats.GetUsersDialogReq
-	(*AddMember)(nil),                // 9: chats.AddMember
-	(*CreateChatReq)(nil),            // 10: chats.CreateChatReq
-	(*IdRes)(nil),                    // 11: chats.IdRes
-	(*UpdateChatReq)(nil),            // 12: chats.UpdateChatReq
-	(*AddUserToChatReq)(nil),         // 13: chats.AddUserToChatReq
-	(*RemoveUserFromChatReq)(nil),    // 14: chats.RemoveUserFromChatReq
-	(*MessageEventReq)(nil),          // 15: chats.MessageEventReq
-	(*MessageEventRes)(nil),          // 16: chats.MessageEventRes
-	(*CreateMessage)(nil),            // 17: chats.CreateMessage
-	(*CreateAttachment)(nil),         // 18: chats.CreateAttachment
-	(*Attachment)(nil),               // 19: chats.Attachment
-	(*Message)(nil),                  // 20: chats.Message
-	(*EditMessage)(nil),              // 21: chats.EditMessage
-	(*DeleteMessage)(nil),            // 22: chats.DeleteMessage
-	(*UserJoined)(nil),               // 23: chats.UserJoined
-	(*StreamMessagesForUserReq)(nil), // 24: chats.StreamMessagesForUserReq
-	(*GetChatAvatarsReq)(nil),        // 25: chats.GetChatAvatarsReq
-	(*GetChatAvatarsRes)(nil),        // 26: chats.GetChatAvatarsRes
-	(*SearchChatsReq)(nil),           // 27: chats.SearchChatsReq
-	(*SearchMessagesReq)(nil),        // 28: chats.SearchMessagesReq
-	(*SearchMessagesRes)(nil),        // 29: chats.SearchMessagesRes
-	(*UploadChatAvatarReq)(nil),      // 30: chats.UploadChatAvatarReq
-	(*UploadChatAvatarRes)(nil),      // 31: chats.UploadChatAvatarRes
-	(*UploadAttachmentReq)(nil),      // 32: chats.UploadAttachmentReq
-	(*UploadAttachmentRes)(nil),      // 33: chats.UploadAttachmentRes
-	nil,                              // 34: chats.GetChatAvatarsRes.AvatarsEntry
-	(*timestamppb.Timestamp)(nil),    // 35: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 36: google.protobuf.Empty
+	(*ChatSettings)(nil),             // 1: chats.ChatSettings
+	(*UserInfoChat)(nil),             // 2: chats.UserInfoChat
+	(*ChatDetailedInformation)(nil),  // 3: chats.ChatDetailedInformation
+	(*GetChatsReq)(nil),              // 4: chats.GetChatsReq
+	(*GetChatsRes)(nil),              // 5: chats.GetChatsRes
+	(*GetChatReq)(nil),               // 6: chats.GetChatReq
+	(*GetChatMessagesReq)(nil),       // 7: chats.GetChatMessagesReq
+	(*GetChatMessagesRes)(nil),       // 8: chats.GetChatMessagesRes
+	(*GetUsersDialogReq)(nil),        // 9: chats.GetUsersDialogReq
+	(*AddMember)(nil),                // 10: chats.AddMember
+	(*CreateChatReq)(nil),            // 11: chats.CreateChatReq
+	(*IdRes)(nil),                    // 12: chats.IdRes
+	(*UpdateChatReq)(nil),            // 13: chats.UpdateChatReq
+	(*AddUserToChatReq)(nil),         // 14: chats.AddUserToChatReq
+	(*RemoveUserFromChatReq)(nil),    // 15: chats.RemoveUserFromChatReq
+	(*MessageEventReq)(nil),          // 16: chats.MessageEventReq
+	(*MessageEventRes)(nil),          // 17: chats.MessageEventRes
+	(*CreateMessage)(nil),            // 18: chats.CreateMessage
+	(*CreateAttachment)(nil),         // 19: chats.CreateAttachment
+	(*Attachment)(nil),               // 20: chats.Attachment
+	(*Message)(nil),                  // 21: chats.Message
+	(*EditMessage)(nil),              // 22: chats.EditMessage
+	(*DeleteMessage)(nil),            // 23: chats.DeleteMessage
+	(*UserJoined)(nil),               // 24: chats.UserJoined
+	(*SystemNotification)(nil),       // 25: chats.SystemNotification
+	(*NotifyUserReq)(nil),            // 26: chats.NotifyUserReq
+	(*StreamMessagesForUserReq)(nil), // 27: chats.StreamMessagesForUserReq
+	(*GetChatAvatarsReq)(nil),        // 28: chats.GetChatAvatarsReq
+	(*GetChatAvatarsRes)(nil),        // 29: chats.GetChatAvatarsRes
+	(*SearchChatsReq)(nil),           // 30: chats.SearchChatsReq
+	(*SearchMessagesReq)(nil),        // 31: chats.SearchMessagesReq
+	(*SearchMessagesRes)(nil),        // 32: chats.SearchMessagesRes
+	(*ChatMentionsReq)(nil),          // 33: chats.ChatMentionsReq
+	(*UpdateChatSettingsReq)(nil),    // 34: chats.UpdateChatSettingsReq
+	(*SetChatUsernameReq)(nil),       // 35: chats.SetChatUsernameReq
+	(*ResolveChatUsernameReq)(nil),   // 36: chats.ResolveChatUsernameReq
+	(*PublicChat)(nil),               // 37: chats.PublicChat
+	(*ResolveChatUsernameRes)(nil),   // 38: chats.ResolveChatUsernameRes
+	(*CreateInviteReq)(nil),          // 39: chats.CreateInviteReq
+	(*Invite)(nil),                   // 40: chats.Invite
+	(*GetChatInvitesRes)(nil),        // 41: chats.GetChatInvitesRes
+	(*RevokeInviteReq)(nil),          // 42: chats.RevokeInviteReq
+	(*JoinChatByInviteReq)(nil),      // 43: chats.JoinChatByInviteReq
+	(*JoinChatByInviteRes)(nil),      // 44: chats.JoinChatByInviteRes
+	(*JoinRequest)(nil),              // 45: chats.JoinRequest
+	(*GetJoinRequestsRes)(nil),       // 46: chats.GetJoinRequestsRes
+	(*JoinRequestDecisionReq)(nil),   // 47: chats.JoinRequestDecisionReq
+	(*GetGroupPeersReq)(nil),         // 48: chats.GetGroupPeersReq
+	(*GetGroupPeersRes)(nil),         // 49: chats.GetGroupPeersRes
+	(*UploadChatAvatarReq)(nil),      // 50: chats.UploadChatAvatarReq
+	(*UploadChatAvatarRes)(nil),      // 51: chats.UploadChatAvatarRes
+	(*ChatAvatar)(nil),               // 52: chats.ChatAvatar
+	(*GetChatAvatarHistoryReq)(nil),  // 53: chats.GetChatAvatarHistoryReq
+	(*GetChatAvatarHistoryRes)(nil),  // 54: chats.GetChatAvatarHistoryRes
+	(*ChatAvatarReq)(nil),            // 55: chats.ChatAvatarReq
+	(*UploadAttachmentReq)(nil),      // 56: chats.UploadAttachmentReq
+	(*UploadAttachmentRes)(nil),      // 57: chats.UploadAttachmentRes
+	(*UserProfileChangedReq)(nil),    // 58: chats.UserProfileChangedReq
+	(*UserStatus)(nil),               // 59: chats.UserStatus
+	nil,                              // 60: chats.GetChatAvatarsRes.AvatarsEntry
+	nil,                              // 61: chats.ChatAvatar.SizesEntry
+	(*timestamppb.Timestamp)(nil),    // 62: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 63: google.protobuf.Empty
 }
 var file_chats_proto_depIdxs = []int32{
-	20, // 0: chats.Chat.last_message:type_name -> chats.Message
-	20, // 1: chats.ChatDetailedInformation.messages:type_name -> chats.Message
-	1,  // 2: chats.ChatDetailedInformation.members:type_name -> chats.UserInfoChat
-	0,  // 3: chats.GetChatsRes.chats:type_name -> chats.Chat
-	20, // 4: chats.GetChatMessagesRes.messages:type_name -> chats.Message
-	9,  // 5: chats.CreateChatReq.members:type_name -> chats.AddMember
-	9,  // 6: chats.AddUserToChatReq.members:type_name -> chats.AddMember
-	17, // 7: chats.MessageEventReq.new_chat_message:type_name -> chats.CreateMessage
-	21, // 8: chats.MessageEventReq.edit_chat_message:type_name -> chats.EditMessage
-	22, // 9: chats.MessageEventReq.delete_chat_message:type_name -> chats.DeleteMessage
-	20, // 10: chats.MessageEventRes.new_chat_message:type_name -> chats.Message
-	0,  // 11: chats.MessageEventRes.new_chat_created:type_name -> chats.Chat
-	21, // 12: chats.MessageEventRes.edit_chat_message:type_name -> chats.EditMessage
-	22, // 13: chats.MessageEventRes.delete_chat_message:type_name -> chats.DeleteMessage
-	23, // 14: chats.MessageEventRes.user_joined:type_name -> chats.UserJoined
-	18, // 15: chats.CreateMessage.attachment:type_name -> chats.CreateAttachment
-	19, // 16: chats.Message.attachment:type_name -> chats.Attachment
-	35, // 17: chats.EditMessage.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 18: chats.GetChatAvatarsRes.avatars:type_name -> chats.GetChatAvatarsRes.AvatarsEntry
-	20, // 19: chats.SearchMessagesRes.messages:type_name -> chats.Message
-	3,  // 20: chats.ChatService.GetChats:input_type -> chats.GetChatsReq
-	5,  // 21: chats.ChatService.GetChat:input_type -> chats.GetChatReq
-	6,  // 22: chats.ChatService.GetChatMessages:input_type -> chats.GetChatMessagesReq
-	8,  // 23: chats.ChatService.GetUsersDialog:input_type -> chats.GetUsersDialogReq
-	10, // 24: chats.ChatService.CreateChat:input_type -> chats.CreateChatReq
-	12, // 25: chats.ChatService.UpdateChat:input_type -> chats.UpdateChatReq
-	5,  // 26: chats.ChatService.DeleteChat:input_type -> chats.GetChatReq
-	13, // 27: chats.ChatService.AddUserToChat:input_type -> chats.AddUserToChatReq
-	14, // 28: chats.ChatService.RemoveUserFromChat:input_type -> chats.RemoveUserFromChatReq
-	25, // 29: chats.ChatService.GetChatAvatars:input_type -> chats.GetChatAvatarsReq
-	30, // 30: chats.ChatService.UploadChatAvatar:input_type -> chats.UploadChatAvatarReq
-	27, // 31: chats.ChatService.SearchChats:input_type -> chats.SearchChatsReq
-	24, // 32: chats.MessageService.StreamMessagesForUser:input_type -> chats.StreamMessagesForUserReq
-	15, // 33: chats.MessageService.HandleSendMessage:input_type -> chats.MessageEventReq
-	28, // 34: chats.MessageService.SearchMessages:input_type -> chats.SearchMessagesReq
-	32, // 35: chats.MessageService.UploadAttachment:input_type -> chats.UploadAttachmentReq
-	4,  // 36: chats.ChatService.GetChats:output_type -> chats.GetChatsRes
-	2,  // 37: chats.ChatService.GetChat:output_type -> chats.ChatDetailedInformation
-	7,  // 38: chats.ChatService.GetChatMessages:output_type -> chats.GetChatMessagesRes
-	11, // 39: chats.ChatService.GetUsersDialog:output_type -> chats.IdRes
-	11, // 40: chats.ChatService.CreateChat:output_type -> chats.IdRes
-	36, // 41: chats.ChatService.UpdateChat:output_type -> google.protobuf.Empty
-	36, // 42: chats.ChatService.DeleteChat:output_type -> google.protobuf.Empty
-	36, // 43: chats.ChatService.AddUserToChat:output_type -> google.protobuf.Empty
-	36, // 44: chats.ChatService.RemoveUserFromChat:output_type -> google.protobuf.Empty
-	26, // 45: chats.ChatService.GetChatAvatars:output_type -> chats.GetChatAvatarsRes
-	31, // 46: chats.ChatService.UploadChatAvatar:output_type -> chats.UploadChatAvatarRes
-	4,  // 47: chats.ChatService.SearchChats:output_type -> chats.GetChatsRes
-	16, // 48: chats.MessageService.StreamMessagesForUser:output_type -> chats.MessageEventRes
-	36, // 49: chats.MessageService.HandleSendMessage:output_type -> google.protobuf.Empty
-	29, // 50: chats.MessageService.SearchMessages:output_type -> chats.SearchMessagesRes
-	33, // 51: chats.MessageService.UploadAttachment:output_type -> chats.UploadAttachmentRes
-	36, // [36:52] is the sub-list for method output_type
-	20, // [20:36] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	21, // 0: chats.Chat.last_message:type_name -> chats.Message
+	1,  // 1: chats.Chat.settings:type_name -> chats.ChatSettings
+	21, // 2: chats.ChatDetailedInformation.messages:type_name -> chats.Message
+	2,  // 3: chats.ChatDetailedInformation.members:type_name -> chats.UserInfoChat
+	0,  // 4: chats.GetChatsRes.chats:type_name -> chats.Chat
+	21, // 5: chats.GetChatMessagesRes.messages:type_name -> chats.Message
+	10, // 6: chats.CreateChatReq.members:type_name -> chats.AddMember
+	10, // 7: chats.AddUserToChatReq.members:type_name -> chats.AddMember
+	18, // 8: chats.MessageEventReq.new_chat_message:type_name -> chats.CreateMessage
+	22, // 9: chats.MessageEventReq.edit_chat_message:type_name -> chats.EditMessage
+	23, // 10: chats.MessageEventReq.delete_chat_message:type_name -> chats.DeleteMessage
+	21, // 11: chats.MessageEventRes.new_chat_message:type_name -> chats.Message
+	0,  // 12: chats.MessageEventRes.new_chat_created:type_name -> chats.Chat
+	22, // 13: chats.MessageEventRes.edit_chat_message:type_name -> chats.EditMessage
+	23, // 14: chats.MessageEventRes.delete_chat_message:type_name -> chats.DeleteMessage
+	24, // 15: chats.MessageEventRes.user_joined:type_name -> chats.UserJoined
+	25, // 16: chats.MessageEventRes.system_notification:type_name -> chats.SystemNotification
+	21, // 17: chats.MessageEventRes.mention:type_name -> chats.Message
+	1,  // 18: chats.MessageEventRes.chat_settings:type_name -> chats.ChatSettings
+	59, // 19: chats.MessageEventRes.user_status:type_name -> chats.UserStatus
+	19, // 20: chats.CreateMessage.attachment:type_name -> chats.CreateAttachment
+	20, // 21: chats.Message.attachment:type_name -> chats.Attachment
+	62, // 22: chats.EditMessage.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 23: chats.NotifyUserReq.notification:type_name -> chats.SystemNotification
+	60, // 24: chats.GetChatAvatarsRes.avatars:type_name -> chats.GetChatAvatarsRes.AvatarsEntry
+	21, // 25: chats.SearchMessagesRes.messages:type_name -> chats.Message
+	62, // 26: chats.UpdateChatSettingsReq.muted_until:type_name -> google.protobuf.Timestamp
+	37, // 27: chats.ResolveChatUsernameRes.chat:type_name -> chats.PublicChat
+	62, // 28: chats.CreateInviteReq.expires_at:type_name -> google.protobuf.Timestamp
+	62, // 29: chats.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	62, // 30: chats.Invite.created_at:type_name -> google.protobuf.Timestamp
+	40, // 31: chats.GetChatInvitesRes.invites:type_name -> chats.Invite
+	62, // 32: chats.JoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	45, // 33: chats.GetJoinRequestsRes.requests:type_name -> chats.JoinRequest
+	61, // 34: chats.ChatAvatar.sizes:type_name -> chats.ChatAvatar.SizesEntry
+	62, // 35: chats.ChatAvatar.created_at:type_name -> google.protobuf.Timestamp
+	52, // 36: chats.GetChatAvatarHistoryRes.avatars:type_name -> chats.ChatAvatar
+	4,  // 37: chats.ChatService.GetChats:input_type -> chats.GetChatsReq
+	6,  // 38: chats.ChatService.GetChat:input_type -> chats.GetChatReq
+	7,  // 39: chats.ChatService.GetChatMessages:input_type -> chats.GetChatMessagesReq
+	9,  // 40: chats.ChatService.GetUsersDialog:input_type -> chats.GetUsersDialogReq
+	11, // 41: chats.ChatService.CreateChat:input_type -> chats.CreateChatReq
+	13, // 42: chats.ChatService.UpdateChat:input_type -> chats.UpdateChatReq
+	6,  // 43: chats.ChatService.DeleteChat:input_type -> chats.GetChatReq
+	14, // 44: chats.ChatService.AddUserToChat:input_type -> chats.AddUserToChatReq
+	15, // 45: chats.ChatService.RemoveUserFromChat:input_type -> chats.RemoveUserFromChatReq
+	28, // 46: chats.ChatService.GetChatAvatars:input_type -> chats.GetChatAvatarsReq
+	50, // 47: chats.ChatService.UploadChatAvatar:input_type -> chats.UploadChatAvatarReq
+	53, // 48: chats.ChatService.GetChatAvatarHistory:input_type -> chats.GetChatAvatarHistoryReq
+	55, // 49: chats.ChatService.DeleteChatAvatar:input_type -> chats.ChatAvatarReq
+	55, // 50: chats.ChatService.SetCurrentChatAvatar:input_type -> chats.ChatAvatarReq
+	30, // 51: chats.ChatService.SearchChats:input_type -> chats.SearchChatsReq
+	34, // 52: chats.ChatService.UpdateChatSettings:input_type -> chats.UpdateChatSettingsReq
+	48, // 53: chats.ChatService.GetGroupPeers:input_type -> chats.GetGroupPeersReq
+	35, // 54: chats.ChatService.SetChatUsername:input_type -> chats.SetChatUsernameReq
+	36, // 55: chats.ChatService.ResolveChatUsername:input_type -> chats.ResolveChatUsernameReq
+	39, // 56: chats.ChatService.CreateInvite:input_type -> chats.CreateInviteReq
+	6,  // 57: chats.ChatService.GetChatInvites:input_type -> chats.GetChatReq
+	42, // 58: chats.ChatService.RevokeInvite:input_type -> chats.RevokeInviteReq
+	43, // 59: chats.ChatService.JoinChatByInvite:input_type -> chats.JoinChatByInviteReq
+	6,  // 60: chats.ChatService.GetJoinRequests:input_type -> chats.GetChatReq
+	47, // 61: chats.ChatService.ApproveJoinRequest:input_type -> chats.JoinRequestDecisionReq
+	47, // 62: chats.ChatService.DeclineJoinRequest:input_type -> chats.JoinRequestDecisionReq
+	27, // 63: chats.MessageService.StreamMessagesForUser:input_type -> chats.StreamMessagesForUserReq
+	16, // 64: chats.MessageService.HandleSendMessage:input_type -> chats.MessageEventReq
+	31, // 65: chats.MessageService.SearchMessages:input_type -> chats.SearchMessagesReq
+	56, // 66: chats.MessageService.UploadAttachment:input_type -> chats.UploadAttachmentReq
+	26, // 67: chats.MessageService.NotifyUser:input_type -> chats.NotifyUserReq
+	33, // 68: chats.MessageService.GetUnreadMentions:input_type -> chats.ChatMentionsReq
+	33, // 69: chats.MessageService.ReadMentions:input_type -> chats.ChatMentionsReq
+	58, // 70: chats.UserEventsService.UserProfileChanged:input_type -> chats.UserProfileChangedReq
+	59, // 71: chats.UserEventsService.UserStatusChanged:input_type -> chats.UserStatus
+	5,  // 72: chats.ChatService.GetChats:output_type -> chats.GetChatsRes
+	3,  // 73: chats.ChatService.GetChat:output_type -> chats.ChatDetailedInformation
+	8,  // 74: chats.ChatService.GetChatMessages:output_type -> chats.GetChatMessagesRes
+	12, // 75: chats.ChatService.GetUsersDialog:output_type -> chats.IdRes
+	12, // 76: chats.ChatService.CreateChat:output_type -> chats.IdRes
+	63, // 77: chats.ChatService.UpdateChat:output_type -> google.protobuf.Empty
+	63, // 78: chats.ChatService.DeleteChat:output_type -> google.protobuf.Empty
+	63, // 79: chats.ChatService.AddUserToChat:output_type -> google.protobuf.Empty
+	63, // 80: chats.ChatService.RemoveUserFromChat:output_type -> google.protobuf.Empty
+	29, // 81: chats.ChatService.GetChatAvatars:output_type -> chats.GetChatAvatarsRes
+	51, // 82: chats.ChatService.UploadChatAvatar:output_type -> chats.UploadChatAvatarRes
+	54, // 83: chats.ChatService.GetChatAvatarHistory:output_type -> chats.GetChatAvatarHistoryRes
+	63, // 84: chats.ChatService.DeleteChatAvatar:output_type -> google.protobuf.Empty
+	63, // 85: chats.ChatService.SetCurrentChatAvatar:output_type -> google.protobuf.Empty
+	5,  // 86: chats.ChatService.SearchChats:output_type -> chats.GetChatsRes
+	1,  // 87: chats.ChatService.UpdateChatSettings:output_type -> chats.ChatSettings
+	49, // 88: chats.ChatService.GetGroupPeers:output_type -> chats.GetGroupPeersRes
+	63, // 89: chats.ChatService.SetChatUsername:output_type -> google.protobuf.Empty
+	38, // 90: chats.ChatService.ResolveChatUsername:output_type -> chats.ResolveChatUsernameRes
+	40, // 91: chats.ChatService.CreateInvite:output_type -> chats.Invite
+	41, // 92: chats.ChatService.GetChatInvites:output_type -> chats.GetChatInvitesRes
+	63, // 93: chats.ChatService.RevokeInvite:output_type -> google.protobuf.Empty
+	44, // 94: chats.ChatService.JoinChatByInvite:output_type -> chats.JoinChatByInviteRes
+	46, // 95: chats.ChatService.GetJoinRequests:output_type -> chats.GetJoinRequestsRes
+	63, // 96: chats.ChatService.ApproveJoinRequest:output_type -> google.protobuf.Empty
+	63, // 97: chats.ChatService.DeclineJoinRequest:output_type -> google.protobuf.Empty
+	17, // 98: chats.MessageService.StreamMessagesForUser:output_type -> chats.MessageEventRes
+	63, // 99: chats.MessageService.HandleSendMessage:output_type -> google.protobuf.Empty
+	32, // 100: chats.MessageService.SearchMessages:output_type -> chats.SearchMessagesRes
+	57, // 101: chats.MessageService.UploadAttachment:output_type -> chats.UploadAttachmentRes
+	63, // 102: chats.MessageService.NotifyUser:output_type -> google.protobuf.Empty
+	8,  // 103: chats.MessageService.GetUnreadMentions:output_type -> chats.GetChatMessagesRes
+	63, // 104: chats.MessageService.ReadMentions:output_type -> google.protobuf.Empty
+	63, // 105: chats.UserEventsService.UserProfileChanged:output_type -> google.protobuf.Empty
+	63, // 106: chats.UserEventsService.UserStatusChanged:output_type -> google.protobuf.Empty
+	72, // [72:107] is the sub-list for method output_type
+	37, // [37:72] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_chats_proto_init() }
@@ -2536,36 +4500,47 @@ func file_chats_proto_init() {
 	file_chats_proto_msgTypes[0].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[1].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[2].OneofWrappers = []any{}
-	file_chats_proto_msgTypes[5].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[3].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[4].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[6].OneofWrappers = []any{}
-	file_chats_proto_msgTypes[12].OneofWrappers = []any{}
-	file_chats_proto_msgTypes[15].OneofWrappers = []any{
+	file_chats_proto_msgTypes[7].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[13].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[16].OneofWrappers = []any{
 		(*MessageEventReq_NewChatMessage)(nil),
 		(*MessageEventReq_EditChatMessage)(nil),
 		(*MessageEventReq_DeleteChatMessage)(nil),
 	}
-	file_chats_proto_msgTypes[16].OneofWrappers = []any{
+	file_chats_proto_msgTypes[17].OneofWrappers = []any{
 		(*MessageEventRes_NewChatMessage)(nil),
 		(*MessageEventRes_NewChatCreated)(nil),
 		(*MessageEventRes_EditChatMessage)(nil),
 		(*MessageEventRes_DeleteChatMessage)(nil),
 		(*MessageEventRes_UserJoined)(nil),
+		(*MessageEventRes_SystemNotification)(nil),
+		(*MessageEventRes_Mention)(nil),
+		(*MessageEventRes_ChatSettings)(nil),
+		(*MessageEventRes_UserStatus)(nil),
 	}
-	file_chats_proto_msgTypes[17].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[18].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[19].OneofWrappers = []any{}
 	file_chats_proto_msgTypes[20].OneofWrappers = []any{}
-	file_chats_proto_msgTypes[32].OneofWrappers = []any{}
-	file_chats_proto_msgTypes[33].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[21].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[34].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[39].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[40].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[45].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[56].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[57].OneofWrappers = []any{}
+	file_chats_proto_msgTypes[59].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chats_proto_rawDesc), len(file_chats_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   62,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_chats_proto_goTypes,
 		DependencyIndexes: file_chats_proto_depIdxs,
@@ -20,18 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChatService_GetChats_FullMethodName           = "/chats.ChatService/GetChats"
-	ChatService_GetChat_FullMethodName            = "/chats.ChatService/GetChat"
-	ChatService_GetChatMessages_FullMethodName    = "/chats.ChatService/GetChatMessages"
-	ChatService_GetUsersDialog_FullMethodName     = "/chats.ChatService/GetUsersDialog"
-	ChatService_CreateChat_FullMethodName         = "/chats.ChatService/CreateChat"
-	ChatService_UpdateChat_FullMethodName         = "/chats.ChatService/UpdateChat"
-	ChatService_DeleteChat_FullMethodName         = "/chats.ChatService/DeleteChat"
-	ChatService_AddUserToChat_FullMethodName      = "/chats.ChatService/AddUserToChat"
-	ChatService_RemoveUserFromChat_FullMethodName = "/chats.ChatService/RemoveUserFromChat"
-	ChatService_GetChatAvatars_FullMethodName     = "/chats.ChatService/GetChatAvatars"
-	ChatService_UploadChatAvatar_FullMethodName   = "/chats.ChatService/UploadChatAvatar"
-	ChatService_SearchChats_FullMethodName        = "/chats.ChatService/SearchChats"
+	ChatService_GetChats_FullMethodName             = "/chats.ChatService/GetChats"
+	ChatService_GetChat_FullMethodName              = "/chats.ChatService/GetChat"
+	ChatService_GetChatMessages_FullMethodName      = "/chats.ChatService/GetChatMessages"
+	ChatService_GetUsersDialog_FullMethodName       = "/chats.ChatService/GetUsersDialog"
+	ChatService_CreateChat_FullMethodName           = "/chats.ChatService/CreateChat"
+	ChatService_UpdateChat_FullMethodName           = "/chats.ChatService/UpdateChat"
+	ChatService_DeleteChat_FullMethodName           = "/chats.ChatService/DeleteChat"
+	ChatService_AddUserToChat_FullMethodName        = "/chats.ChatService/AddUserToChat"
+	ChatService_RemoveUserFromChat_FullMethodName   = "/chats.ChatService/RemoveUserFromChat"
+	ChatService_GetChatAvatars_FullMethodName       = "/chats.ChatService/GetChatAvatars"
+	ChatService_UploadChatAvatar_FullMethodName     = "/chats.ChatService/UploadChatAvatar"
+	ChatService_GetChatAvatarHistory_FullMethodName = "/chats.ChatService/GetChatAvatarHistory"
+	ChatService_DeleteChatAvatar_FullMethodName     = "/chats.ChatService/DeleteChatAvatar"
+	ChatService_SetCurrentChatAvatar_FullMethodName = "/chats.ChatService/SetCurrentChatAvatar"
+	ChatService_SearchChats_FullMethodName          = "/chats.ChatService/SearchChats"
+	ChatService_UpdateChatSettings_FullMethodName   = "/chats.ChatService/UpdateChatSettings"
+	ChatService_GetGroupPeers_FullMethodName        = "/chats.ChatService/GetGroupPeers"
+	ChatService_SetChatUsername_FullMethodName      = "/chats.ChatService/SetChatUsername"
+	ChatService_ResolveChatUsername_FullMethodName  = "/chats.ChatService/ResolveChatUsername"
+	ChatService_CreateInvite_FullMethodName         = "/chats.ChatService/CreateInvite"
+	ChatService_GetChatInvites_FullMethodName       = "/chats.ChatService/GetChatInvites"
+	ChatService_RevokeInvite_FullMethodName         = "/chats.ChatService/RevokeInvite"
+	ChatService_JoinChatByInvite_FullMethodName     = "/chats.ChatService/JoinChatByInvite"
+	ChatService_GetJoinRequests_FullMethodName      = "/chats.ChatService/GetJoinRequests"
+	ChatService_ApproveJoinRequest_FullMethodName   = "/chats.ChatService/ApproveJoinRequest"
+	ChatService_DeclineJoinRequest_FullMethodName   = "/chats.ChatService/DeclineJoinRequest"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -51,7 +65,21 @@ type ChatServiceClient interface {
 	RemoveUserFromChat(ctx context.Context, in *RemoveUserFromChatReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetChatAvatars(ctx context.Context, in *GetChatAvatarsReq, opts ...grpc.CallOption) (*GetChatAvatarsRes, error)
 	UploadChatAvatar(ctx context.Context, in *UploadChatAvatarReq, opts ...grpc.CallOption) (*UploadChatAvatarRes, error)
+	GetChatAvatarHistory(ctx context.Context, in *GetChatAvatarHistoryReq, opts ...grpc.CallOption) (*GetChatAvatarHistoryRes, error)
+	DeleteChatAvatar(ctx context.Context, in *ChatAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetCurrentChatAvatar(ctx context.Context, in *ChatAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SearchChats(ctx context.Context, in *SearchChatsReq, opts ...grpc.CallOption) (*GetChatsRes, error)
+	UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsReq, opts ...grpc.CallOption) (*ChatSettings, error)
+	GetGroupPeers(ctx context.Context, in *GetGroupPeersReq, opts ...grpc.CallOption) (*GetGroupPeersRes, error)
+	SetChatUsername(ctx context.Context, in *SetChatUsernameReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveChatUsername(ctx context.Context, in *ResolveChatUsernameReq, opts ...grpc.CallOption) (*ResolveChatUsernameRes, error)
+	CreateInvite(ctx context.Context, in *CreateInviteReq, opts ...grpc.CallOption) (*Invite, error)
+	GetChatInvites(ctx context.Context, in *GetChatReq, opts ...grpc.CallOption) (*GetChatInvitesRes, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	JoinChatByInvite(ctx context.Context, in *JoinChatByInviteReq, opts ...grpc.CallOption) (*JoinChatByInviteRes, error)
+	GetJoinRequests(ctx context.Context, in *GetChatReq, opts ...grpc.CallOption) (*GetJoinRequestsRes, error)
+	ApproveJoinRequest(ctx context.Context, in *JoinRequestDecisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineJoinRequest(ctx context.Context, in *JoinRequestDecisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type chatServiceClient struct {
@@ -172,6 +200,36 @@ func (c *chatServiceClient) UploadChatAvatar(ctx context.Context, in *UploadChat
 	return out, nil
 }
 
+func (c *chatServiceClient) GetChatAvatarHistory(ctx context.Context, in *GetChatAvatarHistoryReq, opts ...grpc.CallOption) (*GetChatAvatarHistoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChatAvatarHistoryRes)
+	err := c.cc.Invoke(ctx, ChatService_GetChatAvatarHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteChatAvatar(ctx context.Context, in *ChatAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_DeleteChatAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetCurrentChatAvatar(ctx context.Context, in *ChatAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_SetCurrentChatAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) SearchChats(ctx context.Context, in *SearchChatsReq, opts ...grpc.CallOption) (*GetChatsRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetChatsRes)
@@ -182,6 +240,116 @@ func (c *chatServiceClient) SearchChats(ctx context.Context, in *SearchChatsReq,
 	return out, nil
 }
 
+func (c *chatServiceClient) UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsReq, opts ...grpc.CallOption) (*ChatSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSettings)
+	err := c.cc.Invoke(ctx, ChatService_UpdateChatSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetGroupPeers(ctx context.Context, in *GetGroupPeersReq, opts ...grpc.CallOption) (*GetGroupPeersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupPeersRes)
+	err := c.cc.Invoke(ctx, ChatService_GetGroupPeers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChatUsername(ctx context.Context, in *SetChatUsernameReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_SetChatUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ResolveChatUsername(ctx context.Context, in *ResolveChatUsernameReq, opts ...grpc.CallOption) (*ResolveChatUsernameRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveChatUsernameRes)
+	err := c.cc.Invoke(ctx, ChatService_ResolveChatUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateInvite(ctx context.Context, in *CreateInviteReq, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, ChatService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChatInvites(ctx context.Context, in *GetChatReq, opts ...grpc.CallOption) (*GetChatInvitesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChatInvitesRes)
+	err := c.cc.Invoke(ctx, ChatService_GetChatInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) JoinChatByInvite(ctx context.Context, in *JoinChatByInviteReq, opts ...grpc.CallOption) (*JoinChatByInviteRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinChatByInviteRes)
+	err := c.cc.Invoke(ctx, ChatService_JoinChatByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetJoinRequests(ctx context.Context, in *GetChatReq, opts ...grpc.CallOption) (*GetJoinRequestsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJoinRequestsRes)
+	err := c.cc.Invoke(ctx, ChatService_GetJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ApproveJoinRequest(ctx context.Context, in *JoinRequestDecisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeclineJoinRequest(ctx context.Context, in *JoinRequestDecisionReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_DeclineJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
@@ -199,7 +367,21 @@ type ChatServiceServer interface {
 	RemoveUserFromChat(context.Context, *RemoveUserFromChatReq) (*emptypb.Empty, error)
 	GetChatAvatars(context.Context, *GetChatAvatarsReq) (*GetChatAvatarsRes, error)
 	UploadChatAvatar(context.Context, *UploadChatAvatarReq) (*UploadChatAvatarRes, error)
+	GetChatAvatarHistory(context.Context, *GetChatAvatarHistoryReq) (*GetChatAvatarHistoryRes, error)
+	DeleteChatAvatar(context.Context, *ChatAvatarReq) (*emptypb.Empty, error)
+	SetCurrentChatAvatar(context.Context, *ChatAvatarReq) (*emptypb.Empty, error)
 	SearchChats(context.Context, *SearchChatsReq) (*GetChatsRes, error)
+	UpdateChatSettings(context.Context, *UpdateChatSettingsReq) (*ChatSettings, error)
+	GetGroupPeers(context.Context, *GetGroupPeersReq) (*GetGroupPeersRes, error)
+	SetChatUsername(context.Context, *SetChatUsernameReq) (*emptypb.Empty, error)
+	ResolveChatUsername(context.Context, *ResolveChatUsernameReq) (*ResolveChatUsernameRes, error)
+	CreateInvite(context.Context, *CreateInviteReq) (*Invite, error)
+	GetChatInvites(context.Context, *GetChatReq) (*GetChatInvitesRes, error)
+	RevokeInvite(context.Context, *RevokeInviteReq) (*emptypb.Empty, error)
+	JoinChatByInvite(context.Context, *JoinChatByInviteReq) (*JoinChatByInviteRes, error)
+	GetJoinRequests(context.Context, *GetChatReq) (*GetJoinRequestsRes, error)
+	ApproveJoinRequest(context.Context, *JoinRequestDecisionReq) (*emptypb.Empty, error)
+	DeclineJoinRequest(context.Context, *JoinRequestDecisionReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -243,9 +425,51 @@ func (UnimplementedChatServiceServer) GetChatAvatars(context.Context, *GetChatAv
 func (UnimplementedChatServiceServer) UploadChatAvatar(context.Context, *UploadChatAvatarReq) (*UploadChatAvatarRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadChatAvatar not implemented")
 }
+func (UnimplementedChatServiceServer) GetChatAvatarHistory(context.Context, *GetChatAvatarHistoryReq) (*GetChatAvatarHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatAvatarHistory not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteChatAvatar(context.Context, *ChatAvatarReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChatAvatar not implemented")
+}
+func (UnimplementedChatServiceServer) SetCurrentChatAvatar(context.Context, *ChatAvatarReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrentChatAvatar not implemented")
+}
 func (UnimplementedChatServiceServer) SearchChats(context.Context, *SearchChatsReq) (*GetChatsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchChats not implemented")
 }
+func (UnimplementedChatServiceServer) UpdateChatSettings(context.Context, *UpdateChatSettingsReq) (*ChatSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatSettings not implemented")
+}
+func (UnimplementedChatServiceServer) GetGroupPeers(context.Context, *GetGroupPeersReq) (*GetGroupPeersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupPeers not implemented")
+}
+func (UnimplementedChatServiceServer) SetChatUsername(context.Context, *SetChatUsernameReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChatUsername not implemented")
+}
+func (UnimplementedChatServiceServer) ResolveChatUsername(context.Context, *ResolveChatUsernameReq) (*ResolveChatUsernameRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveChatUsername not implemented")
+}
+func (UnimplementedChatServiceServer) CreateInvite(context.Context, *CreateInviteReq) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedChatServiceServer) GetChatInvites(context.Context, *GetChatReq) (*GetChatInvitesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatInvites not implemented")
+}
+func (UnimplementedChatServiceServer) RevokeInvite(context.Context, *RevokeInviteReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedChatServiceServer) JoinChatByInvite(context.Context, *JoinChatByInviteReq) (*JoinChatByInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChatByInvite not implemented")
+}
+func (UnimplementedChatServiceServer) GetJoinRequests(context.Context, *GetChatReq) (*GetJoinRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinRequests not implemented")
+}
+func (UnimplementedChatServiceServer) ApproveJoinRequest(context.Context, *JoinRequestDecisionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedChatServiceServer) DeclineJoinRequest(context.Context, *JoinRequestDecisionReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineJoinRequest not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -465,6 +689,60 @@ func _ChatService_UploadChatAvatar_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_GetChatAvatarHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatAvatarHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatAvatarHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChatAvatarHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatAvatarHistory(ctx, req.(*GetChatAvatarHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteChatAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatAvatarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteChatAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteChatAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteChatAvatar(ctx, req.(*ChatAvatarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetCurrentChatAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatAvatarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetCurrentChatAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetCurrentChatAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetCurrentChatAvatar(ctx, req.(*ChatAvatarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChatService_SearchChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchChatsReq)
 	if err := dec(in); err != nil {
@@ -483,6 +761,204 @@ func _ChatService_SearchChats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_UpdateChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UpdateChatSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateChatSettings(ctx, req.(*UpdateChatSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetGroupPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupPeersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetGroupPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetGroupPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetGroupPeers(ctx, req.(*GetGroupPeersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChatUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatUsernameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChatUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetChatUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChatUsername(ctx, req.(*SetChatUsernameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ResolveChatUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveChatUsernameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ResolveChatUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ResolveChatUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ResolveChatUsername(ctx, req.(*ResolveChatUsernameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateInvite(ctx, req.(*CreateInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChatInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChatInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChatInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChatInvites(ctx, req.(*GetChatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RevokeInvite(ctx, req.(*RevokeInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_JoinChatByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChatByInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).JoinChatByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_JoinChatByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).JoinChatByInvite(ctx, req.(*JoinChatByInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetJoinRequests(ctx, req.(*GetChatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequestDecisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ApproveJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ApproveJoinRequest(ctx, req.(*JoinRequestDecisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeclineJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequestDecisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeclineJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeclineJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeclineJoinRequest(ctx, req.(*JoinRequestDecisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,10 +1010,66 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadChatAvatar",
 			Handler:    _ChatService_UploadChatAvatar_Handler,
 		},
+		{
+			MethodName: "GetChatAvatarHistory",
+			Handler:    _ChatService_GetChatAvatarHistory_Handler,
+		},
+		{
+			MethodName: "DeleteChatAvatar",
+			Handler:    _ChatService_DeleteChatAvatar_Handler,
+		},
+		{
+			MethodName: "SetCurrentChatAvatar",
+			Handler:    _ChatService_SetCurrentChatAvatar_Handler,
+		},
 		{
 			MethodName: "SearchChats",
 			Handler:    _ChatService_SearchChats_Handler,
 		},
+		{
+			MethodName: "UpdateChatSettings",
+			Handler:    _ChatService_UpdateChatSettings_Handler,
+		},
+		{
+			MethodName: "GetGroupPeers",
+			Handler:    _ChatService_GetGroupPeers_Handler,
+		},
+		{
+			MethodName: "SetChatUsername",
+			Handler:    _ChatService_SetChatUsername_Handler,
+		},
+		{
+			MethodName: "ResolveChatUsername",
+			Handler:    _ChatService_ResolveChatUsername_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _ChatService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "GetChatInvites",
+			Handler:    _ChatService_GetChatInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _ChatService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "JoinChatByInvite",
+			Handler:    _ChatService_JoinChatByInvite_Handler,
+		},
+		{
+			MethodName: "GetJoinRequests",
+			Handler:    _ChatService_GetJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _ChatService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "DeclineJoinRequest",
+			Handler:    _ChatService_DeclineJoinRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chats.proto",
@@ -548,6 +1080,9 @@ const (
 	MessageService_HandleSendMessage_FullMethodName     = "/chats.MessageService/HandleSendMessage"
 	MessageService_SearchMessages_FullMethodName        = "/chats.MessageService/SearchMessages"
 	MessageService_UploadAttachment_FullMethodName      = "/chats.MessageService/UploadAttachment"
+	MessageService_NotifyUser_FullMethodName            = "/chats.MessageService/NotifyUser"
+	MessageService_GetUnreadMentions_FullMethodName     = "/chats.MessageService/GetUnreadMentions"
+	MessageService_ReadMentions_FullMethodName          = "/chats.MessageService/ReadMentions"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -558,6 +1093,9 @@ type MessageServiceClient interface {
 	HandleSendMessage(ctx context.Context, in *MessageEventReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SearchMessages(ctx context.Context, in *SearchMessagesReq, opts ...grpc.CallOption) (*SearchMessagesRes, error)
 	UploadAttachment(ctx context.Context, in *UploadAttachmentReq, opts ...grpc.CallOption) (*UploadAttachmentRes, error)
+	NotifyUser(ctx context.Context, in *NotifyUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUnreadMentions(ctx context.Context, in *ChatMentionsReq, opts ...grpc.CallOption) (*GetChatMessagesRes, error)
+	ReadMentions(ctx context.Context, in *ChatMentionsReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type messageServiceClient struct {
@@ -617,6 +1155,36 @@ func (c *messageServiceClient) UploadAttachment(ctx context.Context, in *UploadA
 	return out, nil
 }
 
+func (c *messageServiceClient) NotifyUser(ctx context.Context, in *NotifyUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MessageService_NotifyUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetUnreadMentions(ctx context.Context, in *ChatMentionsReq, opts ...grpc.CallOption) (*GetChatMessagesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChatMessagesRes)
+	err := c.cc.Invoke(ctx, MessageService_GetUnreadMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ReadMentions(ctx context.Context, in *ChatMentionsReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MessageService_ReadMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -625,6 +1193,9 @@ type MessageServiceServer interface {
 	HandleSendMessage(context.Context, *MessageEventReq) (*emptypb.Empty, error)
 	SearchMessages(context.Context, *SearchMessagesReq) (*SearchMessagesRes, error)
 	UploadAttachment(context.Context, *UploadAttachmentReq) (*UploadAttachmentRes, error)
+	NotifyUser(context.Context, *NotifyUserReq) (*emptypb.Empty, error)
+	GetUnreadMentions(context.Context, *ChatMentionsReq) (*GetChatMessagesRes, error)
+	ReadMentions(context.Context, *ChatMentionsReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -647,6 +1218,15 @@ func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *Search
 func (UnimplementedMessageServiceServer) UploadAttachment(context.Context, *UploadAttachmentReq) (*UploadAttachmentRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
 }
+func (UnimplementedMessageServiceServer) NotifyUser(context.Context, *NotifyUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyUser not implemented")
+}
+func (UnimplementedMessageServiceServer) GetUnreadMentions(context.Context, *ChatMentionsReq) (*GetChatMessagesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadMentions not implemented")
+}
+func (UnimplementedMessageServiceServer) ReadMentions(context.Context, *ChatMentionsReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadMentions not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -733,6 +1313,60 @@ func _MessageService_UploadAttachment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_NotifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).NotifyUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_NotifyUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).NotifyUser(ctx, req.(*NotifyUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetUnreadMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMentionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetUnreadMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetUnreadMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetUnreadMentions(ctx, req.(*ChatMentionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ReadMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMentionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ReadMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ReadMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ReadMentions(ctx, req.(*ChatMentionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -752,6 +1386,18 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadAttachment",
 			Handler:    _MessageService_UploadAttachment_Handler,
 		},
+		{
+			MethodName: "NotifyUser",
+			Handler:    _MessageService_NotifyUser_Handler,
+		},
+		{
+			MethodName: "GetUnreadMentions",
+			Handler:    _MessageService_GetUnreadMentions_Handler,
+		},
+		{
+			MethodName: "ReadMentions",
+			Handler:    _MessageService_ReadMentions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -762,3 +1408,145 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "chats.proto",
 }
+
+const (
+	UserEventsService_UserProfileChanged_FullMethodName = "/chats.UserEventsService/UserProfileChanged"
+	UserEventsService_UserStatusChanged_FullMethodName  = "/chats.UserEventsService/UserStatusChanged"
+)
+
+// UserEventsServiceClient is the client API for UserEventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserEventsServiceClient interface {
+	UserProfileChanged(ctx context.Context, in *UserProfileChangedReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Рассылает новый статус собеседникам пользователя по диалогам
+	UserStatusChanged(ctx context.Context, in *UserStatus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userEventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserEventsServiceClient(cc grpc.ClientConnInterface) UserEventsServiceClient {
+	return &userEventsServiceClient{cc}
+}
+
+func (c *userEventsServiceClient) UserProfileChanged(ctx context.Context, in *UserProfileChangedReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserEventsService_UserProfileChanged_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userEventsServiceClient) UserStatusChanged(ctx context.Context, in *UserStatus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserEventsService_UserStatusChanged_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserEventsServiceServer is the server API for UserEventsService service.
+// All implementations must embed UnimplementedUserEventsServiceServer
+// for forward compatibility.
+type UserEventsServiceServer interface {
+	UserProfileChanged(context.Context, *UserProfileChangedReq) (*emptypb.Empty, error)
+	// Рассылает новый статус собеседникам пользователя по диалогам
+	UserStatusChanged(context.Context, *UserStatus) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserEventsServiceServer()
+}
+
+// UnimplementedUserEventsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserEventsServiceServer struct{}
+
+func (UnimplementedUserEventsServiceServer) UserProfileChanged(context.Context, *UserProfileChangedReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserProfileChanged not implemented")
+}
+func (UnimplementedUserEventsServiceServer) UserStatusChanged(context.Context, *UserStatus) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserStatusChanged not implemented")
+}
+func (UnimplementedUserEventsServiceServer) mustEmbedUnimplementedUserEventsServiceServer() {}
+func (UnimplementedUserEventsServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeUserEventsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserEventsServiceServer will
+// result in compilation errors.
+type UnsafeUserEventsServiceServer interface {
+	mustEmbedUnimplementedUserEventsServiceServer()
+}
+
+func RegisterUserEventsServiceServer(s grpc.ServiceRegistrar, srv UserEventsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserEventsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserEventsService_ServiceDesc, srv)
+}
+
+func _UserEventsService_UserProfileChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserProfileChangedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserEventsServiceServer).UserProfileChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserEventsService_UserProfileChanged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserEventsServiceServer).UserProfileChanged(ctx, req.(*UserProfileChangedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserEventsService_UserStatusChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserEventsServiceServer).UserStatusChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserEventsService_UserStatusChanged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserEventsServiceServer).UserStatusChanged(ctx, req.(*UserStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserEventsService_ServiceDesc is the grpc.ServiceDesc for UserEventsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserEventsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chats.UserEventsService",
+	HandlerType: (*UserEventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UserProfileChanged",
+			Handler:    _UserEventsService_UserProfileChanged_Handler,
+		},
+		{
+			MethodName: "UserStatusChanged",
+			Handler:    _UserEventsService_UserStatusChanged_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chats.proto",
+}
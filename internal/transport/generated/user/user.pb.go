@@ -35,6 +35,10 @@ type User struct {
 	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	PasswordHash  string                 `protobuf:"bytes,10,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Status        *UserStatus            `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`     // отсутствует, если статус не задан или истёк
+	Birthday      string                 `protobuf:"bytes,12,opt,name=birthday,proto3" json:"birthday,omitempty"` // YYYY-MM-DD; пусто, если не задан или скрыт
+	Links         []*ProfileLink         `protobuf:"bytes,13,rep,name=links,proto3" json:"links,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,14,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // RFC3339; пусто, если пользователь не заходил или скрыл время
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -139,6 +143,191 @@ func (x *User) GetPasswordHash() string {
 	return ""
 }
 
+func (x *User) GetStatus() *UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *User) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *User) GetLinks() []*ProfileLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *User) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+type UserStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ExpiresAt     *string                `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // RFC3339; отсутствует у бессрочного статуса
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatus) Reset() {
+	*x = UserStatus{}
+	mi := &file_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatus) ProtoMessage() {}
+
+func (x *UserStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatus.ProtoReflect.Descriptor instead.
+func (*UserStatus) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserStatus) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *UserStatus) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UserStatus) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+type ProfileLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLink) Reset() {
+	*x = ProfileLink{}
+	mi := &file_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLink) ProtoMessage() {}
+
+func (x *ProfileLink) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLink.ProtoReflect.Descriptor instead.
+func (*ProfileLink) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProfileLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProfileLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Обёртка нужна, чтобы отличать «ссылки не меняются» от «удалить все ссылки»
+type ProfileLinks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*ProfileLink         `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileLinks) Reset() {
+	*x = ProfileLinks{}
+	mi := &file_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileLinks) ProtoMessage() {}
+
+func (x *ProfileLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileLinks.ProtoReflect.Descriptor instead.
+func (*ProfileLinks) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProfileLinks) GetLinks() []*ProfileLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
 // ############### GetUserById ###############
 type GetUserByIdReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -149,7 +338,7 @@ type GetUserByIdReq struct {
 
 func (x *GetUserByIdReq) Reset() {
 	*x = GetUserByIdReq{}
-	mi := &file_user_proto_msgTypes[1]
+	mi := &file_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -161,7 +350,7 @@ func (x *GetUserByIdReq) String() string {
 func (*GetUserByIdReq) ProtoMessage() {}
 
 func (x *GetUserByIdReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[1]
+	mi := &file_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +363,7 @@ func (x *GetUserByIdReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdReq.ProtoReflect.Descriptor instead.
 func (*GetUserByIdReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{1}
+	return file_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUserByIdReq) GetUserId() string {
@@ -193,7 +382,7 @@ type GetUserByIdRes struct {
 
 func (x *GetUserByIdRes) Reset() {
 	*x = GetUserByIdRes{}
-	mi := &file_user_proto_msgTypes[2]
+	mi := &file_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +394,7 @@ func (x *GetUserByIdRes) String() string {
 func (*GetUserByIdRes) ProtoMessage() {}
 
 func (x *GetUserByIdRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[2]
+	mi := &file_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +407,7 @@ func (x *GetUserByIdRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdRes.ProtoReflect.Descriptor instead.
 func (*GetUserByIdRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{2}
+	return file_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetUserByIdRes) GetUser() *User {
@@ -238,7 +427,7 @@ type GetUserByPhoneReq struct {
 
 func (x *GetUserByPhoneReq) Reset() {
 	*x = GetUserByPhoneReq{}
-	mi := &file_user_proto_msgTypes[3]
+	mi := &file_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +439,7 @@ func (x *GetUserByPhoneReq) String() string {
 func (*GetUserByPhoneReq) ProtoMessage() {}
 
 func (x *GetUserByPhoneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[3]
+	mi := &file_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +452,7 @@ func (x *GetUserByPhoneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByPhoneReq.ProtoReflect.Descriptor instead.
 func (*GetUserByPhoneReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{3}
+	return file_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetUserByPhoneReq) GetPhoneNumber() string {
@@ -282,7 +471,7 @@ type GetUserByPhoneRes struct {
 
 func (x *GetUserByPhoneRes) Reset() {
 	*x = GetUserByPhoneRes{}
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +483,7 @@ func (x *GetUserByPhoneRes) String() string {
 func (*GetUserByPhoneRes) ProtoMessage() {}
 
 func (x *GetUserByPhoneRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[4]
+	mi := &file_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +496,7 @@ func (x *GetUserByPhoneRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByPhoneRes.ProtoReflect.Descriptor instead.
 func (*GetUserByPhoneRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{4}
+	return file_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUserByPhoneRes) GetUser() *User {
@@ -327,7 +516,7 @@ type GetUserByUsernameReq struct {
 
 func (x *GetUserByUsernameReq) Reset() {
 	*x = GetUserByUsernameReq{}
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -339,7 +528,7 @@ func (x *GetUserByUsernameReq) String() string {
 func (*GetUserByUsernameReq) ProtoMessage() {}
 
 func (x *GetUserByUsernameReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +541,7 @@ func (x *GetUserByUsernameReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByUsernameReq.ProtoReflect.Descriptor instead.
 func (*GetUserByUsernameReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{5}
+	return file_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserByUsernameReq) GetUsername() string {
@@ -371,7 +560,7 @@ type GetUserByUsernameRes struct {
 
 func (x *GetUserByUsernameRes) Reset() {
 	*x = GetUserByUsernameRes{}
-	mi := &file_user_proto_msgTypes[6]
+	mi := &file_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +572,7 @@ func (x *GetUserByUsernameRes) String() string {
 func (*GetUserByUsernameRes) ProtoMessage() {}
 
 func (x *GetUserByUsernameRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[6]
+	mi := &file_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +585,7 @@ func (x *GetUserByUsernameRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByUsernameRes.ProtoReflect.Descriptor instead.
 func (*GetUserByUsernameRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{6}
+	return file_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserByUsernameRes) GetUser() *User {
@@ -406,32 +595,29 @@ func (x *GetUserByUsernameRes) GetUser() *User {
 	return nil
 }
 
-// ############### UpdateUserInfo ###############
-type UpdateUserInfoReq struct {
+// Поиск по публичному handle: прежний handle ведёт на владельца, пока действует редирект
+type ResolveUsernameReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Username      *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	Bio           *string                `protobuf:"bytes,4,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserInfoReq) Reset() {
-	*x = UpdateUserInfoReq{}
-	mi := &file_user_proto_msgTypes[7]
+func (x *ResolveUsernameReq) Reset() {
+	*x = ResolveUsernameReq{}
+	mi := &file_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserInfoReq) String() string {
+func (x *ResolveUsernameReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoReq) ProtoMessage() {}
+func (*ResolveUsernameReq) ProtoMessage() {}
 
-func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+func (x *ResolveUsernameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,65 +628,93 @@ func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ResolveUsernameReq.ProtoReflect.Descriptor instead.
+func (*ResolveUsernameReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateUserInfoReq) GetUserId() string {
+func (x *ResolveUsernameReq) GetUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+type ResolveUsernameRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Redirected    bool                   `protobuf:"varint,2,opt,name=redirected,proto3" json:"redirected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUsernameRes) Reset() {
+	*x = ResolveUsernameRes{}
+	mi := &file_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUsernameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUsernameRes) ProtoMessage() {}
+
+func (x *ResolveUsernameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserInfoReq) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+// Deprecated: Use ResolveUsernameRes.ProtoReflect.Descriptor instead.
+func (*ResolveUsernameRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveUsernameRes) GetUser() *User {
+	if x != nil {
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserInfoReq) GetBio() string {
-	if x != nil && x.Bio != nil {
-		return *x.Bio
+func (x *ResolveUsernameRes) GetRedirected() bool {
+	if x != nil {
+		return x.Redirected
 	}
-	return ""
+	return false
 }
 
-// ############### UploadUserAvatar ###############
-type UploadUserAvatarReq struct {
+// ############### GetUsersByIDs ###############
+type GetUsersByIDsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadUserAvatarReq) Reset() {
-	*x = UploadUserAvatarReq{}
-	mi := &file_user_proto_msgTypes[8]
+func (x *GetUsersByIDsReq) Reset() {
+	*x = GetUsersByIDsReq{}
+	mi := &file_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadUserAvatarReq) String() string {
+func (x *GetUsersByIDsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadUserAvatarReq) ProtoMessage() {}
+func (*GetUsersByIDsReq) ProtoMessage() {}
 
-func (x *UploadUserAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[8]
+func (x *GetUsersByIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,14 +725,320 @@ func (x *UploadUserAvatarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadUserAvatarReq.ProtoReflect.Descriptor instead.
-func (*UploadUserAvatarReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetUsersByIDsReq.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UploadUserAvatarReq) GetUserId() string {
+func (x *GetUsersByIDsReq) GetUserIds() []string {
 	if x != nil {
-		return x.UserId
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetUsersByIDsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // ненайденные id в ответ не попадают
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByIDsRes) Reset() {
+	*x = GetUsersByIDsRes{}
+	mi := &file_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsRes) ProtoMessage() {}
+
+func (x *GetUsersByIDsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsRes.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUsersByIDsRes) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// ############### SearchUsers ###############
+type SearchUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // префикс username (можно с @) или имени
+	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersReq) Reset() {
+	*x = SearchUsersReq{}
+	mi := &file_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersReq) ProtoMessage() {}
+
+func (x *SearchUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersReq.ProtoReflect.Descriptor instead.
+func (*SearchUsersReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchUsersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchUsersReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersReq) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SearchUsersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число совпадений до исключения заблокированных
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRes) Reset() {
+	*x = SearchUsersRes{}
+	mi := &file_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRes) ProtoMessage() {}
+
+func (x *SearchUsersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRes.ProtoReflect.Descriptor instead.
+func (*SearchUsersRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchUsersRes) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersRes) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ############### UpdateUserInfo ###############
+type UpdateUserInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Username      *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Bio           *string                `protobuf:"bytes,4,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Status        *UserStatus            `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`           // пустые emoji и text сбрасывают статус
+	Birthday      *string                `protobuf:"bytes,6,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"` // YYYY-MM-DD; пустая строка удаляет день рождения
+	Links         *ProfileLinks          `protobuf:"bytes,7,opt,name=links,proto3" json:"links,omitempty"`             // заменяет ссылки целиком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserInfoReq) Reset() {
+	*x = UpdateUserInfoReq{}
+	mi := &file_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserInfoReq) ProtoMessage() {}
+
+func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateUserInfoReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetStatus() *UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateUserInfoReq) GetBirthday() string {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetLinks() *ProfileLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+// ############### UploadUserAvatar ###############
+type UploadUserAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserAvatarReq) Reset() {
+	*x = UploadUserAvatarReq{}
+	mi := &file_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserAvatarReq) ProtoMessage() {}
+
+func (x *UploadUserAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserAvatarReq.ProtoReflect.Descriptor instead.
+func (*UploadUserAvatarReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UploadUserAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
@@ -537,35 +1057,1529 @@ func (x *UploadUserAvatarReq) GetFilename() string {
 	return ""
 }
 
-func (x *UploadUserAvatarReq) GetContentType() string {
+func (x *UploadUserAvatarReq) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadUserAvatarRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadUserAvatarRes) Reset() {
+	*x = UploadUserAvatarRes{}
+	mi := &file_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadUserAvatarRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadUserAvatarRes) ProtoMessage() {}
+
+func (x *UploadUserAvatarRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadUserAvatarRes.ProtoReflect.Descriptor instead.
+func (*UploadUserAvatarRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UploadUserAvatarRes) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+// ############### Avatar history ###############
+type Avatar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Sizes         map[int32]string       `protobuf:"bytes,3,rep,name=sizes,proto3" json:"sizes,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // сторона квадрата в пикселях -> url
+	IsCurrent     bool                   `protobuf:"varint,4,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Avatar) Reset() {
+	*x = Avatar{}
+	mi := &file_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Avatar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Avatar) ProtoMessage() {}
+
+func (x *Avatar) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Avatar.ProtoReflect.Descriptor instead.
+func (*Avatar) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Avatar) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Avatar) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Avatar) GetSizes() map[int32]string {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *Avatar) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *Avatar) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetUserAvatarHistoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // чья история; смотрящий берётся из подписи запроса
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarHistoryReq) Reset() {
+	*x = GetUserAvatarHistoryReq{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarHistoryReq) ProtoMessage() {}
+
+func (x *GetUserAvatarHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarHistoryReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserAvatarHistoryReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserAvatarHistoryRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatars       []*Avatar              `protobuf:"bytes,1,rep,name=avatars,proto3" json:"avatars,omitempty"` // начиная с текущей
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarHistoryRes) Reset() {
+	*x = GetUserAvatarHistoryRes{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarHistoryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarHistoryRes) ProtoMessage() {}
+
+func (x *GetUserAvatarHistoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarHistoryRes.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarHistoryRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUserAvatarHistoryRes) GetAvatars() []*Avatar {
+	if x != nil {
+		return x.Avatars
+	}
+	return nil
+}
+
+type DeleteUserAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AvatarId      string                 `protobuf:"bytes,2,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserAvatarReq) Reset() {
+	*x = DeleteUserAvatarReq{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserAvatarReq) ProtoMessage() {}
+
+func (x *DeleteUserAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserAvatarReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserAvatarReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteUserAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserAvatarReq) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+type SetCurrentUserAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AvatarId      string                 `protobuf:"bytes,2,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCurrentUserAvatarReq) Reset() {
+	*x = SetCurrentUserAvatarReq{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCurrentUserAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCurrentUserAvatarReq) ProtoMessage() {}
+
+func (x *SetCurrentUserAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCurrentUserAvatarReq.ProtoReflect.Descriptor instead.
+func (*SetCurrentUserAvatarReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetCurrentUserAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetCurrentUserAvatarReq) GetAvatarId() string {
+	if x != nil {
+		return x.AvatarId
+	}
+	return ""
+}
+
+// ############### Contact ###############
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Bio           string                 `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	AccountType   string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Alias         *string                `protobuf:"bytes,10,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Contact) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Contact) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Contact) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Contact) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Contact) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Contact) GetAlias() string {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return ""
+}
+
+// ############### CreateContact ###############
+type CreateContactReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactReq) Reset() {
+	*x = CreateContactReq{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactReq) ProtoMessage() {}
+
+func (x *CreateContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactReq.ProtoReflect.Descriptor instead.
+func (*CreateContactReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateContactReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateContactReq) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+// ############### GetContacts ###############
+type GetContactsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactsReq) Reset() {
+	*x = GetContactsReq{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsReq) ProtoMessage() {}
+
+func (x *GetContactsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsReq.ProtoReflect.Descriptor instead.
+func (*GetContactsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetContactsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetContactsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactsRes) Reset() {
+	*x = GetContactsRes{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactsRes) ProtoMessage() {}
+
+func (x *GetContactsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsRes.ProtoReflect.Descriptor instead.
+func (*GetContactsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetContactsRes) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+// ############### SearchContacts ###############
+type SearchContactsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContactsReq) Reset() {
+	*x = SearchContactsReq{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContactsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContactsReq) ProtoMessage() {}
+
+func (x *SearchContactsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContactsReq.ProtoReflect.Descriptor instead.
+func (*SearchContactsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchContactsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchContactsReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchContactsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContactsRes) Reset() {
+	*x = SearchContactsRes{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContactsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContactsRes) ProtoMessage() {}
+
+func (x *SearchContactsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContactsRes.ProtoReflect.Descriptor instead.
+func (*SearchContactsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchContactsRes) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+// ############### DeleteContact ###############
+type DeleteContactReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactReq) Reset() {
+	*x = DeleteContactReq{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactReq) ProtoMessage() {}
+
+func (x *DeleteContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactReq.ProtoReflect.Descriptor instead.
+func (*DeleteContactReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteContactReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteContactReq) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+// ############### UpdateContactAlias ###############
+type UpdateContactAliasReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Alias         *string                `protobuf:"bytes,3,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactAliasReq) Reset() {
+	*x = UpdateContactAliasReq{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactAliasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactAliasReq) ProtoMessage() {}
+
+func (x *UpdateContactAliasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactAliasReq.ProtoReflect.Descriptor instead.
+func (*UpdateContactAliasReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateContactAliasReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateContactAliasReq) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+func (x *UpdateContactAliasReq) GetAlias() string {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return ""
+}
+
+// ############### GetContactAliases ###############
+type GetContactAliasesReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContactUserIds []string               `protobuf:"bytes,2,rep,name=contact_user_ids,json=contactUserIds,proto3" json:"contact_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetContactAliasesReq) Reset() {
+	*x = GetContactAliasesReq{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactAliasesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactAliasesReq) ProtoMessage() {}
+
+func (x *GetContactAliasesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactAliasesReq.ProtoReflect.Descriptor instead.
+func (*GetContactAliasesReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetContactAliasesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetContactAliasesReq) GetContactUserIds() []string {
+	if x != nil {
+		return x.ContactUserIds
+	}
+	return nil
+}
+
+type GetContactAliasesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aliases       map[string]string      `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactAliasesRes) Reset() {
+	*x = GetContactAliasesRes{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactAliasesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactAliasesRes) ProtoMessage() {}
+
+func (x *GetContactAliasesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactAliasesRes.ProtoReflect.Descriptor instead.
+func (*GetContactAliasesRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetContactAliasesRes) GetAliases() map[string]string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+// ############### ImportContacts ###############
+type ImportContactsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhoneNumbers  []string               `protobuf:"bytes,2,rep,name=phone_numbers,json=phoneNumbers,proto3" json:"phone_numbers,omitempty"`
+	PhoneHashes   []string               `protobuf:"bytes,3,rep,name=phone_hashes,json=phoneHashes,proto3" json:"phone_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContactsReq) Reset() {
+	*x = ImportContactsReq{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContactsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsReq) ProtoMessage() {}
+
+func (x *ImportContactsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsReq.ProtoReflect.Descriptor instead.
+func (*ImportContactsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportContactsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportContactsReq) GetPhoneNumbers() []string {
+	if x != nil {
+		return x.PhoneNumbers
+	}
+	return nil
+}
+
+func (x *ImportContactsReq) GetPhoneHashes() []string {
+	if x != nil {
+		return x.PhoneHashes
+	}
+	return nil
+}
+
+type ImportedContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PhoneHash     string                 `protobuf:"bytes,2,opt,name=phone_hash,json=phoneHash,proto3" json:"phone_hash,omitempty"`
+	Added         bool                   `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedContact) Reset() {
+	*x = ImportedContact{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedContact) ProtoMessage() {}
+
+func (x *ImportedContact) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedContact.ProtoReflect.Descriptor instead.
+func (*ImportedContact) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportedContact) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ImportedContact) GetPhoneHash() string {
+	if x != nil {
+		return x.PhoneHash
+	}
+	return ""
+}
+
+func (x *ImportedContact) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+type ImportContactsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Found         []*ImportedContact     `protobuf:"bytes,1,rep,name=found,proto3" json:"found,omitempty"`
+	Added         int32                  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Invalid       int32                  `protobuf:"varint,3,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportContactsRes) Reset() {
+	*x = ImportContactsRes{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportContactsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportContactsRes) ProtoMessage() {}
+
+func (x *ImportContactsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportContactsRes.ProtoReflect.Descriptor instead.
+func (*ImportContactsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportContactsRes) GetFound() []*ImportedContact {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *ImportContactsRes) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ImportContactsRes) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+// Событие auth_service о регистрации: user_service добавляет нового пользователя в контакты тем, у кого он был в адресной книге
+type UserRegisteredReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRegisteredReq) Reset() {
+	*x = UserRegisteredReq{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRegisteredReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRegisteredReq) ProtoMessage() {}
+
+func (x *UserRegisteredReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRegisteredReq.ProtoReflect.Descriptor instead.
+func (*UserRegisteredReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserRegisteredReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Событие auth_service о смене номера: user_service сбрасывает кэш профиля и переиндексирует контакты
+type UserPhoneChangedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPhoneChangedReq) Reset() {
+	*x = UserPhoneChangedReq{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPhoneChangedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPhoneChangedReq) ProtoMessage() {}
+
+func (x *UserPhoneChangedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPhoneChangedReq.ProtoReflect.Descriptor instead.
+func (*UserPhoneChangedReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserPhoneChangedReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ############### UpdateLastSeen ###############
+type UpdateLastSeenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,2,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLastSeenReq) Reset() {
+	*x = UpdateLastSeenReq{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLastSeenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLastSeenReq) ProtoMessage() {}
+
+func (x *UpdateLastSeenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLastSeenReq.ProtoReflect.Descriptor instead.
+func (*UpdateLastSeenReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateLastSeenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateLastSeenReq) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+// ############### GetUserAvatars ###############
+type GetUserAvatarsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarsReq) Reset() {
+	*x = GetUserAvatarsReq{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarsReq) ProtoMessage() {}
+
+func (x *GetUserAvatarsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarsReq.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserAvatarsReq) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetUserAvatarsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatars       map[string]string      `protobuf:"bytes,1,rep,name=avatars,proto3" json:"avatars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // user_id -> avatar_url
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarsRes) Reset() {
+	*x = GetUserAvatarsRes{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarsRes) ProtoMessage() {}
+
+func (x *GetUserAvatarsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarsRes.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserAvatarsRes) GetAvatars() map[string]string {
+	if x != nil {
+		return x.Avatars
+	}
+	return nil
+}
+
+// ############### Block ###############
+type BlockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BlockUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type UnblockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserReq) Reset() {
+	*x = UnblockUserReq{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserReq) ProtoMessage() {}
+
+func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
+func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UnblockUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserReq) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	BlockedAt     string                 `protobuf:"bytes,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BlockedUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *BlockedUser) GetBlockedAt() string {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return ""
+}
+
+type GetBlockedUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedUsersReq) Reset() {
+	*x = GetBlockedUsersReq{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedUsersReq) ProtoMessage() {}
+
+func (x *GetBlockedUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedUsersReq.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetBlockedUsersReq) GetUserId() string {
 	if x != nil {
-		return x.ContentType
+		return x.UserId
 	}
 	return ""
 }
 
-type UploadUserAvatarRes struct {
+type GetBlockedUsersRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AvatarUrl     string                 `protobuf:"bytes,1,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadUserAvatarRes) Reset() {
-	*x = UploadUserAvatarRes{}
-	mi := &file_user_proto_msgTypes[9]
+func (x *GetBlockedUsersRes) Reset() {
+	*x = GetBlockedUsersRes{}
+	mi := &file_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadUserAvatarRes) String() string {
+func (x *GetBlockedUsersRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadUserAvatarRes) ProtoMessage() {}
+func (*GetBlockedUsersRes) ProtoMessage() {}
 
-func (x *UploadUserAvatarRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[9]
+func (x *GetBlockedUsersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,49 +2590,42 @@ func (x *UploadUserAvatarRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadUserAvatarRes.ProtoReflect.Descriptor instead.
-func (*UploadUserAvatarRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetBlockedUsersRes.ProtoReflect.Descriptor instead.
+func (*GetBlockedUsersRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UploadUserAvatarRes) GetAvatarUrl() string {
+func (x *GetBlockedUsersRes) GetUsers() []*BlockedUser {
 	if x != nil {
-		return x.AvatarUrl
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-// ############### Contact ###############
-type Contact struct {
+// Межсервисная проверка: блокировка в любую сторону между user_id и каждым из peer_ids
+type GetBlockedPeersReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,2,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Bio           string                 `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	AccountType   string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerIds       []string               `protobuf:"bytes,2,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Contact) Reset() {
-	*x = Contact{}
-	mi := &file_user_proto_msgTypes[10]
+func (x *GetBlockedPeersReq) Reset() {
+	*x = GetBlockedPeersReq{}
+	mi := &file_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Contact) String() string {
+func (x *GetBlockedPeersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contact) ProtoMessage() {}
+func (*GetBlockedPeersReq) ProtoMessage() {}
 
-func (x *Contact) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[10]
+func (x *GetBlockedPeersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,98 +2636,94 @@ func (x *Contact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
-func (*Contact) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetBlockedPeersReq.ProtoReflect.Descriptor instead.
+func (*GetBlockedPeersReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Contact) GetId() string {
+func (x *GetBlockedPeersReq) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Contact) GetPhoneNumber() string {
+func (x *GetBlockedPeersReq) GetPeerIds() []string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.PeerIds
 	}
-	return ""
+	return nil
 }
 
-func (x *Contact) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetBlockedPeersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerIds       []string               `protobuf:"bytes,1,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"` // только те, с кем есть блокировка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Contact) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+func (x *GetBlockedPeersRes) Reset() {
+	*x = GetBlockedPeersRes{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Contact) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+func (x *GetBlockedPeersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Contact) GetAvatarUrl() string {
-	if x != nil {
-		return x.AvatarUrl
-	}
-	return ""
-}
+func (*GetBlockedPeersRes) ProtoMessage() {}
 
-func (x *Contact) GetAccountType() string {
+func (x *GetBlockedPeersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
 	if x != nil {
-		return x.AccountType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Contact) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use GetBlockedPeersRes.ProtoReflect.Descriptor instead.
+func (*GetBlockedPeersRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Contact) GetUpdatedAt() string {
+func (x *GetBlockedPeersRes) GetPeerIds() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PeerIds
 	}
-	return ""
+	return nil
 }
 
-// ############### CreateContact ###############
-type CreateContactReq struct {
+type PrivacyRule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Setting       string                 `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`       // phone_number, avatar, last_seen, bio, group_add, birthday
+	Visibility    string                 `protobuf:"bytes,2,opt,name=visibility,proto3" json:"visibility,omitempty"` // everybody, contacts, nobody
+	AllowUserIds  []string               `protobuf:"bytes,3,rep,name=allow_user_ids,json=allowUserIds,proto3" json:"allow_user_ids,omitempty"`
+	DenyUserIds   []string               `protobuf:"bytes,4,rep,name=deny_user_ids,json=denyUserIds,proto3" json:"deny_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateContactReq) Reset() {
-	*x = CreateContactReq{}
-	mi := &file_user_proto_msgTypes[11]
+func (x *PrivacyRule) Reset() {
+	*x = PrivacyRule{}
+	mi := &file_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContactReq) String() string {
+func (x *PrivacyRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContactReq) ProtoMessage() {}
+func (*PrivacyRule) ProtoMessage() {}
 
-func (x *CreateContactReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[11]
+func (x *PrivacyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,48 +2734,61 @@ func (x *CreateContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContactReq.ProtoReflect.Descriptor instead.
-func (*CreateContactReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PrivacyRule.ProtoReflect.Descriptor instead.
+func (*PrivacyRule) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateContactReq) GetUserId() string {
+func (x *PrivacyRule) GetSetting() string {
 	if x != nil {
-		return x.UserId
+		return x.Setting
 	}
 	return ""
 }
 
-func (x *CreateContactReq) GetContactUserId() string {
+func (x *PrivacyRule) GetVisibility() string {
 	if x != nil {
-		return x.ContactUserId
+		return x.Visibility
 	}
 	return ""
 }
 
-// ############### GetContacts ###############
-type GetContactsReq struct {
+func (x *PrivacyRule) GetAllowUserIds() []string {
+	if x != nil {
+		return x.AllowUserIds
+	}
+	return nil
+}
+
+func (x *PrivacyRule) GetDenyUserIds() []string {
+	if x != nil {
+		return x.DenyUserIds
+	}
+	return nil
+}
+
+type GetPrivacySettingsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContactsReq) Reset() {
-	*x = GetContactsReq{}
-	mi := &file_user_proto_msgTypes[12]
+func (x *GetPrivacySettingsReq) Reset() {
+	*x = GetPrivacySettingsReq{}
+	mi := &file_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContactsReq) String() string {
+func (x *GetPrivacySettingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactsReq) ProtoMessage() {}
+func (*GetPrivacySettingsReq) ProtoMessage() {}
 
-func (x *GetContactsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[12]
+func (x *GetPrivacySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,40 +2799,40 @@ func (x *GetContactsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactsReq.ProtoReflect.Descriptor instead.
-func (*GetContactsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetPrivacySettingsReq.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetContactsReq) GetUserId() string {
+func (x *GetPrivacySettingsReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetContactsRes struct {
+type GetPrivacySettingsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Rules         []*PrivacyRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContactsRes) Reset() {
-	*x = GetContactsRes{}
-	mi := &file_user_proto_msgTypes[13]
+func (x *GetPrivacySettingsRes) Reset() {
+	*x = GetPrivacySettingsRes{}
+	mi := &file_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContactsRes) String() string {
+func (x *GetPrivacySettingsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactsRes) ProtoMessage() {}
+func (*GetPrivacySettingsRes) ProtoMessage() {}
 
-func (x *GetContactsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[13]
+func (x *GetPrivacySettingsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,42 +2843,41 @@ func (x *GetContactsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactsRes.ProtoReflect.Descriptor instead.
-func (*GetContactsRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetPrivacySettingsRes.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetContactsRes) GetContacts() []*Contact {
+func (x *GetPrivacySettingsRes) GetRules() []*PrivacyRule {
 	if x != nil {
-		return x.Contacts
+		return x.Rules
 	}
 	return nil
 }
 
-// ############### SearchContacts ###############
-type SearchContactsReq struct {
+type UpdatePrivacySettingReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Rule          *PrivacyRule           `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchContactsReq) Reset() {
-	*x = SearchContactsReq{}
-	mi := &file_user_proto_msgTypes[14]
+func (x *UpdatePrivacySettingReq) Reset() {
+	*x = UpdatePrivacySettingReq{}
+	mi := &file_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchContactsReq) String() string {
+func (x *UpdatePrivacySettingReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchContactsReq) ProtoMessage() {}
+func (*UpdatePrivacySettingReq) ProtoMessage() {}
 
-func (x *SearchContactsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+func (x *UpdatePrivacySettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,47 +2888,48 @@ func (x *SearchContactsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchContactsReq.ProtoReflect.Descriptor instead.
-func (*SearchContactsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdatePrivacySettingReq.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SearchContactsReq) GetUserId() string {
+func (x *UpdatePrivacySettingReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *SearchContactsReq) GetQuery() string {
+func (x *UpdatePrivacySettingReq) GetRule() *PrivacyRule {
 	if x != nil {
-		return x.Query
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type SearchContactsRes struct {
+type GetGroupAddDeniedReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // кто добавляет
+	PeerIds       []string               `protobuf:"bytes,2,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchContactsRes) Reset() {
-	*x = SearchContactsRes{}
-	mi := &file_user_proto_msgTypes[15]
+func (x *GetGroupAddDeniedReq) Reset() {
+	*x = GetGroupAddDeniedReq{}
+	mi := &file_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchContactsRes) String() string {
+func (x *GetGroupAddDeniedReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchContactsRes) ProtoMessage() {}
+func (*GetGroupAddDeniedReq) ProtoMessage() {}
 
-func (x *SearchContactsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+func (x *GetGroupAddDeniedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,85 +2940,47 @@ func (x *SearchContactsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchContactsRes.ProtoReflect.Descriptor instead.
-func (*SearchContactsRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *SearchContactsRes) GetContacts() []*Contact {
-	if x != nil {
-		return x.Contacts
-	}
-	return nil
-}
-
-// ############### GetUserAvatars ###############
-type GetUserAvatarsReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUserAvatarsReq) Reset() {
-	*x = GetUserAvatarsReq{}
-	mi := &file_user_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetUserAvatarsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetGroupAddDeniedReq.ProtoReflect.Descriptor instead.
+func (*GetGroupAddDeniedReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
 }
 
-func (*GetUserAvatarsReq) ProtoMessage() {}
-
-func (x *GetUserAvatarsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+func (x *GetGroupAddDeniedReq) GetUserId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetUserAvatarsReq.ProtoReflect.Descriptor instead.
-func (*GetUserAvatarsReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+	return ""
 }
 
-func (x *GetUserAvatarsReq) GetUserIds() []string {
+func (x *GetGroupAddDeniedReq) GetPeerIds() []string {
 	if x != nil {
-		return x.UserIds
+		return x.PeerIds
 	}
 	return nil
 }
 
-type GetUserAvatarsRes struct {
+type GetGroupAddDeniedRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Avatars       map[string]string      `protobuf:"bytes,1,rep,name=avatars,proto3" json:"avatars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // user_id -> avatar_url
+	PeerIds       []string               `protobuf:"bytes,1,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"` // кто запретил добавлять себя в группы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAvatarsRes) Reset() {
-	*x = GetUserAvatarsRes{}
-	mi := &file_user_proto_msgTypes[17]
+func (x *GetGroupAddDeniedRes) Reset() {
+	*x = GetGroupAddDeniedRes{}
+	mi := &file_user_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAvatarsRes) String() string {
+func (x *GetGroupAddDeniedRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAvatarsRes) ProtoMessage() {}
+func (*GetGroupAddDeniedRes) ProtoMessage() {}
 
-func (x *GetUserAvatarsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+func (x *GetGroupAddDeniedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,14 +2991,14 @@ func (x *GetUserAvatarsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAvatarsRes.ProtoReflect.Descriptor instead.
-func (*GetUserAvatarsRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetGroupAddDeniedRes.ProtoReflect.Descriptor instead.
+func (*GetGroupAddDeniedRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetUserAvatarsRes) GetAvatars() map[string]string {
+func (x *GetGroupAddDeniedRes) GetPeerIds() []string {
 	if x != nil {
-		return x.Avatars
+		return x.PeerIds
 	}
 	return nil
 }
@@ -1030,7 +3008,7 @@ var File_user_proto protoreflect.FileDescriptor
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x04user\x1a\x1bgoogle/protobuf/empty.proto\"\xa0\x02\n" +
+	"user.proto\x12\x04user\x1a\x1bgoogle/protobuf/empty.proto\"\xb1\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fphone_number\x18\x02 \x01(\tR\vphoneNumber\x12\x12\n" +
@@ -1045,7 +3023,24 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\tR\tupdatedAt\x12#\n" +
 	"\rpassword_hash\x18\n" +
-	" \x01(\tR\fpasswordHash\")\n" +
+	" \x01(\tR\fpasswordHash\x12(\n" +
+	"\x06status\x18\v \x01(\v2\x10.user.UserStatusR\x06status\x12\x1a\n" +
+	"\bbirthday\x18\f \x01(\tR\bbirthday\x12'\n" +
+	"\x05links\x18\r \x03(\v2\x11.user.ProfileLinkR\x05links\x12 \n" +
+	"\flast_seen_at\x18\x0e \x01(\tR\n" +
+	"lastSeenAt\"i\n" +
+	"\n" +
+	"UserStatus\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"5\n" +
+	"\vProfileLink\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"7\n" +
+	"\fProfileLinks\x12'\n" +
+	"\x05links\x18\x01 \x03(\v2\x11.user.ProfileLinkR\x05links\")\n" +
 	"\x0eGetUserByIdReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"0\n" +
 	"\x0eGetUserByIdRes\x12\x1e\n" +
@@ -1060,15 +3055,43 @@ const file_user_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\"6\n" +
 	"\x14GetUserByUsernameRes\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\"\x9b\x01\n" +
+	".user.UserR\x04user\"0\n" +
+	"\x12ResolveUsernameReq\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"T\n" +
+	"\x12ResolveUsernameRes\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x1e\n" +
+	"\n" +
+	"redirected\x18\x02 \x01(\bR\n" +
+	"redirected\"-\n" +
+	"\x10GetUsersByIDsReq\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"4\n" +
+	"\x10GetUsersByIDsRes\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\"\x8c\x01\n" +
+	"\x0eSearchUsersReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\x06offset\x18\x03 \x01(\x05H\x00R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"H\n" +
+	"\x0eSearchUsersRes\x12 \n" +
+	"\x05users\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9d\x02\n" +
 	"\x11UpdateUserInfoReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
 	"\busername\x18\x03 \x01(\tH\x01R\busername\x88\x01\x01\x12\x15\n" +
-	"\x03bio\x18\x04 \x01(\tH\x02R\x03bio\x88\x01\x01B\a\n" +
+	"\x03bio\x18\x04 \x01(\tH\x02R\x03bio\x88\x01\x01\x12(\n" +
+	"\x06status\x18\x05 \x01(\v2\x10.user.UserStatusR\x06status\x12\x1f\n" +
+	"\bbirthday\x18\x06 \x01(\tH\x03R\bbirthday\x88\x01\x01\x12(\n" +
+	"\x05links\x18\a \x01(\v2\x12.user.ProfileLinksR\x05linksB\a\n" +
 	"\x05_nameB\v\n" +
 	"\t_usernameB\x06\n" +
-	"\x04_bio\"\x81\x01\n" +
+	"\x04_bioB\v\n" +
+	"\t_birthday\"\x81\x01\n" +
 	"\x13UploadUserAvatarReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1a\n" +
@@ -1076,7 +3099,29 @@ const file_user_proto_rawDesc = "" +
 	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\"4\n" +
 	"\x13UploadUserAvatarRes\x12\x1d\n" +
 	"\n" +
-	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\"\xfe\x01\n" +
+	"avatar_url\x18\x01 \x01(\tR\tavatarUrl\"\xd1\x01\n" +
+	"\x06Avatar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12-\n" +
+	"\x05sizes\x18\x03 \x03(\v2\x17.user.Avatar.SizesEntryR\x05sizes\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x04 \x01(\bR\tisCurrent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x1a8\n" +
+	"\n" +
+	"SizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"2\n" +
+	"\x17GetUserAvatarHistoryReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"A\n" +
+	"\x17GetUserAvatarHistoryRes\x12&\n" +
+	"\aavatars\x18\x01 \x03(\v2\f.user.AvatarR\aavatars\"K\n" +
+	"\x13DeleteUserAvatarReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tavatar_id\x18\x02 \x01(\tR\bavatarId\"O\n" +
+	"\x17SetCurrentUserAvatarReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tavatar_id\x18\x02 \x01(\tR\bavatarId\"\xa3\x02\n" +
 	"\aContact\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fphone_number\x18\x02 \x01(\tR\vphoneNumber\x12\x12\n" +
@@ -1089,7 +3134,10 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\"S\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x19\n" +
+	"\x05alias\x18\n" +
+	" \x01(\tH\x00R\x05alias\x88\x01\x01B\b\n" +
+	"\x06_alias\"S\n" +
 	"\x10CreateContactReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
 	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\")\n" +
@@ -1101,24 +3149,121 @@ const file_user_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\">\n" +
 	"\x11SearchContactsRes\x12)\n" +
-	"\bcontacts\x18\x01 \x03(\v2\r.user.ContactR\bcontacts\".\n" +
+	"\bcontacts\x18\x01 \x03(\v2\r.user.ContactR\bcontacts\"S\n" +
+	"\x10DeleteContactReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\"}\n" +
+	"\x15UpdateContactAliasReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\x12\x19\n" +
+	"\x05alias\x18\x03 \x01(\tH\x00R\x05alias\x88\x01\x01B\b\n" +
+	"\x06_alias\"Y\n" +
+	"\x14GetContactAliasesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
+	"\x10contact_user_ids\x18\x02 \x03(\tR\x0econtactUserIds\"\x95\x01\n" +
+	"\x14GetContactAliasesRes\x12A\n" +
+	"\aaliases\x18\x01 \x03(\v2'.user.GetContactAliasesRes.AliasesEntryR\aaliases\x1a:\n" +
+	"\fAliasesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"t\n" +
+	"\x11ImportContactsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rphone_numbers\x18\x02 \x03(\tR\fphoneNumbers\x12!\n" +
+	"\fphone_hashes\x18\x03 \x03(\tR\vphoneHashes\"f\n" +
+	"\x0fImportedContact\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"phone_hash\x18\x02 \x01(\tR\tphoneHash\x12\x14\n" +
+	"\x05added\x18\x03 \x01(\bR\x05added\"p\n" +
+	"\x11ImportContactsRes\x12+\n" +
+	"\x05found\x18\x01 \x03(\v2\x15.user.ImportedContactR\x05found\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x18\n" +
+	"\ainvalid\x18\x03 \x01(\x05R\ainvalid\",\n" +
+	"\x11UserRegisteredReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\".\n" +
+	"\x13UserPhoneChangedReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"N\n" +
+	"\x11UpdateLastSeenReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\flast_seen_at\x18\x02 \x01(\tR\n" +
+	"lastSeenAt\".\n" +
 	"\x11GetUserAvatarsReq\x12\x19\n" +
 	"\buser_ids\x18\x01 \x03(\tR\auserIds\"\x8f\x01\n" +
 	"\x11GetUserAvatarsRes\x12>\n" +
 	"\aavatars\x18\x01 \x03(\v2$.user.GetUserAvatarsRes.AvatarsEntryR\aavatars\x1a:\n" +
 	"\fAvatarsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xea\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"O\n" +
+	"\fBlockUserReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"Q\n" +
+	"\x0eUnblockUserReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"L\n" +
+	"\vBlockedUser\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\tR\tblockedAt\"-\n" +
+	"\x12GetBlockedUsersReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\x12GetBlockedUsersRes\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.user.BlockedUserR\x05users\"H\n" +
+	"\x12GetBlockedPeersReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bpeer_ids\x18\x02 \x03(\tR\apeerIds\"/\n" +
+	"\x12GetBlockedPeersRes\x12\x19\n" +
+	"\bpeer_ids\x18\x01 \x03(\tR\apeerIds\"\x91\x01\n" +
+	"\vPrivacyRule\x12\x18\n" +
+	"\asetting\x18\x01 \x01(\tR\asetting\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x02 \x01(\tR\n" +
+	"visibility\x12$\n" +
+	"\x0eallow_user_ids\x18\x03 \x03(\tR\fallowUserIds\x12\"\n" +
+	"\rdeny_user_ids\x18\x04 \x03(\tR\vdenyUserIds\"0\n" +
+	"\x15GetPrivacySettingsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\x15GetPrivacySettingsRes\x12'\n" +
+	"\x05rules\x18\x01 \x03(\v2\x11.user.PrivacyRuleR\x05rules\"Y\n" +
+	"\x17UpdatePrivacySettingReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x04rule\x18\x02 \x01(\v2\x11.user.PrivacyRuleR\x04rule\"J\n" +
+	"\x14GetGroupAddDeniedReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bpeer_ids\x18\x02 \x03(\tR\apeerIds\"1\n" +
+	"\x14GetGroupAddDeniedRes\x12\x19\n" +
+	"\bpeer_ids\x18\x01 \x03(\tR\apeerIds2\xf3\x0f\n" +
 	"\vUserService\x129\n" +
 	"\vGetUserById\x12\x14.user.GetUserByIdReq\x1a\x14.user.GetUserByIdRes\x12B\n" +
 	"\x0eGetUserByPhone\x12\x17.user.GetUserByPhoneReq\x1a\x17.user.GetUserByPhoneRes\x12K\n" +
-	"\x11GetUserByUsername\x12\x1a.user.GetUserByUsernameReq\x1a\x1a.user.GetUserByUsernameRes\x12A\n" +
+	"\x11GetUserByUsername\x12\x1a.user.GetUserByUsernameReq\x1a\x1a.user.GetUserByUsernameRes\x12E\n" +
+	"\x0fResolveUsername\x12\x18.user.ResolveUsernameReq\x1a\x18.user.ResolveUsernameRes\x12?\n" +
+	"\rGetUsersByIDs\x12\x16.user.GetUsersByIDsReq\x1a\x16.user.GetUsersByIDsRes\x129\n" +
+	"\vSearchUsers\x12\x14.user.SearchUsersReq\x1a\x14.user.SearchUsersRes\x12A\n" +
 	"\x0eUpdateUserInfo\x12\x17.user.UpdateUserInfoReq\x1a\x16.google.protobuf.Empty\x12H\n" +
-	"\x10UploadUserAvatar\x12\x19.user.UploadUserAvatarReq\x1a\x19.user.UploadUserAvatarRes\x12?\n" +
+	"\x10UploadUserAvatar\x12\x19.user.UploadUserAvatarReq\x1a\x19.user.UploadUserAvatarRes\x12T\n" +
+	"\x14GetUserAvatarHistory\x12\x1d.user.GetUserAvatarHistoryReq\x1a\x1d.user.GetUserAvatarHistoryRes\x12E\n" +
+	"\x10DeleteUserAvatar\x12\x19.user.DeleteUserAvatarReq\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x14SetCurrentUserAvatar\x12\x1d.user.SetCurrentUserAvatarReq\x1a\x16.google.protobuf.Empty\x12?\n" +
 	"\rCreateContact\x12\x16.user.CreateContactReq\x1a\x16.google.protobuf.Empty\x129\n" +
 	"\vGetContacts\x12\x14.user.GetContactsReq\x1a\x14.user.GetContactsRes\x12B\n" +
-	"\x0eSearchContacts\x12\x17.user.SearchContactsReq\x1a\x17.user.SearchContactsRes\x12B\n" +
-	"\x0eGetUserAvatars\x12\x17.user.GetUserAvatarsReq\x1a\x17.user.GetUserAvatarsResBOZMgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/userb\x06proto3"
+	"\x0eSearchContacts\x12\x17.user.SearchContactsReq\x1a\x17.user.SearchContactsRes\x12?\n" +
+	"\rDeleteContact\x12\x16.user.DeleteContactReq\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x12UpdateContactAlias\x12\x1b.user.UpdateContactAliasReq\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x11GetContactAliases\x12\x1a.user.GetContactAliasesReq\x1a\x1a.user.GetContactAliasesRes\x12B\n" +
+	"\x0eImportContacts\x12\x17.user.ImportContactsReq\x1a\x17.user.ImportContactsRes\x12A\n" +
+	"\x0eUserRegistered\x12\x17.user.UserRegisteredReq\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x10UserPhoneChanged\x12\x19.user.UserPhoneChangedReq\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x0eUpdateLastSeen\x12\x17.user.UpdateLastSeenReq\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x0eGetUserAvatars\x12\x17.user.GetUserAvatarsReq\x1a\x17.user.GetUserAvatarsRes\x127\n" +
+	"\tBlockUser\x12\x12.user.BlockUserReq\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\vUnblockUser\x12\x14.user.UnblockUserReq\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\x0fGetBlockedUsers\x12\x18.user.GetBlockedUsersReq\x1a\x18.user.GetBlockedUsersRes\x12E\n" +
+	"\x0fGetBlockedPeers\x12\x18.user.GetBlockedPeersReq\x1a\x18.user.GetBlockedPeersRes\x12N\n" +
+	"\x12GetPrivacySettings\x12\x1b.user.GetPrivacySettingsReq\x1a\x1b.user.GetPrivacySettingsRes\x12M\n" +
+	"\x14UpdatePrivacySetting\x12\x1d.user.UpdatePrivacySettingReq\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x11GetGroupAddDenied\x12\x1a.user.GetGroupAddDeniedReq\x1a\x1a.user.GetGroupAddDeniedResBOZMgithub.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1132,59 +3277,155 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_user_proto_goTypes = []any{
-	(*User)(nil),                 // 0: user.User
-	(*GetUserByIdReq)(nil),       // 1: user.GetUserByIdReq
-	(*GetUserByIdRes)(nil),       // 2: user.GetUserByIdRes
-	(*GetUserByPhoneReq)(nil),    // 3: user.GetUserByPhoneReq
-	(*GetUserByPhoneRes)(nil),    // 4: user.GetUserByPhoneRes
-	(*GetUserByUsernameReq)(nil), // 5: user.GetUserByUsernameReq
-	(*GetUserByUsernameRes)(nil), // 6: user.GetUserByUsernameRes
-	(*UpdateUserInfoReq)(nil),    // 7: user.UpdateUserInfoReq
-	(*UploadUserAvatarReq)(nil),  // 8: user.UploadUserAvatarReq
-	(*UploadUserAvatarRes)(nil),  // 9: user.UploadUserAvatarRes
-	(*Contact)(nil),              // 10: user.Contact
-	(*CreateContactReq)(nil),     // 11: user.CreateContactReq
-	(*GetContactsReq)(nil),       // 12: user.GetContactsReq
-	(*GetContactsRes)(nil),       // 13: user.GetContactsRes
-	(*SearchContactsReq)(nil),    // 14: user.SearchContactsReq
-	(*SearchContactsRes)(nil),    // 15: user.SearchContactsRes
-	(*GetUserAvatarsReq)(nil),    // 16: user.GetUserAvatarsReq
-	(*GetUserAvatarsRes)(nil),    // 17: user.GetUserAvatarsRes
-	nil,                          // 18: user.GetUserAvatarsRes.AvatarsEntry
-	(*emptypb.Empty)(nil),        // 19: google.protobuf.Empty
+	(*User)(nil),                    // 0: user.User
+	(*UserStatus)(nil),              // 1: user.UserStatus
+	(*ProfileLink)(nil),             // 2: user.ProfileLink
+	(*ProfileLinks)(nil),            // 3: user.ProfileLinks
+	(*GetUserByIdReq)(nil),          // 4: user.GetUserByIdReq
+	(*GetUserByIdRes)(nil),          // 5: user.GetUserByIdRes
+	(*GetUserByPhoneReq)(nil),       // 6: user.GetUserByPhoneReq
+	(*GetUserByPhoneRes)(nil),       // 7: user.GetUserByPhoneRes
+	(*GetUserByUsernameReq)(nil),    // 8: user.GetUserByUsernameReq
+	(*GetUserByUsernameRes)(nil),    // 9: user.GetUserByUsernameRes
+	(*ResolveUsernameReq)(nil),      // 10: user.ResolveUsernameReq
+	(*ResolveUsernameRes)(nil),      // 11: user.ResolveUsernameRes
+	(*GetUsersByIDsReq)(nil),        // 12: user.GetUsersByIDsReq
+	(*GetUsersByIDsRes)(nil),        // 13: user.GetUsersByIDsRes
+	(*SearchUsersReq)(nil),          // 14: user.SearchUsersReq
+	(*SearchUsersRes)(nil),          // 15: user.SearchUsersRes
+	(*UpdateUserInfoReq)(nil),       // 16: user.UpdateUserInfoReq
+	(*UploadUserAvatarReq)(nil),     // 17: user.UploadUserAvatarReq
+	(*UploadUserAvatarRes)(nil),     // 18: user.UploadUserAvatarRes
+	(*Avatar)(nil),                  // 19: user.Avatar
+	(*GetUserAvatarHistoryReq)(nil), // 20: user.GetUserAvatarHistoryReq
+	(*GetUserAvatarHistoryRes)(nil), // 21: user.GetUserAvatarHistoryRes
+	(*DeleteUserAvatarReq)(nil),     // 22: user.DeleteUserAvatarReq
+	(*SetCurrentUserAvatarReq)(nil), // 23: user.SetCurrentUserAvatarReq
+	(*Contact)(nil),                 // 24: user.Contact
+	(*CreateContactReq)(nil),        // 25: user.CreateContactReq
+	(*GetContactsReq)(nil),          // 26: user.GetContactsReq
+	(*GetContactsRes)(nil),          // 27: user.GetContactsRes
+	(*SearchContactsReq)(nil),       // 28: user.SearchContactsReq
+	(*SearchContactsRes)(nil),       // 29: user.SearchContactsRes
+	(*DeleteContactReq)(nil),        // 30: user.DeleteContactReq
+	(*UpdateContactAliasReq)(nil),   // 31: user.UpdateContactAliasReq
+	(*GetContactAliasesReq)(nil),    // 32: user.GetContactAliasesReq
+	(*GetContactAliasesRes)(nil),    // 33: user.GetContactAliasesRes
+	(*ImportContactsReq)(nil),       // 34: user.ImportContactsReq
+	(*ImportedContact)(nil),         // 35: user.ImportedContact
+	(*ImportContactsRes)(nil),       // 36: user.ImportContactsRes
+	(*UserRegisteredReq)(nil),       // 37: user.UserRegisteredReq
+	(*UserPhoneChangedReq)(nil),     // 38: user.UserPhoneChangedReq
+	(*UpdateLastSeenReq)(nil),       // 39: user.UpdateLastSeenReq
+	(*GetUserAvatarsReq)(nil),       // 40: user.GetUserAvatarsReq
+	(*GetUserAvatarsRes)(nil),       // 41: user.GetUserAvatarsRes
+	(*BlockUserReq)(nil),            // 42: user.BlockUserReq
+	(*UnblockUserReq)(nil),          // 43: user.UnblockUserReq
+	(*BlockedUser)(nil),             // 44: user.BlockedUser
+	(*GetBlockedUsersReq)(nil),      // 45: user.GetBlockedUsersReq
+	(*GetBlockedUsersRes)(nil),      // 46: user.GetBlockedUsersRes
+	(*GetBlockedPeersReq)(nil),      // 47: user.GetBlockedPeersReq
+	(*GetBlockedPeersRes)(nil),      // 48: user.GetBlockedPeersRes
+	(*PrivacyRule)(nil),             // 49: user.PrivacyRule
+	(*GetPrivacySettingsReq)(nil),   // 50: user.GetPrivacySettingsReq
+	(*GetPrivacySettingsRes)(nil),   // 51: user.GetPrivacySettingsRes
+	(*UpdatePrivacySettingReq)(nil), // 52: user.UpdatePrivacySettingReq
+	(*GetGroupAddDeniedReq)(nil),    // 53: user.GetGroupAddDeniedReq
+	(*GetGroupAddDeniedRes)(nil),    // 54: user.GetGroupAddDeniedRes
+	nil,                             // 55: user.Avatar.SizesEntry
+	nil,                             // 56: user.GetContactAliasesRes.AliasesEntry
+	nil,                             // 57: user.GetUserAvatarsRes.AvatarsEntry
+	(*emptypb.Empty)(nil),           // 58: google.protobuf.Empty
 }
 var file_user_proto_depIdxs = []int32{
-	0,  // 0: user.GetUserByIdRes.user:type_name -> user.User
-	0,  // 1: user.GetUserByPhoneRes.user:type_name -> user.User
-	0,  // 2: user.GetUserByUsernameRes.user:type_name -> user.User
-	10, // 3: user.GetContactsRes.contacts:type_name -> user.Contact
-	10, // 4: user.SearchContactsRes.contacts:type_name -> user.Contact
-	18, // 5: user.GetUserAvatarsRes.avatars:type_name -> user.GetUserAvatarsRes.AvatarsEntry
-	1,  // 6: user.UserService.GetUserById:input_type -> user.GetUserByIdReq
-	3,  // 7: user.UserService.GetUserByPhone:input_type -> user.GetUserByPhoneReq
-	5,  // 8: user.UserService.GetUserByUsername:input_type -> user.GetUserByUsernameReq
-	7,  // 9: user.UserService.UpdateUserInfo:input_type -> user.UpdateUserInfoReq
-	8,  // 10: user.UserService.UploadUserAvatar:input_type -> user.UploadUserAvatarReq
-	11, // 11: user.UserService.CreateContact:input_type -> user.CreateContactReq
-	12, // 12: user.UserService.GetContacts:input_type -> user.GetContactsReq
-	14, // 13: user.UserService.SearchContacts:input_type -> user.SearchContactsReq
-	16, // 14: user.UserService.GetUserAvatars:input_type -> user.GetUserAvatarsReq
-	2,  // 15: user.UserService.GetUserById:output_type -> user.GetUserByIdRes
-	4,  // 16: user.UserService.GetUserByPhone:output_type -> user.GetUserByPhoneRes
-	6,  // 17: user.UserService.GetUserByUsername:output_type -> user.GetUserByUsernameRes
-	19, // 18: user.UserService.UpdateUserInfo:output_type -> google.protobuf.Empty
-	9,  // 19: user.UserService.UploadUserAvatar:output_type -> user.UploadUserAvatarRes
-	19, // 20: user.UserService.CreateContact:output_type -> google.protobuf.Empty
-	13, // 21: user.UserService.GetContacts:output_type -> user.GetContactsRes
-	15, // 22: user.UserService.SearchContacts:output_type -> user.SearchContactsRes
-	17, // 23: user.UserService.GetUserAvatars:output_type -> user.GetUserAvatarsRes
-	15, // [15:24] is the sub-list for method output_type
-	6,  // [6:15] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 0: user.User.status:type_name -> user.UserStatus
+	2,  // 1: user.User.links:type_name -> user.ProfileLink
+	2,  // 2: user.ProfileLinks.links:type_name -> user.ProfileLink
+	0,  // 3: user.GetUserByIdRes.user:type_name -> user.User
+	0,  // 4: user.GetUserByPhoneRes.user:type_name -> user.User
+	0,  // 5: user.GetUserByUsernameRes.user:type_name -> user.User
+	0,  // 6: user.ResolveUsernameRes.user:type_name -> user.User
+	0,  // 7: user.GetUsersByIDsRes.users:type_name -> user.User
+	0,  // 8: user.SearchUsersRes.users:type_name -> user.User
+	1,  // 9: user.UpdateUserInfoReq.status:type_name -> user.UserStatus
+	3,  // 10: user.UpdateUserInfoReq.links:type_name -> user.ProfileLinks
+	55, // 11: user.Avatar.sizes:type_name -> user.Avatar.SizesEntry
+	19, // 12: user.GetUserAvatarHistoryRes.avatars:type_name -> user.Avatar
+	24, // 13: user.GetContactsRes.contacts:type_name -> user.Contact
+	24, // 14: user.SearchContactsRes.contacts:type_name -> user.Contact
+	56, // 15: user.GetContactAliasesRes.aliases:type_name -> user.GetContactAliasesRes.AliasesEntry
+	0,  // 16: user.ImportedContact.user:type_name -> user.User
+	35, // 17: user.ImportContactsRes.found:type_name -> user.ImportedContact
+	57, // 18: user.GetUserAvatarsRes.avatars:type_name -> user.GetUserAvatarsRes.AvatarsEntry
+	0,  // 19: user.BlockedUser.user:type_name -> user.User
+	44, // 20: user.GetBlockedUsersRes.users:type_name -> user.BlockedUser
+	49, // 21: user.GetPrivacySettingsRes.rules:type_name -> user.PrivacyRule
+	49, // 22: user.UpdatePrivacySettingReq.rule:type_name -> user.PrivacyRule
+	4,  // 23: user.UserService.GetUserById:input_type -> user.GetUserByIdReq
+	6,  // 24: user.UserService.GetUserByPhone:input_type -> user.GetUserByPhoneReq
+	8,  // 25: user.UserService.GetUserByUsername:input_type -> user.GetUserByUsernameReq
+	10, // 26: user.UserService.ResolveUsername:input_type -> user.ResolveUsernameReq
+	12, // 27: user.UserService.GetUsersByIDs:input_type -> user.GetUsersByIDsReq
+	14, // 28: user.UserService.SearchUsers:input_type -> user.SearchUsersReq
+	16, // 29: user.UserService.UpdateUserInfo:input_type -> user.UpdateUserInfoReq
+	17, // 30: user.UserService.UploadUserAvatar:input_type -> user.UploadUserAvatarReq
+	20, // 31: user.UserService.GetUserAvatarHistory:input_type -> user.GetUserAvatarHistoryReq
+	22, // 32: user.UserService.DeleteUserAvatar:input_type -> user.DeleteUserAvatarReq
+	23, // 33: user.UserService.SetCurrentUserAvatar:input_type -> user.SetCurrentUserAvatarReq
+	25, // 34: user.UserService.CreateContact:input_type -> user.CreateContactReq
+	26, // 35: user.UserService.GetContacts:input_type -> user.GetContactsReq
+	28, // 36: user.UserService.SearchContacts:input_type -> user.SearchContactsReq
+	30, // 37: user.UserService.DeleteContact:input_type -> user.DeleteContactReq
+	31, // 38: user.UserService.UpdateContactAlias:input_type -> user.UpdateContactAliasReq
+	32, // 39: user.UserService.GetContactAliases:input_type -> user.GetContactAliasesReq
+	34, // 40: user.UserService.ImportContacts:input_type -> user.ImportContactsReq
+	37, // 41: user.UserService.UserRegistered:input_type -> user.UserRegisteredReq
+	38, // 42: user.UserService.UserPhoneChanged:input_type -> user.UserPhoneChangedReq
+	39, // 43: user.UserService.UpdateLastSeen:input_type -> user.UpdateLastSeenReq
+	40, // 44: user.UserService.GetUserAvatars:input_type -> user.GetUserAvatarsReq
+	42, // 45: user.UserService.BlockUser:input_type -> user.BlockUserReq
+	43, // 46: user.UserService.UnblockUser:input_type -> user.UnblockUserReq
+	45, // 47: user.UserService.GetBlockedUsers:input_type -> user.GetBlockedUsersReq
+	47, // 48: user.UserService.GetBlockedPeers:input_type -> user.GetBlockedPeersReq
+	50, // 49: user.UserService.GetPrivacySettings:input_type -> user.GetPrivacySettingsReq
+	52, // 50: user.UserService.UpdatePrivacySetting:input_type -> user.UpdatePrivacySettingReq
+	53, // 51: user.UserService.GetGroupAddDenied:input_type -> user.GetGroupAddDeniedReq
+	5,  // 52: user.UserService.GetUserById:output_type -> user.GetUserByIdRes
+	7,  // 53: user.UserService.GetUserByPhone:output_type -> user.GetUserByPhoneRes
+	9,  // 54: user.UserService.GetUserByUsername:output_type -> user.GetUserByUsernameRes
+	11, // 55: user.UserService.ResolveUsername:output_type -> user.ResolveUsernameRes
+	13, // 56: user.UserService.GetUsersByIDs:output_type -> user.GetUsersByIDsRes
+	15, // 57: user.UserService.SearchUsers:output_type -> user.SearchUsersRes
+	58, // 58: user.UserService.UpdateUserInfo:output_type -> google.protobuf.Empty
+	18, // 59: user.UserService.UploadUserAvatar:output_type -> user.UploadUserAvatarRes
+	21, // 60: user.UserService.GetUserAvatarHistory:output_type -> user.GetUserAvatarHistoryRes
+	58, // 61: user.UserService.DeleteUserAvatar:output_type -> google.protobuf.Empty
+	58, // 62: user.UserService.SetCurrentUserAvatar:output_type -> google.protobuf.Empty
+	58, // 63: user.UserService.CreateContact:output_type -> google.protobuf.Empty
+	27, // 64: user.UserService.GetContacts:output_type -> user.GetContactsRes
+	29, // 65: user.UserService.SearchContacts:output_type -> user.SearchContactsRes
+	58, // 66: user.UserService.DeleteContact:output_type -> google.protobuf.Empty
+	58, // 67: user.UserService.UpdateContactAlias:output_type -> google.protobuf.Empty
+	33, // 68: user.UserService.GetContactAliases:output_type -> user.GetContactAliasesRes
+	36, // 69: user.UserService.ImportContacts:output_type -> user.ImportContactsRes
+	58, // 70: user.UserService.UserRegistered:output_type -> google.protobuf.Empty
+	58, // 71: user.UserService.UserPhoneChanged:output_type -> google.protobuf.Empty
+	58, // 72: user.UserService.UpdateLastSeen:output_type -> google.protobuf.Empty
+	41, // 73: user.UserService.GetUserAvatars:output_type -> user.GetUserAvatarsRes
+	58, // 74: user.UserService.BlockUser:output_type -> google.protobuf.Empty
+	58, // 75: user.UserService.UnblockUser:output_type -> google.protobuf.Empty
+	46, // 76: user.UserService.GetBlockedUsers:output_type -> user.GetBlockedUsersRes
+	48, // 77: user.UserService.GetBlockedPeers:output_type -> user.GetBlockedPeersRes
+	51, // 78: user.UserService.GetPrivacySettings:output_type -> user.GetPrivacySettingsRes
+	58, // 79: user.UserService.UpdatePrivacySetting:output_type -> google.protobuf.Empty
+	54, // 80: user.UserService.GetGroupAddDenied:output_type -> user.GetGroupAddDeniedRes
+	52, // [52:81] is the sub-list for method output_type
+	23, // [23:52] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1192,14 +3433,18 @@ func file_user_proto_init() {
 	if File_user_proto != nil {
 		return
 	}
-	file_user_proto_msgTypes[7].OneofWrappers = []any{}
+	file_user_proto_msgTypes[1].OneofWrappers = []any{}
+	file_user_proto_msgTypes[14].OneofWrappers = []any{}
+	file_user_proto_msgTypes[16].OneofWrappers = []any{}
+	file_user_proto_msgTypes[24].OneofWrappers = []any{}
+	file_user_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,15 +20,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUserById_FullMethodName       = "/user.UserService/GetUserById"
-	UserService_GetUserByPhone_FullMethodName    = "/user.UserService/GetUserByPhone"
-	UserService_GetUserByUsername_FullMethodName = "/user.UserService/GetUserByUsername"
-	UserService_UpdateUserInfo_FullMethodName    = "/user.UserService/UpdateUserInfo"
-	UserService_UploadUserAvatar_FullMethodName  = "/user.UserService/UploadUserAvatar"
-	UserService_CreateContact_FullMethodName     = "/user.UserService/CreateContact"
-	UserService_GetContacts_FullMethodName       = "/user.UserService/GetContacts"
-	UserService_SearchContacts_FullMethodName    = "/user.UserService/SearchContacts"
-	UserService_GetUserAvatars_FullMethodName    = "/user.UserService/GetUserAvatars"
+	UserService_GetUserById_FullMethodName          = "/user.UserService/GetUserById"
+	UserService_GetUserByPhone_FullMethodName       = "/user.UserService/GetUserByPhone"
+	UserService_GetUserByUsername_FullMethodName    = "/user.UserService/GetUserByUsername"
+	UserService_ResolveUsername_FullMethodName      = "/user.UserService/ResolveUsername"
+	UserService_GetUsersByIDs_FullMethodName        = "/user.UserService/GetUsersByIDs"
+	UserService_SearchUsers_FullMethodName          = "/user.UserService/SearchUsers"
+	UserService_UpdateUserInfo_FullMethodName       = "/user.UserService/UpdateUserInfo"
+	UserService_UploadUserAvatar_FullMethodName     = "/user.UserService/UploadUserAvatar"
+	UserService_GetUserAvatarHistory_FullMethodName = "/user.UserService/GetUserAvatarHistory"
+	UserService_DeleteUserAvatar_FullMethodName     = "/user.UserService/DeleteUserAvatar"
+	UserService_SetCurrentUserAvatar_FullMethodName = "/user.UserService/SetCurrentUserAvatar"
+	UserService_CreateContact_FullMethodName        = "/user.UserService/CreateContact"
+	UserService_GetContacts_FullMethodName          = "/user.UserService/GetContacts"
+	UserService_SearchContacts_FullMethodName       = "/user.UserService/SearchContacts"
+	UserService_DeleteContact_FullMethodName        = "/user.UserService/DeleteContact"
+	UserService_UpdateContactAlias_FullMethodName   = "/user.UserService/UpdateContactAlias"
+	UserService_GetContactAliases_FullMethodName    = "/user.UserService/GetContactAliases"
+	UserService_ImportContacts_FullMethodName       = "/user.UserService/ImportContacts"
+	UserService_UserRegistered_FullMethodName       = "/user.UserService/UserRegistered"
+	UserService_UserPhoneChanged_FullMethodName     = "/user.UserService/UserPhoneChanged"
+	UserService_UpdateLastSeen_FullMethodName       = "/user.UserService/UpdateLastSeen"
+	UserService_GetUserAvatars_FullMethodName       = "/user.UserService/GetUserAvatars"
+	UserService_BlockUser_FullMethodName            = "/user.UserService/BlockUser"
+	UserService_UnblockUser_FullMethodName          = "/user.UserService/UnblockUser"
+	UserService_GetBlockedUsers_FullMethodName      = "/user.UserService/GetBlockedUsers"
+	UserService_GetBlockedPeers_FullMethodName      = "/user.UserService/GetBlockedPeers"
+	UserService_GetPrivacySettings_FullMethodName   = "/user.UserService/GetPrivacySettings"
+	UserService_UpdatePrivacySetting_FullMethodName = "/user.UserService/UpdatePrivacySetting"
+	UserService_GetGroupAddDenied_FullMethodName    = "/user.UserService/GetGroupAddDenied"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -40,12 +60,32 @@ type UserServiceClient interface {
 	GetUserById(ctx context.Context, in *GetUserByIdReq, opts ...grpc.CallOption) (*GetUserByIdRes, error)
 	GetUserByPhone(ctx context.Context, in *GetUserByPhoneReq, opts ...grpc.CallOption) (*GetUserByPhoneRes, error)
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameReq, opts ...grpc.CallOption) (*GetUserByUsernameRes, error)
+	ResolveUsername(ctx context.Context, in *ResolveUsernameReq, opts ...grpc.CallOption) (*ResolveUsernameRes, error)
+	GetUsersByIDs(ctx context.Context, in *GetUsersByIDsReq, opts ...grpc.CallOption) (*GetUsersByIDsRes, error)
+	SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersRes, error)
 	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UploadUserAvatar(ctx context.Context, in *UploadUserAvatarReq, opts ...grpc.CallOption) (*UploadUserAvatarRes, error)
+	GetUserAvatarHistory(ctx context.Context, in *GetUserAvatarHistoryReq, opts ...grpc.CallOption) (*GetUserAvatarHistoryRes, error)
+	DeleteUserAvatar(ctx context.Context, in *DeleteUserAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetCurrentUserAvatar(ctx context.Context, in *SetCurrentUserAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateContact(ctx context.Context, in *CreateContactReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetContacts(ctx context.Context, in *GetContactsReq, opts ...grpc.CallOption) (*GetContactsRes, error)
 	SearchContacts(ctx context.Context, in *SearchContactsReq, opts ...grpc.CallOption) (*SearchContactsRes, error)
+	DeleteContact(ctx context.Context, in *DeleteContactReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateContactAlias(ctx context.Context, in *UpdateContactAliasReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetContactAliases(ctx context.Context, in *GetContactAliasesReq, opts ...grpc.CallOption) (*GetContactAliasesRes, error)
+	ImportContacts(ctx context.Context, in *ImportContactsReq, opts ...grpc.CallOption) (*ImportContactsRes, error)
+	UserRegistered(ctx context.Context, in *UserRegisteredReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UserPhoneChanged(ctx context.Context, in *UserPhoneChangedReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateLastSeen(ctx context.Context, in *UpdateLastSeenReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetUserAvatars(ctx context.Context, in *GetUserAvatarsReq, opts ...grpc.CallOption) (*GetUserAvatarsRes, error)
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBlockedUsers(ctx context.Context, in *GetBlockedUsersReq, opts ...grpc.CallOption) (*GetBlockedUsersRes, error)
+	GetBlockedPeers(ctx context.Context, in *GetBlockedPeersReq, opts ...grpc.CallOption) (*GetBlockedPeersRes, error)
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsReq, opts ...grpc.CallOption) (*GetPrivacySettingsRes, error)
+	UpdatePrivacySetting(ctx context.Context, in *UpdatePrivacySettingReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGroupAddDenied(ctx context.Context, in *GetGroupAddDeniedReq, opts ...grpc.CallOption) (*GetGroupAddDeniedRes, error)
 }
 
 type userServiceClient struct {
@@ -86,6 +126,36 @@ func (c *userServiceClient) GetUserByUsername(ctx context.Context, in *GetUserBy
 	return out, nil
 }
 
+func (c *userServiceClient) ResolveUsername(ctx context.Context, in *ResolveUsernameReq, opts ...grpc.CallOption) (*ResolveUsernameRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveUsernameRes)
+	err := c.cc.Invoke(ctx, UserService_ResolveUsername_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUsersByIDs(ctx context.Context, in *GetUsersByIDsReq, opts ...grpc.CallOption) (*GetUsersByIDsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByIDsRes)
+	err := c.cc.Invoke(ctx, UserService_GetUsersByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersRes)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -106,6 +176,36 @@ func (c *userServiceClient) UploadUserAvatar(ctx context.Context, in *UploadUser
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserAvatarHistory(ctx context.Context, in *GetUserAvatarHistoryReq, opts ...grpc.CallOption) (*GetUserAvatarHistoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserAvatarHistoryRes)
+	err := c.cc.Invoke(ctx, UserService_GetUserAvatarHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserAvatar(ctx context.Context, in *DeleteUserAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetCurrentUserAvatar(ctx context.Context, in *SetCurrentUserAvatarReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_SetCurrentUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateContact(ctx context.Context, in *CreateContactReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -136,6 +236,76 @@ func (c *userServiceClient) SearchContacts(ctx context.Context, in *SearchContac
 	return out, nil
 }
 
+func (c *userServiceClient) DeleteContact(ctx context.Context, in *DeleteContactReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateContactAlias(ctx context.Context, in *UpdateContactAliasReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdateContactAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetContactAliases(ctx context.Context, in *GetContactAliasesReq, opts ...grpc.CallOption) (*GetContactAliasesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactAliasesRes)
+	err := c.cc.Invoke(ctx, UserService_GetContactAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportContacts(ctx context.Context, in *ImportContactsReq, opts ...grpc.CallOption) (*ImportContactsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportContactsRes)
+	err := c.cc.Invoke(ctx, UserService_ImportContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserRegistered(ctx context.Context, in *UserRegisteredReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UserRegistered_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UserPhoneChanged(ctx context.Context, in *UserPhoneChangedReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UserPhoneChanged_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateLastSeen(ctx context.Context, in *UpdateLastSeenReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdateLastSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserAvatars(ctx context.Context, in *GetUserAvatarsReq, opts ...grpc.CallOption) (*GetUserAvatarsRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserAvatarsRes)
@@ -146,6 +316,76 @@ func (c *userServiceClient) GetUserAvatars(ctx context.Context, in *GetUserAvata
 	return out, nil
 }
 
+func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBlockedUsers(ctx context.Context, in *GetBlockedUsersReq, opts ...grpc.CallOption) (*GetBlockedUsersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockedUsersRes)
+	err := c.cc.Invoke(ctx, UserService_GetBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetBlockedPeers(ctx context.Context, in *GetBlockedPeersReq, opts ...grpc.CallOption) (*GetBlockedPeersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockedPeersRes)
+	err := c.cc.Invoke(ctx, UserService_GetBlockedPeers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsReq, opts ...grpc.CallOption) (*GetPrivacySettingsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrivacySettingsRes)
+	err := c.cc.Invoke(ctx, UserService_GetPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePrivacySetting(ctx context.Context, in *UpdatePrivacySettingReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UpdatePrivacySetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetGroupAddDenied(ctx context.Context, in *GetGroupAddDeniedReq, opts ...grpc.CallOption) (*GetGroupAddDeniedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupAddDeniedRes)
+	err := c.cc.Invoke(ctx, UserService_GetGroupAddDenied_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -155,12 +395,32 @@ type UserServiceServer interface {
 	GetUserById(context.Context, *GetUserByIdReq) (*GetUserByIdRes, error)
 	GetUserByPhone(context.Context, *GetUserByPhoneReq) (*GetUserByPhoneRes, error)
 	GetUserByUsername(context.Context, *GetUserByUsernameReq) (*GetUserByUsernameRes, error)
+	ResolveUsername(context.Context, *ResolveUsernameReq) (*ResolveUsernameRes, error)
+	GetUsersByIDs(context.Context, *GetUsersByIDsReq) (*GetUsersByIDsRes, error)
+	SearchUsers(context.Context, *SearchUsersReq) (*SearchUsersRes, error)
 	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*emptypb.Empty, error)
 	UploadUserAvatar(context.Context, *UploadUserAvatarReq) (*UploadUserAvatarRes, error)
+	GetUserAvatarHistory(context.Context, *GetUserAvatarHistoryReq) (*GetUserAvatarHistoryRes, error)
+	DeleteUserAvatar(context.Context, *DeleteUserAvatarReq) (*emptypb.Empty, error)
+	SetCurrentUserAvatar(context.Context, *SetCurrentUserAvatarReq) (*emptypb.Empty, error)
 	CreateContact(context.Context, *CreateContactReq) (*emptypb.Empty, error)
 	GetContacts(context.Context, *GetContactsReq) (*GetContactsRes, error)
 	SearchContacts(context.Context, *SearchContactsReq) (*SearchContactsRes, error)
+	DeleteContact(context.Context, *DeleteContactReq) (*emptypb.Empty, error)
+	UpdateContactAlias(context.Context, *UpdateContactAliasReq) (*emptypb.Empty, error)
+	GetContactAliases(context.Context, *GetContactAliasesReq) (*GetContactAliasesRes, error)
+	ImportContacts(context.Context, *ImportContactsReq) (*ImportContactsRes, error)
+	UserRegistered(context.Context, *UserRegisteredReq) (*emptypb.Empty, error)
+	UserPhoneChanged(context.Context, *UserPhoneChangedReq) (*emptypb.Empty, error)
+	UpdateLastSeen(context.Context, *UpdateLastSeenReq) (*emptypb.Empty, error)
 	GetUserAvatars(context.Context, *GetUserAvatarsReq) (*GetUserAvatarsRes, error)
+	BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error)
+	GetBlockedUsers(context.Context, *GetBlockedUsersReq) (*GetBlockedUsersRes, error)
+	GetBlockedPeers(context.Context, *GetBlockedPeersReq) (*GetBlockedPeersRes, error)
+	GetPrivacySettings(context.Context, *GetPrivacySettingsReq) (*GetPrivacySettingsRes, error)
+	UpdatePrivacySetting(context.Context, *UpdatePrivacySettingReq) (*emptypb.Empty, error)
+	GetGroupAddDenied(context.Context, *GetGroupAddDeniedReq) (*GetGroupAddDeniedRes, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -180,12 +440,30 @@ func (UnimplementedUserServiceServer) GetUserByPhone(context.Context, *GetUserBy
 func (UnimplementedUserServiceServer) GetUserByUsername(context.Context, *GetUserByUsernameReq) (*GetUserByUsernameRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
 }
+func (UnimplementedUserServiceServer) ResolveUsername(context.Context, *ResolveUsernameReq) (*ResolveUsernameRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveUsername not implemented")
+}
+func (UnimplementedUserServiceServer) GetUsersByIDs(context.Context, *GetUsersByIDsReq) (*GetUsersByIDsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersReq) (*SearchUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
 }
 func (UnimplementedUserServiceServer) UploadUserAvatar(context.Context, *UploadUserAvatarReq) (*UploadUserAvatarRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadUserAvatar not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserAvatarHistory(context.Context, *GetUserAvatarHistoryReq) (*GetUserAvatarHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatarHistory not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserAvatar(context.Context, *DeleteUserAvatarReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAvatar not implemented")
+}
+func (UnimplementedUserServiceServer) SetCurrentUserAvatar(context.Context, *SetCurrentUserAvatarReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrentUserAvatar not implemented")
+}
 func (UnimplementedUserServiceServer) CreateContact(context.Context, *CreateContactReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateContact not implemented")
 }
@@ -195,9 +473,51 @@ func (UnimplementedUserServiceServer) GetContacts(context.Context, *GetContactsR
 func (UnimplementedUserServiceServer) SearchContacts(context.Context, *SearchContactsReq) (*SearchContactsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchContacts not implemented")
 }
+func (UnimplementedUserServiceServer) DeleteContact(context.Context, *DeleteContactReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContact not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateContactAlias(context.Context, *UpdateContactAliasReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContactAlias not implemented")
+}
+func (UnimplementedUserServiceServer) GetContactAliases(context.Context, *GetContactAliasesReq) (*GetContactAliasesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContactAliases not implemented")
+}
+func (UnimplementedUserServiceServer) ImportContacts(context.Context, *ImportContactsReq) (*ImportContactsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportContacts not implemented")
+}
+func (UnimplementedUserServiceServer) UserRegistered(context.Context, *UserRegisteredReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRegistered not implemented")
+}
+func (UnimplementedUserServiceServer) UserPhoneChanged(context.Context, *UserPhoneChangedReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPhoneChanged not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateLastSeen(context.Context, *UpdateLastSeenReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLastSeen not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserAvatars(context.Context, *GetUserAvatarsReq) (*GetUserAvatarsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatars not implemented")
 }
+func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnblockUser(context.Context, *UnblockUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetBlockedUsers(context.Context, *GetBlockedUsersReq) (*GetBlockedUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetBlockedPeers(context.Context, *GetBlockedPeersReq) (*GetBlockedPeersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedPeers not implemented")
+}
+func (UnimplementedUserServiceServer) GetPrivacySettings(context.Context, *GetPrivacySettingsReq) (*GetPrivacySettingsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePrivacySetting(context.Context, *UpdatePrivacySettingReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySetting not implemented")
+}
+func (UnimplementedUserServiceServer) GetGroupAddDenied(context.Context, *GetGroupAddDeniedReq) (*GetGroupAddDeniedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupAddDenied not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -273,6 +593,60 @@ func _UserService_GetUserByUsername_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ResolveUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveUsernameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveUsername(ctx, req.(*ResolveUsernameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersByIDs(ctx, req.(*GetUsersByIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserInfoReq)
 	if err := dec(in); err != nil {
@@ -309,6 +683,60 @@ func _UserService_UploadUserAvatar_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserAvatarHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAvatarHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserAvatarHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserAvatarHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserAvatarHistory(ctx, req.(*GetUserAvatarHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserAvatarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserAvatar(ctx, req.(*DeleteUserAvatarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetCurrentUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCurrentUserAvatarReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetCurrentUserAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetCurrentUserAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetCurrentUserAvatar(ctx, req.(*SetCurrentUserAvatarReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateContactReq)
 	if err := dec(in); err != nil {
@@ -363,6 +791,132 @@ func _UserService_SearchContacts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteContact(ctx, req.(*DeleteContactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateContactAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactAliasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateContactAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateContactAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateContactAlias(ctx, req.(*UpdateContactAliasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetContactAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactAliasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetContactAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetContactAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetContactAliases(ctx, req.(*GetContactAliasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportContactsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportContacts(ctx, req.(*ImportContactsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserRegistered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRegisteredReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserRegistered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserRegistered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserRegistered(ctx, req.(*UserRegisteredReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UserPhoneChanged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPhoneChangedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserPhoneChanged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UserPhoneChanged_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserPhoneChanged(ctx, req.(*UserPhoneChangedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateLastSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLastSeenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateLastSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateLastSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateLastSeen(ctx, req.(*UpdateLastSeenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUserAvatars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserAvatarsReq)
 	if err := dec(in); err != nil {
@@ -381,6 +935,132 @@ func _UserService_GetUserAvatars_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockUser(ctx, req.(*BlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnblockUser(ctx, req.(*UnblockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBlockedUsers(ctx, req.(*GetBlockedUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetBlockedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedPeersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBlockedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBlockedPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBlockedPeers(ctx, req.(*GetBlockedPeersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePrivacySetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePrivacySetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePrivacySetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePrivacySetting(ctx, req.(*UpdatePrivacySettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetGroupAddDenied_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupAddDeniedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetGroupAddDenied(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetGroupAddDenied_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetGroupAddDenied(ctx, req.(*GetGroupAddDeniedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -400,6 +1080,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByUsername",
 			Handler:    _UserService_GetUserByUsername_Handler,
 		},
+		{
+			MethodName: "ResolveUsername",
+			Handler:    _UserService_ResolveUsername_Handler,
+		},
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _UserService_GetUsersByIDs_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "UpdateUserInfo",
 			Handler:    _UserService_UpdateUserInfo_Handler,
@@ -408,6 +1100,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadUserAvatar",
 			Handler:    _UserService_UploadUserAvatar_Handler,
 		},
+		{
+			MethodName: "GetUserAvatarHistory",
+			Handler:    _UserService_GetUserAvatarHistory_Handler,
+		},
+		{
+			MethodName: "DeleteUserAvatar",
+			Handler:    _UserService_DeleteUserAvatar_Handler,
+		},
+		{
+			MethodName: "SetCurrentUserAvatar",
+			Handler:    _UserService_SetCurrentUserAvatar_Handler,
+		},
 		{
 			MethodName: "CreateContact",
 			Handler:    _UserService_CreateContact_Handler,
@@ -420,10 +1124,66 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchContacts",
 			Handler:    _UserService_SearchContacts_Handler,
 		},
+		{
+			MethodName: "DeleteContact",
+			Handler:    _UserService_DeleteContact_Handler,
+		},
+		{
+			MethodName: "UpdateContactAlias",
+			Handler:    _UserService_UpdateContactAlias_Handler,
+		},
+		{
+			MethodName: "GetContactAliases",
+			Handler:    _UserService_GetContactAliases_Handler,
+		},
+		{
+			MethodName: "ImportContacts",
+			Handler:    _UserService_ImportContacts_Handler,
+		},
+		{
+			MethodName: "UserRegistered",
+			Handler:    _UserService_UserRegistered_Handler,
+		},
+		{
+			MethodName: "UserPhoneChanged",
+			Handler:    _UserService_UserPhoneChanged_Handler,
+		},
+		{
+			MethodName: "UpdateLastSeen",
+			Handler:    _UserService_UpdateLastSeen_Handler,
+		},
 		{
 			MethodName: "GetUserAvatars",
 			Handler:    _UserService_GetUserAvatars_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "GetBlockedUsers",
+			Handler:    _UserService_GetBlockedUsers_Handler,
+		},
+		{
+			MethodName: "GetBlockedPeers",
+			Handler:    _UserService_GetBlockedPeers_Handler,
+		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _UserService_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySetting",
+			Handler:    _UserService_UpdatePrivacySetting_Handler,
+		},
+		{
+			MethodName: "GetGroupAddDenied",
+			Handler:    _UserService_GetGroupAddDenied_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
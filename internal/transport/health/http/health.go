@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/health"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	backendCheckTimeout = 2 * time.Second
+	// backendUnavailable - статус бэкенда, до которого не удалось достучаться
+	backendUnavailable = "UNAVAILABLE"
+)
+
+type HealthHandler struct {
+	backends     map[string]healthpb.HealthClient
+	shuttingDown atomic.Bool
+}
+
+// NewHealthHandler создаёт обработчик проб. backends - клиенты grpc.health.v1 сервисов, от которых зависит шлюз
+func NewHealthHandler(backends map[string]healthpb.HealthClient) *HealthHandler {
+	return &HealthHandler{
+		backends: backends,
+	}
+}
+
+// SetShuttingDown переводит шлюз в состояние "не готов", чтобы балансировщик перестал направлять на него трафик
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// Liveness отвечает 200, пока процесс способен обрабатывать запросы
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	const op = "HealthHandler.Liveness"
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dto.HealthDTO{Status: dto.StatusOK})
+}
+
+// Readiness отвечает 200, только если все бэкенды в статусе SERVING и шлюз не останавливается
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	const op = "HealthHandler.Readiness"
+	logger := domains.GetLogger(r.Context()).WithField("operation", op)
+
+	if h.shuttingDown.Load() {
+		utils.SendJSONResponse(r.Context(), op, w, http.StatusServiceUnavailable, dto.HealthDTO{Status: dto.StatusNotReady})
+		return
+	}
+
+	services := h.checkBackends(r.Context())
+
+	ready := true
+	for name, status := range services {
+		if status != healthpb.HealthCheckResponse_SERVING.String() {
+			logger.Warnf("backend %s is not ready: %s", name, status)
+			ready = false
+		}
+	}
+
+	if !ready {
+		utils.SendJSONResponse(r.Context(), op, w, http.StatusServiceUnavailable, dto.HealthDTO{Status: dto.StatusNotReady, Services: services})
+		return
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dto.HealthDTO{Status: dto.StatusReady, Services: services})
+}
+
+// checkBackends параллельно опрашивает бэкенды, чтобы время ответа пробы не росло с их числом
+func (h *HealthHandler) checkBackends(ctx context.Context) map[string]string {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		services = make(map[string]string, len(h.backends))
+	)
+
+	for name, client := range h.backends {
+		wg.Add(1)
+		go func(name string, client healthpb.HealthClient) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, backendCheckTimeout)
+			defer cancel()
+
+			status := backendUnavailable
+			resp, err := client.Check(checkCtx, &healthpb.HealthCheckRequest{})
+			if err == nil {
+				status = resp.GetStatus().String()
+			}
+
+			mu.Lock()
+			services[name] = status
+			mu.Unlock()
+		}(name, client)
+	}
+
+	wg.Wait()
+	return services
+}
@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakeHealthClient struct {
+	healthpb.HealthClient
+	status healthpb.HealthCheckResponse_ServingStatus
+	err    error
+}
+
+func (c *fakeHealthClient) Check(ctx context.Context, in *healthpb.HealthCheckRequest, opts ...grpc.CallOption) (*healthpb.HealthCheckResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &healthpb.HealthCheckResponse{Status: c.status}, nil
+}
+
+func serving() healthpb.HealthClient {
+	return &fakeHealthClient{status: healthpb.HealthCheckResponse_SERVING}
+}
+
+func TestLiveness(t *testing.T) {
+	handler := NewHealthHandler(nil)
+
+	w := httptest.NewRecorder()
+	handler.Liveness(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
+
+func TestReadiness(t *testing.T) {
+	tests := []struct {
+		name             string
+		backends         map[string]healthpb.HealthClient
+		shuttingDown     bool
+		expectedCode     int
+		expectedStatus   string
+		expectedServices map[string]string
+	}{
+		{
+			name:             "All backends serving",
+			backends:         map[string]healthpb.HealthClient{"auth": serving(), "chats": serving()},
+			expectedCode:     http.StatusOK,
+			expectedStatus:   dto.StatusReady,
+			expectedServices: map[string]string{"auth": "SERVING", "chats": "SERVING"},
+		},
+		{
+			name: "Backend not serving",
+			backends: map[string]healthpb.HealthClient{
+				"auth":  serving(),
+				"chats": &fakeHealthClient{status: healthpb.HealthCheckResponse_NOT_SERVING},
+			},
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedStatus:   dto.StatusNotReady,
+			expectedServices: map[string]string{"auth": "SERVING", "chats": "NOT_SERVING"},
+		},
+		{
+			name: "Backend unreachable",
+			backends: map[string]healthpb.HealthClient{
+				"user": &fakeHealthClient{err: errors.New("connection refused")},
+			},
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedStatus:   dto.StatusNotReady,
+			expectedServices: map[string]string{"user": backendUnavailable},
+		},
+		{
+			name:           "Gateway shutting down",
+			backends:       map[string]healthpb.HealthClient{"auth": serving()},
+			shuttingDown:   true,
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: dto.StatusNotReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHealthHandler(tt.backends)
+			if tt.shuttingDown {
+				handler.SetShuttingDown()
+			}
+
+			w := httptest.NewRecorder()
+			handler.Readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			var body dto.HealthDTO
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedStatus, body.Status)
+			assert.Equal(t, tt.expectedServices, body.Services)
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type ChatsUsecase interface {
-	GetChats(ctx context.Context, userId uuid.UUID) ([]dtoChats.ChatViewInformationDTO, error)
+	GetChats(ctx context.Context, userId uuid.UUID, filter dtoChats.ChatsFilterDTO) ([]dtoChats.ChatViewInformationDTO, error)
 	CreateChat(ctx context.Context, chatDTO dtoChats.ChatCreateInformationDTO) (uuid.UUID, error)
 	GetInformationAboutChat(ctx context.Context, userId, chatId uuid.UUID, offset, limit int) (*dtoChats.ChatDetailedInformationDTO, error)
 	GetUsersDialog(ctx context.Context, user1ID, user2ID uuid.UUID) (*dtoUtils.IdDTO, error)
@@ -19,5 +19,19 @@ type ChatsUsecase interface {
 	UpdateChat(ctx context.Context, userId, chatId uuid.UUID, name, description string) error
 	GetChatAvatars(ctx context.Context, userId uuid.UUID, chatIDs []uuid.UUID) (map[string]*string, error)
 	UploadChatAvatar(ctx context.Context, userID, chatID uuid.UUID, fileData minio.FileData) (string, error)
+	GetChatAvatarHistory(ctx context.Context, userID, chatID uuid.UUID) ([]dtoUtils.AvatarDTO, error)
+	DeleteChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error
+	SetCurrentChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error
 	SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]dtoChats.ChatViewInformationDTO, error)
+	UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, update dtoChats.ChatSettingsUpdateDTO) (*dtoChats.ChatSettingsDTO, error)
+	GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	SetChatUsername(ctx context.Context, userID, chatID uuid.UUID, username string) error
+	ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*dtoChats.PublicChatDTO, bool, error)
+	CreateInvite(ctx context.Context, userID, chatID uuid.UUID, req dtoChats.InviteCreateDTO) (*dtoChats.InviteDTO, error)
+	GetChatInvites(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.InviteDTO, error)
+	RevokeInvite(ctx context.Context, userID, chatID, inviteID uuid.UUID) error
+	JoinChatByInvite(ctx context.Context, userID uuid.UUID, code string) (*dtoChats.JoinByInviteDTO, error)
+	GetJoinRequests(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.JoinRequestDTO, error)
+	ApproveJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) (*dtoChats.AddChatMemberDTO, error)
+	DeclineJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) error
 }
@@ -18,4 +18,13 @@ type MessageUsecase interface {
 	GetChatMessages(ctx context.Context, userID uuid.UUID, chatID uuid.UUID, offset, limit int) ([]dtoMessage.MessageDTO, error)
 	AddMessageJoinUsers(ctx context.Context, chatID uuid.UUID, users []dtoChats.AddChatMemberDTO) error
 	UploadAttachment(ctx context.Context, userID, chatID uuid.UUID, contentType string, fileData []byte, filename string, duration *int) (*dtoMessage.AttachmentDTO, error)
+	NotifyUser(ctx context.Context, userID uuid.UUID, notification dtoMessage.SystemNotificationDTO) error
+	NotifyChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings dtoChats.ChatSettingsDTO) error
+	NotifyUserStatus(ctx context.Context, userStatus dtoMessage.UserStatusDTO) error
+	GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]dtoMessage.MessageDTO, error)
+	ReadMentions(ctx context.Context, userID, chatID uuid.UUID) error
+	// UpdateLastSeen запоминает, что пользователь только что закрыл поток событий
+	UpdateLastSeen(ctx context.Context, userID uuid.UUID)
+	// ShuttingDown закрывается при остановке сервиса, после чего потоки событий должны завершиться
+	ShuttingDown() <-chan struct{}
 }
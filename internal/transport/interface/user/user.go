@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
 	"github.com/google/uuid"
 )
@@ -11,7 +12,13 @@ type UserUsecase interface {
 	GetUserById(ctx context.Context, id uuid.UUID) (*UserDTO.User, error)
 	GetUserByPhone(ctx context.Context, phone string) (*UserDTO.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*UserDTO.User, error)
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*UserDTO.User, error)
 	UploadUserAvatar(ctx context.Context, userID uuid.UUID, data []byte, filename, contentType string) (string, error)
-	UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error
+	UpdateUserInfo(ctx context.Context, userID uuid.UUID, update UserModels.InfoUpdate) error
 	GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]*string, error)
 }
+
+// UserCacheInvalidator сбрасывает закэшированные профили пользователей
+type UserCacheInvalidator interface {
+	Invalidate(ctx context.Context, ids ...uuid.UUID) error
+}
@@ -3,19 +3,38 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	tokenModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	userModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
 	cookieUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/cookie"
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/gorilla/mux"
 )
 
+// botRoutes — маршруты, доступные токенам бот-аккаунтов: отправка сообщений
+// и чтение чатов, участником которых бот является (членство проверяет сервис чатов)
+var botRoutes = map[string]struct{}{
+	http.MethodGet + " /api/v1/chats":                    {},
+	http.MethodGet + " /api/v1/chats/{chat_id}":          {},
+	http.MethodGet + " /api/v1/chats/{chat_id}/messages": {},
+	http.MethodPost + " /api/v1/bot/messages":            {},
+}
+
 func AuthGRPCMiddleware(sessionConf *config.SessionConfig, authClient gen.AuthServiceClient) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			const op = "AuthGRPCMiddleware"
 
+			if bearer, ok := bearerToken(r); ok {
+				authenticateToken(w, r, next, authClient, bearer)
+				return
+			}
+
 			cookie, err := r.Cookie(sessionConf.Signature)
 			if err != nil {
 				cookieUtils.Unset(w, sessionConf.Signature)
@@ -41,3 +60,58 @@ func AuthGRPCMiddleware(sessionConf *config.SessionConfig, authClient gen.AuthSe
 		})
 	}
 }
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if len(header) < len("Bearer ") || !strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return "", false
+	}
+	return strings.TrimSpace(header[len("Bearer "):]), true
+}
+
+// authenticateToken авторизует запрос персональным токеном и проверяет его scope
+func authenticateToken(w http.ResponseWriter, r *http.Request, next http.Handler, authClient gen.AuthServiceClient, token string) {
+	const op = "AuthGRPCMiddleware.Token"
+
+	res, err := authClient.ValidateToken(r.Context(), &gen.ValidateTokenReq{Token: token})
+	if err != nil || !res.Valid {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, "Invalid or expired token")
+		return
+	}
+
+	if !slices.Contains(res.Scopes, requiredScope(r.Method)) {
+		utils.SendError(r.Context(), op, w, http.StatusForbidden, "Token scope is insufficient")
+		return
+	}
+
+	if res.AccountType == userModels.BotAccount && !botRouteAllowed(r) {
+		utils.SendError(r.Context(), op, w, http.StatusForbidden, "Endpoint is not available for bots")
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), domains.UserIDKey{}, res.UserId)
+	ctx = context.WithValue(ctx, domains.TokenScopesKey{}, res.Scopes)
+	ctx = context.WithValue(ctx, domains.AccountTypeKey{}, res.AccountType)
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func requiredScope(method string) string {
+	if method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+		return tokenModels.ScopeRead
+	}
+	return tokenModels.ScopeWrite
+}
+
+func botRouteAllowed(r *http.Request) bool {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return false
+	}
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return false
+	}
+	_, ok := botRoutes[r.Method+" "+template]
+	return ok
+}
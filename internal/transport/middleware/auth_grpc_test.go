@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	tokenModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	userModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/auth"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type tokenAuthClient struct {
+	gen.AuthServiceClient
+	res *gen.ValidateTokenRes
+}
+
+func (c *tokenAuthClient) ValidateToken(context.Context, *gen.ValidateTokenReq, ...grpc.CallOption) (*gen.ValidateTokenRes, error) {
+	return c.res, nil
+}
+
+func newTokenRouter(accountType string) *mux.Router {
+	client := &tokenAuthClient{res: &gen.ValidateTokenRes{
+		Valid:       true,
+		UserId:      "user-id",
+		Scopes:      tokenModels.KnownScopes,
+		AccountType: accountType,
+	}}
+
+	ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	router := mux.NewRouter()
+	protected := router.PathPrefix("/api/v1").Subrouter()
+	protected.Use(AuthGRPCMiddleware(&config.SessionConfig{Signature: "session_id"}, client))
+
+	chats := protected.PathPrefix("/chats").Subrouter()
+	chats.HandleFunc("", ok).Methods(http.MethodGet)
+	chats.HandleFunc("", ok).Methods(http.MethodPost)
+	chats.HandleFunc("/{chat_id}", ok).Methods(http.MethodGet)
+	chats.HandleFunc("/{chat_id}", ok).Methods(http.MethodDelete)
+	protected.HandleFunc("/chats/{chat_id}/messages", ok).Methods(http.MethodGet)
+	protected.HandleFunc("/bot/messages", ok).Methods(http.MethodPost)
+	protected.HandleFunc("/tokens", ok).Methods(http.MethodPost)
+	protected.HandleFunc("/me", ok).Methods(http.MethodGet)
+
+	return router
+}
+
+func TestAuthGRPCMiddleware_BotRoutes(t *testing.T) {
+	tests := []struct {
+		name        string
+		accountType string
+		method      string
+		path        string
+		wantStatus  int
+	}{
+		{name: "Bot sends message", accountType: userModels.BotAccount, method: http.MethodPost, path: "/api/v1/bot/messages", wantStatus: http.StatusOK},
+		{name: "Bot lists chats", accountType: userModels.BotAccount, method: http.MethodGet, path: "/api/v1/chats", wantStatus: http.StatusOK},
+		{name: "Bot reads chat", accountType: userModels.BotAccount, method: http.MethodGet, path: "/api/v1/chats/1", wantStatus: http.StatusOK},
+		{name: "Bot reads messages", accountType: userModels.BotAccount, method: http.MethodGet, path: "/api/v1/chats/1/messages", wantStatus: http.StatusOK},
+		{name: "Bot creates chat", accountType: userModels.BotAccount, method: http.MethodPost, path: "/api/v1/chats", wantStatus: http.StatusForbidden},
+		{name: "Bot deletes chat", accountType: userModels.BotAccount, method: http.MethodDelete, path: "/api/v1/chats/1", wantStatus: http.StatusForbidden},
+		{name: "Bot creates token", accountType: userModels.BotAccount, method: http.MethodPost, path: "/api/v1/tokens", wantStatus: http.StatusForbidden},
+		{name: "Bot reads profile", accountType: userModels.BotAccount, method: http.MethodGet, path: "/api/v1/me", wantStatus: http.StatusForbidden},
+		{name: "User creates token", accountType: userModels.UserAccount, method: http.MethodPost, path: "/api/v1/tokens", wantStatus: http.StatusOK},
+		{name: "User reads profile", accountType: userModels.UserAccount, method: http.MethodGet, path: "/api/v1/me", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer token")
+			rec := httptest.NewRecorder()
+
+			newTokenRouter(tt.accountType).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/csrf"
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
 	"github.com/google/uuid"
@@ -21,6 +22,12 @@ func CSRFMiddleware(sessionConf *config.SessionConfig, csrfConfig *config.CSRFCo
 				return
 			}
 
+			// Запросы с персональным токеном не используют cookie, CSRF для них не нужен
+			if r.Context().Value(domains.TokenScopesKey{}) != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			csrfToken := r.Header.Get("X-CSRF-Token")
 			if csrfToken == "" {
 				utils.SendError(r.Context(), op, w, http.StatusForbidden, "CSRF token required")
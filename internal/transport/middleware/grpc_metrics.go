@@ -35,6 +35,36 @@ var (
 		},
 		[]string{"method", "status"},
 	)
+
+	grpcActiveStreams = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grpc_active_streams",
+			Help: "Number of currently open gRPC streams",
+		},
+		[]string{"method"},
+	)
+
+	grpcStreamDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_stream_duration_seconds",
+			Help:    "gRPC stream lifetime in seconds",
+			Buckets: []float64{1, 10, 60, 300, 900, 1800, 3600, 4 * 3600, 12 * 3600},
+		},
+		[]string{"method", "status"},
+	)
+
+	grpcStreamMessagesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_stream_messages_total",
+			Help: "Total number of messages sent and received over gRPC streams",
+		},
+		[]string{"method", "direction"},
+	)
+)
+
+const (
+	streamDirectionSent     = "sent"
+	streamDirectionReceived = "received"
 )
 
 func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -64,3 +94,60 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// StreamServerInterceptor считает открытые стримы, сообщения в них и итог стрима.
+// Время жизни стрима пишется в отдельную гистограмму, чтобы не портить квантили unary-вызовов
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		method := info.FullMethod
+		start := time.Now()
+
+		grpcActiveStreams.WithLabelValues(method).Inc()
+		defer grpcActiveStreams.WithLabelValues(method).Dec()
+
+		err := handler(srv, &metricsServerStream{
+			ServerStream: ss,
+			sent:         grpcStreamMessagesTotal.WithLabelValues(method, streamDirectionSent),
+			received:     grpcStreamMessagesTotal.WithLabelValues(method, streamDirectionReceived),
+		})
+
+		st, _ := status.FromError(err)
+		statusCode := st.Code().String()
+
+		grpcRequestsTotal.WithLabelValues(method, statusCode).Inc()
+		grpcStreamDuration.WithLabelValues(method, statusCode).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			grpcErrorsTotal.WithLabelValues(method, statusCode).Inc()
+		}
+
+		return err
+	}
+}
+
+type metricsServerStream struct {
+	grpc.ServerStream
+	sent     prometheus.Counter
+	received prometheus.Counter
+}
+
+func (s *metricsServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Inc()
+	}
+	return err
+}
+
+func (s *metricsServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Inc()
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type countingServerStream struct {
+	grpc.ServerStream
+	recvErr error
+}
+
+func (s *countingServerStream) Context() context.Context    { return context.Background() }
+func (s *countingServerStream) SendMsg(m interface{}) error { return nil }
+func (s *countingServerStream) RecvMsg(m interface{}) error { return s.recvErr }
+
+func TestStreamServerInterceptor(t *testing.T) {
+	const method = "/test.Service/Stream"
+	info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+
+	err := StreamServerInterceptor()(nil, &countingServerStream{}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		assert.Equal(t, 1.0, testutil.ToFloat64(grpcActiveStreams.WithLabelValues(method)))
+
+		_ = ss.RecvMsg(nil)
+		for i := 0; i < 3; i++ {
+			_ = ss.SendMsg(nil)
+		}
+		return status.Error(codes.Canceled, "client gone")
+	})
+
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, 0.0, testutil.ToFloat64(grpcActiveStreams.WithLabelValues(method)))
+	assert.Equal(t, 3.0, testutil.ToFloat64(grpcStreamMessagesTotal.WithLabelValues(method, streamDirectionSent)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(grpcStreamMessagesTotal.WithLabelValues(method, streamDirectionReceived)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(grpcRequestsTotal.WithLabelValues(method, codes.Canceled.String())))
+	assert.Equal(t, 1.0, testutil.ToFloat64(grpcErrorsTotal.WithLabelValues(method, codes.Canceled.String())))
+}
+
+func TestStreamServerInterceptor_FailedRecvNotCounted(t *testing.T) {
+	const method = "/test.Service/FailedRecv"
+	info := &grpc.StreamServerInfo{FullMethod: method}
+
+	err := StreamServerInterceptor()(nil, &countingServerStream{recvErr: errors.New("eof")}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(nil)
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 0.0, testutil.ToFloat64(grpcStreamMessagesTotal.WithLabelValues(method, streamDirectionReceived)))
+}
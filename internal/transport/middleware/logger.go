@@ -2,8 +2,6 @@ package middleware
 
 import (
 	"context"
-	"fmt"
-	"math/rand"
 	"net/http"
 	"time"
 
@@ -13,19 +11,20 @@ import (
 
 func AccessLogMiddleware(logger *logrus.Logger, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestID := fmt.Sprintf("%016x", rand.Int())[:10]
-
-		middlewareLogger := logger.WithFields(logrus.Fields{
-			"mode":        "access_log",
-			"request_id":  requestID,
-			"method":      r.Method,
-			"remote_addr": r.RemoteAddr,
-			"path":        r.URL.Path,
-		})
+		requestID := requestIDFromHeader(r.Header.Get(RequestIDHeader))
+		w.Header().Set(RequestIDHeader, requestID)
+
+		middlewareLogger := domains.WithTrace(r.Context(), logger.WithFields(logrus.Fields{
+			"mode":                 "access_log",
+			domains.RequestIDField: requestID,
+			"method":               r.Method,
+			"remote_addr":          r.RemoteAddr,
+			"path":                 r.URL.Path,
+		}))
 
 		contextLogger := logrus.NewEntry(logger).WithFields(logrus.Fields{
-			"mode":       "application",
-			"request_id": requestID,
+			"mode":                 "application",
+			domains.RequestIDField: requestID,
 		})
 
 		ctx := context.WithValue(r.Context(), domains.ContextKeyLogger{}, contextLogger)
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDHeader - HTTP-заголовок, из которого берётся и в котором возвращается идентификатор запроса
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDMetadataKey - ключ метаданных gRPC для передачи идентификатора запроса между сервисами
+	RequestIDMetadataKey = "x-request-id"
+
+	maxRequestIDLength = 128
+)
+
+// requestIDFromHeader возвращает присланный клиентом идентификатор, если он допустим, иначе генерирует новый
+func requestIDFromHeader(value string) string {
+	if !isValidRequestID(value) {
+		return uuid.NewString()
+	}
+	return value
+}
+
+// isValidRequestID пропускает только печатные ASCII-символы без пробелов, чтобы идентификатор нельзя было использовать для подделки строк лога
+func isValidRequestID(value string) bool {
+	if value == "" || len(value) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range value {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// withOutgoingRequestID добавляет идентификатор запроса из логгера контекста в исходящие метаданные
+func withOutgoingRequestID(ctx context.Context) context.Context {
+	requestID := domains.GetRequestID(ctx)
+	if requestID == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID)
+}
+
+// withIncomingRequestID кладёт в контекст логгер с идентификатором запроса из входящих метаданных
+func withIncomingRequestID(ctx context.Context, logger *logrus.Logger) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+
+	contextLogger := logrus.NewEntry(logger).WithFields(logrus.Fields{
+		"mode":                 "application",
+		domains.RequestIDField: requestIDFromHeader(requestID),
+	})
+
+	return context.WithValue(ctx, domains.ContextKeyLogger{}, contextLogger)
+}
+
+// RequestIDUnaryClientInterceptor передаёт идентификатор запроса в вызываемый сервис
+func RequestIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return invoker(withOutgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// RequestIDStreamClientInterceptor передаёт идентификатор запроса при открытии стрима
+func RequestIDStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withOutgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+// RequestIDUnaryServerInterceptor восстанавливает идентификатор запроса из метаданных
+// и кладёт в контекст логгер, который вернёт domains.GetLogger
+func RequestIDUnaryServerInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withIncomingRequestID(ctx, logger), req)
+	}
+}
+
+// RequestIDStreamServerInterceptor делает то же для стримов
+func RequestIDStreamServerInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &requestIDServerStream{
+			ServerStream: ss,
+			ctx:          withIncomingRequestID(ss.Context(), logger),
+		})
+	}
+}
+
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestAccessLogMiddleware_RequestID(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		expectSame bool
+	}{
+		{
+			name:       "Uses client request id",
+			header:     "client-req-1",
+			expectSame: true,
+		},
+		{
+			name:       "Generates request id when missing",
+			header:     "",
+			expectSame: false,
+		},
+		{
+			name:       "Replaces invalid request id",
+			header:     "bad id\nwith newline",
+			expectSame: false,
+		},
+		{
+			name:       "Replaces too long request id",
+			header:     strings.Repeat("a", maxRequestIDLength+1),
+			expectSame: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxRequestID string
+			handler := AccessLogMiddleware(newTestLogger(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxRequestID = domains.GetRequestID(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/chats", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.NotEmpty(t, ctxRequestID)
+			assert.Equal(t, ctxRequestID, w.Header().Get(RequestIDHeader))
+			if tt.expectSame {
+				assert.Equal(t, tt.header, ctxRequestID)
+			} else {
+				assert.NotEqual(t, tt.header, ctxRequestID)
+			}
+		})
+	}
+}
+
+func TestRequestIDUnaryClientInterceptor(t *testing.T) {
+	ctx := context.WithValue(context.Background(), domains.ContextKeyLogger{},
+		logrus.NewEntry(newTestLogger()).WithField(domains.RequestIDField, "req-1"))
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := RequestIDUnaryClientInterceptor()(ctx, "/svc/Method", nil, nil, nil, invoker)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req-1"}, outgoing.Get(RequestIDMetadataKey))
+}
+
+func TestRequestIDUnaryClientInterceptor_NoRequestID(t *testing.T) {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	err := RequestIDUnaryClientInterceptor()(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+
+	require.NoError(t, err)
+	assert.Empty(t, outgoing.Get(RequestIDMetadataKey))
+}
+
+func TestRequestIDUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		expected string
+	}{
+		{
+			name:     "Restores request id from metadata",
+			md:       metadata.Pairs(RequestIDMetadataKey, "req-1"),
+			expected: "req-1",
+		},
+		{
+			name:     "Generates request id without metadata",
+			md:       nil,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var requestID string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				requestID = domains.GetRequestID(ctx)
+				return nil, nil
+			}
+
+			_, err := RequestIDUnaryServerInterceptor(newTestLogger())(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			require.NoError(t, err)
+			require.NotEmpty(t, requestID)
+			if tt.expected != "" {
+				assert.Equal(t, tt.expected, requestID)
+			}
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestRequestIDStreamServerInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-stream"))
+
+	var requestID string
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		requestID = domains.GetRequestID(stream.Context())
+		return nil
+	}
+
+	err := RequestIDStreamServerInterceptor(newTestLogger())(nil, &testServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, handler)
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-stream", requestID)
+}
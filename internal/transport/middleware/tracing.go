@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const tracingOperation = "gateway.http"
+
+// TracingMiddleware создаёт серверный спан на каждый HTTP-запрос и извлекает контекст трейса из заголовков.
+// WebSocket-соединения не трассируются, так как живут дольше любого разумного спана.
+// Они определяются по заголовкам Upgrade, а не по пути, чтобы не терять спаны обычных маршрутов
+func TracingMiddleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, tracingOperation,
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !websocket.IsWebSocketUpgrade(r)
+		}),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + routeTemplate(r)
+		}),
+	)
+}
+
+// routeTemplate возвращает шаблон маршрута вместо конкретного пути, чтобы имена спанов не зависели от идентификаторов
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
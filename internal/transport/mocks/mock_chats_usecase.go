@@ -52,6 +52,21 @@ func (mr *MockChatsUsecaseMockRecorder) AddUsersToChat(ctx, chatID, userID, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUsersToChat", reflect.TypeOf((*MockChatsUsecase)(nil).AddUsersToChat), ctx, chatID, userID, users)
 }
 
+// ApproveJoinRequest mocks base method.
+func (m *MockChatsUsecase) ApproveJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) (*dto.AddChatMemberDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", ctx, adminID, chatID, userID)
+	ret0, _ := ret[0].(*dto.AddChatMemberDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockChatsUsecaseMockRecorder) ApproveJoinRequest(ctx, adminID, chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockChatsUsecase)(nil).ApproveJoinRequest), ctx, adminID, chatID, userID)
+}
+
 // CreateChat mocks base method.
 func (m *MockChatsUsecase) CreateChat(ctx context.Context, chatDTO dto.ChatCreateInformationDTO) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -67,6 +82,35 @@ func (mr *MockChatsUsecaseMockRecorder) CreateChat(ctx, chatDTO interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatsUsecase)(nil).CreateChat), ctx, chatDTO)
 }
 
+// CreateInvite mocks base method.
+func (m *MockChatsUsecase) CreateInvite(ctx context.Context, userID, chatID uuid.UUID, req dto.InviteCreateDTO) (*dto.InviteDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, userID, chatID, req)
+	ret0, _ := ret[0].(*dto.InviteDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockChatsUsecaseMockRecorder) CreateInvite(ctx, userID, chatID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockChatsUsecase)(nil).CreateInvite), ctx, userID, chatID, req)
+}
+
+// DeclineJoinRequest mocks base method.
+func (m *MockChatsUsecase) DeclineJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineJoinRequest", ctx, adminID, chatID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineJoinRequest indicates an expected call of DeclineJoinRequest.
+func (mr *MockChatsUsecaseMockRecorder) DeclineJoinRequest(ctx, adminID, chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineJoinRequest", reflect.TypeOf((*MockChatsUsecase)(nil).DeclineJoinRequest), ctx, adminID, chatID, userID)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatsUsecase) DeleteChat(ctx context.Context, userId, chatId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -81,6 +125,35 @@ func (mr *MockChatsUsecaseMockRecorder) DeleteChat(ctx, userId, chatId interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatsUsecase)(nil).DeleteChat), ctx, userId, chatId)
 }
 
+// DeleteChatAvatar mocks base method.
+func (m *MockChatsUsecase) DeleteChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatAvatar", ctx, userID, chatID, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatAvatar indicates an expected call of DeleteChatAvatar.
+func (mr *MockChatsUsecaseMockRecorder) DeleteChatAvatar(ctx, userID, chatID, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatAvatar", reflect.TypeOf((*MockChatsUsecase)(nil).DeleteChatAvatar), ctx, userID, chatID, attachmentID)
+}
+
+// GetChatAvatarHistory mocks base method.
+func (m *MockChatsUsecase) GetChatAvatarHistory(ctx context.Context, userID, chatID uuid.UUID) ([]dto0.AvatarDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAvatarHistory", ctx, userID, chatID)
+	ret0, _ := ret[0].([]dto0.AvatarDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAvatarHistory indicates an expected call of GetChatAvatarHistory.
+func (mr *MockChatsUsecaseMockRecorder) GetChatAvatarHistory(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatarHistory", reflect.TypeOf((*MockChatsUsecase)(nil).GetChatAvatarHistory), ctx, userID, chatID)
+}
+
 // GetChatAvatars mocks base method.
 func (m *MockChatsUsecase) GetChatAvatars(ctx context.Context, userId uuid.UUID, chatIDs []uuid.UUID) (map[string]*string, error) {
 	m.ctrl.T.Helper()
@@ -96,19 +169,49 @@ func (mr *MockChatsUsecaseMockRecorder) GetChatAvatars(ctx, userId, chatIDs inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatars", reflect.TypeOf((*MockChatsUsecase)(nil).GetChatAvatars), ctx, userId, chatIDs)
 }
 
+// GetChatInvites mocks base method.
+func (m *MockChatsUsecase) GetChatInvites(ctx context.Context, userID, chatID uuid.UUID) ([]dto.InviteDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvites", ctx, userID, chatID)
+	ret0, _ := ret[0].([]dto.InviteDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatsUsecaseMockRecorder) GetChatInvites(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatsUsecase)(nil).GetChatInvites), ctx, userID, chatID)
+}
+
 // GetChats mocks base method.
-func (m *MockChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID) ([]dto.ChatViewInformationDTO, error) {
+func (m *MockChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID, filter dto.ChatsFilterDTO) ([]dto.ChatViewInformationDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChats", ctx, userId)
+	ret := m.ctrl.Call(m, "GetChats", ctx, userId, filter)
 	ret0, _ := ret[0].([]dto.ChatViewInformationDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetChats indicates an expected call of GetChats.
-func (mr *MockChatsUsecaseMockRecorder) GetChats(ctx, userId interface{}) *gomock.Call {
+func (mr *MockChatsUsecaseMockRecorder) GetChats(ctx, userId, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockChatsUsecase)(nil).GetChats), ctx, userId, filter)
+}
+
+// GetGroupPeers mocks base method.
+func (m *MockChatsUsecase) GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupPeers", ctx, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupPeers indicates an expected call of GetGroupPeers.
+func (mr *MockChatsUsecaseMockRecorder) GetGroupPeers(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockChatsUsecase)(nil).GetChats), ctx, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPeers", reflect.TypeOf((*MockChatsUsecase)(nil).GetGroupPeers), ctx, userID)
 }
 
 // GetInformationAboutChat mocks base method.
@@ -126,6 +229,21 @@ func (mr *MockChatsUsecaseMockRecorder) GetInformationAboutChat(ctx, userId, cha
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInformationAboutChat", reflect.TypeOf((*MockChatsUsecase)(nil).GetInformationAboutChat), ctx, userId, chatId, offset, limit)
 }
 
+// GetJoinRequests mocks base method.
+func (m *MockChatsUsecase) GetJoinRequests(ctx context.Context, userID, chatID uuid.UUID) ([]dto.JoinRequestDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequests", ctx, userID, chatID)
+	ret0, _ := ret[0].([]dto.JoinRequestDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequests indicates an expected call of GetJoinRequests.
+func (mr *MockChatsUsecaseMockRecorder) GetJoinRequests(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequests", reflect.TypeOf((*MockChatsUsecase)(nil).GetJoinRequests), ctx, userID, chatID)
+}
+
 // GetUsersDialog mocks base method.
 func (m *MockChatsUsecase) GetUsersDialog(ctx context.Context, user1ID, user2ID uuid.UUID) (*dto0.IdDTO, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +259,51 @@ func (mr *MockChatsUsecaseMockRecorder) GetUsersDialog(ctx, user1ID, user2ID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersDialog", reflect.TypeOf((*MockChatsUsecase)(nil).GetUsersDialog), ctx, user1ID, user2ID)
 }
 
+// JoinChatByInvite mocks base method.
+func (m *MockChatsUsecase) JoinChatByInvite(ctx context.Context, userID uuid.UUID, code string) (*dto.JoinByInviteDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChatByInvite", ctx, userID, code)
+	ret0, _ := ret[0].(*dto.JoinByInviteDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChatByInvite indicates an expected call of JoinChatByInvite.
+func (mr *MockChatsUsecaseMockRecorder) JoinChatByInvite(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChatByInvite", reflect.TypeOf((*MockChatsUsecase)(nil).JoinChatByInvite), ctx, userID, code)
+}
+
+// ResolveChatUsername mocks base method.
+func (m *MockChatsUsecase) ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*dto.PublicChatDTO, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveChatUsername", ctx, userID, username)
+	ret0, _ := ret[0].(*dto.PublicChatDTO)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveChatUsername indicates an expected call of ResolveChatUsername.
+func (mr *MockChatsUsecaseMockRecorder) ResolveChatUsername(ctx, userID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveChatUsername", reflect.TypeOf((*MockChatsUsecase)(nil).ResolveChatUsername), ctx, userID, username)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockChatsUsecase) RevokeInvite(ctx context.Context, userID, chatID, inviteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvite", ctx, userID, chatID, inviteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockChatsUsecaseMockRecorder) RevokeInvite(ctx, userID, chatID, inviteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockChatsUsecase)(nil).RevokeInvite), ctx, userID, chatID, inviteID)
+}
+
 // SearchChats mocks base method.
 func (m *MockChatsUsecase) SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]dto.ChatViewInformationDTO, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +319,34 @@ func (mr *MockChatsUsecaseMockRecorder) SearchChats(ctx, userID, name interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChats", reflect.TypeOf((*MockChatsUsecase)(nil).SearchChats), ctx, userID, name)
 }
 
+// SetChatUsername mocks base method.
+func (m *MockChatsUsecase) SetChatUsername(ctx context.Context, userID, chatID uuid.UUID, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatUsername", ctx, userID, chatID, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatUsername indicates an expected call of SetChatUsername.
+func (mr *MockChatsUsecaseMockRecorder) SetChatUsername(ctx, userID, chatID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatUsername", reflect.TypeOf((*MockChatsUsecase)(nil).SetChatUsername), ctx, userID, chatID, username)
+}
+
+// SetCurrentChatAvatar mocks base method.
+func (m *MockChatsUsecase) SetCurrentChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCurrentChatAvatar", ctx, userID, chatID, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCurrentChatAvatar indicates an expected call of SetCurrentChatAvatar.
+func (mr *MockChatsUsecaseMockRecorder) SetCurrentChatAvatar(ctx, userID, chatID, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentChatAvatar", reflect.TypeOf((*MockChatsUsecase)(nil).SetCurrentChatAvatar), ctx, userID, chatID, attachmentID)
+}
+
 // UpdateChat mocks base method.
 func (m *MockChatsUsecase) UpdateChat(ctx context.Context, userId, chatId uuid.UUID, name, description string) error {
 	m.ctrl.T.Helper()
@@ -170,6 +361,21 @@ func (mr *MockChatsUsecaseMockRecorder) UpdateChat(ctx, userId, chatId, name, de
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChat", reflect.TypeOf((*MockChatsUsecase)(nil).UpdateChat), ctx, userId, chatId, name, description)
 }
 
+// UpdateChatSettings mocks base method.
+func (m *MockChatsUsecase) UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, update dto.ChatSettingsUpdateDTO) (*dto.ChatSettingsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChatSettings", ctx, userID, chatID, update)
+	ret0, _ := ret[0].(*dto.ChatSettingsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChatSettings indicates an expected call of UpdateChatSettings.
+func (mr *MockChatsUsecaseMockRecorder) UpdateChatSettings(ctx, userID, chatID, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatSettings", reflect.TypeOf((*MockChatsUsecase)(nil).UpdateChatSettings), ctx, userID, chatID, update)
+}
+
 // UploadChatAvatar mocks base method.
 func (m *MockChatsUsecase) UploadChatAvatar(ctx context.Context, userID, chatID uuid.UUID, fileData minio.FileData) (string, error) {
 	m.ctrl.T.Helper()
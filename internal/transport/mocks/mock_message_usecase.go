@@ -123,6 +123,91 @@ func (mr *MockMessageUsecaseMockRecorder) GetMessagesBySearch(ctx, userID, chatI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesBySearch", reflect.TypeOf((*MockMessageUsecase)(nil).GetMessagesBySearch), ctx, userID, chatID, text)
 }
 
+// GetUnreadMentions mocks base method.
+func (m *MockMessageUsecase) GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]dto0.MessageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadMentions", ctx, userID, chatID)
+	ret0, _ := ret[0].([]dto0.MessageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadMentions indicates an expected call of GetUnreadMentions.
+func (mr *MockMessageUsecaseMockRecorder) GetUnreadMentions(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadMentions", reflect.TypeOf((*MockMessageUsecase)(nil).GetUnreadMentions), ctx, userID, chatID)
+}
+
+// NotifyChatSettings mocks base method.
+func (m *MockMessageUsecase) NotifyChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings dto.ChatSettingsDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyChatSettings", ctx, userID, chatID, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyChatSettings indicates an expected call of NotifyChatSettings.
+func (mr *MockMessageUsecaseMockRecorder) NotifyChatSettings(ctx, userID, chatID, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyChatSettings", reflect.TypeOf((*MockMessageUsecase)(nil).NotifyChatSettings), ctx, userID, chatID, settings)
+}
+
+// NotifyUser mocks base method.
+func (m *MockMessageUsecase) NotifyUser(ctx context.Context, userID uuid.UUID, notification dto0.SystemNotificationDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyUser", ctx, userID, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyUser indicates an expected call of NotifyUser.
+func (mr *MockMessageUsecaseMockRecorder) NotifyUser(ctx, userID, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUser", reflect.TypeOf((*MockMessageUsecase)(nil).NotifyUser), ctx, userID, notification)
+}
+
+// NotifyUserStatus mocks base method.
+func (m *MockMessageUsecase) NotifyUserStatus(ctx context.Context, userStatus dto0.UserStatusDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyUserStatus", ctx, userStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyUserStatus indicates an expected call of NotifyUserStatus.
+func (mr *MockMessageUsecaseMockRecorder) NotifyUserStatus(ctx, userStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUserStatus", reflect.TypeOf((*MockMessageUsecase)(nil).NotifyUserStatus), ctx, userStatus)
+}
+
+// ReadMentions mocks base method.
+func (m *MockMessageUsecase) ReadMentions(ctx context.Context, userID, chatID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMentions", ctx, userID, chatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadMentions indicates an expected call of ReadMentions.
+func (mr *MockMessageUsecaseMockRecorder) ReadMentions(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMentions", reflect.TypeOf((*MockMessageUsecase)(nil).ReadMentions), ctx, userID, chatID)
+}
+
+// ShuttingDown mocks base method.
+func (m *MockMessageUsecase) ShuttingDown() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShuttingDown")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// ShuttingDown indicates an expected call of ShuttingDown.
+func (mr *MockMessageUsecaseMockRecorder) ShuttingDown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShuttingDown", reflect.TypeOf((*MockMessageUsecase)(nil).ShuttingDown))
+}
+
 // SubscribeConnectionToChats mocks base method.
 func (m *MockMessageUsecase) SubscribeConnectionToChats(ctx context.Context, connectionID, userID uuid.UUID, chatsDTO []dto.ChatViewInformationDTO) <-chan dto0.WebSocketMessageDTO {
 	m.ctrl.T.Helper()
@@ -151,6 +236,18 @@ func (mr *MockMessageUsecaseMockRecorder) SubscribeUsersOnChat(ctx, chatID, memb
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeUsersOnChat", reflect.TypeOf((*MockMessageUsecase)(nil).SubscribeUsersOnChat), ctx, chatID, members)
 }
 
+// UpdateLastSeen mocks base method.
+func (m *MockMessageUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateLastSeen", ctx, userID)
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockMessageUsecaseMockRecorder) UpdateLastSeen(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockMessageUsecase)(nil).UpdateLastSeen), ctx, userID)
+}
+
 // UploadAttachment mocks base method.
 func (m *MockMessageUsecase) UploadAttachment(ctx context.Context, userID, chatID uuid.UUID, contentType string, fileData []byte, filename string, duration *int) (*dto0.AttachmentDTO, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +96,21 @@ func (mr *MockUserUsecaseMockRecorder) GetUserByUsername(ctx, username interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserUsecase)(nil).GetUserByUsername), ctx, username)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *MockUserUsecase) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserUsecaseMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserUsecase)(nil).GetUsersByIDs), ctx, ids)
+}
+
 // UpdateUserInfo mocks base method.
 func (m *MockUserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name, username, bio *string) error {
 	m.ctrl.T.Helper()
@@ -124,3 +139,45 @@ func (mr *MockUserUsecaseMockRecorder) UploadUserAvatar(ctx, userID, data, filen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUserAvatar", reflect.TypeOf((*MockUserUsecase)(nil).UploadUserAvatar), ctx, userID, data, filename, contentType)
 }
+
+// MockUserCacheInvalidator is a mock of UserCacheInvalidator interface.
+type MockUserCacheInvalidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserCacheInvalidatorMockRecorder
+}
+
+// MockUserCacheInvalidatorMockRecorder is the mock recorder for MockUserCacheInvalidator.
+type MockUserCacheInvalidatorMockRecorder struct {
+	mock *MockUserCacheInvalidator
+}
+
+// NewMockUserCacheInvalidator creates a new mock instance.
+func NewMockUserCacheInvalidator(ctrl *gomock.Controller) *MockUserCacheInvalidator {
+	mock := &MockUserCacheInvalidator{ctrl: ctrl}
+	mock.recorder = &MockUserCacheInvalidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserCacheInvalidator) EXPECT() *MockUserCacheInvalidatorMockRecorder {
+	return m.recorder
+}
+
+// Invalidate mocks base method.
+func (m *MockUserCacheInvalidator) Invalidate(ctx context.Context, ids ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Invalidate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockUserCacheInvalidatorMockRecorder) Invalidate(ctx interface{}, ids ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockUserCacheInvalidator)(nil).Invalidate), varargs...)
+}
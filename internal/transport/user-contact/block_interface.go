@@ -0,0 +1,16 @@
+package user
+
+import (
+	"context"
+
+	BlockDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=block_interface.go -destination=../../usecase/mocks/mock_block_usecase.go -package=mocks IBlockUsecase
+type IBlockUsecase interface {
+	BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error
+	UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error
+	GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*BlockDTO.BlockedUserDTO, error)
+	GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error)
+}
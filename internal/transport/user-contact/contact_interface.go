@@ -13,4 +13,10 @@ type IContactUsecase interface {
 	GetContacts(ctx context.Context, userID uuid.UUID) ([]*ContactDTO.GetContactsDTO, error)
 	SearchContacts(ctx context.Context, userID uuid.UUID, query string) ([]*ContactDTO.GetContactsDTO, error)
 	ReindexAllContacts(ctx context.Context) error
+	DeleteContact(ctx context.Context, userID, contactUserID uuid.UUID) error
+	UpdateContactAlias(ctx context.Context, userID, contactUserID uuid.UUID, alias *string) error
+	GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	ImportContacts(ctx context.Context, userID uuid.UUID, req *ContactDTO.ImportContactsDTO) (*ContactDTO.ImportContactsResultDTO, error)
+	HandleUserRegistered(ctx context.Context, userID uuid.UUID) error
+	HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error
 }
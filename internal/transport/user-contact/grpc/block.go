@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserGRPCHandler) BlockUser(ctx context.Context, req *gen.BlockUserReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.BlockUser"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, blockedUserID, err := parseBlockPair(req.UserId, req.BlockedUserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, err
+	}
+
+	if userID == blockedUserID {
+		return nil, status.Error(codes.InvalidArgument, "cannot block yourself")
+	}
+
+	if err := h.blockUC.BlockUser(ctx, userID, blockedUserID); err != nil {
+		logger.WithError(err).Error("failed to block user")
+
+		switch {
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, errs.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, "cannot block yourself")
+		default:
+			return nil, status.Error(codes.Internal, "failed to block user")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) UnblockUser(ctx context.Context, req *gen.UnblockUserReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UnblockUser"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, blockedUserID, err := parseBlockPair(req.UserId, req.BlockedUserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, err
+	}
+
+	if err := h.blockUC.UnblockUser(ctx, userID, blockedUserID); err != nil {
+		logger.WithError(err).Error("failed to unblock user")
+
+		if errors.Is(err, errs.ErrBlockNotFound) {
+			return nil, status.Error(codes.NotFound, "user is not blocked")
+		}
+		return nil, status.Error(codes.Internal, "failed to unblock user")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) GetBlockedUsers(ctx context.Context, req *gen.GetBlockedUsersReq) (*gen.GetBlockedUsersRes, error) {
+	const op = "UserGRPCHandler.GetBlockedUsers"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	blocked, err := h.blockUC.GetBlockedUsers(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get blocked users")
+		return nil, status.Error(codes.Internal, "failed to get blocked users")
+	}
+
+	res := &gen.GetBlockedUsersRes{Users: make([]*gen.BlockedUser, 0, len(blocked))}
+	for _, b := range blocked {
+		bio := ""
+		if b.User.Bio != nil {
+			bio = *b.User.Bio
+		}
+
+		res.Users = append(res.Users, &gen.BlockedUser{
+			User: &gen.User{
+				Id:          b.User.ID.String(),
+				Name:        b.User.Name,
+				Username:    b.User.Username,
+				Bio:         bio,
+				AccountType: b.User.AccountType,
+				CreatedAt:   b.User.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:   b.User.UpdatedAt.Format(time.RFC3339),
+			},
+			BlockedAt: b.BlockedAt.Format(time.RFC3339),
+		})
+	}
+
+	return res, nil
+}
+
+func (h *UserGRPCHandler) GetBlockedPeers(ctx context.Context, req *gen.GetBlockedPeersReq) (*gen.GetBlockedPeersRes, error) {
+	const op = "UserGRPCHandler.GetBlockedPeers"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	peerIDs := make([]uuid.UUID, 0, len(req.GetPeerIds()))
+	for _, idStr := range req.GetPeerIds() {
+		peerID, err := uuid.Parse(idStr)
+		if err != nil {
+			logger.WithError(err).Errorf("error parsing peerId: %s", idStr)
+			return nil, status.Error(codes.InvalidArgument, "wrong peer id format")
+		}
+		peerIDs = append(peerIDs, peerID)
+	}
+
+	if len(peerIDs) == 0 {
+		return &gen.GetBlockedPeersRes{}, nil
+	}
+
+	blocked, err := h.blockUC.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get blocked peers")
+		return nil, status.Error(codes.Internal, "failed to get blocked peers")
+	}
+
+	res := &gen.GetBlockedPeersRes{PeerIds: make([]string, 0, len(blocked))}
+	for _, id := range blocked {
+		res.PeerIds = append(res.PeerIds, id.String())
+	}
+
+	return res, nil
+}
+
+// isHiddenByBlock сообщает, нужно ли скрыть пользователя от того, кто делает запрос от имени пользователя.
+// Для межсервисных вызовов без подписи пользователя ничего не скрывается
+func (h *UserGRPCHandler) isHiddenByBlock(ctx context.Context, targetID uuid.UUID) (bool, error) {
+	viewerID, ok := identity.UserIDFromContext(ctx)
+	if !ok || viewerID == targetID {
+		return false, nil
+	}
+
+	blocked, err := h.blockUC.GetBlockedPeers(ctx, viewerID, []uuid.UUID{targetID})
+	if err != nil {
+		return false, err
+	}
+
+	return len(blocked) > 0, nil
+}
+
+func parseBlockPair(userIDStr, blockedUserIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	blockedUserID, err := uuid.Parse(blockedUserIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid blocked user ID")
+	}
+
+	return userID, blockedUserID, nil
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoBlock "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	dtoUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// viewerContext возвращает контекст, в котором серверный интерцептор проверил подпись viewerID
+func viewerContext(t *testing.T, viewerID uuid.UUID) context.Context {
+	signer := identity.NewSigner(&config.IdentityConfig{Secret: "secret", TTL: time.Minute})
+	md := metadata.Pairs(identity.MetadataKey, signer.Sign(viewerID))
+	incoming := metadata.NewIncomingContext(setupContext(), md)
+
+	var handlerCtx context.Context
+	_, err := identity.UnaryServerInterceptor(signer, true)(incoming, &gen.GetUserByUsernameReq{},
+		&grpc.UnaryServerInfo{FullMethod: gen.UserService_GetUserByUsername_FullMethodName},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			handlerCtx = ctx
+			return nil, nil
+		})
+	assert.NoError(t, err)
+
+	return handlerCtx
+}
+
+func TestBlockUser_Success(t *testing.T) {
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, blockedID := uuid.New(), uuid.New()
+	mockBlockUC.On("BlockUser", ctx, userID, blockedID).Return(nil)
+
+	_, err := handler.BlockUser(ctx, &gen.BlockUserReq{UserId: userID.String(), BlockedUserId: blockedID.String()})
+
+	assert.NoError(t, err)
+	mockBlockUC.AssertExpectations(t)
+}
+
+func TestBlockUser_Self(t *testing.T) {
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	userID := uuid.NewString()
+
+	_, err := handler.BlockUser(setupContext(), &gen.BlockUserReq{UserId: userID, BlockedUserId: userID})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestBlockUser_UserNotFound(t *testing.T) {
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, blockedID := uuid.New(), uuid.New()
+	mockBlockUC.On("BlockUser", ctx, userID, blockedID).Return(fmt.Errorf("wrapped: %w", errs.ErrUserNotFound))
+
+	_, err := handler.BlockUser(ctx, &gen.BlockUserReq{UserId: userID.String(), BlockedUserId: blockedID.String()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUnblockUser_NotBlocked(t *testing.T) {
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, blockedID := uuid.New(), uuid.New()
+	mockBlockUC.On("UnblockUser", ctx, userID, blockedID).Return(errs.ErrBlockNotFound)
+
+	_, err := handler.UnblockUser(ctx, &gen.UnblockUserReq{UserId: userID.String(), BlockedUserId: blockedID.String()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetBlockedUsers_Success(t *testing.T) {
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, blockedID := uuid.New(), uuid.New()
+	mockBlockUC.On("GetBlockedUsers", ctx, userID).Return([]*dtoBlock.BlockedUserDTO{
+		{User: &dtoUser.User{ID: blockedID, Username: "spammer"}, BlockedAt: time.Now()},
+	}, nil)
+
+	res, err := handler.GetBlockedUsers(ctx, &gen.GetBlockedUsersReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Users, 1)
+	assert.Equal(t, blockedID.String(), res.Users[0].User.Id)
+	// Номер телефона заблокированного пользователя не раскрывается
+	assert.Empty(t, res.Users[0].User.PhoneNumber)
+}
+
+func TestGetBlockedPeers_InvalidPeerID(t *testing.T) {
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	_, err := handler.GetBlockedPeers(setupContext(), &gen.GetBlockedPeersReq{UserId: uuid.NewString(), PeerIds: []string{"bad"}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetBlockedPeers_Success(t *testing.T) {
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, blockedID, freeID := uuid.New(), uuid.New(), uuid.New()
+	mockBlockUC.On("GetBlockedPeers", ctx, userID, []uuid.UUID{blockedID, freeID}).Return([]uuid.UUID{blockedID}, nil)
+
+	res, err := handler.GetBlockedPeers(ctx, &gen.GetBlockedPeersReq{
+		UserId:  userID.String(),
+		PeerIds: []string{blockedID.String(), freeID.String()},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{blockedID.String()}, res.PeerIds)
+}
+
+func TestGetUserByUsername_HiddenByBlock(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+
+	viewerID, targetID := uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+
+	mockUserUC.On("GetUserByUsername", ctx, "blocker").Return(&dtoUser.User{ID: targetID, Username: "blocker"}, nil)
+	mockBlockUC.On("GetBlockedPeers", ctx, viewerID, []uuid.UUID{targetID}).Return([]uuid.UUID{targetID}, nil)
+
+	res, err := handler.GetUserByUsername(ctx, &gen.GetUserByUsernameReq{Username: "blocker"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockBlockUC.AssertExpectations(t)
+}
+
+func TestGetUserByUsername_BlockCheckError(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockBlockUC := new(MockBlockUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), mockBlockUC, new(MockPrivacyUsecase))
+
+	viewerID, targetID := uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+
+	mockUserUC.On("GetUserByUsername", ctx, "someone").Return(&dtoUser.User{ID: targetID}, nil)
+	mockBlockUC.On("GetBlockedPeers", ctx, viewerID, mock.Anything).Return(nil, errors.New("db error"))
+
+	_, err := handler.GetUserByUsername(ctx, &gen.GetUserByUsernameReq{Username: "someone"})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -2,14 +2,20 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/grpcclient"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // UserServiceClient - gRPC клиент для взаимодействия с user_service
@@ -18,8 +24,10 @@ type UserServiceClient struct {
 	conn   *grpc.ClientConn
 }
 
-func NewUserServiceClient(addr string) (*UserServiceClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewUserServiceClient(addr string, conf *config.GRPCConfig, signer *identity.Signer) (*UserServiceClient, error) {
+	conn, err := grpcclient.New(addr, grpcclient.UserDownstream, conf,
+		grpc.WithChainUnaryInterceptor(identity.UnaryClientInterceptor(signer)),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -72,23 +80,55 @@ func (c *UserServiceClient) GetUserByID(ctx context.Context, id uuid.UUID) (*Use
 	return user, nil
 }
 
-func (c *UserServiceClient) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
-	const op = "UserServiceClient.GetUsersNames"
+// GetUsersByIDs получает пользователей одним запросом; ненайденных id в результате нет
+func (c *UserServiceClient) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*UserModels.User, error) {
+	const op = "UserServiceClient.GetUsersByIDs"
 	logger := domains.GetLogger(ctx).WithField("operation", op)
 
-	names := make([]string, 0, len(usersIds))
+	users := make(map[uuid.UUID]*UserModels.User, len(ids))
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	req := &gen.GetUsersByIDsReq{UserIds: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		req.UserIds = append(req.UserIds, id.String())
+	}
+
+	resp, err := c.client.GetUsersByIDs(ctx, req)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to get %d users", len(ids))
+		return nil, errs.ErrInternalServerError
+	}
 
-	for _, userID := range usersIds {
-		user, err := c.GetUserByID(ctx, userID)
+	for _, protoUser := range resp.Users {
+		userID, err := uuid.Parse(protoUser.Id)
 		if err != nil {
-			logger.WithError(err).Warningf("failed to get user name for id: %s", userID)
-			names = append(names, "Unknown")
-			continue
+			logger.WithError(err).Error("failed to parse user id")
+			return nil, errs.ErrInternalServerError
 		}
-		names = append(names, user.Name)
+
+		bio := protoUser.Bio
+		users[userID] = &UserModels.User{
+			ID:          userID,
+			Name:        protoUser.Name,
+			Username:    protoUser.Username,
+			PhoneNumber: protoUser.PhoneNumber,
+			Bio:         &bio,
+			AccountType: protoUser.AccountType,
+		}
+	}
+
+	return users, nil
+}
+
+func (c *UserServiceClient) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
+	users, err := c.GetUsersByIDs(ctx, usersIds)
+	if err != nil {
+		return nil, err
 	}
 
-	return names, nil
+	return UserModels.NamesByIDs(usersIds, users), nil
 }
 
 func (c *UserServiceClient) GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error) {
@@ -101,7 +141,47 @@ func (c *UserServiceClient) GetUserByPhone(ctx context.Context, phone string) (*
 
 	resp, err := c.client.GetUserByPhone(ctx, req)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errs.ErrNotFound
+		}
 		logger.WithError(err).Errorf("failed to get user by phone: %s", phone)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if resp.User == nil {
+		return nil, errs.ErrNotFound
+	}
+
+	userID, err := uuid.Parse(resp.User.Id)
+	if err != nil {
+		logger.WithError(err).Error("failed to parse user id")
+		return nil, errs.ErrInternalServerError
+	}
+
+	user := &UserModels.User{
+		ID:           userID,
+		Name:         resp.User.Name,
+		Username:     resp.User.Username,
+		PhoneNumber:  resp.User.PhoneNumber,
+		Bio:          &resp.User.Bio,
+		AccountType:  resp.User.AccountType,
+		PasswordHash: resp.User.PasswordHash,
+	}
+
+	return user, nil
+}
+
+func (c *UserServiceClient) GetUserByUsername(ctx context.Context, username string) (*UserModels.User, error) {
+	const op = "UserServiceClient.GetUserByUsername"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	req := &gen.GetUserByUsernameReq{
+		Username: username,
+	}
+
+	resp, err := c.client.GetUserByUsername(ctx, req)
+	if err != nil {
+		logger.WithError(err).Debugf("failed to get user by username: %s", username)
 		return nil, errs.ErrNotFound
 	}
 
@@ -127,3 +207,141 @@ func (c *UserServiceClient) GetUserByPhone(ctx context.Context, phone string) (*
 
 	return user, nil
 }
+
+func (c *UserServiceClient) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "UserServiceClient.GetBlockedPeers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if len(peerIDs) == 0 {
+		return nil, nil
+	}
+
+	req := &gen.GetBlockedPeersReq{UserId: userID.String(), PeerIds: make([]string, 0, len(peerIDs))}
+	for _, id := range peerIDs {
+		req.PeerIds = append(req.PeerIds, id.String())
+	}
+
+	resp, err := c.client.GetBlockedPeers(ctx, req)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to get blocked peers of user %s", userID)
+		return nil, errs.ErrInternalServerError
+	}
+
+	blocked := make([]uuid.UUID, 0, len(resp.PeerIds))
+	for _, idStr := range resp.PeerIds {
+		peerID, err := uuid.Parse(idStr)
+		if err != nil {
+			logger.WithError(err).Error("failed to parse peer id")
+			return nil, errs.ErrInternalServerError
+		}
+		blocked = append(blocked, peerID)
+	}
+
+	return blocked, nil
+}
+
+func (c *UserServiceClient) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "UserServiceClient.GetGroupAddDenied"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if len(peerIDs) == 0 {
+		return nil, nil
+	}
+
+	req := &gen.GetGroupAddDeniedReq{UserId: userID.String(), PeerIds: make([]string, 0, len(peerIDs))}
+	for _, id := range peerIDs {
+		req.PeerIds = append(req.PeerIds, id.String())
+	}
+
+	resp, err := c.client.GetGroupAddDenied(ctx, req)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to check group add privacy for user %s", userID)
+		return nil, errs.ErrInternalServerError
+	}
+
+	denied := make([]uuid.UUID, 0, len(resp.PeerIds))
+	for _, idStr := range resp.PeerIds {
+		peerID, err := uuid.Parse(idStr)
+		if err != nil {
+			logger.WithError(err).Error("failed to parse peer id")
+			return nil, errs.ErrInternalServerError
+		}
+		denied = append(denied, peerID)
+	}
+
+	return denied, nil
+}
+
+func (c *UserServiceClient) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	const op = "UserServiceClient.GetContactAliases"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	aliases := make(map[uuid.UUID]string)
+	if len(contactUserIDs) == 0 {
+		return aliases, nil
+	}
+
+	req := &gen.GetContactAliasesReq{UserId: userID.String(), ContactUserIds: make([]string, 0, len(contactUserIDs))}
+	for _, id := range contactUserIDs {
+		req.ContactUserIds = append(req.ContactUserIds, id.String())
+	}
+
+	resp, err := c.client.GetContactAliases(ctx, req)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to get contact aliases for user %s", userID)
+		return nil, errs.ErrInternalServerError
+	}
+
+	for idStr, alias := range resp.Aliases {
+		contactUserID, err := uuid.Parse(idStr)
+		if err != nil {
+			logger.WithError(err).Error("failed to parse contact user id")
+			return nil, errs.ErrInternalServerError
+		}
+		aliases[contactUserID] = alias
+	}
+
+	return aliases, nil
+}
+
+func (c *UserServiceClient) NotifyUserRegistered(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserServiceClient.NotifyUserRegistered"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.UserRegistered(ctx, &gen.UserRegisteredReq{UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to report registration of user %s", userID)
+		return errs.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (c *UserServiceClient) NotifyPhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserServiceClient.NotifyPhoneChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.UserPhoneChanged(ctx, &gen.UserPhoneChangedReq{UserId: userID.String()})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to report phone change of user %s", userID)
+		return errs.ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (c *UserServiceClient) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	const op = "UserServiceClient.UpdateLastSeen"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	_, err := c.client.UpdateLastSeen(ctx, &gen.UpdateLastSeenReq{
+		UserId:     userID.String(),
+		LastSeenAt: at.Format(time.RFC3339),
+	})
+	if err != nil {
+		logger.WithError(err).Errorf("failed to update last seen of user %s", userID)
+		return errs.ErrInternalServerError
+	}
+
+	return nil
+}
@@ -87,6 +87,7 @@ func (h *UserGRPCHandler) GetContacts(ctx context.Context, req *gen.GetContactsR
 			Username:    c.ContactUser.Username,
 			Bio:         bio,
 			AccountType: c.ContactUser.AccountType,
+			Alias:       c.Alias,
 			CreatedAt:   c.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:   c.UpdatedAt.Format(time.RFC3339),
 		})
@@ -131,6 +132,7 @@ func (h *UserGRPCHandler) SearchContacts(ctx context.Context, req *gen.SearchCon
 			Username:    c.ContactUser.Username,
 			Bio:         bio,
 			AccountType: c.ContactUser.AccountType,
+			Alias:       c.Alias,
 			CreatedAt:   c.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:   c.UpdatedAt.Format(time.RFC3339),
 		})
@@ -140,3 +142,201 @@ func (h *UserGRPCHandler) SearchContacts(ctx context.Context, req *gen.SearchCon
 		Contacts: grpcContacts,
 	}, nil
 }
+
+func (h *UserGRPCHandler) DeleteContact(ctx context.Context, req *gen.DeleteContactReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.DeleteContact"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	contactUserID, err := uuid.Parse(req.ContactUserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid contact user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid contact user ID")
+	}
+
+	if err := h.contactUC.DeleteContact(ctx, userID, contactUserID); err != nil {
+		logger.WithError(err).Error("failed to delete contact")
+
+		if errors.Is(err, errs.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete contact")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) UpdateContactAlias(ctx context.Context, req *gen.UpdateContactAliasReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UpdateContactAlias"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	contactUserID, err := uuid.Parse(req.ContactUserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid contact user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid contact user ID")
+	}
+
+	if err := h.contactUC.UpdateContactAlias(ctx, userID, contactUserID, req.Alias); err != nil {
+		logger.WithError(err).Error("failed to update contact alias")
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, "invalid contact alias")
+		case errors.Is(err, errs.ErrContactNotFound):
+			return nil, status.Error(codes.NotFound, "contact not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update contact alias")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) GetContactAliases(ctx context.Context, req *gen.GetContactAliasesReq) (*gen.GetContactAliasesRes, error) {
+	const op = "UserGRPCHandler.GetContactAliases"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	contactUserIDs, err := parseUUIDs(req.GetContactUserIds())
+	if err != nil {
+		logger.WithError(err).Error("invalid contact user ID")
+		return nil, status.Error(codes.InvalidArgument, "wrong contact user id format")
+	}
+
+	if len(contactUserIDs) == 0 {
+		return &gen.GetContactAliasesRes{}, nil
+	}
+
+	aliases, err := h.contactUC.GetContactAliases(ctx, userID, contactUserIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get contact aliases")
+		return nil, status.Error(codes.Internal, "failed to get contact aliases")
+	}
+
+	res := &gen.GetContactAliasesRes{Aliases: make(map[string]string, len(aliases))}
+	for contactUserID, alias := range aliases {
+		res.Aliases[contactUserID.String()] = alias
+	}
+
+	return res, nil
+}
+
+func (h *UserGRPCHandler) ImportContacts(ctx context.Context, req *gen.ImportContactsReq) (*gen.ImportContactsRes, error) {
+	const op = "UserGRPCHandler.ImportContacts"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	result, err := h.contactUC.ImportContacts(ctx, userID, &ContactDTO.ImportContactsDTO{
+		PhoneNumbers: req.GetPhoneNumbers(),
+		PhoneHashes:  req.GetPhoneHashes(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to import contacts")
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, "phone list is empty or too long")
+		case errors.Is(err, errs.ErrTooManyRequests):
+			return nil, status.Error(codes.ResourceExhausted, "contact import limit exceeded, try again later")
+		default:
+			return nil, status.Error(codes.Internal, "failed to import contacts")
+		}
+	}
+
+	res := &gen.ImportContactsRes{
+		Found:   make([]*gen.ImportedContact, 0, len(result.Found)),
+		Added:   int32(result.Added),
+		Invalid: int32(result.Invalid),
+	}
+	for _, found := range result.Found {
+		res.Found = append(res.Found, &gen.ImportedContact{
+			User: &gen.User{
+				Id:          found.User.ID.String(),
+				Name:        found.User.Name,
+				Username:    found.User.Username,
+				AccountType: found.User.AccountType,
+				CreatedAt:   found.User.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:   found.User.UpdatedAt.Format(time.RFC3339),
+			},
+			PhoneHash: found.PhoneHash,
+			Added:     found.Added,
+		})
+	}
+
+	return res, nil
+}
+
+func (h *UserGRPCHandler) UserRegistered(ctx context.Context, req *gen.UserRegisteredReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UserRegistered"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	// Без записи в индексе человек просто не найдётся глобальным поиском до переиндексации
+	if err := h.userUC.IndexUser(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to index registered user")
+	}
+
+	if err := h.contactUC.HandleUserRegistered(ctx, userID); err != nil {
+		logger.WithError(err).Error("failed to handle user registration")
+
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to handle user registration")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) UserPhoneChanged(ctx context.Context, req *gen.UserPhoneChangedReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UserPhoneChanged"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := h.userUC.HandlePhoneChanged(ctx, userID); err != nil {
+		logger.WithError(err).Error("failed to handle phone change")
+
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to handle phone change")
+	}
+
+	// Устаревший номер в индексе контактов исправит следующая переиндексация, поэтому ошибка не фатальна
+	if err := h.contactUC.HandlePhoneChanged(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to reindex contacts after phone change")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoContact "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/contact"
+	dtoUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDeleteContact_NotFound(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, contactUserID := uuid.New(), uuid.New()
+	mockContactUC.On("DeleteContact", ctx, userID, contactUserID).Return(fmt.Errorf("wrapped: %w", errs.ErrContactNotFound))
+
+	_, err := handler.DeleteContact(ctx, &gen.DeleteContactReq{
+		UserId:        userID.String(),
+		ContactUserId: contactUserID.String(),
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUpdateContactAlias_Success(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "Мама"
+	mockContactUC.On("UpdateContactAlias", ctx, userID, contactUserID, &alias).Return(nil)
+
+	_, err := handler.UpdateContactAlias(ctx, &gen.UpdateContactAliasReq{
+		UserId:        userID.String(),
+		ContactUserId: contactUserID.String(),
+		Alias:         &alias,
+	})
+
+	assert.NoError(t, err)
+	mockContactUC.AssertExpectations(t)
+}
+
+func TestUpdateContactAlias_Invalid(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "слишком длинное имя"
+	mockContactUC.On("UpdateContactAlias", ctx, userID, contactUserID, &alias).Return(fmt.Errorf("wrapped: %w", errs.ErrInvalidInput))
+
+	_, err := handler.UpdateContactAlias(ctx, &gen.UpdateContactAliasReq{
+		UserId:        userID.String(),
+		ContactUserId: contactUserID.String(),
+		Alias:         &alias,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetContactAliases_Success(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, namedID := uuid.New(), uuid.New()
+	mockContactUC.On("GetContactAliases", ctx, userID, []uuid.UUID{namedID}).Return(map[uuid.UUID]string{namedID: "Начальник"}, nil)
+
+	res, err := handler.GetContactAliases(ctx, &gen.GetContactAliasesReq{
+		UserId:         userID.String(),
+		ContactUserIds: []string{namedID.String()},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{namedID.String(): "Начальник"}, res.Aliases)
+}
+
+func TestImportContacts_Success(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, foundID := uuid.New(), uuid.New()
+	req := &dtoContact.ImportContactsDTO{PhoneNumbers: []string{"+79991234567"}, PhoneHashes: []string{"hash"}}
+	mockContactUC.On("ImportContacts", ctx, userID, req).Return(&dtoContact.ImportContactsResultDTO{
+		Found:   []*dtoContact.ImportedContactDTO{{User: &dtoUser.User{ID: foundID, Name: "Anna"}, PhoneHash: "hash", Added: true}},
+		Added:   1,
+		Invalid: 0,
+	}, nil)
+
+	res, err := handler.ImportContacts(ctx, &gen.ImportContactsReq{
+		UserId:       userID.String(),
+		PhoneNumbers: req.PhoneNumbers,
+		PhoneHashes:  req.PhoneHashes,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Found, 1)
+	assert.Equal(t, foundID.String(), res.Found[0].User.Id)
+	assert.Empty(t, res.Found[0].User.PhoneNumber)
+	assert.Equal(t, int32(1), res.Added)
+}
+
+func TestImportContacts_QuotaExceeded(t *testing.T) {
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockContactUC.On("ImportContacts", ctx, userID, mock.Anything).Return(nil, fmt.Errorf("wrapped: %w", errs.ErrTooManyRequests))
+
+	_, err := handler.ImportContacts(ctx, &gen.ImportContactsReq{UserId: userID.String(), PhoneNumbers: []string{"+79991234567"}})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestUserRegistered_IndexFailureDoesNotBlock(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockUserUC.On("IndexUser", ctx, userID).Return(fmt.Errorf("opensearch unavailable"))
+	mockContactUC.On("HandleUserRegistered", ctx, userID).Return(nil)
+
+	_, err := handler.UserRegistered(ctx, &gen.UserRegisteredReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	mockUserUC.AssertExpectations(t)
+	mockContactUC.AssertExpectations(t)
+}
+
+func TestUserPhoneChanged_ReindexFailureDoesNotBlock(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockUserUC.On("HandlePhoneChanged", ctx, userID).Return(nil)
+	mockContactUC.On("HandlePhoneChanged", ctx, userID).Return(fmt.Errorf("opensearch unavailable"))
+
+	_, err := handler.UserPhoneChanged(ctx, &gen.UserPhoneChangedReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	mockUserUC.AssertExpectations(t)
+	mockContactUC.AssertExpectations(t)
+}
+
+func TestUserPhoneChanged_UserNotFound(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockUserUC.On("HandlePhoneChanged", ctx, userID).Return(fmt.Errorf("wrapped: %w", errs.ErrUserNotFound))
+
+	_, err := handler.UserPhoneChanged(ctx, &gen.UserPhoneChangedReq{UserId: userID.String()})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockContactUC.AssertNotCalled(t, "HandlePhoneChanged", mock.Anything, mock.Anything)
+}
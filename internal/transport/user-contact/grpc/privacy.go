@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoPrivacy "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *UserGRPCHandler) GetPrivacySettings(ctx context.Context, req *gen.GetPrivacySettingsReq) (*gen.GetPrivacySettingsRes, error) {
+	const op = "UserGRPCHandler.GetPrivacySettings"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	rules, err := h.privacyUC.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get privacy settings")
+		return nil, status.Error(codes.Internal, "failed to get privacy settings")
+	}
+
+	res := &gen.GetPrivacySettingsRes{Rules: make([]*gen.PrivacyRule, 0, len(rules))}
+	for _, rule := range rules {
+		res.Rules = append(res.Rules, &gen.PrivacyRule{
+			Setting:      rule.Setting,
+			Visibility:   rule.Visibility,
+			AllowUserIds: uuidsToStrings(rule.AllowUserIDs),
+			DenyUserIds:  uuidsToStrings(rule.DenyUserIDs),
+		})
+	}
+
+	return res, nil
+}
+
+func (h *UserGRPCHandler) UpdatePrivacySetting(ctx context.Context, req *gen.UpdatePrivacySettingReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UpdatePrivacySetting"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if req.GetRule() == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+
+	allow, err := parseUUIDs(req.Rule.GetAllowUserIds())
+	if err != nil {
+		logger.WithError(err).Error("invalid allow user ID")
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+	deny, err := parseUUIDs(req.Rule.GetDenyUserIds())
+	if err != nil {
+		logger.WithError(err).Error("invalid deny user ID")
+		return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+	}
+
+	err = h.privacyUC.UpdatePrivacySetting(ctx, userID, &dtoPrivacy.PrivacyRuleDTO{
+		Setting:      req.Rule.Setting,
+		Visibility:   req.Rule.Visibility,
+		AllowUserIDs: allow,
+		DenyUserIDs:  deny,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to update privacy setting")
+
+		switch {
+		case errors.Is(err, errs.ErrInvalidInput):
+			return nil, status.Error(codes.InvalidArgument, "invalid privacy rule")
+		case errors.Is(err, errs.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update privacy setting")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) GetGroupAddDenied(ctx context.Context, req *gen.GetGroupAddDeniedReq) (*gen.GetGroupAddDeniedRes, error) {
+	const op = "UserGRPCHandler.GetGroupAddDenied"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	peerIDs, err := parseUUIDs(req.GetPeerIds())
+	if err != nil {
+		logger.WithError(err).Error("invalid peer ID")
+		return nil, status.Error(codes.InvalidArgument, "wrong peer id format")
+	}
+
+	if len(peerIDs) == 0 {
+		return &gen.GetGroupAddDeniedRes{}, nil
+	}
+
+	denied, err := h.privacyUC.GetGroupAddDenied(ctx, userID, peerIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to check group add privacy")
+		return nil, status.Error(codes.Internal, "failed to check group add privacy")
+	}
+
+	return &gen.GetGroupAddDeniedRes{PeerIds: uuidsToStrings(denied)}, nil
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoPrivacy "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetPrivacySettings_Success(t *testing.T) {
+	mockPrivacyUC := new(MockPrivacyUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), mockPrivacyUC)
+	ctx := setupContext()
+
+	userID, friendID := uuid.New(), uuid.New()
+	mockPrivacyUC.On("GetPrivacySettings", ctx, userID).Return([]*dtoPrivacy.PrivacyRuleDTO{
+		{Setting: "phone_number", Visibility: "nobody", AllowUserIDs: []uuid.UUID{friendID}},
+	}, nil)
+
+	res, err := handler.GetPrivacySettings(ctx, &gen.GetPrivacySettingsReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Rules, 1)
+	assert.Equal(t, "nobody", res.Rules[0].Visibility)
+	assert.Equal(t, []string{friendID.String()}, res.Rules[0].AllowUserIds)
+}
+
+func TestUpdatePrivacySetting_InvalidRule(t *testing.T) {
+	mockPrivacyUC := new(MockPrivacyUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), mockPrivacyUC)
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockPrivacyUC.On("UpdatePrivacySetting", ctx, userID, mock.Anything).Return(fmt.Errorf("wrapped: %w", errs.ErrInvalidInput))
+
+	_, err := handler.UpdatePrivacySetting(ctx, &gen.UpdatePrivacySettingReq{
+		UserId: userID.String(),
+		Rule:   &gen.PrivacyRule{Setting: "email", Visibility: "nobody"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdatePrivacySetting_BadExceptionID(t *testing.T) {
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	_, err := handler.UpdatePrivacySetting(setupContext(), &gen.UpdatePrivacySettingReq{
+		UserId: uuid.NewString(),
+		Rule:   &gen.PrivacyRule{Setting: "bio", Visibility: "nobody", DenyUserIds: []string{"bad"}},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetGroupAddDenied_Success(t *testing.T) {
+	mockPrivacyUC := new(MockPrivacyUsecase)
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), mockPrivacyUC)
+	ctx := setupContext()
+
+	userID, deniedID, allowedID := uuid.New(), uuid.New(), uuid.New()
+	mockPrivacyUC.On("GetGroupAddDenied", ctx, userID, []uuid.UUID{deniedID, allowedID}).Return([]uuid.UUID{deniedID}, nil)
+
+	res, err := handler.GetGroupAddDenied(ctx, &gen.GetGroupAddDeniedReq{
+		UserId:  userID.String(),
+		PeerIds: []string{deniedID.String(), allowedID.String()},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{deniedID.String()}, res.PeerIds)
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// parseProfileUpdate проверяет статус, день рождения и ссылки из запроса и переносит их в update
+func parseProfileUpdate(req *gen.UpdateUserInfoReq, update *UserModels.InfoUpdate, now time.Time) error {
+	if req.Status != nil {
+		if !validation.ValidateStatus(req.Status.Emoji, req.Status.Text) {
+			return status.Errorf(codes.InvalidArgument, "status emoji must not exceed %d characters and text %d characters",
+				UserModels.MaxStatusEmojiLength, UserModels.MaxStatusTextLength)
+		}
+
+		userStatus := UserModels.Status{Emoji: req.Status.Emoji, Text: req.Status.Text}
+		if req.Status.ExpiresAt != nil && !userStatus.IsEmpty() {
+			expiresAt, err := time.Parse(time.RFC3339, *req.Status.ExpiresAt)
+			if err != nil || !expiresAt.After(now) {
+				return status.Error(codes.InvalidArgument, "status expires_at must be a future RFC3339 time")
+			}
+			userStatus.ExpiresAt = &expiresAt
+		}
+		update.Status = &userStatus
+	}
+
+	if req.Birthday != nil {
+		var birthday time.Time
+		if *req.Birthday != "" {
+			parsed, ok := validation.ParseBirthday(*req.Birthday, now)
+			if !ok {
+				return status.Error(codes.InvalidArgument, "birthday must be a past date in YYYY-MM-DD format")
+			}
+			birthday = parsed
+		}
+		update.Birthday = &birthday
+	}
+
+	if req.Links != nil {
+		if len(req.Links.Links) > UserModels.MaxLinks {
+			return status.Errorf(codes.InvalidArgument, "profile can have at most %d links", UserModels.MaxLinks)
+		}
+
+		links := make([]UserModels.Link, 0, len(req.Links.Links))
+		for i, link := range req.Links.Links {
+			if !validation.ValidateProfileLink(link.Title, link.Url) {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("link %d must have a title up to %d characters and an http(s) url", i+1, UserModels.MaxLinkTitleLength))
+			}
+			links = append(links, UserModels.Link{Title: link.Title, URL: link.Url})
+		}
+		update.Links = &links
+	}
+
+	return nil
+}
+
+// setProtoProfile переносит статус, день рождения, ссылки и время последнего визита в ответ
+func setProtoProfile(protoUser *gen.User, user *UserDTO.User) {
+	if user.Status != nil {
+		protoUser.Status = &gen.UserStatus{
+			Emoji: user.Status.Emoji,
+			Text:  user.Status.Text,
+		}
+		if user.Status.ExpiresAt != nil {
+			expiresAt := user.Status.ExpiresAt.Format(time.RFC3339)
+			protoUser.Status.ExpiresAt = &expiresAt
+		}
+	}
+
+	if user.Birthday != nil {
+		protoUser.Birthday = *user.Birthday
+	}
+
+	if user.LastSeenAt != nil {
+		protoUser.LastSeenAt = user.LastSeenAt.Format(time.RFC3339)
+	}
+
+	for _, link := range user.Links {
+		protoUser.Links = append(protoUser.Links, &gen.ProfileLink{Title: link.Title, Url: link.URL})
+	}
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateUserInfo_Profile(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	expiresAtStr := expiresAt.Format(time.RFC3339)
+	birthday := "1995-03-14"
+
+	var update UserModels.InfoUpdate
+	mockUserUC.On("UpdateUserInfo", ctx, userID, mock.Anything).Run(func(args mock.Arguments) {
+		update = args.Get(2).(UserModels.InfoUpdate)
+	}).Return(nil)
+
+	res, err := handler.UpdateUserInfo(ctx, &gen.UpdateUserInfoReq{
+		UserId:   userID.String(),
+		Status:   &gen.UserStatus{Emoji: "🌴", Text: "On vacation", ExpiresAt: &expiresAtStr},
+		Birthday: &birthday,
+		Links:    &gen.ProfileLinks{Links: []*gen.ProfileLink{{Title: "GitHub", Url: "https://github.com/user"}}},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "🌴", update.Status.Emoji)
+	assert.Equal(t, "On vacation", update.Status.Text)
+	assert.True(t, expiresAt.Equal(*update.Status.ExpiresAt))
+	assert.Equal(t, time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC), *update.Birthday)
+	assert.Equal(t, []UserModels.Link{{Title: "GitHub", URL: "https://github.com/user"}}, *update.Links)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestUpdateUserInfo_ClearProfile(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	empty := ""
+	noLinks := []UserModels.Link{}
+	mockUserUC.On("UpdateUserInfo", ctx, userID, UserModels.InfoUpdate{
+		Status:   &UserModels.Status{},
+		Birthday: &time.Time{},
+		Links:    &noLinks,
+	}).Return(nil)
+
+	res, err := handler.UpdateUserInfo(ctx, &gen.UpdateUserInfoReq{
+		UserId:   userID.String(),
+		Status:   &gen.UserStatus{},
+		Birthday: &empty,
+		Links:    &gen.ProfileLinks{},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestUpdateUserInfo_InvalidProfile(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	future := time.Now().AddDate(0, 0, 1).Format(UserModels.BirthdayLayout)
+	tooManyLinks := make([]*gen.ProfileLink, UserModels.MaxLinks+1)
+	for i := range tooManyLinks {
+		tooManyLinks[i] = &gen.ProfileLink{Title: "Site", Url: "https://example.com"}
+	}
+
+	tests := []struct {
+		name string
+		req  *gen.UpdateUserInfoReq
+	}{
+		{"long status text", &gen.UpdateUserInfoReq{Status: &gen.UserStatus{Text: string(make([]rune, UserModels.MaxStatusTextLength+1))}}},
+		{"expired status", &gen.UpdateUserInfoReq{Status: &gen.UserStatus{Text: "Busy", ExpiresAt: &past}}},
+		{"future birthday", &gen.UpdateUserInfoReq{Birthday: &future}},
+		{"too many links", &gen.UpdateUserInfoReq{Links: &gen.ProfileLinks{Links: tooManyLinks}}},
+		{"bad link url", &gen.UpdateUserInfoReq{Links: &gen.ProfileLinks{Links: []*gen.ProfileLink{{Title: "Site", Url: "javascript:alert(1)"}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserUC := new(MockUserUsecase)
+			handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+			tt.req.UserId = uuid.New().String()
+			res, err := handler.UpdateUserInfo(setupContext(), tt.req)
+
+			assert.Nil(t, res)
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			mockUserUC.AssertNotCalled(t, "UpdateUserInfo", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetUserById_Profile(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	birthday := "1995-03-14"
+	lastSeenAt := time.Date(2025, 11, 2, 18, 30, 0, 0, time.UTC)
+	mockUserUC.On("GetUserById", ctx, userID).Return(&dtoUser.User{
+		ID:         userID,
+		Name:       "Test User",
+		Status:     &dtoUser.StatusDTO{Emoji: "🌴", Text: "On vacation", ExpiresAt: &expiresAt},
+		Birthday:   &birthday,
+		Links:      []dtoUser.LinkDTO{{Title: "GitHub", URL: "https://github.com/user"}},
+		LastSeenAt: &lastSeenAt,
+	}, nil)
+
+	res, err := handler.GetUserById(ctx, &gen.GetUserByIdReq{UserId: userID.String()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "🌴", res.User.Status.Emoji)
+	assert.Equal(t, "On vacation", res.User.Status.Text)
+	assert.Equal(t, "2030-01-02T03:04:05Z", res.User.Status.GetExpiresAt())
+	assert.Equal(t, birthday, res.User.Birthday)
+	assert.Len(t, res.User.Links, 1)
+	assert.Equal(t, "https://github.com/user", res.User.Links[0].Url)
+	assert.Equal(t, "2025-11-02T18:30:00Z", res.User.LastSeenAt)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestUpdateLastSeen_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockUserUC.On("UpdateLastSeen", ctx, userID, time.Date(2025, 11, 2, 18, 30, 0, 0, time.UTC)).Return(nil)
+
+	_, err := handler.UpdateLastSeen(ctx, &gen.UpdateLastSeenReq{UserId: userID.String(), LastSeenAt: "2025-11-02T18:30:00Z"})
+
+	assert.NoError(t, err)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestUpdateLastSeen_InvalidTime(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	_, err := handler.UpdateLastSeen(setupContext(), &gen.UpdateLastSeenReq{UserId: uuid.New().String(), LastSeenAt: "вчера"})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	mockUserUC.AssertNotCalled(t, "UpdateLastSeen", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact"
@@ -22,12 +23,16 @@ type UserGRPCHandler struct {
 
 	userUC    user.IUserUsecase
 	contactUC user.IContactUsecase
+	blockUC   user.IBlockUsecase
+	privacyUC user.IPrivacyUsecase
 }
 
-func NewUserGRPCHandler(userUC user.IUserUsecase, contactUC user.IContactUsecase) *UserGRPCHandler {
+func NewUserGRPCHandler(userUC user.IUserUsecase, contactUC user.IContactUsecase, blockUC user.IBlockUsecase, privacyUC user.IPrivacyUsecase) *UserGRPCHandler {
 	return &UserGRPCHandler{
 		userUC:    userUC,
 		contactUC: contactUC,
+		blockUC:   blockUC,
+		privacyUC: privacyUC,
 	}
 }
 
@@ -52,7 +57,7 @@ func (h *UserGRPCHandler) GetUserById(ctx context.Context, req *gen.GetUserByIdR
 		bio = *user.Bio
 	}
 
-	return &gen.GetUserByIdRes{
+	res := &gen.GetUserByIdRes{
 		User: &gen.User{
 			Id:          user.ID.String(),
 			PhoneNumber: user.PhoneNumber,
@@ -63,7 +68,10 @@ func (h *UserGRPCHandler) GetUserById(ctx context.Context, req *gen.GetUserByIdR
 			CreatedAt:   user.CreatedAt.Format(time.RFC3339),
 			UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
 		},
-	}, nil
+	}
+	setProtoProfile(res.User, user)
+
+	return res, nil
 }
 
 func (h *UserGRPCHandler) GetUserByPhone(ctx context.Context, req *gen.GetUserByPhoneReq) (*gen.GetUserByPhoneRes, error) {
@@ -72,8 +80,11 @@ func (h *UserGRPCHandler) GetUserByPhone(ctx context.Context, req *gen.GetUserBy
 
 	user, err := h.userUC.GetUserByPhone(ctx, req.PhoneNumber)
 	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
 		logger.WithError(err).Error("failed to get user")
-		return nil, status.Error(codes.NotFound, "user not found")
+		return nil, status.Error(codes.Internal, "failed to get user")
 	}
 
 	bio := ""
@@ -102,7 +113,20 @@ func (h *UserGRPCHandler) GetUserByUsername(ctx context.Context, req *gen.GetUse
 
 	user, err := h.userUC.GetUserByUsername(ctx, req.Username)
 	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
 		logger.WithError(err).Error("failed to get user")
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+
+	// Для пользователя, с которым есть блокировка, ответ не отличается от несуществующего
+	hidden, err := h.isHiddenByBlock(ctx, user.ID)
+	if err != nil {
+		logger.WithError(err).Error("failed to check block")
+		return nil, status.Error(codes.Internal, "failed to get user")
+	}
+	if hidden {
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
@@ -125,6 +149,140 @@ func (h *UserGRPCHandler) GetUserByUsername(ctx context.Context, req *gen.GetUse
 	}, nil
 }
 
+func (h *UserGRPCHandler) ResolveUsername(ctx context.Context, req *gen.ResolveUsernameReq) (*gen.ResolveUsernameRes, error) {
+	const op = "UserGRPCHandler.ResolveUsername"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	user, redirected, err := h.userUC.ResolveUsername(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		logger.WithError(err).Error("failed to resolve username")
+		return nil, status.Error(codes.Internal, "failed to resolve username")
+	}
+
+	// Для пользователя, с которым есть блокировка, ответ не отличается от несуществующего
+	hidden, err := h.isHiddenByBlock(ctx, user.ID)
+	if err != nil {
+		logger.WithError(err).Error("failed to check block")
+		return nil, status.Error(codes.Internal, "failed to resolve username")
+	}
+	if hidden {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	bio := ""
+	if user.Bio != nil {
+		bio = *user.Bio
+	}
+
+	return &gen.ResolveUsernameRes{
+		User: &gen.User{
+			Id:          user.ID.String(),
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+			Username:    user.Username,
+			Bio:         bio,
+			AccountType: user.AccountType,
+			CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
+		},
+		Redirected: redirected,
+	}, nil
+}
+
+func (h *UserGRPCHandler) GetUsersByIDs(ctx context.Context, req *gen.GetUsersByIDsReq) (*gen.GetUsersByIDsRes, error) {
+	const op = "UserGRPCHandler.GetUsersByIDs"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userIDs := make([]uuid.UUID, 0, len(req.GetUserIds()))
+	for _, idStr := range req.GetUserIds() {
+		userID, err := uuid.Parse(idStr)
+		if err != nil {
+			logger.WithError(err).Errorf("error parsing userId: %s", idStr)
+			return nil, status.Error(codes.InvalidArgument, "wrong user id format")
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if len(userIDs) == 0 {
+		return &gen.GetUsersByIDsRes{}, nil
+	}
+
+	users, err := h.userUC.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get users")
+		return nil, status.Error(codes.Internal, "failed to get users")
+	}
+
+	res := &gen.GetUsersByIDsRes{Users: make([]*gen.User, 0, len(users))}
+	for _, user := range users {
+		bio := ""
+		if user.Bio != nil {
+			bio = *user.Bio
+		}
+
+		res.Users = append(res.Users, &gen.User{
+			Id:          user.ID.String(),
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+			Username:    user.Username,
+			Bio:         bio,
+			AccountType: user.AccountType,
+			CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return res, nil
+}
+
+func (h *UserGRPCHandler) SearchUsers(ctx context.Context, req *gen.SearchUsersReq) (*gen.SearchUsersRes, error) {
+	const op = "UserGRPCHandler.SearchUsers"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	result, err := h.userUC.SearchUsers(ctx, userID, req.GetQuery(), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		logger.WithError(err).Error("failed to search users")
+
+		if errors.Is(err, errs.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, "query must be at least 2 characters, offset and limit must be within the search window")
+		}
+		return nil, status.Error(codes.Internal, "failed to search users")
+	}
+
+	res := &gen.SearchUsersRes{
+		Users: make([]*gen.User, 0, len(result.Users)),
+		Total: int32(result.Total),
+	}
+	for _, user := range result.Users {
+		bio := ""
+		if user.Bio != nil {
+			bio = *user.Bio
+		}
+
+		res.Users = append(res.Users, &gen.User{
+			Id:          user.ID.String(),
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+			Username:    user.Username,
+			Bio:         bio,
+			AccountType: user.AccountType,
+			CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   user.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return res, nil
+}
+
 func (h *UserGRPCHandler) UpdateUserInfo(ctx context.Context, req *gen.UpdateUserInfoReq) (*emptypb.Empty, error) {
 	const op = "UserGRPCHandler.UpdateUserInfo"
 	logger := domains.GetLogger(ctx).WithField("op", op)
@@ -135,32 +293,37 @@ func (h *UserGRPCHandler) UpdateUserInfo(ctx context.Context, req *gen.UpdateUse
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
 	}
 
-	var name, username, bio *string
+	var update UserModels.InfoUpdate
 	if req.Name != nil {
 		if !validation.ValidateName(*req.Name) {
 			return nil, status.Error(codes.InvalidArgument, "name must be 1-20 characters")
 		}
-		name = req.Name
+		update.Name = req.Name
 	}
 	if req.Username != nil {
 		if !validation.ValidateUsername(*req.Username) {
 			return nil, status.Error(codes.InvalidArgument, "username must be 3-20 characters and contain only Latin letters, digits, and underscores")
 		}
-		username = req.Username
+		update.Username = req.Username
 	}
 	if req.Bio != nil {
 		if len(*req.Bio) > 200 {
 			return nil, status.Error(codes.InvalidArgument, "bio must not exceed 200 characters")
 		}
-		bio = req.Bio
+		update.Bio = req.Bio
+	}
+	if err := parseProfileUpdate(req, &update, time.Now()); err != nil {
+		return nil, err
 	}
 
-	if err := h.userUC.UpdateUserInfo(ctx, userID, name, username, bio); err != nil {
+	if err := h.userUC.UpdateUserInfo(ctx, userID, update); err != nil {
 		logger.WithError(err).Error("failed to update user info")
 
 		switch {
 		case errors.Is(err, errs.ErrIsDuplicateKey):
 			return nil, status.Error(codes.AlreadyExists, "username already exist")
+		case errors.Is(err, errs.ErrUsernameReserved):
+			return nil, status.Error(codes.InvalidArgument, "username is reserved")
 		case errors.Is(err, errs.ErrUserNotFound), errors.Is(err, errs.ErrNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
 		case errors.Is(err, errs.ErrBadRequest):
@@ -173,6 +336,34 @@ func (h *UserGRPCHandler) UpdateUserInfo(ctx context.Context, req *gen.UpdateUse
 	return &emptypb.Empty{}, nil
 }
 
+// UpdateLastSeen сохраняет время последнего визита; вызывает chats_service при закрытии потока событий
+func (h *UserGRPCHandler) UpdateLastSeen(ctx context.Context, req *gen.UpdateLastSeenReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.UpdateLastSeen"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	lastSeenAt, err := time.Parse(time.RFC3339, req.LastSeenAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "last_seen_at must be in RFC3339 format")
+	}
+
+	if err := h.userUC.UpdateLastSeen(ctx, userID, lastSeenAt); err != nil {
+		logger.WithError(err).Error("failed to update last seen")
+
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update last seen")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (h *UserGRPCHandler) UploadUserAvatar(ctx context.Context, req *gen.UploadUserAvatarReq) (*gen.UploadUserAvatarRes, error) {
 	const op = "UserGRPCHandler.UploadUserAvatar"
 	logger := domains.GetLogger(ctx).WithField("op", op)
@@ -186,6 +377,9 @@ func (h *UserGRPCHandler) UploadUserAvatar(ctx context.Context, req *gen.UploadU
 	avatarURL, err := h.userUC.UploadUserAvatar(ctx, userID, req.Data, req.Filename, req.ContentType)
 	if err != nil {
 		logger.WithError(err).Error("failed to upload avatar")
+		if errors.Is(err, errs.ErrInvalidImage) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -194,6 +388,98 @@ func (h *UserGRPCHandler) UploadUserAvatar(ctx context.Context, req *gen.UploadU
 	}, nil
 }
 
+func (h *UserGRPCHandler) GetUserAvatarHistory(ctx context.Context, req *gen.GetUserAvatarHistoryReq) (*gen.GetUserAvatarHistoryRes, error) {
+	const op = "UserGRPCHandler.GetUserAvatarHistory"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	ownerID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		logger.WithError(err).Error("invalid user ID")
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	avatars, err := h.userUC.GetUserAvatarHistory(ctx, ownerID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get avatar history")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*gen.Avatar, 0, len(avatars))
+	for _, avatar := range avatars {
+		sizes := make(map[int32]string, len(avatar.Sizes))
+		for size, url := range avatar.Sizes {
+			sizes[int32(size)] = url
+		}
+
+		result = append(result, &gen.Avatar{
+			Id:        avatar.ID.String(),
+			Url:       avatar.URL,
+			Sizes:     sizes,
+			IsCurrent: avatar.IsCurrent,
+			CreatedAt: avatar.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &gen.GetUserAvatarHistoryRes{Avatars: result}, nil
+}
+
+func (h *UserGRPCHandler) DeleteUserAvatar(ctx context.Context, req *gen.DeleteUserAvatarReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.DeleteUserAvatar"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, avatarID, err := parseUserAvatarIDs(req.UserId, req.AvatarId)
+	if err != nil {
+		logger.WithError(err).Error("invalid ID")
+		return nil, err
+	}
+
+	if err := h.userUC.DeleteUserAvatar(ctx, userID, avatarID); err != nil {
+		logger.WithError(err).Error("failed to delete avatar")
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "avatar not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserGRPCHandler) SetCurrentUserAvatar(ctx context.Context, req *gen.SetCurrentUserAvatarReq) (*emptypb.Empty, error) {
+	const op = "UserGRPCHandler.SetCurrentUserAvatar"
+	logger := domains.GetLogger(ctx).WithField("op", op)
+
+	userID, avatarID, err := parseUserAvatarIDs(req.UserId, req.AvatarId)
+	if err != nil {
+		logger.WithError(err).Error("invalid ID")
+		return nil, err
+	}
+
+	if err := h.userUC.SetCurrentUserAvatar(ctx, userID, avatarID); err != nil {
+		logger.WithError(err).Error("failed to set current avatar")
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "avatar not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// parseUserAvatarIDs разбирает id пользователя и аватарки, возвращая статус InvalidArgument при ошибке
+func parseUserAvatarIDs(rawUserID, rawAvatarID string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	avatarID, err := uuid.Parse(rawAvatarID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid avatar ID")
+	}
+
+	return userID, avatarID, nil
+}
+
 func (h *UserGRPCHandler) GetUserAvatars(ctx context.Context, req *gen.GetUserAvatarsReq) (*gen.GetUserAvatarsRes, error) {
 	const op = "UserGRPCHandler.GetUserAvatars"
 	logger := domains.GetLogger(ctx).WithField("op", op)
@@ -3,13 +3,18 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoBlock "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
 	dtoContact "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/contact"
+	dtoPrivacy "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
 	dtoUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -47,13 +52,70 @@ func (m *MockUserUsecase) GetUserByUsername(ctx context.Context, username string
 	return args.Get(0).(*dtoUser.User), args.Error(1)
 }
 
+func (m *MockUserUsecase) ResolveUsername(ctx context.Context, username string) (*dtoUser.User, bool, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*dtoUser.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserUsecase) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*dtoUser.User, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dtoUser.User), args.Error(1)
+}
+
 func (m *MockUserUsecase) UploadUserAvatar(ctx context.Context, userID uuid.UUID, data []byte, filename, contentType string) (string, error) {
 	args := m.Called(ctx, userID, data, filename, contentType)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockUserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error {
-	args := m.Called(ctx, userID, name, username, bio)
+func (m *MockUserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, update UserModels.InfoUpdate) error {
+	args := m.Called(ctx, userID, update)
+	return args.Error(0)
+}
+
+func (m *MockUserUsecase) SearchUsers(ctx context.Context, viewerID uuid.UUID, query string, offset, limit int) (*dtoUser.SearchUsersResult, error) {
+	args := m.Called(ctx, viewerID, query, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoUser.SearchUsersResult), args.Error(1)
+}
+
+func (m *MockUserUsecase) IndexUser(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, userID, at)
+	return args.Error(0)
+}
+
+func (m *MockUserUsecase) GetUserAvatarHistory(ctx context.Context, ownerID uuid.UUID) ([]dtoUtils.AvatarDTO, error) {
+	args := m.Called(ctx, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dtoUtils.AvatarDTO), args.Error(1)
+}
+
+func (m *MockUserUsecase) DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	args := m.Called(ctx, userID, avatarID)
+	return args.Error(0)
+}
+
+func (m *MockUserUsecase) SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	args := m.Called(ctx, userID, avatarID)
 	return args.Error(0)
 }
 
@@ -95,6 +157,97 @@ func (m *MockContactUsecase) ReindexAllContacts(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockContactUsecase) DeleteContact(ctx context.Context, userID, contactUserID uuid.UUID) error {
+	args := m.Called(ctx, userID, contactUserID)
+	return args.Error(0)
+}
+
+func (m *MockContactUsecase) UpdateContactAlias(ctx context.Context, userID, contactUserID uuid.UUID, alias *string) error {
+	args := m.Called(ctx, userID, contactUserID, alias)
+	return args.Error(0)
+}
+
+func (m *MockContactUsecase) ImportContacts(ctx context.Context, userID uuid.UUID, req *dtoContact.ImportContactsDTO) (*dtoContact.ImportContactsResultDTO, error) {
+	args := m.Called(ctx, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dtoContact.ImportContactsResultDTO), args.Error(1)
+}
+
+func (m *MockContactUsecase) HandleUserRegistered(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockContactUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockContactUsecase) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	args := m.Called(ctx, userID, contactUserIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]string), args.Error(1)
+}
+
+type MockBlockUsecase struct {
+	mock.Mock
+}
+
+func (m *MockBlockUsecase) BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *MockBlockUsecase) UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *MockBlockUsecase) GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*dtoBlock.BlockedUserDTO, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dtoBlock.BlockedUserDTO), args.Error(1)
+}
+
+func (m *MockBlockUsecase) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID, peerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+type MockPrivacyUsecase struct {
+	mock.Mock
+}
+
+func (m *MockPrivacyUsecase) GetPrivacySettings(ctx context.Context, userID uuid.UUID) ([]*dtoPrivacy.PrivacyRuleDTO, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*dtoPrivacy.PrivacyRuleDTO), args.Error(1)
+}
+
+func (m *MockPrivacyUsecase) UpdatePrivacySetting(ctx context.Context, userID uuid.UUID, rule *dtoPrivacy.PrivacyRuleDTO) error {
+	args := m.Called(ctx, userID, rule)
+	return args.Error(0)
+}
+
+func (m *MockPrivacyUsecase) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID, peerIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
 func setupContext() context.Context {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
@@ -105,7 +258,7 @@ func setupContext() context.Context {
 func TestGetUserById_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -138,7 +291,7 @@ func TestGetUserById_Success(t *testing.T) {
 func TestGetUserById_InvalidUserID(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	req := &gen.GetUserByIdReq{UserId: "invalid-uuid"}
@@ -154,7 +307,7 @@ func TestGetUserById_InvalidUserID(t *testing.T) {
 func TestGetUserById_UserNotFound(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -174,7 +327,7 @@ func TestGetUserById_UserNotFound(t *testing.T) {
 func TestGetUserByPhone_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	phone := "+1234567890"
@@ -205,7 +358,7 @@ func TestGetUserByPhone_Success(t *testing.T) {
 func TestGetUserByUsername_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	username := "testuser"
@@ -231,10 +384,203 @@ func TestGetUserByUsername_Success(t *testing.T) {
 	mockUserUC.AssertExpectations(t)
 }
 
+func TestGetUserByPhone_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "Not found", err: errs.ErrUserNotFound, code: codes.NotFound},
+		{name: "Database error", err: errors.New("connection refused"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserUC := new(MockUserUsecase)
+			handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+			ctx := setupContext()
+
+			mockUserUC.On("GetUserByPhone", ctx, "+79990001122").Return(nil, tt.err)
+
+			res, err := handler.GetUserByPhone(ctx, &gen.GetUserByPhoneReq{PhoneNumber: "+79990001122"})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestGetUserByUsername_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "Not found", err: fmt.Errorf("UserUsecase.GetUserByUsername: %w", errs.ErrUserNotFound), code: codes.NotFound},
+		{name: "Database error", err: errors.New("connection refused"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserUC := new(MockUserUsecase)
+			handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+			ctx := setupContext()
+
+			mockUserUC.On("GetUserByUsername", ctx, "someone").Return(nil, tt.err)
+
+			res, err := handler.GetUserByUsername(ctx, &gen.GetUserByUsernameReq{Username: "someone"})
+
+			assert.Nil(t, res)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestResolveUsername_Redirected(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	testUser := &dtoUser.User{
+		ID:          uuid.New(),
+		Name:        "Test User",
+		Username:    "new_handle",
+		AccountType: "user",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	mockUserUC.On("ResolveUsername", ctx, "old_handle").Return(testUser, true, nil)
+
+	res, err := handler.ResolveUsername(ctx, &gen.ResolveUsernameReq{Username: "old_handle"})
+
+	assert.NoError(t, err)
+	assert.True(t, res.Redirected)
+	assert.Equal(t, "new_handle", res.User.Username)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestResolveUsername_NotFound(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	mockUserUC.On("ResolveUsername", ctx, "ghost").Return(nil, false, errs.ErrUserNotFound)
+
+	res, err := handler.ResolveUsername(ctx, &gen.ResolveUsernameReq{Username: "ghost"})
+
+	assert.Nil(t, res)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestGetUsersByIDs_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+	bio := "about"
+
+	mockUserUC.On("GetUsersByIDs", ctx, []uuid.UUID{userID1, userID2}).Return([]*dtoUser.User{
+		{ID: userID1, Name: "First", Bio: &bio},
+		{ID: userID2, Name: "Second"},
+	}, nil)
+
+	res, err := handler.GetUsersByIDs(ctx, &gen.GetUsersByIDsReq{UserIds: []string{userID1.String(), userID2.String()}})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Users, 2)
+	assert.Equal(t, userID1.String(), res.Users[0].Id)
+	assert.Equal(t, bio, res.Users[0].Bio)
+	assert.Equal(t, "Second", res.Users[1].Name)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestGetUsersByIDs_EmptyRequest(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	res, err := handler.GetUsersByIDs(setupContext(), &gen.GetUsersByIDsReq{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, res.Users)
+	mockUserUC.AssertNotCalled(t, "GetUsersByIDs")
+}
+
+func TestGetUsersByIDs_InvalidUserID(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	res, err := handler.GetUsersByIDs(setupContext(), &gen.GetUsersByIDsReq{UserIds: []string{"invalid-uuid"}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetUsersByIDs_UsecaseError(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	mockContactUC := new(MockContactUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	mockUserUC.On("GetUsersByIDs", ctx, mock.Anything).Return(nil, errors.New("database error"))
+
+	res, err := handler.GetUsersByIDs(ctx, &gen.GetUsersByIDsReq{UserIds: []string{uuid.NewString()}})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestSearchUsers_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	viewerID := uuid.New()
+	foundID := uuid.New()
+	offset, limit := int32(20), int32(10)
+
+	mockUserUC.On("SearchUsers", ctx, viewerID, "ivan", 20, 10).Return(&dtoUser.SearchUsersResult{
+		Users: []*dtoUser.User{{ID: foundID, Name: "Ivan", Username: "ivan"}},
+		Total: 21,
+	}, nil)
+
+	res, err := handler.SearchUsers(ctx, &gen.SearchUsersReq{UserId: viewerID.String(), Query: "ivan", Offset: &offset, Limit: &limit})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(21), res.Total)
+	assert.Len(t, res.Users, 1)
+	assert.Equal(t, foundID.String(), res.Users[0].Id)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestSearchUsers_InvalidQuery(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	viewerID := uuid.New()
+	mockUserUC.On("SearchUsers", ctx, viewerID, "i", 0, 0).Return(nil, errs.ErrInvalidInput)
+
+	res, err := handler.SearchUsers(ctx, &gen.SearchUsersReq{UserId: viewerID.String(), Query: "i"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUserUC.AssertExpectations(t)
+}
+
 func TestUpdateUserInfo_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -242,7 +588,7 @@ func TestUpdateUserInfo_Success(t *testing.T) {
 	username := "newusername"
 	bio := "New bio"
 
-	mockUserUC.On("UpdateUserInfo", ctx, userID, &name, &username, &bio).Return(nil)
+	mockUserUC.On("UpdateUserInfo", ctx, userID, UserModels.InfoUpdate{Name: &name, Username: &username, Bio: &bio}).Return(nil)
 
 	req := &gen.UpdateUserInfoReq{
 		UserId:   userID.String(),
@@ -260,7 +606,7 @@ func TestUpdateUserInfo_Success(t *testing.T) {
 func TestUpdateUserInfo_InvalidUserID(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	req := &gen.UpdateUserInfoReq{UserId: "invalid-uuid"}
@@ -276,7 +622,7 @@ func TestUpdateUserInfo_InvalidUserID(t *testing.T) {
 func TestUpdateUserInfo_InvalidName(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -298,13 +644,13 @@ func TestUpdateUserInfo_InvalidName(t *testing.T) {
 func TestUpdateUserInfo_DuplicateUsername(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
 	username := "existinguser"
 
-	mockUserUC.On("UpdateUserInfo", ctx, userID, mock.Anything, &username, mock.Anything).Return(errs.ErrIsDuplicateKey)
+	mockUserUC.On("UpdateUserInfo", ctx, userID, UserModels.InfoUpdate{Username: &username}).Return(errs.ErrIsDuplicateKey)
 
 	req := &gen.UpdateUserInfoReq{
 		UserId:   userID.String(),
@@ -320,10 +666,29 @@ func TestUpdateUserInfo_DuplicateUsername(t *testing.T) {
 	mockUserUC.AssertExpectations(t)
 }
 
+func TestUpdateUserInfo_ReservedUsername(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	username := "support_team"
+
+	mockUserUC.On("UpdateUserInfo", ctx, userID, UserModels.InfoUpdate{Username: &username}).Return(errs.ErrUsernameReserved)
+
+	res, err := handler.UpdateUserInfo(ctx, &gen.UpdateUserInfoReq{UserId: userID.String(), Username: &username})
+
+	assert.Nil(t, res)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	mockUserUC.AssertExpectations(t)
+}
+
 func TestUploadUserAvatar_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -351,7 +716,7 @@ func TestUploadUserAvatar_Success(t *testing.T) {
 func TestUploadUserAvatar_InvalidUserID(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	req := &gen.UploadUserAvatarReq{UserId: "invalid-uuid"}
@@ -367,7 +732,7 @@ func TestUploadUserAvatar_InvalidUserID(t *testing.T) {
 func TestUploadUserAvatar_UploadError(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -389,10 +754,114 @@ func TestUploadUserAvatar_UploadError(t *testing.T) {
 	mockUserUC.AssertExpectations(t)
 }
 
+func TestUploadUserAvatar_InvalidImage(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID := uuid.New()
+	mockUserUC.On("UploadUserAvatar", ctx, userID, mock.Anything, mock.Anything, mock.Anything).Return("", errs.ErrInvalidImage)
+
+	res, err := handler.UploadUserAvatar(ctx, &gen.UploadUserAvatarReq{UserId: userID.String(), Data: []byte("data")})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestGetUserAvatarHistory_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	ownerID := uuid.New()
+	avatar := dtoUtils.AvatarDTO{
+		ID:        uuid.New(),
+		URL:       "https://example.com/avatar.jpg",
+		Sizes:     map[int]string{640: "https://example.com/avatar.jpg", 160: "https://example.com/avatar_160.jpg"},
+		IsCurrent: true,
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	mockUserUC.On("GetUserAvatarHistory", ctx, ownerID).Return([]dtoUtils.AvatarDTO{avatar}, nil)
+
+	res, err := handler.GetUserAvatarHistory(ctx, &gen.GetUserAvatarHistoryReq{UserId: ownerID.String()})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Avatars, 1)
+	assert.Equal(t, avatar.ID.String(), res.Avatars[0].Id)
+	assert.True(t, res.Avatars[0].IsCurrent)
+	assert.Equal(t, "https://example.com/avatar_160.jpg", res.Avatars[0].Sizes[160])
+	assert.Equal(t, "2025-01-02T03:04:05Z", res.Avatars[0].CreatedAt)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestGetUserAvatarHistory_InvalidUserID(t *testing.T) {
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	res, err := handler.GetUserAvatarHistory(setupContext(), &gen.GetUserAvatarHistoryReq{UserId: "invalid-uuid"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeleteUserAvatar_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, avatarID := uuid.New(), uuid.New()
+	mockUserUC.On("DeleteUserAvatar", ctx, userID, avatarID).Return(nil)
+
+	res, err := handler.DeleteUserAvatar(ctx, &gen.DeleteUserAvatarReq{UserId: userID.String(), AvatarId: avatarID.String()})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestDeleteUserAvatar_NotFound(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, avatarID := uuid.New(), uuid.New()
+	mockUserUC.On("DeleteUserAvatar", ctx, userID, avatarID).Return(errs.ErrNotFound)
+
+	res, err := handler.DeleteUserAvatar(ctx, &gen.DeleteUserAvatarReq{UserId: userID.String(), AvatarId: avatarID.String()})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockUserUC.AssertExpectations(t)
+}
+
+func TestDeleteUserAvatar_InvalidAvatarID(t *testing.T) {
+	handler := NewUserGRPCHandler(new(MockUserUsecase), new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+
+	res, err := handler.DeleteUserAvatar(setupContext(), &gen.DeleteUserAvatarReq{UserId: uuid.New().String(), AvatarId: "invalid-uuid"})
+
+	assert.Nil(t, res)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetCurrentUserAvatar_Success(t *testing.T) {
+	mockUserUC := new(MockUserUsecase)
+	handler := NewUserGRPCHandler(mockUserUC, new(MockContactUsecase), new(MockBlockUsecase), new(MockPrivacyUsecase))
+	ctx := setupContext()
+
+	userID, avatarID := uuid.New(), uuid.New()
+	mockUserUC.On("SetCurrentUserAvatar", ctx, userID, avatarID).Return(nil)
+
+	res, err := handler.SetCurrentUserAvatar(ctx, &gen.SetCurrentUserAvatarReq{UserId: userID.String(), AvatarId: avatarID.String()})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	mockUserUC.AssertExpectations(t)
+}
+
 func TestGetUserAvatars_Success(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID1 := uuid.New()
@@ -425,7 +894,7 @@ func TestGetUserAvatars_Success(t *testing.T) {
 func TestGetUserAvatars_EmptyRequest(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	req := &gen.GetUserAvatarsReq{UserIds: []string{}}
@@ -439,7 +908,7 @@ func TestGetUserAvatars_EmptyRequest(t *testing.T) {
 func TestGetUserAvatars_InvalidUserID(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	req := &gen.GetUserAvatarsReq{
@@ -457,7 +926,7 @@ func TestGetUserAvatars_InvalidUserID(t *testing.T) {
 func TestGetUserAvatars_UsecaseError(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, new(MockBlockUsecase), new(MockPrivacyUsecase))
 	ctx := setupContext()
 
 	userID := uuid.New()
@@ -480,9 +949,14 @@ func TestNewUserGRPCHandler(t *testing.T) {
 	mockUserUC := new(MockUserUsecase)
 	mockContactUC := new(MockContactUsecase)
 
-	handler := NewUserGRPCHandler(mockUserUC, mockContactUC)
+	mockBlockUC := new(MockBlockUsecase)
+	mockPrivacyUC := new(MockPrivacyUsecase)
+
+	handler := NewUserGRPCHandler(mockUserUC, mockContactUC, mockBlockUC, mockPrivacyUC)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockUserUC, handler.userUC)
 	assert.Equal(t, mockContactUC, handler.contactUC)
+	assert.Equal(t, mockBlockUC, handler.blockUC)
+	assert.Equal(t, mockPrivacyUC, handler.privacyUC)
 }
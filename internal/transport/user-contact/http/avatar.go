@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// GetUserAvatarHistory возвращает историю аватарок пользователя через gRPC
+// @Summary      История аватарок пользователя
+// @Description  Возвращает аватарки пользователя, начиная с текущей, со ссылками на все размеры. Если владелец скрыл аватарку, список пустой
+// @Tags         user
+// @Produce      json
+// @Param        id   path  string  true  "ID пользователя"
+// @Success      200   {object}  dto.GetAvatarHistoryResponse  "История аватарок"
+// @Failure      400   {object}  dto.ErrorDTO                  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO                  "Неавторизованный доступ"
+// @Failure      500   {object}  dto.ErrorDTO                  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/{id}/avatars [get]
+func (h *UserGRPCProxyHandler) GetUserAvatarHistory(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.GetUserAvatarHistory"
+
+	ownerID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	res, err := h.userClient.GetUserAvatarHistory(r.Context(), &gen.GetUserAvatarHistoryReq{
+		UserId: ownerID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	avatars := make([]dtoUtils.AvatarDTO, 0, len(res.Avatars))
+	for _, avatar := range res.Avatars {
+		avatars = append(avatars, mapProtoAvatarToDTO(avatar))
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, dtoUtils.GetAvatarHistoryResponse{Avatars: avatars})
+}
+
+// DeleteUserAvatar удаляет аватарку из истории текущего пользователя через gRPC
+// @Summary      Удаление аватарки
+// @Description  Удаляет аватарку из истории вместе с файлами всех размеров. Если удалена текущая, текущей становится предыдущая
+// @Tags         user
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        avatar_id   path  string  true  "ID аватарки"
+// @Success      204   "Аватарка удалена"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Аватарка не найдена"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/avatars/{avatar_id} [delete]
+func (h *UserGRPCProxyHandler) DeleteUserAvatar(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.DeleteUserAvatar"
+
+	userID, avatarID, ok := avatarPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	_, err := h.userClient.DeleteUserAvatar(r.Context(), &gen.DeleteUserAvatarReq{
+		UserId:   userID.String(),
+		AvatarId: avatarID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetCurrentUserAvatar делает аватарку из истории текущей через gRPC
+// @Summary      Вернуть прежнюю аватарку
+// @Description  Делает аватарку из истории текущего пользователя текущей
+// @Tags         user
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        avatar_id   path  string  true  "ID аватарки"
+// @Success      204   "Аватарка стала текущей"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Аватарка не найдена"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/avatars/{avatar_id}/current [put]
+func (h *UserGRPCProxyHandler) SetCurrentUserAvatar(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.SetCurrentUserAvatar"
+
+	userID, avatarID, ok := avatarPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	_, err := h.userClient.SetCurrentUserAvatar(r.Context(), &gen.SetCurrentUserAvatarReq{
+		UserId:   userID.String(),
+		AvatarId: avatarID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// avatarPair достаёт текущего пользователя и id аватарки из пути, отвечая ошибкой при неудаче
+func avatarPair(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	avatarID, err := uuid.Parse(mux.Vars(r)["avatar_id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid avatar ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, avatarID, true
+}
+
+func mapProtoAvatarToDTO(avatar *gen.Avatar) dtoUtils.AvatarDTO {
+	id, _ := uuid.Parse(avatar.GetId())
+	createdAt, _ := time.Parse(time.RFC3339, avatar.GetCreatedAt())
+
+	var sizes map[int]string
+	if len(avatar.GetSizes()) > 0 {
+		sizes = make(map[int]string, len(avatar.GetSizes()))
+		for size, url := range avatar.GetSizes() {
+			sizes[int(size)] = url
+		}
+	}
+
+	return dtoUtils.AvatarDTO{
+		ID:        id,
+		URL:       avatar.GetUrl(),
+		Sizes:     sizes,
+		IsCurrent: avatar.GetIsCurrent(),
+		CreatedAt: createdAt,
+	}
+}
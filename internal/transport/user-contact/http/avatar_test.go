@@ -0,0 +1,150 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/http/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newAvatarRequest(method, path string, userID uuid.UUID, vars map[string]string) *http.Request {
+	request := httptest.NewRequest(method, path, nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	return mux.SetURLVars(request.WithContext(ctx), vars)
+}
+
+func TestAvatarHandler_GetUserAvatarHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	ownerID, avatarID := uuid.New(), uuid.New()
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockUserClient.EXPECT().
+		GetUserAvatarHistory(gomock.Any(), &gen.GetUserAvatarHistoryReq{UserId: ownerID.String()}).
+		Return(&gen.GetUserAvatarHistoryRes{Avatars: []*gen.Avatar{{
+			Id:        avatarID.String(),
+			Url:       "http://avatar/640",
+			Sizes:     map[int32]string{640: "http://avatar/640", 160: "http://avatar/160"},
+			IsCurrent: true,
+			CreatedAt: createdAt.Format(time.RFC3339),
+		}}}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.GetUserAvatarHistory(recorder, newAvatarRequest(http.MethodGet, "/users/"+ownerID.String()+"/avatars", uuid.New(),
+		map[string]string{"id": ownerID.String()}))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res dtoUtils.GetAvatarHistoryResponse
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&res))
+	require.Len(t, res.Avatars, 1)
+	assert.Equal(t, avatarID, res.Avatars[0].ID)
+	assert.True(t, res.Avatars[0].IsCurrent)
+	assert.Equal(t, "http://avatar/160", res.Avatars[0].Sizes[160])
+	assert.True(t, createdAt.Equal(res.Avatars[0].CreatedAt))
+}
+
+func TestAvatarHandler_GetUserAvatarHistory_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+
+	recorder := httptest.NewRecorder()
+	handler.GetUserAvatarHistory(recorder, newAvatarRequest(http.MethodGet, "/users/bad/avatars", uuid.New(),
+		map[string]string{"id": "bad"}))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestAvatarHandler_DeleteUserAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, avatarID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		DeleteUserAvatar(gomock.Any(), &gen.DeleteUserAvatarReq{UserId: userID.String(), AvatarId: avatarID.String()}).
+		Return(&emptypb.Empty{}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteUserAvatar(recorder, newAvatarRequest(http.MethodDelete, "/users/avatars/"+avatarID.String(), userID,
+		map[string]string{"avatar_id": avatarID.String()}))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestAvatarHandler_DeleteUserAvatar_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	avatarID := uuid.New()
+
+	mockUserClient.EXPECT().
+		DeleteUserAvatar(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "avatar not found"))
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteUserAvatar(recorder, newAvatarRequest(http.MethodDelete, "/users/avatars/"+avatarID.String(), uuid.New(),
+		map[string]string{"avatar_id": avatarID.String()}))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestAvatarHandler_SetCurrentUserAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, avatarID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		SetCurrentUserAvatar(gomock.Any(), &gen.SetCurrentUserAvatarReq{UserId: userID.String(), AvatarId: avatarID.String()}).
+		Return(&emptypb.Empty{}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.SetCurrentUserAvatar(recorder, newAvatarRequest(http.MethodPut, "/users/avatars/"+avatarID.String()+"/current", userID,
+		map[string]string{"avatar_id": avatarID.String()}))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestAvatarHandler_SetCurrentUserAvatar_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+
+	recorder := httptest.NewRecorder()
+	handler.SetCurrentUserAvatar(recorder, newAvatarRequest(http.MethodPut, "/users/avatars/bad/current", uuid.New(),
+		map[string]string{"avatar_id": "bad"}))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
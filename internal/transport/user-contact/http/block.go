@@ -0,0 +1,143 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+
+	BlockDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// BlockUser блокирует пользователя через gRPC
+// @Summary      Блокировка пользователя
+// @Description  Добавляет пользователя в чёрный список: он не сможет писать в диалог, начать новый диалог и добавить текущего пользователя в группы
+// @Tags         blocks
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        id   path  string  true  "ID блокируемого пользователя"
+// @Success      204   "Пользователь заблокирован"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Пользователь не найден"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/{id}/block [post]
+func (h *UserGRPCProxyHandler) BlockUser(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.BlockUser"
+
+	userID, blockedUserID, ok := blockPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	if userID == blockedUserID {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Cannot block yourself")
+		return
+	}
+
+	_, err := h.userClient.BlockUser(r.Context(), &gen.BlockUserReq{
+		UserId:        userID.String(),
+		BlockedUserId: blockedUserID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnblockUser снимает блокировку через gRPC
+// @Summary      Разблокировка пользователя
+// @Description  Удаляет пользователя из чёрного списка текущего пользователя
+// @Tags         blocks
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        id   path  string  true  "ID заблокированного пользователя"
+// @Success      204   "Блокировка снята"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Пользователь не заблокирован"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/{id}/block [delete]
+func (h *UserGRPCProxyHandler) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.UnblockUser"
+
+	userID, blockedUserID, ok := blockPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	_, err := h.userClient.UnblockUser(r.Context(), &gen.UnblockUserReq{
+		UserId:        userID.String(),
+		BlockedUserId: blockedUserID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBlockedUsers возвращает чёрный список через gRPC
+// @Summary      Список заблокированных пользователей
+// @Description  Возвращает пользователей, заблокированных текущим пользователем, начиная с последних
+// @Tags         blocks
+// @Produce      json
+// @Success      200   {array}   dto.BlockedUserDTO  "Список заблокированных пользователей"
+// @Failure      401   {object}  dto.ErrorDTO        "Неавторизованный доступ"
+// @Failure      500   {object}  dto.ErrorDTO        "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /users/blocked [get]
+func (h *UserGRPCProxyHandler) GetBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.GetBlockedUsers"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.userClient.GetBlockedUsers(r.Context(), &gen.GetBlockedUsersReq{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	blocked := make([]*BlockDTO.BlockedUserDTO, 0, len(res.Users))
+	for _, b := range res.Users {
+		blockedAt, _ := time.Parse(time.RFC3339, b.BlockedAt)
+
+		blocked = append(blocked, &BlockDTO.BlockedUserDTO{
+			User:      mapProtoUserToDTO(b.User),
+			BlockedAt: blockedAt,
+		})
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, blocked)
+}
+
+func blockPair(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	blockedUserID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, blockedUserID, true
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	BlockDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/http/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func newBlockRequest(method string, userID uuid.UUID, target string) *http.Request {
+	request := httptest.NewRequest(method, "/users/"+target+"/block", nil)
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	return mux.SetURLVars(request.WithContext(ctx), map[string]string{"id": target})
+}
+
+func TestBlockHandler_BlockUser_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		BlockUser(gomock.Any(), &gen.BlockUserReq{UserId: userID.String(), BlockedUserId: blockedID.String()}).
+		Return(&emptypb.Empty{}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.BlockUser(recorder, newBlockRequest(http.MethodPost, userID, blockedID.String()))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestBlockHandler_BlockUser_Self(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+	userID := uuid.New()
+
+	recorder := httptest.NewRecorder()
+	handler.BlockUser(recorder, newBlockRequest(http.MethodPost, userID, userID.String()))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestBlockHandler_BlockUser_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+
+	recorder := httptest.NewRecorder()
+	handler.BlockUser(recorder, newBlockRequest(http.MethodPost, uuid.New(), "bad"))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestBlockHandler_UnblockUser_NotBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		UnblockUser(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "user is not blocked"))
+
+	recorder := httptest.NewRecorder()
+	handler.UnblockUser(recorder, newBlockRequest(http.MethodDelete, uuid.New(), uuid.NewString()))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestBlockHandler_GetBlockedUsers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, blockedID := uuid.New(), uuid.New()
+	blockedAt := time.Now().UTC().Truncate(time.Second)
+
+	mockUserClient.EXPECT().
+		GetBlockedUsers(gomock.Any(), &gen.GetBlockedUsersReq{UserId: userID.String()}).
+		Return(&gen.GetBlockedUsersRes{Users: []*gen.BlockedUser{
+			{User: &gen.User{Id: blockedID.String(), Username: "spammer"}, BlockedAt: blockedAt.Format(time.RFC3339)},
+		}}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/users/blocked", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.GetBlockedUsers(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var blocked []BlockDTO.BlockedUserDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&blocked))
+	assert.Len(t, blocked, 1)
+	assert.Equal(t, blockedID, blocked[0].User.ID)
+	assert.True(t, blockedAt.Equal(blocked[0].BlockedAt))
+}
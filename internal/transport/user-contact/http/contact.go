@@ -10,6 +10,8 @@ import (
 	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
 	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 )
 
 // CreateContact создает новый контакт через gRPC
@@ -95,6 +97,7 @@ func (h *UserGRPCProxyHandler) GetContacts(w http.ResponseWriter, r *http.Reques
 		contacts = append(contacts, &ContactDTO.GetContactsDTO{
 			UserID:      userID,
 			ContactUser: mapProtoUserToDTO(convertContactToUser(c)),
+			Alias:       c.Alias,
 			CreatedAt:   createdAt,
 			UpdatedAt:   updatedAt,
 		})
@@ -148,6 +151,7 @@ func (h *UserGRPCProxyHandler) SearchContacts(w http.ResponseWriter, r *http.Req
 		contacts = append(contacts, &ContactDTO.GetContactsDTO{
 			UserID:      userID,
 			ContactUser: mapProtoUserToDTO(convertContactToUser(c)),
+			Alias:       c.Alias,
 			CreatedAt:   createdAt,
 			UpdatedAt:   updatedAt,
 		})
@@ -156,6 +160,155 @@ func (h *UserGRPCProxyHandler) SearchContacts(w http.ResponseWriter, r *http.Req
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, contacts)
 }
 
+// DeleteContact удаляет контакт через gRPC
+// @Summary      Удаление контакта
+// @Description  Удаляет пользователя из списка контактов текущего пользователя вместе с заданным ему именем
+// @Tags         contacts
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        id   path  string  true  "ID пользователя из контактов"
+// @Success      204   "Контакт удалён"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Контакт не найден"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /contacts/{id} [delete]
+func (h *UserGRPCProxyHandler) DeleteContact(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.DeleteContact"
+
+	userID, contactUserID, ok := contactPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	_, err := h.userClient.DeleteContact(r.Context(), &gen.DeleteContactReq{
+		UserId:        userID.String(),
+		ContactUserId: contactUserID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UpdateContactAlias задаёт имя контакта через gRPC
+// @Summary      Переименование контакта
+// @Description  Задаёт личное имя контакта, которое видит только текущий пользователь: в списке контактов, поиске и названиях диалогов. Пустое значение сбрасывает имя
+// @Tags         contacts
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        id     path  string                     true  "ID пользователя из контактов"
+// @Param        alias  body  dto.UpdateContactAliasDTO  true  "Имя контакта"
+// @Success      204   "Имя сохранено"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректный ID или слишком длинное имя"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Контакт не найден"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /contacts/{id} [patch]
+func (h *UserGRPCProxyHandler) UpdateContactAlias(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.UpdateContactAlias"
+
+	userID, contactUserID, ok := contactPair(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req ContactDTO.UpdateContactAliasDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	_, err := h.userClient.UpdateContactAlias(r.Context(), &gen.UpdateContactAliasReq{
+		UserId:        userID.String(),
+		ContactUserId: contactUserID.String(),
+		Alias:         req.Alias,
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportContacts импортирует адресную книгу через gRPC
+// @Summary      Импорт адресной книги
+// @Description  Сверяет номера из адресной книги устройства с зарегистрированными пользователями и добавляет найденных в контакты. Номера передаются как есть или SHA-256 нормализованного номера (+7XXXXXXXXXX) в hex. Когда зарегистрируется владелец ненайденного номера, он будет добавлен в контакты и придёт уведомление contact_joined. Число проверяемых номеров ограничено
+// @Tags         contacts
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        contacts  body  dto.ImportContactsDTO  true  "Номера из адресной книги"
+// @Success      200   {object}  dto.ImportContactsResultDTO  "Найденные пользователи"
+// @Failure      400   {object}  dto.ErrorDTO  "Пустой или слишком длинный список"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      429   {object}  dto.ErrorDTO  "Превышен лимит импорта"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /contacts/import [post]
+func (h *UserGRPCProxyHandler) ImportContacts(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.ImportContacts"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req ContactDTO.ImportContactsDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	res, err := h.userClient.ImportContacts(r.Context(), &gen.ImportContactsReq{
+		UserId:       userID.String(),
+		PhoneNumbers: req.PhoneNumbers,
+		PhoneHashes:  req.PhoneHashes,
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	result := &ContactDTO.ImportContactsResultDTO{
+		Found:   make([]*ContactDTO.ImportedContactDTO, 0, len(res.Found)),
+		Added:   int(res.Added),
+		Invalid: int(res.Invalid),
+	}
+	for _, found := range res.Found {
+		result.Found = append(result.Found, &ContactDTO.ImportedContactDTO{
+			User:      mapProtoUserToDTO(found.User),
+			PhoneHash: found.PhoneHash,
+			Added:     found.Added,
+		})
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, result)
+}
+
+func contactPair(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	contactUserID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid contact ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, contactUserID, true
+}
+
 func convertContactToUser(contact *gen.Contact) *gen.User {
 	return &gen.User{
 		Id:          contact.Id,
@@ -15,6 +15,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -355,3 +356,130 @@ func TestContactHandler_SearchContacts_GRPCError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 }
+
+func newContactRequest(method string, userID uuid.UUID, target string, body []byte) *http.Request {
+	request := httptest.NewRequest(method, "/contacts/"+target, bytes.NewBuffer(body))
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, userID.String())
+	return mux.SetURLVars(request.WithContext(ctx), map[string]string{"id": target})
+}
+
+func TestContactHandler_DeleteContact_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		DeleteContact(gomock.Any(), &gen.DeleteContactReq{UserId: userID.String(), ContactUserId: contactUserID.String()}).
+		Return(&emptypb.Empty{}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteContact(recorder, newContactRequest(http.MethodDelete, userID, contactUserID.String(), nil))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestContactHandler_DeleteContact_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		DeleteContact(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "contact not found"))
+
+	recorder := httptest.NewRecorder()
+	handler.DeleteContact(recorder, newContactRequest(http.MethodDelete, uuid.New(), uuid.NewString(), nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestContactHandler_UpdateContactAlias_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "Мама"
+
+	mockUserClient.EXPECT().
+		UpdateContactAlias(gomock.Any(), &gen.UpdateContactAliasReq{UserId: userID.String(), ContactUserId: contactUserID.String(), Alias: &alias}).
+		Return(&emptypb.Empty{}, nil)
+
+	body, _ := json.Marshal(ContactDTO.UpdateContactAliasDTO{Alias: &alias})
+	recorder := httptest.NewRecorder()
+	handler.UpdateContactAlias(recorder, newContactRequest(http.MethodPatch, userID, contactUserID.String(), body))
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestContactHandler_UpdateContactAlias_InvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateContactAlias(recorder, newContactRequest(http.MethodPatch, uuid.New(), "bad", []byte(`{"alias":"x"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestContactHandler_ImportContacts_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, foundID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		ImportContacts(gomock.Any(), &gen.ImportContactsReq{UserId: userID.String(), PhoneNumbers: []string{"+79991112233"}}).
+		Return(&gen.ImportContactsRes{
+			Found: []*gen.ImportedContact{{User: &gen.User{Id: foundID.String(), Name: "Anna"}, PhoneHash: "hash", Added: true}},
+			Added: 1,
+		}, nil)
+
+	body, _ := json.Marshal(ContactDTO.ImportContactsDTO{PhoneNumbers: []string{"+79991112233"}})
+	request := httptest.NewRequest(http.MethodPost, "/contacts/import", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.ImportContacts(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var result ContactDTO.ImportContactsResultDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&result))
+	assert.Equal(t, 1, result.Added)
+	assert.Len(t, result.Found, 1)
+	assert.Equal(t, foundID, result.Found[0].User.ID)
+}
+
+func TestContactHandler_ImportContacts_RateLimited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		ImportContacts(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.ResourceExhausted, "contact import limit exceeded, try again later"))
+
+	request := httptest.NewRequest(http.MethodPost, "/contacts/import", bytes.NewBufferString(`{"phone_numbers":["+79991112233"]}`))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.NewString()))
+
+	recorder := httptest.NewRecorder()
+	handler.ImportContacts(recorder, request)
+
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+}
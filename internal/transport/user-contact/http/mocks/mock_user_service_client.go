@@ -37,6 +37,26 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// BlockUser mocks base method.
+func (m *MockUserServiceClient) BlockUser(arg0 context.Context, arg1 *user.BlockUserReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockUserServiceClientMockRecorder) BlockUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockUserServiceClient)(nil).BlockUser), varargs...)
+}
+
 // CreateContact mocks base method.
 func (m *MockUserServiceClient) CreateContact(arg0 context.Context, arg1 *user.CreateContactReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +77,106 @@ func (mr *MockUserServiceClientMockRecorder) CreateContact(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockUserServiceClient)(nil).CreateContact), varargs...)
 }
 
+// DeleteContact mocks base method.
+func (m *MockUserServiceClient) DeleteContact(arg0 context.Context, arg1 *user.DeleteContactReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteContact", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockUserServiceClientMockRecorder) DeleteContact(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteContact), varargs...)
+}
+
+// DeleteUserAvatar mocks base method.
+func (m *MockUserServiceClient) DeleteUserAvatar(arg0 context.Context, arg1 *user.DeleteUserAvatarReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserAvatar", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserAvatar indicates an expected call of DeleteUserAvatar.
+func (mr *MockUserServiceClientMockRecorder) DeleteUserAvatar(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAvatar", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteUserAvatar), varargs...)
+}
+
+// GetBlockedPeers mocks base method.
+func (m *MockUserServiceClient) GetBlockedPeers(arg0 context.Context, arg1 *user.GetBlockedPeersReq, arg2 ...grpc.CallOption) (*user.GetBlockedPeersRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockedPeers", varargs...)
+	ret0, _ := ret[0].(*user.GetBlockedPeersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedPeers indicates an expected call of GetBlockedPeers.
+func (mr *MockUserServiceClientMockRecorder) GetBlockedPeers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedPeers", reflect.TypeOf((*MockUserServiceClient)(nil).GetBlockedPeers), varargs...)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockUserServiceClient) GetBlockedUsers(arg0 context.Context, arg1 *user.GetBlockedUsersReq, arg2 ...grpc.CallOption) (*user.GetBlockedUsersRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockedUsers", varargs...)
+	ret0, _ := ret[0].(*user.GetBlockedUsersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockUserServiceClientMockRecorder) GetBlockedUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockUserServiceClient)(nil).GetBlockedUsers), varargs...)
+}
+
+// GetContactAliases mocks base method.
+func (m *MockUserServiceClient) GetContactAliases(arg0 context.Context, arg1 *user.GetContactAliasesReq, arg2 ...grpc.CallOption) (*user.GetContactAliasesRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetContactAliases", varargs...)
+	ret0, _ := ret[0].(*user.GetContactAliasesRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactAliases indicates an expected call of GetContactAliases.
+func (mr *MockUserServiceClientMockRecorder) GetContactAliases(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactAliases", reflect.TypeOf((*MockUserServiceClient)(nil).GetContactAliases), varargs...)
+}
+
 // GetContacts mocks base method.
 func (m *MockUserServiceClient) GetContacts(arg0 context.Context, arg1 *user.GetContactsReq, arg2 ...grpc.CallOption) (*user.GetContactsRes, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +197,66 @@ func (mr *MockUserServiceClientMockRecorder) GetContacts(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockUserServiceClient)(nil).GetContacts), varargs...)
 }
 
+// GetGroupAddDenied mocks base method.
+func (m *MockUserServiceClient) GetGroupAddDenied(arg0 context.Context, arg1 *user.GetGroupAddDeniedReq, arg2 ...grpc.CallOption) (*user.GetGroupAddDeniedRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupAddDenied", varargs...)
+	ret0, _ := ret[0].(*user.GetGroupAddDeniedRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupAddDenied indicates an expected call of GetGroupAddDenied.
+func (mr *MockUserServiceClientMockRecorder) GetGroupAddDenied(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupAddDenied", reflect.TypeOf((*MockUserServiceClient)(nil).GetGroupAddDenied), varargs...)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockUserServiceClient) GetPrivacySettings(arg0 context.Context, arg1 *user.GetPrivacySettingsReq, arg2 ...grpc.CallOption) (*user.GetPrivacySettingsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPrivacySettings", varargs...)
+	ret0, _ := ret[0].(*user.GetPrivacySettingsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockUserServiceClientMockRecorder) GetPrivacySettings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockUserServiceClient)(nil).GetPrivacySettings), varargs...)
+}
+
+// GetUserAvatarHistory mocks base method.
+func (m *MockUserServiceClient) GetUserAvatarHistory(arg0 context.Context, arg1 *user.GetUserAvatarHistoryReq, arg2 ...grpc.CallOption) (*user.GetUserAvatarHistoryRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserAvatarHistory", varargs...)
+	ret0, _ := ret[0].(*user.GetUserAvatarHistoryRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAvatarHistory indicates an expected call of GetUserAvatarHistory.
+func (mr *MockUserServiceClientMockRecorder) GetUserAvatarHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAvatarHistory", reflect.TypeOf((*MockUserServiceClient)(nil).GetUserAvatarHistory), varargs...)
+}
+
 // GetUserAvatars mocks base method.
 func (m *MockUserServiceClient) GetUserAvatars(arg0 context.Context, arg1 *user.GetUserAvatarsReq, arg2 ...grpc.CallOption) (*user.GetUserAvatarsRes, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +337,66 @@ func (mr *MockUserServiceClientMockRecorder) GetUserByUsername(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserServiceClient)(nil).GetUserByUsername), varargs...)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *MockUserServiceClient) GetUsersByIDs(arg0 context.Context, arg1 *user.GetUsersByIDsReq, arg2 ...grpc.CallOption) (*user.GetUsersByIDsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsersByIDs", varargs...)
+	ret0, _ := ret[0].(*user.GetUsersByIDsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserServiceClientMockRecorder) GetUsersByIDs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserServiceClient)(nil).GetUsersByIDs), varargs...)
+}
+
+// ImportContacts mocks base method.
+func (m *MockUserServiceClient) ImportContacts(arg0 context.Context, arg1 *user.ImportContactsReq, arg2 ...grpc.CallOption) (*user.ImportContactsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportContacts", varargs...)
+	ret0, _ := ret[0].(*user.ImportContactsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportContacts indicates an expected call of ImportContacts.
+func (mr *MockUserServiceClientMockRecorder) ImportContacts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportContacts", reflect.TypeOf((*MockUserServiceClient)(nil).ImportContacts), varargs...)
+}
+
+// ResolveUsername mocks base method.
+func (m *MockUserServiceClient) ResolveUsername(arg0 context.Context, arg1 *user.ResolveUsernameReq, arg2 ...grpc.CallOption) (*user.ResolveUsernameRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveUsername", varargs...)
+	ret0, _ := ret[0].(*user.ResolveUsernameRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveUsername indicates an expected call of ResolveUsername.
+func (mr *MockUserServiceClientMockRecorder) ResolveUsername(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUsername", reflect.TypeOf((*MockUserServiceClient)(nil).ResolveUsername), varargs...)
+}
+
 // SearchContacts mocks base method.
 func (m *MockUserServiceClient) SearchContacts(arg0 context.Context, arg1 *user.SearchContactsReq, arg2 ...grpc.CallOption) (*user.SearchContactsRes, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +417,126 @@ func (mr *MockUserServiceClientMockRecorder) SearchContacts(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContacts", reflect.TypeOf((*MockUserServiceClient)(nil).SearchContacts), varargs...)
 }
 
+// SearchUsers mocks base method.
+func (m *MockUserServiceClient) SearchUsers(arg0 context.Context, arg1 *user.SearchUsersReq, arg2 ...grpc.CallOption) (*user.SearchUsersRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchUsers", varargs...)
+	ret0, _ := ret[0].(*user.SearchUsersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockUserServiceClientMockRecorder) SearchUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserServiceClient)(nil).SearchUsers), varargs...)
+}
+
+// SetCurrentUserAvatar mocks base method.
+func (m *MockUserServiceClient) SetCurrentUserAvatar(arg0 context.Context, arg1 *user.SetCurrentUserAvatarReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCurrentUserAvatar", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCurrentUserAvatar indicates an expected call of SetCurrentUserAvatar.
+func (mr *MockUserServiceClientMockRecorder) SetCurrentUserAvatar(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentUserAvatar", reflect.TypeOf((*MockUserServiceClient)(nil).SetCurrentUserAvatar), varargs...)
+}
+
+// UnblockUser mocks base method.
+func (m *MockUserServiceClient) UnblockUser(arg0 context.Context, arg1 *user.UnblockUserReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnblockUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockUserServiceClientMockRecorder) UnblockUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockUserServiceClient)(nil).UnblockUser), varargs...)
+}
+
+// UpdateContactAlias mocks base method.
+func (m *MockUserServiceClient) UpdateContactAlias(arg0 context.Context, arg1 *user.UpdateContactAliasReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateContactAlias", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContactAlias indicates an expected call of UpdateContactAlias.
+func (mr *MockUserServiceClientMockRecorder) UpdateContactAlias(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContactAlias", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateContactAlias), varargs...)
+}
+
+// UpdateLastSeen mocks base method.
+func (m *MockUserServiceClient) UpdateLastSeen(arg0 context.Context, arg1 *user.UpdateLastSeenReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateLastSeen", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockUserServiceClientMockRecorder) UpdateLastSeen(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockUserServiceClient)(nil).UpdateLastSeen), varargs...)
+}
+
+// UpdatePrivacySetting mocks base method.
+func (m *MockUserServiceClient) UpdatePrivacySetting(arg0 context.Context, arg1 *user.UpdatePrivacySettingReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdatePrivacySetting", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePrivacySetting indicates an expected call of UpdatePrivacySetting.
+func (mr *MockUserServiceClientMockRecorder) UpdatePrivacySetting(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySetting", reflect.TypeOf((*MockUserServiceClient)(nil).UpdatePrivacySetting), varargs...)
+}
+
 // UpdateUserInfo mocks base method.
 func (m *MockUserServiceClient) UpdateUserInfo(arg0 context.Context, arg1 *user.UpdateUserInfoReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -216,3 +576,43 @@ func (mr *MockUserServiceClientMockRecorder) UploadUserAvatar(arg0, arg1 interfa
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUserAvatar", reflect.TypeOf((*MockUserServiceClient)(nil).UploadUserAvatar), varargs...)
 }
+
+// UserPhoneChanged mocks base method.
+func (m *MockUserServiceClient) UserPhoneChanged(arg0 context.Context, arg1 *user.UserPhoneChangedReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UserPhoneChanged", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserPhoneChanged indicates an expected call of UserPhoneChanged.
+func (mr *MockUserServiceClientMockRecorder) UserPhoneChanged(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPhoneChanged", reflect.TypeOf((*MockUserServiceClient)(nil).UserPhoneChanged), varargs...)
+}
+
+// UserRegistered mocks base method.
+func (m *MockUserServiceClient) UserRegistered(arg0 context.Context, arg1 *user.UserRegisteredReq, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UserRegistered", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserRegistered indicates an expected call of UserRegistered.
+func (mr *MockUserServiceClientMockRecorder) UserRegistered(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRegistered", reflect.TypeOf((*MockUserServiceClient)(nil).UserRegistered), varargs...)
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	PrivacyDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// GetPrivacySettings возвращает настройки приватности через gRPC
+// @Summary      Настройки приватности
+// @Description  Возвращает, кто видит номер телефона, аватар, время последнего входа и bio текущего пользователя и кто может добавлять его в группы
+// @Tags         privacy
+// @Produce      json
+// @Success      200   {array}   dto.PrivacyRuleDTO  "Настройки приватности"
+// @Failure      401   {object}  dto.ErrorDTO        "Неавторизованный доступ"
+// @Failure      500   {object}  dto.ErrorDTO        "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /me/privacy [get]
+func (h *UserGRPCProxyHandler) GetPrivacySettings(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.GetPrivacySettings"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	res, err := h.userClient.GetPrivacySettings(r.Context(), &gen.GetPrivacySettingsReq{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	rules := make([]*PrivacyDTO.PrivacyRuleDTO, 0, len(res.Rules))
+	for _, rule := range res.Rules {
+		rules = append(rules, &PrivacyDTO.PrivacyRuleDTO{
+			Setting:      rule.Setting,
+			Visibility:   rule.Visibility,
+			AllowUserIDs: parseUUIDList(rule.AllowUserIds),
+			DenyUserIDs:  parseUUIDList(rule.DenyUserIds),
+		})
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, rules)
+}
+
+// UpdatePrivacySetting изменяет настройку приватности через gRPC
+// @Summary      Изменить настройку приватности
+// @Description  Задаёт, кому разрешено: everybody, contacts или nobody. Исключения заменяют прежние; запрет сильнее разрешения
+// @Tags         privacy
+// @Accept       json
+// @Produce      json
+// @Param X-CSRF-Token header string true "CSRF Token"
+// @Param        setting  path  string                      true  "phone_number, avatar, last_seen, bio, group_add или birthday"
+// @Param        rule     body  dto.UpdatePrivacyRuleDTO    true  "Правило"
+// @Success      204   "Настройка сохранена"
+// @Failure      400   {object}  dto.ErrorDTO  "Некорректное правило"
+// @Failure      401   {object}  dto.ErrorDTO  "Неавторизованный доступ"
+// @Failure      404   {object}  dto.ErrorDTO  "Пользователь из исключений не найден"
+// @Failure      500   {object}  dto.ErrorDTO  "Внутренняя ошибка сервера"
+// @Security     ApiKeyAuth
+// @Router       /me/privacy/{setting} [put]
+func (h *UserGRPCProxyHandler) UpdatePrivacySetting(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.UpdatePrivacySetting"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req PrivacyDTO.UpdatePrivacyRuleDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	_, err = h.userClient.UpdatePrivacySetting(r.Context(), &gen.UpdatePrivacySettingReq{
+		UserId: userID.String(),
+		Rule: &gen.PrivacyRule{
+			Setting:      mux.Vars(r)["setting"],
+			Visibility:   req.Visibility,
+			AllowUserIds: formatUUIDList(req.AllowUserIDs),
+			DenyUserIds:  formatUUIDList(req.DenyUserIDs),
+		},
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseUUIDList(values []string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		if id, err := uuid.Parse(value); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func formatUUIDList(ids []uuid.UUID) []string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return values
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	PrivacyDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/http/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestPrivacyHandler_GetPrivacySettings_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID := uuid.New()
+
+	mockUserClient.EXPECT().
+		GetPrivacySettings(gomock.Any(), &gen.GetPrivacySettingsReq{UserId: userID.String()}).
+		Return(&gen.GetPrivacySettingsRes{Rules: []*gen.PrivacyRule{
+			{Setting: "phone_number", Visibility: "contacts"},
+		}}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/me/privacy", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.GetPrivacySettings(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var rules []PrivacyDTO.PrivacyRuleDTO
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&rules))
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "contacts", rules[0].Visibility)
+	assert.NotNil(t, rules[0].AllowUserIDs)
+}
+
+func TestPrivacyHandler_UpdatePrivacySetting_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID, friendID := uuid.New(), uuid.New()
+
+	mockUserClient.EXPECT().
+		UpdatePrivacySetting(gomock.Any(), &gen.UpdatePrivacySettingReq{
+			UserId: userID.String(),
+			Rule: &gen.PrivacyRule{
+				Setting:      "group_add",
+				Visibility:   "nobody",
+				AllowUserIds: []string{friendID.String()},
+				DenyUserIds:  []string{},
+			},
+		}).
+		Return(&emptypb.Empty{}, nil)
+
+	body := `{"visibility":"nobody","allow_user_ids":["` + friendID.String() + `"]}`
+	request := httptest.NewRequest(http.MethodPut, "/me/privacy/group_add", strings.NewReader(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+	request = mux.SetURLVars(request, map[string]string{"setting": "group_add"})
+
+	recorder := httptest.NewRecorder()
+	handler.UpdatePrivacySetting(recorder, request)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestPrivacyHandler_UpdatePrivacySetting_InvalidRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		UpdatePrivacySetting(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid privacy rule"))
+
+	request := httptest.NewRequest(http.MethodPut, "/me/privacy/email", strings.NewReader(`{"visibility":"nobody"}`))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.NewString()))
+	request = mux.SetURLVars(request, map[string]string{"setting": "email"})
+
+	recorder := httptest.NewRecorder()
+	handler.UpdatePrivacySetting(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestPrivacyHandler_UpdatePrivacySetting_InvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewUserGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl))
+
+	request := httptest.NewRequest(http.MethodPut, "/me/privacy/bio", strings.NewReader(`{`))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.NewString()))
+
+	recorder := httptest.NewRecorder()
+	handler.UpdatePrivacySetting(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	contextUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/context"
+	grpcUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/grpc"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveGRPCProxyHandler открывает публичные ссылки вида /resolve/{handle}.
+// Пространство handle общее у пользователей, групп и каналов, поэтому нужны оба сервиса
+type ResolveGRPCProxyHandler struct {
+	userClient  gen.UserServiceClient
+	chatsClient chatsGen.ChatServiceClient
+}
+
+func NewResolveGRPCProxyHandler(userClient gen.UserServiceClient, chatsClient chatsGen.ChatServiceClient) *ResolveGRPCProxyHandler {
+	return &ResolveGRPCProxyHandler{
+		userClient:  userClient,
+		chatsClient: chatsClient,
+	}
+}
+
+// Resolve находит владельца публичного handle
+// @Summary      Открыть ссылку по handle
+// @Description  Возвращает пользователя, публичную группу или канал по handle (без учёта регистра, можно с @). После переименования прежний handle ещё 14 дней ведёт на владельца - тогда redirected = true
+// @Tags         user
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        handle  path      string             true  "Публичный handle"
+// @Success      200     {object}  dto.ResolveResult  "Владелец handle"
+// @Failure      400     {object}  dto.ErrorDTO       "Некорректный handle"
+// @Failure      401     {object}  dto.ErrorDTO       "Неавторизованный доступ"
+// @Failure      404     {object}  dto.ErrorDTO       "Handle никому не принадлежит"
+// @Router       /resolve/{handle} [get]
+func (h *ResolveGRPCProxyHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	const op = "ResolveGRPCProxyHandler.Resolve"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	handle := strings.TrimPrefix(mux.Vars(r)["handle"], "@")
+	if !validation.ValidateUsername(handle) {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "invalid handle")
+		return
+	}
+
+	userRes, err := h.userClient.ResolveUsername(r.Context(), &gen.ResolveUsernameReq{Username: handle})
+	if err == nil {
+		utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, UserDTO.ResolveResult{
+			Type:       UserDTO.ResolvedUser,
+			Redirected: userRes.GetRedirected(),
+			User:       mapProtoUserToDTO(userRes.GetUser()),
+		})
+		return
+	}
+	if status.Code(err) != codes.NotFound {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	chatRes, err := h.chatsClient.ResolveChatUsername(r.Context(), &chatsGen.ResolveChatUsernameReq{
+		UserId:   userID.String(),
+		Username: handle,
+	})
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	chat := chatRes.GetChat()
+	chatID, _ := uuid.Parse(chat.GetId())
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, UserDTO.ResolveResult{
+		Type:       chat.GetType(),
+		Redirected: chatRes.GetRedirected(),
+		Chat: &dtoChats.PublicChatDTO{
+			ID:           chatID,
+			Type:         chat.GetType(),
+			Name:         chat.GetName(),
+			Description:  chat.GetDescription(),
+			Username:     chat.GetUsername(),
+			MembersCount: int(chat.GetMembersCount()),
+			IsMember:     chat.GetIsMember(),
+		},
+	})
+}
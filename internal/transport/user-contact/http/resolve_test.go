@@ -0,0 +1,142 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	chatsMocks "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/chats-message/http/mocks"
+	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	chatsGen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/chats"
+	gen "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/generated/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/user-contact/http/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newResolveRequest(handle string, userID uuid.UUID) *http.Request {
+	request := httptest.NewRequest(http.MethodGet, "/resolve/"+handle, nil)
+	request = mux.SetURLVars(request, map[string]string{"handle": handle})
+	return request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+}
+
+func TestResolveHandler_User(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewResolveGRPCProxyHandler(mockUserClient, chatsMocks.NewMockChatServiceClient(ctrl))
+
+	targetID := uuid.New()
+
+	mockUserClient.EXPECT().
+		ResolveUsername(gomock.Any(), &gen.ResolveUsernameReq{Username: "Old_Name"}).
+		Return(&gen.ResolveUsernameRes{
+			User:       &gen.User{Id: targetID.String(), Name: "Test", Username: "new_name", AccountType: "user"},
+			Redirected: true,
+		}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.Resolve(recorder, newResolveRequest("@Old_Name", uuid.New()))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var result UserDTO.ResolveResult
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&result))
+	assert.Equal(t, UserDTO.ResolvedUser, result.Type)
+	assert.True(t, result.Redirected)
+	assert.Equal(t, targetID, result.User.ID)
+	assert.Equal(t, "new_name", result.User.Username)
+	assert.Nil(t, result.Chat)
+}
+
+func TestResolveHandler_Channel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	mockChatsClient := chatsMocks.NewMockChatServiceClient(ctrl)
+	handler := NewResolveGRPCProxyHandler(mockUserClient, mockChatsClient)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockUserClient.EXPECT().
+		ResolveUsername(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+	mockChatsClient.EXPECT().
+		ResolveChatUsername(gomock.Any(), &chatsGen.ResolveChatUsernameReq{UserId: userID.String(), Username: "daily_news"}).
+		Return(&chatsGen.ResolveChatUsernameRes{
+			Chat: &chatsGen.PublicChat{Id: chatID.String(), Type: "channel", Name: "News", Username: "daily_news", MembersCount: 7},
+		}, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.Resolve(recorder, newResolveRequest("daily_news", userID))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var result UserDTO.ResolveResult
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&result))
+	assert.Equal(t, "channel", result.Type)
+	assert.False(t, result.Redirected)
+	assert.Nil(t, result.User)
+	assert.Equal(t, chatID, result.Chat.ID)
+	assert.Equal(t, 7, result.Chat.MembersCount)
+}
+
+func TestResolveHandler_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	mockChatsClient := chatsMocks.NewMockChatServiceClient(ctrl)
+	handler := NewResolveGRPCProxyHandler(mockUserClient, mockChatsClient)
+
+	mockUserClient.EXPECT().
+		ResolveUsername(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+	mockChatsClient.EXPECT().
+		ResolveChatUsername(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.NotFound, "chat not found"))
+
+	recorder := httptest.NewRecorder()
+	handler.Resolve(recorder, newResolveRequest("ghost_name", uuid.New()))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestResolveHandler_InvalidHandle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := NewResolveGRPCProxyHandler(mocks.NewMockUserServiceClient(ctrl), chatsMocks.NewMockChatServiceClient(ctrl))
+
+	recorder := httptest.NewRecorder()
+	handler.Resolve(recorder, newResolveRequest("no", uuid.New()))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestResolveHandler_UserServiceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewResolveGRPCProxyHandler(mockUserClient, chatsMocks.NewMockChatServiceClient(ctrl))
+
+	mockUserClient.EXPECT().
+		ResolveUsername(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unavailable, "unavailable"))
+
+	recorder := httptest.NewRecorder()
+	handler.Resolve(recorder, newResolveRequest("someone", uuid.New()))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
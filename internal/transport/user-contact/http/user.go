@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
@@ -128,9 +129,81 @@ func (h *UserGRPCProxyHandler) GetUserByUsername(w http.ResponseWriter, r *http.
 	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, user)
 }
 
+// SearchUsers выполняет глобальный поиск людей через gRPC
+// @Summary      Глобальный поиск людей
+// @Description  Ищет среди всех пользователей по префиксу username (можно с @) и имени. Контакты и участники общих групп идут первыми, заблокированные не показываются, скрытые настройками приватности поля пустые
+// @Tags         user
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        query   query     string  true   "Поисковый запрос, не короче 2 символов"
+// @Param        offset  query     int     false  "Смещение (по умолчанию 0)"
+// @Param        limit   query     int     false  "Размер страницы (по умолчанию 20, не больше 50)"
+// @Success      200  {object}  dto.SearchUsersResult  "Страница найденных пользователей"
+// @Failure      400  {object}  dto.ErrorDTO           "Неверный запрос"
+// @Failure      401  {object}  dto.ErrorDTO           "Неавторизованный доступ"
+// @Failure      500  {object}  dto.ErrorDTO           "Внутренняя ошибка сервера"
+// @Router       /users/search [get]
+func (h *UserGRPCProxyHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	const op = "UserGRPCProxyHandler.SearchUsers"
+
+	userID, err := contextUtils.GetUserIDFromContext(r)
+	if err != nil {
+		utils.SendError(r.Context(), op, w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	queryValues := r.URL.Query()
+	query := queryValues.Get("query")
+	if query == "" {
+		utils.SendError(r.Context(), op, w, http.StatusBadRequest, "query parameter is required")
+		return
+	}
+
+	req := &gen.SearchUsersReq{
+		UserId: userID.String(),
+		Query:  query,
+	}
+
+	if offsetStr := queryValues.Get("offset"); offsetStr != "" {
+		offset, err := strconv.ParseInt(offsetStr, 10, 32)
+		if err != nil {
+			utils.SendError(r.Context(), op, w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		parsed := int32(offset)
+		req.Offset = &parsed
+	}
+
+	if limitStr := queryValues.Get("limit"); limitStr != "" {
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil {
+			utils.SendError(r.Context(), op, w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		parsed := int32(limit)
+		req.Limit = &parsed
+	}
+
+	res, err := h.userClient.SearchUsers(r.Context(), req)
+	if err != nil {
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
+		return
+	}
+
+	users := make([]*UserDTO.User, 0, len(res.Users))
+	for _, user := range res.Users {
+		users = append(users, mapProtoUserToDTO(user))
+	}
+
+	utils.SendJSONResponse(r.Context(), op, w, http.StatusOK, UserDTO.SearchUsersResult{
+		Users: users,
+		Total: int(res.Total),
+	})
+}
+
 // UpdateUserInfo обновляет информацию о пользователе через gRPC
 // @Summary      Обновить информацию о пользователе
-// @Description  Обновляет имя, username или bio текущего пользователя
+// @Description  Обновляет имя, username, bio, статус, день рождения или ссылки текущего пользователя. Статус с пустыми эмодзи и текстом сбрасывается, пустая строка в birthday удаляет день рождения, links заменяет список целиком
 // @Tags         user
 // @Accept       json
 // @Produce      json
@@ -168,6 +241,25 @@ func (h *UserGRPCProxyHandler) UpdateUserInfo(w http.ResponseWriter, r *http.Req
 	if req.Bio != nil {
 		grpcReq.Bio = req.Bio
 	}
+	if req.Status != nil {
+		grpcReq.Status = &gen.UserStatus{
+			Emoji: req.Status.Emoji,
+			Text:  req.Status.Text,
+		}
+		if req.Status.ExpiresAt != nil {
+			expiresAt := req.Status.ExpiresAt.Format(time.RFC3339)
+			grpcReq.Status.ExpiresAt = &expiresAt
+		}
+	}
+	if req.Birthday != nil {
+		grpcReq.Birthday = req.Birthday
+	}
+	if req.Links != nil {
+		grpcReq.Links = &gen.ProfileLinks{}
+		for _, link := range *req.Links {
+			grpcReq.Links.Links = append(grpcReq.Links.Links, &gen.ProfileLink{Title: link.Title, Url: link.URL})
+		}
+	}
 
 	_, err = h.userClient.UpdateUserInfo(r.Context(), grpcReq)
 	if err != nil {
@@ -186,9 +278,9 @@ func (h *UserGRPCProxyHandler) UpdateUserInfo(w http.ResponseWriter, r *http.Req
 // @Produce      json
 // @Param X-CSRF-Token header string true "CSRF Token"
 // @Security     ApiKeyAuth
-// @Param        avatar formData file true "Файл аватара"
+// @Param        avatar formData file true "Файл аватара (JPEG или PNG, сторона не меньше 100px)"
 // @Success      200  {object}  map[string]string  "URL загруженного аватара"
-// @Failure      400  {object}  dto.ErrorDTO      "Неверный формат запроса"
+// @Failure      400  {object}  dto.ErrorDTO      "Неверный формат запроса или изображения"
 // @Failure      401  {object}  dto.ErrorDTO      "Неавторизованный доступ"
 // @Router       /user/avatar [post]
 func (h *UserGRPCProxyHandler) UploadUserAvatar(w http.ResponseWriter, r *http.Request) {
@@ -230,7 +322,7 @@ func (h *UserGRPCProxyHandler) UploadUserAvatar(w http.ResponseWriter, r *http.R
 	})
 	if err != nil {
 		logger.WithError(err).Error("failed to upload avatar")
-		utils.SendError(r.Context(), op, w, http.StatusInternalServerError, err.Error())
+		grpcUtils.HandleGRPCError(r.Context(), op, w, err)
 		return
 	}
 
@@ -293,7 +385,7 @@ func mapProtoUserToDTO(protoUser *gen.User) *UserDTO.User {
 
 	userID, _ := uuid.Parse(protoUser.GetId())
 
-	return &UserDTO.User{
+	user := &UserDTO.User{
 		ID:          userID,
 		PhoneNumber: protoUser.PhoneNumber,
 		Name:        protoUser.Name,
@@ -303,4 +395,29 @@ func mapProtoUserToDTO(protoUser *gen.User) *UserDTO.User {
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
+
+	if protoUser.Status != nil {
+		user.Status = &UserDTO.StatusDTO{
+			Emoji: protoUser.Status.Emoji,
+			Text:  protoUser.Status.Text,
+		}
+		if protoUser.Status.ExpiresAt != nil {
+			if expiresAt, err := time.Parse(time.RFC3339, *protoUser.Status.ExpiresAt); err == nil {
+				user.Status.ExpiresAt = &expiresAt
+			}
+		}
+	}
+	if protoUser.Birthday != "" {
+		user.Birthday = &protoUser.Birthday
+	}
+	if protoUser.LastSeenAt != "" {
+		if lastSeenAt, err := time.Parse(time.RFC3339, protoUser.LastSeenAt); err == nil {
+			user.LastSeenAt = &lastSeenAt
+		}
+	}
+	for _, link := range protoUser.Links {
+		user.Links = append(user.Links, UserDTO.LinkDTO{Title: link.Title, URL: link.Url})
+	}
+
+	return user
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
@@ -17,6 +18,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -137,6 +139,62 @@ func TestUserHandler_GetCurrentUser_Unauthorized(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 }
 
+func TestUserHandler_SearchUsers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID := uuid.New()
+	foundID := uuid.New()
+	offset, limit := int32(20), int32(10)
+
+	mockUserClient.EXPECT().
+		SearchUsers(gomock.Any(), &gen.SearchUsersReq{UserId: userID.String(), Query: "ivan", Offset: &offset, Limit: &limit}).
+		Return(&gen.SearchUsersRes{
+			Users: []*gen.User{{Id: foundID.String(), Name: "Ivan", Username: "ivan"}},
+			Total: 21,
+		}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/users/search?query=ivan&offset=20&limit=10", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.SearchUsers(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var response UserDTO.SearchUsersResult
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 21, response.Total)
+	assert.Len(t, response.Users, 1)
+	assert.Equal(t, foundID, response.Users[0].ID)
+}
+
+func TestUserHandler_SearchUsers_InvalidQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID := uuid.New()
+
+	mockUserClient.EXPECT().
+		SearchUsers(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "query must be at least 2 characters"))
+
+	request := httptest.NewRequest(http.MethodGet, "/users/search?query=i", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.SearchUsers(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
 func TestUserHandler_GetUserByUsername_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -223,6 +281,108 @@ func TestUserHandler_UpdateUserInfo_Unauthorized(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 }
 
+func TestUserHandler_UpdateUserInfo_Profile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID := uuid.New()
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	birthday := ""
+
+	mockUserClient.EXPECT().
+		UpdateUserInfo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *gen.UpdateUserInfoReq, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			assert.Equal(t, userID.String(), req.UserId)
+			assert.Equal(t, "🌴", req.Status.Emoji)
+			assert.Equal(t, "2030-01-02T03:04:05Z", req.Status.GetExpiresAt())
+			assert.Equal(t, "", req.GetBirthday())
+			assert.NotNil(t, req.Birthday)
+			assert.Len(t, req.Links.Links, 1)
+			assert.Equal(t, "https://github.com/user", req.Links.Links[0].Url)
+			return &emptypb.Empty{}, nil
+		})
+
+	links := []UserDTO.LinkDTO{{Title: "GitHub", URL: "https://github.com/user"}}
+	body, _ := json.Marshal(UserDTO.UpdateUserInfo{
+		Status:   &UserDTO.StatusDTO{Emoji: "🌴", ExpiresAt: &expiresAt},
+		Birthday: &birthday,
+		Links:    &links,
+	})
+	request := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateUserInfo(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestUserHandler_UpdateUserInfo_InvalidProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		UpdateUserInfo(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "birthday must be a past date in YYYY-MM-DD format"))
+
+	birthday := "2999-01-01"
+	body, _ := json.Marshal(UserDTO.UpdateUserInfo{Birthday: &birthday})
+	request := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBuffer(body))
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String()))
+
+	recorder := httptest.NewRecorder()
+	handler.UpdateUserInfo(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestUserHandler_GetCurrentUser_Profile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	userID := uuid.New()
+	expiresAt := "2030-01-02T03:04:05Z"
+
+	mockUserClient.EXPECT().
+		GetUserById(gomock.Any(), &gen.GetUserByIdReq{UserId: userID.String()}).
+		Return(&gen.GetUserByIdRes{
+			User: &gen.User{
+				Id:         userID.String(),
+				Name:       "Test User",
+				Status:     &gen.UserStatus{Emoji: "🌴", Text: "On vacation", ExpiresAt: &expiresAt},
+				Birthday:   "1995-03-14",
+				Links:      []*gen.ProfileLink{{Title: "GitHub", Url: "https://github.com/user"}},
+				LastSeenAt: "2025-11-02T18:30:00Z",
+				CreatedAt:  "2024-01-01T00:00:00Z",
+				UpdatedAt:  "2024-01-01T00:00:00Z",
+			},
+		}, nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/me", nil)
+	request = request.WithContext(context.WithValue(request.Context(), domains.UserIDKey{}, userID.String()))
+
+	recorder := httptest.NewRecorder()
+	handler.GetCurrentUser(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var user UserDTO.User
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&user))
+	assert.Equal(t, "On vacation", user.Status.Text)
+	assert.True(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC).Equal(*user.Status.ExpiresAt))
+	assert.Equal(t, "1995-03-14", *user.Birthday)
+	assert.Equal(t, []UserDTO.LinkDTO{{Title: "GitHub", URL: "https://github.com/user"}}, user.Links)
+	assert.True(t, time.Date(2025, 11, 2, 18, 30, 0, 0, time.UTC).Equal(*user.LastSeenAt))
+}
+
 func TestUserHandler_GetUserAvatars_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -351,3 +511,31 @@ func TestUserHandler_UploadUserAvatar_GRPCError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 }
+
+func TestUserHandler_UploadUserAvatar_InvalidImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	handler := NewUserGRPCProxyHandler(mockUserClient)
+
+	mockUserClient.EXPECT().
+		UploadUserAvatar(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.InvalidArgument, "invalid image"))
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("avatar", "avatar.jpg")
+	part.Write([]byte("fake image data"))
+	writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/user/avatar", body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	ctx := context.WithValue(request.Context(), domains.UserIDKey{}, uuid.New().String())
+	request = request.WithContext(ctx)
+
+	recorder := httptest.NewRecorder()
+	handler.UploadUserAvatar(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,15 @@
+package user
+
+import (
+	"context"
+
+	PrivacyDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=privacy_interface.go -destination=../../usecase/mocks/mock_privacy_usecase.go -package=mocks IPrivacyUsecase
+type IPrivacyUsecase interface {
+	GetPrivacySettings(ctx context.Context, userID uuid.UUID) ([]*PrivacyDTO.PrivacyRuleDTO, error)
+	UpdatePrivacySetting(ctx context.Context, userID uuid.UUID, rule *PrivacyDTO.PrivacyRuleDTO) error
+	GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error)
+}
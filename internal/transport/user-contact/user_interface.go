@@ -2,8 +2,11 @@ package user
 
 import (
 	"context"
+	"time"
 
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	"github.com/google/uuid"
 )
 
@@ -12,7 +15,16 @@ type IUserUsecase interface {
 	GetUserById(ctx context.Context, id uuid.UUID) (*UserDTO.User, error)
 	GetUserByPhone(ctx context.Context, phone string) (*UserDTO.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*UserDTO.User, error)
+	ResolveUsername(ctx context.Context, username string) (*UserDTO.User, bool, error)
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*UserDTO.User, error)
 	UploadUserAvatar(ctx context.Context, userID uuid.UUID, data []byte, filename, contentType string) (string, error)
-	UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error
+	GetUserAvatarHistory(ctx context.Context, ownerID uuid.UUID) ([]dtoUtils.AvatarDTO, error)
+	DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error
+	SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error
+	UpdateUserInfo(ctx context.Context, userID uuid.UUID, update UserModels.InfoUpdate) error
 	GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]*string, error)
+	SearchUsers(ctx context.Context, viewerID uuid.UUID, query string, offset, limit int) (*UserDTO.SearchUsersResult, error)
+	IndexUser(ctx context.Context, userID uuid.UUID) error
+	HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error
+	UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error
 }
@@ -24,7 +24,7 @@ func SendError(ctx context.Context, op string, w http.ResponseWriter, status int
 		"status":    status,
 	})
 
-	resp, err := json.Marshal(dto.ErrorDTO{Message: message})
+	resp, err := json.Marshal(dto.ErrorDTO{Message: message, RequestID: domains.GetRequestID(ctx)})
 	if err != nil {
 		logger.Errorf("failed to marshal response: %s", err.Error())
 		return
@@ -160,6 +160,8 @@ func HandleGRPCError(ctx context.Context, w http.ResponseWriter, err error, op s
 		SendJSONError(ctx, w, http.StatusNotFound, st.Message())
 	case codes.InvalidArgument:
 		SendJSONError(ctx, w, http.StatusBadRequest, st.Message())
+	case codes.PermissionDenied:
+		SendJSONError(ctx, w, http.StatusForbidden, st.Message())
 	default:
 		logger.WithError(err).Error(op + ": unexpected gRPC status code")
 		SendJSONError(ctx, w, http.StatusInternalServerError, "internal server error")
@@ -170,7 +172,7 @@ func SendJSONError(ctx context.Context, w http.ResponseWriter, statusCode int, m
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
-	resp, err := json.Marshal(dto.ErrorDTO{Message: message})
+	resp, err := json.Marshal(dto.ErrorDTO{Message: message, RequestID: domains.GetRequestID(ctx)})
 	if err != nil {
 		domains.GetLogger(ctx).Error("failed to marshal response: ", err.Error())
 		return
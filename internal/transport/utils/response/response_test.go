@@ -2,13 +2,17 @@ package response
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -209,3 +213,16 @@ func TestSendJSONError(t *testing.T) {
 		})
 	}
 }
+
+func TestSendError_EchoesRequestID(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := context.WithValue(context.Background(), domains.ContextKeyLogger{},
+		logrus.NewEntry(logrus.New()).WithField(domains.RequestIDField, "req-42"))
+
+	SendError(ctx, "TestOp", w, http.StatusBadRequest, "invalid input")
+
+	var body dto.ErrorDTO
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "invalid input", body.Message)
+	assert.Equal(t, "req-42", body.RequestID)
+}
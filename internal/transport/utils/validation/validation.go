@@ -1,11 +1,15 @@
 package validation
 
 import (
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	AuthModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 )
@@ -171,6 +175,41 @@ func ValidateName(name string) bool {
 	return true
 }
 
+// ValidateStatus проверяет длину эмодзи и текста статуса; пустые значения означают сброс статуса
+func ValidateStatus(emoji, text string) bool {
+	return utf8.RuneCountInString(emoji) <= UserModels.MaxStatusEmojiLength &&
+		utf8.RuneCountInString(text) <= UserModels.MaxStatusTextLength
+}
+
+// ValidateProfileLink проверяет ссылку профиля: непустое название и абсолютный http(s) адрес
+func ValidateProfileLink(title, rawURL string) bool {
+	titleLength := utf8.RuneCountInString(strings.TrimSpace(title))
+	if titleLength == 0 || titleLength > UserModels.MaxLinkTitleLength {
+		return false
+	}
+	if len(rawURL) > UserModels.MaxLinkURLLength {
+		return false
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// ParseBirthday разбирает день рождения в формате YYYY-MM-DD; дата не может быть в будущем
+func ParseBirthday(value string, now time.Time) (time.Time, bool) {
+	birthday, err := time.Parse(UserModels.BirthdayLayout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if birthday.Year() < UserModels.MinBirthdayYear || birthday.After(now) {
+		return time.Time{}, false
+	}
+	return birthday, true
+}
+
 // ValidImageType проверяет, является ли Content-Type допустимым типом изображения
 func ValidImageType(contentType string) bool {
 	validTypes := map[string]bool{
@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	AuthModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
@@ -262,6 +264,70 @@ func TestValidateName(t *testing.T) {
 	}
 }
 
+func TestValidateStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		text  string
+		want  bool
+	}{
+		{"empty resets status", "", "", true},
+		{"emoji and text", "🌴", "в отпуске", true},
+		{"emoji with modifiers", "👍🏽", "", true},
+		{"emoji too long", "🌴🌴🌴🌴🌴🌴🌴🌴🌴", "", false},
+		{"70 runes", "", strings.Repeat("ы", 70), true},
+		{"71 runes", "", strings.Repeat("ы", 71), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateStatus(tt.emoji, tt.text); got != tt.want {
+				t.Errorf("ValidateStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateProfileLink(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		url   string
+		want  bool
+	}{
+		{"valid", "GitHub", "https://github.com/undefined", true},
+		{"http", "Сайт", "http://example.com", true},
+		{"empty title", " ", "https://example.com", false},
+		{"title too long", strings.Repeat("a", 33), "https://example.com", false},
+		{"relative url", "Site", "/profile", false},
+		{"javascript scheme", "Site", "javascript:alert(1)", false},
+		{"url too long", "Site", "https://example.com/" + strings.Repeat("a", 250), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateProfileLink(tt.title, tt.url); got != tt.want {
+				t.Errorf("ValidateProfileLink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBirthday(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	birthday, ok := ParseBirthday("1995-03-14", now)
+	if !ok || birthday != time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("ParseBirthday() = %v, %v", birthday, ok)
+	}
+
+	for _, value := range []string{"14.03.1995", "1995-02-30", "1899-12-31", "2025-06-02"} {
+		if _, ok := ParseBirthday(value, now); ok {
+			t.Errorf("ParseBirthday(%q) must fail", value)
+		}
+	}
+}
+
 func TestValidImageType(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	SessionModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
@@ -22,28 +23,44 @@ type AuthRepository interface {
 type UserClient interface {
 	GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*UserModels.User, error)
+	// NotifyUserRegistered сообщает user_service о новом пользователе, чтобы оповестить тех, у кого он был в адресной книге
+	NotifyUserRegistered(ctx context.Context, userID uuid.UUID) error
 }
 
 type SessionRepository interface {
-	AddSession(ctx context.Context, UserID uuid.UUID, device string) (uuid.UUID, error)
+	AddSession(ctx context.Context, UserID uuid.UUID, info SessionModels.DeviceInfo) (uuid.UUID, error)
 	DeleteSession(ctx context.Context, SessionID uuid.UUID) error
+	RegisterDevice(ctx context.Context, userID uuid.UUID, fingerprint string) (bool, error)
+}
+
+type GeoIPLocator interface {
+	Lookup(ip string) string
+}
+
+// SessionNotifier оповещает остальные сессии пользователя о входе с нового устройства
+type SessionNotifier interface {
+	NotifyNewDevice(ctx context.Context, userID uuid.UUID, info SessionModels.DeviceInfo) error
 }
 
 type AuthUsecase struct {
 	authrepo    AuthRepository
 	userrepo    UserClient
 	sessionrepo SessionRepository
+	locator     GeoIPLocator
+	notifier    SessionNotifier
 }
 
-func New(authrepo AuthRepository, userrepo UserClient, sessionrepo SessionRepository) *AuthUsecase {
+func New(authrepo AuthRepository, userrepo UserClient, sessionrepo SessionRepository, locator GeoIPLocator, notifier SessionNotifier) *AuthUsecase {
 	return &AuthUsecase{
 		authrepo:    authrepo,
 		userrepo:    userrepo,
 		sessionrepo: sessionrepo,
+		locator:     locator,
+		notifier:    notifier,
 	}
 }
 
-func (uc *AuthUsecase) Register(ctx context.Context, req *AuthDTO.RegisterRequest, device string) (uuid.UUID, *dto.ValidationErrorsDTO) {
+func (uc *AuthUsecase) Register(ctx context.Context, req *AuthDTO.RegisterRequest, info SessionModels.DeviceInfo) (uuid.UUID, *dto.ValidationErrorsDTO) {
 	const op = "AuthUsecase.Register"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op)
@@ -92,7 +109,14 @@ func (uc *AuthUsecase) Register(ctx context.Context, req *AuthDTO.RegisterReques
 		}
 	}
 
-	newsSession, err := uc.sessionrepo.AddSession(ctx, user.ID, device)
+	// Пользователь уже создан, поэтому сбой оповещения не должен мешать регистрации
+	if err := uc.userrepo.NotifyUserRegistered(ctx, user.ID); err != nil {
+		logger.WithError(fmt.Errorf("%s: %w", op, err)).Warn("failed to notify about registration")
+	}
+
+	info = uc.locate(info)
+
+	newsSession, err := uc.sessionrepo.AddSession(ctx, user.ID, info)
 	if err != nil {
 		wrappedErr := fmt.Errorf("%s: %w", op, err)
 		logger.WithError(wrappedErr).Error("failed to create session")
@@ -101,10 +125,15 @@ func (uc *AuthUsecase) Register(ctx context.Context, req *AuthDTO.RegisterReques
 		}
 	}
 
+	// Запоминаем первое устройство, оповещать некого
+	if _, err := uc.sessionrepo.RegisterDevice(ctx, user.ID, info.Fingerprint()); err != nil {
+		logger.WithError(fmt.Errorf("%s: %w", op, err)).Warn("failed to register device")
+	}
+
 	return newsSession, nil
 }
 
-func (uc *AuthUsecase) Login(ctx context.Context, req *AuthDTO.LoginRequest, device string) (uuid.UUID, error) {
+func (uc *AuthUsecase) Login(ctx context.Context, req *AuthDTO.LoginRequest, info SessionModels.DeviceInfo) (uuid.UUID, error) {
 	const op = "AuthUsecase.Login"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op)
@@ -115,22 +144,63 @@ func (uc *AuthUsecase) Login(ctx context.Context, req *AuthDTO.LoginRequest, dev
 		return uuid.Nil, errs.ErrInvalidCredentials
 	}
 
+	// Боты авторизуются только по токену
+	if user.AccountType == UserModels.BotAccount {
+		logger.Warn("login attempt for bot account")
+		return uuid.Nil, errs.ErrInvalidCredentials
+	}
+
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
 		wrappedErr := fmt.Errorf("%s: %w", op, errs.ErrInvalidCredentials)
 		logger.WithError(wrappedErr).Error("invalid password")
 		return uuid.Nil, errs.ErrInvalidCredentials
 	}
 
-	newSession, err := uc.sessionrepo.AddSession(ctx, user.ID, device)
+	info = uc.locate(info)
+
+	newSession, err := uc.sessionrepo.AddSession(ctx, user.ID, info)
 	if err != nil {
 		wrappedErr := fmt.Errorf("%s: %w", op, err)
 		logger.WithError(wrappedErr).Error("failed to create session")
 		return uuid.Nil, wrappedErr
 	}
 
+	uc.checkNewDevice(ctx, user.ID, info)
+
 	return newSession, nil
 }
 
+// locate дополняет метаданные сессии местоположением по IP
+func (uc *AuthUsecase) locate(info SessionModels.DeviceInfo) SessionModels.DeviceInfo {
+	if uc.locator != nil && info.Location == "" && info.IP != "" {
+		info.Location = uc.locator.Lookup(info.IP)
+	}
+
+	return info
+}
+
+// checkNewDevice отправляет уведомление остальным сессиям, если вход выполнен с ранее не встречавшегося устройства.
+// Ошибки не прерывают вход и только логируются.
+func (uc *AuthUsecase) checkNewDevice(ctx context.Context, userID uuid.UUID, info SessionModels.DeviceInfo) {
+	const op = "AuthUsecase.checkNewDevice"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	isNew, err := uc.sessionrepo.RegisterDevice(ctx, userID, info.Fingerprint())
+	if err != nil {
+		logger.WithError(fmt.Errorf("%s: %w", op, err)).Warn("failed to register device")
+		return
+	}
+
+	if !isNew || uc.notifier == nil {
+		return
+	}
+
+	if err := uc.notifier.NotifyNewDevice(ctx, userID, info); err != nil {
+		logger.WithError(fmt.Errorf("%s: %w", op, err)).Warn("failed to notify about new device")
+	}
+}
+
 func (uc *AuthUsecase) Logout(ctx context.Context, SessionID uuid.UUID) error {
 	const op = "AuthUsecase.Logout"
 
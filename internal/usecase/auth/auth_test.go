@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	SessionModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
 	"github.com/google/uuid"
@@ -46,15 +47,43 @@ func (m *MockUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*Us
 	return args.Get(0).(*UserModels.User), args.Error(1)
 }
 
+func (m *MockUserRepository) NotifyUserRegistered(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
 type MockSessionRepository struct {
 	mock.Mock
 }
 
-func (m *MockSessionRepository) AddSession(ctx context.Context, userID uuid.UUID, device string) (uuid.UUID, error) {
-	args := m.Called(ctx, userID, device)
+func (m *MockSessionRepository) AddSession(ctx context.Context, userID uuid.UUID, info SessionModels.DeviceInfo) (uuid.UUID, error) {
+	args := m.Called(ctx, userID, info)
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
 
+func (m *MockSessionRepository) RegisterDevice(ctx context.Context, userID uuid.UUID, fingerprint string) (bool, error) {
+	args := m.Called(ctx, userID, fingerprint)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockGeoIPLocator struct {
+	mock.Mock
+}
+
+func (m *MockGeoIPLocator) Lookup(ip string) string {
+	args := m.Called(ip)
+	return args.String(0)
+}
+
+type MockSessionNotifier struct {
+	mock.Mock
+}
+
+func (m *MockSessionNotifier) NotifyNewDevice(ctx context.Context, userID uuid.UUID, info SessionModels.DeviceInfo) error {
+	args := m.Called(ctx, userID, info)
+	return args.Error(0)
+}
+
 func (m *MockSessionRepository) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
 	args := m.Called(ctx, sessionID)
 	return args.Error(0)
@@ -66,14 +95,14 @@ func TestAuthUsecase_Register_Success(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 		Name:        "Test User",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	userID := uuid.New()
 	sessionID := uuid.New()
 
@@ -88,7 +117,9 @@ func TestAuthUsecase_Register_Success(t *testing.T) {
 			AccountType:  UserModels.UserAccount,
 		}, nil)
 
+	mockUserRepo.On("NotifyUserRegistered", ctx, userID).Return(nil)
 	mockSessionRepo.On("AddSession", ctx, userID, device).Return(sessionID, nil)
+	mockSessionRepo.On("RegisterDevice", ctx, userID, device.Fingerprint()).Return(false, nil)
 
 	result, validationErr := uc.Register(ctx, req, device)
 
@@ -105,14 +136,14 @@ func TestAuthUsecase_Register_PhoneAlreadyExists(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 		Name:        "Test User",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 
 	existingUser := &UserModels.User{
 		ID:          uuid.New(),
@@ -137,7 +168,7 @@ func TestAuthUsecase_Register_CreateUserError(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
@@ -145,7 +176,7 @@ func TestAuthUsecase_Register_CreateUserError(t *testing.T) {
 		Name:        "Test User",
 	}
 
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(nil, errors.New("not found"))
 	mockAuthRepo.On("CreateUser", ctx, req.Name, req.PhoneNumber, mock.AnythingOfType("string")).
 		Return(nil, errors.New("database error"))
@@ -165,14 +196,14 @@ func TestAuthUsecase_Register_UserNotCreated(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 		Name:        "Test User",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(nil, errors.New("not found"))
 	mockAuthRepo.On("CreateUser", ctx, req.Name, req.PhoneNumber, mock.AnythingOfType("string")).
@@ -193,14 +224,14 @@ func TestAuthUsecase_Register_SessionCreationError(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.RegisterRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 		Name:        "Test User",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	userID := uuid.New()
 
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(nil, errors.New("not found"))
@@ -214,6 +245,7 @@ func TestAuthUsecase_Register_SessionCreationError(t *testing.T) {
 			AccountType:  UserModels.UserAccount,
 		}, nil)
 
+	mockUserRepo.On("NotifyUserRegistered", ctx, userID).Return(errors.New("user service unavailable"))
 	mockSessionRepo.On("AddSession", ctx, userID, device).Return(uuid.Nil, errors.New("session creation failed"))
 
 	result, validationErr := uc.Register(ctx, req, device)
@@ -232,13 +264,13 @@ func TestAuthUsecase_Login_Success(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	userID := uuid.New()
 	sessionID := uuid.New()
 
@@ -252,6 +284,7 @@ func TestAuthUsecase_Login_Success(t *testing.T) {
 
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(user, nil)
 	mockSessionRepo.On("AddSession", ctx, userID, device).Return(sessionID, nil)
+	mockSessionRepo.On("RegisterDevice", ctx, userID, device.Fingerprint()).Return(false, nil)
 
 	result, err := uc.Login(ctx, req, device)
 
@@ -267,13 +300,13 @@ func TestAuthUsecase_Login_UserNotFound(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(nil, errors.New("user not found"))
 
@@ -291,13 +324,13 @@ func TestAuthUsecase_Login_UserIsNil(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 
 	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(nil, nil)
 
@@ -315,13 +348,13 @@ func TestAuthUsecase_Login_InvalidPassword(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "wrongpassword",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	userID := uuid.New()
 
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("correctpassword"), bcrypt.DefaultCost)
@@ -342,19 +375,51 @@ func TestAuthUsecase_Login_InvalidPassword(t *testing.T) {
 	mockUserRepo.AssertExpectations(t)
 }
 
+func TestAuthUsecase_Login_BotAccount(t *testing.T) {
+	ctx := context.Background()
+	mockAuthRepo := new(MockAuthRepository)
+	mockUserRepo := new(MockUserRepository)
+	mockSessionRepo := new(MockSessionRepository)
+
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
+
+	req := &AuthDTO.LoginRequest{
+		PhoneNumber: "+00123456789012345",
+		Password:    "password",
+	}
+	device := SessionModels.DeviceInfo{Device: "test-device"}
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+
+	user := &UserModels.User{
+		ID:           uuid.New(),
+		PhoneNumber:  req.PhoneNumber,
+		PasswordHash: string(hashedPassword),
+		AccountType:  UserModels.BotAccount,
+	}
+
+	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(user, nil)
+
+	result, err := uc.Login(ctx, req, device)
+
+	assert.Equal(t, uuid.Nil, result)
+	assert.Equal(t, errs.ErrInvalidCredentials, err)
+	mockSessionRepo.AssertNotCalled(t, "AddSession", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestAuthUsecase_Login_SessionCreationError(t *testing.T) {
 	ctx := context.Background()
 	mockAuthRepo := new(MockAuthRepository)
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	req := &AuthDTO.LoginRequest{
 		PhoneNumber: "+79998887766",
 		Password:    "password123",
 	}
-	device := "test-device"
+	device := SessionModels.DeviceInfo{Device: "test-device"}
 	userID := uuid.New()
 
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
@@ -383,7 +448,7 @@ func TestAuthUsecase_Logout_Success(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	sessionID := uuid.New()
 
@@ -401,7 +466,7 @@ func TestAuthUsecase_Logout_SessionDeleteError(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockSessionRepo := new(MockSessionRepository)
 
-	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo)
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, nil)
 
 	sessionID := uuid.New()
 
@@ -413,3 +478,85 @@ func TestAuthUsecase_Logout_SessionDeleteError(t *testing.T) {
 	assert.Contains(t, err.Error(), "delete session failed")
 	mockSessionRepo.AssertExpectations(t)
 }
+
+func TestAuthUsecase_Login_NewDevice(t *testing.T) {
+	ctx := context.Background()
+	mockAuthRepo := new(MockAuthRepository)
+	mockUserRepo := new(MockUserRepository)
+	mockSessionRepo := new(MockSessionRepository)
+	mockLocator := new(MockGeoIPLocator)
+	mockNotifier := new(MockSessionNotifier)
+
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, mockLocator, mockNotifier)
+
+	req := &AuthDTO.LoginRequest{
+		PhoneNumber: "+79998887766",
+		Password:    "password123",
+	}
+	device := SessionModels.DeviceInfo{
+		Device:  "Firefox 120.0 on Windows 10",
+		IP:      "5.255.255.10",
+		OS:      "Windows 10",
+		Browser: "Firefox",
+	}
+	located := device
+	located.Location = "Moscow, Russia"
+
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+
+	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(&UserModels.User{
+		ID:           userID,
+		PhoneNumber:  req.PhoneNumber,
+		PasswordHash: string(hashedPassword),
+	}, nil)
+	mockLocator.On("Lookup", device.IP).Return("Moscow, Russia")
+	mockSessionRepo.On("AddSession", ctx, userID, located).Return(sessionID, nil)
+	mockSessionRepo.On("RegisterDevice", ctx, userID, "windows 10|firefox").Return(true, nil)
+	mockNotifier.On("NotifyNewDevice", ctx, userID, located).Return(nil)
+
+	result, err := uc.Login(ctx, req, device)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sessionID, result)
+	mockLocator.AssertExpectations(t)
+	mockSessionRepo.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestAuthUsecase_Login_NotifyErrorDoesNotFailLogin(t *testing.T) {
+	ctx := context.Background()
+	mockAuthRepo := new(MockAuthRepository)
+	mockUserRepo := new(MockUserRepository)
+	mockSessionRepo := new(MockSessionRepository)
+	mockNotifier := new(MockSessionNotifier)
+
+	uc := New(mockAuthRepo, mockUserRepo, mockSessionRepo, nil, mockNotifier)
+
+	req := &AuthDTO.LoginRequest{
+		PhoneNumber: "+79998887766",
+		Password:    "password123",
+	}
+	device := SessionModels.DeviceInfo{Device: "Safari on iOS", OS: "iOS", Browser: "Safari"}
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+
+	mockUserRepo.On("GetUserByPhone", ctx, req.PhoneNumber).Return(&UserModels.User{
+		ID:           userID,
+		PhoneNumber:  req.PhoneNumber,
+		PasswordHash: string(hashedPassword),
+	}, nil)
+	mockSessionRepo.On("AddSession", ctx, userID, device).Return(sessionID, nil)
+	mockSessionRepo.On("RegisterDevice", ctx, userID, device.Fingerprint()).Return(true, nil)
+	mockNotifier.On("NotifyNewDevice", ctx, userID, device).Return(errors.New("chats service unavailable"))
+
+	result, err := uc.Login(ctx, req, device)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sessionID, result)
+	mockNotifier.AssertExpectations(t)
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	BlockDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	InterfaceBlockRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/block"
+	InterfaceUserRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	"github.com/google/uuid"
+)
+
+type BlockUsecase struct {
+	blockrepo InterfaceBlockRepository.BlockRepository
+	userrepo  InterfaceUserRepository.UserRepository
+}
+
+func New(blockrepo InterfaceBlockRepository.BlockRepository, userrepo InterfaceUserRepository.UserRepository) *BlockUsecase {
+	return &BlockUsecase{
+		blockrepo: blockrepo,
+		userrepo:  userrepo,
+	}
+}
+
+func (uc *BlockUsecase) BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	const op = "BlockUsecase.BlockUser"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if userID == blockedUserID {
+		logger.Warn("attempt to block yourself")
+		return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	if _, err := uc.userrepo.GetUserByID(ctx, blockedUserID); err != nil {
+		logger.WithError(err).Error("failed to get blocked user by ID")
+		return fmt.Errorf("%s: %w", op, errs.ErrUserNotFound)
+	}
+
+	if err := uc.blockrepo.BlockUser(ctx, userID, blockedUserID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to block user")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func (uc *BlockUsecase) UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	const op = "BlockUsecase.UnblockUser"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if err := uc.blockrepo.UnblockUser(ctx, userID, blockedUserID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to unblock user")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func (uc *BlockUsecase) GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*BlockDTO.BlockedUserDTO, error) {
+	const op = "BlockUsecase.GetBlockedUsers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	blocks, err := uc.blockrepo.GetBlocksByUserID(ctx, userID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get blocks by user ID")
+		return nil, wrappedErr
+	}
+
+	if len(blocks) == 0 {
+		return []*BlockDTO.BlockedUserDTO{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(blocks))
+	for i, block := range blocks {
+		ids[i] = block.BlockedUserID
+	}
+
+	users, err := uc.userrepo.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get blocked users info")
+		return nil, wrappedErr
+	}
+
+	result := make([]*BlockDTO.BlockedUserDTO, 0, len(blocks))
+	for _, block := range blocks {
+		user, ok := users[block.BlockedUserID]
+		if !ok {
+			continue
+		}
+
+		result = append(result, &BlockDTO.BlockedUserDTO{
+			User: &UserDTO.User{
+				ID:          user.ID,
+				PhoneNumber: user.PhoneNumber,
+				Name:        user.Name,
+				Username:    user.Username,
+				Bio:         user.Bio,
+				AccountType: user.AccountType,
+				CreatedAt:   user.CreatedAt,
+				UpdatedAt:   user.UpdatedAt,
+			},
+			BlockedAt: block.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// GetBlockedPeers возвращает тех из peerIDs, с кем у пользователя есть блокировка в любую сторону
+func (uc *BlockUsecase) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "BlockUsecase.GetBlockedPeers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	blocked, err := uc.blockrepo.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get blocked peers")
+		return nil, wrappedErr
+	}
+
+	return blocked, nil
+}
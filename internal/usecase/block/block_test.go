@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	BlockModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/block"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockUsecase_BlockUser_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockBlockRepo, mockUserRepo)
+
+	ctx := context.Background()
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, blockedID).Return(&UserModels.User{ID: blockedID}, nil)
+	mockBlockRepo.EXPECT().BlockUser(ctx, userID, blockedID).Return(nil)
+
+	err := uc.BlockUser(ctx, userID, blockedID)
+
+	assert.NoError(t, err)
+}
+
+func TestBlockUsecase_BlockUser_Self(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := New(mocks.NewMockBlockRepository(ctrl), mocks.NewMockUserRepository(ctrl))
+	userID := uuid.New()
+
+	err := uc.BlockUser(context.Background(), userID, userID)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestBlockUsecase_BlockUser_UserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mocks.NewMockBlockRepository(ctrl), mockUserRepo)
+
+	ctx := context.Background()
+	blockedID := uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, blockedID).Return(nil, errors.New("no rows"))
+
+	err := uc.BlockUser(ctx, uuid.New(), blockedID)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+}
+
+func TestBlockUsecase_UnblockUser_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	uc := New(mockBlockRepo, mocks.NewMockUserRepository(ctrl))
+
+	ctx := context.Background()
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mockBlockRepo.EXPECT().UnblockUser(ctx, userID, blockedID).Return(errs.ErrBlockNotFound)
+
+	err := uc.UnblockUser(ctx, userID, blockedID)
+
+	assert.ErrorIs(t, err, errs.ErrBlockNotFound)
+}
+
+func TestBlockUsecase_GetBlockedUsers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockBlockRepo, mockUserRepo)
+
+	ctx := context.Background()
+	userID, blockedID, deletedID := uuid.New(), uuid.New(), uuid.New()
+	blockedAt := time.Now()
+
+	mockBlockRepo.EXPECT().GetBlocksByUserID(ctx, userID).Return([]*BlockModels.Block{
+		{UserID: userID, BlockedUserID: blockedID, CreatedAt: blockedAt},
+		{UserID: userID, BlockedUserID: deletedID, CreatedAt: blockedAt},
+	}, nil)
+	// Пользователь, которого нет в ответе, пропускается
+	mockUserRepo.EXPECT().GetUsersByIDs(ctx, []uuid.UUID{blockedID, deletedID}).Return(map[uuid.UUID]*UserModels.User{
+		blockedID: {ID: blockedID, Name: "Spammer", Username: "spammer"},
+	}, nil)
+
+	result, err := uc.GetBlockedUsers(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, blockedID, result[0].User.ID)
+	assert.Equal(t, "spammer", result[0].User.Username)
+	assert.Equal(t, blockedAt, result[0].BlockedAt)
+}
+
+func TestBlockUsecase_GetBlockedUsers_Empty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	uc := New(mockBlockRepo, mocks.NewMockUserRepository(ctrl))
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockBlockRepo.EXPECT().GetBlocksByUserID(ctx, userID).Return(nil, nil)
+
+	result, err := uc.GetBlockedUsers(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestBlockUsecase_GetBlockedPeers_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	uc := New(mockBlockRepo, mocks.NewMockUserRepository(ctrl))
+
+	ctx := context.Background()
+	userID, peerID := uuid.New(), uuid.New()
+
+	mockBlockRepo.EXPECT().GetBlockedPeers(ctx, userID, []uuid.UUID{peerID}).Return(nil, errors.New("db error"))
+
+	result, err := uc.GetBlockedPeers(ctx, userID, []uuid.UUID{peerID})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
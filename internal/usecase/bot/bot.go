@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/bot"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsHandle "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	tokenModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	tokenDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/webhook"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// UsernameSuffix обязателен в username бота, чтобы его можно было отличить от человека
+	UsernameSuffix = "bot"
+
+	// Боты не входят по телефону, но колонка phone_number обязательна и уникальна.
+	// Код страны +00 не выдается, поэтому такие номера не пересекаются с реальными
+	botPhonePrefix = "+00"
+	botPhoneDigits = 15
+
+	webhookSecretBytes = 32
+	defaultTokenName   = "default"
+)
+
+type BotRepository interface {
+	CreateBot(ctx context.Context, bot *models.Bot, phone, passwordHash string) error
+	GetBotsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*models.Bot, error)
+	GetBot(ctx context.Context, ownerID, botID uuid.UUID) (*models.Bot, error)
+	DeleteBot(ctx context.Context, ownerID, botID uuid.UUID) error
+	UpdateWebhook(ctx context.Context, ownerID, botID uuid.UUID, url, secret *string) error
+}
+
+type TokenIssuer interface {
+	CreateToken(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*tokenDTO.CreatedToken, error)
+	RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error
+}
+
+type BotUsecase struct {
+	botrepo BotRepository
+	tokens  TokenIssuer
+}
+
+func New(botrepo BotRepository, tokens TokenIssuer) *BotUsecase {
+	return &BotUsecase{
+		botrepo: botrepo,
+		tokens:  tokens,
+	}
+}
+
+// CreateBot создает бота и выпускает для него токен с правами read и write
+func (uc *BotUsecase) CreateBot(ctx context.Context, ownerID uuid.UUID, name, username string) (*dto.CreatedBot, error) {
+	const op = "BotUsecase.CreateBot"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("owner_id", ownerID.String())
+
+	name = strings.TrimSpace(name)
+	if !validation.ValidateName(name) || !ValidateBotUsername(username) {
+		logger.Warn("invalid bot name or username")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	if modelsHandle.IsReserved(username) {
+		logger.Warn("bot username is reserved")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrUsernameReserved)
+	}
+
+	phone, err := generateBotPhone()
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to generate bot phone")
+		return nil, wrappedErr
+	}
+
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to generate bot password")
+		return nil, wrappedErr
+	}
+
+	// Пароль никому не сообщается: хэш нужен только для ограничения на колонку
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(password)), bcrypt.DefaultCost)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to hash bot password")
+		return nil, wrappedErr
+	}
+
+	bot := &models.Bot{
+		UserID:    uuid.New(),
+		OwnerID:   ownerID,
+		Name:      name,
+		Username:  username,
+		CreatedAt: time.Now(),
+	}
+
+	if err := uc.botrepo.CreateBot(ctx, bot, phone, string(passwordHash)); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to create bot")
+		return nil, wrappedErr
+	}
+
+	token, err := uc.tokens.CreateToken(ctx, bot.UserID, defaultTokenName, tokenModels.KnownScopes, 0)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to issue bot token")
+
+		// Бот без токена бесполезен и занимает username - удаляем его
+		if err := uc.botrepo.DeleteBot(context.WithoutCancel(ctx), ownerID, bot.UserID); err != nil {
+			logger.WithError(err).Error("failed to delete bot without token")
+		}
+		return nil, wrappedErr
+	}
+
+	return &dto.CreatedBot{
+		Bot:   *botToDTO(bot),
+		Token: token.Value,
+	}, nil
+}
+
+func (uc *BotUsecase) GetBots(ctx context.Context, ownerID uuid.UUID) ([]*dto.Bot, error) {
+	const op = "BotUsecase.GetBots"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("owner_id", ownerID.String())
+
+	bots, err := uc.botrepo.GetBotsByOwnerID(ctx, ownerID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get bots")
+		return nil, wrappedErr
+	}
+
+	result := make([]*dto.Bot, 0, len(bots))
+	for _, bot := range bots {
+		result = append(result, botToDTO(bot))
+	}
+
+	return result, nil
+}
+
+func (uc *BotUsecase) DeleteBot(ctx context.Context, ownerID, botID uuid.UUID) error {
+	const op = "BotUsecase.DeleteBot"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("bot_id", botID.String())
+
+	if err := uc.botrepo.DeleteBot(ctx, ownerID, botID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to delete bot")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+// SetWebhook задает адрес webhook и возвращает новый ключ подписи. Пустой адрес отключает webhook
+func (uc *BotUsecase) SetWebhook(ctx context.Context, ownerID, botID uuid.UUID, webhookURL string) (*dto.WebhookSecret, error) {
+	const op = "BotUsecase.SetWebhook"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("bot_id", botID.String())
+
+	webhookURL = strings.TrimSpace(webhookURL)
+	if webhookURL == "" {
+		if err := uc.botrepo.UpdateWebhook(ctx, ownerID, botID, nil, nil); err != nil {
+			wrappedErr := fmt.Errorf("%s: %w", op, err)
+			logger.WithError(wrappedErr).Error("failed to disable webhook")
+			return nil, wrappedErr
+		}
+		return &dto.WebhookSecret{}, nil
+	}
+
+	if !ValidateWebhookURL(webhookURL) {
+		logger.Warn("invalid webhook url")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	secretBytes := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secretBytes); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to generate webhook secret")
+		return nil, wrappedErr
+	}
+	secret := hex.EncodeToString(secretBytes)
+
+	if err := uc.botrepo.UpdateWebhook(ctx, ownerID, botID, &webhookURL, &secret); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to update webhook")
+		return nil, wrappedErr
+	}
+
+	return &dto.WebhookSecret{Secret: secret}, nil
+}
+
+// CreateBotToken выпускает дополнительный токен для бота владельца
+func (uc *BotUsecase) CreateBotToken(ctx context.Context, ownerID, botID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*tokenDTO.CreatedToken, error) {
+	const op = "BotUsecase.CreateBotToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("bot_id", botID.String())
+
+	if _, err := uc.botrepo.GetBot(ctx, ownerID, botID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Warn("bot not found")
+		return nil, wrappedErr
+	}
+
+	token, err := uc.tokens.CreateToken(ctx, botID, name, scopes, expiresIn)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to issue bot token")
+		return nil, wrappedErr
+	}
+
+	return token, nil
+}
+
+func (uc *BotUsecase) RevokeBotToken(ctx context.Context, ownerID, botID, tokenID uuid.UUID) error {
+	const op = "BotUsecase.RevokeBotToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("bot_id", botID.String())
+
+	if _, err := uc.botrepo.GetBot(ctx, ownerID, botID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Warn("bot not found")
+		return wrappedErr
+	}
+
+	if err := uc.tokens.RevokeToken(ctx, botID, tokenID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to revoke bot token")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func ValidateBotUsername(username string) bool {
+	return validation.ValidateUsername(username) &&
+		strings.HasSuffix(strings.ToLower(username), UsernameSuffix)
+}
+
+// ValidateWebhookURL допускает только https-адреса, которые не ведут во внутреннюю сеть.
+// Доменные имена проверяются повторно при подключении, см. webhook.NewHTTPClient
+func ValidateWebhookURL(webhookURL string) bool {
+	if len(webhookURL) > 2048 {
+		return false
+	}
+
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || parsed.Scheme != "https" || parsed.User != nil {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return webhook.IsPublicIP(ip)
+	}
+
+	return true
+}
+
+func generateBotPhone() (string, error) {
+	var sb strings.Builder
+	sb.WriteString(botPhonePrefix)
+
+	for i := 0; i < botPhoneDigits; i++ {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(digit.String())
+	}
+
+	return sb.String(), nil
+}
+
+func botToDTO(bot *models.Bot) *dto.Bot {
+	return &dto.Bot{
+		ID:         bot.UserID,
+		Name:       bot.Name,
+		Username:   bot.Username,
+		WebhookURL: bot.WebhookURL,
+		CreatedAt:  bot.CreatedAt,
+	}
+}
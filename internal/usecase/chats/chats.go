@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	modelsAvatar "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
@@ -19,6 +22,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaxGroupPeers ограничивает выборку участников общих групп: их используют только для ранжирования поиска людей
+const MaxGroupPeers = 1000
+
 type ChatsUsecase struct {
 	chatsRepo   interfaceChatsRepository.ChatsRepository
 	messageRepo interfaceMessageRepository.MessageRepository
@@ -35,16 +41,20 @@ func NewChatsUsecase(chatsRepo interfaceChatsRepository.ChatsRepository, usersCl
 	}
 }
 
-func (uc *ChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID) ([]dtoChats.ChatViewInformationDTO, error) {
-	const op = "ChatsUsecase.GetChats"
-
-	logger := domains.GetLogger(ctx).WithField("operation", op)
-
-	chats, err := uc.chatsRepo.GetChats(ctx, userId)
+func (uc *ChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID, filter dtoChats.ChatsFilterDTO) ([]dtoChats.ChatViewInformationDTO, error) {
+	// Репозиторий уже возвращает чаты в нужном порядке: сначала закреплённые, затем по последнему сообщению
+	allChats, err := uc.chatsRepo.GetChats(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 
+	chats := make([]modelsChats.Chat, 0, len(allChats))
+	for _, chat := range allChats {
+		if matchesChatsFilter(chat.Settings, filter) {
+			chats = append(chats, chat)
+		}
+	}
+
 	lastMessages, err := uc.messageRepo.GetLastMessagesOfChats(ctx, userId)
 	if err != nil {
 		return nil, err
@@ -56,30 +66,28 @@ func (uc *ChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID) ([]dtoCh
 		messageMap[msg.ChatID] = msg
 	}
 
+	dialogPartners, partnerNames := uc.dialogPartners(ctx, userId, chats)
+
+	now := time.Now()
 	result := make([]dtoChats.ChatViewInformationDTO, 0, len(chats))
+	// Собеседники диалогов: по ним имя заменяется на заданное пользователем в контактах
+	partners := make(map[int]uuid.UUID)
 	for _, chat := range chats {
 		chatName := chat.Name
 
-		// Для диалогов определяем имя собеседника
-		if chat.Type == modelsChats.ChatTypeDialog {
-			users, err := uc.chatsRepo.GetUsersOfChat(ctx, chat.ID)
-			if err != nil {
-				logger.Warningf("could not get users for dialog %s: %v", chat.ID, err)
-			} else {
-				// Ищем собеседника (не текущего пользователя)
-				for _, user := range users {
-					if user.UserID != userId {
-						chatName = user.UserName
-						break
-					}
-				}
+		// Диалог называется именем собеседника
+		if partnerID, ok := dialogPartners[chat.ID]; ok {
+			if name, ok := partnerNames[partnerID]; ok {
+				chatName = name
 			}
+			partners[len(result)] = partnerID
 		}
 
 		chatDTO := dtoChats.ChatViewInformationDTO{
-			ID:   chat.ID,
-			Name: chatName,
-			Type: chat.Type,
+			ID:       chat.ID,
+			Name:     chatName,
+			Type:     chat.Type,
+			Settings: chatSettingsToDTO(chat.Settings, now),
 		}
 
 		if lastMsg, exists := messageMap[chat.ID]; exists {
@@ -89,9 +97,94 @@ func (uc *ChatsUsecase) GetChats(ctx context.Context, userId uuid.UUID) ([]dtoCh
 		result = append(result, chatDTO)
 	}
 
+	uc.applyContactAliases(ctx, userId, result, partners)
+
 	return result, nil
 }
 
+// dialogPartners загружает собеседников всех диалогов из chats одним запросом и их имена одним вызовом user_service.
+// Возвращает id диалога -> id собеседника и id собеседника -> имя; при ошибке диалоги остаются без имён
+func (uc *ChatsUsecase) dialogPartners(ctx context.Context, userID uuid.UUID, chats []modelsChats.Chat) (map[uuid.UUID]uuid.UUID, map[uuid.UUID]string) {
+	const op = "ChatsUsecase.dialogPartners"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	hasDialogs := false
+	for _, chat := range chats {
+		if chat.Type == modelsChats.ChatTypeDialog {
+			hasDialogs = true
+			break
+		}
+	}
+	if !hasDialogs {
+		return nil, nil
+	}
+
+	partnerChats, err := uc.chatsRepo.GetDialogPartners(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Warn("could not get dialog partners")
+		return nil, nil
+	}
+
+	dialogs := make(map[uuid.UUID]uuid.UUID, len(partnerChats))
+	for partnerID, chatID := range partnerChats {
+		dialogs[chatID] = partnerID
+	}
+
+	// Имена нужны только для диалогов, прошедших фильтр
+	partnerIDs := make([]uuid.UUID, 0, len(chats))
+	for _, chat := range chats {
+		if partnerID, ok := dialogs[chat.ID]; ok && chat.Type == modelsChats.ChatTypeDialog {
+			partnerIDs = append(partnerIDs, partnerID)
+		}
+	}
+	if len(partnerIDs) == 0 {
+		return dialogs, nil
+	}
+
+	users, err := uc.usersClient.GetUsersByIDs(ctx, partnerIDs)
+	if err != nil {
+		logger.WithError(err).Warn("could not get dialog partners profiles")
+		return dialogs, nil
+	}
+
+	names := make(map[uuid.UUID]string, len(users))
+	for id, user := range users {
+		names[id] = user.Name
+	}
+
+	return dialogs, names
+}
+
+// applyContactAliases подставляет в названия диалогов имена, которые пользователь дал собеседникам в контактах
+func (uc *ChatsUsecase) applyContactAliases(ctx context.Context, userID uuid.UUID, chats []dtoChats.ChatViewInformationDTO, partners map[int]uuid.UUID) {
+	const op = "ChatsUsecase.applyContactAliases"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if len(partners) == 0 {
+		return
+	}
+
+	partnerIDs := make([]uuid.UUID, 0, len(partners))
+	for i := range chats {
+		if partnerID, ok := partners[i]; ok {
+			partnerIDs = append(partnerIDs, partnerID)
+		}
+	}
+
+	aliases, err := uc.usersClient.GetContactAliases(ctx, userID, partnerIDs)
+	if err != nil {
+		// Без имён из контактов диалоги остаются с именами из профилей
+		logger.WithError(err).Warn("failed to get contact aliases")
+		return
+	}
+
+	for i, partnerID := range partners {
+		if alias, ok := aliases[partnerID]; ok {
+			chats[i].Name = alias
+		}
+	}
+}
+
 func (uc *ChatsUsecase) GetInformationAboutChat(ctx context.Context, userID, chatID uuid.UUID, offset, limit int) (*dtoChats.ChatDetailedInformationDTO, error) {
 	const op = "ChatsUsecase.GetInformationAboutChat"
 
@@ -187,6 +280,10 @@ func (uc *ChatsUsecase) GetInformationAboutChat(ctx context.Context, userID, cha
 }
 
 func (uc *ChatsUsecase) GetUsersDialog(ctx context.Context, user1ID, user2ID uuid.UUID) (*dtoUtils.IdDTO, error) {
+	if err := uc.checkNotBlocked(ctx, user1ID, []uuid.UUID{user2ID}); err != nil {
+		return nil, err
+	}
+
 	idDialog, err := uc.chatsRepo.GetUsersDialog(ctx, user1ID, user2ID)
 	if err != nil {
 		return nil, err
@@ -212,6 +309,21 @@ func (uc *ChatsUsecase) CreateChat(ctx context.Context, chatDTO dtoChats.ChatCre
 		usersIds[i] = memberDTO.UserId
 	}
 
+	// Новый диалог нельзя начать, если один из собеседников заблокировал другого
+	if chat.Type == modelsChats.ChatTypeDialog && len(usersIds) == 2 {
+		if err := uc.checkNotBlocked(ctx, usersIds[0], usersIds[1:]); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	// В группу или канал нельзя включить тех, кто запретил создателю добавлять себя.
+	// Создатель известен только по подписи запроса от имени пользователя
+	if creatorID, ok := identity.UserIDFromContext(ctx); ok && chat.Type != modelsChats.ChatTypeDialog {
+		if err := uc.checkGroupAddAllowed(ctx, creatorID, usersIds); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
 	usersNames, err := uc.usersClient.GetUsersNames(ctx, usersIds)
 	if err != nil {
 		return uuid.Nil, err
@@ -243,6 +355,20 @@ func (uc *ChatsUsecase) AddUsersToChat(ctx context.Context, chatID, userID uuid.
 		return errs.ErrNoRights
 	}
 
+	addedIDs := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		addedIDs[i] = user.UserId
+	}
+
+	// Заблокировавшего пользователя нельзя добавить в группу, как и заблокированного им
+	if err := uc.checkNotBlocked(ctx, userID, addedIDs); err != nil {
+		return err
+	}
+
+	if err := uc.checkGroupAddAllowed(ctx, userID, addedIDs); err != nil {
+		return err
+	}
+
 	usersInfo := make([]modelsChats.UserInfo, len(users))
 	for i, user := range users {
 		usersInfo[i] = modelsChats.UserInfo{
@@ -259,6 +385,44 @@ func (uc *ChatsUsecase) AddUsersToChat(ctx context.Context, chatID, userID uuid.
 	return nil
 }
 
+// checkNotBlocked возвращает ErrUserBlocked, если между userID и кем-то из peerIDs есть блокировка
+func (uc *ChatsUsecase) checkNotBlocked(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) error {
+	blocked, err := uc.usersClient.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(blocked) > 0 {
+		return errs.ErrUserBlocked
+	}
+
+	return nil
+}
+
+// checkGroupAddAllowed возвращает ErrPrivacyRestricted, если кто-то из peerIDs запретил userID добавлять себя в группы
+func (uc *ChatsUsecase) checkGroupAddAllowed(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) error {
+	others := make([]uuid.UUID, 0, len(peerIDs))
+	for _, id := range peerIDs {
+		if id != userID {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+
+	denied, err := uc.usersClient.GetGroupAddDenied(ctx, userID, others)
+	if err != nil {
+		return err
+	}
+
+	if len(denied) > 0 {
+		return errs.ErrPrivacyRestricted
+	}
+
+	return nil
+}
+
 func (uc *ChatsUsecase) DeleteChat(ctx context.Context, userId, chatId uuid.UUID) error {
 	return uc.chatsRepo.DeleteChat(ctx, userId, chatId)
 }
@@ -318,20 +482,29 @@ func (uc *ChatsUsecase) UploadChatAvatar(ctx context.Context, userID, chatID uui
 		return "", errs.ErrNoRights
 	}
 
+	// Проверяем изображение и готовим квадратные копии всех размеров
+	variants, err := utils.ProcessAvatar(fileData.Data)
+	if err != nil {
+		logger.WithError(err).Warn("Invalid avatar image")
+		return "", err
+	}
+
 	// Генерируем UUID для файла в MinIO
 	attachmentID := uuid.New()
 
-	// Сохраняем файл в MinIO
-	avatarURL, err := uc.fileStorage.CreateOne(ctx, fileData, attachmentID)
+	// Сохраняем копии в MinIO
+	avatarURL, err := utils.StoreAvatarVariants(ctx, uc.fileStorage, attachmentID, variants)
 	if err != nil {
 		logger.WithError(err).Error("Failed to save avatar file")
 		return "", err
 	}
 
 	// Сохраняем attachment_id в БД
-	err = uc.chatsRepo.UpdateChatAvatar(ctx, chatID, attachmentID, int64(len(fileData.Data)))
+	sizes := utils.AvatarVariantSizes(variants)
+	err = uc.chatsRepo.UpdateChatAvatar(ctx, chatID, attachmentID, int64(len(variants[0].Data)), sizes)
 	if err != nil {
 		logger.WithError(err).Error("Failed to update chat avatar in repository")
+		utils.DeleteAvatarObjects(ctx, uc.fileStorage, modelsAvatar.Avatar{ID: attachmentID, Sizes: sizes})
 		return "", err
 	}
 
@@ -339,6 +512,83 @@ func (uc *ChatsUsecase) UploadChatAvatar(ctx context.Context, userID, chatID uui
 	return avatarURL, nil
 }
 
+// GetChatAvatarHistory возвращает аватарки чата, начиная с текущей. Доступно только участникам
+func (uc *ChatsUsecase) GetChatAvatarHistory(ctx context.Context, userID, chatID uuid.UUID) ([]dtoUtils.AvatarDTO, error) {
+	const op = "ChatsUsecase.GetChatAvatarHistory"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	if _, err := uc.chatsRepo.GetUserInfo(ctx, userID, chatID); err != nil {
+		logger.WithError(err).Error("User is not a member of the chat")
+		return nil, errs.ErrNoRights
+	}
+
+	avatars, err := uc.chatsRepo.GetChatAvatarHistory(ctx, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get chat avatar history")
+		return nil, err
+	}
+
+	result := make([]dtoUtils.AvatarDTO, 0, len(avatars))
+	for _, avatar := range avatars {
+		result = append(result, utils.ConvertAvatarToDTO(ctx, avatar, uc.fileStorage))
+	}
+
+	return result, nil
+}
+
+// DeleteChatAvatar удаляет аватарку из истории чата вместе с файлами всех размеров
+func (uc *ChatsUsecase) DeleteChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	const op = "ChatsUsecase.DeleteChatAvatar"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	isAdmin, err := uc.chatsRepo.CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleAdmin)
+	if err != nil {
+		logger.WithError(err).Error("Failed to check user role")
+		return err
+	}
+
+	if !isAdmin {
+		logger.Error("User is not admin")
+		return errs.ErrNoRights
+	}
+
+	sizes, err := uc.chatsRepo.DeleteChatAvatar(ctx, chatID, attachmentID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to delete chat avatar")
+		return err
+	}
+
+	utils.DeleteAvatarObjects(ctx, uc.fileStorage, modelsAvatar.Avatar{ID: attachmentID, Sizes: sizes})
+
+	logger.Info("Chat avatar deleted successfully")
+	return nil
+}
+
+// SetCurrentChatAvatar делает аватарку из истории чата текущей
+func (uc *ChatsUsecase) SetCurrentChatAvatar(ctx context.Context, userID, chatID, attachmentID uuid.UUID) error {
+	const op = "ChatsUsecase.SetCurrentChatAvatar"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	isAdmin, err := uc.chatsRepo.CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleAdmin)
+	if err != nil {
+		logger.WithError(err).Error("Failed to check user role")
+		return err
+	}
+
+	if !isAdmin {
+		logger.Error("User is not admin")
+		return errs.ErrNoRights
+	}
+
+	if err := uc.chatsRepo.SetCurrentChatAvatar(ctx, chatID, attachmentID); err != nil {
+		logger.WithError(err).Error("Failed to set current chat avatar")
+		return err
+	}
+
+	logger.Info("Current chat avatar set successfully")
+	return nil
+}
+
 func (uc *ChatsUsecase) SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]dtoChats.ChatViewInformationDTO, error) {
 	const op = "ChatsUsecase.SearchChats"
 
@@ -387,3 +637,18 @@ func (uc *ChatsUsecase) SearchChats(ctx context.Context, userID uuid.UUID, name
 
 	return result, nil
 }
+
+// GetGroupPeers возвращает пользователей, с которыми userID состоит в общих группах
+func (uc *ChatsUsecase) GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	const op = "ChatsUsecase.GetGroupPeers"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	peers, err := uc.chatsRepo.GetGroupPeers(ctx, userID, MaxGroupPeers)
+	if err != nil {
+		logger.WithError(err).Errorf("Failed to get group peers of user %s", userID)
+		return nil, err
+	}
+
+	return peers, nil
+}
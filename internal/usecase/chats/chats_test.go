@@ -1,25 +1,35 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"image"
+	"image/png"
 	"testing"
 	"time"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	modelsAvatar "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
 	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
-func createTestHandler(ctrl *gomock.Controller) (*ChatsUsecase, *mocks.MockChatsRepository, *mocks.MockMessageRepository, *mocks.MockUserRepository, *mocks.MockFileStorage) {
+func createTestHandler(ctrl *gomock.Controller) (*ChatsUsecase, *mocks.MockChatsRepository, *mocks.MockMessageRepository, *mocks.MockUserClient, *mocks.MockFileStorage) {
 	mockChatsRepo := mocks.NewMockChatsRepository(ctrl)
 	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
-	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserClient(ctrl)
 	mockStorage := mocks.NewMockFileStorage(ctrl)
 
 	service := NewChatsUsecase(mockChatsRepo, mockUserRepo, mockMessageRepo, mockStorage)
@@ -47,7 +57,7 @@ func TestGetChats_Success(t *testing.T) {
 			CreatedAt: time.Now(),
 		}}, nil)
 
-	chats, err := service.GetChats(context.Background(), userId)
+	chats, err := service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{})
 
 	assert.NoError(t, err)
 	assert.Len(t, chats, 1)
@@ -56,6 +66,61 @@ func TestGetChats_Success(t *testing.T) {
 	assert.Equal(t, "Hello", chats[0].LastMessage.Text)
 }
 
+func TestGetChats_DialogContactAlias(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, mockMessageRepo, mockUserClient, _ := createTestHandler(ctrl)
+
+	userId, namedID, plainID := uuid.New(), uuid.New(), uuid.New()
+	namedChatID, plainChatID := uuid.New(), uuid.New()
+
+	mockChatsRepo.EXPECT().
+		GetChats(gomock.Any(), userId).
+		Return([]modelsChats.Chat{
+			{ID: namedChatID, Type: modelsChats.ChatTypeDialog},
+			{ID: plainChatID, Type: modelsChats.ChatTypeDialog},
+		}, nil)
+	mockMessageRepo.EXPECT().GetLastMessagesOfChats(gomock.Any(), userId).Return(nil, nil)
+	// Собеседники и их имена загружаются одним запросом на все диалоги
+	mockChatsRepo.EXPECT().
+		GetDialogPartners(gomock.Any(), userId).
+		Return(map[uuid.UUID]uuid.UUID{namedID: namedChatID, plainID: plainChatID}, nil)
+	mockUserClient.EXPECT().
+		GetUsersByIDs(gomock.Any(), []uuid.UUID{namedID, plainID}).
+		Return(map[uuid.UUID]*modelsUser.User{namedID: {ID: namedID, Name: "Anna"}, plainID: {ID: plainID, Name: "Boris"}}, nil)
+	mockUserClient.EXPECT().
+		GetContactAliases(gomock.Any(), userId, []uuid.UUID{namedID, plainID}).
+		Return(map[uuid.UUID]string{namedID: "Мама"}, nil)
+
+	chats, err := service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{})
+
+	assert.NoError(t, err)
+	assert.Len(t, chats, 2)
+	assert.Equal(t, "Мама", chats[0].Name)
+	assert.Equal(t, "Boris", chats[1].Name)
+}
+
+func TestGetChats_DialogPartnersError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, mockMessageRepo, _, _ := createTestHandler(ctrl)
+
+	userId, chatID := uuid.New(), uuid.New()
+
+	mockChatsRepo.EXPECT().
+		GetChats(gomock.Any(), userId).
+		Return([]modelsChats.Chat{{ID: chatID, Name: "dialog", Type: modelsChats.ChatTypeDialog}}, nil)
+	mockMessageRepo.EXPECT().GetLastMessagesOfChats(gomock.Any(), userId).Return(nil, nil)
+	mockChatsRepo.EXPECT().GetDialogPartners(gomock.Any(), userId).Return(nil, errors.New("db error"))
+
+	chats, err := service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{})
+
+	// Без собеседников список всё равно возвращается
+	assert.NoError(t, err)
+	assert.Len(t, chats, 1)
+	assert.Equal(t, "dialog", chats[0].Name)
+}
+
 func TestGetChats_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -67,7 +132,7 @@ func TestGetChats_Error(t *testing.T) {
 		GetChats(gomock.Any(), userId).
 		Return(nil, errors.New("repo error"))
 
-	_, err := service.GetChats(context.Background(), userId)
+	_, err := service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{})
 
 	assert.Error(t, err)
 }
@@ -166,6 +231,68 @@ func TestCreateChat_Success(t *testing.T) {
 	assert.NotEqual(t, uuid.Nil, id)
 }
 
+func TestCreateChat_DialogBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, _, _, mockUserRepo, _ := createTestHandler(ctrl)
+
+	creatorID, peerID := uuid.New(), uuid.New()
+
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), creatorID, []uuid.UUID{peerID}).
+		Return([]uuid.UUID{peerID}, nil)
+
+	chatDTO := dto.ChatCreateInformationDTO{
+		Name: "Dialog",
+		Type: modelsChats.ChatTypeDialog,
+		Members: []dto.AddChatMemberDTO{
+			{UserId: creatorID, Role: modelsChats.RoleAdmin},
+			{UserId: peerID, Role: modelsChats.RoleAdmin},
+		},
+	}
+	id, err := service.CreateChat(context.Background(), chatDTO)
+
+	assert.ErrorIs(t, err, errs.ErrUserBlocked)
+	assert.Equal(t, uuid.Nil, id)
+}
+
+func TestCreateChat_GroupAddDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, _, _, mockUserRepo, _ := createTestHandler(ctrl)
+
+	creatorID, privateID := uuid.New(), uuid.New()
+
+	// Создатель группы известен по подписи запроса
+	signer := identity.NewSigner(&config.IdentityConfig{Secret: "secret", TTL: time.Minute})
+	var ctx context.Context
+	_, err := identity.UnaryServerInterceptor(signer, true)(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(identity.MetadataKey, signer.Sign(creatorID))), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/chats.ChatService/CreateChat"},
+		func(handlerCtx context.Context, req interface{}) (interface{}, error) {
+			ctx = handlerCtx
+			return nil, nil
+		})
+	assert.NoError(t, err)
+
+	mockUserRepo.EXPECT().
+		GetGroupAddDenied(gomock.Any(), creatorID, []uuid.UUID{privateID}).
+		Return([]uuid.UUID{privateID}, nil)
+
+	chatDTO := dto.ChatCreateInformationDTO{
+		Name: "Group",
+		Type: modelsChats.ChatTypeGroup,
+		Members: []dto.AddChatMemberDTO{
+			{UserId: creatorID, Role: modelsChats.RoleAdmin},
+			{UserId: privateID, Role: modelsChats.RoleMember},
+		},
+	}
+	id, err := service.CreateChat(ctx, chatDTO)
+
+	assert.ErrorIs(t, err, errs.ErrPrivacyRestricted)
+	assert.Equal(t, uuid.Nil, id)
+}
+
 func TestCreateChat_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -197,7 +324,7 @@ func TestCreateChat_Error(t *testing.T) {
 func TestAddUsersToChat_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
-	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+	service, mockChatsRepo, _, mockUserRepo, _ := createTestHandler(ctrl)
 
 	chatID := uuid.New()
 	adminUserID := uuid.New()
@@ -218,6 +345,14 @@ func TestAddUsersToChat_Success(t *testing.T) {
 		CheckUserHasRole(gomock.Any(), adminUserID, chatID, modelsChats.RoleAdmin).
 		Return(true, nil)
 
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), adminUserID, []uuid.UUID{userID1, userID2}).
+		Return(nil, nil)
+
+	mockUserRepo.EXPECT().
+		GetGroupAddDenied(gomock.Any(), adminUserID, []uuid.UUID{userID1, userID2}).
+		Return(nil, nil)
+
 	mockChatsRepo.EXPECT().
 		InsertUsersToChat(gomock.Any(), chatID, expectedUsersInfo).
 		Return(nil)
@@ -227,6 +362,60 @@ func TestAddUsersToChat_Success(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAddUsersToChat_Blocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, mockUserRepo, _ := createTestHandler(ctrl)
+
+	chatID := uuid.New()
+	adminUserID := uuid.New()
+	blockerID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), adminUserID, chatID, modelsChats.RoleAdmin).
+		Return(true, nil)
+
+	// Добавляемый пользователь заблокировал администратора: в чат никто не добавляется
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), adminUserID, []uuid.UUID{blockerID}).
+		Return([]uuid.UUID{blockerID}, nil)
+
+	err := service.AddUsersToChat(context.Background(), chatID, adminUserID, []dto.AddChatMemberDTO{
+		{UserId: blockerID, Role: modelsChats.RoleMember},
+	})
+
+	assert.ErrorIs(t, err, errs.ErrUserBlocked)
+}
+
+func TestAddUsersToChat_GroupAddDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, mockUserRepo, _ := createTestHandler(ctrl)
+
+	chatID := uuid.New()
+	adminUserID := uuid.New()
+	privateID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), adminUserID, chatID, modelsChats.RoleAdmin).
+		Return(true, nil)
+
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), adminUserID, []uuid.UUID{privateID}).
+		Return(nil, nil)
+
+	// Пользователь запретил добавлять себя в группы: в чат никто не добавляется
+	mockUserRepo.EXPECT().
+		GetGroupAddDenied(gomock.Any(), adminUserID, []uuid.UUID{privateID}).
+		Return([]uuid.UUID{privateID}, nil)
+
+	err := service.AddUsersToChat(context.Background(), chatID, adminUserID, []dto.AddChatMemberDTO{
+		{UserId: privateID, Role: modelsChats.RoleMember},
+	})
+
+	assert.ErrorIs(t, err, errs.ErrPrivacyRestricted)
+}
+
 func TestDeleteChat_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
@@ -259,12 +448,16 @@ func TestUpdateChat_Success(t *testing.T) {
 
 func TestGetUsersDialog_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+	service, mockChatsRepo, _, mockUserRepo, _ := createTestHandler(ctrl)
 
 	user1ID := uuid.New()
 	user2ID := uuid.New()
 	dialogID := uuid.New()
 
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), user1ID, []uuid.UUID{user2ID}).
+		Return(nil, nil)
+
 	mockChatsRepo.EXPECT().
 		GetUsersDialog(gomock.Any(), user1ID, user2ID).
 		Return(dialogID, nil)
@@ -275,6 +468,23 @@ func TestGetUsersDialog_Success(t *testing.T) {
 	assert.Equal(t, dialogID, result.ID)
 }
 
+func TestGetUsersDialog_Blocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, _, _, mockUserRepo, _ := createTestHandler(ctrl)
+
+	user1ID := uuid.New()
+	user2ID := uuid.New()
+
+	mockUserRepo.EXPECT().
+		GetBlockedPeers(gomock.Any(), user1ID, []uuid.UUID{user2ID}).
+		Return([]uuid.UUID{user2ID}, nil)
+
+	result, err := service.GetUsersDialog(context.Background(), user1ID, user2ID)
+
+	assert.ErrorIs(t, err, errs.ErrUserBlocked)
+	assert.Nil(t, result)
+}
+
 func TestSearchChats_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	service, mockChatsRepo, mockMessageRepo, _, _ := createTestHandler(ctrl)
@@ -313,6 +523,23 @@ func TestSearchChats_Success(t *testing.T) {
 	assert.Equal(t, "TestChat", result[0].Name)
 }
 
+func TestGetGroupPeers_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	peers := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mockChatsRepo.EXPECT().
+		GetGroupPeers(gomock.Any(), userID, MaxGroupPeers).
+		Return(peers, nil)
+
+	result, err := service.GetGroupPeers(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, peers, result)
+}
+
 func TestGetChatAvatars_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	service, mockChatsRepo, _, _, mockStorage := createTestHandler(ctrl)
@@ -353,6 +580,17 @@ func TestGetChatAvatars_Success(t *testing.T) {
 	assert.Equal(t, url2, *result[chatID2.String()])
 }
 
+// testAvatarPNG возвращает PNG 200x200: из него получаются копии 200 и 160 пикселей
+func testAvatarPNG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 200, 200))); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
 func TestUploadChatAvatar_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	service, mockChatsRepo, _, _, mockStorage := createTestHandler(ctrl)
@@ -360,9 +598,9 @@ func TestUploadChatAvatar_Success(t *testing.T) {
 	userID := uuid.New()
 	chatID := uuid.New()
 	fileData := minio.FileData{
-		Name:        "avatar.jpg",
-		Data:        []byte("fake image data"),
-		ContentType: "image/jpeg",
+		Name:        "avatar.png",
+		Data:        testAvatarPNG(t),
+		ContentType: "image/png",
 	}
 	avatarURL := "https://example.com/avatar.jpg"
 
@@ -372,10 +610,11 @@ func TestUploadChatAvatar_Success(t *testing.T) {
 
 	mockStorage.EXPECT().
 		CreateOne(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(avatarURL, nil)
+		Return(avatarURL, nil).
+		Times(2)
 
 	mockChatsRepo.EXPECT().
-		UpdateChatAvatar(gomock.Any(), chatID, gomock.Any(), int64(len(fileData.Data))).
+		UpdateChatAvatar(gomock.Any(), chatID, gomock.Any(), gomock.Any(), []int{200, 160}).
 		Return(nil)
 
 	result, err := service.UploadChatAvatar(context.Background(), userID, chatID, fileData)
@@ -383,3 +622,133 @@ func TestUploadChatAvatar_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, avatarURL, result)
 }
+
+func TestUploadChatAvatar_InvalidImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	fileData := minio.FileData{
+		Name:        "avatar.jpg",
+		Data:        []byte("fake image data"),
+		ContentType: "image/jpeg",
+	}
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).
+		Return(true, nil)
+
+	result, err := service.UploadChatAvatar(context.Background(), userID, chatID, fileData)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+	assert.Empty(t, result)
+}
+
+func TestGetChatAvatarHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, mockStorage := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	avatar := &modelsAvatar.Avatar{ID: uuid.New(), Sizes: []int{320, 160}, IsCurrent: true}
+
+	mockChatsRepo.EXPECT().
+		GetUserInfo(gomock.Any(), userID, chatID).
+		Return(&modelsChats.UserInfo{UserID: userID, ChatID: chatID, Role: modelsChats.RoleMember}, nil)
+
+	mockChatsRepo.EXPECT().
+		GetChatAvatarHistory(gomock.Any(), chatID).
+		Return([]*modelsAvatar.Avatar{avatar}, nil)
+
+	mockStorage.EXPECT().
+		GetOne(gomock.Any(), gomock.Any()).
+		Return("https://example.com/avatar.jpg", nil).
+		Times(3)
+
+	result, err := service.GetChatAvatarHistory(context.Background(), userID, chatID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, avatar.ID, result[0].ID)
+	assert.True(t, result[0].IsCurrent)
+	assert.Len(t, result[0].Sizes, 2)
+}
+
+func TestGetChatAvatarHistory_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		GetUserInfo(gomock.Any(), userID, chatID).
+		Return(nil, errors.New("no rows in result set"))
+
+	result, err := service.GetChatAvatarHistory(context.Background(), userID, chatID)
+
+	assert.ErrorIs(t, err, errs.ErrNoRights)
+	assert.Nil(t, result)
+}
+
+func TestDeleteChatAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, mockStorage := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).
+		Return(true, nil)
+
+	mockChatsRepo.EXPECT().
+		DeleteChatAvatar(gomock.Any(), chatID, attachmentID).
+		Return([]int{640, 160}, nil)
+
+	mockStorage.EXPECT().DeleteOne(gomock.Any(), attachmentID).Return(nil)
+	mockStorage.EXPECT().DeleteOne(gomock.Any(), modelsAvatar.VariantID(attachmentID, 160)).Return(nil)
+
+	err := service.DeleteChatAvatar(context.Background(), userID, chatID, attachmentID)
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteChatAvatar_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).
+		Return(false, nil)
+
+	err := service.DeleteChatAvatar(context.Background(), userID, chatID, uuid.New())
+
+	assert.ErrorIs(t, err, errs.ErrNoRights)
+}
+
+func TestSetCurrentChatAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	attachmentID := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).
+		Return(true, nil)
+
+	mockChatsRepo.EXPECT().
+		SetCurrentChatAvatar(gomock.Any(), chatID, attachmentID).
+		Return(errs.ErrNotFound)
+
+	err := service.SetCurrentChatAvatar(context.Background(), userID, chatID, attachmentID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
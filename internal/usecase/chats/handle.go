@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsHandle "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	"github.com/google/uuid"
+)
+
+// SetChatUsername меняет публичный handle группы или канала; пустой username делает чат приватным
+func (uc *ChatsUsecase) SetChatUsername(ctx context.Context, userID, chatID uuid.UUID, username string) error {
+	const op = "ChatsUsecase.SetChatUsername"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	username = strings.TrimPrefix(strings.TrimSpace(username), "@")
+	if username != "" {
+		if !validation.ValidateUsername(username) {
+			logger.Warn("invalid chat username")
+			return errs.ErrBadRequest
+		}
+		if modelsHandle.IsReserved(username) {
+			logger.Warn("chat username is reserved")
+			return errs.ErrUsernameReserved
+		}
+	}
+
+	isAdmin, err := uc.chatsRepo.CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleAdmin)
+	if err != nil {
+		logger.WithError(err).Error("Failed to check user role")
+		return err
+	}
+
+	if !isAdmin {
+		logger.Error("User is not admin")
+		return errs.ErrNoRights
+	}
+
+	return uc.chatsRepo.SetChatUsername(ctx, chatID, username)
+}
+
+// ResolveChatUsername находит публичную группу или канал; redirected - handle прежний и ведёт на новый
+func (uc *ChatsUsecase) ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*dtoChats.PublicChatDTO, bool, error) {
+	chat, redirected, err := uc.chatsRepo.ResolveChatUsername(ctx, userID, username)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &dtoChats.PublicChatDTO{
+		ID:           chat.ID,
+		Type:         chat.Type,
+		Name:         chat.Name,
+		Description:  chat.Description,
+		Username:     chat.Username,
+		MembersCount: chat.MembersCount,
+		IsMember:     chat.IsMember,
+	}, redirected, nil
+}
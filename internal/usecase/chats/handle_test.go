@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetChatUsername_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().SetChatUsername(gomock.Any(), chatID, "daily_news").Return(nil)
+
+	err := service.SetChatUsername(context.Background(), userID, chatID, " @daily_news ")
+
+	assert.NoError(t, err)
+}
+
+func TestSetChatUsername_MakePrivate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().SetChatUsername(gomock.Any(), chatID, "").Return(nil)
+
+	err := service.SetChatUsername(context.Background(), userID, chatID, "")
+
+	assert.NoError(t, err)
+}
+
+func TestSetChatUsername_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, _, _, _, _ := createTestHandler(ctrl)
+
+	err := service.SetChatUsername(context.Background(), uuid.New(), uuid.New(), "no spaces allowed")
+
+	assert.ErrorIs(t, err, errs.ErrBadRequest)
+}
+
+func TestSetChatUsername_Reserved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, _, _, _, _ := createTestHandler(ctrl)
+
+	err := service.SetChatUsername(context.Background(), uuid.New(), uuid.New(), "Official_News")
+
+	assert.ErrorIs(t, err, errs.ErrUsernameReserved)
+}
+
+func TestSetChatUsername_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(false, nil)
+
+	err := service.SetChatUsername(context.Background(), userID, chatID, "daily_news")
+
+	assert.ErrorIs(t, err, errs.ErrNoRights)
+}
+
+func TestResolveChatUsername_Redirected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chat := &modelsChats.PublicChat{
+		ID:           uuid.New(),
+		Type:         modelsChats.ChatTypeChannel,
+		Name:         "News",
+		Username:     "daily_news",
+		MembersCount: 10,
+	}
+
+	mockChatsRepo.EXPECT().ResolveChatUsername(gomock.Any(), userID, "old_news").Return(chat, true, nil)
+
+	result, redirected, err := service.ResolveChatUsername(context.Background(), userID, "old_news")
+
+	assert.NoError(t, err)
+	assert.True(t, redirected)
+	assert.Equal(t, chat.ID, result.ID)
+	assert.Equal(t, "daily_news", result.Username)
+	assert.Equal(t, 10, result.MembersCount)
+}
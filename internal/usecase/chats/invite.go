@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	"github.com/google/uuid"
+)
+
+// CreateInvite создаёт пригласительную ссылку в группу или канал от имени админа
+func (uc *ChatsUsecase) CreateInvite(ctx context.Context, userID, chatID uuid.UUID, req dtoChats.InviteCreateDTO) (*dtoChats.InviteDTO, error) {
+	const op = "ChatsUsecase.CreateInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	req.Name = strings.TrimSpace(req.Name)
+	if utf8.RuneCountInString(req.Name) > modelsChats.InviteNameMaxLen {
+		logger.Warn("invite name is too long")
+		return nil, errs.ErrBadRequest
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		logger.Warn("invite expiry is in the past")
+		return nil, errs.ErrBadRequest
+	}
+	if req.UsageLimit != nil && *req.UsageLimit <= 0 {
+		logger.Warn("invalid invite usage limit")
+		return nil, errs.ErrBadRequest
+	}
+	// По ссылке с одобрением вступает столько, сколько одобрят админы
+	if req.RequiresApproval && req.UsageLimit != nil {
+		logger.Warn("usage limit is not allowed for invites with approval")
+		return nil, errs.ErrBadRequest
+	}
+
+	if err := uc.checkChatAdmin(ctx, userID, chatID); err != nil {
+		logger.WithError(err).Warn("user can not manage invites")
+		return nil, err
+	}
+
+	chat, err := uc.chatsRepo.GetChat(ctx, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get chat")
+		return nil, err
+	}
+
+	if chat.Type == modelsChats.ChatTypeDialog {
+		logger.Warn("dialog can not have invites")
+		return nil, errs.ErrBadRequest
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		logger.WithError(err).Error("Failed to generate invite code")
+		return nil, err
+	}
+
+	invite := &modelsChats.Invite{
+		ID:               uuid.New(),
+		ChatID:           chatID,
+		ChatType:         chat.Type,
+		CreatorID:        userID,
+		Code:             code,
+		Name:             req.Name,
+		ExpiresAt:        req.ExpiresAt,
+		UsageLimit:       req.UsageLimit,
+		RequiresApproval: req.RequiresApproval,
+	}
+
+	if err := uc.chatsRepo.CreateInvite(ctx, invite); err != nil {
+		logger.WithError(err).Error("Failed to create invite")
+		return nil, err
+	}
+
+	result := inviteToDTO(*invite)
+	return &result, nil
+}
+
+// GetChatInvites возвращает ссылки чата для админа
+func (uc *ChatsUsecase) GetChatInvites(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.InviteDTO, error) {
+	if err := uc.checkChatAdmin(ctx, userID, chatID); err != nil {
+		return nil, err
+	}
+
+	invites, err := uc.chatsRepo.GetChatInvites(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtoChats.InviteDTO, len(invites))
+	for i, invite := range invites {
+		result[i] = inviteToDTO(invite)
+	}
+
+	return result, nil
+}
+
+// RevokeInvite отзывает ссылку; поданные по ней заявки остаются в очереди
+func (uc *ChatsUsecase) RevokeInvite(ctx context.Context, userID, chatID, inviteID uuid.UUID) error {
+	if err := uc.checkChatAdmin(ctx, userID, chatID); err != nil {
+		return err
+	}
+
+	return uc.chatsRepo.RevokeInvite(ctx, chatID, inviteID)
+}
+
+// JoinChatByInvite вступает в чат по ссылке или ставит заявку в очередь, если ссылка требует одобрения
+func (uc *ChatsUsecase) JoinChatByInvite(ctx context.Context, userID uuid.UUID, code string) (*dtoChats.JoinByInviteDTO, error) {
+	const op = "ChatsUsecase.JoinChatByInvite"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	invite, isMember, err := uc.chatsRepo.GetInviteByCode(ctx, userID, code)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get invite")
+		return nil, err
+	}
+
+	logger = logger.WithField("chat_id", invite.ChatID.String())
+
+	if isMember {
+		logger.Warn("user is already a member")
+		return nil, errs.ErrAlreadyMember
+	}
+
+	if !invite.IsUsableAt(time.Now()) {
+		logger.Warn("invite is no longer usable")
+		return nil, errs.ErrInviteExpired
+	}
+
+	if invite.RequiresApproval {
+		if err := uc.chatsRepo.CreateJoinRequest(ctx, invite.ChatID, userID, invite.ID); err != nil {
+			logger.WithError(err).Error("Failed to create join request")
+			return nil, err
+		}
+		return &dtoChats.JoinByInviteDTO{ChatID: invite.ChatID, Pending: true}, nil
+	}
+
+	// Лимит проверяется атомарно в базе: между чтением ссылки и вступлением её могли исчерпать.
+	// Использование ссылки и вставка участника идут одной транзакцией
+	role := modelsChats.JoinRole(invite.ChatType)
+	err = uc.chatsRepo.JoinByInvite(ctx, invite.ID, modelsChats.UserInfo{
+		UserID: userID,
+		ChatID: invite.ChatID,
+		Role:   role,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to join by invite")
+		return nil, err
+	}
+
+	return &dtoChats.JoinByInviteDTO{ChatID: invite.ChatID, Role: role}, nil
+}
+
+// GetJoinRequests возвращает очередь заявок на вступление для админа
+func (uc *ChatsUsecase) GetJoinRequests(ctx context.Context, userID, chatID uuid.UUID) ([]dtoChats.JoinRequestDTO, error) {
+	if err := uc.checkChatAdmin(ctx, userID, chatID); err != nil {
+		return nil, err
+	}
+
+	requests, err := uc.chatsRepo.GetJoinRequests(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtoChats.JoinRequestDTO, len(requests))
+	for i, request := range requests {
+		result[i] = dtoChats.JoinRequestDTO{
+			UserID:    request.UserID,
+			UserName:  request.UserName,
+			InviteID:  request.InviteID,
+			CreatedAt: request.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// ApproveJoinRequest одобряет заявку и добавляет пользователя в чат; возвращает добавленного участника
+func (uc *ChatsUsecase) ApproveJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) (*dtoChats.AddChatMemberDTO, error) {
+	const op = "ChatsUsecase.ApproveJoinRequest"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String()).WithField("user_id", userID.String())
+
+	if err := uc.checkChatAdmin(ctx, adminID, chatID); err != nil {
+		logger.WithError(err).Warn("user can not manage join requests")
+		return nil, err
+	}
+
+	chat, err := uc.chatsRepo.GetChat(ctx, chatID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get chat")
+		return nil, err
+	}
+
+	role := modelsChats.JoinRole(chat.Type)
+	err = uc.chatsRepo.ApproveJoinRequest(ctx, modelsChats.UserInfo{
+		UserID: userID,
+		ChatID: chatID,
+		Role:   role,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to approve join request")
+		return nil, err
+	}
+
+	return &dtoChats.AddChatMemberDTO{UserId: userID, Role: role}, nil
+}
+
+// DeclineJoinRequest отклоняет заявку на вступление
+func (uc *ChatsUsecase) DeclineJoinRequest(ctx context.Context, adminID, chatID, userID uuid.UUID) error {
+	if err := uc.checkChatAdmin(ctx, adminID, chatID); err != nil {
+		return err
+	}
+
+	return uc.chatsRepo.DeclineJoinRequest(ctx, chatID, userID)
+}
+
+// checkChatAdmin возвращает ErrNoRights, если userID не админ чата
+func (uc *ChatsUsecase) checkChatAdmin(ctx context.Context, userID, chatID uuid.UUID) error {
+	isAdmin, err := uc.chatsRepo.CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if !isAdmin {
+		return errs.ErrNoRights
+	}
+
+	return nil
+}
+
+func newInviteCode() (string, error) {
+	buf := make([]byte, modelsChats.InviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func inviteToDTO(invite modelsChats.Invite) dtoChats.InviteDTO {
+	return dtoChats.InviteDTO{
+		ID:               invite.ID,
+		Code:             invite.Code,
+		Name:             invite.Name,
+		CreatorID:        invite.CreatorID,
+		ExpiresAt:        invite.ExpiresAt,
+		UsageLimit:       invite.UsageLimit,
+		UsageCount:       invite.UsageCount,
+		RequiresApproval: invite.RequiresApproval,
+		IsRevoked:        invite.RevokedAt != nil,
+		CreatedAt:        invite.CreatedAt,
+	}
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateInvite_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+	limit := 3
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup}, nil)
+	mockChatsRepo.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, invite *modelsChats.Invite) error {
+			assert.Equal(t, chatID, invite.ChatID)
+			assert.Equal(t, userID, invite.CreatorID)
+			assert.Equal(t, "friends", invite.Name)
+			assert.NotEmpty(t, invite.Code)
+			return nil
+		})
+
+	invite, err := service.CreateInvite(context.Background(), userID, chatID, dtoChats.InviteCreateDTO{Name: " friends ", UsageLimit: &limit})
+
+	assert.NoError(t, err)
+	assert.Equal(t, limit, *invite.UsageLimit)
+	assert.False(t, invite.IsRevoked)
+}
+
+func TestCreateInvite_InvalidParams(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	zero := 0
+	limit := 5
+
+	tests := []struct {
+		name string
+		req  dtoChats.InviteCreateDTO
+	}{
+		{name: "expired", req: dtoChats.InviteCreateDTO{ExpiresAt: &past}},
+		{name: "zero limit", req: dtoChats.InviteCreateDTO{UsageLimit: &zero}},
+		{name: "limit with approval", req: dtoChats.InviteCreateDTO{UsageLimit: &limit, RequiresApproval: true}},
+		{name: "long name", req: dtoChats.InviteCreateDTO{Name: "очень длинное название ссылки для друзей"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			service, _, _, _, _ := createTestHandler(ctrl)
+
+			_, err := service.CreateInvite(context.Background(), uuid.New(), uuid.New(), tt.req)
+
+			assert.ErrorIs(t, err, errs.ErrBadRequest)
+		})
+	}
+}
+
+func TestCreateInvite_Dialog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeDialog}, nil)
+
+	_, err := service.CreateInvite(context.Background(), userID, chatID, dtoChats.InviteCreateDTO{})
+
+	assert.ErrorIs(t, err, errs.ErrBadRequest)
+}
+
+func TestRevokeInvite_NotAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), userID, chatID, modelsChats.RoleAdmin).Return(false, nil)
+
+	err := service.RevokeInvite(context.Background(), userID, chatID, uuid.New())
+
+	assert.ErrorIs(t, err, errs.ErrNoRights)
+}
+
+func TestJoinChatByInvite_Joined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	invite := &modelsChats.Invite{ID: uuid.New(), ChatID: uuid.New(), ChatType: modelsChats.ChatTypeChannel}
+
+	mockChatsRepo.EXPECT().GetInviteByCode(gomock.Any(), userID, "abc").Return(invite, false, nil)
+	mockChatsRepo.EXPECT().JoinByInvite(gomock.Any(), invite.ID, modelsChats.UserInfo{
+		UserID: userID,
+		ChatID: invite.ChatID,
+		Role:   modelsChats.RoleViewer,
+	}).Return(nil)
+
+	result, err := service.JoinChatByInvite(context.Background(), userID, "abc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, invite.ChatID, result.ChatID)
+	assert.Equal(t, modelsChats.RoleViewer, result.Role)
+	assert.False(t, result.Pending)
+}
+
+func TestJoinChatByInvite_RequiresApproval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	invite := &modelsChats.Invite{ID: uuid.New(), ChatID: uuid.New(), ChatType: modelsChats.ChatTypeGroup, RequiresApproval: true}
+
+	mockChatsRepo.EXPECT().GetInviteByCode(gomock.Any(), userID, "abc").Return(invite, false, nil)
+	mockChatsRepo.EXPECT().CreateJoinRequest(gomock.Any(), invite.ChatID, userID, invite.ID).Return(nil)
+
+	result, err := service.JoinChatByInvite(context.Background(), userID, "abc")
+
+	assert.NoError(t, err)
+	assert.True(t, result.Pending)
+}
+
+func TestJoinChatByInvite_Rejected(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		invite   *modelsChats.Invite
+		isMember bool
+		expected error
+	}{
+		{name: "already member", invite: &modelsChats.Invite{}, isMember: true, expected: errs.ErrAlreadyMember},
+		{name: "revoked", invite: &modelsChats.Invite{RevokedAt: &past}, expected: errs.ErrInviteExpired},
+		{name: "expired", invite: &modelsChats.Invite{ExpiresAt: &past}, expected: errs.ErrInviteExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+			userID := uuid.New()
+
+			mockChatsRepo.EXPECT().GetInviteByCode(gomock.Any(), userID, "abc").Return(tt.invite, tt.isMember, nil)
+
+			_, err := service.JoinChatByInvite(context.Background(), userID, "abc")
+
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestJoinChatByInvite_LimitExhaustedConcurrently(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userID := uuid.New()
+	invite := &modelsChats.Invite{ID: uuid.New(), ChatID: uuid.New(), ChatType: modelsChats.ChatTypeGroup}
+
+	mockChatsRepo.EXPECT().GetInviteByCode(gomock.Any(), userID, "abc").Return(invite, false, nil)
+	mockChatsRepo.EXPECT().JoinByInvite(gomock.Any(), invite.ID, gomock.Any()).Return(errs.ErrInviteExpired)
+
+	_, err := service.JoinChatByInvite(context.Background(), userID, "abc")
+
+	assert.ErrorIs(t, err, errs.ErrInviteExpired)
+}
+
+func TestApproveJoinRequest_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	adminID := uuid.New()
+	chatID := uuid.New()
+	requesterID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), adminID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup}, nil)
+	mockChatsRepo.EXPECT().ApproveJoinRequest(gomock.Any(), modelsChats.UserInfo{
+		UserID: requesterID,
+		ChatID: chatID,
+		Role:   modelsChats.RoleMember,
+	}).Return(nil)
+
+	member, err := service.ApproveJoinRequest(context.Background(), adminID, chatID, requesterID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, requesterID, member.UserId)
+	assert.Equal(t, modelsChats.RoleMember, member.Role)
+}
+
+func TestApproveJoinRequest_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	adminID := uuid.New()
+	chatID := uuid.New()
+	requesterID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), adminID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().GetChat(gomock.Any(), chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup}, nil)
+	mockChatsRepo.EXPECT().ApproveJoinRequest(gomock.Any(), gomock.Any()).Return(errs.ErrNotFound)
+
+	_, err := service.ApproveJoinRequest(context.Background(), adminID, chatID, requesterID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestDeclineJoinRequest_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	adminID := uuid.New()
+	chatID := uuid.New()
+	requesterID := uuid.New()
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(gomock.Any(), adminID, chatID, modelsChats.RoleAdmin).Return(true, nil)
+	mockChatsRepo.EXPECT().DeclineJoinRequest(gomock.Any(), chatID, requesterID).Return(nil)
+
+	err := service.DeclineJoinRequest(context.Background(), adminID, chatID, requesterID)
+
+	assert.NoError(t, err)
+}
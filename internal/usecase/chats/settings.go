@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dtoChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	"github.com/google/uuid"
+)
+
+// MaxChatFolderLength максимальная длина названия папки (совпадает с ограничением в БД)
+const MaxChatFolderLength = 32
+
+func (uc *ChatsUsecase) UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, update dtoChats.ChatSettingsUpdateDTO) (*dtoChats.ChatSettingsDTO, error) {
+	const op = "ChatsUsecase.UpdateChatSettings"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("chat_id", chatID.String())
+
+	current, err := uc.chatsRepo.GetChatSettings(ctx, userID, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	settings, err := applyChatSettingsUpdate(*current, update, now)
+	if err != nil {
+		logger.WithError(err).Warn("invalid chat settings update")
+		return nil, err
+	}
+
+	if err := uc.chatsRepo.UpdateChatSettings(ctx, userID, chatID, settings); err != nil {
+		return nil, err
+	}
+
+	result := chatSettingsToDTO(settings, now)
+	return &result, nil
+}
+
+// applyChatSettingsUpdate накладывает частичное изменение на текущие настройки
+func applyChatSettingsUpdate(settings modelsChats.ChatSettings, update dtoChats.ChatSettingsUpdateDTO, now time.Time) (modelsChats.ChatSettings, error) {
+	if update.Muted != nil {
+		settings.IsMuted = *update.Muted
+		// Включение без срока или выключение сбрасывают прежний срок заглушения
+		settings.MutedUntil = nil
+	}
+
+	if update.MutedUntil != nil {
+		if update.Muted != nil && !*update.Muted {
+			return settings, errs.ErrBadRequest
+		}
+		if !update.MutedUntil.After(now) {
+			return settings, errs.ErrBadRequest
+		}
+		mutedUntil := *update.MutedUntil
+		settings.IsMuted = true
+		settings.MutedUntil = &mutedUntil
+	}
+
+	if update.Archived != nil {
+		settings.IsArchived = *update.Archived
+	}
+
+	if update.PinOrder != nil {
+		switch {
+		case *update.PinOrder < 0:
+			return settings, errs.ErrBadRequest
+		case *update.PinOrder == 0:
+			settings.PinOrder = nil
+		default:
+			pinOrder := *update.PinOrder
+			settings.PinOrder = &pinOrder
+		}
+	}
+
+	if update.Folder != nil {
+		folder := strings.TrimSpace(*update.Folder)
+		switch {
+		case folder == "":
+			settings.Folder = nil
+		case utf8.RuneCountInString(folder) > MaxChatFolderLength:
+			return settings, errs.ErrBadRequest
+		default:
+			settings.Folder = &folder
+		}
+	}
+
+	return settings, nil
+}
+
+func matchesChatsFilter(settings modelsChats.ChatSettings, filter dtoChats.ChatsFilterDTO) bool {
+	if filter.Archived != nil && settings.IsArchived != *filter.Archived {
+		return false
+	}
+	if filter.Folder != nil && (settings.Folder == nil || *settings.Folder != *filter.Folder) {
+		return false
+	}
+	return true
+}
+
+func chatSettingsToDTO(settings modelsChats.ChatSettings, now time.Time) dtoChats.ChatSettingsDTO {
+	result := dtoChats.ChatSettingsDTO{
+		Muted:    settings.IsMutedAt(now),
+		Archived: settings.IsArchived,
+	}
+	if result.Muted {
+		result.MutedUntil = settings.MutedUntil
+	}
+	if settings.PinOrder != nil {
+		result.PinOrder = *settings.PinOrder
+	}
+	if settings.Folder != nil {
+		result.Folder = *settings.Folder
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/chats"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func boolPtr(b bool) *bool       { return &b }
+func intPtr(i int) *int          { return &i }
+func stringPtr(s string) *string { return &s }
+
+func TestGetChats_FilterAndSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, mockMessageRepo, _, _ := createTestHandler(ctrl)
+
+	userId := uuid.New()
+	pinnedID := uuid.New()
+	archivedID := uuid.New()
+	expired := time.Now().Add(-time.Hour)
+
+	mockChatsRepo.EXPECT().
+		GetChats(gomock.Any(), userId).
+		Return([]modelsChats.Chat{
+			{ID: pinnedID, Name: "Pinned", Type: modelsChats.ChatTypeGroup, Settings: modelsChats.ChatSettings{
+				IsMuted: true, PinOrder: intPtr(1), Folder: stringPtr("Work"),
+			}},
+			{ID: archivedID, Name: "Archived", Type: modelsChats.ChatTypeGroup, Settings: modelsChats.ChatSettings{
+				IsMuted: true, MutedUntil: &expired, IsArchived: true,
+			}},
+		}, nil).Times(2)
+
+	mockMessageRepo.EXPECT().
+		GetLastMessagesOfChats(gomock.Any(), userId).
+		Return(nil, nil).Times(2)
+
+	chats, err := service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{Archived: boolPtr(false)})
+
+	assert.NoError(t, err)
+	assert.Len(t, chats, 1)
+	assert.Equal(t, pinnedID, chats[0].ID)
+	assert.True(t, chats[0].Settings.Muted)
+	assert.Nil(t, chats[0].Settings.MutedUntil)
+	assert.Equal(t, 1, chats[0].Settings.PinOrder)
+	assert.Equal(t, "Work", chats[0].Settings.Folder)
+
+	chats, err = service.GetChats(context.Background(), userId, dto.ChatsFilterDTO{Archived: boolPtr(true)})
+
+	assert.NoError(t, err)
+	assert.Len(t, chats, 1)
+	assert.Equal(t, archivedID, chats[0].ID)
+	// Срок заглушения истёк
+	assert.False(t, chats[0].Settings.Muted)
+	assert.True(t, chats[0].Settings.Archived)
+}
+
+func TestUpdateChatSettings_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userId := uuid.New()
+	chatId := uuid.New()
+	mutedUntil := time.Now().Add(8 * time.Hour)
+
+	mockChatsRepo.EXPECT().
+		GetChatSettings(gomock.Any(), userId, chatId).
+		Return(&modelsChats.ChatSettings{Folder: stringPtr("Work")}, nil)
+
+	mockChatsRepo.EXPECT().
+		UpdateChatSettings(gomock.Any(), userId, chatId, modelsChats.ChatSettings{
+			IsMuted:    true,
+			MutedUntil: &mutedUntil,
+			PinOrder:   intPtr(3),
+			Folder:     stringPtr("Work"),
+		}).
+		Return(nil)
+
+	settings, err := service.UpdateChatSettings(context.Background(), userId, chatId, dto.ChatSettingsUpdateDTO{
+		MutedUntil: &mutedUntil,
+		PinOrder:   intPtr(3),
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, settings.Muted)
+	assert.Equal(t, &mutedUntil, settings.MutedUntil)
+	assert.Equal(t, 3, settings.PinOrder)
+	assert.Equal(t, "Work", settings.Folder)
+}
+
+func TestUpdateChatSettings_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service, mockChatsRepo, _, _, _ := createTestHandler(ctrl)
+
+	userId := uuid.New()
+	chatId := uuid.New()
+
+	mockChatsRepo.EXPECT().
+		GetChatSettings(gomock.Any(), userId, chatId).
+		Return(nil, errs.ErrNotFound)
+
+	settings, err := service.UpdateChatSettings(context.Background(), userId, chatId, dto.ChatSettingsUpdateDTO{Archived: boolPtr(true)})
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.Nil(t, settings)
+}
+
+func TestApplyChatSettingsUpdate(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		current  modelsChats.ChatSettings
+		update   dto.ChatSettingsUpdateDTO
+		expected modelsChats.ChatSettings
+		err      error
+	}{
+		{
+			name:     "mute forever",
+			update:   dto.ChatSettingsUpdateDTO{Muted: boolPtr(true)},
+			expected: modelsChats.ChatSettings{IsMuted: true},
+		},
+		{
+			name:     "unmute resets deadline",
+			current:  modelsChats.ChatSettings{IsMuted: true, MutedUntil: &future},
+			update:   dto.ChatSettingsUpdateDTO{Muted: boolPtr(false)},
+			expected: modelsChats.ChatSettings{},
+		},
+		{
+			name:   "mute until past",
+			update: dto.ChatSettingsUpdateDTO{MutedUntil: &past},
+			err:    errs.ErrBadRequest,
+		},
+		{
+			name:   "unmute with deadline",
+			update: dto.ChatSettingsUpdateDTO{Muted: boolPtr(false), MutedUntil: &future},
+			err:    errs.ErrBadRequest,
+		},
+		{
+			name:     "unpin and leave folder",
+			current:  modelsChats.ChatSettings{PinOrder: intPtr(1), Folder: stringPtr("Work")},
+			update:   dto.ChatSettingsUpdateDTO{PinOrder: intPtr(0), Folder: stringPtr("  ")},
+			expected: modelsChats.ChatSettings{},
+		},
+		{
+			name:   "negative pin order",
+			update: dto.ChatSettingsUpdateDTO{PinOrder: intPtr(-1)},
+			err:    errs.ErrBadRequest,
+		},
+		{
+			name:   "folder too long",
+			update: dto.ChatSettingsUpdateDTO{Folder: stringPtr("папка с очень длинным названием!!")},
+			err:    errs.ErrBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := applyChatSettingsUpdate(tt.current, tt.update, now)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -3,15 +3,24 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	ContactModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/contact"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	contactES "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch/contact"
 	ContactDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/contact"
 	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	InterfaceBlockRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/block"
 	InterfaceContactRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/contact"
+	InterfacePrivacyRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/privacy"
 	InterfaceFileStorage "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/storage"
 	InterfaceUserRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	privacyUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/privacy"
 	"github.com/google/uuid"
 )
 
@@ -20,14 +29,27 @@ type ContactUsecase struct {
 	userrepo    InterfaceUserRepository.UserRepository
 	fileStorage InterfaceFileStorage.FileStorage
 	esClient    contactES.ContactSearchRepositoryInterface
+	blockrepo   InterfaceBlockRepository.BlockRepository
+	notifier    InterfaceContactRepository.ContactJoinNotifier
+	privacyrepo InterfacePrivacyRepository.PrivacyRepository
+	// importSecret - ключ HMAC для номеров из адресных книг, ожидающих регистрации
+	importSecret []byte
 }
 
-func New(contactrepo InterfaceContactRepository.ContactRepository, userrepo InterfaceUserRepository.UserRepository, fileStorage InterfaceFileStorage.FileStorage, esClient contactES.ContactSearchRepositoryInterface) *ContactUsecase {
+// New создаёт usecase контактов; если notifier равен nil, о регистрации знакомых никто не оповещается,
+// если privacyrepo равен nil, профили контактов отдаются без учёта приватности
+func New(contactrepo InterfaceContactRepository.ContactRepository, userrepo InterfaceUserRepository.UserRepository, fileStorage InterfaceFileStorage.FileStorage,
+	esClient contactES.ContactSearchRepositoryInterface, blockrepo InterfaceBlockRepository.BlockRepository, notifier InterfaceContactRepository.ContactJoinNotifier,
+	privacyrepo InterfacePrivacyRepository.PrivacyRepository, importSecret []byte) *ContactUsecase {
 	return &ContactUsecase{
-		contactrepo: contactrepo,
-		userrepo:    userrepo,
-		fileStorage: fileStorage,
-		esClient:    esClient,
+		contactrepo:  contactrepo,
+		userrepo:     userrepo,
+		fileStorage:  fileStorage,
+		esClient:     esClient,
+		blockrepo:    blockrepo,
+		notifier:     notifier,
+		privacyrepo:  privacyrepo,
+		importSecret: importSecret,
 	}
 }
 
@@ -43,6 +65,18 @@ func (uc *ContactUsecase) CreateContact(ctx context.Context, req *ContactDTO.Pos
 		return wrappedErr
 	}
 
+	if req.ContactUserID == userID {
+		logger.Warn("attempt to add yourself to contacts")
+		return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	contactUser, err := uc.userrepo.GetUserByID(ctx, req.ContactUserID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get contact user by ID")
+		return wrappedErr
+	}
+
 	err = uc.contactrepo.CreateContact(ctx, userID, req.ContactUserID)
 	if err != nil {
 		wrappedErr := fmt.Errorf("%s: %w", op, err)
@@ -50,27 +84,24 @@ func (uc *ContactUsecase) CreateContact(ctx context.Context, req *ContactDTO.Pos
 		return wrappedErr
 	}
 
-	if uc.esClient != nil {
-		contactUser, err := uc.userrepo.GetUserByID(ctx, req.ContactUserID)
-		if err == nil {
-			logger.Debug("indexing contact in elasticsearch")
-			if indexErr := uc.esClient.IndexContact(
-				ctx,
-				userID.String(),
-				contactUser.ID.String(),
-				contactUser.Username,
-				contactUser.Name,
-				contactUser.PhoneNumber,
-			); indexErr != nil {
-				logger.WithError(indexErr).Warn("failed to index contact in elasticsearch")
-			} else {
-				logger.Debug("contact indexed successfully")
-			}
-		} else {
-			logger.WithError(err).Warn("failed to get contact user for indexing")
-		}
-	} else {
+	if uc.esClient == nil {
 		logger.Warn("elasticsearch client is nil, skipping indexing")
+		return nil
+	}
+
+	logger.Debug("indexing contact in elasticsearch")
+	if indexErr := uc.esClient.IndexContact(
+		ctx,
+		userID.String(),
+		contactUser.ID.String(),
+		contactUser.Username,
+		contactUser.Name,
+		uc.visiblePhone(ctx, userID, contactUser),
+		"",
+	); indexErr != nil {
+		logger.WithError(indexErr).Warn("failed to index contact in elasticsearch")
+	} else {
+		logger.Debug("contact indexed successfully")
 	}
 
 	return nil
@@ -91,6 +122,7 @@ func (uc *ContactUsecase) GetContacts(ctx context.Context, userID uuid.UUID) ([]
 	}
 
 	ContactsDTO := make([]*ContactDTO.GetContactsDTO, len(contactsModels))
+	contactUsers := make([]*UserDTO.User, len(contactsModels))
 	for i, contact := range contactsModels {
 		contactUserInfoModels, err := uc.userrepo.GetUserByID(ctx, contact.ContactUserID)
 		if err != nil {
@@ -108,13 +140,21 @@ func (uc *ContactUsecase) GetContacts(ctx context.Context, userID uuid.UUID) ([]
 			CreatedAt:   contactUserInfoModels.CreatedAt,
 			UpdatedAt:   contactUserInfoModels.UpdatedAt,
 		}
+		contactUsers[i] = contactUserInfoDTO
 		ContactsDTO[i] = &ContactDTO.GetContactsDTO{
 			UserID:      contact.UserID,
 			ContactUser: contactUserInfoDTO,
+			Alias:       contact.Alias,
 			CreatedAt:   contact.CreatedAt,
 			UpdatedAt:   contact.UpdatedAt,
 		}
 	}
+
+	if err := uc.applyPrivacy(ctx, userID, contactUsers...); err != nil {
+		logger.WithError(err).Error("failed to apply privacy rules")
+		return nil, err
+	}
+
 	return ContactsDTO, nil
 }
 
@@ -180,11 +220,23 @@ func (uc *ContactUsecase) SearchContacts(ctx context.Context, userID uuid.UUID,
 						CreatedAt:   user.CreatedAt,
 						UpdatedAt:   user.UpdatedAt,
 					},
+					Alias:     contactModel.Alias,
 					CreatedAt: contactModel.CreatedAt,
 					UpdatedAt: contactModel.UpdatedAt,
 				})
 			}
 
+			contacts = uc.excludeBlocked(ctx, userID, contacts)
+
+			contactUsers := make([]*UserDTO.User, 0, len(contacts))
+			for _, contact := range contacts {
+				contactUsers = append(contactUsers, contact.ContactUser)
+			}
+			if err := uc.applyPrivacy(ctx, userID, contactUsers...); err != nil {
+				logger.WithError(err).Error("failed to apply privacy rules")
+				return nil, err
+			}
+
 			return contacts, nil
 		}
 	}
@@ -229,7 +281,8 @@ func (uc *ContactUsecase) ReindexAllContacts(ctx context.Context) error {
 			contact.ContactUserID.String(),
 			user.Username,
 			user.Name,
-			user.PhoneNumber,
+			uc.visiblePhone(ctx, contact.UserID, user),
+			aliasOrEmpty(contact.Alias),
 		)
 		if err != nil {
 			logger.WithError(err).WithField("user_id", contact.UserID).WithField("contact_user_id", contact.ContactUserID).Warn("failed to index contact")
@@ -247,3 +300,425 @@ func (uc *ContactUsecase) ReindexAllContacts(ctx context.Context) error {
 
 	return nil
 }
+
+func (uc *ContactUsecase) DeleteContact(ctx context.Context, userID, contactUserID uuid.UUID) error {
+	const op = "ContactUsecase.DeleteContact"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if err := uc.contactrepo.DeleteContact(ctx, userID, contactUserID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to delete contact")
+		return wrappedErr
+	}
+
+	if uc.esClient == nil {
+		logger.Warn("elasticsearch client is nil, skipping index removal")
+		return nil
+	}
+
+	// База - источник истины; документ без строки в contact отсеется при поиске
+	if err := uc.esClient.DeleteContact(ctx, userID.String(), contactUserID.String()); err != nil {
+		logger.WithError(err).Warn("failed to delete contact from elasticsearch")
+	}
+
+	return nil
+}
+
+// UpdateContactAlias задаёт личное имя контакта; пустая строка или nil сбрасывают его
+func (uc *ContactUsecase) UpdateContactAlias(ctx context.Context, userID, contactUserID uuid.UUID, alias *string) error {
+	const op = "ContactUsecase.UpdateContactAlias"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if alias != nil {
+		trimmed := strings.TrimSpace(*alias)
+		if utf8.RuneCountInString(trimmed) > ContactModels.MaxAliasLength {
+			logger.Warn("contact alias is too long")
+			return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+		}
+
+		alias = &trimmed
+		if trimmed == "" {
+			alias = nil
+		}
+	}
+
+	if err := uc.contactrepo.UpdateContactAlias(ctx, userID, contactUserID, alias); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to update contact alias")
+		return wrappedErr
+	}
+
+	if uc.esClient == nil {
+		logger.Warn("elasticsearch client is nil, skipping indexing")
+		return nil
+	}
+
+	contactUser, err := uc.userrepo.GetUserByID(ctx, contactUserID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get contact user for indexing")
+		return nil
+	}
+
+	if err := uc.esClient.IndexContact(
+		ctx,
+		userID.String(),
+		contactUserID.String(),
+		contactUser.Username,
+		contactUser.Name,
+		uc.visiblePhone(ctx, userID, contactUser),
+		aliasOrEmpty(alias),
+	); err != nil {
+		logger.WithError(err).Warn("failed to reindex contact in elasticsearch")
+	}
+
+	return nil
+}
+
+// GetContactAliases возвращает имена, которые userID дал пользователям из contactUserIDs
+func (uc *ContactUsecase) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	const op = "ContactUsecase.GetContactAliases"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	aliases, err := uc.contactrepo.GetContactAliases(ctx, userID, contactUserIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get contact aliases")
+		return nil, wrappedErr
+	}
+
+	return aliases, nil
+}
+
+// ImportContacts сверяет номера из адресной книги с зарегистрированными пользователями и добавляет найденных в контакты.
+// Номера незарегистрированных запоминаются хэшами, чтобы сообщить о регистрации владельца
+func (uc *ContactUsecase) ImportContacts(ctx context.Context, userID uuid.UUID, req *ContactDTO.ImportContactsDTO) (*ContactDTO.ImportContactsResultDTO, error) {
+	const op = "ContactUsecase.ImportContacts"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	total := len(req.PhoneNumbers) + len(req.PhoneHashes)
+	if total == 0 || total > ContactModels.MaxImportBatch {
+		logger.Warnf("invalid import batch size: %d", total)
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	result := &ContactDTO.ImportContactsResultDTO{Found: []*ContactDTO.ImportedContactDTO{}}
+
+	hashes := make([]string, 0, total)
+	seen := make(map[string]struct{}, total)
+	addHash := func(hash string) {
+		if _, ok := seen[hash]; ok {
+			return
+		}
+		seen[hash] = struct{}{}
+		hashes = append(hashes, hash)
+	}
+
+	for _, phone := range req.PhoneNumbers {
+		normalized, ok := validation.ValidateAndNormalizePhone(phone)
+		if !ok {
+			result.Invalid++
+			continue
+		}
+		addHash(ContactModels.HashPhone(normalized))
+	}
+	for _, hash := range req.PhoneHashes {
+		hash = strings.ToLower(hash)
+		if !ContactModels.IsPhoneHash(hash) {
+			result.Invalid++
+			continue
+		}
+		addHash(hash)
+	}
+
+	if len(hashes) == 0 {
+		return result, nil
+	}
+
+	// Квота считается по проверяемым номерам, а не по запросам: так перебор базы упирается в лимит
+	allowed, err := uc.contactrepo.ReserveImportQuota(ctx, userID, len(hashes), ContactModels.ImportQuota, ContactModels.ImportQuotaWindow)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to reserve import quota")
+		return nil, wrappedErr
+	}
+	if !allowed {
+		logger.Warnf("import quota exceeded for user %s", userID)
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrTooManyRequests)
+	}
+
+	matched, err := uc.contactrepo.MatchPhoneHashes(ctx, hashes)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to match phone hashes")
+		return nil, wrappedErr
+	}
+
+	pending := make([]string, 0, len(hashes))
+	peerIDs := make([]uuid.UUID, 0, len(matched))
+	for _, hash := range hashes {
+		peerID, ok := matched[hash]
+		if !ok {
+			pending = append(pending, ContactModels.PendingImportKey(uc.importSecret, hash))
+			continue
+		}
+		if peerID != userID {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
+
+	peerIDs, err = uc.withoutBlocked(ctx, userID, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get blocked peers")
+		return nil, wrappedErr
+	}
+
+	users, err := uc.userrepo.GetUsersByIDs(ctx, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get matched users")
+		return nil, wrappedErr
+	}
+
+	created, err := uc.contactrepo.CreateContacts(ctx, userID, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to create contacts")
+		return nil, wrappedErr
+	}
+
+	if err := uc.contactrepo.SavePendingImports(ctx, userID, pending); err != nil {
+		// Найденные контакты уже добавлены, теряется только будущее оповещение
+		logger.WithError(err).Warn("failed to save pending imports")
+	}
+
+	createdSet := make(map[uuid.UUID]struct{}, len(created))
+	for _, id := range created {
+		createdSet[id] = struct{}{}
+		if user, ok := users[id]; ok {
+			uc.indexContact(ctx, userID, user.ID, user.Username, user.Name, user.PhoneNumber)
+		}
+	}
+
+	for _, hash := range hashes {
+		user, ok := users[matched[hash]]
+		if !ok {
+			continue
+		}
+		_, added := createdSet[user.ID]
+
+		// Номер не возвращается: клиент сопоставляет запись книги по хэшу
+		result.Found = append(result.Found, &ContactDTO.ImportedContactDTO{
+			User: &UserDTO.User{
+				ID:          user.ID,
+				Name:        user.Name,
+				Username:    user.Username,
+				AccountType: user.AccountType,
+				CreatedAt:   user.CreatedAt,
+				UpdatedAt:   user.UpdatedAt,
+			},
+			PhoneHash: hash,
+			Added:     added,
+		})
+	}
+	result.Added = len(created)
+
+	logger.Infof("imported %d numbers: %d found, %d added, %d pending", len(hashes), len(result.Found), result.Added, len(pending))
+	return result, nil
+}
+
+// HandleUserRegistered добавляет нового пользователя в контакты тем, у кого его номер был в адресной книге, и оповещает их
+func (uc *ContactUsecase) HandleUserRegistered(ctx context.Context, userID uuid.UUID) error {
+	const op = "ContactUsecase.HandleUserRegistered"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	user, err := uc.userrepo.GetUserByID(ctx, userID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get registered user")
+		return wrappedErr
+	}
+
+	owners, err := uc.contactrepo.ClaimPendingImports(ctx, ContactModels.PendingImportKey(uc.importSecret, ContactModels.HashPhone(user.PhoneNumber)), user.ID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to claim pending imports")
+		return wrappedErr
+	}
+
+	for _, ownerID := range owners {
+		uc.indexContact(ctx, ownerID, user.ID, user.Username, user.Name, user.PhoneNumber)
+
+		if uc.notifier == nil {
+			continue
+		}
+		if err := uc.notifier.NotifyContactJoined(ctx, ownerID, user); err != nil {
+			logger.WithError(err).Warnf("failed to notify user %s about joined contact", ownerID)
+		}
+	}
+
+	logger.Infof("user %s found in %d address books", userID, len(owners))
+	return nil
+}
+
+// HandlePhoneChanged обновляет номер пользователя в поисковых документах всех, у кого он есть в контактах
+func (uc *ContactUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	const op = "ContactUsecase.HandlePhoneChanged"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if uc.esClient == nil {
+		logger.Warn("elasticsearch client is nil, skipping indexing")
+		return nil
+	}
+
+	user, err := uc.userrepo.GetUserByID(ctx, userID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get user")
+		return wrappedErr
+	}
+
+	contacts, err := uc.contactrepo.GetContactsByContactUserID(ctx, userID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get contact owners")
+		return wrappedErr
+	}
+
+	failed := 0
+	for _, contact := range contacts {
+		if err := uc.esClient.IndexContact(ctx, contact.UserID.String(), user.ID.String(), user.Username, user.Name,
+			user.PhoneNumber, aliasOrEmpty(contact.Alias)); err != nil {
+			logger.WithError(err).WithField("user_id", contact.UserID).Warn("failed to reindex contact")
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s: %d of %d contacts were not reindexed", op, failed, len(contacts))
+	}
+
+	return nil
+}
+
+// indexContact добавляет контакт без имени в поиск; ошибки поиска не мешают основной операции
+func (uc *ContactUsecase) indexContact(ctx context.Context, userID, contactUserID uuid.UUID, username, name, phoneNumber string) {
+	const op = "ContactUsecase.indexContact"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if uc.esClient == nil {
+		return
+	}
+
+	if err := uc.esClient.IndexContact(ctx, userID.String(), contactUserID.String(), username, name, phoneNumber, ""); err != nil {
+		logger.WithError(err).Warn("failed to index contact in elasticsearch")
+	}
+}
+
+// withoutBlocked убирает из peerIDs тех, с кем есть блокировка в любую сторону
+func (uc *ContactUsecase) withoutBlocked(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if uc.blockrepo == nil || len(peerIDs) == 0 {
+		return peerIDs, nil
+	}
+
+	blocked, err := uc.blockrepo.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocked) == 0 {
+		return peerIDs, nil
+	}
+
+	blockedSet := make(map[uuid.UUID]struct{}, len(blocked))
+	for _, id := range blocked {
+		blockedSet[id] = struct{}{}
+	}
+
+	allowed := make([]uuid.UUID, 0, len(peerIDs))
+	for _, id := range peerIDs {
+		if _, ok := blockedSet[id]; !ok {
+			allowed = append(allowed, id)
+		}
+	}
+
+	return allowed, nil
+}
+
+func aliasOrEmpty(alias *string) string {
+	if alias == nil {
+		return ""
+	}
+	return *alias
+}
+
+// excludeBlocked убирает из выдачи пользователей, с которыми есть блокировка в любую сторону
+func (uc *ContactUsecase) excludeBlocked(ctx context.Context, userID uuid.UUID, contacts []*ContactDTO.GetContactsDTO) []*ContactDTO.GetContactsDTO {
+	const op = "ContactUsecase.excludeBlocked"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if uc.blockrepo == nil || len(contacts) == 0 {
+		return contacts
+	}
+
+	peerIDs := make([]uuid.UUID, len(contacts))
+	for i, contact := range contacts {
+		peerIDs[i] = contact.ContactUser.ID
+	}
+
+	blocked, err := uc.blockrepo.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		// Без списка блокировок нельзя гарантировать скрытие, поэтому выдачу не показываем
+		logger.WithError(err).Error("failed to get blocked peers")
+		return []*ContactDTO.GetContactsDTO{}
+	}
+
+	if len(blocked) == 0 {
+		return contacts
+	}
+
+	blockedSet := make(map[uuid.UUID]struct{}, len(blocked))
+	for _, id := range blocked {
+		blockedSet[id] = struct{}{}
+	}
+
+	filtered := make([]*ContactDTO.GetContactsDTO, 0, len(contacts))
+	for _, contact := range contacts {
+		if _, ok := blockedSet[contact.ContactUser.ID]; ok {
+			continue
+		}
+		filtered = append(filtered, contact)
+	}
+
+	return filtered
+}
+
+// applyPrivacy очищает поля профилей контактов, которые их владельцы скрыли от viewerID
+func (uc *ContactUsecase) applyPrivacy(ctx context.Context, viewerID uuid.UUID, users ...*UserDTO.User) error {
+	ownerIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		ownerIDs = append(ownerIDs, user.ID)
+	}
+
+	access, err := privacyUsecase.ViewerAccess(ctx, uc.privacyrepo, uc.contactrepo, viewerID, ownerIDs)
+	if err != nil {
+		return err
+	}
+
+	privacyUsecase.HideFields(access, users...)
+	return nil
+}
+
+// visiblePhone возвращает номер contactUser, если viewerID разрешено его видеть.
+// Скрытый номер не попадает в индекс, иначе поиск по нему раскрыл бы его
+func (uc *ContactUsecase) visiblePhone(ctx context.Context, viewerID uuid.UUID, contactUser *UserModels.User) string {
+	access, err := privacyUsecase.ViewerAccess(ctx, uc.privacyrepo, uc.contactrepo, viewerID, []uuid.UUID{contactUser.ID})
+	if err != nil {
+		domains.GetLogger(ctx).WithError(err).Warn("failed to load privacy rules, phone is not indexed")
+		return ""
+	}
+	if access != nil && !access.Allows(contactUser.ID, PrivacyModels.SettingPhoneNumber) {
+		return ""
+	}
+	return contactUser.PhoneNumber
+}
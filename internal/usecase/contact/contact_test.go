@@ -3,10 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	ContactModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/contact"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	ContactDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/contact"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
@@ -15,6 +18,8 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var testImportSecret = []byte("import_secret")
+
 func TestContactUsecase_CreateContact_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -22,7 +27,7 @@ func TestContactUsecase_CreateContact_Success(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -38,6 +43,7 @@ func TestContactUsecase_CreateContact_Success(t *testing.T) {
 	}
 
 	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, contactUserID).Return(&UserModels.User{ID: contactUserID}, nil)
 	mockContactRepo.EXPECT().CreateContact(ctx, userID, contactUserID).Return(nil)
 
 	err := uc.CreateContact(ctx, req, userID)
@@ -52,7 +58,7 @@ func TestContactUsecase_CreateContact_UserNotFound(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -76,7 +82,7 @@ func TestContactUsecase_CreateContact_RepositoryError(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -92,6 +98,7 @@ func TestContactUsecase_CreateContact_RepositoryError(t *testing.T) {
 	}
 
 	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, contactUserID).Return(&UserModels.User{ID: contactUserID}, nil)
 	mockContactRepo.EXPECT().CreateContact(ctx, userID, contactUserID).Return(errors.New("database error"))
 
 	err := uc.CreateContact(ctx, req, userID)
@@ -100,6 +107,67 @@ func TestContactUsecase_CreateContact_RepositoryError(t *testing.T) {
 	assert.Contains(t, err.Error(), "database error")
 }
 
+func TestContactUsecase_CreateContact_ContactUserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mocks.NewMockContactRepository(ctrl), mockUserRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, contactUserID).Return(nil, errs.ErrUserNotFound)
+
+	err := uc.CreateContact(ctx, &ContactDTO.PostContactDTO{ContactUserID: contactUserID}, userID)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+}
+
+func TestContactUsecase_CreateContact_Self(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mocks.NewMockContactRepository(ctrl), mockUserRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+
+	err := uc.CreateContact(ctx, &ContactDTO.PostContactDTO{ContactUserID: userID}, userID)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestContactUsecase_CreateContact_HiddenPhoneNotIndexed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, nil, nil, mockPrivacyRepo, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, contactUserID).Return(&UserModels.User{ID: contactUserID, PhoneNumber: "+79998887777"}, nil)
+	mockContactRepo.EXPECT().CreateContact(ctx, userID, contactUserID).Return(nil)
+	// Правил нет: номер по умолчанию видят только контакты владельца, а userID в них не входит
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{contactUserID}).Return(map[uuid.UUID]PrivacyModels.UserRules{}, nil)
+	mockContactRepo.EXPECT().GetContactOwners(ctx, userID, []uuid.UUID{contactUserID}).Return(nil, nil)
+
+	err := uc.CreateContact(ctx, &ContactDTO.PostContactDTO{ContactUserID: contactUserID}, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", search.phones[userID.String()])
+}
+
 func TestContactUsecase_GetContacts_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -107,7 +175,7 @@ func TestContactUsecase_GetContacts_Success(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -144,6 +212,41 @@ func TestContactUsecase_GetContacts_Success(t *testing.T) {
 	assert.Equal(t, "Contact User", result[0].ContactUser.Name)
 }
 
+func TestContactUsecase_GetContacts_AppliesPrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, mockPrivacyRepo, testImportSecret)
+
+	ctx := context.Background()
+	userID, friendID, strangerID := uuid.New(), uuid.New(), uuid.New()
+	bio := "bio"
+
+	mockContactRepo.EXPECT().GetContactsByUserID(ctx, userID).Return([]*ContactModels.Contact{
+		{UserID: userID, ContactUserID: friendID},
+		{UserID: userID, ContactUserID: strangerID},
+	}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, friendID).Return(&UserModels.User{ID: friendID, PhoneNumber: "+79990000001", Bio: &bio}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, strangerID).Return(&UserModels.User{ID: strangerID, PhoneNumber: "+79990000002", Bio: &bio}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{friendID, strangerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		strangerID: {PrivacyModels.SettingBio: {Setting: PrivacyModels.SettingBio, Visibility: PrivacyModels.VisibilityNobody}},
+	}, nil)
+	// Взаимный контакт только у friendID
+	mockContactRepo.EXPECT().GetContactOwners(ctx, userID, []uuid.UUID{friendID, strangerID}).Return([]uuid.UUID{friendID}, nil)
+
+	result, err := uc.GetContacts(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "+79990000001", result[0].ContactUser.PhoneNumber)
+	assert.Equal(t, &bio, result[0].ContactUser.Bio)
+	assert.Equal(t, "", result[1].ContactUser.PhoneNumber)
+	assert.Nil(t, result[1].ContactUser.Bio)
+}
+
 func TestContactUsecase_GetContacts_NoContacts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -151,7 +254,7 @@ func TestContactUsecase_GetContacts_NoContacts(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -171,7 +274,7 @@ func TestContactUsecase_GetContacts_RepositoryError(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -192,7 +295,7 @@ func TestContactUsecase_GetContacts_UserNotFound(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -224,7 +327,7 @@ func TestContactUsecase_SearchContacts_EmptyQuery(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -242,7 +345,7 @@ func TestContactUsecase_SearchContacts_NoESClient(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -260,7 +363,7 @@ func TestContactUsecase_ReindexAllContacts_NoESClient(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 
@@ -276,7 +379,7 @@ func TestContactUsecase_GetContacts_DatabaseError(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -297,7 +400,7 @@ func TestContactUsecase_GetContacts_UserRepoError(t *testing.T) {
 	mockContactRepo := mocks.NewMockContactRepository(ctrl)
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil)
+	uc := New(mockContactRepo, mockUserRepo, mockFileStorage, nil, nil, nil, nil, testImportSecret)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -321,3 +424,310 @@ func TestContactUsecase_GetContacts_UserRepoError(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "user not found")
 }
+
+// stubContactSearch отдаёт заранее заданную выдачу поиска
+type stubContactSearch struct {
+	results []map[string]interface{}
+	indexed map[string]string
+	phones  map[string]string
+	deleted []string
+}
+
+func (s *stubContactSearch) CreateIndex(ctx context.Context) error { return nil }
+
+func (s *stubContactSearch) IndexContact(ctx context.Context, userID, contactUserID, username, name, phoneNumber, alias string) error {
+	if s.indexed == nil {
+		s.indexed = make(map[string]string)
+	}
+	s.indexed[contactUserID] = alias
+	if s.phones == nil {
+		s.phones = make(map[string]string)
+	}
+	s.phones[userID] = phoneNumber
+	return nil
+}
+
+func (s *stubContactSearch) SearchContacts(ctx context.Context, userID, query string) ([]map[string]interface{}, error) {
+	return s.results, nil
+}
+
+func (s *stubContactSearch) DeleteContact(ctx context.Context, userID, contactUserID string) error {
+	s.deleted = append(s.deleted, contactUserID)
+	return nil
+}
+
+func TestContactUsecase_SearchContacts_ExcludesBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+
+	ctx := context.Background()
+	userID, friendID, blockedID := uuid.New(), uuid.New(), uuid.New()
+
+	search := &stubContactSearch{results: []map[string]interface{}{
+		{"contact_user_id": friendID.String()},
+		{"contact_user_id": blockedID.String()},
+	}}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, mockBlockRepo, nil, nil, testImportSecret)
+
+	contacts := []*ContactModels.Contact{
+		{UserID: userID, ContactUserID: friendID},
+		{UserID: userID, ContactUserID: blockedID},
+	}
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, friendID).Return(&UserModels.User{ID: friendID, Name: "Alex"}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, blockedID).Return(&UserModels.User{ID: blockedID, Name: "Alexey"}, nil)
+	mockContactRepo.EXPECT().GetContactsByUserID(ctx, userID).Return(contacts, nil).Times(2)
+	mockBlockRepo.EXPECT().GetBlockedPeers(ctx, userID, []uuid.UUID{friendID, blockedID}).Return([]uuid.UUID{blockedID}, nil)
+
+	result, err := uc.SearchContacts(ctx, userID, "alex")
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, friendID, result[0].ContactUser.ID)
+}
+
+func TestContactUsecase_DeleteContact_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), search, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mockContactRepo.EXPECT().DeleteContact(ctx, userID, contactUserID).Return(nil)
+
+	err := uc.DeleteContact(ctx, userID, contactUserID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{contactUserID.String()}, search.deleted)
+}
+
+func TestContactUsecase_DeleteContact_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), search, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+
+	mockContactRepo.EXPECT().DeleteContact(ctx, userID, contactUserID).Return(errs.ErrContactNotFound)
+
+	err := uc.DeleteContact(ctx, userID, contactUserID)
+
+	assert.ErrorIs(t, err, errs.ErrContactNotFound)
+	assert.Empty(t, search.deleted)
+}
+
+func TestContactUsecase_UpdateContactAlias_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "  Мама  "
+	trimmed := "Мама"
+
+	mockContactRepo.EXPECT().UpdateContactAlias(ctx, userID, contactUserID, &trimmed).Return(nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, contactUserID).Return(&UserModels.User{ID: contactUserID, Name: "Anna"}, nil)
+
+	err := uc.UpdateContactAlias(ctx, userID, contactUserID, &alias)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Мама", search.indexed[contactUserID.String()])
+}
+
+func TestContactUsecase_UpdateContactAlias_EmptyClears(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, contactUserID := uuid.New(), uuid.New()
+	alias := "   "
+
+	mockContactRepo.EXPECT().UpdateContactAlias(ctx, userID, contactUserID, (*string)(nil)).Return(nil)
+
+	err := uc.UpdateContactAlias(ctx, userID, contactUserID, &alias)
+
+	assert.NoError(t, err)
+}
+
+func TestContactUsecase_UpdateContactAlias_TooLong(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := New(mocks.NewMockContactRepository(ctrl), mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+	alias := strings.Repeat("я", ContactModels.MaxAliasLength+1)
+
+	err := uc.UpdateContactAlias(context.Background(), uuid.New(), uuid.New(), &alias)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestContactUsecase_ImportContacts_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, mockBlockRepo, nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, friendID, knownID, blockedID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	friendHash := ContactModels.HashPhone("+79991112233")
+	knownHash := ContactModels.HashPhone("+79994445566")
+	blockedHash := ContactModels.HashPhone("+79997778899")
+	strangerHash := ContactModels.HashPhone("+79990000000")
+	hashes := []string{friendHash, knownHash, blockedHash, strangerHash}
+
+	mockContactRepo.EXPECT().ReserveImportQuota(ctx, userID, 4, ContactModels.ImportQuota, ContactModels.ImportQuotaWindow).Return(true, nil)
+	mockContactRepo.EXPECT().MatchPhoneHashes(ctx, hashes).Return(map[string]uuid.UUID{
+		friendHash: friendID, knownHash: knownID, blockedHash: blockedID,
+	}, nil)
+	mockBlockRepo.EXPECT().GetBlockedPeers(ctx, userID, []uuid.UUID{friendID, knownID, blockedID}).Return([]uuid.UUID{blockedID}, nil)
+	mockUserRepo.EXPECT().GetUsersByIDs(ctx, []uuid.UUID{friendID, knownID}).Return(map[uuid.UUID]*UserModels.User{
+		friendID: {ID: friendID, Name: "Anna", PhoneNumber: "+79991112233"},
+		knownID:  {ID: knownID, Name: "Boris", PhoneNumber: "+79994445566"},
+	}, nil)
+	// knownID уже был в контактах
+	mockContactRepo.EXPECT().CreateContacts(ctx, userID, []uuid.UUID{friendID, knownID}).Return([]uuid.UUID{friendID}, nil)
+	mockContactRepo.EXPECT().SavePendingImports(ctx, userID, []string{ContactModels.PendingImportKey(testImportSecret, strangerHash)}).Return(nil)
+
+	result, err := uc.ImportContacts(ctx, userID, &ContactDTO.ImportContactsDTO{
+		PhoneNumbers: []string{"8 999 111 22 33", "+7 999 444 55 66", "not a phone", "89991112233"},
+		PhoneHashes:  []string{strings.ToUpper(blockedHash), strangerHash, "bad"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Added)
+	assert.Equal(t, 2, result.Invalid)
+	assert.Len(t, result.Found, 2)
+	assert.Equal(t, friendID, result.Found[0].User.ID)
+	assert.True(t, result.Found[0].Added)
+	assert.Empty(t, result.Found[0].User.PhoneNumber)
+	assert.Equal(t, knownHash, result.Found[1].PhoneHash)
+	assert.False(t, result.Found[1].Added)
+	assert.Contains(t, search.indexed, friendID.String())
+	assert.NotContains(t, search.indexed, knownID.String())
+}
+
+func TestContactUsecase_ImportContacts_QuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockContactRepo, mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockContactRepo.EXPECT().ReserveImportQuota(ctx, userID, 1, ContactModels.ImportQuota, ContactModels.ImportQuotaWindow).Return(false, nil)
+
+	result, err := uc.ImportContacts(ctx, userID, &ContactDTO.ImportContactsDTO{PhoneNumbers: []string{"+79991112233"}})
+
+	assert.ErrorIs(t, err, errs.ErrTooManyRequests)
+	assert.Nil(t, result)
+}
+
+func TestContactUsecase_ImportContacts_BatchTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := New(mocks.NewMockContactRepository(ctrl), mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	_, err := uc.ImportContacts(context.Background(), uuid.New(), &ContactDTO.ImportContactsDTO{
+		PhoneHashes: make([]string, ContactModels.MaxImportBatch+1),
+	})
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestContactUsecase_HandleUserRegistered_NotifiesOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockNotifier := mocks.NewMockContactJoinNotifier(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, mocks.NewMockBlockRepository(ctrl), mockNotifier, nil, testImportSecret)
+
+	ctx := context.Background()
+	newUserID, ownerID, otherOwnerID := uuid.New(), uuid.New(), uuid.New()
+	newUser := &UserModels.User{ID: newUserID, Name: "Anna", PhoneNumber: "+79991112233"}
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, newUserID).Return(newUser, nil)
+	mockContactRepo.EXPECT().ClaimPendingImports(ctx, ContactModels.PendingImportKey(testImportSecret, ContactModels.HashPhone("+79991112233")), newUserID).Return([]uuid.UUID{ownerID, otherOwnerID}, nil)
+	mockNotifier.EXPECT().NotifyContactJoined(ctx, ownerID, newUser).Return(nil)
+	mockNotifier.EXPECT().NotifyContactJoined(ctx, otherOwnerID, newUser).Return(errors.New("chats service unavailable"))
+
+	err := uc.HandleUserRegistered(ctx, newUserID)
+
+	assert.NoError(t, err)
+	assert.Contains(t, search.indexed, newUserID.String())
+}
+
+func TestContactUsecase_HandlePhoneChanged_ReindexesOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	search := &stubContactSearch{}
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), search, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID, ownerID := uuid.New(), uuid.New()
+	alias := "Аня"
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, Name: "Anna", PhoneNumber: "+79990001122"}, nil)
+	mockContactRepo.EXPECT().GetContactsByContactUserID(ctx, userID).Return([]*ContactModels.Contact{
+		{UserID: ownerID, ContactUserID: userID, Alias: &alias},
+	}, nil)
+
+	err := uc.HandlePhoneChanged(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+79990001122", search.phones[ownerID.String()])
+	assert.Equal(t, alias, search.indexed[userID.String()])
+}
+
+func TestContactUsecase_HandlePhoneChanged_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockContactRepo, mockUserRepo, mocks.NewMockFileStorage(ctrl), &stubContactSearch{}, mocks.NewMockBlockRepository(ctrl), nil, nil, testImportSecret)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockContactRepo.EXPECT().GetContactsByContactUserID(ctx, userID).Return(nil, errors.New("database error"))
+
+	err := uc.HandlePhoneChanged(ctx, userID)
+
+	assert.Error(t, err)
+}
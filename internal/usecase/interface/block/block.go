@@ -0,0 +1,15 @@
+package block
+
+import (
+	"context"
+
+	BlockModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/block"
+	"github.com/google/uuid"
+)
+
+type BlockRepository interface {
+	BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error
+	UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error
+	GetBlocksByUserID(ctx context.Context, userID uuid.UUID) ([]*BlockModels.Block, error)
+	GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error)
+}
@@ -3,6 +3,7 @@ package chats
 import (
 	"context"
 
+	modelsAvatar "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
 	"github.com/google/uuid"
 )
@@ -19,6 +20,25 @@ type ChatsRepository interface {
 	DeleteChat(ctx context.Context, userID, chatID uuid.UUID) error
 	UpdateChat(ctx context.Context, userID, chatID uuid.UUID, name, description string) error
 	GetChatAvatars(ctx context.Context, userId uuid.UUID, chatIDs []uuid.UUID) (map[string]uuid.UUID, error)
-	UpdateChatAvatar(ctx context.Context, chatID uuid.UUID, attachmentID uuid.UUID, fileSize int64) error
+	UpdateChatAvatar(ctx context.Context, chatID uuid.UUID, attachmentID uuid.UUID, fileSize int64, sizes []int) error
+	GetChatAvatarHistory(ctx context.Context, chatID uuid.UUID) ([]*modelsAvatar.Avatar, error)
+	DeleteChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) ([]int, error)
+	SetCurrentChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) error
 	SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]modelsChats.Chat, error)
+	GetChatSettings(ctx context.Context, userID, chatID uuid.UUID) (*modelsChats.ChatSettings, error)
+	UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings modelsChats.ChatSettings) error
+	GetChatMembersNotifySettings(ctx context.Context, chatID uuid.UUID) ([]modelsChats.MemberNotifySettings, error)
+	GetGroupPeers(ctx context.Context, userID uuid.UUID, limit int) ([]uuid.UUID, error)
+	GetDialogPartners(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	SetChatUsername(ctx context.Context, chatID uuid.UUID, username string) error
+	ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*modelsChats.PublicChat, bool, error)
+	CreateInvite(ctx context.Context, invite *modelsChats.Invite) error
+	GetChatInvites(ctx context.Context, chatID uuid.UUID) ([]modelsChats.Invite, error)
+	RevokeInvite(ctx context.Context, chatID, inviteID uuid.UUID) error
+	GetInviteByCode(ctx context.Context, userID uuid.UUID, code string) (*modelsChats.Invite, bool, error)
+	JoinByInvite(ctx context.Context, inviteID uuid.UUID, member modelsChats.UserInfo) error
+	CreateJoinRequest(ctx context.Context, chatID, userID, inviteID uuid.UUID) error
+	GetJoinRequests(ctx context.Context, chatID uuid.UUID) ([]modelsChats.JoinRequest, error)
+	ApproveJoinRequest(ctx context.Context, member modelsChats.UserInfo) error
+	DeclineJoinRequest(ctx context.Context, chatID, userID uuid.UUID) error
 }
@@ -2,8 +2,10 @@ package contact
 
 import (
 	"context"
+	"time"
 
 	ContactModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/contact"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	"github.com/google/uuid"
 )
 
@@ -11,4 +13,25 @@ type ContactRepository interface {
 	CreateContact(ctx context.Context, user_id uuid.UUID, contact_user_id uuid.UUID) error
 	GetContactsByUserID(ctx context.Context, user_id uuid.UUID) ([]*ContactModels.Contact, error)
 	GetAllContacts(ctx context.Context) ([]*ContactModels.Contact, error)
+	// GetContactsByContactUserID возвращает записи всех, у кого contactUserID есть в контактах
+	GetContactsByContactUserID(ctx context.Context, contactUserID uuid.UUID) ([]*ContactModels.Contact, error)
+	// GetContactOwners возвращает тех из userIDs, у кого contactUserID есть в контактах
+	GetContactOwners(ctx context.Context, contactUserID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error)
+	DeleteContact(ctx context.Context, userID uuid.UUID, contactUserID uuid.UUID) error
+	UpdateContactAlias(ctx context.Context, userID uuid.UUID, contactUserID uuid.UUID, alias *string) error
+	GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	// ReserveImportQuota учитывает count проверяемых номеров; false - лимит за окно исчерпан
+	ReserveImportQuota(ctx context.Context, userID uuid.UUID, count, limit int, window time.Duration) (bool, error)
+	// MatchPhoneHashes возвращает зарегистрированных пользователей по хэшам номеров
+	MatchPhoneHashes(ctx context.Context, phoneHashes []string) (map[string]uuid.UUID, error)
+	// CreateContacts добавляет контакты пачкой и возвращает только новые
+	CreateContacts(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) ([]uuid.UUID, error)
+	SavePendingImports(ctx context.Context, userID uuid.UUID, phoneHashes []string) error
+	// ClaimPendingImports превращает ожидающие номера нового пользователя в контакты и возвращает их владельцев
+	ClaimPendingImports(ctx context.Context, phoneHash string, contactUserID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// ContactJoinNotifier сообщает пользователю, что человек из его адресной книги зарегистрировался
+type ContactJoinNotifier interface {
+	NotifyContactJoined(ctx context.Context, userID uuid.UUID, joined *UserModels.User) error
 }
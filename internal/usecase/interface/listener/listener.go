@@ -11,6 +11,7 @@ type ListenerMapInterface interface {
 	AddChatToUserSubscription(userID, chatID uuid.UUID) map[uuid.UUID]chan dto.WebSocketMessageDTO
 	GetOutgoingChannel(connectionID uuid.UUID) chan dto.WebSocketMessageDTO
 	RegisterUserConnection(userID, connectionID uuid.UUID, outgoingChan chan dto.WebSocketMessageDTO)
+	GetUserConnections(userID uuid.UUID) []uuid.UUID
 	CloseAll()
 	CleanInactiveChats() int
 	CleanInactiveReaders() int
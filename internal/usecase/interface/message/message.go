@@ -22,6 +22,9 @@ type MessageRepository interface {
 	InsertAttachment(ctx context.Context, attachment modelsAttachment.CreateAttachment, userID uuid.UUID) error
 	GetAttachmentByID(ctx context.Context, attachmentID uuid.UUID) (*modelsAttachment.Attachment, error)
 	CheckAttachmentOwnership(ctx context.Context, attachmentID, userID uuid.UUID) (bool, error)
-	LinkAttachmentToMessage(ctx context.Context, messageID, attachmentID, userID uuid.UUID) error
 	UpdateAttachmentType(ctx context.Context, attachmentID uuid.UUID, attachmentType string) error
+	InsertMentions(ctx context.Context, messageID, chatID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error)
+	DeleteMentionsExcept(ctx context.Context, messageID uuid.UUID, userIDs []uuid.UUID) error
+	GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]modelsMessage.Message, error)
+	MarkMentionsRead(ctx context.Context, userID, chatID uuid.UUID) error
 }
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/google/uuid"
+)
+
+type OutboxRepository interface {
+	ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]modelsOutbox.Event, error)
+	MarkPublished(ctx context.Context, ids []uuid.UUID) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Publisher потребитель событий outbox (realtime, поисковый индекс, вебхуки, push).
+// Событие может прийти повторно, поэтому Publish должен быть идемпотентным по DedupKey
+type Publisher interface {
+	Name() string
+	Publish(ctx context.Context, event modelsOutbox.Event) error
+}
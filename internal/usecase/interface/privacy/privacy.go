@@ -0,0 +1,14 @@
+package privacy
+
+import (
+	"context"
+
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	"github.com/google/uuid"
+)
+
+type PrivacyRepository interface {
+	// GetRules возвращает изменённые правила; для отсутствующих действует PrivacyModels.DefaultRule
+	GetRules(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]PrivacyModels.UserRules, error)
+	SetRule(ctx context.Context, userID uuid.UUID, rule *PrivacyModels.Rule) error
+}
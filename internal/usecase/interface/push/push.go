@@ -0,0 +1,20 @@
+package push
+
+import (
+	"context"
+
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	"github.com/google/uuid"
+)
+
+// Provider доставляет уведомление на устройство через конкретную платформу.
+// Если платформа сообщила, что токен больше не действует, возвращает errs.ErrPushTokenExpired
+type Provider interface {
+	Send(ctx context.Context, target modelsPush.Target, notification modelsPush.Notification) error
+}
+
+// TargetsClient читает и чистит push-токены, привязанные к сессиям в auth_service
+type TargetsClient interface {
+	GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error)
+	DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error
+}
@@ -2,23 +2,66 @@ package user
 
 import (
 	"context"
+	"time"
 
+	AvatarModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	"github.com/google/uuid"
 )
 
 type UserRepository interface {
 	GetUserByID(ctx context.Context, id uuid.UUID) (*UserModels.User, error)
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*UserModels.User, error)
 	GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error)
 	GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*UserModels.User, error)
-	UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatarID uuid.UUID, file_size int64) error
-	UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error
+	// ResolveUsername ищет по текущему handle или по прежнему, пока действует редирект
+	ResolveUsername(ctx context.Context, username string) (*UserModels.User, bool, error)
+	UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatarID uuid.UUID, file_size int64, sizes []int) error
+	UpdateUserInfo(ctx context.Context, userID uuid.UUID, update UserModels.InfoUpdate) error
+	GetUserProfile(ctx context.Context, userID uuid.UUID) (*UserModels.Profile, error)
+	UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error
 	GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]uuid.UUID, error)
+	GetAllUsers(ctx context.Context) ([]*UserModels.User, error)
+	GetUserAvatarHistory(ctx context.Context, userID uuid.UUID) ([]*AvatarModels.Avatar, error)
+	DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) ([]int, error)
+	SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error
 }
 
 type UserClient interface {
 	GetUserByID(ctx context.Context, id uuid.UUID) (*UserModels.User, error)
+	GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*UserModels.User, error)
 	GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error)
 	GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*UserModels.User, error)
+	// GetBlockedPeers возвращает тех из peerIDs, с кем у пользователя есть блокировка в любую сторону
+	GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error)
+	// GetGroupAddDenied возвращает тех из peerIDs, кто настройками приватности запретил userID добавлять себя в группы
+	GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error)
+	// GetContactAliases возвращает имена, которые userID дал пользователям из contactUserIDs в своих контактах
+	GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	// UpdateLastSeen сохраняет время последнего визита пользователя
+	UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error
+}
+
+// UserCacheStore - разделяемый между репликами уровень кэша профилей (Redis)
+type UserCacheStore interface {
+	GetUsers(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*UserModels.User, error)
+	SetUsers(ctx context.Context, users []*UserModels.User) error
+	// DeleteUsers удаляет записи и оповещает остальные реплики о сбросе
+	DeleteUsers(ctx context.Context, ids []uuid.UUID) error
+	// SubscribeInvalidations вызывает handler для id, сброшенных другими репликами, до отмены ctx
+	SubscribeInvalidations(ctx context.Context, handler func(ids []uuid.UUID)) error
+}
+
+// GroupPeersProvider возвращает пользователей, с которыми userID состоит в общих группах
+type GroupPeersProvider interface {
+	GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// ProfileEventPublisher оповещает другие сервисы об изменении профиля пользователя
+type ProfileEventPublisher interface {
+	PublishProfileChanged(ctx context.Context, userID uuid.UUID) error
+	// PublishStatusChanged рассылает новый статус собеседникам пользователя по диалогам
+	PublishStatusChanged(ctx context.Context, userID uuid.UUID, status UserModels.Status) error
 }
@@ -0,0 +1,11 @@
+package webhook
+
+import (
+	"context"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+)
+
+type WebhookDispatcher interface {
+	DispatchMessage(ctx context.Context, msg dto.MessageDTO)
+}
@@ -135,6 +135,19 @@ func (lm *ListenerMap) RegisterUserConnection(userID, connectionID uuid.UUID, ou
 	lm.userConnections[userID][connectionID] = outgoingChan
 }
 
+// GetUserConnections возвращает идентификаторы всех соединений пользователя
+func (lm *ListenerMap) GetUserConnections(userID uuid.UUID) []uuid.UUID {
+	lm.mu.RLock()
+	defer lm.mu.RUnlock()
+
+	result := make([]uuid.UUID, 0, len(lm.userConnections[userID]))
+	for connectionID := range lm.userConnections[userID] {
+		result = append(result, connectionID)
+	}
+
+	return result
+}
+
 func (lm *ListenerMap) CloseAll() {
 	lm.mu.Lock()
 	defer lm.mu.Unlock()
@@ -189,6 +202,7 @@ func (lm *ListenerMap) CleanInactiveReaders() int {
 		}
 	}
 
+	inactiveReadersDroppedTotal.Add(float64(cleanedCount))
 	lm.logger.Infof("Cleaned %d inactive readers", cleanedCount)
 	return cleanedCount
 }
@@ -0,0 +1,117 @@
+package message
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	bufferKindChat     = "chat"
+	bufferKindOutgoing = "outgoing"
+)
+
+var (
+	inactiveReadersDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "chats_listener_inactive_readers_dropped_total",
+			Help: "Total number of chat subscriptions dropped by CleanInactiveReaders because the reader fell behind",
+		},
+	)
+
+	listenerChannelsDesc = prometheus.NewDesc(
+		"chats_listener_channels",
+		"Number of channels in ListenerMap",
+		[]string{"kind"}, nil,
+	)
+
+	listenerBufferedMessagesDesc = prometheus.NewDesc(
+		"chats_listener_buffered_messages",
+		"Number of messages waiting in ListenerMap channel buffers",
+		[]string{"kind"}, nil,
+	)
+
+	listenerBufferOccupancyDesc = prometheus.NewDesc(
+		"chats_listener_buffer_occupancy_ratio",
+		"Distribution of ListenerMap channel buffer fill ratio (len/cap)",
+		[]string{"kind"}, nil,
+	)
+)
+
+// границы гистограммы заполненности: 1 - буфер полон и читатель будет отключён
+var occupancyBuckets = []float64{0.1, 0.25, 0.5, 0.75, 0.9, 1}
+
+// bufferStats - заполненность буферов каналов одного вида на момент сбора метрик
+type bufferStats struct {
+	channels int
+	buffered int
+	buckets  map[float64]uint64
+	count    uint64
+	sum      float64
+}
+
+func newBufferStats() *bufferStats {
+	return &bufferStats{buckets: make(map[float64]uint64, len(occupancyBuckets))}
+}
+
+func (s *bufferStats) observe(length, capacity int) {
+	s.channels++
+	s.buffered += length
+	if capacity == 0 {
+		return
+	}
+
+	ratio := float64(length) / float64(capacity)
+	s.count++
+	s.sum += ratio
+	for _, bound := range occupancyBuckets {
+		if ratio <= bound {
+			s.buckets[bound]++
+		}
+	}
+}
+
+// bufferStats собирает заполненность буферов каналов чатов и исходящих каналов соединений
+func (lm *ListenerMap) bufferStats() map[string]*bufferStats {
+	lm.mu.RLock()
+	defer lm.mu.RUnlock()
+
+	stats := map[string]*bufferStats{
+		bufferKindChat:     newBufferStats(),
+		bufferKindOutgoing: newBufferStats(),
+	}
+
+	for _, connections := range lm.data {
+		for _, ch := range connections {
+			stats[bufferKindChat].observe(len(ch), cap(ch))
+		}
+	}
+	for _, ch := range lm.outgoingChannels {
+		stats[bufferKindOutgoing].observe(len(ch), cap(ch))
+	}
+
+	return stats
+}
+
+// listenerMapCollector отдаёт состояние ListenerMap в Prometheus в момент сбора метрик
+type listenerMapCollector struct {
+	lm *ListenerMap
+}
+
+// NewListenerMapCollector возвращает коллектор числа каналов и заполненности их буферов
+func NewListenerMapCollector(lm *ListenerMap) prometheus.Collector {
+	return &listenerMapCollector{lm: lm}
+}
+
+func (c *listenerMapCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- listenerChannelsDesc
+	ch <- listenerBufferedMessagesDesc
+	ch <- listenerBufferOccupancyDesc
+}
+
+func (c *listenerMapCollector) Collect(ch chan<- prometheus.Metric) {
+	for kind, stats := range c.lm.bufferStats() {
+		ch <- prometheus.MustNewConstMetric(listenerChannelsDesc, prometheus.GaugeValue, float64(stats.channels), kind)
+		ch <- prometheus.MustNewConstMetric(listenerBufferedMessagesDesc, prometheus.GaugeValue, float64(stats.buffered), kind)
+		ch <- prometheus.MustNewConstHistogram(listenerBufferOccupancyDesc, stats.count, stats.sum, stats.buckets, kind)
+	}
+}
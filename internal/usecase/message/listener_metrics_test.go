@@ -0,0 +1,68 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenerMapCollector(t *testing.T) {
+	lm := NewListenerMap()
+	chatID := uuid.New()
+
+	fullConnectionID := uuid.New()
+	_ = lm.SubscribeConnectionToChat(fullConnectionID, chatID, uuid.New())
+	_ = lm.SubscribeConnectionToChat(uuid.New(), chatID, uuid.New())
+	out := lm.GetOutgoingChannel(uuid.New())
+
+	lm.mu.Lock()
+	fullCh := lm.data[chatID][fullConnectionID]
+	for i := 0; i < cap(fullCh); i++ {
+		fullCh <- dtoMessage.WebSocketMessageDTO{}
+	}
+	lm.mu.Unlock()
+	out <- dtoMessage.WebSocketMessageDTO{}
+
+	expected := `
+# HELP chats_listener_buffered_messages Number of messages waiting in ListenerMap channel buffers
+# TYPE chats_listener_buffered_messages gauge
+chats_listener_buffered_messages{kind="chat"} 50
+chats_listener_buffered_messages{kind="outgoing"} 1
+# HELP chats_listener_channels Number of channels in ListenerMap
+# TYPE chats_listener_channels gauge
+chats_listener_channels{kind="chat"} 2
+chats_listener_channels{kind="outgoing"} 1
+`
+	collector := NewListenerMapCollector(lm)
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"chats_listener_channels", "chats_listener_buffered_messages"))
+
+	// гистограмма: один пустой и один полный буфер чата
+	stats := lm.bufferStats()[bufferKindChat]
+	assert.Equal(t, uint64(2), stats.count)
+	assert.Equal(t, uint64(1), stats.buckets[0.1])
+	assert.Equal(t, uint64(2), stats.buckets[1])
+}
+
+func TestListenerMap_CleanInactiveReaders_CountsDropped(t *testing.T) {
+	lm := NewListenerMap()
+	chatID := uuid.New()
+	connectionID := uuid.New()
+	_ = lm.SubscribeConnectionToChat(connectionID, chatID, uuid.New())
+
+	lm.mu.Lock()
+	ch := lm.data[chatID][connectionID]
+	for i := 0; i < cap(ch); i++ {
+		ch <- dtoMessage.WebSocketMessageDTO{}
+	}
+	lm.mu.Unlock()
+
+	before := testutil.ToFloat64(inactiveReadersDroppedTotal)
+	assert.Equal(t, 1, lm.CleanInactiveReaders())
+	assert.Equal(t, before+1, testutil.ToFloat64(inactiveReadersDroppedTotal))
+}
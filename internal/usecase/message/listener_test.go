@@ -326,3 +326,19 @@ func TestMessageUsecase_ListenerMap_RegisterUserConnection_ThenAddChat(t *testin
 	assert.Len(t, listeners, 1)
 	assert.Contains(t, listeners, connectionID)
 }
+
+func TestMessageUsecase_ListenerMap_GetUserConnections(t *testing.T) {
+	lm := NewListenerMap()
+	userID := uuid.New()
+	connection1 := uuid.New()
+	connection2 := uuid.New()
+
+	assert.Empty(t, lm.GetUserConnections(userID))
+
+	lm.RegisterUserConnection(userID, connection1, make(chan dtoMessage.WebSocketMessageDTO, 1))
+	lm.SubscribeConnectionToChat(connection2, uuid.New(), userID)
+
+	connections := lm.GetUserConnections(userID)
+	assert.Len(t, connections, 2)
+	assert.ElementsMatch(t, []uuid.UUID{connection1, connection2}, connections)
+}
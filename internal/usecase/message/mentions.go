@@ -0,0 +1,180 @@
+package message
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/utils"
+	"github.com/google/uuid"
+)
+
+// MaxMentionsPerMessage ограничивает число упоминаний, обрабатываемых в одном сообщении
+const MaxMentionsPerMessage = 20
+
+// Упоминание - @username, перед которым нет символа имени пользователя
+var mentionRegexp = regexp.MustCompile(`(?:^|[^a-zA-Z0-9_@])@([a-zA-Z0-9_]{3,20})\b`)
+
+// ParseMentions возвращает уникальные username, упомянутые в тексте, в порядке появления
+func ParseMentions(text string) []string {
+	matches := mentionRegexp.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(matches))
+	usernames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		username := match[1]
+		if _, ok := seen[username]; ok {
+			continue
+		}
+
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+
+		if len(usernames) == MaxMentionsPerMessage {
+			break
+		}
+	}
+
+	return usernames
+}
+
+// resolveMentions находит пользователей, упомянутых в тексте; автор сообщения и несуществующие username пропускаются
+func (uc *MessageUsecase) resolveMentions(ctx context.Context, text string, authorID uuid.UUID) []uuid.UUID {
+	const op = "MessageUsecase.resolveMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	usernames := ParseMentions(text)
+	userIDs := make([]uuid.UUID, 0, len(usernames))
+	for _, username := range usernames {
+		user, err := uc.userClient.GetUserByUsername(ctx, username)
+		if err != nil {
+			logger.WithError(err).Debugf("mentioned user %s not found", username)
+			continue
+		}
+
+		if user.ID == authorID {
+			continue
+		}
+
+		userIDs = append(userIDs, user.ID)
+	}
+
+	return userIDs
+}
+
+// saveMentions сохраняет упоминания нового сообщения и уведомляет упомянутых участников чата.
+// Уведомление получают только пользователи, чьи упоминания вставлены сейчас, поэтому повтор безопасен
+func (uc *MessageUsecase) saveMentions(ctx context.Context, msg dtoMessage.MessageDTO, authorID uuid.UUID) error {
+	const op = "MessageUsecase.saveMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userIDs := uc.resolveMentions(ctx, msg.Text, authorID)
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	mentioned, err := uc.messageRepository.InsertMentions(ctx, msg.ID, msg.ChatID, userIDs)
+	if err != nil {
+		logger.WithError(err).Errorf("could not save mentions of message %s", msg.ID)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.notifyMentioned(ctx, mentioned, msg)
+	return nil
+}
+
+// updateMentions синхронизирует упоминания отредактированного сообщения с его новым текстом.
+// Уведомление получают только пользователи, упомянутые впервые.
+func (uc *MessageUsecase) updateMentions(ctx context.Context, msg dtoMessage.MessageDTO, authorID uuid.UUID) {
+	const op = "MessageUsecase.updateMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	userIDs := uc.resolveMentions(ctx, msg.Text, authorID)
+
+	if err := uc.messageRepository.DeleteMentionsExcept(ctx, msg.ID, userIDs); err != nil {
+		logger.WithError(err).Errorf("could not delete stale mentions of message %s", msg.ID)
+		return
+	}
+
+	if len(userIDs) == 0 {
+		return
+	}
+
+	mentioned, err := uc.messageRepository.InsertMentions(ctx, msg.ID, msg.ChatID, userIDs)
+	if err != nil {
+		logger.WithError(err).Errorf("could not save mentions of message %s", msg.ID)
+		return
+	}
+
+	if len(mentioned) == 0 {
+		return
+	}
+
+	if msg.SenderName == nil {
+		if author, err := uc.userClient.GetUserByID(ctx, authorID); err == nil {
+			msg.SenderName = &author.Name
+		}
+	}
+
+	uc.notifyMentioned(ctx, mentioned, msg)
+}
+
+// notifyMentioned отправляет событие mention напрямую в соединения пользователей, минуя подписки на чат,
+// поэтому упоминание доставляется и тем, у кого чат заглушен
+func (uc *MessageUsecase) notifyMentioned(ctx context.Context, userIDs []uuid.UUID, msg dtoMessage.MessageDTO) {
+	const op = "MessageUsecase.notifyMentioned"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	event := dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeMention,
+		ChatID: msg.ChatID,
+		Value:  msg,
+	}
+
+	for _, userID := range userIDs {
+		for _, connectionID := range uc.listenerMap.GetUserConnections(userID) {
+			select {
+			case uc.listenerMap.GetOutgoingChannel(connectionID) <- event:
+			default:
+				logger.Warningf("outgoing channel of connection %s is full, mention dropped", connectionID)
+			}
+		}
+	}
+}
+
+// GetUnreadMentions возвращает непрочитанные упоминания пользователя в чате, новые первыми
+func (uc *MessageUsecase) GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]dtoMessage.MessageDTO, error) {
+	const op = "MessageUsecase.GetUnreadMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	messages, err := uc.messageRepository.GetUnreadMentions(ctx, userID, chatID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get unread mentions")
+		return nil, err
+	}
+
+	messagesDTO := make([]dtoMessage.MessageDTO, 0, len(messages))
+	for _, msg := range messages {
+		messagesDTO = append(messagesDTO, utils.ConvertMessageToDTO(ctx, msg, uc.fileStorage))
+	}
+
+	return messagesDTO, nil
+}
+
+// ReadMentions отмечает все упоминания пользователя в чате прочитанными
+func (uc *MessageUsecase) ReadMentions(ctx context.Context, userID, chatID uuid.UUID) error {
+	const op = "MessageUsecase.ReadMentions"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if err := uc.messageRepository.MarkMentionsRead(ctx, userID, chatID); err != nil {
+		logger.WithError(err).Error("failed to mark mentions as read")
+		return err
+	}
+
+	return nil
+}
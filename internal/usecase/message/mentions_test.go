@@ -0,0 +1,132 @@
+package message
+
+import (
+	"context"
+	"testing"
+
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "no mentions", text: "просто текст", want: nil},
+		{name: "single", text: "@alice привет", want: []string{"alice"}},
+		{name: "several with duplicates", text: "@alice, @bob_1 и снова @alice", want: []string{"alice", "bob_1"}},
+		{name: "email is not mention", text: "пиши на mail@example.com", want: nil},
+		{name: "too short", text: "@ab", want: nil},
+		{name: "too long", text: "@abcdefghijklmnopqrstuvwxyz", want: nil},
+		{name: "punctuation after", text: "спасибо, @alice!", want: []string{"alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseMentions(tt.text))
+		})
+	}
+}
+
+func TestMessageUsecase_EditMessage_NotifiesOnlyNewMentions(t *testing.T) {
+	uc, mockMessageRepo, mockUserRepo, _, _, mockListenerMap := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	messageID := uuid.New()
+	oldMentionID := uuid.New()
+	newMentionID := uuid.New()
+	connectionID := uuid.New()
+
+	mockMessageRepo.EXPECT().GetMessageByID(ctx, messageID).Return(modelsMessage.Message{
+		ID:     messageID,
+		ChatID: chatID,
+		UserID: &userID,
+		Text:   "@alice привет",
+		Type:   modelsMessage.MessageTypeUser,
+	}, nil)
+	mockMessageRepo.EXPECT().UpdateMessage(ctx, messageID, "@alice @bob привет").Return(nil)
+
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(&modelsUser.User{ID: oldMentionID}, nil)
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "bob").Return(&modelsUser.User{ID: newMentionID}, nil)
+	mockMessageRepo.EXPECT().DeleteMentionsExcept(ctx, messageID, []uuid.UUID{oldMentionID, newMentionID}).Return(nil)
+	mockMessageRepo.EXPECT().InsertMentions(ctx, messageID, chatID, []uuid.UUID{oldMentionID, newMentionID}).Return([]uuid.UUID{newMentionID}, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Author"}, nil)
+
+	outChan := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	mockListenerMap.EXPECT().GetUserConnections(newMentionID).Return([]uuid.UUID{connectionID})
+	mockListenerMap.EXPECT().GetOutgoingChannel(connectionID).Return(outChan)
+
+	err := uc.EditMessage(ctx, dtoMessage.EditMessageDTO{ID: messageID, Text: "@alice @bob привет"}, userID)
+	assert.NoError(t, err)
+
+	event := <-outChan
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeMention, event.Type)
+	mentionDTO, ok := event.Value.(dtoMessage.MessageDTO)
+	assert.True(t, ok)
+	assert.Equal(t, "Author", *mentionDTO.SenderName)
+}
+
+func TestMessageUsecase_EditMessage_RemovesMentions(t *testing.T) {
+	uc, mockMessageRepo, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	messageID := uuid.New()
+
+	mockMessageRepo.EXPECT().GetMessageByID(ctx, messageID).Return(modelsMessage.Message{
+		ID:     messageID,
+		ChatID: uuid.New(),
+		UserID: &userID,
+		Text:   "@alice привет",
+	}, nil)
+	mockMessageRepo.EXPECT().UpdateMessage(ctx, messageID, "привет").Return(nil)
+	mockMessageRepo.EXPECT().DeleteMentionsExcept(ctx, messageID, []uuid.UUID{}).Return(nil)
+
+	err := uc.EditMessage(ctx, dtoMessage.EditMessageDTO{ID: messageID, Text: "привет"}, userID)
+	assert.NoError(t, err)
+}
+
+func TestMessageUsecase_GetUnreadMentions(t *testing.T) {
+	uc, mockMessageRepo, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	senderID := uuid.New()
+	senderName := "Sender"
+
+	mockMessageRepo.EXPECT().GetUnreadMentions(ctx, userID, chatID).Return([]modelsMessage.Message{
+		{ID: uuid.New(), ChatID: chatID, UserID: &senderID, UserName: &senderName, Text: "@user привет", Type: modelsMessage.MessageTypeUser},
+	}, nil)
+
+	result, err := uc.GetUnreadMentions(ctx, userID, chatID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "@user привет", result[0].Text)
+}
+
+func TestMessageUsecase_ReadMentions(t *testing.T) {
+	uc, mockMessageRepo, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+
+	mockMessageRepo.EXPECT().MarkMentionsRead(ctx, userID, chatID).Return(assert.AnError)
+
+	err := uc.ReadMentions(ctx, userID, chatID)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -21,6 +21,7 @@ import (
 	interfaceMessageUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/message"
 	interfaceFileStorage "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/storage"
 	interfaceUserUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/outbox"
 	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/utils"
 	"github.com/google/uuid"
 )
@@ -31,6 +32,7 @@ const (
 	MessagesGLobalBuffer          = 1000
 	DistributorsCount             = 1
 	ClearsCount                   = 1
+	RealtimeDedupSize             = 10000
 )
 
 type MessageUsecase struct {
@@ -38,6 +40,8 @@ type MessageUsecase struct {
 	messageRepository interfaceMessageUsecase.MessageRepository
 	userClient        interfaceUserUsecase.UserClient
 	chatsRepository   interfaceChatsUsecase.ChatsRepository
+	// realtimeDedup отбрасывает повторно доставленные relay события outbox
+	realtimeDedup *outbox.Deduplicator
 
 	listenerMap                    interfaceListenerMap.ListenerMapInterface
 	distributeChannel              chan dtoMessage.WebSocketMessageDTO
@@ -48,6 +52,10 @@ type MessageUsecase struct {
 
 	ctx    context.Context
 	cancel context.CancelFunc
+
+	// shuttingDown закрывается раньше ctx: потоки событий завершаются, а рассылка ещё работает
+	shuttingDown chan struct{}
+	shutdownOnce sync.Once
 }
 
 func NewMessageUsecase(messageRepository interfaceMessageUsecase.MessageRepository, userClient interfaceUserUsecase.UserClient, chatsRepository interfaceChatsUsecase.ChatsRepository, fileStorage interfaceFileStorage.FileStorage, listenerMap interfaceListenerMap.ListenerMapInterface) *MessageUsecase {
@@ -58,9 +66,11 @@ func NewMessageUsecase(messageRepository interfaceMessageUsecase.MessageReposito
 		userClient:             userClient,
 		chatsRepository:        chatsRepository,
 		fileStorage:            fileStorage,
+		realtimeDedup:          outbox.NewDeduplicator(RealtimeDedupSize),
 		distributeChannel:      make(chan dtoMessage.WebSocketMessageDTO, MessagesGLobalBuffer),
 		ctx:                    ctx,
 		cancel:                 cancel,
+		shuttingDown:           make(chan struct{}),
 		connectionContext:      make(map[uuid.UUID]context.Context),
 		connectionContextCount: make(map[uuid.UUID]int),
 	}
@@ -77,6 +87,41 @@ func NewMessageUsecase(messageRepository interfaceMessageUsecase.MessageReposito
 	return uc
 }
 
+// checkDialogNotBlocked запрещает писать в диалог, если один из собеседников заблокировал другого
+func (uc *MessageUsecase) checkDialogNotBlocked(ctx context.Context, chatID, userID uuid.UUID) error {
+	chat, err := uc.chatsRepository.GetChat(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.Type != modelsChats.ChatTypeDialog {
+		return nil
+	}
+
+	members, err := uc.chatsRepository.GetUsersOfChat(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	peerIDs := make([]uuid.UUID, 0, 1)
+	for _, member := range members {
+		if member.UserID != userID {
+			peerIDs = append(peerIDs, member.UserID)
+		}
+	}
+
+	blocked, err := uc.userClient.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(blocked) > 0 {
+		return errs.ErrUserBlocked
+	}
+
+	return nil
+}
+
 func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateMessageDTO, userId uuid.UUID) error {
 	const op = "MessageUsecase.AddMessage"
 
@@ -94,6 +139,11 @@ func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateM
 		return errs.ErrNoRights
 	}
 
+	if err := uc.checkDialogNotBlocked(ctx, msg.ChatId, userId); err != nil {
+		logger.WithError(err).Warningf("user %s can't write to dialog %s", userId, msg.ChatId)
+		return err
+	}
+
 	user, err := uc.userClient.GetUserByID(ctx, userId)
 	if err != nil {
 		logger.WithError(err).Warningf("could not get user %s", userId)
@@ -118,9 +168,6 @@ func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateM
 		UserID:    &user.ID,
 	}
 
-	var msgID uuid.UUID
-	var attachmentDTO *dtoMessage.AttachmentDTO
-
 	// Обработка вложений
 	if msg.Attachment != nil {
 		// Валидация типа вложения
@@ -156,18 +203,10 @@ func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateM
 				Duration:           nil,
 			}
 
-			msgID, err = uc.messageRepository.InsertMessageWithAttachment(ctx, msgCreateModel)
-			if err != nil {
+			if _, err := uc.messageRepository.InsertMessageWithAttachment(ctx, msgCreateModel); err != nil {
 				logger.WithError(err).Error("could not insert message with sticker")
 				return err
 			}
-
-			attachmentDTO = &dtoMessage.AttachmentDTO{
-				ID:       &attachmentID,
-				Type:     &msg.Attachment.Type,
-				FileURL:  msg.Attachment.AttachmentID,
-				Duration: nil,
-			}
 		} else {
 			// В msg.Attachment.FileURL приходит attachment_id (в виде строки)
 			attachmentID, err := uuid.Parse(msg.Attachment.AttachmentID)
@@ -188,13 +227,6 @@ func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateM
 				return errs.ErrNoRights
 			}
 
-			// Получаем информацию о вложении
-			attachment, err := uc.messageRepository.GetAttachmentByID(ctx, attachmentID)
-			if err != nil {
-				logger.WithError(err).Errorf("could not get attachment %s", attachmentID)
-				return err
-			}
-
 			// Обновляем тип вложения из сообщения
 			err = uc.messageRepository.UpdateAttachmentType(ctx, attachmentID, msg.Attachment.Type)
 			if err != nil {
@@ -202,60 +234,22 @@ func (uc *MessageUsecase) AddMessage(ctx context.Context, msg dtoMessage.CreateM
 				return err
 			}
 
-			// Создаём обычное сообщение
-			msgID, err = uc.messageRepository.InsertMessage(ctx, msgCreateModel)
-			if err != nil {
+			// Создаём обычное сообщение, вложение привязывается в той же транзакции
+			msgCreateModel.PendingAttachmentID = &attachmentID
+			if _, err := uc.messageRepository.InsertMessage(ctx, msgCreateModel); err != nil {
 				logger.WithError(err).Error("could not insert message")
 				return err
 			}
-
-			err = uc.messageRepository.LinkAttachmentToMessage(ctx, msgID, attachmentID, userId)
-			if err != nil {
-				logger.WithError(err).Error("could not link attachment to message")
-				return err
-			}
-
-			attachmentURL, err := uc.fileStorage.GetOne(ctx, &attachment.ID)
-			if err != nil {
-				logger.Warningf("could not get url of file with id %s", attachment.ID.String())
-			}
-
-			attachmentDTO = &dtoMessage.AttachmentDTO{
-				ID:       &attachment.ID,
-				Type:     &msg.Attachment.Type,
-				FileURL:  attachmentURL,
-				Duration: attachment.Duration,
-			}
 		}
 	} else {
 		// Обычное текстовое сообщение
-		msgID, err = uc.messageRepository.InsertMessage(ctx, msgCreateModel)
-		if err != nil {
+		if _, err := uc.messageRepository.InsertMessage(ctx, msgCreateModel); err != nil {
 			return err
 		}
 	}
 
-	msgDTO := dtoMessage.MessageDTO{
-		ID:         msgID,
-		SenderID:   &user.ID,
-		SenderName: &user.Name,
-		Text:       msg.Text,
-		CreatedAt:  msg.CreatedAt,
-		UpdatedAt:  msg.CreatedAt,
-		ChatID:     msg.ChatId,
-		Type:       modelsMessage.MessageTypeUser,
-		Attachment: attachmentDTO,
-	}
-
-	err = uc.sendWebsocketMessage(dtoMessage.WebSocketMessageDTO{
-		Type:   dtoMessage.WebSocketMessageTypeNewChatMessage,
-		ChatID: msg.ChatId,
-		Value:  msgDTO,
-	})
-	if err != nil {
-		return err
-	}
-
+	// Подписчикам чата сообщение разошлёт relay outbox: событие записано вместе с сообщением.
+	// Упоминания и вебхуки ботов обрабатывают его же потребители MentionsPublisher и WebhooksPublisher
 	return nil
 }
 
@@ -356,7 +350,11 @@ func (uc *MessageUsecase) distributeToOutChannel(connectionID uuid.UUID, in <-ch
 
 		for {
 			select {
-			case ch := <-chatChan:
+			case ch, ok := <-chatChan:
+				// Канал чата закрыт (CloseAll или очистка медленного читателя) - пересылать больше нечего
+				if !ok {
+					return
+				}
 				out <- ch
 
 			case <-ctx.Done():
@@ -366,10 +364,25 @@ func (uc *MessageUsecase) distributeToOutChannel(connectionID uuid.UUID, in <-ch
 	}(in)
 }
 
+// ShutdownStreams просит потоки событий завершиться. Рассылка продолжает работать,
+// чтобы RPC, которые ещё выполняются во время GracefulStop, могли её использовать
+func (uc *MessageUsecase) ShutdownStreams() {
+	uc.shutdownOnce.Do(func() {
+		close(uc.shuttingDown)
+	})
+}
+
+// Stop останавливает рассылку. Вызывается после остановки gRPC-сервера
 func (uc *MessageUsecase) Stop() {
+	uc.ShutdownStreams()
 	uc.cancel()
 }
 
+// ShuttingDown закрывается после вызова ShutdownStreams или Stop, потоки событий по нему узнают об остановке сервиса
+func (uc *MessageUsecase) ShuttingDown() <-chan struct{} {
+	return uc.shuttingDown
+}
+
 func (uc *MessageUsecase) EditMessage(ctx context.Context, msg dtoMessage.EditMessageDTO, userID uuid.UUID) error {
 	const op = "MessageUsecase.EditMessage"
 	logger := domains.GetLogger(ctx).WithField("operation", op)
@@ -394,14 +407,15 @@ func (uc *MessageUsecase) EditMessage(ctx context.Context, msg dtoMessage.EditMe
 		return err
 	}
 
-	err = uc.sendWebsocketMessage(dtoMessage.WebSocketMessageDTO{
-		Type:   dtoMessage.WebSocketMessageTypeEditChatMessage,
-		ChatID: message.ChatID,
-		Value:  msg,
-	})
-	if err != nil {
-		return err
-	}
+	uc.updateMentions(ctx, dtoMessage.MessageDTO{
+		ID:        message.ID,
+		SenderID:  message.UserID,
+		Text:      msg.Text,
+		CreatedAt: message.CreatedAt,
+		UpdatedAt: msg.UpdatedAt,
+		ChatID:    message.ChatID,
+		Type:      message.Type,
+	}, userID)
 
 	return nil
 }
@@ -432,15 +446,6 @@ func (uc *MessageUsecase) DeleteMessage(ctx context.Context, msg dtoMessage.Dele
 		return err
 	}
 
-	err = uc.sendWebsocketMessage(dtoMessage.WebSocketMessageDTO{
-		Type:   dtoMessage.WebSocketMessageTypeDeleteChatMessage,
-		ChatID: message.ChatID,
-		Value:  msg,
-	})
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -498,7 +503,7 @@ func (uc *MessageUsecase) AddMessageJoinUsers(ctx context.Context, chatID uuid.U
 		}
 
 		for i := range users {
-			messageID, err := uc.messageRepository.InsertMessage(ctx, modelsMessage.CreateMessage{
+			_, err := uc.messageRepository.InsertMessage(ctx, modelsMessage.CreateMessage{
 				ChatID:    chatID,
 				UserID:    &users[i].UserId,
 				Text:      fmt.Sprintf("Пользователь %s вступил в группу", usersNames[i]),
@@ -509,32 +514,129 @@ func (uc *MessageUsecase) AddMessageJoinUsers(ctx context.Context, chatID uuid.U
 			if err != nil {
 				return err
 			}
+		}
+	}
+	return nil
+}
 
-			now := time.Now()
-
-			uc.sendWebsocketMessage(dtoMessage.WebSocketMessageDTO{
-				Type:   dtoMessage.WebSocketMessageTypeNewChatMessage,
-				ChatID: chatID,
-				Value: dtoMessage.MessageDTO{
-					ID:        messageID,
-					SenderID:  &users[i].UserId,
-					Text:      fmt.Sprintf("Пользователь %s вступил в группу", usersNames[i]),
-					CreatedAt: now,
-					UpdatedAt: now,
-					ChatID:    chatID,
-					Type:      modelsMessage.MessageTypeSystem,
-				},
-			})
+// NotifyUser отправляет системное уведомление во все активные соединения пользователя
+func (uc *MessageUsecase) NotifyUser(ctx context.Context, userID uuid.UUID, notification dtoMessage.SystemNotificationDTO) error {
+	const op = "MessageUsecase.NotifyUser"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+
+	msg := dtoMessage.WebSocketMessageDTO{
+		Type:  dtoMessage.WebSocketMessageTypeSystemNotification,
+		Value: notification,
+	}
+
+	for _, connectionID := range uc.listenerMap.GetUserConnections(userID) {
+		select {
+		case uc.listenerMap.GetOutgoingChannel(connectionID) <- msg:
+		default:
+			// Читатель не успевает обрабатывать сообщения, не блокируемся на нём
+			logger.Warningf("outgoing channel of connection %s is full, notification dropped", connectionID)
+		}
+	}
+
+	return nil
+}
+
+// NotifyChatSettings рассылает соединениям пользователя его новые настройки чата,
+// чтобы другие устройства и поток событий узнали об изменении заглушения
+func (uc *MessageUsecase) NotifyChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings dtoChats.ChatSettingsDTO) error {
+	const op = "MessageUsecase.NotifyChatSettings"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	msg := dtoMessage.WebSocketMessageDTO{
+		Type:   dtoMessage.WebSocketMessageTypeChatSettings,
+		ChatID: chatID,
+		Value:  settings,
+	}
+
+	for _, connectionID := range uc.listenerMap.GetUserConnections(userID) {
+		select {
+		case uc.listenerMap.GetOutgoingChannel(connectionID) <- msg:
+		default:
+			logger.Warningf("outgoing channel of connection %s is full, chat settings dropped", connectionID)
 		}
 	}
+
+	return nil
+}
+
+// UpdateLastSeen сохраняет время последнего визита в user_service.
+// Ошибка не критична: время обновится при следующем отключении пользователя
+func (uc *MessageUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID) {
+	const op = "MessageUsecase.UpdateLastSeen"
+
+	if err := uc.userClient.UpdateLastSeen(ctx, userID, time.Now()); err != nil {
+		domains.GetLogger(ctx).WithField("operation", op).WithError(err).Warnf("could not update last seen of user %s", userID)
+	}
+}
+
+// NotifyUserStatus рассылает новый статус пользователя собеседникам по личным диалогам.
+// Собеседники, с которыми есть блокировка в любую сторону, статус не получают
+func (uc *MessageUsecase) NotifyUserStatus(ctx context.Context, userStatus dtoMessage.UserStatusDTO) error {
+	const op = "MessageUsecase.NotifyUserStatus"
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userStatus.UserID.String())
+
+	partners, err := uc.chatsRepository.GetDialogPartners(ctx, userStatus.UserID)
+	if err != nil {
+		logger.WithError(err).Error("could not get dialog partners")
+		return err
+	}
+	if len(partners) == 0 {
+		return nil
+	}
+
+	partnerIDs := make([]uuid.UUID, 0, len(partners))
+	for partnerID := range partners {
+		partnerIDs = append(partnerIDs, partnerID)
+	}
+
+	blocked, err := uc.userClient.GetBlockedPeers(ctx, userStatus.UserID, partnerIDs)
+	if err != nil {
+		logger.WithError(err).Error("could not get blocked peers")
+		return err
+	}
+	for _, blockedID := range blocked {
+		delete(partners, blockedID)
+	}
+
+	for partnerID, dialogID := range partners {
+		msg := dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeUserStatus,
+			ChatID: dialogID,
+			Value:  userStatus,
+		}
+
+		for _, connectionID := range uc.listenerMap.GetUserConnections(partnerID) {
+			select {
+			case uc.listenerMap.GetOutgoingChannel(connectionID) <- msg:
+			default:
+				logger.Warningf("outgoing channel of connection %s is full, user status dropped", connectionID)
+			}
+		}
+	}
+
 	return nil
 }
 
-func (uc *MessageUsecase) sendWebsocketMessage(msg dtoMessage.WebSocketMessageDTO) error {
+func (uc *MessageUsecase) sendWebsocketMessage(ctx context.Context, msg dtoMessage.WebSocketMessageDTO) error {
+	msg.EnqueuedAt = time.Now()
+
 	select {
 	case uc.distributeChannel <- msg:
 		// Всё ок :-)
 		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-uc.ctx.Done():
+		return uc.ctx.Err()
 	case <-time.After(10 * time.Second):
 		return errs.ErrServiceIsOverloaded
 	}
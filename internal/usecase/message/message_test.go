@@ -19,11 +19,11 @@ import (
 )
 
 // setupMessageUsecase создает MessageUsecase с настроенными mock-объектами для тестирования
-func setupMessageUsecase(t *testing.T) (*MessageUsecase, *mocks.MockMessageRepository, *mocks.MockUserRepository, *mocks.MockChatsRepository, *mocks.MockFileStorage, *mocks.MockListenerMapInterface) {
+func setupMessageUsecase(t *testing.T) (*MessageUsecase, *mocks.MockMessageRepository, *mocks.MockUserClient, *mocks.MockChatsRepository, *mocks.MockFileStorage, *mocks.MockListenerMapInterface) {
 	ctrl := gomock.NewController(t)
 
 	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
-	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserClient(ctrl)
 	mockChatsRepo := mocks.NewMockChatsRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
 	mockListenerMap := mocks.NewMockListenerMapInterface(ctrl)
@@ -53,6 +53,26 @@ func TestMessageUsecase_NewMessageUsecase(t *testing.T) {
 	assert.Equal(t, mockListenerMap, uc.listenerMap)
 }
 
+func TestMessageUsecase_ShutdownStreamsKeepsDistribution(t *testing.T) {
+	uc, _, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	uc.ShutdownStreams()
+
+	select {
+	case <-uc.ShuttingDown():
+	default:
+		t.Fatal("streams should be notified about shutdown")
+	}
+
+	// RPC, завершающиеся во время GracefulStop, всё ещё отправляют события
+	assert.NoError(t, uc.ctx.Err())
+	assert.NoError(t, uc.sendWebsocketMessage(context.Background(), dtoMessage.WebSocketMessageDTO{ChatID: uuid.New()}))
+
+	uc.Stop()
+	assert.Error(t, uc.ctx.Err())
+}
+
 func TestMessageUsecase_AddMessage_Success(t *testing.T) {
 	uc, mockMessageRepo, mockUserRepo, mockChatsRepo, _, _ := setupMessageUsecase(t)
 	defer uc.Stop()
@@ -75,6 +95,7 @@ func TestMessageUsecase_AddMessage_Success(t *testing.T) {
 
 	// Проверяем права пользователя (false означает, что пользователь НЕ viewer, то есть имеет права)
 	mockChatsRepo.EXPECT().CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleViewer).Return(false, nil)
+	mockChatsRepo.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup}, nil)
 
 	// Получаем пользователя
 	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil)
@@ -126,6 +147,7 @@ func TestMessageUsecase_AddMessage_UserNotFound(t *testing.T) {
 
 	// Пользователь имеет права
 	mockChatsRepo.EXPECT().CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleViewer).Return(false, nil)
+	mockChatsRepo.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup}, nil)
 
 	// Пользователь не найден
 	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(nil, errors.New("user not found"))
@@ -136,6 +158,59 @@ func TestMessageUsecase_AddMessage_UserNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "user not found")
 }
 
+func TestMessageUsecase_AddMessage_DialogBlocked(t *testing.T) {
+	uc, _, mockUserRepo, mockChatsRepo, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	peerID := uuid.New()
+	chatID := uuid.New()
+
+	msg := dtoMessage.CreateMessageDTO{
+		ChatId:    chatID,
+		Text:      "Test message",
+		CreatedAt: time.Now(),
+	}
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleViewer).Return(false, nil)
+	mockChatsRepo.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeDialog}, nil)
+	mockChatsRepo.EXPECT().GetUsersOfChat(ctx, chatID).Return([]modelsChats.UserInfo{{UserID: userID}, {UserID: peerID}}, nil)
+	// Собеседник заблокировал отправителя: сообщение не сохраняется
+	mockUserRepo.EXPECT().GetBlockedPeers(ctx, userID, []uuid.UUID{peerID}).Return([]uuid.UUID{peerID}, nil)
+
+	err := uc.AddMessage(ctx, msg, userID)
+
+	assert.ErrorIs(t, err, errs.ErrUserBlocked)
+}
+
+func TestMessageUsecase_AddMessage_DialogNotBlocked(t *testing.T) {
+	uc, mockMessageRepo, mockUserRepo, mockChatsRepo, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	peerID := uuid.New()
+	chatID := uuid.New()
+
+	msg := dtoMessage.CreateMessageDTO{
+		ChatId:    chatID,
+		Text:      "Test message",
+		CreatedAt: time.Now(),
+	}
+
+	mockChatsRepo.EXPECT().CheckUserHasRole(ctx, userID, chatID, modelsChats.RoleViewer).Return(false, nil)
+	mockChatsRepo.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeDialog}, nil)
+	mockChatsRepo.EXPECT().GetUsersOfChat(ctx, chatID).Return([]modelsChats.UserInfo{{UserID: userID}, {UserID: peerID}}, nil)
+	mockUserRepo.EXPECT().GetBlockedPeers(ctx, userID, []uuid.UUID{peerID}).Return(nil, nil)
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Test User"}, nil)
+	mockMessageRepo.EXPECT().InsertMessage(ctx, gomock.Any()).Return(uuid.New(), nil)
+
+	err := uc.AddMessage(ctx, msg, userID)
+
+	assert.NoError(t, err)
+}
+
 func TestMessageUsecase_SubscribeConnectionToChats_Success(t *testing.T) {
 	uc, _, _, _, _, mockListenerMap := setupMessageUsecase(t)
 	defer uc.Stop()
@@ -265,3 +340,168 @@ func TestMessageUsecase_Stop(t *testing.T) {
 		t.Fatal("Context should be cancelled")
 	}
 }
+
+func TestMessageUsecase_ShuttingDown(t *testing.T) {
+	uc, _, _, _, _, _ := setupMessageUsecase(t)
+
+	select {
+	case <-uc.ShuttingDown():
+		t.Fatal("ShuttingDown should not be closed before Stop")
+	default:
+	}
+
+	uc.Stop()
+
+	select {
+	case <-uc.ShuttingDown():
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("ShuttingDown should be closed after Stop")
+	}
+}
+
+func TestMessageUsecase_DistributeToOutChannel_StopsOnClosedChat(t *testing.T) {
+	uc, _, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	connectionID := uuid.New()
+	uc.mu.Lock()
+	uc.connectionContext[connectionID] = context.Background()
+	uc.mu.Unlock()
+
+	chatChan := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	out := make(chan dtoMessage.WebSocketMessageDTO, 10)
+
+	uc.distributeToOutChannel(connectionID, chatChan, out)
+
+	chatChan <- dtoMessage.WebSocketMessageDTO{Type: dtoMessage.WebSocketMessageTypeNewChatMessage}
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("message was not forwarded")
+	}
+
+	close(chatChan)
+
+	// После закрытия канала чата горутина должна завершиться, а не пересылать пустые сообщения
+	assert.Eventually(t, func() bool {
+		return uc.distributersToOutChannelsCount.Load() == 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Empty(t, out)
+}
+
+func TestMessageUsecase_NotifyUser(t *testing.T) {
+	uc, _, _, _, _, mockListenerMap := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	activeConnection := uuid.New()
+	fullConnection := uuid.New()
+
+	activeChan := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	fullChan := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	fullChan <- dtoMessage.WebSocketMessageDTO{}
+
+	mockListenerMap.EXPECT().GetUserConnections(userID).Return([]uuid.UUID{activeConnection, fullConnection})
+	mockListenerMap.EXPECT().GetOutgoingChannel(activeConnection).Return(activeChan)
+	mockListenerMap.EXPECT().GetOutgoingChannel(fullConnection).Return(fullChan)
+
+	err := uc.NotifyUser(ctx, userID, dtoMessage.SystemNotificationDTO{
+		Kind: dtoMessage.SystemNotificationKindNewDevice,
+		Text: "Выполнен вход в аккаунт с нового устройства",
+	})
+
+	assert.NoError(t, err)
+
+	msg := <-activeChan
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeSystemNotification, msg.Type)
+	notification, ok := msg.Value.(dtoMessage.SystemNotificationDTO)
+	assert.True(t, ok)
+	assert.Equal(t, dtoMessage.SystemNotificationKindNewDevice, notification.Kind)
+	assert.False(t, notification.CreatedAt.IsZero())
+}
+
+func TestMessageUsecase_UpdateLastSeen(t *testing.T) {
+	uc, _, mockUserClient, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	before := time.Now()
+
+	mockUserClient.EXPECT().UpdateLastSeen(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, at time.Time) error {
+		assert.False(t, at.Before(before))
+		return assert.AnError
+	})
+
+	// Ошибка user_service только логируется
+	uc.UpdateLastSeen(ctx, userID)
+}
+
+func TestMessageUsecase_NotifyUserStatus(t *testing.T) {
+	uc, _, mockUserClient, mockChatsRepo, _, mockListenerMap := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	partnerID, partnerDialogID := uuid.New(), uuid.New()
+	blockedID := uuid.New()
+	connectionID := uuid.New()
+
+	outgoing := make(chan dtoMessage.WebSocketMessageDTO, 1)
+
+	mockChatsRepo.EXPECT().GetDialogPartners(ctx, userID).
+		Return(map[uuid.UUID]uuid.UUID{partnerID: partnerDialogID, blockedID: uuid.New()}, nil)
+	mockUserClient.EXPECT().GetBlockedPeers(ctx, userID, gomock.Len(2)).Return([]uuid.UUID{blockedID}, nil)
+	mockListenerMap.EXPECT().GetUserConnections(partnerID).Return([]uuid.UUID{connectionID})
+	mockListenerMap.EXPECT().GetOutgoingChannel(connectionID).Return(outgoing)
+
+	userStatus := dtoMessage.UserStatusDTO{UserID: userID, Emoji: "🌴", Text: "В отпуске"}
+	err := uc.NotifyUserStatus(ctx, userStatus)
+
+	assert.NoError(t, err)
+
+	msg := <-outgoing
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeUserStatus, msg.Type)
+	assert.Equal(t, partnerDialogID, msg.ChatID)
+	assert.Equal(t, userStatus, msg.Value)
+}
+
+func TestMessageUsecase_NotifyUserStatus_NoDialogs(t *testing.T) {
+	uc, _, _, mockChatsRepo, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockChatsRepo.EXPECT().GetDialogPartners(ctx, userID).Return(map[uuid.UUID]uuid.UUID{}, nil)
+
+	err := uc.NotifyUserStatus(ctx, dtoMessage.UserStatusDTO{UserID: userID})
+
+	assert.NoError(t, err)
+}
+
+func TestMessageUsecase_NotifyChatSettings(t *testing.T) {
+	uc, _, _, _, _, mockListenerMap := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	connectionID := uuid.New()
+
+	outgoing := make(chan dtoMessage.WebSocketMessageDTO, 1)
+
+	mockListenerMap.EXPECT().GetUserConnections(userID).Return([]uuid.UUID{connectionID})
+	mockListenerMap.EXPECT().GetOutgoingChannel(connectionID).Return(outgoing)
+
+	settings := dtoChats.ChatSettingsDTO{Muted: true, Archived: true}
+	err := uc.NotifyChatSettings(ctx, userID, chatID, settings)
+
+	assert.NoError(t, err)
+
+	msg := <-outgoing
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeChatSettings, msg.Type)
+	assert.Equal(t, chatID, msg.ChatID)
+	assert.Equal(t, settings, msg.Value)
+}
@@ -0,0 +1,131 @@
+package message
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	interfaceWebhook "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/webhook"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/outbox"
+)
+
+const (
+	MentionsPublisherName = "mentions"
+	WebhooksPublisherName = "webhooks"
+	PublisherDedupSize    = 10000
+)
+
+// MentionsPublisher сохраняет упоминания нового сообщения и уведомляет упомянутых участников.
+// Работает из relay outbox, поэтому упоминания не теряются, если сервис упал сразу после вставки сообщения
+type MentionsPublisher struct {
+	uc    *MessageUsecase
+	dedup *outbox.Deduplicator
+}
+
+func NewMentionsPublisher(uc *MessageUsecase) *MentionsPublisher {
+	return &MentionsPublisher{
+		uc:    uc,
+		dedup: outbox.NewDeduplicator(PublisherDedupSize),
+	}
+}
+
+// Name имя потребителя outbox в логах и метриках relay
+func (p *MentionsPublisher) Name() string {
+	return MentionsPublisherName
+}
+
+// Publish обрабатывает упоминания в новом сообщении пользователя. Повтор безопасен:
+// уже сохранённые упоминания не вставляются и не уведомляются второй раз
+func (p *MentionsPublisher) Publish(ctx context.Context, event modelsOutbox.Event) error {
+	const op = "MentionsPublisher.Publish"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("event_id", event.ID.String())
+
+	payload, ok, err := mentioningMessage(event)
+	if err != nil || !ok {
+		return err
+	}
+
+	if p.dedup.Seen(event.DedupKey) {
+		logger.Debugf("event %s already handled, skipping", event.DedupKey)
+		return nil
+	}
+
+	msg, err := p.uc.createdMessageDTO(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	if err := p.uc.saveMentions(ctx, msg, *payload.UserID); err != nil {
+		return err
+	}
+
+	p.dedup.Remember(event.DedupKey)
+	return nil
+}
+
+// WebhooksPublisher передаёт новые сообщения с упоминаниями диспетчеру вебхуков ботов
+type WebhooksPublisher struct {
+	uc         *MessageUsecase
+	dispatcher interfaceWebhook.WebhookDispatcher
+	dedup      *outbox.Deduplicator
+}
+
+func NewWebhooksPublisher(uc *MessageUsecase, dispatcher interfaceWebhook.WebhookDispatcher) *WebhooksPublisher {
+	return &WebhooksPublisher{
+		uc:         uc,
+		dispatcher: dispatcher,
+		dedup:      outbox.NewDeduplicator(PublisherDedupSize),
+	}
+}
+
+// Name имя потребителя outbox в логах и метриках relay
+func (p *WebhooksPublisher) Name() string {
+	return WebhooksPublisherName
+}
+
+// Publish ставит новое сообщение с упоминаниями в очередь доставки вебхуков
+func (p *WebhooksPublisher) Publish(ctx context.Context, event modelsOutbox.Event) error {
+	const op = "WebhooksPublisher.Publish"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("event_id", event.ID.String())
+
+	payload, ok, err := mentioningMessage(event)
+	if err != nil || !ok {
+		return err
+	}
+
+	if p.dedup.Seen(event.DedupKey) {
+		logger.Debugf("event %s already handled, skipping", event.DedupKey)
+		return nil
+	}
+
+	msg, err := p.uc.createdMessageDTO(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	p.dispatcher.DispatchMessage(ctx, msg)
+
+	p.dedup.Remember(event.DedupKey)
+	return nil
+}
+
+// mentioningMessage возвращает payload события, если это новое сообщение пользователя с упоминаниями
+func mentioningMessage(event modelsOutbox.Event) (modelsOutbox.MessagePayload, bool, error) {
+	if event.Type != modelsOutbox.EventMessageCreated {
+		return modelsOutbox.MessagePayload{}, false, nil
+	}
+
+	payload, err := event.MessagePayload()
+	if err != nil {
+		return modelsOutbox.MessagePayload{}, false, err
+	}
+
+	if payload.Type != modelsMessage.MessageTypeUser || payload.UserID == nil || len(ParseMentions(payload.Text)) == 0 {
+		return modelsOutbox.MessagePayload{}, false, nil
+	}
+
+	return payload, true, nil
+}
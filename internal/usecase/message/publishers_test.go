@@ -0,0 +1,162 @@
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	modelsUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func messageCreatedEvent(t *testing.T, payload modelsOutbox.MessagePayload) modelsOutbox.Event {
+	t.Helper()
+
+	event, err := modelsOutbox.MessageEvent(modelsOutbox.EventMessageCreated, payload)
+	assert.NoError(t, err)
+	return event
+}
+
+func TestMentionsPublisher_Publish(t *testing.T) {
+	uc, mockMessageRepo, mockUserRepo, _, _, mockListenerMap := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	messageID := uuid.New()
+	mentionedID := uuid.New()
+	connectionID := uuid.New()
+
+	event := messageCreatedEvent(t, modelsOutbox.MessagePayload{
+		MessageID: messageID,
+		ChatID:    chatID,
+		UserID:    &userID,
+		Text:      "@alice @ghost @author посмотри",
+		Type:      modelsMessage.MessageTypeUser,
+		CreatedAt: time.Now(),
+	})
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Author"}, nil)
+
+	// Несуществующий пользователь и сам автор не считаются упоминаниями
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(&modelsUser.User{ID: mentionedID}, nil)
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "ghost").Return(nil, errs.ErrNotFound)
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "author").Return(&modelsUser.User{ID: userID}, nil)
+	mockMessageRepo.EXPECT().InsertMentions(ctx, messageID, chatID, []uuid.UUID{mentionedID}).Return([]uuid.UUID{mentionedID}, nil)
+
+	outChan := make(chan dtoMessage.WebSocketMessageDTO, 1)
+	mockListenerMap.EXPECT().GetUserConnections(mentionedID).Return([]uuid.UUID{connectionID})
+	mockListenerMap.EXPECT().GetOutgoingChannel(connectionID).Return(outChan)
+
+	publisher := NewMentionsPublisher(uc)
+	assert.NoError(t, publisher.Publish(ctx, event))
+
+	mention := <-outChan
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeMention, mention.Type)
+	assert.Equal(t, chatID, mention.ChatID)
+	mentionDTO, ok := mention.Value.(dtoMessage.MessageDTO)
+	assert.True(t, ok)
+	assert.Equal(t, messageID, mentionDTO.ID)
+	assert.Equal(t, "Author", *mentionDTO.SenderName)
+
+	// Повторная доставка того же события отбрасывается
+	assert.NoError(t, publisher.Publish(ctx, event))
+}
+
+func TestMentionsPublisher_Publish_SaveError(t *testing.T) {
+	uc, mockMessageRepo, mockUserRepo, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	chatID := uuid.New()
+	messageID := uuid.New()
+	mentionedID := uuid.New()
+
+	event := messageCreatedEvent(t, modelsOutbox.MessagePayload{
+		MessageID: messageID,
+		ChatID:    chatID,
+		UserID:    &userID,
+		Text:      "@alice привет",
+		Type:      modelsMessage.MessageTypeUser,
+	})
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Author"}, nil).Times(2)
+	mockUserRepo.EXPECT().GetUserByUsername(ctx, "alice").Return(&modelsUser.User{ID: mentionedID}, nil).Times(2)
+	mockMessageRepo.EXPECT().InsertMentions(ctx, messageID, chatID, []uuid.UUID{mentionedID}).Return(nil, assert.AnError)
+	mockMessageRepo.EXPECT().InsertMentions(ctx, messageID, chatID, []uuid.UUID{mentionedID}).Return(nil, nil)
+
+	publisher := NewMentionsPublisher(uc)
+
+	// Ошибка возвращается relay, и событие будет доставлено повторно
+	assert.ErrorIs(t, publisher.Publish(ctx, event), assert.AnError)
+	assert.NoError(t, publisher.Publish(ctx, event))
+}
+
+func TestMentionsPublisher_Publish_SkipsWithoutMentions(t *testing.T) {
+	uc, _, _, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	userID := uuid.New()
+	publisher := NewMentionsPublisher(uc)
+
+	// Вызовы моков не ожидаются: такие события потребителю неинтересны
+	assert.NoError(t, publisher.Publish(context.Background(), messageCreatedEvent(t, modelsOutbox.MessagePayload{
+		MessageID: uuid.New(),
+		ChatID:    uuid.New(),
+		UserID:    &userID,
+		Text:      "без упоминаний",
+		Type:      modelsMessage.MessageTypeUser,
+	})))
+	assert.NoError(t, publisher.Publish(context.Background(), messageCreatedEvent(t, modelsOutbox.MessagePayload{
+		MessageID: uuid.New(),
+		ChatID:    uuid.New(),
+		Text:      "@alice добавлен в чат",
+		Type:      modelsMessage.MessageTypeSystem,
+	})))
+
+	deleted, err := modelsOutbox.MessageEvent(modelsOutbox.EventMessageDeleted, modelsOutbox.MessagePayload{MessageID: uuid.New(), ChatID: uuid.New()})
+	assert.NoError(t, err)
+	assert.NoError(t, publisher.Publish(context.Background(), deleted))
+}
+
+func TestWebhooksPublisher_Publish(t *testing.T) {
+	uc, _, mockUserRepo, _, _, _ := setupMessageUsecase(t)
+	defer uc.Stop()
+
+	ctrl := gomock.NewController(t)
+	mockDispatcher := mocks.NewMockWebhookDispatcher(ctrl)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	messageID := uuid.New()
+	text := "@weather_bot погода"
+
+	event := messageCreatedEvent(t, modelsOutbox.MessagePayload{
+		MessageID: messageID,
+		ChatID:    uuid.New(),
+		UserID:    &userID,
+		Text:      text,
+		Type:      modelsMessage.MessageTypeUser,
+	})
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Test User"}, nil)
+
+	// Сообщение должно быть передано диспетчеру вебхуков ровно один раз
+	mockDispatcher.EXPECT().DispatchMessage(ctx, gomock.Any()).Do(func(_ context.Context, m dtoMessage.MessageDTO) {
+		assert.Equal(t, messageID, m.ID)
+		assert.Equal(t, text, m.Text)
+	})
+
+	publisher := NewWebhooksPublisher(uc, mockDispatcher)
+	assert.NoError(t, publisher.Publish(ctx, event))
+	assert.NoError(t, publisher.Publish(ctx, event))
+}
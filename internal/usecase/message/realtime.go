@@ -0,0 +1,123 @@
+package message
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	utils "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/utils"
+)
+
+const RealtimePublisherName = "realtime"
+
+// Name имя потребителя outbox в логах и метриках relay
+func (uc *MessageUsecase) Name() string {
+	return RealtimePublisherName
+}
+
+// Publish переводит событие outbox в сообщение потока событий и ставит его в очередь рассылки подписчикам чата
+func (uc *MessageUsecase) Publish(ctx context.Context, event modelsOutbox.Event) error {
+	const op = "MessageUsecase.Publish"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("event_id", event.ID.String())
+
+	if uc.realtimeDedup.Seen(event.DedupKey) {
+		logger.Debugf("event %s already delivered, skipping", event.DedupKey)
+		return nil
+	}
+
+	var websocketMsg dtoMessage.WebSocketMessageDTO
+	switch event.Type {
+	case modelsOutbox.EventMessageCreated:
+		payload, err := event.MessagePayload()
+		if err != nil {
+			return err
+		}
+
+		msgDTO, err := uc.createdMessageDTO(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		websocketMsg = dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeNewChatMessage,
+			ChatID: payload.ChatID,
+			Value:  msgDTO,
+		}
+	case modelsOutbox.EventMessageUpdated:
+		payload, err := event.MessagePayload()
+		if err != nil {
+			return err
+		}
+
+		websocketMsg = dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeEditChatMessage,
+			ChatID: payload.ChatID,
+			Value: dtoMessage.EditMessageDTO{
+				ID:        payload.MessageID,
+				Text:      payload.Text,
+				UpdatedAt: payload.UpdatedAt,
+			},
+		}
+	case modelsOutbox.EventMessageDeleted:
+		payload, err := event.MessagePayload()
+		if err != nil {
+			return err
+		}
+
+		websocketMsg = dtoMessage.WebSocketMessageDTO{
+			Type:   dtoMessage.WebSocketMessageTypeDeleteChatMessage,
+			ChatID: payload.ChatID,
+			Value:  dtoMessage.DeleteMessageDTO{ID: payload.MessageID},
+		}
+	default:
+		// Остальные события (создание чата, новые участники) в поток сообщений не попадают
+		return nil
+	}
+
+	if err := uc.sendWebsocketMessage(ctx, websocketMsg); err != nil {
+		return err
+	}
+
+	uc.realtimeDedup.Remember(event.DedupKey)
+
+	return nil
+}
+
+func (uc *MessageUsecase) createdMessageDTO(ctx context.Context, payload modelsOutbox.MessagePayload) (dtoMessage.MessageDTO, error) {
+	const op = "MessageUsecase.createdMessageDTO"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("message_id", payload.MessageID.String())
+
+	message := modelsMessage.Message{
+		ID:        payload.MessageID,
+		ChatID:    payload.ChatID,
+		UserID:    payload.UserID,
+		Text:      payload.Text,
+		CreatedAt: payload.CreatedAt,
+		UpdatedAt: payload.UpdatedAt,
+		Type:      payload.Type,
+	}
+
+	if payload.Type == modelsMessage.MessageTypeUser && payload.UserID != nil {
+		user, err := uc.userClient.GetUserByID(ctx, *payload.UserID)
+		if err != nil {
+			// Имя отправителя клиент подтянет при загрузке истории, задерживать сообщение из-за него не стоит
+			logger.WithError(err).Warningf("could not get sender %s", *payload.UserID)
+		} else {
+			message.UserName = &user.Name
+		}
+	}
+
+	if payload.HasAttachment {
+		attachment, err := uc.messageRepository.GetMessageAttachments(ctx, payload.MessageID)
+		if err != nil {
+			return dtoMessage.MessageDTO{}, err
+		}
+		message.Attachment = attachment
+	}
+
+	return utils.ConvertMessageToDTO(ctx, message, uc.fileStorage), nil
+}
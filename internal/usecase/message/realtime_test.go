@@ -0,0 +1,122 @@
+package message
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	modelsUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupRealtimeUsecase создаёт usecase, у чата chatID которого есть один слушатель
+func setupRealtimeUsecase(t *testing.T, chatID uuid.UUID) (*MessageUsecase, *mocks.MockMessageRepository, *mocks.MockUserClient, chan dtoMessage.WebSocketMessageDTO) {
+	ctrl := gomock.NewController(t)
+
+	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserClient(ctrl)
+	mockListenerMap := mocks.NewMockListenerMapInterface(ctrl)
+
+	listener := make(chan dtoMessage.WebSocketMessageDTO, 10)
+	mockListenerMap.EXPECT().GetChatListeners(chatID).Return(map[uuid.UUID]chan dtoMessage.WebSocketMessageDTO{uuid.New(): listener}).AnyTimes()
+	mockListenerMap.EXPECT().CleanInactiveChats().Return(0).AnyTimes()
+	mockListenerMap.EXPECT().CleanInactiveReaders().Return(0).AnyTimes()
+
+	uc := NewMessageUsecase(mockMessageRepo, mockUserRepo, mocks.NewMockChatsRepository(ctrl), mocks.NewMockFileStorage(ctrl), mockListenerMap)
+
+	return uc, mockMessageRepo, mockUserRepo, listener
+}
+
+func receive(t *testing.T, listener <-chan dtoMessage.WebSocketMessageDTO) dtoMessage.WebSocketMessageDTO {
+	t.Helper()
+
+	select {
+	case msg := <-listener:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("message was not distributed")
+		return dtoMessage.WebSocketMessageDTO{}
+	}
+}
+
+func TestMessageUsecase_Publish_MessageCreated(t *testing.T) {
+	chatID := uuid.New()
+	uc, _, mockUserRepo, listener := setupRealtimeUsecase(t, chatID)
+	defer uc.Stop()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	event, err := modelsOutbox.MessageEvent(modelsOutbox.EventMessageCreated, modelsOutbox.MessagePayload{
+		MessageID: uuid.New(),
+		ChatID:    chatID,
+		UserID:    &userID,
+		Text:      "hello",
+		Type:      modelsMessage.MessageTypeUser,
+		CreatedAt: time.Now(),
+	})
+	assert.NoError(t, err)
+
+	mockUserRepo.EXPECT().GetUserByID(ctx, userID).Return(&modelsUser.User{ID: userID, Name: "Alice"}, nil)
+
+	assert.NoError(t, uc.Publish(ctx, event))
+
+	msg := receive(t, listener)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeNewChatMessage, msg.Type)
+	msgDTO, ok := msg.Value.(dtoMessage.MessageDTO)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", msgDTO.Text)
+	assert.Equal(t, "Alice", *msgDTO.SenderName)
+
+	// Повторная доставка того же события отбрасывается
+	assert.NoError(t, uc.Publish(ctx, event))
+	select {
+	case dup := <-listener:
+		t.Fatalf("duplicate event distributed: %v", dup)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMessageUsecase_Publish_MessageDeleted(t *testing.T) {
+	chatID := uuid.New()
+	uc, _, _, listener := setupRealtimeUsecase(t, chatID)
+	defer uc.Stop()
+
+	messageID := uuid.New()
+	event, err := modelsOutbox.MessageEvent(modelsOutbox.EventMessageDeleted, modelsOutbox.MessagePayload{
+		MessageID: messageID,
+		ChatID:    chatID,
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, uc.Publish(context.Background(), event))
+
+	msg := receive(t, listener)
+	assert.Equal(t, dtoMessage.WebSocketMessageTypeDeleteChatMessage, msg.Type)
+	assert.Equal(t, dtoMessage.DeleteMessageDTO{ID: messageID}, msg.Value)
+}
+
+func TestMessageUsecase_Publish_IgnoresChatEvents(t *testing.T) {
+	chatID := uuid.New()
+	uc, _, _, listener := setupRealtimeUsecase(t, chatID)
+	defer uc.Stop()
+
+	event, err := modelsOutbox.ChatMembersEvent(modelsOutbox.EventChatMembersAdded, modelsOutbox.ChatMembersPayload{
+		ChatID:  chatID,
+		UserIDs: []uuid.UUID{uuid.New()},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, uc.Publish(context.Background(), event))
+
+	select {
+	case msg := <-listener:
+		t.Fatalf("unexpected message distributed: %v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -17,7 +17,7 @@ func TestMessageUsecase_WorkerDistribute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
-	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserClient(ctrl)
 	mockChatsRepo := mocks.NewMockChatsRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
 	mockListenerMap := mocks.NewMockListenerMapInterface(ctrl)
@@ -60,7 +60,7 @@ func TestMessageUsecase_Distribute_NoListeners(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockMessageRepo := mocks.NewMockMessageRepository(ctrl)
-	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserClient(ctrl)
 	mockChatsRepo := mocks.NewMockChatsRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
 	mockListenerMap := mocks.NewMockListenerMapInterface(ctrl)
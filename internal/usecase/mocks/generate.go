@@ -4,5 +4,10 @@
 //go:generate mockgen -source=../interface/listener/listener.go -destination=mock_listener_map.go -package=mocks
 //go:generate mockgen -source=../interface/storage/storage.go -destination=mock_storage.go -package=mocks
 //go:generate mockgen -source=../interface/contact/contact.go -destination=mock_contact_repository.go -package=mocks
+//go:generate mockgen -source=../interface/webhook/webhook.go -destination=mock_webhook_dispatcher.go -package=mocks
+//go:generate mockgen -source=../interface/outbox/outbox.go -destination=mock_outbox.go -package=mocks
+//go:generate mockgen -source=../interface/push/push.go -destination=mock_push.go -package=mocks
+//go:generate mockgen -source=../interface/block/block.go -destination=mock_block_repository.go -package=mocks
+//go:generate mockgen -source=../interface/privacy/privacy.go -destination=mock_privacy_repository.go -package=mocks
 
 package mocks
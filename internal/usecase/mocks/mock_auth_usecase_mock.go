@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
 	dto0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gomock "github.com/golang/mock/gomock"
@@ -38,18 +39,18 @@ func (m *MockIAuthUsecase) EXPECT() *MockIAuthUsecaseMockRecorder {
 }
 
 // Login mocks base method.
-func (m *MockIAuthUsecase) Login(ctx context.Context, req *dto.LoginRequest, device string) (uuid.UUID, error) {
+func (m *MockIAuthUsecase) Login(ctx context.Context, req *dto.LoginRequest, info models.DeviceInfo) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, req, device)
+	ret := m.ctrl.Call(m, "Login", ctx, req, info)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockIAuthUsecaseMockRecorder) Login(ctx, req, device interface{}) *gomock.Call {
+func (mr *MockIAuthUsecaseMockRecorder) Login(ctx, req, info interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthUsecase)(nil).Login), ctx, req, device)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthUsecase)(nil).Login), ctx, req, info)
 }
 
 // Logout mocks base method.
@@ -67,16 +68,16 @@ func (mr *MockIAuthUsecaseMockRecorder) Logout(ctx, SessionID interface{}) *gomo
 }
 
 // Register mocks base method.
-func (m *MockIAuthUsecase) Register(ctx context.Context, req *dto.RegisterRequest, device string) (uuid.UUID, *dto0.ValidationErrorsDTO) {
+func (m *MockIAuthUsecase) Register(ctx context.Context, req *dto.RegisterRequest, info models.DeviceInfo) (uuid.UUID, *dto0.ValidationErrorsDTO) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", ctx, req, device)
+	ret := m.ctrl.Call(m, "Register", ctx, req, info)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(*dto0.ValidationErrorsDTO)
 	return ret0, ret1
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockIAuthUsecaseMockRecorder) Register(ctx, req, device interface{}) *gomock.Call {
+func (mr *MockIAuthUsecaseMockRecorder) Register(ctx, req, info interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthUsecase)(nil).Register), ctx, req, device)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthUsecase)(nil).Register), ctx, req, info)
 }
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../interface/block/block.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/block"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockBlockRepository is a mock of BlockRepository interface.
+type MockBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRepositoryMockRecorder
+}
+
+// MockBlockRepositoryMockRecorder is the mock recorder for MockBlockRepository.
+type MockBlockRepositoryMockRecorder struct {
+	mock *MockBlockRepository
+}
+
+// NewMockBlockRepository creates a new mock instance.
+func NewMockBlockRepository(ctrl *gomock.Controller) *MockBlockRepository {
+	mock := &MockBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockRepository) EXPECT() *MockBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BlockUser mocks base method.
+func (m *MockBlockRepository) BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockBlockRepositoryMockRecorder) BlockUser(ctx, userID, blockedUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockBlockRepository)(nil).BlockUser), ctx, userID, blockedUserID)
+}
+
+// GetBlockedPeers mocks base method.
+func (m *MockBlockRepository) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedPeers", ctx, userID, peerIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedPeers indicates an expected call of GetBlockedPeers.
+func (mr *MockBlockRepositoryMockRecorder) GetBlockedPeers(ctx, userID, peerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedPeers", reflect.TypeOf((*MockBlockRepository)(nil).GetBlockedPeers), ctx, userID, peerIDs)
+}
+
+// GetBlocksByUserID mocks base method.
+func (m *MockBlockRepository) GetBlocksByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*models.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByUserID indicates an expected call of GetBlocksByUserID.
+func (mr *MockBlockRepositoryMockRecorder) GetBlocksByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByUserID", reflect.TypeOf((*MockBlockRepository)(nil).GetBlocksByUserID), ctx, userID)
+}
+
+// UnblockUser mocks base method.
+func (m *MockBlockRepository) UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockBlockRepositoryMockRecorder) UnblockUser(ctx, userID, blockedUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockBlockRepository)(nil).UnblockUser), ctx, userID, blockedUserID)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: block_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/block"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIBlockUsecase is a mock of IBlockUsecase interface.
+type MockIBlockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBlockUsecaseMockRecorder
+}
+
+// MockIBlockUsecaseMockRecorder is the mock recorder for MockIBlockUsecase.
+type MockIBlockUsecaseMockRecorder struct {
+	mock *MockIBlockUsecase
+}
+
+// NewMockIBlockUsecase creates a new mock instance.
+func NewMockIBlockUsecase(ctrl *gomock.Controller) *MockIBlockUsecase {
+	mock := &MockIBlockUsecase{ctrl: ctrl}
+	mock.recorder = &MockIBlockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBlockUsecase) EXPECT() *MockIBlockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// BlockUser mocks base method.
+func (m *MockIBlockUsecase) BlockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockIBlockUsecaseMockRecorder) BlockUser(ctx, userID, blockedUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockIBlockUsecase)(nil).BlockUser), ctx, userID, blockedUserID)
+}
+
+// GetBlockedPeers mocks base method.
+func (m *MockIBlockUsecase) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedPeers", ctx, userID, peerIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedPeers indicates an expected call of GetBlockedPeers.
+func (mr *MockIBlockUsecaseMockRecorder) GetBlockedPeers(ctx, userID, peerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedPeers", reflect.TypeOf((*MockIBlockUsecase)(nil).GetBlockedPeers), ctx, userID, peerIDs)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockIBlockUsecase) GetBlockedUsers(ctx context.Context, userID uuid.UUID) ([]*dto.BlockedUserDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", ctx, userID)
+	ret0, _ := ret[0].([]*dto.BlockedUserDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockIBlockUsecaseMockRecorder) GetBlockedUsers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockIBlockUsecase)(nil).GetBlockedUsers), ctx, userID)
+}
+
+// UnblockUser mocks base method.
+func (m *MockIBlockUsecase) UnblockUser(ctx context.Context, userID, blockedUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockIBlockUsecaseMockRecorder) UnblockUser(ctx, userID, blockedUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockIBlockUsecase)(nil).UnblockUser), ctx, userID, blockedUserID)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: bot_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/bot"
+	dto0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIBotUsecase is a mock of IBotUsecase interface.
+type MockIBotUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBotUsecaseMockRecorder
+}
+
+// MockIBotUsecaseMockRecorder is the mock recorder for MockIBotUsecase.
+type MockIBotUsecaseMockRecorder struct {
+	mock *MockIBotUsecase
+}
+
+// NewMockIBotUsecase creates a new mock instance.
+func NewMockIBotUsecase(ctrl *gomock.Controller) *MockIBotUsecase {
+	mock := &MockIBotUsecase{ctrl: ctrl}
+	mock.recorder = &MockIBotUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBotUsecase) EXPECT() *MockIBotUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateBot mocks base method.
+func (m *MockIBotUsecase) CreateBot(ctx context.Context, ownerID uuid.UUID, name, username string) (*dto.CreatedBot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBot", ctx, ownerID, name, username)
+	ret0, _ := ret[0].(*dto.CreatedBot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBot indicates an expected call of CreateBot.
+func (mr *MockIBotUsecaseMockRecorder) CreateBot(ctx, ownerID, name, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBot", reflect.TypeOf((*MockIBotUsecase)(nil).CreateBot), ctx, ownerID, name, username)
+}
+
+// CreateBotToken mocks base method.
+func (m *MockIBotUsecase) CreateBotToken(ctx context.Context, ownerID, botID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*dto0.CreatedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBotToken", ctx, ownerID, botID, name, scopes, expiresIn)
+	ret0, _ := ret[0].(*dto0.CreatedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBotToken indicates an expected call of CreateBotToken.
+func (mr *MockIBotUsecaseMockRecorder) CreateBotToken(ctx, ownerID, botID, name, scopes, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBotToken", reflect.TypeOf((*MockIBotUsecase)(nil).CreateBotToken), ctx, ownerID, botID, name, scopes, expiresIn)
+}
+
+// DeleteBot mocks base method.
+func (m *MockIBotUsecase) DeleteBot(ctx context.Context, ownerID, botID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBot", ctx, ownerID, botID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBot indicates an expected call of DeleteBot.
+func (mr *MockIBotUsecaseMockRecorder) DeleteBot(ctx, ownerID, botID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBot", reflect.TypeOf((*MockIBotUsecase)(nil).DeleteBot), ctx, ownerID, botID)
+}
+
+// GetBots mocks base method.
+func (m *MockIBotUsecase) GetBots(ctx context.Context, ownerID uuid.UUID) ([]*dto.Bot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBots", ctx, ownerID)
+	ret0, _ := ret[0].([]*dto.Bot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBots indicates an expected call of GetBots.
+func (mr *MockIBotUsecaseMockRecorder) GetBots(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBots", reflect.TypeOf((*MockIBotUsecase)(nil).GetBots), ctx, ownerID)
+}
+
+// RevokeBotToken mocks base method.
+func (m *MockIBotUsecase) RevokeBotToken(ctx context.Context, ownerID, botID, tokenID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeBotToken", ctx, ownerID, botID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeBotToken indicates an expected call of RevokeBotToken.
+func (mr *MockIBotUsecaseMockRecorder) RevokeBotToken(ctx, ownerID, botID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeBotToken", reflect.TypeOf((*MockIBotUsecase)(nil).RevokeBotToken), ctx, ownerID, botID, tokenID)
+}
+
+// SetWebhook mocks base method.
+func (m *MockIBotUsecase) SetWebhook(ctx context.Context, ownerID, botID uuid.UUID, webhookURL string) (*dto.WebhookSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWebhook", ctx, ownerID, botID, webhookURL)
+	ret0, _ := ret[0].(*dto.WebhookSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWebhook indicates an expected call of SetWebhook.
+func (mr *MockIBotUsecaseMockRecorder) SetWebhook(ctx, ownerID, botID, webhookURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWebhook", reflect.TypeOf((*MockIBotUsecase)(nil).SetWebhook), ctx, ownerID, botID, webhookURL)
+}
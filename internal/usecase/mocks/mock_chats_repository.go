@@ -8,7 +8,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
+	models0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +37,20 @@ func (m *MockChatsRepository) EXPECT() *MockChatsRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApproveJoinRequest mocks base method.
+func (m *MockChatsRepository) ApproveJoinRequest(ctx context.Context, member models0.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockChatsRepositoryMockRecorder) ApproveJoinRequest(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockChatsRepository)(nil).ApproveJoinRequest), ctx, member)
+}
+
 // CheckUserHasRole mocks base method.
 func (m *MockChatsRepository) CheckUserHasRole(ctx context.Context, userID, chatID uuid.UUID, role string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -52,7 +67,7 @@ func (mr *MockChatsRepositoryMockRecorder) CheckUserHasRole(ctx, userID, chatID,
 }
 
 // CreateChat mocks base method.
-func (m *MockChatsRepository) CreateChat(ctx context.Context, chat models.Chat, usersInfo []models.UserInfo, usersNames []string) error {
+func (m *MockChatsRepository) CreateChat(ctx context.Context, chat models0.Chat, usersInfo []models0.UserInfo, usersNames []string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateChat", ctx, chat, usersInfo, usersNames)
 	ret0, _ := ret[0].(error)
@@ -65,6 +80,48 @@ func (mr *MockChatsRepositoryMockRecorder) CreateChat(ctx, chat, usersInfo, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChat", reflect.TypeOf((*MockChatsRepository)(nil).CreateChat), ctx, chat, usersInfo, usersNames)
 }
 
+// CreateInvite mocks base method.
+func (m *MockChatsRepository) CreateInvite(ctx context.Context, invite *models0.Invite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockChatsRepositoryMockRecorder) CreateInvite(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockChatsRepository)(nil).CreateInvite), ctx, invite)
+}
+
+// CreateJoinRequest mocks base method.
+func (m *MockChatsRepository) CreateJoinRequest(ctx context.Context, chatID, userID, inviteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJoinRequest", ctx, chatID, userID, inviteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJoinRequest indicates an expected call of CreateJoinRequest.
+func (mr *MockChatsRepositoryMockRecorder) CreateJoinRequest(ctx, chatID, userID, inviteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJoinRequest", reflect.TypeOf((*MockChatsRepository)(nil).CreateJoinRequest), ctx, chatID, userID, inviteID)
+}
+
+// DeclineJoinRequest mocks base method.
+func (m *MockChatsRepository) DeclineJoinRequest(ctx context.Context, chatID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineJoinRequest", ctx, chatID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineJoinRequest indicates an expected call of DeclineJoinRequest.
+func (mr *MockChatsRepositoryMockRecorder) DeclineJoinRequest(ctx, chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineJoinRequest", reflect.TypeOf((*MockChatsRepository)(nil).DeclineJoinRequest), ctx, chatID, userID)
+}
+
 // DeleteChat mocks base method.
 func (m *MockChatsRepository) DeleteChat(ctx context.Context, userID, chatID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -79,11 +136,26 @@ func (mr *MockChatsRepositoryMockRecorder) DeleteChat(ctx, userID, chatID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*MockChatsRepository)(nil).DeleteChat), ctx, userID, chatID)
 }
 
+// DeleteChatAvatar mocks base method.
+func (m *MockChatsRepository) DeleteChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatAvatar", ctx, chatID, attachmentID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteChatAvatar indicates an expected call of DeleteChatAvatar.
+func (mr *MockChatsRepositoryMockRecorder) DeleteChatAvatar(ctx, chatID, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatAvatar", reflect.TypeOf((*MockChatsRepository)(nil).DeleteChatAvatar), ctx, chatID, attachmentID)
+}
+
 // GetChat mocks base method.
-func (m *MockChatsRepository) GetChat(ctx context.Context, chatID uuid.UUID) (*models.Chat, error) {
+func (m *MockChatsRepository) GetChat(ctx context.Context, chatID uuid.UUID) (*models0.Chat, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChat", ctx, chatID)
-	ret0, _ := ret[0].(*models.Chat)
+	ret0, _ := ret[0].(*models0.Chat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -94,6 +166,21 @@ func (mr *MockChatsRepositoryMockRecorder) GetChat(ctx, chatID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockChatsRepository)(nil).GetChat), ctx, chatID)
 }
 
+// GetChatAvatarHistory mocks base method.
+func (m *MockChatsRepository) GetChatAvatarHistory(ctx context.Context, chatID uuid.UUID) ([]*models.Avatar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAvatarHistory", ctx, chatID)
+	ret0, _ := ret[0].([]*models.Avatar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAvatarHistory indicates an expected call of GetChatAvatarHistory.
+func (mr *MockChatsRepositoryMockRecorder) GetChatAvatarHistory(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatarHistory", reflect.TypeOf((*MockChatsRepository)(nil).GetChatAvatarHistory), ctx, chatID)
+}
+
 // GetChatAvatars mocks base method.
 func (m *MockChatsRepository) GetChatAvatars(ctx context.Context, userId uuid.UUID, chatIDs []uuid.UUID) (map[string]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -109,11 +196,56 @@ func (mr *MockChatsRepositoryMockRecorder) GetChatAvatars(ctx, userId, chatIDs i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAvatars", reflect.TypeOf((*MockChatsRepository)(nil).GetChatAvatars), ctx, userId, chatIDs)
 }
 
+// GetChatInvites mocks base method.
+func (m *MockChatsRepository) GetChatInvites(ctx context.Context, chatID uuid.UUID) ([]models0.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatInvites", ctx, chatID)
+	ret0, _ := ret[0].([]models0.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatInvites indicates an expected call of GetChatInvites.
+func (mr *MockChatsRepositoryMockRecorder) GetChatInvites(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatInvites", reflect.TypeOf((*MockChatsRepository)(nil).GetChatInvites), ctx, chatID)
+}
+
+// GetChatMembersNotifySettings mocks base method.
+func (m *MockChatsRepository) GetChatMembersNotifySettings(ctx context.Context, chatID uuid.UUID) ([]models0.MemberNotifySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatMembersNotifySettings", ctx, chatID)
+	ret0, _ := ret[0].([]models0.MemberNotifySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatMembersNotifySettings indicates an expected call of GetChatMembersNotifySettings.
+func (mr *MockChatsRepositoryMockRecorder) GetChatMembersNotifySettings(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatMembersNotifySettings", reflect.TypeOf((*MockChatsRepository)(nil).GetChatMembersNotifySettings), ctx, chatID)
+}
+
+// GetChatSettings mocks base method.
+func (m *MockChatsRepository) GetChatSettings(ctx context.Context, userID, chatID uuid.UUID) (*models0.ChatSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatSettings", ctx, userID, chatID)
+	ret0, _ := ret[0].(*models0.ChatSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSettings indicates an expected call of GetChatSettings.
+func (mr *MockChatsRepositoryMockRecorder) GetChatSettings(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSettings", reflect.TypeOf((*MockChatsRepository)(nil).GetChatSettings), ctx, userID, chatID)
+}
+
 // GetChats mocks base method.
-func (m *MockChatsRepository) GetChats(ctx context.Context, userID uuid.UUID) ([]models.Chat, error) {
+func (m *MockChatsRepository) GetChats(ctx context.Context, userID uuid.UUID) ([]models0.Chat, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChats", ctx, userID)
-	ret0, _ := ret[0].([]models.Chat)
+	ret0, _ := ret[0].([]models0.Chat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -124,11 +256,72 @@ func (mr *MockChatsRepositoryMockRecorder) GetChats(ctx, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockChatsRepository)(nil).GetChats), ctx, userID)
 }
 
+// GetDialogPartners mocks base method.
+func (m *MockChatsRepository) GetDialogPartners(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDialogPartners", ctx, userID)
+	ret0, _ := ret[0].(map[uuid.UUID]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDialogPartners indicates an expected call of GetDialogPartners.
+func (mr *MockChatsRepositoryMockRecorder) GetDialogPartners(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDialogPartners", reflect.TypeOf((*MockChatsRepository)(nil).GetDialogPartners), ctx, userID)
+}
+
+// GetGroupPeers mocks base method.
+func (m *MockChatsRepository) GetGroupPeers(ctx context.Context, userID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupPeers", ctx, userID, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupPeers indicates an expected call of GetGroupPeers.
+func (mr *MockChatsRepositoryMockRecorder) GetGroupPeers(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPeers", reflect.TypeOf((*MockChatsRepository)(nil).GetGroupPeers), ctx, userID, limit)
+}
+
+// GetInviteByCode mocks base method.
+func (m *MockChatsRepository) GetInviteByCode(ctx context.Context, userID uuid.UUID, code string) (*models0.Invite, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInviteByCode", ctx, userID, code)
+	ret0, _ := ret[0].(*models0.Invite)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInviteByCode indicates an expected call of GetInviteByCode.
+func (mr *MockChatsRepositoryMockRecorder) GetInviteByCode(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInviteByCode", reflect.TypeOf((*MockChatsRepository)(nil).GetInviteByCode), ctx, userID, code)
+}
+
+// GetJoinRequests mocks base method.
+func (m *MockChatsRepository) GetJoinRequests(ctx context.Context, chatID uuid.UUID) ([]models0.JoinRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinRequests", ctx, chatID)
+	ret0, _ := ret[0].([]models0.JoinRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinRequests indicates an expected call of GetJoinRequests.
+func (mr *MockChatsRepositoryMockRecorder) GetJoinRequests(ctx, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinRequests", reflect.TypeOf((*MockChatsRepository)(nil).GetJoinRequests), ctx, chatID)
+}
+
 // GetUserInfo mocks base method.
-func (m *MockChatsRepository) GetUserInfo(ctx context.Context, userID, chatID uuid.UUID) (*models.UserInfo, error) {
+func (m *MockChatsRepository) GetUserInfo(ctx context.Context, userID, chatID uuid.UUID) (*models0.UserInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserInfo", ctx, userID, chatID)
-	ret0, _ := ret[0].(*models.UserInfo)
+	ret0, _ := ret[0].(*models0.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -155,10 +348,10 @@ func (mr *MockChatsRepositoryMockRecorder) GetUsersDialog(ctx, user1ID, user2ID
 }
 
 // GetUsersOfChat mocks base method.
-func (m *MockChatsRepository) GetUsersOfChat(ctx context.Context, chatID uuid.UUID) ([]models.UserInfo, error) {
+func (m *MockChatsRepository) GetUsersOfChat(ctx context.Context, chatID uuid.UUID) ([]models0.UserInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUsersOfChat", ctx, chatID)
-	ret0, _ := ret[0].([]models.UserInfo)
+	ret0, _ := ret[0].([]models0.UserInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -170,7 +363,7 @@ func (mr *MockChatsRepositoryMockRecorder) GetUsersOfChat(ctx, chatID interface{
 }
 
 // InsertUsersToChat mocks base method.
-func (m *MockChatsRepository) InsertUsersToChat(ctx context.Context, chatID uuid.UUID, usersInfo []models.UserInfo) error {
+func (m *MockChatsRepository) InsertUsersToChat(ctx context.Context, chatID uuid.UUID, usersInfo []models0.UserInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertUsersToChat", ctx, chatID, usersInfo)
 	ret0, _ := ret[0].(error)
@@ -183,11 +376,55 @@ func (mr *MockChatsRepositoryMockRecorder) InsertUsersToChat(ctx, chatID, usersI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUsersToChat", reflect.TypeOf((*MockChatsRepository)(nil).InsertUsersToChat), ctx, chatID, usersInfo)
 }
 
+// JoinByInvite mocks base method.
+func (m *MockChatsRepository) JoinByInvite(ctx context.Context, inviteID uuid.UUID, member models0.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinByInvite", ctx, inviteID, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinByInvite indicates an expected call of JoinByInvite.
+func (mr *MockChatsRepositoryMockRecorder) JoinByInvite(ctx, inviteID, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinByInvite", reflect.TypeOf((*MockChatsRepository)(nil).JoinByInvite), ctx, inviteID, member)
+}
+
+// ResolveChatUsername mocks base method.
+func (m *MockChatsRepository) ResolveChatUsername(ctx context.Context, userID uuid.UUID, username string) (*models0.PublicChat, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveChatUsername", ctx, userID, username)
+	ret0, _ := ret[0].(*models0.PublicChat)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveChatUsername indicates an expected call of ResolveChatUsername.
+func (mr *MockChatsRepositoryMockRecorder) ResolveChatUsername(ctx, userID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveChatUsername", reflect.TypeOf((*MockChatsRepository)(nil).ResolveChatUsername), ctx, userID, username)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockChatsRepository) RevokeInvite(ctx context.Context, chatID, inviteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvite", ctx, chatID, inviteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockChatsRepositoryMockRecorder) RevokeInvite(ctx, chatID, inviteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockChatsRepository)(nil).RevokeInvite), ctx, chatID, inviteID)
+}
+
 // SearchChats mocks base method.
-func (m *MockChatsRepository) SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]models.Chat, error) {
+func (m *MockChatsRepository) SearchChats(ctx context.Context, userID uuid.UUID, name string) ([]models0.Chat, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchChats", ctx, userID, name)
-	ret0, _ := ret[0].([]models.Chat)
+	ret0, _ := ret[0].([]models0.Chat)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -198,6 +435,34 @@ func (mr *MockChatsRepositoryMockRecorder) SearchChats(ctx, userID, name interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChats", reflect.TypeOf((*MockChatsRepository)(nil).SearchChats), ctx, userID, name)
 }
 
+// SetChatUsername mocks base method.
+func (m *MockChatsRepository) SetChatUsername(ctx context.Context, chatID uuid.UUID, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatUsername", ctx, chatID, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatUsername indicates an expected call of SetChatUsername.
+func (mr *MockChatsRepositoryMockRecorder) SetChatUsername(ctx, chatID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatUsername", reflect.TypeOf((*MockChatsRepository)(nil).SetChatUsername), ctx, chatID, username)
+}
+
+// SetCurrentChatAvatar mocks base method.
+func (m *MockChatsRepository) SetCurrentChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCurrentChatAvatar", ctx, chatID, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCurrentChatAvatar indicates an expected call of SetCurrentChatAvatar.
+func (mr *MockChatsRepositoryMockRecorder) SetCurrentChatAvatar(ctx, chatID, attachmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentChatAvatar", reflect.TypeOf((*MockChatsRepository)(nil).SetCurrentChatAvatar), ctx, chatID, attachmentID)
+}
+
 // UpdateChat mocks base method.
 func (m *MockChatsRepository) UpdateChat(ctx context.Context, userID, chatID uuid.UUID, name, description string) error {
 	m.ctrl.T.Helper()
@@ -213,15 +478,29 @@ func (mr *MockChatsRepositoryMockRecorder) UpdateChat(ctx, userID, chatID, name,
 }
 
 // UpdateChatAvatar mocks base method.
-func (m *MockChatsRepository) UpdateChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID, fileSize int64) error {
+func (m *MockChatsRepository) UpdateChatAvatar(ctx context.Context, chatID, attachmentID uuid.UUID, fileSize int64, sizes []int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateChatAvatar", ctx, chatID, attachmentID, fileSize)
+	ret := m.ctrl.Call(m, "UpdateChatAvatar", ctx, chatID, attachmentID, fileSize, sizes)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateChatAvatar indicates an expected call of UpdateChatAvatar.
-func (mr *MockChatsRepositoryMockRecorder) UpdateChatAvatar(ctx, chatID, attachmentID, fileSize interface{}) *gomock.Call {
+func (mr *MockChatsRepositoryMockRecorder) UpdateChatAvatar(ctx, chatID, attachmentID, fileSize, sizes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAvatar", reflect.TypeOf((*MockChatsRepository)(nil).UpdateChatAvatar), ctx, chatID, attachmentID, fileSize, sizes)
+}
+
+// UpdateChatSettings mocks base method.
+func (m *MockChatsRepository) UpdateChatSettings(ctx context.Context, userID, chatID uuid.UUID, settings models0.ChatSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChatSettings", ctx, userID, chatID, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChatSettings indicates an expected call of UpdateChatSettings.
+func (mr *MockChatsRepositoryMockRecorder) UpdateChatSettings(ctx, userID, chatID, settings interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAvatar", reflect.TypeOf((*MockChatsRepository)(nil).UpdateChatAvatar), ctx, chatID, attachmentID, fileSize)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatSettings", reflect.TypeOf((*MockChatsRepository)(nil).UpdateChatSettings), ctx, userID, chatID, settings)
 }
@@ -7,8 +7,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/contact"
+	models0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +38,21 @@ func (m *MockContactRepository) EXPECT() *MockContactRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimPendingImports mocks base method.
+func (m *MockContactRepository) ClaimPendingImports(ctx context.Context, phoneHash string, contactUserID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingImports", ctx, phoneHash, contactUserID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingImports indicates an expected call of ClaimPendingImports.
+func (mr *MockContactRepositoryMockRecorder) ClaimPendingImports(ctx, phoneHash, contactUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingImports", reflect.TypeOf((*MockContactRepository)(nil).ClaimPendingImports), ctx, phoneHash, contactUserID)
+}
+
 // CreateContact mocks base method.
 func (m *MockContactRepository) CreateContact(ctx context.Context, user_id, contact_user_id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -50,6 +67,35 @@ func (mr *MockContactRepositoryMockRecorder) CreateContact(ctx, user_id, contact
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockContactRepository)(nil).CreateContact), ctx, user_id, contact_user_id)
 }
 
+// CreateContacts mocks base method.
+func (m *MockContactRepository) CreateContacts(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContacts", ctx, userID, contactUserIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContacts indicates an expected call of CreateContacts.
+func (mr *MockContactRepositoryMockRecorder) CreateContacts(ctx, userID, contactUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContacts", reflect.TypeOf((*MockContactRepository)(nil).CreateContacts), ctx, userID, contactUserIDs)
+}
+
+// DeleteContact mocks base method.
+func (m *MockContactRepository) DeleteContact(ctx context.Context, userID, contactUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, userID, contactUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockContactRepositoryMockRecorder) DeleteContact(ctx, userID, contactUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactRepository)(nil).DeleteContact), ctx, userID, contactUserID)
+}
+
 // GetAllContacts mocks base method.
 func (m *MockContactRepository) GetAllContacts(ctx context.Context) ([]*models.Contact, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +111,51 @@ func (mr *MockContactRepositoryMockRecorder) GetAllContacts(ctx interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllContacts", reflect.TypeOf((*MockContactRepository)(nil).GetAllContacts), ctx)
 }
 
+// GetContactAliases mocks base method.
+func (m *MockContactRepository) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactAliases", ctx, userID, contactUserIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactAliases indicates an expected call of GetContactAliases.
+func (mr *MockContactRepositoryMockRecorder) GetContactAliases(ctx, userID, contactUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactAliases", reflect.TypeOf((*MockContactRepository)(nil).GetContactAliases), ctx, userID, contactUserIDs)
+}
+
+// GetContactOwners mocks base method.
+func (m *MockContactRepository) GetContactOwners(ctx context.Context, contactUserID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactOwners", ctx, contactUserID, userIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactOwners indicates an expected call of GetContactOwners.
+func (mr *MockContactRepositoryMockRecorder) GetContactOwners(ctx, contactUserID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactOwners", reflect.TypeOf((*MockContactRepository)(nil).GetContactOwners), ctx, contactUserID, userIDs)
+}
+
+// GetContactsByContactUserID mocks base method.
+func (m *MockContactRepository) GetContactsByContactUserID(ctx context.Context, contactUserID uuid.UUID) ([]*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactsByContactUserID", ctx, contactUserID)
+	ret0, _ := ret[0].([]*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactsByContactUserID indicates an expected call of GetContactsByContactUserID.
+func (mr *MockContactRepositoryMockRecorder) GetContactsByContactUserID(ctx, contactUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactsByContactUserID", reflect.TypeOf((*MockContactRepository)(nil).GetContactsByContactUserID), ctx, contactUserID)
+}
+
 // GetContactsByUserID mocks base method.
 func (m *MockContactRepository) GetContactsByUserID(ctx context.Context, user_id uuid.UUID) ([]*models.Contact, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +170,98 @@ func (mr *MockContactRepositoryMockRecorder) GetContactsByUserID(ctx, user_id in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactsByUserID", reflect.TypeOf((*MockContactRepository)(nil).GetContactsByUserID), ctx, user_id)
 }
+
+// MatchPhoneHashes mocks base method.
+func (m *MockContactRepository) MatchPhoneHashes(ctx context.Context, phoneHashes []string) (map[string]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchPhoneHashes", ctx, phoneHashes)
+	ret0, _ := ret[0].(map[string]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchPhoneHashes indicates an expected call of MatchPhoneHashes.
+func (mr *MockContactRepositoryMockRecorder) MatchPhoneHashes(ctx, phoneHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchPhoneHashes", reflect.TypeOf((*MockContactRepository)(nil).MatchPhoneHashes), ctx, phoneHashes)
+}
+
+// ReserveImportQuota mocks base method.
+func (m *MockContactRepository) ReserveImportQuota(ctx context.Context, userID uuid.UUID, count, limit int, window time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveImportQuota", ctx, userID, count, limit, window)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveImportQuota indicates an expected call of ReserveImportQuota.
+func (mr *MockContactRepositoryMockRecorder) ReserveImportQuota(ctx, userID, count, limit, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveImportQuota", reflect.TypeOf((*MockContactRepository)(nil).ReserveImportQuota), ctx, userID, count, limit, window)
+}
+
+// SavePendingImports mocks base method.
+func (m *MockContactRepository) SavePendingImports(ctx context.Context, userID uuid.UUID, phoneHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePendingImports", ctx, userID, phoneHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePendingImports indicates an expected call of SavePendingImports.
+func (mr *MockContactRepositoryMockRecorder) SavePendingImports(ctx, userID, phoneHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePendingImports", reflect.TypeOf((*MockContactRepository)(nil).SavePendingImports), ctx, userID, phoneHashes)
+}
+
+// UpdateContactAlias mocks base method.
+func (m *MockContactRepository) UpdateContactAlias(ctx context.Context, userID, contactUserID uuid.UUID, alias *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContactAlias", ctx, userID, contactUserID, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateContactAlias indicates an expected call of UpdateContactAlias.
+func (mr *MockContactRepositoryMockRecorder) UpdateContactAlias(ctx, userID, contactUserID, alias interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContactAlias", reflect.TypeOf((*MockContactRepository)(nil).UpdateContactAlias), ctx, userID, contactUserID, alias)
+}
+
+// MockContactJoinNotifier is a mock of ContactJoinNotifier interface.
+type MockContactJoinNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactJoinNotifierMockRecorder
+}
+
+// MockContactJoinNotifierMockRecorder is the mock recorder for MockContactJoinNotifier.
+type MockContactJoinNotifierMockRecorder struct {
+	mock *MockContactJoinNotifier
+}
+
+// NewMockContactJoinNotifier creates a new mock instance.
+func NewMockContactJoinNotifier(ctrl *gomock.Controller) *MockContactJoinNotifier {
+	mock := &MockContactJoinNotifier{ctrl: ctrl}
+	mock.recorder = &MockContactJoinNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactJoinNotifier) EXPECT() *MockContactJoinNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyContactJoined mocks base method.
+func (m *MockContactJoinNotifier) NotifyContactJoined(ctx context.Context, userID uuid.UUID, joined *models0.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyContactJoined", ctx, userID, joined)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyContactJoined indicates an expected call of NotifyContactJoined.
+func (mr *MockContactJoinNotifierMockRecorder) NotifyContactJoined(ctx, userID, joined interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyContactJoined", reflect.TypeOf((*MockContactJoinNotifier)(nil).NotifyContactJoined), ctx, userID, joined)
+}
@@ -50,6 +50,35 @@ func (mr *MockIContactUsecaseMockRecorder) CreateContact(ctx, req, userID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockIContactUsecase)(nil).CreateContact), ctx, req, userID)
 }
 
+// DeleteContact mocks base method.
+func (m *MockIContactUsecase) DeleteContact(ctx context.Context, userID, contactUserID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, userID, contactUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockIContactUsecaseMockRecorder) DeleteContact(ctx, userID, contactUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockIContactUsecase)(nil).DeleteContact), ctx, userID, contactUserID)
+}
+
+// GetContactAliases mocks base method.
+func (m *MockIContactUsecase) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactAliases", ctx, userID, contactUserIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactAliases indicates an expected call of GetContactAliases.
+func (mr *MockIContactUsecaseMockRecorder) GetContactAliases(ctx, userID, contactUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactAliases", reflect.TypeOf((*MockIContactUsecase)(nil).GetContactAliases), ctx, userID, contactUserIDs)
+}
+
 // GetContacts mocks base method.
 func (m *MockIContactUsecase) GetContacts(ctx context.Context, userID uuid.UUID) ([]*dto.GetContactsDTO, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +94,49 @@ func (mr *MockIContactUsecaseMockRecorder) GetContacts(ctx, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockIContactUsecase)(nil).GetContacts), ctx, userID)
 }
 
+// HandlePhoneChanged mocks base method.
+func (m *MockIContactUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandlePhoneChanged", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandlePhoneChanged indicates an expected call of HandlePhoneChanged.
+func (mr *MockIContactUsecaseMockRecorder) HandlePhoneChanged(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePhoneChanged", reflect.TypeOf((*MockIContactUsecase)(nil).HandlePhoneChanged), ctx, userID)
+}
+
+// HandleUserRegistered mocks base method.
+func (m *MockIContactUsecase) HandleUserRegistered(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleUserRegistered", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleUserRegistered indicates an expected call of HandleUserRegistered.
+func (mr *MockIContactUsecaseMockRecorder) HandleUserRegistered(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleUserRegistered", reflect.TypeOf((*MockIContactUsecase)(nil).HandleUserRegistered), ctx, userID)
+}
+
+// ImportContacts mocks base method.
+func (m *MockIContactUsecase) ImportContacts(ctx context.Context, userID uuid.UUID, req *dto.ImportContactsDTO) (*dto.ImportContactsResultDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportContacts", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.ImportContactsResultDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportContacts indicates an expected call of ImportContacts.
+func (mr *MockIContactUsecaseMockRecorder) ImportContacts(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportContacts", reflect.TypeOf((*MockIContactUsecase)(nil).ImportContacts), ctx, userID, req)
+}
+
 // ReindexAllContacts mocks base method.
 func (m *MockIContactUsecase) ReindexAllContacts(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -93,3 +165,17 @@ func (mr *MockIContactUsecaseMockRecorder) SearchContacts(ctx, userID, query int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContacts", reflect.TypeOf((*MockIContactUsecase)(nil).SearchContacts), ctx, userID, query)
 }
+
+// UpdateContactAlias mocks base method.
+func (m *MockIContactUsecase) UpdateContactAlias(ctx context.Context, userID, contactUserID uuid.UUID, alias *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContactAlias", ctx, userID, contactUserID, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateContactAlias indicates an expected call of UpdateContactAlias.
+func (mr *MockIContactUsecaseMockRecorder) UpdateContactAlias(ctx, userID, contactUserID, alias interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContactAlias", reflect.TypeOf((*MockIContactUsecase)(nil).UpdateContactAlias), ctx, userID, contactUserID, alias)
+}
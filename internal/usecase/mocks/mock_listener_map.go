@@ -117,6 +117,20 @@ func (mr *MockListenerMapInterfaceMockRecorder) GetOutgoingChannel(connectionID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingChannel", reflect.TypeOf((*MockListenerMapInterface)(nil).GetOutgoingChannel), connectionID)
 }
 
+// GetUserConnections mocks base method.
+func (m *MockListenerMapInterface) GetUserConnections(userID uuid.UUID) []uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserConnections", userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	return ret0
+}
+
+// GetUserConnections indicates an expected call of GetUserConnections.
+func (mr *MockListenerMapInterfaceMockRecorder) GetUserConnections(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserConnections", reflect.TypeOf((*MockListenerMapInterface)(nil).GetUserConnections), userID)
+}
+
 // RegisterUserConnection mocks base method.
 func (m *MockListenerMapInterface) RegisterUserConnection(userID, connectionID uuid.UUID, outgoingChan chan dto.WebSocketMessageDTO) {
 	m.ctrl.T.Helper()
@@ -52,6 +52,20 @@ func (mr *MockMessageRepositoryMockRecorder) CheckAttachmentOwnership(ctx, attac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAttachmentOwnership", reflect.TypeOf((*MockMessageRepository)(nil).CheckAttachmentOwnership), ctx, attachmentID, userID)
 }
 
+// DeleteMentionsExcept mocks base method.
+func (m *MockMessageRepository) DeleteMentionsExcept(ctx context.Context, messageID uuid.UUID, userIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMentionsExcept", ctx, messageID, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMentionsExcept indicates an expected call of DeleteMentionsExcept.
+func (mr *MockMessageRepositoryMockRecorder) DeleteMentionsExcept(ctx, messageID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMentionsExcept", reflect.TypeOf((*MockMessageRepository)(nil).DeleteMentionsExcept), ctx, messageID, userIDs)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockMessageRepository) DeleteMessage(ctx context.Context, messageID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -156,6 +170,21 @@ func (mr *MockMessageRepositoryMockRecorder) GetMessagesOfChat(ctx, chatID, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesOfChat", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesOfChat), ctx, chatID, offset, limit)
 }
 
+// GetUnreadMentions mocks base method.
+func (m *MockMessageRepository) GetUnreadMentions(ctx context.Context, userID, chatID uuid.UUID) ([]models0.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadMentions", ctx, userID, chatID)
+	ret0, _ := ret[0].([]models0.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadMentions indicates an expected call of GetUnreadMentions.
+func (mr *MockMessageRepositoryMockRecorder) GetUnreadMentions(ctx, userID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadMentions", reflect.TypeOf((*MockMessageRepository)(nil).GetUnreadMentions), ctx, userID, chatID)
+}
+
 // InsertAttachment mocks base method.
 func (m *MockMessageRepository) InsertAttachment(ctx context.Context, attachment models.CreateAttachment, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -170,6 +199,21 @@ func (mr *MockMessageRepositoryMockRecorder) InsertAttachment(ctx, attachment, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttachment", reflect.TypeOf((*MockMessageRepository)(nil).InsertAttachment), ctx, attachment, userID)
 }
 
+// InsertMentions mocks base method.
+func (m *MockMessageRepository) InsertMentions(ctx context.Context, messageID, chatID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMentions", ctx, messageID, chatID, userIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertMentions indicates an expected call of InsertMentions.
+func (mr *MockMessageRepositoryMockRecorder) InsertMentions(ctx, messageID, chatID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMentions", reflect.TypeOf((*MockMessageRepository)(nil).InsertMentions), ctx, messageID, chatID, userIDs)
+}
+
 // InsertMessage mocks base method.
 func (m *MockMessageRepository) InsertMessage(ctx context.Context, msg models0.CreateMessage) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -200,32 +244,18 @@ func (mr *MockMessageRepositoryMockRecorder) InsertMessageWithAttachment(ctx, ms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMessageWithAttachment", reflect.TypeOf((*MockMessageRepository)(nil).InsertMessageWithAttachment), ctx, msg)
 }
 
-// LinkAttachmentToMessage mocks base method.
-func (m *MockMessageRepository) LinkAttachmentToMessage(ctx context.Context, messageID, attachmentID, userID uuid.UUID) error {
+// MarkMentionsRead mocks base method.
+func (m *MockMessageRepository) MarkMentionsRead(ctx context.Context, userID, chatID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LinkAttachmentToMessage", ctx, messageID, attachmentID, userID)
+	ret := m.ctrl.Call(m, "MarkMentionsRead", ctx, userID, chatID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// LinkAttachmentToMessage indicates an expected call of LinkAttachmentToMessage.
-func (mr *MockMessageRepositoryMockRecorder) LinkAttachmentToMessage(ctx, messageID, attachmentID, userID interface{}) *gomock.Call {
+// MarkMentionsRead indicates an expected call of MarkMentionsRead.
+func (mr *MockMessageRepositoryMockRecorder) MarkMentionsRead(ctx, userID, chatID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkAttachmentToMessage", reflect.TypeOf((*MockMessageRepository)(nil).LinkAttachmentToMessage), ctx, messageID, attachmentID, userID)
-}
-
-// UpdateAttachmentType mocks base method.
-func (m *MockMessageRepository) UpdateAttachmentType(ctx context.Context, attachmentID uuid.UUID, attachmentType string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAttachmentType", ctx, attachmentID, attachmentType)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateAttachmentType indicates an expected call of UpdateAttachmentType.
-func (mr *MockMessageRepositoryMockRecorder) UpdateAttachmentType(ctx, attachmentID, attachmentType interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttachmentType", reflect.TypeOf((*MockMessageRepository)(nil).UpdateAttachmentType), ctx, attachmentID, attachmentType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMentionsRead", reflect.TypeOf((*MockMessageRepository)(nil).MarkMentionsRead), ctx, userID, chatID)
 }
 
 // SearchMessagesInChat mocks base method.
@@ -243,6 +273,20 @@ func (mr *MockMessageRepositoryMockRecorder) SearchMessagesInChat(ctx, userID, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessagesInChat", reflect.TypeOf((*MockMessageRepository)(nil).SearchMessagesInChat), ctx, userID, chatID, text)
 }
 
+// UpdateAttachmentType mocks base method.
+func (m *MockMessageRepository) UpdateAttachmentType(ctx context.Context, attachmentID uuid.UUID, attachmentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttachmentType", ctx, attachmentID, attachmentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttachmentType indicates an expected call of UpdateAttachmentType.
+func (mr *MockMessageRepositoryMockRecorder) UpdateAttachmentType(ctx, attachmentID, attachmentType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttachmentType", reflect.TypeOf((*MockMessageRepository)(nil).UpdateAttachmentType), ctx, attachmentID, attachmentType)
+}
+
 // UpdateMessage mocks base method.
 func (m *MockMessageRepository) UpdateMessage(ctx context.Context, messageID uuid.UUID, newText string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: phone_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPhoneUsecase is a mock of IPhoneUsecase interface.
+type MockIPhoneUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPhoneUsecaseMockRecorder
+}
+
+// MockIPhoneUsecaseMockRecorder is the mock recorder for MockIPhoneUsecase.
+type MockIPhoneUsecaseMockRecorder struct {
+	mock *MockIPhoneUsecase
+}
+
+// NewMockIPhoneUsecase creates a new mock instance.
+func NewMockIPhoneUsecase(ctrl *gomock.Controller) *MockIPhoneUsecase {
+	mock := &MockIPhoneUsecase{ctrl: ctrl}
+	mock.recorder = &MockIPhoneUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPhoneUsecase) EXPECT() *MockIPhoneUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ConfirmPhoneChange mocks base method.
+func (m *MockIPhoneUsecase) ConfirmPhoneChange(ctx context.Context, userID, currentSessionID uuid.UUID, code string, revokeSessions bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPhoneChange", ctx, userID, currentSessionID, code, revokeSessions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPhoneChange indicates an expected call of ConfirmPhoneChange.
+func (mr *MockIPhoneUsecaseMockRecorder) ConfirmPhoneChange(ctx, userID, currentSessionID, code, revokeSessions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPhoneChange", reflect.TypeOf((*MockIPhoneUsecase)(nil).ConfirmPhoneChange), ctx, userID, currentSessionID, code, revokeSessions)
+}
+
+// RequestPhoneChange mocks base method.
+func (m *MockIPhoneUsecase) RequestPhoneChange(ctx context.Context, userID uuid.UUID, newPhone string) (*dto.PhoneChangeRequested, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPhoneChange", ctx, userID, newPhone)
+	ret0, _ := ret[0].(*dto.PhoneChangeRequested)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPhoneChange indicates an expected call of RequestPhoneChange.
+func (mr *MockIPhoneUsecaseMockRecorder) RequestPhoneChange(ctx, userID, newPhone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPhoneChange", reflect.TypeOf((*MockIPhoneUsecase)(nil).RequestPhoneChange), ctx, userID, newPhone)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../interface/privacy/privacy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPrivacyRepository is a mock of PrivacyRepository interface.
+type MockPrivacyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyRepositoryMockRecorder
+}
+
+// MockPrivacyRepositoryMockRecorder is the mock recorder for MockPrivacyRepository.
+type MockPrivacyRepositoryMockRecorder struct {
+	mock *MockPrivacyRepository
+}
+
+// NewMockPrivacyRepository creates a new mock instance.
+func NewMockPrivacyRepository(ctrl *gomock.Controller) *MockPrivacyRepository {
+	mock := &MockPrivacyRepository{ctrl: ctrl}
+	mock.recorder = &MockPrivacyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyRepository) EXPECT() *MockPrivacyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRules mocks base method.
+func (m *MockPrivacyRepository) GetRules(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]models.UserRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRules", ctx, userIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]models.UserRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRules indicates an expected call of GetRules.
+func (mr *MockPrivacyRepositoryMockRecorder) GetRules(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockPrivacyRepository)(nil).GetRules), ctx, userIDs)
+}
+
+// SetRule mocks base method.
+func (m *MockPrivacyRepository) SetRule(ctx context.Context, userID uuid.UUID, rule *models.Rule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRule", ctx, userID, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRule indicates an expected call of SetRule.
+func (mr *MockPrivacyRepositoryMockRecorder) SetRule(ctx, userID, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRule", reflect.TypeOf((*MockPrivacyRepository)(nil).SetRule), ctx, userID, rule)
+}
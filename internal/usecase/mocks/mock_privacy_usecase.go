@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: privacy_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPrivacyUsecase is a mock of IPrivacyUsecase interface.
+type MockIPrivacyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPrivacyUsecaseMockRecorder
+}
+
+// MockIPrivacyUsecaseMockRecorder is the mock recorder for MockIPrivacyUsecase.
+type MockIPrivacyUsecaseMockRecorder struct {
+	mock *MockIPrivacyUsecase
+}
+
+// NewMockIPrivacyUsecase creates a new mock instance.
+func NewMockIPrivacyUsecase(ctrl *gomock.Controller) *MockIPrivacyUsecase {
+	mock := &MockIPrivacyUsecase{ctrl: ctrl}
+	mock.recorder = &MockIPrivacyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPrivacyUsecase) EXPECT() *MockIPrivacyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetGroupAddDenied mocks base method.
+func (m *MockIPrivacyUsecase) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupAddDenied", ctx, userID, peerIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupAddDenied indicates an expected call of GetGroupAddDenied.
+func (mr *MockIPrivacyUsecaseMockRecorder) GetGroupAddDenied(ctx, userID, peerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupAddDenied", reflect.TypeOf((*MockIPrivacyUsecase)(nil).GetGroupAddDenied), ctx, userID, peerIDs)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockIPrivacyUsecase) GetPrivacySettings(ctx context.Context, userID uuid.UUID) ([]*dto.PrivacyRuleDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", ctx, userID)
+	ret0, _ := ret[0].([]*dto.PrivacyRuleDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockIPrivacyUsecaseMockRecorder) GetPrivacySettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockIPrivacyUsecase)(nil).GetPrivacySettings), ctx, userID)
+}
+
+// UpdatePrivacySetting mocks base method.
+func (m *MockIPrivacyUsecase) UpdatePrivacySetting(ctx context.Context, userID uuid.UUID, rule *dto.PrivacyRuleDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacySetting", ctx, userID, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivacySetting indicates an expected call of UpdatePrivacySetting.
+func (mr *MockIPrivacyUsecaseMockRecorder) UpdatePrivacySetting(ctx, userID, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySetting", reflect.TypeOf((*MockIPrivacyUsecase)(nil).UpdatePrivacySetting), ctx, userID, rule)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../interface/push/push.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockProvider is a mock of Provider interface.
+type MockProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderMockRecorder
+}
+
+// MockProviderMockRecorder is the mock recorder for MockProvider.
+type MockProviderMockRecorder struct {
+	mock *MockProvider
+}
+
+// NewMockProvider creates a new mock instance.
+func NewMockProvider(ctrl *gomock.Controller) *MockProvider {
+	mock := &MockProvider{ctrl: ctrl}
+	mock.recorder = &MockProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockProvider) Send(ctx context.Context, target models.Target, notification models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, target, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockProviderMockRecorder) Send(ctx, target, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockProvider)(nil).Send), ctx, target, notification)
+}
+
+// MockTargetsClient is a mock of TargetsClient interface.
+type MockTargetsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetsClientMockRecorder
+}
+
+// MockTargetsClientMockRecorder is the mock recorder for MockTargetsClient.
+type MockTargetsClientMockRecorder struct {
+	mock *MockTargetsClient
+}
+
+// NewMockTargetsClient creates a new mock instance.
+func NewMockTargetsClient(ctrl *gomock.Controller) *MockTargetsClient {
+	mock := &MockTargetsClient{ctrl: ctrl}
+	mock.recorder = &MockTargetsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTargetsClient) EXPECT() *MockTargetsClientMockRecorder {
+	return m.recorder
+}
+
+// DropPushTokens mocks base method.
+func (m *MockTargetsClient) DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropPushTokens", ctx, sessionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropPushTokens indicates an expected call of DropPushTokens.
+func (mr *MockTargetsClientMockRecorder) DropPushTokens(ctx, sessionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropPushTokens", reflect.TypeOf((*MockTargetsClient)(nil).DropPushTokens), ctx, sessionIDs)
+}
+
+// GetPushTargets mocks base method.
+func (m *MockTargetsClient) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]models.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPushTargets", ctx, userIDs)
+	ret0, _ := ret[0].([]models.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPushTargets indicates an expected call of GetPushTargets.
+func (mr *MockTargetsClientMockRecorder) GetPushTargets(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPushTargets", reflect.TypeOf((*MockTargetsClient)(nil).GetPushTargets), ctx, userIDs)
+}
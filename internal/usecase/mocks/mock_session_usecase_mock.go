@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/session"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -64,6 +65,35 @@ func (mr *MockISessionUsecaseMockRecorder) DeleteSession(ctx, userID, sessionID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockISessionUsecase)(nil).DeleteSession), ctx, userID, sessionID)
 }
 
+// DropPushTokens mocks base method.
+func (m *MockISessionUsecase) DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropPushTokens", ctx, sessionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropPushTokens indicates an expected call of DropPushTokens.
+func (mr *MockISessionUsecaseMockRecorder) DropPushTokens(ctx, sessionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropPushTokens", reflect.TypeOf((*MockISessionUsecase)(nil).DropPushTokens), ctx, sessionIDs)
+}
+
+// GetPushTargets mocks base method.
+func (m *MockISessionUsecase) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]models.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPushTargets", ctx, userIDs)
+	ret0, _ := ret[0].([]models.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPushTargets indicates an expected call of GetPushTargets.
+func (mr *MockISessionUsecaseMockRecorder) GetPushTargets(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPushTargets", reflect.TypeOf((*MockISessionUsecase)(nil).GetPushTargets), ctx, userIDs)
+}
+
 // GetSession mocks base method.
 func (m *MockISessionUsecase) GetSession(ctx context.Context, sessionID uuid.UUID) (*dto.Session, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +124,34 @@ func (mr *MockISessionUsecaseMockRecorder) GetSessionsByUserID(ctx, userID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsByUserID", reflect.TypeOf((*MockISessionUsecase)(nil).GetSessionsByUserID), ctx, userID)
 }
 
+// RegisterPushToken mocks base method.
+func (m *MockISessionUsecase) RegisterPushToken(ctx context.Context, userID, sessionID uuid.UUID, platform, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterPushToken", ctx, userID, sessionID, platform, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterPushToken indicates an expected call of RegisterPushToken.
+func (mr *MockISessionUsecaseMockRecorder) RegisterPushToken(ctx, userID, sessionID, platform, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPushToken", reflect.TypeOf((*MockISessionUsecase)(nil).RegisterPushToken), ctx, userID, sessionID, platform, token)
+}
+
+// UnregisterPushToken mocks base method.
+func (m *MockISessionUsecase) UnregisterPushToken(ctx context.Context, userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterPushToken", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterPushToken indicates an expected call of UnregisterPushToken.
+func (mr *MockISessionUsecaseMockRecorder) UnregisterPushToken(ctx, userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterPushToken", reflect.TypeOf((*MockISessionUsecase)(nil).UnregisterPushToken), ctx, userID, sessionID)
+}
+
 // UpdateSession mocks base method.
 func (m *MockISessionUsecase) UpdateSession(ctx context.Context, sessionID uuid.UUID) error {
 	m.ctrl.T.Helper()
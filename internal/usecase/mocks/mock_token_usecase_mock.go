@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockITokenUsecase is a mock of ITokenUsecase interface.
+type MockITokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITokenUsecaseMockRecorder
+}
+
+// MockITokenUsecaseMockRecorder is the mock recorder for MockITokenUsecase.
+type MockITokenUsecaseMockRecorder struct {
+	mock *MockITokenUsecase
+}
+
+// NewMockITokenUsecase creates a new mock instance.
+func NewMockITokenUsecase(ctrl *gomock.Controller) *MockITokenUsecase {
+	mock := &MockITokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockITokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITokenUsecase) EXPECT() *MockITokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockITokenUsecase) CreateToken(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*dto.CreatedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, userID, name, scopes, expiresIn)
+	ret0, _ := ret[0].(*dto.CreatedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockITokenUsecaseMockRecorder) CreateToken(ctx, userID, name, scopes, expiresIn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockITokenUsecase)(nil).CreateToken), ctx, userID, name, scopes, expiresIn)
+}
+
+// GetTokensByUserID mocks base method.
+func (m *MockITokenUsecase) GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*dto.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokensByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*dto.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokensByUserID indicates an expected call of GetTokensByUserID.
+func (mr *MockITokenUsecaseMockRecorder) GetTokensByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokensByUserID", reflect.TypeOf((*MockITokenUsecase)(nil).GetTokensByUserID), ctx, userID)
+}
+
+// RevokeToken mocks base method.
+func (m *MockITokenUsecase) RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockITokenUsecaseMockRecorder) RevokeToken(ctx, userID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockITokenUsecase)(nil).RevokeToken), ctx, userID, tokenID)
+}
+
+// ValidateToken mocks base method.
+func (m *MockITokenUsecase) ValidateToken(ctx context.Context, value string) (*dto.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", ctx, value)
+	ret0, _ := ret[0].(*dto.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken.
+func (mr *MockITokenUsecaseMockRecorder) ValidateToken(ctx, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockITokenUsecase)(nil).ValidateToken), ctx, value)
+}
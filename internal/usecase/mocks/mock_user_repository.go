@@ -7,8 +7,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
+	models0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +38,51 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteUserAvatar mocks base method.
+func (m *MockUserRepository) DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserAvatar", ctx, userID, avatarID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserAvatar indicates an expected call of DeleteUserAvatar.
+func (mr *MockUserRepositoryMockRecorder) DeleteUserAvatar(ctx, userID, avatarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).DeleteUserAvatar), ctx, userID, avatarID)
+}
+
+// GetAllUsers mocks base method.
+func (m *MockUserRepository) GetAllUsers(ctx context.Context) ([]*models0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
+	ret0, _ := ret[0].([]*models0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUsers indicates an expected call of GetAllUsers.
+func (mr *MockUserRepositoryMockRecorder) GetAllUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserRepository)(nil).GetAllUsers), ctx)
+}
+
+// GetUserAvatarHistory mocks base method.
+func (m *MockUserRepository) GetUserAvatarHistory(ctx context.Context, userID uuid.UUID) ([]*models.Avatar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAvatarHistory", ctx, userID)
+	ret0, _ := ret[0].([]*models.Avatar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAvatarHistory indicates an expected call of GetUserAvatarHistory.
+func (mr *MockUserRepositoryMockRecorder) GetUserAvatarHistory(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAvatarHistory", reflect.TypeOf((*MockUserRepository)(nil).GetUserAvatarHistory), ctx, userID)
+}
+
 // GetUserAvatars mocks base method.
 func (m *MockUserRepository) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -52,10 +99,10 @@ func (mr *MockUserRepositoryMockRecorder) GetUserAvatars(ctx, userIDs interface{
 }
 
 // GetUserByID mocks base method.
-func (m *MockUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+func (m *MockUserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*models0.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
-	ret0, _ := ret[0].(*models.User)
+	ret0, _ := ret[0].(*models0.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -67,10 +114,10 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, id interface{}) *gomo
 }
 
 // GetUserByPhone mocks base method.
-func (m *MockUserRepository) GetUserByPhone(ctx context.Context, phone string) (*models.User, error) {
+func (m *MockUserRepository) GetUserByPhone(ctx context.Context, phone string) (*models0.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByPhone", ctx, phone)
-	ret0, _ := ret[0].(*models.User)
+	ret0, _ := ret[0].(*models0.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -82,10 +129,10 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByPhone(ctx, phone interface{})
 }
 
 // GetUserByUsername mocks base method.
-func (m *MockUserRepository) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+func (m *MockUserRepository) GetUserByUsername(ctx context.Context, username string) (*models0.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByUsername", ctx, username)
-	ret0, _ := ret[0].(*models.User)
+	ret0, _ := ret[0].(*models0.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -96,6 +143,36 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByUsername(ctx, username interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserRepository)(nil).GetUserByUsername), ctx, username)
 }
 
+// GetUserProfile mocks base method.
+func (m *MockUserRepository) GetUserProfile(ctx context.Context, userID uuid.UUID) (*models0.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", ctx, userID)
+	ret0, _ := ret[0].(*models0.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockUserRepositoryMockRecorder) GetUserProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockUserRepository)(nil).GetUserProfile), ctx, userID)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserRepository) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID]*models0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserRepositoryMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByIDs), ctx, ids)
+}
+
 // GetUsersNames mocks base method.
 func (m *MockUserRepository) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -111,32 +188,76 @@ func (mr *MockUserRepositoryMockRecorder) GetUsersNames(ctx, usersIds interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersNames", reflect.TypeOf((*MockUserRepository)(nil).GetUsersNames), ctx, usersIds)
 }
 
+// ResolveUsername mocks base method.
+func (m *MockUserRepository) ResolveUsername(ctx context.Context, username string) (*models0.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveUsername", ctx, username)
+	ret0, _ := ret[0].(*models0.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveUsername indicates an expected call of ResolveUsername.
+func (mr *MockUserRepositoryMockRecorder) ResolveUsername(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUsername", reflect.TypeOf((*MockUserRepository)(nil).ResolveUsername), ctx, username)
+}
+
+// SetCurrentUserAvatar mocks base method.
+func (m *MockUserRepository) SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCurrentUserAvatar", ctx, userID, avatarID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCurrentUserAvatar indicates an expected call of SetCurrentUserAvatar.
+func (mr *MockUserRepositoryMockRecorder) SetCurrentUserAvatar(ctx, userID, avatarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).SetCurrentUserAvatar), ctx, userID, avatarID)
+}
+
+// UpdateLastSeen mocks base method.
+func (m *MockUserRepository) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastSeen", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockUserRepositoryMockRecorder) UpdateLastSeen(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockUserRepository)(nil).UpdateLastSeen), ctx, userID, at)
+}
+
 // UpdateUserAvatar mocks base method.
-func (m *MockUserRepository) UpdateUserAvatar(ctx context.Context, userID, avatarID uuid.UUID, file_size int64) error {
+func (m *MockUserRepository) UpdateUserAvatar(ctx context.Context, userID, avatarID uuid.UUID, file_size int64, sizes []int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserAvatar", ctx, userID, avatarID, file_size)
+	ret := m.ctrl.Call(m, "UpdateUserAvatar", ctx, userID, avatarID, file_size, sizes)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUserAvatar indicates an expected call of UpdateUserAvatar.
-func (mr *MockUserRepositoryMockRecorder) UpdateUserAvatar(ctx, userID, avatarID, file_size interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) UpdateUserAvatar(ctx, userID, avatarID, file_size, sizes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserAvatar), ctx, userID, avatarID, file_size)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserAvatar), ctx, userID, avatarID, file_size, sizes)
 }
 
 // UpdateUserInfo mocks base method.
-func (m *MockUserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name, username, bio *string) error {
+func (m *MockUserRepository) UpdateUserInfo(ctx context.Context, userID uuid.UUID, update models0.InfoUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserInfo", ctx, userID, name, username, bio)
+	ret := m.ctrl.Call(m, "UpdateUserInfo", ctx, userID, update)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUserInfo indicates an expected call of UpdateUserInfo.
-func (mr *MockUserRepositoryMockRecorder) UpdateUserInfo(ctx, userID, name, username, bio interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) UpdateUserInfo(ctx, userID, update interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserInfo", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserInfo), ctx, userID, name, username, bio)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserInfo", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserInfo), ctx, userID, update)
 }
 
 // MockUserClient is a mock of UserClient interface.
@@ -162,11 +283,56 @@ func (m *MockUserClient) EXPECT() *MockUserClientMockRecorder {
 	return m.recorder
 }
 
+// GetBlockedPeers mocks base method.
+func (m *MockUserClient) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedPeers", ctx, userID, peerIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedPeers indicates an expected call of GetBlockedPeers.
+func (mr *MockUserClientMockRecorder) GetBlockedPeers(ctx, userID, peerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedPeers", reflect.TypeOf((*MockUserClient)(nil).GetBlockedPeers), ctx, userID, peerIDs)
+}
+
+// GetContactAliases mocks base method.
+func (m *MockUserClient) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactAliases", ctx, userID, contactUserIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactAliases indicates an expected call of GetContactAliases.
+func (mr *MockUserClientMockRecorder) GetContactAliases(ctx, userID, contactUserIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactAliases", reflect.TypeOf((*MockUserClient)(nil).GetContactAliases), ctx, userID, contactUserIDs)
+}
+
+// GetGroupAddDenied mocks base method.
+func (m *MockUserClient) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupAddDenied", ctx, userID, peerIDs)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupAddDenied indicates an expected call of GetGroupAddDenied.
+func (mr *MockUserClientMockRecorder) GetGroupAddDenied(ctx, userID, peerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupAddDenied", reflect.TypeOf((*MockUserClient)(nil).GetGroupAddDenied), ctx, userID, peerIDs)
+}
+
 // GetUserByID mocks base method.
-func (m *MockUserClient) GetUserByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+func (m *MockUserClient) GetUserByID(ctx context.Context, id uuid.UUID) (*models0.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
-	ret0, _ := ret[0].(*models.User)
+	ret0, _ := ret[0].(*models0.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -178,10 +344,10 @@ func (mr *MockUserClientMockRecorder) GetUserByID(ctx, id interface{}) *gomock.C
 }
 
 // GetUserByPhone mocks base method.
-func (m *MockUserClient) GetUserByPhone(ctx context.Context, phone string) (*models.User, error) {
+func (m *MockUserClient) GetUserByPhone(ctx context.Context, phone string) (*models0.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserByPhone", ctx, phone)
-	ret0, _ := ret[0].(*models.User)
+	ret0, _ := ret[0].(*models0.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -192,6 +358,36 @@ func (mr *MockUserClientMockRecorder) GetUserByPhone(ctx, phone interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhone", reflect.TypeOf((*MockUserClient)(nil).GetUserByPhone), ctx, phone)
 }
 
+// GetUserByUsername mocks base method.
+func (m *MockUserClient) GetUserByUsername(ctx context.Context, username string) (*models0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByUsername", ctx, username)
+	ret0, _ := ret[0].(*models0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByUsername indicates an expected call of GetUserByUsername.
+func (mr *MockUserClientMockRecorder) GetUserByUsername(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserClient)(nil).GetUserByUsername), ctx, username)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *MockUserClient) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID]*models0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUserClientMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUserClient)(nil).GetUsersByIDs), ctx, ids)
+}
+
 // GetUsersNames mocks base method.
 func (m *MockUserClient) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -206,3 +402,186 @@ func (mr *MockUserClientMockRecorder) GetUsersNames(ctx, usersIds interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersNames", reflect.TypeOf((*MockUserClient)(nil).GetUsersNames), ctx, usersIds)
 }
+
+// UpdateLastSeen mocks base method.
+func (m *MockUserClient) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastSeen", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockUserClientMockRecorder) UpdateLastSeen(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockUserClient)(nil).UpdateLastSeen), ctx, userID, at)
+}
+
+// MockUserCacheStore is a mock of UserCacheStore interface.
+type MockUserCacheStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserCacheStoreMockRecorder
+}
+
+// MockUserCacheStoreMockRecorder is the mock recorder for MockUserCacheStore.
+type MockUserCacheStoreMockRecorder struct {
+	mock *MockUserCacheStore
+}
+
+// NewMockUserCacheStore creates a new mock instance.
+func NewMockUserCacheStore(ctrl *gomock.Controller) *MockUserCacheStore {
+	mock := &MockUserCacheStore{ctrl: ctrl}
+	mock.recorder = &MockUserCacheStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserCacheStore) EXPECT() *MockUserCacheStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteUsers mocks base method.
+func (m *MockUserCacheStore) DeleteUsers(ctx context.Context, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUsers", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUsers indicates an expected call of DeleteUsers.
+func (mr *MockUserCacheStoreMockRecorder) DeleteUsers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUsers", reflect.TypeOf((*MockUserCacheStore)(nil).DeleteUsers), ctx, ids)
+}
+
+// GetUsers mocks base method.
+func (m *MockUserCacheStore) GetUsers(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID]*models0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockUserCacheStoreMockRecorder) GetUsers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserCacheStore)(nil).GetUsers), ctx, ids)
+}
+
+// SetUsers mocks base method.
+func (m *MockUserCacheStore) SetUsers(ctx context.Context, users []*models0.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUsers", ctx, users)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUsers indicates an expected call of SetUsers.
+func (mr *MockUserCacheStoreMockRecorder) SetUsers(ctx, users interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUsers", reflect.TypeOf((*MockUserCacheStore)(nil).SetUsers), ctx, users)
+}
+
+// SubscribeInvalidations mocks base method.
+func (m *MockUserCacheStore) SubscribeInvalidations(ctx context.Context, handler func([]uuid.UUID)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeInvalidations", ctx, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeInvalidations indicates an expected call of SubscribeInvalidations.
+func (mr *MockUserCacheStoreMockRecorder) SubscribeInvalidations(ctx, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeInvalidations", reflect.TypeOf((*MockUserCacheStore)(nil).SubscribeInvalidations), ctx, handler)
+}
+
+// MockGroupPeersProvider is a mock of GroupPeersProvider interface.
+type MockGroupPeersProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupPeersProviderMockRecorder
+}
+
+// MockGroupPeersProviderMockRecorder is the mock recorder for MockGroupPeersProvider.
+type MockGroupPeersProviderMockRecorder struct {
+	mock *MockGroupPeersProvider
+}
+
+// NewMockGroupPeersProvider creates a new mock instance.
+func NewMockGroupPeersProvider(ctrl *gomock.Controller) *MockGroupPeersProvider {
+	mock := &MockGroupPeersProvider{ctrl: ctrl}
+	mock.recorder = &MockGroupPeersProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupPeersProvider) EXPECT() *MockGroupPeersProviderMockRecorder {
+	return m.recorder
+}
+
+// GetGroupPeers mocks base method.
+func (m *MockGroupPeersProvider) GetGroupPeers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupPeers", ctx, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupPeers indicates an expected call of GetGroupPeers.
+func (mr *MockGroupPeersProviderMockRecorder) GetGroupPeers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPeers", reflect.TypeOf((*MockGroupPeersProvider)(nil).GetGroupPeers), ctx, userID)
+}
+
+// MockProfileEventPublisher is a mock of ProfileEventPublisher interface.
+type MockProfileEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileEventPublisherMockRecorder
+}
+
+// MockProfileEventPublisherMockRecorder is the mock recorder for MockProfileEventPublisher.
+type MockProfileEventPublisherMockRecorder struct {
+	mock *MockProfileEventPublisher
+}
+
+// NewMockProfileEventPublisher creates a new mock instance.
+func NewMockProfileEventPublisher(ctrl *gomock.Controller) *MockProfileEventPublisher {
+	mock := &MockProfileEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockProfileEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileEventPublisher) EXPECT() *MockProfileEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishProfileChanged mocks base method.
+func (m *MockProfileEventPublisher) PublishProfileChanged(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProfileChanged", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProfileChanged indicates an expected call of PublishProfileChanged.
+func (mr *MockProfileEventPublisherMockRecorder) PublishProfileChanged(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProfileChanged", reflect.TypeOf((*MockProfileEventPublisher)(nil).PublishProfileChanged), ctx, userID)
+}
+
+// PublishStatusChanged mocks base method.
+func (m *MockProfileEventPublisher) PublishStatusChanged(ctx context.Context, userID uuid.UUID, status models0.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStatusChanged", ctx, userID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStatusChanged indicates an expected call of PublishStatusChanged.
+func (mr *MockProfileEventPublisherMockRecorder) PublishStatusChanged(ctx, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStatusChanged", reflect.TypeOf((*MockProfileEventPublisher)(nil).PublishStatusChanged), ctx, userID, status)
+}
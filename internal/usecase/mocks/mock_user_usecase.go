@@ -7,8 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	dto0 "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +39,35 @@ func (m *MockIUserUsecase) EXPECT() *MockIUserUsecaseMockRecorder {
 	return m.recorder
 }
 
+// DeleteUserAvatar mocks base method.
+func (m *MockIUserUsecase) DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserAvatar", ctx, userID, avatarID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserAvatar indicates an expected call of DeleteUserAvatar.
+func (mr *MockIUserUsecaseMockRecorder) DeleteUserAvatar(ctx, userID, avatarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAvatar", reflect.TypeOf((*MockIUserUsecase)(nil).DeleteUserAvatar), ctx, userID, avatarID)
+}
+
+// GetUserAvatarHistory mocks base method.
+func (m *MockIUserUsecase) GetUserAvatarHistory(ctx context.Context, ownerID uuid.UUID) ([]dto0.AvatarDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAvatarHistory", ctx, ownerID)
+	ret0, _ := ret[0].([]dto0.AvatarDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAvatarHistory indicates an expected call of GetUserAvatarHistory.
+func (mr *MockIUserUsecaseMockRecorder) GetUserAvatarHistory(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAvatarHistory", reflect.TypeOf((*MockIUserUsecase)(nil).GetUserAvatarHistory), ctx, ownerID)
+}
+
 // GetUserAvatars mocks base method.
 func (m *MockIUserUsecase) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]*string, error) {
 	m.ctrl.T.Helper()
@@ -96,18 +128,120 @@ func (mr *MockIUserUsecaseMockRecorder) GetUserByUsername(ctx, username interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockIUserUsecase)(nil).GetUserByUsername), ctx, username)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *MockIUserUsecase) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockIUserUsecaseMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockIUserUsecase)(nil).GetUsersByIDs), ctx, ids)
+}
+
+// HandlePhoneChanged mocks base method.
+func (m *MockIUserUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandlePhoneChanged", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandlePhoneChanged indicates an expected call of HandlePhoneChanged.
+func (mr *MockIUserUsecaseMockRecorder) HandlePhoneChanged(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePhoneChanged", reflect.TypeOf((*MockIUserUsecase)(nil).HandlePhoneChanged), ctx, userID)
+}
+
+// IndexUser mocks base method.
+func (m *MockIUserUsecase) IndexUser(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexUser indicates an expected call of IndexUser.
+func (mr *MockIUserUsecaseMockRecorder) IndexUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexUser", reflect.TypeOf((*MockIUserUsecase)(nil).IndexUser), ctx, userID)
+}
+
+// ResolveUsername mocks base method.
+func (m *MockIUserUsecase) ResolveUsername(ctx context.Context, username string) (*dto.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveUsername", ctx, username)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolveUsername indicates an expected call of ResolveUsername.
+func (mr *MockIUserUsecaseMockRecorder) ResolveUsername(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUsername", reflect.TypeOf((*MockIUserUsecase)(nil).ResolveUsername), ctx, username)
+}
+
+// SearchUsers mocks base method.
+func (m *MockIUserUsecase) SearchUsers(ctx context.Context, viewerID uuid.UUID, query string, offset, limit int) (*dto.SearchUsersResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, viewerID, query, offset, limit)
+	ret0, _ := ret[0].(*dto.SearchUsersResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockIUserUsecaseMockRecorder) SearchUsers(ctx, viewerID, query, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockIUserUsecase)(nil).SearchUsers), ctx, viewerID, query, offset, limit)
+}
+
+// SetCurrentUserAvatar mocks base method.
+func (m *MockIUserUsecase) SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCurrentUserAvatar", ctx, userID, avatarID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCurrentUserAvatar indicates an expected call of SetCurrentUserAvatar.
+func (mr *MockIUserUsecaseMockRecorder) SetCurrentUserAvatar(ctx, userID, avatarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCurrentUserAvatar", reflect.TypeOf((*MockIUserUsecase)(nil).SetCurrentUserAvatar), ctx, userID, avatarID)
+}
+
+// UpdateLastSeen mocks base method.
+func (m *MockIUserUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastSeen", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastSeen indicates an expected call of UpdateLastSeen.
+func (mr *MockIUserUsecaseMockRecorder) UpdateLastSeen(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastSeen", reflect.TypeOf((*MockIUserUsecase)(nil).UpdateLastSeen), ctx, userID, at)
+}
+
 // UpdateUserInfo mocks base method.
-func (m *MockIUserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name, username, bio *string) error {
+func (m *MockIUserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, update models.InfoUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserInfo", ctx, userID, name, username, bio)
+	ret := m.ctrl.Call(m, "UpdateUserInfo", ctx, userID, update)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUserInfo indicates an expected call of UpdateUserInfo.
-func (mr *MockIUserUsecaseMockRecorder) UpdateUserInfo(ctx, userID, name, username, bio interface{}) *gomock.Call {
+func (mr *MockIUserUsecaseMockRecorder) UpdateUserInfo(ctx, userID, update interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserInfo", reflect.TypeOf((*MockIUserUsecase)(nil).UpdateUserInfo), ctx, userID, name, username, bio)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserInfo", reflect.TypeOf((*MockIUserUsecase)(nil).UpdateUserInfo), ctx, userID, update)
 }
 
 // UploadUserAvatar mocks base method.
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../interface/webhook/webhook.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookDispatcher is a mock of WebhookDispatcher interface.
+type MockWebhookDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDispatcherMockRecorder
+}
+
+// MockWebhookDispatcherMockRecorder is the mock recorder for MockWebhookDispatcher.
+type MockWebhookDispatcherMockRecorder struct {
+	mock *MockWebhookDispatcher
+}
+
+// NewMockWebhookDispatcher creates a new mock instance.
+func NewMockWebhookDispatcher(ctrl *gomock.Controller) *MockWebhookDispatcher {
+	mock := &MockWebhookDispatcher{ctrl: ctrl}
+	mock.recorder = &MockWebhookDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDispatcher) EXPECT() *MockWebhookDispatcherMockRecorder {
+	return m.recorder
+}
+
+// DispatchMessage mocks base method.
+func (m *MockWebhookDispatcher) DispatchMessage(ctx context.Context, msg dto.MessageDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DispatchMessage", ctx, msg)
+}
+
+// DispatchMessage indicates an expected call of DispatchMessage.
+func (mr *MockWebhookDispatcherMockRecorder) DispatchMessage(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchMessage", reflect.TypeOf((*MockWebhookDispatcher)(nil).DispatchMessage), ctx, msg)
+}
@@ -0,0 +1,44 @@
+package outbox
+
+import "sync"
+
+// Deduplicator помнит последние size ключей событий, чтобы потребитель
+// не обработал повторно доставленное событие
+type Deduplicator struct {
+	mu    sync.Mutex
+	keys  map[string]struct{}
+	order []string
+	next  int
+}
+
+func NewDeduplicator(size int) *Deduplicator {
+	return &Deduplicator{
+		keys:  make(map[string]struct{}, size),
+		order: make([]string, size),
+	}
+}
+
+func (d *Deduplicator) Seen(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, ok := d.keys[key]
+	return ok
+}
+
+// Remember запоминает ключ после успешной обработки, вытесняя самый старый
+func (d *Deduplicator) Remember(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.keys[key]; ok {
+		return
+	}
+
+	if old := d.order[d.next]; old != "" {
+		delete(d.keys, old)
+	}
+	d.order[d.next] = key
+	d.keys[key] = struct{}{}
+	d.next = (d.next + 1) % len(d.order)
+}
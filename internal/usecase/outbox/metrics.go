@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	publishedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chats_outbox_published_total",
+			Help: "Total number of outbox events delivered to all consumers",
+		},
+		[]string{"event_type"},
+	)
+
+	publishFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chats_outbox_publish_failures_total",
+			Help: "Total number of failed outbox event deliveries by consumer",
+		},
+		[]string{"publisher"},
+	)
+
+	deliveryLag = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "chats_outbox_delivery_lag_seconds",
+			Help:    "Time between writing an outbox event and delivering it to all consumers",
+			Buckets: []float64{0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 30, 120},
+		},
+	)
+)
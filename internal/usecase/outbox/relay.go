@@ -0,0 +1,162 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	interfaceOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/outbox"
+	"github.com/google/uuid"
+)
+
+const (
+	InitialBackoff  = time.Second
+	MaxBackoff      = 5 * time.Minute
+	CleanupInterval = 10 * time.Minute
+)
+
+// Relay забирает события из outbox и доставляет их всем потребителям.
+// Событие отмечается доставленным, только когда его приняли все потребители; иначе оно
+// повторяется целиком с нарастающей задержкой, а уже принявшие отбрасывают дубль по DedupKey
+type Relay struct {
+	repo       interfaceOutbox.OutboxRepository
+	publishers []interfaceOutbox.Publisher
+	conf       *config.OutboxConfig
+	wake       chan struct{}
+}
+
+func NewRelay(repo interfaceOutbox.OutboxRepository, conf *config.OutboxConfig, publishers ...interfaceOutbox.Publisher) *Relay {
+	return &Relay{
+		repo:       repo,
+		publishers: publishers,
+		conf:       conf,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// Notify будит relay, не дожидаясь очередного опроса. Не блокируется
+func (r *Relay) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	const op = "Relay.Run"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+	logger.Info("Outbox relay started")
+	defer logger.Info("Outbox relay stopped")
+
+	poll := time.NewTicker(r.conf.PollInterval)
+	defer poll.Stop()
+
+	cleanup := time.NewTicker(CleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		r.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.wake:
+		case <-poll.C:
+		case <-cleanup.C:
+			r.cleanup(ctx)
+		}
+	}
+}
+
+// drain забирает пачки, пока они приходят полными
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		if r.processBatch(ctx) < r.conf.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) processBatch(ctx context.Context) int {
+	const op = "Relay.processBatch"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	events, err := r.repo.ClaimEvents(ctx, r.conf.BatchSize, r.conf.Lease)
+	if err != nil {
+		logger.WithError(err).Error("could not claim outbox events")
+		return 0
+	}
+
+	if len(events) == 0 {
+		return 0
+	}
+
+	published := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		if err := r.publish(ctx, event); err != nil {
+			retryAt := time.Now().Add(backoff(event.Attempts))
+			logger.WithError(err).Warnf("outbox event %s (%s) not delivered, attempt %d, retry at %s",
+				event.ID, event.Type, event.Attempts, retryAt.Format(time.RFC3339))
+
+			if err := r.repo.MarkFailed(ctx, event.ID, err.Error(), retryAt); err != nil {
+				// Событие вернётся после окончания аренды
+				logger.WithError(err).Errorf("could not reschedule outbox event %s", event.ID)
+			}
+			continue
+		}
+
+		published = append(published, event.ID)
+		publishedTotal.WithLabelValues(event.Type).Inc()
+		deliveryLag.Observe(time.Since(event.CreatedAt).Seconds())
+	}
+
+	if err := r.repo.MarkPublished(ctx, published); err != nil {
+		// Доставленные события придут повторно после окончания аренды, потребители отбросят их по DedupKey
+		logger.WithError(err).Errorf("could not mark %d outbox events as published", len(published))
+	}
+
+	return len(events)
+}
+
+func (r *Relay) publish(ctx context.Context, event modelsOutbox.Event) error {
+	var errs []error
+	for _, publisher := range r.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			publishFailuresTotal.WithLabelValues(publisher.Name()).Inc()
+			errs = append(errs, fmt.Errorf("%s: %w", publisher.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Relay) cleanup(ctx context.Context) {
+	const op = "Relay.cleanup"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	deleted, err := r.repo.DeletePublishedBefore(ctx, time.Now().Add(-r.conf.Retention))
+	if err != nil {
+		logger.WithError(err).Error("could not delete published outbox events")
+		return
+	}
+
+	if deleted > 0 {
+		logger.Infof("deleted %d published outbox events", deleted)
+	}
+}
+
+func backoff(attempts int) time.Duration {
+	delay := InitialBackoff
+	for i := 1; i < attempts && delay < MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, MaxBackoff)
+}
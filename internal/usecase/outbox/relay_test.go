@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig() *config.OutboxConfig {
+	return &config.OutboxConfig{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		Lease:        30 * time.Second,
+		Retention:    time.Hour,
+	}
+}
+
+func newEvent(attempts int) modelsOutbox.Event {
+	return modelsOutbox.Event{
+		ID:        uuid.New(),
+		DedupKey:  uuid.NewString(),
+		Type:      modelsOutbox.EventMessageCreated,
+		Attempts:  attempts,
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestRelay_ProcessBatch_PublishesToAllConsumers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockOutboxRepository(ctrl)
+	realtime := mocks.NewMockPublisher(ctrl)
+	search := mocks.NewMockPublisher(ctrl)
+
+	relay := NewRelay(repo, testConfig(), realtime, search)
+	ctx := context.Background()
+	first, second := newEvent(1), newEvent(1)
+
+	repo.EXPECT().ClaimEvents(ctx, 10, 30*time.Second).Return([]modelsOutbox.Event{first, second}, nil)
+	realtime.EXPECT().Publish(ctx, first).Return(nil)
+	search.EXPECT().Publish(ctx, first).Return(nil)
+	realtime.EXPECT().Publish(ctx, second).Return(nil)
+	search.EXPECT().Publish(ctx, second).Return(nil)
+	repo.EXPECT().MarkPublished(ctx, []uuid.UUID{first.ID, second.ID}).Return(nil)
+
+	assert.Equal(t, 2, relay.processBatch(ctx))
+}
+
+func TestRelay_ProcessBatch_RetriesFailedEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockOutboxRepository(ctrl)
+	realtime := mocks.NewMockPublisher(ctrl)
+	search := mocks.NewMockPublisher(ctrl)
+
+	relay := NewRelay(repo, testConfig(), realtime, search)
+	ctx := context.Background()
+	failed, ok := newEvent(3), newEvent(1)
+
+	repo.EXPECT().ClaimEvents(ctx, 10, 30*time.Second).Return([]modelsOutbox.Event{failed, ok}, nil)
+	// Один потребитель принял событие, другой - нет: событие повторится целиком
+	realtime.EXPECT().Publish(ctx, failed).Return(nil)
+	search.EXPECT().Publish(ctx, failed).Return(errors.New("index unavailable"))
+	search.EXPECT().Name().Return("search").AnyTimes()
+	realtime.EXPECT().Publish(ctx, ok).Return(nil)
+	search.EXPECT().Publish(ctx, ok).Return(nil)
+
+	before := time.Now()
+	repo.EXPECT().MarkFailed(ctx, failed.ID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, reason string, retryAt time.Time) error {
+			assert.Contains(t, reason, "search: index unavailable")
+			// Третья попытка - задержка 4 секунды
+			assert.WithinDuration(t, before.Add(4*time.Second), retryAt, time.Second)
+			return nil
+		})
+	repo.EXPECT().MarkPublished(ctx, []uuid.UUID{ok.ID}).Return(nil)
+
+	assert.Equal(t, 2, relay.processBatch(ctx))
+}
+
+func TestRelay_ProcessBatch_ClaimError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockOutboxRepository(ctrl)
+
+	relay := NewRelay(repo, testConfig())
+	ctx := context.Background()
+
+	repo.EXPECT().ClaimEvents(ctx, 10, 30*time.Second).Return(nil, errors.New("db error"))
+
+	assert.Equal(t, 0, relay.processBatch(ctx))
+}
+
+func TestRelay_RunDrainsOnNotify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockOutboxRepository(ctrl)
+	publisher := mocks.NewMockPublisher(ctrl)
+
+	conf := testConfig()
+	conf.PollInterval = time.Hour
+	relay := NewRelay(repo, conf, publisher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	event := newEvent(1)
+	delivered := make(chan struct{})
+
+	// Первый проход при старте пустой, второй - после уведомления
+	repo.EXPECT().ClaimEvents(gomock.Any(), 10, 30*time.Second).Return(nil, nil)
+	repo.EXPECT().ClaimEvents(gomock.Any(), 10, 30*time.Second).Return([]modelsOutbox.Event{event}, nil)
+	publisher.EXPECT().Publish(gomock.Any(), event).Return(nil)
+	repo.EXPECT().MarkPublished(gomock.Any(), []uuid.UUID{event.ID}).DoAndReturn(func(context.Context, []uuid.UUID) error {
+		close(delivered)
+		return nil
+	})
+
+	go relay.Run(ctx)
+	relay.Notify()
+	// Повторное уведомление не блокирует вызывающего
+	relay.Notify()
+
+	select {
+	case <-delivered:
+	case <-time.After(2 * time.Second):
+		t.Fatal("event was not delivered after notify")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(1))
+	assert.Equal(t, 2*time.Second, backoff(2))
+	assert.Equal(t, 8*time.Second, backoff(4))
+	assert.Equal(t, MaxBackoff, backoff(50))
+}
+
+func TestDeduplicator(t *testing.T) {
+	d := NewDeduplicator(2)
+
+	assert.False(t, d.Seen("a"))
+	d.Remember("a")
+	d.Remember("b")
+	assert.True(t, d.Seen("a"))
+	assert.True(t, d.Seen("b"))
+
+	// Самый старый ключ вытесняется
+	d.Remember("c")
+	assert.False(t, d.Seen("a"))
+	assert.True(t, d.Seen("b"))
+	assert.True(t, d.Seen("c"))
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	AuthDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/auth"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/utils/validation"
+	"github.com/google/uuid"
+)
+
+type PhoneChangeRepository interface {
+	SavePhoneChange(ctx context.Context, change *UserModels.PhoneChange) error
+	GetPhoneChange(ctx context.Context, userID uuid.UUID) (*UserModels.PhoneChange, error)
+	DeletePhoneChange(ctx context.Context, userID uuid.UUID) error
+	// IncrPhoneChangeAttempts атомарно засчитывает попытку ввода кода; счётчик живёт ttl с первой попытки
+	IncrPhoneChangeAttempts(ctx context.Context, userID uuid.UUID, ttl time.Duration) (int, error)
+	ResetPhoneChangeAttempts(ctx context.Context, userID uuid.UUID) error
+}
+
+type AuthRepository interface {
+	UpdatePhoneNumber(ctx context.Context, userID uuid.UUID, phone string) (string, error)
+}
+
+type UserClient interface {
+	GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error)
+	// NotifyPhoneChanged сообщает user_service о новом номере, чтобы сбросить кэш профиля и обновить индекс контактов
+	NotifyPhoneChanged(ctx context.Context, userID uuid.UUID) error
+}
+
+type SessionRepository interface {
+	DeleteAllSessionWithoutCurrent(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) error
+}
+
+// CodeSender доставляет короткие сообщения на номер телефона (SMS-шлюз или его заглушка)
+type CodeSender interface {
+	Send(ctx context.Context, phone, text string) error
+}
+
+type PhoneUsecase struct {
+	phonerepo   PhoneChangeRepository
+	authrepo    AuthRepository
+	userrepo    UserClient
+	sessionrepo SessionRepository
+	sender      CodeSender
+}
+
+func New(phonerepo PhoneChangeRepository, authrepo AuthRepository, userrepo UserClient, sessionrepo SessionRepository, sender CodeSender) *PhoneUsecase {
+	return &PhoneUsecase{
+		phonerepo:   phonerepo,
+		authrepo:    authrepo,
+		userrepo:    userrepo,
+		sessionrepo: sessionrepo,
+		sender:      sender,
+	}
+}
+
+// RequestPhoneChange отправляет код подтверждения на новый номер. Повторный запрос заменяет прежний код
+func (uc *PhoneUsecase) RequestPhoneChange(ctx context.Context, userID uuid.UUID, newPhone string) (*AuthDTO.PhoneChangeRequested, error) {
+	const op = "PhoneUsecase.RequestPhoneChange"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	phone, ok := validation.ValidateAndNormalizePhone(newPhone)
+	if !ok {
+		logger.Warn("invalid phone number")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	existing, err := uc.userrepo.GetUserByPhone(ctx, phone)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to check phone number")
+		return nil, wrappedErr
+	}
+	if existing != nil {
+		logger.Warn("phone number is already taken")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrIsDuplicateKey)
+	}
+
+	now := time.Now()
+
+	pending, err := uc.phonerepo.GetPhoneChange(ctx, userID)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get pending phone change")
+		return nil, wrappedErr
+	}
+	if pending != nil && now.Before(pending.SentAt.Add(UserModels.PhoneChangeResendInterval)) {
+		logger.Warn("phone change code requested too often")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrTooManyRequests)
+	}
+
+	code, err := generateCode()
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to generate code")
+		return nil, wrappedErr
+	}
+
+	change := &UserModels.PhoneChange{
+		UserID:    userID,
+		NewPhone:  phone,
+		CodeHash:  hashCode(userID, phone, code),
+		SentAt:    now,
+		ExpiresAt: now.Add(UserModels.PhoneChangeCodeTTL),
+	}
+
+	if err := uc.phonerepo.SavePhoneChange(ctx, change); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to save phone change")
+		return nil, wrappedErr
+	}
+
+	text := fmt.Sprintf("Код для смены номера: %s. Никому его не сообщайте", code)
+	if err := uc.sender.Send(ctx, phone, text); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to send code")
+		// Без доставленного кода запись бесполезна и лишь блокировала бы повторный запрос
+		if err := uc.phonerepo.DeletePhoneChange(ctx, userID); err != nil {
+			logger.WithError(err).Warn("failed to drop undelivered phone change")
+		}
+		return nil, wrappedErr
+	}
+
+	return &AuthDTO.PhoneChangeRequested{
+		ExpiresAt:   change.ExpiresAt,
+		ResendAfter: change.SentAt.Add(UserModels.PhoneChangeResendInterval),
+	}, nil
+}
+
+// ConfirmPhoneChange проверяет код и заменяет номер. currentSessionID сохраняется при revokeSessions
+func (uc *PhoneUsecase) ConfirmPhoneChange(ctx context.Context, userID, currentSessionID uuid.UUID, code string, revokeSessions bool) error {
+	const op = "PhoneUsecase.ConfirmPhoneChange"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	change, err := uc.phonerepo.GetPhoneChange(ctx, userID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			logger.Warn("no pending phone change")
+			return fmt.Errorf("%s: %w", op, errs.ErrNotFound)
+		}
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get pending phone change")
+		return wrappedErr
+	}
+
+	if time.Now().After(change.ExpiresAt) {
+		logger.Warn("phone change code expired")
+		return fmt.Errorf("%s: %w", op, errs.ErrNotFound)
+	}
+
+	// Попытка засчитывается до сравнения: без учтённой попытки код не проверяется
+	attempts, err := uc.phonerepo.IncrPhoneChangeAttempts(ctx, userID, UserModels.PhoneChangeCodeTTL)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to count phone change attempt")
+		return wrappedErr
+	}
+	if attempts > UserModels.PhoneChangeMaxAttempts {
+		logger.Warn("phone change attempts exhausted")
+		return fmt.Errorf("%s: %w", op, errs.ErrTooManyRequests)
+	}
+
+	expected := []byte(change.CodeHash)
+	actual := []byte(hashCode(userID, change.NewPhone, code))
+	if subtle.ConstantTimeCompare(expected, actual) != 1 {
+		if attempts == UserModels.PhoneChangeMaxAttempts {
+			logger.Warn("phone change attempts exhausted")
+			if err := uc.phonerepo.DeletePhoneChange(ctx, userID); err != nil {
+				logger.WithError(err).Warn("failed to drop phone change")
+			}
+			return fmt.Errorf("%s: %w", op, errs.ErrTooManyRequests)
+		}
+		logger.Warn("invalid phone change code")
+		return fmt.Errorf("%s: %w", op, errs.ErrInvalidCode)
+	}
+
+	oldPhone, err := uc.authrepo.UpdatePhoneNumber(ctx, userID, change.NewPhone)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to update phone number")
+		return wrappedErr
+	}
+
+	// Номер уже заменён, дальше только побочные действия - их сбой не отменяет смену
+	if err := uc.phonerepo.DeletePhoneChange(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to drop confirmed phone change")
+	}
+	if err := uc.phonerepo.ResetPhoneChangeAttempts(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to reset phone change attempts")
+	}
+
+	text := fmt.Sprintf("Номер вашего аккаунта изменён на %s. Если это были не вы, обратитесь в поддержку", maskPhone(change.NewPhone))
+	if err := uc.sender.Send(ctx, oldPhone, text); err != nil {
+		logger.WithError(err).Warn("failed to notify old phone number")
+	}
+
+	if err := uc.userrepo.NotifyPhoneChanged(ctx, userID); err != nil {
+		logger.WithError(err).Warn("failed to notify about phone change")
+	}
+
+	if revokeSessions {
+		if err := uc.sessionrepo.DeleteAllSessionWithoutCurrent(ctx, userID, currentSessionID); err != nil {
+			logger.WithError(err).Warn("failed to revoke sessions after phone change")
+		}
+	}
+
+	return nil
+}
+
+func generateCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < UserModels.PhoneChangeCodeLength; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", UserModels.PhoneChangeCodeLength, n), nil
+}
+
+// hashCode привязывает код к пользователю и номеру, чтобы хэш из одной заявки не подходил к другой
+func hashCode(userID uuid.UUID, phone, code string) string {
+	sum := sha256.Sum256([]byte(userID.String() + ":" + phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+// maskPhone оставляет видимыми код страны и последние цифры номера
+func maskPhone(phone string) string {
+	const prefix, suffix = 2, 4
+	runes := []rune(phone)
+	if len(runes) <= prefix+suffix {
+		return phone
+	}
+
+	masked := make([]rune, len(runes))
+	for i, r := range runes {
+		if i >= prefix && i < len(runes)-suffix && r >= '0' && r <= '9' {
+			masked[i] = '*'
+		} else {
+			masked[i] = r
+		}
+	}
+	return string(masked)
+}
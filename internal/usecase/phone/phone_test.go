@@ -0,0 +1,370 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPhoneChangeRepository struct {
+	mock.Mock
+}
+
+func (m *MockPhoneChangeRepository) SavePhoneChange(ctx context.Context, change *UserModels.PhoneChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockPhoneChangeRepository) GetPhoneChange(ctx context.Context, userID uuid.UUID) (*UserModels.PhoneChange, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserModels.PhoneChange), args.Error(1)
+}
+
+func (m *MockPhoneChangeRepository) DeletePhoneChange(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockPhoneChangeRepository) IncrPhoneChangeAttempts(ctx context.Context, userID uuid.UUID, ttl time.Duration) (int, error) {
+	args := m.Called(ctx, userID, ttl)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPhoneChangeRepository) ResetPhoneChangeAttempts(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+type MockAuthRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuthRepository) UpdatePhoneNumber(ctx context.Context, userID uuid.UUID, phone string) (string, error) {
+	args := m.Called(ctx, userID, phone)
+	return args.String(0), args.Error(1)
+}
+
+type MockUserClient struct {
+	mock.Mock
+}
+
+func (m *MockUserClient) GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error) {
+	args := m.Called(ctx, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserModels.User), args.Error(1)
+}
+
+func (m *MockUserClient) NotifyPhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepository) DeleteAllSessionWithoutCurrent(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) error {
+	args := m.Called(ctx, userID, currentSessionID)
+	return args.Error(0)
+}
+
+// stubSender запоминает отправленные сообщения
+type stubSender struct {
+	sent map[string]string
+	err  error
+}
+
+func (s *stubSender) Send(ctx context.Context, phone, text string) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.sent == nil {
+		s.sent = make(map[string]string)
+	}
+	s.sent[phone] = text
+	return nil
+}
+
+type phoneMocks struct {
+	phone   *MockPhoneChangeRepository
+	auth    *MockAuthRepository
+	user    *MockUserClient
+	session *MockSessionRepository
+	sender  *stubSender
+}
+
+func newTestUsecase() (*PhoneUsecase, phoneMocks) {
+	m := phoneMocks{
+		phone:   new(MockPhoneChangeRepository),
+		auth:    new(MockAuthRepository),
+		user:    new(MockUserClient),
+		session: new(MockSessionRepository),
+		sender:  &stubSender{},
+	}
+	return New(m.phone, m.auth, m.user, m.session, m.sender), m
+}
+
+// codeFromText достаёт код из текста сообщения
+func codeFromText(text string) string {
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r < '0' || r > '9' }) {
+		if len(field) == UserModels.PhoneChangeCodeLength {
+			return field
+		}
+	}
+	return ""
+}
+
+func TestPhoneUsecase_RequestPhoneChange_Success(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.user.On("GetUserByPhone", ctx, "+79990001122").Return(nil, errs.ErrNotFound)
+	m.phone.On("GetPhoneChange", ctx, userID).Return(nil, errs.ErrNotFound)
+
+	var saved *UserModels.PhoneChange
+	m.phone.On("SavePhoneChange", ctx, mock.AnythingOfType("*models.PhoneChange")).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(*UserModels.PhoneChange) }).
+		Return(nil)
+
+	res, err := uc.RequestPhoneChange(ctx, userID, "8 999 000 11 22")
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, "+79990001122", saved.NewPhone)
+	assert.Equal(t, saved.ExpiresAt, res.ExpiresAt)
+
+	code := codeFromText(m.sender.sent["+79990001122"])
+	require.Len(t, code, UserModels.PhoneChangeCodeLength)
+	assert.Equal(t, hashCode(userID, saved.NewPhone, code), saved.CodeHash)
+	assert.NotContains(t, saved.CodeHash, code)
+}
+
+func TestPhoneUsecase_RequestPhoneChange_PhoneTaken(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+
+	m.user.On("GetUserByPhone", ctx, "+79990001122").Return(&UserModels.User{ID: uuid.New()}, nil)
+
+	res, err := uc.RequestPhoneChange(ctx, uuid.New(), "+79990001122")
+
+	assert.ErrorIs(t, err, errs.ErrIsDuplicateKey)
+	assert.Nil(t, res)
+	assert.Empty(t, m.sender.sent)
+}
+
+func TestPhoneUsecase_RequestPhoneChange_LookupError(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+
+	m.user.On("GetUserByPhone", ctx, "+79990001122").Return(nil, errors.New("user service unavailable"))
+
+	res, err := uc.RequestPhoneChange(ctx, uuid.New(), "+79990001122")
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errs.ErrIsDuplicateKey)
+	assert.Nil(t, res)
+	assert.Empty(t, m.sender.sent)
+}
+
+func TestPhoneUsecase_RequestPhoneChange_InvalidPhone(t *testing.T) {
+	uc, _ := newTestUsecase()
+
+	_, err := uc.RequestPhoneChange(context.Background(), uuid.New(), "12345")
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestPhoneUsecase_RequestPhoneChange_ResendTooSoon(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.user.On("GetUserByPhone", ctx, "+79990001122").Return(nil, errs.ErrNotFound)
+	m.phone.On("GetPhoneChange", ctx, userID).Return(&UserModels.PhoneChange{
+		UserID:    userID,
+		SentAt:    time.Now().Add(-10 * time.Second),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	_, err := uc.RequestPhoneChange(ctx, userID, "+79990001122")
+
+	assert.ErrorIs(t, err, errs.ErrTooManyRequests)
+	m.phone.AssertNotCalled(t, "SavePhoneChange", mock.Anything, mock.Anything)
+}
+
+func TestPhoneUsecase_RequestPhoneChange_SendFailureDropsChange(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+	m.sender.err = errors.New("gateway down")
+
+	m.user.On("GetUserByPhone", ctx, "+79990001122").Return(nil, errs.ErrNotFound)
+	m.phone.On("GetPhoneChange", ctx, userID).Return(nil, errs.ErrNotFound)
+	m.phone.On("SavePhoneChange", ctx, mock.Anything).Return(nil)
+	m.phone.On("DeletePhoneChange", ctx, userID).Return(nil)
+
+	_, err := uc.RequestPhoneChange(ctx, userID, "+79990001122")
+
+	assert.Error(t, err)
+	m.phone.AssertExpectations(t)
+}
+
+func pendingChange(userID uuid.UUID, code string) *UserModels.PhoneChange {
+	return &UserModels.PhoneChange{
+		UserID:    userID,
+		NewPhone:  "+79990001122",
+		CodeHash:  hashCode(userID, "+79990001122", code),
+		SentAt:    time.Now(),
+		ExpiresAt: time.Now().Add(UserModels.PhoneChangeCodeTTL),
+	}
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_Success(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(1, nil)
+	m.auth.On("UpdatePhoneNumber", ctx, userID, "+79990001122").Return("+79998887766", nil)
+	m.phone.On("DeletePhoneChange", ctx, userID).Return(nil)
+	m.phone.On("ResetPhoneChangeAttempts", ctx, userID).Return(nil)
+	m.user.On("NotifyPhoneChanged", ctx, userID).Return(nil)
+	m.session.On("DeleteAllSessionWithoutCurrent", ctx, userID, sessionID).Return(nil)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, sessionID, "123456", true)
+
+	require.NoError(t, err)
+	assert.Contains(t, m.sender.sent["+79998887766"], "+7******1122")
+	m.phone.AssertExpectations(t)
+	m.auth.AssertExpectations(t)
+	m.user.AssertExpectations(t)
+	m.session.AssertExpectations(t)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_KeepsSessions(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(1, nil)
+	m.auth.On("UpdatePhoneNumber", ctx, userID, "+79990001122").Return("+79998887766", nil)
+	m.phone.On("DeletePhoneChange", ctx, userID).Return(nil)
+	m.phone.On("ResetPhoneChangeAttempts", ctx, userID).Return(nil)
+	m.user.On("NotifyPhoneChanged", ctx, userID).Return(errors.New("user service unavailable"))
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "123456", false)
+
+	assert.NoError(t, err)
+	m.session.AssertNotCalled(t, "DeleteAllSessionWithoutCurrent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_InvalidCode(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(1, nil)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "654321", false)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidCode)
+	m.phone.AssertExpectations(t)
+	m.phone.AssertNotCalled(t, "DeletePhoneChange", mock.Anything, mock.Anything)
+	m.auth.AssertNotCalled(t, "UpdatePhoneNumber", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_AttemptsExhausted(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(UserModels.PhoneChangeMaxAttempts, nil)
+	m.phone.On("DeletePhoneChange", ctx, userID).Return(nil)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "654321", false)
+
+	assert.ErrorIs(t, err, errs.ErrTooManyRequests)
+	m.phone.AssertExpectations(t)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_LockedAfterResend(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// Новый код после исчерпания попыток: счётчик не сброшен, даже верный код не принимается
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(UserModels.PhoneChangeMaxAttempts+1, nil)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "123456", false)
+
+	assert.ErrorIs(t, err, errs.ErrTooManyRequests)
+	m.auth.AssertNotCalled(t, "UpdatePhoneNumber", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_CounterUnavailable(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(0, errors.New("redis down"))
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "123456", false)
+
+	assert.Error(t, err)
+	m.auth.AssertNotCalled(t, "UpdatePhoneNumber", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_NoPendingChange(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(nil, errs.ErrNotFound)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "123456", false)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestPhoneUsecase_ConfirmPhoneChange_PhoneTakenMeanwhile(t *testing.T) {
+	uc, m := newTestUsecase()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	m.phone.On("GetPhoneChange", ctx, userID).Return(pendingChange(userID, "123456"), nil)
+	m.phone.On("IncrPhoneChangeAttempts", ctx, userID, UserModels.PhoneChangeCodeTTL).Return(1, nil)
+	m.auth.On("UpdatePhoneNumber", ctx, userID, "+79990001122").Return("", errs.ErrIsDuplicateKey)
+
+	err := uc.ConfirmPhoneChange(ctx, userID, uuid.New(), "123456", false)
+
+	assert.ErrorIs(t, err, errs.ErrIsDuplicateKey)
+	assert.Empty(t, m.sender.sent)
+	m.user.AssertNotCalled(t, "NotifyPhoneChanged", mock.Anything, mock.Anything)
+}
+
+func TestMaskPhone(t *testing.T) {
+	assert.Equal(t, "+7******1122", maskPhone("+79990001122"))
+	assert.Equal(t, "1122", maskPhone("1122"))
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	PrivacyDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	UserDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	InterfaceContactRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/contact"
+	InterfacePrivacyRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/privacy"
+	"github.com/google/uuid"
+)
+
+type PrivacyUsecase struct {
+	privacyrepo InterfacePrivacyRepository.PrivacyRepository
+	contactrepo InterfaceContactRepository.ContactRepository
+}
+
+func New(privacyrepo InterfacePrivacyRepository.PrivacyRepository, contactrepo InterfaceContactRepository.ContactRepository) *PrivacyUsecase {
+	return &PrivacyUsecase{
+		privacyrepo: privacyrepo,
+		contactrepo: contactrepo,
+	}
+}
+
+// GetPrivacySettings возвращает все настройки пользователя, включая не изменённые
+func (uc *PrivacyUsecase) GetPrivacySettings(ctx context.Context, userID uuid.UUID) ([]*PrivacyDTO.PrivacyRuleDTO, error) {
+	const op = "PrivacyUsecase.GetPrivacySettings"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	rules, err := uc.privacyrepo.GetRules(ctx, []uuid.UUID{userID})
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get privacy rules")
+		return nil, wrappedErr
+	}
+
+	result := make([]*PrivacyDTO.PrivacyRuleDTO, 0, len(PrivacyModels.Settings))
+	for _, setting := range PrivacyModels.Settings {
+		rule := rules[userID].Rule(setting)
+
+		result = append(result, &PrivacyDTO.PrivacyRuleDTO{
+			Setting:      string(rule.Setting),
+			Visibility:   string(rule.Visibility),
+			AllowUserIDs: nonNil(rule.AllowUserIDs),
+			DenyUserIDs:  nonNil(rule.DenyUserIDs),
+		})
+	}
+
+	return result, nil
+}
+
+func (uc *PrivacyUsecase) UpdatePrivacySetting(ctx context.Context, userID uuid.UUID, rule *PrivacyDTO.PrivacyRuleDTO) error {
+	const op = "PrivacyUsecase.UpdatePrivacySetting"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	setting := PrivacyModels.Setting(rule.Setting)
+	visibility := PrivacyModels.Visibility(rule.Visibility)
+	if !PrivacyModels.IsValidSetting(setting) || !PrivacyModels.IsValidVisibility(visibility) {
+		logger.Warnf("invalid privacy rule: %s=%s", rule.Setting, rule.Visibility)
+		return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	// Исключение для самого себя не имеет смысла: владелец всегда видит свой профиль
+	for _, ids := range [][]uuid.UUID{rule.AllowUserIDs, rule.DenyUserIDs} {
+		for _, id := range ids {
+			if id == userID {
+				logger.Warn("privacy exception for yourself")
+				return fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+			}
+		}
+	}
+
+	err := uc.privacyrepo.SetRule(ctx, userID, &PrivacyModels.Rule{
+		Setting:      setting,
+		Visibility:   visibility,
+		AllowUserIDs: rule.AllowUserIDs,
+		DenyUserIDs:  rule.DenyUserIDs,
+	})
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to set privacy rule")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+// GetGroupAddDenied возвращает тех из peerIDs, кто не разрешил userID добавлять себя в группы
+func (uc *PrivacyUsecase) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "PrivacyUsecase.GetGroupAddDenied"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	rules, err := uc.privacyrepo.GetRules(ctx, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get privacy rules")
+		return nil, wrappedErr
+	}
+
+	contactOwners, err := uc.contactrepo.GetContactOwners(ctx, userID, peerIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get contact owners")
+		return nil, wrappedErr
+	}
+
+	access := PrivacyModels.NewAccess(userID, rules, contactOwners)
+
+	var denied []uuid.UUID
+	for _, peerID := range peerIDs {
+		if !access.Allows(peerID, PrivacyModels.SettingGroupAdd) {
+			denied = append(denied, peerID)
+		}
+	}
+
+	return denied, nil
+}
+
+// ViewerAccess загружает настройки приватности владельцев для viewerID.
+// Возвращает nil, если скрывать нечего: приватность не подключена или все владельцы - сам viewerID
+func ViewerAccess(ctx context.Context, privacyrepo InterfacePrivacyRepository.PrivacyRepository, contactrepo InterfaceContactRepository.ContactRepository,
+	viewerID uuid.UUID, ownerIDs []uuid.UUID) (*PrivacyModels.Access, error) {
+	if privacyrepo == nil {
+		return nil, nil
+	}
+
+	others := make([]uuid.UUID, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		if id != viewerID {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return nil, nil
+	}
+
+	rules, err := privacyrepo.GetRules(ctx, others)
+	if err != nil {
+		return nil, err
+	}
+
+	var contactOwners []uuid.UUID
+	if contactrepo != nil {
+		contactOwners, err = contactrepo.GetContactOwners(ctx, viewerID, others)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return PrivacyModels.NewAccess(viewerID, rules, contactOwners), nil
+}
+
+// HideFields очищает поля профилей, которые владельцы скрыли; nil access ничего не скрывает
+func HideFields(access *PrivacyModels.Access, users ...*UserDTO.User) {
+	if access == nil {
+		return
+	}
+
+	for _, user := range users {
+		if !access.Allows(user.ID, PrivacyModels.SettingPhoneNumber) {
+			user.PhoneNumber = ""
+		}
+		if !access.Allows(user.ID, PrivacyModels.SettingLastSeen) {
+			user.LastSeenAt = nil
+		}
+		if !access.Allows(user.ID, PrivacyModels.SettingBio) {
+			user.Bio = nil
+		}
+		if !access.Allows(user.ID, PrivacyModels.SettingBirthday) {
+			user.Birthday = nil
+		}
+	}
+}
+
+func nonNil(ids []uuid.UUID) []uuid.UUID {
+	if ids == nil {
+		return []uuid.UUID{}
+	}
+	return ids
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	PrivacyDTO "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/privacy"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivacyUsecase_GetPrivacySettings_Defaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	uc := New(mockPrivacyRepo, mocks.NewMockContactRepository(ctrl))
+
+	ctx := context.Background()
+	userID, friendID := uuid.New(), uuid.New()
+
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{userID}).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		userID: {PrivacyModels.SettingAvatar: {
+			Setting:      PrivacyModels.SettingAvatar,
+			Visibility:   PrivacyModels.VisibilityNobody,
+			AllowUserIDs: []uuid.UUID{friendID},
+		}},
+	}, nil)
+
+	result, err := uc.GetPrivacySettings(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, len(PrivacyModels.Settings))
+	assert.Equal(t, "phone_number", result[0].Setting)
+	assert.Equal(t, "contacts", result[0].Visibility)
+	assert.NotNil(t, result[0].AllowUserIDs)
+	assert.Equal(t, "avatar", result[1].Setting)
+	assert.Equal(t, "nobody", result[1].Visibility)
+	assert.Equal(t, []uuid.UUID{friendID}, result[1].AllowUserIDs)
+}
+
+func TestPrivacyUsecase_UpdatePrivacySetting_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	uc := New(mockPrivacyRepo, mocks.NewMockContactRepository(ctrl))
+
+	ctx := context.Background()
+	userID, enemyID := uuid.New(), uuid.New()
+
+	mockPrivacyRepo.EXPECT().SetRule(ctx, userID, &PrivacyModels.Rule{
+		Setting:     PrivacyModels.SettingLastSeen,
+		Visibility:  PrivacyModels.VisibilityEverybody,
+		DenyUserIDs: []uuid.UUID{enemyID},
+	}).Return(nil)
+
+	err := uc.UpdatePrivacySetting(ctx, userID, &PrivacyDTO.PrivacyRuleDTO{
+		Setting:     "last_seen",
+		Visibility:  "everybody",
+		DenyUserIDs: []uuid.UUID{enemyID},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestPrivacyUsecase_UpdatePrivacySetting_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := New(mocks.NewMockPrivacyRepository(ctrl), mocks.NewMockContactRepository(ctrl))
+	userID := uuid.New()
+
+	tests := []struct {
+		name string
+		rule *PrivacyDTO.PrivacyRuleDTO
+	}{
+		{"unknown setting", &PrivacyDTO.PrivacyRuleDTO{Setting: "email", Visibility: "nobody"}},
+		{"unknown visibility", &PrivacyDTO.PrivacyRuleDTO{Setting: "bio", Visibility: "friends"}},
+		{"exception for yourself", &PrivacyDTO.PrivacyRuleDTO{Setting: "bio", Visibility: "nobody", AllowUserIDs: []uuid.UUID{userID}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.UpdatePrivacySetting(context.Background(), userID, tt.rule)
+			assert.ErrorIs(t, err, errs.ErrInvalidInput)
+		})
+	}
+}
+
+func TestPrivacyUsecase_GetGroupAddDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockPrivacyRepo, mockContactRepo)
+
+	ctx := context.Background()
+	adderID, openID, contactsOnlyID, friendID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	peerIDs := []uuid.UUID{openID, contactsOnlyID, friendID}
+
+	contactsOnly := &PrivacyModels.Rule{Setting: PrivacyModels.SettingGroupAdd, Visibility: PrivacyModels.VisibilityContacts}
+	mockPrivacyRepo.EXPECT().GetRules(ctx, peerIDs).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		contactsOnlyID: {PrivacyModels.SettingGroupAdd: contactsOnly},
+		friendID:       {PrivacyModels.SettingGroupAdd: contactsOnly},
+	}, nil)
+	// Добавляющий есть в контактах только у friendID
+	mockContactRepo.EXPECT().GetContactOwners(ctx, adderID, peerIDs).Return([]uuid.UUID{friendID}, nil)
+
+	denied, err := uc.GetGroupAddDenied(ctx, adderID, peerIDs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{contactsOnlyID}, denied)
+}
+
+func TestPrivacyUsecase_GetGroupAddDenied_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	uc := New(mockPrivacyRepo, mocks.NewMockContactRepository(ctrl))
+
+	ctx := context.Background()
+	peerIDs := []uuid.UUID{uuid.New()}
+
+	mockPrivacyRepo.EXPECT().GetRules(ctx, peerIDs).Return(nil, errors.New("db error"))
+
+	denied, err := uc.GetGroupAddDenied(ctx, uuid.New(), peerIDs)
+
+	assert.Error(t, err)
+	assert.Nil(t, denied)
+}
@@ -0,0 +1,314 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	interfaceChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/chats"
+	interfaceListener "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/listener"
+	interfacePush "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/push"
+	interfaceUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/message"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/outbox"
+	"github.com/google/uuid"
+)
+
+const (
+	PublisherName = "push"
+	DedupSize     = 10000
+	// FlushTimeout - сколько ждать провайдеров при финальной отправке во время остановки
+	FlushTimeout = 5 * time.Second
+	// MaxBodyLength - длина текста сообщения в уведомлении
+	MaxBodyLength  = 200
+	AttachmentBody = "Вложение"
+)
+
+type pendingKey struct {
+	userID uuid.UUID
+	chatID uuid.UUID
+}
+
+// pending уведомление, которое копится в окне батчинга
+type pending struct {
+	notification modelsPush.Notification
+	since        time.Time
+}
+
+// Dispatcher отправляет push-уведомления о новых сообщениях участникам чата без активного потока событий.
+// Сообщения одного чата за окно BatchWindow схлопываются в одно уведомление
+type Dispatcher struct {
+	chatsRepo   interfaceChats.ChatsRepository
+	userClient  interfaceUser.UserClient
+	targets     interfacePush.TargetsClient
+	providers   map[string]interfacePush.Provider
+	listenerMap interfaceListener.ListenerMapInterface
+	dedup       *outbox.Deduplicator
+	window      time.Duration
+
+	mu      sync.Mutex
+	pending map[pendingKey]*pending
+}
+
+func NewDispatcher(
+	chatsRepo interfaceChats.ChatsRepository,
+	userClient interfaceUser.UserClient,
+	targets interfacePush.TargetsClient,
+	providers map[string]interfacePush.Provider,
+	listenerMap interfaceListener.ListenerMapInterface,
+	conf *config.PushConfig,
+) *Dispatcher {
+	return &Dispatcher{
+		chatsRepo:   chatsRepo,
+		userClient:  userClient,
+		targets:     targets,
+		providers:   providers,
+		listenerMap: listenerMap,
+		dedup:       outbox.NewDeduplicator(DedupSize),
+		window:      conf.BatchWindow,
+		pending:     make(map[pendingKey]*pending),
+	}
+}
+
+// Name имя потребителя outbox в логах и метриках relay
+func (d *Dispatcher) Name() string {
+	return PublisherName
+}
+
+// Publish ставит уведомление о новом сообщении в очередь для каждого получателя не в сети
+func (d *Dispatcher) Publish(ctx context.Context, event modelsOutbox.Event) error {
+	const op = "Dispatcher.Publish"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("event_id", event.ID.String())
+
+	if event.Type != modelsOutbox.EventMessageCreated {
+		return nil
+	}
+
+	if d.dedup.Seen(event.DedupKey) {
+		logger.Debugf("event %s already handled, skipping", event.DedupKey)
+		return nil
+	}
+
+	payload, err := event.MessagePayload()
+	if err != nil {
+		return err
+	}
+
+	// Системные сообщения не уведомляют
+	if payload.Type != modelsMessage.MessageTypeUser || payload.UserID == nil {
+		d.dedup.Remember(event.DedupKey)
+		return nil
+	}
+
+	members, err := d.chatsRepo.GetChatMembersNotifySettings(ctx, payload.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to get chat members: %w", op, err)
+	}
+
+	now := time.Now()
+	mentions := message.ParseMentions(payload.Text)
+	recipients := make([]modelsChats.MemberNotifySettings, 0, len(members))
+	mentioned := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		if member.UserID == *payload.UserID {
+			continue
+		}
+
+		// Получатель с открытым потоком событий уже увидел сообщение
+		if len(d.listenerMap.GetUserConnections(member.UserID)) > 0 {
+			continue
+		}
+
+		isMentioned := slices.ContainsFunc(mentions, func(username string) bool {
+			return strings.EqualFold(username, member.Username)
+		})
+		if member.Settings.IsMutedAt(now) && !isMentioned {
+			continue
+		}
+
+		recipients = append(recipients, member)
+		mentioned[member.UserID] = isMentioned
+	}
+
+	if len(recipients) == 0 {
+		d.dedup.Remember(event.DedupKey)
+		return nil
+	}
+
+	title, body, err := d.describe(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	for _, member := range recipients {
+		key := pendingKey{userID: member.UserID, chatID: payload.ChatID}
+		if p, ok := d.pending[key]; ok {
+			p.notification.Body = body
+			p.notification.Count++
+			p.notification.Mentioned = p.notification.Mentioned || mentioned[member.UserID]
+			continue
+		}
+
+		d.pending[key] = &pending{
+			notification: modelsPush.Notification{
+				ChatID:      payload.ChatID,
+				Title:       title,
+				Body:        body,
+				CollapseKey: payload.ChatID.String(),
+				Count:       1,
+				Mentioned:   mentioned[member.UserID],
+			},
+			since: now,
+		}
+	}
+	d.mu.Unlock()
+
+	d.dedup.Remember(event.DedupKey)
+	return nil
+}
+
+// describe формирует заголовок и текст уведомления: в диалоге заголовок - имя отправителя,
+// в группе - название чата, а имя отправителя идёт в начале текста
+func (d *Dispatcher) describe(ctx context.Context, payload modelsOutbox.MessagePayload) (string, string, error) {
+	const op = "Dispatcher.describe"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	chat, err := d.chatsRepo.GetChat(ctx, payload.ChatID)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: failed to get chat: %w", op, err)
+	}
+
+	senderName := ""
+	sender, err := d.userClient.GetUserByID(ctx, *payload.UserID)
+	if err != nil {
+		logger.WithError(err).Warnf("could not get sender %s", payload.UserID)
+	} else {
+		senderName = sender.Name
+	}
+
+	body := payload.Text
+	if body == "" && payload.HasAttachment {
+		body = AttachmentBody
+	}
+	if runes := []rune(body); len(runes) > MaxBodyLength {
+		body = string(runes[:MaxBodyLength]) + "…"
+	}
+
+	if chat.Type == modelsChats.ChatTypeDialog {
+		return senderName, body, nil
+	}
+
+	if senderName != "" {
+		body = senderName + ": " + body
+	}
+
+	return chat.Name, body, nil
+}
+
+// Run отправляет накопленные уведомления по истечении окна. При остановке досылает всё, что осталось
+func (d *Dispatcher) Run(ctx context.Context) {
+	const op = "Dispatcher.Run"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+	logger.Info("Push dispatcher started")
+	defer logger.Info("Push dispatcher stopped")
+
+	ticker := time.NewTicker(d.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), FlushTimeout)
+			d.flush(flushCtx, time.Time{})
+			cancel()
+			return
+		case now := <-ticker.C:
+			d.flush(ctx, now.Add(-d.window))
+		}
+	}
+}
+
+// flush отправляет уведомления, накопленные не позже before. Нулевой before - отправить всё
+func (d *Dispatcher) flush(ctx context.Context, before time.Time) {
+	const op = "Dispatcher.flush"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	d.mu.Lock()
+	ready := make(map[uuid.UUID][]modelsPush.Notification)
+	for key, p := range d.pending {
+		if !before.IsZero() && p.since.After(before) {
+			continue
+		}
+		delete(d.pending, key)
+
+		// Пока копились сообщения, получатель мог подключиться
+		if len(d.listenerMap.GetUserConnections(key.userID)) > 0 {
+			continue
+		}
+		ready[key.userID] = append(ready[key.userID], p.notification)
+	}
+	d.mu.Unlock()
+
+	if len(ready) == 0 {
+		return
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(ready))
+	for userID := range ready {
+		userIDs = append(userIDs, userID)
+	}
+
+	targets, err := d.targets.GetPushTargets(ctx, userIDs)
+	if err != nil {
+		logger.WithError(err).Error("failed to get push targets")
+		return
+	}
+
+	var expired []uuid.UUID
+	for _, target := range targets {
+		provider, ok := d.providers[target.Platform]
+		if !ok {
+			logger.Warnf("no push provider for platform %s", target.Platform)
+			continue
+		}
+
+		for _, notification := range ready[target.UserID] {
+			err := provider.Send(ctx, target, notification)
+			if errors.Is(err, errs.ErrPushTokenExpired) {
+				sentTotal.WithLabelValues(target.Platform, "expired").Inc()
+				expired = append(expired, target.SessionID)
+				break
+			}
+
+			if err != nil {
+				sentTotal.WithLabelValues(target.Platform, "fail").Inc()
+				logger.WithError(err).Warnf("failed to send push to session %s", target.SessionID)
+				continue
+			}
+
+			sentTotal.WithLabelValues(target.Platform, "success").Inc()
+		}
+	}
+
+	if len(expired) > 0 {
+		if err := d.targets.DropPushTokens(ctx, expired); err != nil {
+			logger.WithError(err).Warn("failed to drop expired push tokens")
+		}
+	}
+}
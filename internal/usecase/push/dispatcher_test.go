@@ -0,0 +1,207 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	modelsChats "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/chats"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/message"
+	modelsOutbox "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/outbox"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
+	modelsUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	interfacePush "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/push"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type dispatcherMocks struct {
+	chats     *mocks.MockChatsRepository
+	users     *mocks.MockUserClient
+	targets   *mocks.MockTargetsClient
+	provider  *mocks.MockProvider
+	listeners *mocks.MockListenerMapInterface
+}
+
+func newTestDispatcher(t *testing.T) (*Dispatcher, dispatcherMocks) {
+	ctrl := gomock.NewController(t)
+	m := dispatcherMocks{
+		chats:     mocks.NewMockChatsRepository(ctrl),
+		users:     mocks.NewMockUserClient(ctrl),
+		targets:   mocks.NewMockTargetsClient(ctrl),
+		provider:  mocks.NewMockProvider(ctrl),
+		listeners: mocks.NewMockListenerMapInterface(ctrl),
+	}
+
+	providers := map[string]interfacePush.Provider{modelsPush.PlatformFCM: m.provider}
+	d := NewDispatcher(m.chats, m.users, m.targets, providers, m.listeners, &config.PushConfig{BatchWindow: time.Second})
+
+	return d, m
+}
+
+func messageEvent(t *testing.T, chatID, senderID uuid.UUID, text string) modelsOutbox.Event {
+	payload, err := json.Marshal(modelsOutbox.MessagePayload{
+		MessageID: uuid.New(),
+		ChatID:    chatID,
+		UserID:    &senderID,
+		Text:      text,
+		Type:      modelsMessage.MessageTypeUser,
+		CreatedAt: time.Now(),
+	})
+	assert.NoError(t, err)
+
+	return modelsOutbox.Event{
+		ID:       uuid.New(),
+		DedupKey: uuid.NewString(),
+		Type:     modelsOutbox.EventMessageCreated,
+		Payload:  payload,
+	}
+}
+
+func TestDispatcher_Publish_SkipsSenderOnlineAndMuted(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	chatID, senderID := uuid.New(), uuid.New()
+	offlineID, onlineID, mutedID := uuid.New(), uuid.New(), uuid.New()
+
+	m.chats.EXPECT().GetChatMembersNotifySettings(ctx, chatID).Return([]modelsChats.MemberNotifySettings{
+		{UserID: senderID, Username: "sender"},
+		{UserID: offlineID, Username: "offline"},
+		{UserID: onlineID, Username: "online"},
+		{UserID: mutedID, Username: "muted", Settings: modelsChats.ChatSettings{IsMuted: true}},
+	}, nil)
+	m.listeners.EXPECT().GetUserConnections(offlineID).Return(nil)
+	m.listeners.EXPECT().GetUserConnections(onlineID).Return([]uuid.UUID{uuid.New()})
+	m.listeners.EXPECT().GetUserConnections(mutedID).Return(nil)
+	m.chats.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeGroup, Name: "Team"}, nil)
+	m.users.EXPECT().GetUserByID(ctx, senderID).Return(&modelsUser.User{ID: senderID, Name: "Alice"}, nil)
+
+	err := d.Publish(ctx, messageEvent(t, chatID, senderID, "hello"))
+
+	assert.NoError(t, err)
+	assert.Len(t, d.pending, 1)
+	p := d.pending[pendingKey{userID: offlineID, chatID: chatID}]
+	assert.NotNil(t, p)
+	assert.Equal(t, "Team", p.notification.Title)
+	assert.Equal(t, "Alice: hello", p.notification.Body)
+	assert.Equal(t, chatID.String(), p.notification.CollapseKey)
+}
+
+func TestDispatcher_Publish_MentionOverridesMute(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	chatID, senderID, mutedID := uuid.New(), uuid.New(), uuid.New()
+
+	m.chats.EXPECT().GetChatMembersNotifySettings(ctx, chatID).Return([]modelsChats.MemberNotifySettings{
+		{UserID: mutedID, Username: "Bob", Settings: modelsChats.ChatSettings{IsMuted: true}},
+	}, nil)
+	m.listeners.EXPECT().GetUserConnections(mutedID).Return(nil)
+	m.chats.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeDialog}, nil)
+	m.users.EXPECT().GetUserByID(ctx, senderID).Return(&modelsUser.User{ID: senderID, Name: "Alice"}, nil)
+
+	err := d.Publish(ctx, messageEvent(t, chatID, senderID, "@bob look"))
+
+	assert.NoError(t, err)
+	p := d.pending[pendingKey{userID: mutedID, chatID: chatID}]
+	assert.NotNil(t, p)
+	assert.True(t, p.notification.Mentioned)
+	assert.Equal(t, "Alice", p.notification.Title)
+	assert.Equal(t, "@bob look", p.notification.Body)
+}
+
+func TestDispatcher_Publish_CollapsesBurstAndSkipsDuplicates(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	chatID, senderID, recipientID := uuid.New(), uuid.New(), uuid.New()
+
+	m.chats.EXPECT().GetChatMembersNotifySettings(ctx, chatID).Return([]modelsChats.MemberNotifySettings{
+		{UserID: recipientID, Username: "bob"},
+	}, nil).Times(2)
+	m.listeners.EXPECT().GetUserConnections(recipientID).Return(nil).Times(2)
+	m.chats.EXPECT().GetChat(ctx, chatID).Return(&modelsChats.Chat{ID: chatID, Type: modelsChats.ChatTypeDialog}, nil).Times(2)
+	m.users.EXPECT().GetUserByID(ctx, senderID).Return(&modelsUser.User{ID: senderID, Name: "Alice"}, nil).Times(2)
+
+	first := messageEvent(t, chatID, senderID, "first")
+	assert.NoError(t, d.Publish(ctx, first))
+	assert.NoError(t, d.Publish(ctx, messageEvent(t, chatID, senderID, "second")))
+	// Повторная доставка relay не увеличивает счётчик
+	assert.NoError(t, d.Publish(ctx, first))
+
+	p := d.pending[pendingKey{userID: recipientID, chatID: chatID}]
+	assert.Equal(t, 2, p.notification.Count)
+	assert.Equal(t, "second", p.notification.Body)
+}
+
+func TestDispatcher_Publish_MembersError(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	chatID, senderID := uuid.New(), uuid.New()
+	event := messageEvent(t, chatID, senderID, "hello")
+
+	m.chats.EXPECT().GetChatMembersNotifySettings(ctx, chatID).Return(nil, errors.New("db error"))
+
+	assert.Error(t, d.Publish(ctx, event))
+	// Событие не запомнено и будет обработано при повторе
+	assert.False(t, d.dedup.Seen(event.DedupKey))
+}
+
+func TestDispatcher_Publish_IgnoresOtherEvents(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+
+	err := d.Publish(context.Background(), modelsOutbox.Event{ID: uuid.New(), Type: modelsOutbox.EventMessageDeleted})
+
+	assert.NoError(t, err)
+	assert.Empty(t, d.pending)
+}
+
+func TestDispatcher_Flush_SendsAndDropsExpiredTokens(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	userID, chatID := uuid.New(), uuid.New()
+	validSession, expiredSession := uuid.New(), uuid.New()
+	notification := modelsPush.Notification{ChatID: chatID, Title: "Alice", Body: "hi", CollapseKey: chatID.String(), Count: 1}
+	d.pending[pendingKey{userID: userID, chatID: chatID}] = &pending{notification: notification, since: time.Now()}
+
+	valid := modelsPush.Target{SessionID: validSession, UserID: userID, Platform: modelsPush.PlatformFCM, Token: "valid"}
+	expired := modelsPush.Target{SessionID: expiredSession, UserID: userID, Platform: modelsPush.PlatformFCM, Token: "expired"}
+
+	m.listeners.EXPECT().GetUserConnections(userID).Return(nil)
+	m.targets.EXPECT().GetPushTargets(ctx, []uuid.UUID{userID}).Return([]modelsPush.Target{valid, expired}, nil)
+	m.provider.EXPECT().Send(ctx, valid, notification).Return(nil)
+	m.provider.EXPECT().Send(ctx, expired, notification).Return(errs.ErrPushTokenExpired)
+	m.targets.EXPECT().DropPushTokens(ctx, []uuid.UUID{expiredSession}).Return(nil)
+
+	d.flush(ctx, time.Time{})
+
+	assert.Empty(t, d.pending)
+}
+
+func TestDispatcher_Flush_KeepsFreshAndSkipsReconnected(t *testing.T) {
+	d, m := newTestDispatcher(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	freshKey := pendingKey{userID: uuid.New(), chatID: uuid.New()}
+	reconnectedKey := pendingKey{userID: uuid.New(), chatID: uuid.New()}
+	d.pending[freshKey] = &pending{since: now}
+	d.pending[reconnectedKey] = &pending{since: now.Add(-time.Minute)}
+
+	// Получатель подключился, пока копились сообщения: уведомление не нужно
+	m.listeners.EXPECT().GetUserConnections(reconnectedKey.userID).Return([]uuid.UUID{uuid.New()})
+
+	d.flush(ctx, now.Add(-time.Second))
+
+	assert.Len(t, d.pending, 1)
+	assert.Contains(t, d.pending, freshKey)
+}
@@ -0,0 +1,14 @@
+package push
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var sentTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "chats_push_sent_total",
+		Help: "Total number of push notifications sent by platform and status",
+	},
+	[]string{"platform", "status"},
+)
@@ -7,18 +7,22 @@ import (
 
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/session"
 	"github.com/google/uuid"
 )
 
 type SessionRepository interface {
-	AddSession(ctx context.Context, userID uuid.UUID, device string) (uuid.UUID, error)
+	AddSession(ctx context.Context, userID uuid.UUID, info models.DeviceInfo) (uuid.UUID, error)
 	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
 	DeleteAllSessionWithoutCurrent(ctx context.Context, userID uuid.UUID, currentSessionID uuid.UUID) error
 	GetSession(ctx context.Context, sessionID uuid.UUID) (*models.Session, error)
 	GetSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Session, error)
 	UpdateSession(ctx context.Context, sessionID uuid.UUID) error
+	SetPushToken(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, platform, token string) error
+	DeletePushTokens(ctx context.Context, sessionIDs []uuid.UUID) error
+	GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error)
 }
 
 type SessionUsecase struct {
@@ -54,6 +58,11 @@ func (uc *SessionUsecase) GetSession(ctx context.Context, sessionID uuid.UUID) (
 		ID:         sess.ID,
 		UserID:     sess.UserID,
 		Device:     sess.Device,
+		IP:         sess.IP,
+		OS:         sess.OS,
+		Browser:    sess.Browser,
+		AppVersion: sess.AppVersion,
+		Location:   sess.Location,
 		Created_at: sess.Created_at,
 		Last_seen:  sess.Last_seen,
 	}
@@ -86,6 +95,11 @@ func (uc *SessionUsecase) GetSessionsByUserID(ctx context.Context, userID uuid.U
 			ID:         sess.ID,
 			UserID:     sess.UserID,
 			Device:     sess.Device,
+			IP:         sess.IP,
+			OS:         sess.OS,
+			Browser:    sess.Browser,
+			AppVersion: sess.AppVersion,
+			Location:   sess.Location,
 			Created_at: sess.Created_at,
 			Last_seen:  sess.Last_seen,
 		}
@@ -193,3 +207,97 @@ func (uc *SessionUsecase) DeleteAllSessionWithoutCurrent(ctx context.Context, us
 
 	return nil
 }
+
+// RegisterPushToken привязывает токен устройства к сессии пользователя
+func (uc *SessionUsecase) RegisterPushToken(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, platform, token string) error {
+	const op = "SessionUsecase.RegisterPushToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if !modelsPush.IsValidPlatform(platform) || token == "" {
+		wrappedErr := fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+		logger.WithError(wrappedErr).Warn("invalid push platform or token")
+		return errs.ErrInvalidInput
+	}
+
+	if err := uc.checkSessionOwner(ctx, userID, sessionID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Warn("session check failed")
+		return err
+	}
+
+	if err := uc.sessionrepo.SetPushToken(ctx, sessionID, userID, platform, token); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to set push token")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func (uc *SessionUsecase) UnregisterPushToken(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	const op = "SessionUsecase.UnregisterPushToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if err := uc.checkSessionOwner(ctx, userID, sessionID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Warn("session check failed")
+		return err
+	}
+
+	if err := uc.sessionrepo.DeletePushTokens(ctx, []uuid.UUID{sessionID}); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to delete push token")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func (uc *SessionUsecase) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error) {
+	const op = "SessionUsecase.GetPushTargets"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	targets, err := uc.sessionrepo.GetPushTargets(ctx, userIDs)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get push targets")
+		return nil, wrappedErr
+	}
+
+	return targets, nil
+}
+
+// DropPushTokens удаляет токены, которые провайдер признал недействительными
+func (uc *SessionUsecase) DropPushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	const op = "SessionUsecase.DropPushTokens"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if err := uc.sessionrepo.DeletePushTokens(ctx, sessionIDs); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to drop push tokens")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+func (uc *SessionUsecase) checkSessionOwner(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	if userID == uuid.Nil || sessionID == uuid.Nil {
+		return errs.ErrInvalidInput
+	}
+
+	session, err := uc.sessionrepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return errs.ErrSessionNotFound
+	}
+
+	if session.UserID != userID {
+		return errs.ErrSessionNotFound
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	modelsPush "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/push"
 	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/session"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,8 +18,8 @@ type MockSessionRepository struct {
 	mock.Mock
 }
 
-func (m *MockSessionRepository) AddSession(ctx context.Context, userID uuid.UUID, device string) (uuid.UUID, error) {
-	args := m.Called(ctx, userID, device)
+func (m *MockSessionRepository) AddSession(ctx context.Context, userID uuid.UUID, info models.DeviceInfo) (uuid.UUID, error) {
+	args := m.Called(ctx, userID, info)
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
 
@@ -52,6 +54,24 @@ func (m *MockSessionRepository) DeleteAllSessionWithoutCurrent(ctx context.Conte
 	return args.Error(0)
 }
 
+func (m *MockSessionRepository) SetPushToken(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, platform, token string) error {
+	args := m.Called(ctx, sessionID, userID, platform, token)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) DeletePushTokens(ctx context.Context, sessionIDs []uuid.UUID) error {
+	args := m.Called(ctx, sessionIDs)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) GetPushTargets(ctx context.Context, userIDs []uuid.UUID) ([]modelsPush.Target, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]modelsPush.Target), args.Error(1)
+}
+
 func TestSessionUsecase_GetSession_Success(t *testing.T) {
 	mockRepo := new(MockSessionRepository)
 	uc := New(mockRepo)
@@ -366,3 +386,95 @@ func TestSessionUsecase_DeleteAllSessionWithoutCurrent_RepositoryError(t *testin
 	assert.Contains(t, err.Error(), "redis error")
 	mockRepo.AssertExpectations(t)
 }
+
+func TestSessionUsecase_RegisterPushToken_Success(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	mockRepo.On("GetSession", ctx, sessionID).Return(&models.Session{ID: sessionID, UserID: userID}, nil)
+	mockRepo.On("SetPushToken", ctx, sessionID, userID, "fcm", "device-token").Return(nil)
+
+	err := uc.RegisterPushToken(ctx, userID, sessionID, "fcm", "device-token")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSessionUsecase_RegisterPushToken_InvalidPlatform(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	err := uc.RegisterPushToken(context.Background(), uuid.New(), uuid.New(), "sms", "device-token")
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	mockRepo.AssertNotCalled(t, "SetPushToken")
+}
+
+func TestSessionUsecase_RegisterPushToken_ForeignSession(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	sessionID := uuid.New()
+
+	mockRepo.On("GetSession", ctx, sessionID).Return(&models.Session{ID: sessionID, UserID: uuid.New()}, nil)
+
+	err := uc.RegisterPushToken(ctx, uuid.New(), sessionID, "apns", "device-token")
+
+	assert.ErrorIs(t, err, errs.ErrSessionNotFound)
+	mockRepo.AssertNotCalled(t, "SetPushToken")
+}
+
+func TestSessionUsecase_UnregisterPushToken_Success(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	mockRepo.On("GetSession", ctx, sessionID).Return(&models.Session{ID: sessionID, UserID: userID}, nil)
+	mockRepo.On("DeletePushTokens", ctx, []uuid.UUID{sessionID}).Return(nil)
+
+	err := uc.UnregisterPushToken(ctx, userID, sessionID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSessionUsecase_GetPushTargets(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userIDs := []uuid.UUID{uuid.New()}
+	expected := []modelsPush.Target{{SessionID: uuid.New(), UserID: userIDs[0], Platform: "webpush", Token: "{}"}}
+
+	mockRepo.On("GetPushTargets", ctx, userIDs).Return(expected, nil)
+
+	targets, err := uc.GetPushTargets(ctx, userIDs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, targets)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSessionUsecase_DropPushTokens_Error(t *testing.T) {
+	mockRepo := new(MockSessionRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	sessionIDs := []uuid.UUID{uuid.New()}
+
+	mockRepo.On("DeletePushTokens", ctx, sessionIDs).Return(errors.New("redis error"))
+
+	err := uc.DropPushTokens(ctx, sessionIDs)
+
+	assert.Error(t, err)
+	mockRepo.AssertExpectations(t)
+}
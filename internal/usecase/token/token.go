@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	dto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/token"
+	"github.com/google/uuid"
+)
+
+const (
+	// TokenPrefix позволяет отличать персональные токены от других секретов
+	TokenPrefix = "gt_"
+
+	tokenBytes   = 32
+	maxNameRunes = 64
+)
+
+type TokenRepository interface {
+	CreateToken(ctx context.Context, token *models.Token, tokenHash string) error
+	GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Token, error)
+	GetActiveTokenByHash(ctx context.Context, tokenHash string) (*models.Token, error)
+	RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error
+	TouchToken(ctx context.Context, tokenID uuid.UUID) error
+}
+
+type TokenUsecase struct {
+	tokenrepo TokenRepository
+}
+
+func New(tokenrepo TokenRepository) *TokenUsecase {
+	return &TokenUsecase{
+		tokenrepo: tokenrepo,
+	}
+}
+
+// CreateToken выпускает новый персональный токен. Открытое значение возвращается только здесь,
+// в базе хранится лишь его хэш
+func (uc *TokenUsecase) CreateToken(ctx context.Context, userID uuid.UUID, name string, scopes []string, expiresIn time.Duration) (*dto.CreatedToken, error) {
+	const op = "TokenUsecase.CreateToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameRunes {
+		logger.Warn("invalid token name")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		logger.WithError(err).Warn("invalid token scopes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if expiresIn < 0 {
+		logger.Warn("negative token lifetime")
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidInput)
+	}
+
+	value, err := generateTokenValue()
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to generate token")
+		return nil, wrappedErr
+	}
+
+	now := time.Now()
+	token := &models.Token{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if expiresIn > 0 {
+		expiresAt := now.Add(expiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.tokenrepo.CreateToken(ctx, token, HashToken(value)); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to save token")
+		return nil, wrappedErr
+	}
+
+	return &dto.CreatedToken{
+		Token: *tokenToDTO(token),
+		Value: value,
+	}, nil
+}
+
+func (uc *TokenUsecase) GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*dto.Token, error) {
+	const op = "TokenUsecase.GetTokensByUserID"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_id", userID.String())
+
+	tokens, err := uc.tokenrepo.GetTokensByUserID(ctx, userID)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to get tokens")
+		return nil, wrappedErr
+	}
+
+	result := make([]*dto.Token, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, tokenToDTO(token))
+	}
+
+	return result, nil
+}
+
+func (uc *TokenUsecase) RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	const op = "TokenUsecase.RevokeToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).
+		WithField("user_id", userID.String()).
+		WithField("token_id", tokenID.String())
+
+	if err := uc.tokenrepo.RevokeToken(ctx, userID, tokenID); err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Error("failed to revoke token")
+		return wrappedErr
+	}
+
+	return nil
+}
+
+// ValidateToken проверяет открытое значение токена и возвращает его владельца и scope
+func (uc *TokenUsecase) ValidateToken(ctx context.Context, value string) (*dto.Token, error) {
+	const op = "TokenUsecase.ValidateToken"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if !strings.HasPrefix(value, TokenPrefix) {
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidToken)
+	}
+
+	token, err := uc.tokenrepo.GetActiveTokenByHash(ctx, HashToken(value))
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", op, err)
+		logger.WithError(wrappedErr).Debug("token validation failed")
+		return nil, wrappedErr
+	}
+
+	if err := uc.tokenrepo.TouchToken(ctx, token.ID); err != nil {
+		logger.WithError(err).Warn("failed to update token last usage")
+	}
+
+	return tokenToDTO(token), nil
+}
+
+// HashToken возвращает sha256 от открытого значения токена в hex
+func HashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateTokenValue() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !models.IsKnownScope(scope) {
+			return nil, errs.ErrInvalidInput
+		}
+		if !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+
+	return result, nil
+}
+
+func tokenToDTO(token *models.Token) *dto.Token {
+	return &dto.Token{
+		ID:         token.ID,
+		UserID:     token.UserID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		CreatedAt:  token.CreatedAt,
+
+		AccountType: token.AccountType,
+	}
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockTokenRepository) CreateToken(ctx context.Context, token *models.Token, tokenHash string) error {
+	args := m.Called(ctx, token, tokenHash)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) GetTokensByUserID(ctx context.Context, userID uuid.UUID) ([]*models.Token, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*models.Token), args.Error(1)
+}
+
+func (m *MockTokenRepository) GetActiveTokenByHash(ctx context.Context, tokenHash string) (*models.Token, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Token), args.Error(1)
+}
+
+func (m *MockTokenRepository) RevokeToken(ctx context.Context, userID, tokenID uuid.UUID) error {
+	args := m.Called(ctx, userID, tokenID)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) TouchToken(ctx context.Context, tokenID uuid.UUID) error {
+	args := m.Called(ctx, tokenID)
+	return args.Error(0)
+}
+
+func TestTokenUsecase_CreateToken_Success(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	var savedHash string
+	mockRepo.On("CreateToken", ctx, mock.MatchedBy(func(token *models.Token) bool {
+		return token.UserID == userID && token.Name == "ci" && token.ExpiresAt != nil
+	}), mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { savedHash = args.String(2) }).
+		Return(nil)
+
+	created, err := uc.CreateToken(ctx, userID, " ci ", []string{"READ", "read", "write"}, 24*time.Hour)
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(created.Value, TokenPrefix))
+	assert.Equal(t, HashToken(created.Value), savedHash)
+	assert.Equal(t, []string{models.ScopeRead, models.ScopeWrite}, created.Scopes)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTokenUsecase_CreateToken_InvalidScope(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	created, err := uc.CreateToken(context.Background(), uuid.New(), "ci", []string{"admin"}, 0)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Nil(t, created)
+	mockRepo.AssertNotCalled(t, "CreateToken", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTokenUsecase_CreateToken_EmptyName(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	created, err := uc.CreateToken(context.Background(), uuid.New(), "  ", []string{models.ScopeRead}, 0)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+	assert.Nil(t, created)
+}
+
+func TestTokenUsecase_GetTokensByUserID_Success(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	tokens := []*models.Token{{ID: uuid.New(), UserID: userID, Name: "ci", Scopes: []string{models.ScopeRead}}}
+
+	mockRepo.On("GetTokensByUserID", ctx, userID).Return(tokens, nil)
+
+	result, err := uc.GetTokensByUserID(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, tokens[0].ID, result[0].ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTokenUsecase_RevokeToken_NotFound(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mockRepo.On("RevokeToken", ctx, userID, tokenID).Return(errs.ErrNotFound)
+
+	err := uc.RevokeToken(ctx, userID, tokenID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTokenUsecase_ValidateToken_Success(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	ctx := context.Background()
+	value := TokenPrefix + "secret"
+	token := &models.Token{ID: uuid.New(), UserID: uuid.New(), Scopes: []string{models.ScopeRead}}
+
+	mockRepo.On("GetActiveTokenByHash", ctx, HashToken(value)).Return(token, nil)
+	mockRepo.On("TouchToken", ctx, token.ID).Return(errors.New("db error"))
+
+	result, err := uc.ValidateToken(ctx, value)
+
+	assert.NoError(t, err)
+	assert.Equal(t, token.UserID, result.UserID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTokenUsecase_ValidateToken_WrongPrefix(t *testing.T) {
+	mockRepo := new(MockTokenRepository)
+	uc := New(mockRepo)
+
+	result, err := uc.ValidateToken(context.Background(), "not-a-token")
+
+	assert.ErrorIs(t, err, errs.ErrInvalidToken)
+	assert.Nil(t, result)
+	mockRepo.AssertNotCalled(t, "GetActiveTokenByHash", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	UserDto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	"github.com/google/uuid"
+)
+
+// setProfile дополняет профиль статусом, днём рождения, ссылками и временем последнего визита. Истёкший к now статус не показывается
+func setProfile(user *UserDto.User, profile *UserModels.Profile, now time.Time) {
+	if profile.Status.ActiveAt(now) {
+		user.Status = &UserDto.StatusDTO{
+			Emoji:     profile.Status.Emoji,
+			Text:      profile.Status.Text,
+			ExpiresAt: profile.Status.ExpiresAt,
+		}
+	}
+
+	if profile.Birthday != nil {
+		birthday := profile.Birthday.Format(UserModels.BirthdayLayout)
+		user.Birthday = &birthday
+	}
+
+	user.LastSeenAt = profile.LastSeenAt
+
+	if len(profile.Links) > 0 {
+		user.Links = make([]UserDto.LinkDTO, 0, len(profile.Links))
+		for _, link := range profile.Links {
+			user.Links = append(user.Links, UserDto.LinkDTO{Title: link.Title, URL: link.URL})
+		}
+	}
+}
+
+// publishStatusChanged рассылает новый статус собеседникам по диалогам.
+// Ошибка не прерывает операцию: статус сохранён, собеседники увидят его при следующем открытии профиля
+func (uc *UserUsecase) publishStatusChanged(ctx context.Context, userID uuid.UUID, status UserModels.Status) {
+	if uc.profileEvents == nil {
+		return
+	}
+
+	if err := uc.profileEvents.PublishStatusChanged(ctx, userID, status); err != nil {
+		domains.GetLogger(ctx).WithError(err).Warnf("could not publish status change of user %s", userID)
+	}
+}
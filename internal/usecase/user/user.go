@@ -2,25 +2,58 @@ package user
 
 import (
 	"context"
-
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	AvatarModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
-	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
+	HandleModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/handle"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	userES "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch/user"
 	UserDto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
+	InterfaceBlockRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/block"
+	InterfaceContactRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/contact"
+	InterfacePrivacyRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/privacy"
 	InterfaceFileStorage "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/storage"
 	InterfaceUserRepository "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	privacyUsecase "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/privacy"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/utils"
 	"github.com/google/uuid"
 )
 
 type UserUsecase struct {
-	userrepo    InterfaceUserRepository.UserRepository
-	fileStorage InterfaceFileStorage.FileStorage
+	userrepo      InterfaceUserRepository.UserRepository
+	fileStorage   InterfaceFileStorage.FileStorage
+	profileEvents InterfaceUserRepository.ProfileEventPublisher
+	privacyrepo   InterfacePrivacyRepository.PrivacyRepository
+	contactrepo   InterfaceContactRepository.ContactRepository
+	blockrepo     InterfaceBlockRepository.BlockRepository
+	searchrepo    userES.UserSearchRepositoryInterface
+	groupPeers    InterfaceUserRepository.GroupPeersProvider
 }
 
-func New(userrepo InterfaceUserRepository.UserRepository, fileStorage InterfaceFileStorage.FileStorage) *UserUsecase {
+// New создаёт usecase пользователей; profileEvents может быть nil - тогда об изменениях профиля никто не оповещается.
+// Если privacyrepo равен nil, настройки приватности не применяются.
+// Без searchrepo глобальный поиск людей отключён, без groupPeers участники общих групп не поднимаются в выдаче
+func New(userrepo InterfaceUserRepository.UserRepository, fileStorage InterfaceFileStorage.FileStorage, profileEvents InterfaceUserRepository.ProfileEventPublisher,
+	privacyrepo InterfacePrivacyRepository.PrivacyRepository, contactrepo InterfaceContactRepository.ContactRepository, blockrepo InterfaceBlockRepository.BlockRepository,
+	searchrepo userES.UserSearchRepositoryInterface, groupPeers InterfaceUserRepository.GroupPeersProvider) *UserUsecase {
 	return &UserUsecase{
-		userrepo:    userrepo,
-		fileStorage: fileStorage,
+		userrepo:      userrepo,
+		fileStorage:   fileStorage,
+		profileEvents: profileEvents,
+		privacyrepo:   privacyrepo,
+		contactrepo:   contactrepo,
+		blockrepo:     blockrepo,
+		searchrepo:    searchrepo,
+		groupPeers:    groupPeers,
 	}
 }
 
@@ -46,6 +79,18 @@ func (uc *UserUsecase) GetUserById(ctx context.Context, id uuid.UUID) (*UserDto.
 		UpdatedAt:   user.UpdatedAt,
 	}
 
+	profile, err := uc.userrepo.GetUserProfile(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error("could not get user profile")
+		return nil, err
+	}
+	setProfile(userdto, profile, time.Now())
+
+	if err := uc.applyPrivacy(ctx, userdto); err != nil {
+		logger.WithError(err).Error("could not apply privacy settings")
+		return nil, err
+	}
+
 	return userdto, nil
 }
 
@@ -56,8 +101,11 @@ func (uc *UserUsecase) GetUserByPhone(ctx context.Context, phone string) (*UserD
 
 	user, err := uc.userrepo.GetUserByPhone(ctx, phone)
 	if err != nil {
-		logger.WithError(err).Error(errs.ErrUserNotFound)
-		return nil, errs.ErrUserNotFound
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, errs.ErrUserNotFound
+		}
+		logger.WithError(err).Error("could not get user by phone")
+		return nil, err
 	}
 
 	userdto := &UserDto.User{
@@ -72,6 +120,11 @@ func (uc *UserUsecase) GetUserByPhone(ctx context.Context, phone string) (*UserD
 		UpdatedAt:    user.UpdatedAt,
 	}
 
+	if err := uc.applyPrivacy(ctx, userdto); err != nil {
+		logger.WithError(err).Error("could not apply privacy settings")
+		return nil, err
+	}
+
 	return userdto, nil
 }
 
@@ -97,51 +150,439 @@ func (uc *UserUsecase) GetUserByUsername(ctx context.Context, username string) (
 		UpdatedAt:   user.UpdatedAt,
 	}
 
+	if err := uc.applyPrivacy(ctx, userdto); err != nil {
+		logger.WithError(err).Error("could not apply privacy settings")
+		return nil, err
+	}
+
 	return userdto, nil
 }
 
+// ResolveUsername находит пользователя по публичному handle; redirected - handle прежний и ведёт на новый
+func (uc *UserUsecase) ResolveUsername(ctx context.Context, username string) (*UserDto.User, bool, error) {
+	const op = "UserUsecase.ResolveUsername"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	user, redirected, err := uc.userrepo.ResolveUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			return nil, false, errs.ErrUserNotFound
+		}
+		logger.WithError(err).Error("could not resolve username")
+		return nil, false, err
+	}
+
+	userdto := &UserDto.User{
+		ID:          user.ID,
+		PhoneNumber: user.PhoneNumber,
+		Name:        user.Name,
+		Username:    user.Username,
+		Bio:         user.Bio,
+		AccountType: user.AccountType,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}
+
+	if err := uc.applyPrivacy(ctx, userdto); err != nil {
+		logger.WithError(err).Error("could not apply privacy settings")
+		return nil, false, err
+	}
+
+	return userdto, redirected, nil
+}
+
+func (uc *UserUsecase) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*UserDto.User, error) {
+	const op = "UserUsecase.GetUsersByIDs"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("user_ids_count", len(ids))
+
+	users, err := uc.userrepo.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		logger.WithError(err).Error("could not get users")
+		return nil, err
+	}
+
+	// порядок ответа совпадает с порядком запроса, повторы id отбрасываются
+	result := make([]*UserDto.User, 0, len(users))
+	for _, id := range ids {
+		user, ok := users[id]
+		if !ok {
+			continue
+		}
+		delete(users, id)
+		result = append(result, &UserDto.User{
+			ID:          user.ID,
+			PhoneNumber: user.PhoneNumber,
+			Name:        user.Name,
+			Username:    user.Username,
+			Bio:         user.Bio,
+			AccountType: user.AccountType,
+			CreatedAt:   user.CreatedAt,
+			UpdatedAt:   user.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
 func (uc *UserUsecase) UploadUserAvatar(ctx context.Context, userID uuid.UUID, data []byte, filename, contentType string) (string, error) {
 	const op = "UserUsecase.UploadUserAvatar"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op)
 
-	avatarID := uuid.New()
-
-	file := minio.FileData{
-		Name:        filename,
-		Data:        data,
-		ContentType: contentType,
+	// Исходник обрезается до квадрата и сохраняется в нескольких размерах, поэтому filename и contentType
+	// клиента не используются: формат определяется по содержимому
+	variants, err := utils.ProcessAvatar(data)
+	if err != nil {
+		logger.WithError(err).Warn("invalid avatar image")
+		return "", err
 	}
 
-	avatar_url, err := uc.fileStorage.CreateOne(ctx, file, avatarID)
+	avatarID := uuid.New()
+
+	avatar_url, err := utils.StoreAvatarVariants(ctx, uc.fileStorage, avatarID, variants)
 	if err != nil {
 		logger.WithError(err).Error("could not upload user avatar to file storage")
 		return "", err
 	}
 
-	err = uc.userrepo.UpdateUserAvatar(ctx, userID, avatarID, int64(len(data)))
+	err = uc.userrepo.UpdateUserAvatar(ctx, userID, avatarID, int64(len(variants[0].Data)), utils.AvatarVariantSizes(variants))
 	if err != nil {
 		logger.WithError(err).Error("could not update user avatar")
+		utils.DeleteAvatarObjects(ctx, uc.fileStorage, AvatarModels.Avatar{ID: avatarID, Sizes: utils.AvatarVariantSizes(variants)})
 		return "", err
 	}
 
+	uc.publishProfileChanged(ctx, userID)
+
 	return avatar_url, nil
 }
 
-func (uc *UserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, name *string, username *string, bio *string) error {
+// GetUserAvatarHistory возвращает аватарки пользователя, начиная с текущей.
+// Если владелец скрыл аватарку от пользователя из подписи запроса, история пустая
+func (uc *UserUsecase) GetUserAvatarHistory(ctx context.Context, ownerID uuid.UUID) ([]dtoUtils.AvatarDTO, error) {
+	const op = "UserUsecase.GetUserAvatarHistory"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("owner_id", ownerID.String())
+
+	access, err := uc.viewerAccess(ctx, []uuid.UUID{ownerID})
+	if err != nil {
+		logger.WithError(err).Error("could not load privacy settings")
+		return nil, err
+	}
+	if access != nil && !access.Allows(ownerID, PrivacyModels.SettingAvatar) {
+		return []dtoUtils.AvatarDTO{}, nil
+	}
+
+	avatars, err := uc.userrepo.GetUserAvatarHistory(ctx, ownerID)
+	if err != nil {
+		logger.WithError(err).Error("could not get user avatar history")
+		return nil, err
+	}
+
+	result := make([]dtoUtils.AvatarDTO, 0, len(avatars))
+	for _, avatar := range avatars {
+		result = append(result, utils.ConvertAvatarToDTO(ctx, avatar, uc.fileStorage))
+	}
+
+	return result, nil
+}
+
+// DeleteUserAvatar удаляет аватарку из истории пользователя вместе с файлами всех размеров.
+// Если удалена текущая, текущей становится предыдущая
+func (uc *UserUsecase) DeleteUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	const op = "UserUsecase.DeleteUserAvatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("avatar_id", avatarID.String())
+
+	sizes, err := uc.userrepo.DeleteUserAvatar(ctx, userID, avatarID)
+	if err != nil {
+		logger.WithError(err).Error("could not delete user avatar")
+		return err
+	}
+
+	utils.DeleteAvatarObjects(ctx, uc.fileStorage, AvatarModels.Avatar{ID: avatarID, Sizes: sizes})
+
+	uc.publishProfileChanged(ctx, userID)
+
+	return nil
+}
+
+// SetCurrentUserAvatar делает аватарку из истории пользователя текущей
+func (uc *UserUsecase) SetCurrentUserAvatar(ctx context.Context, userID, avatarID uuid.UUID) error {
+	const op = "UserUsecase.SetCurrentUserAvatar"
+
+	logger := domains.GetLogger(ctx).WithField("operation", op).WithField("avatar_id", avatarID.String())
+
+	if err := uc.userrepo.SetCurrentUserAvatar(ctx, userID, avatarID); err != nil {
+		logger.WithError(err).Error("could not set current user avatar")
+		return err
+	}
+
+	uc.publishProfileChanged(ctx, userID)
+
+	return nil
+}
+
+func (uc *UserUsecase) UpdateUserInfo(ctx context.Context, userID uuid.UUID, update UserModels.InfoUpdate) error {
 	const op = "UserUsecase.UpdateUserInfo"
 
 	logger := domains.GetLogger(ctx).WithField("operation", op)
 
-	err := uc.userrepo.UpdateUserInfo(ctx, userID, name, username, bio)
+	if update.Username != nil {
+		if err := uc.checkUsernameAllowed(ctx, userID, *update.Username); err != nil {
+			logger.WithError(err).Error("username is not allowed")
+			return err
+		}
+	}
+
+	err := uc.userrepo.UpdateUserInfo(ctx, userID, update)
 	if err != nil {
 		logger.WithError(err).Error("could not update user info")
 		return err
 	}
 
+	uc.publishProfileChanged(ctx, userID)
+
+	if update.Status != nil {
+		uc.publishStatusChanged(ctx, userID, *update.Status)
+	}
+
+	if err := uc.IndexUser(ctx, userID); err != nil {
+		// Индекс догонит профиль при следующей переиндексации
+		logger.WithError(err).Warn("could not update user in search index")
+	}
+
 	return nil
 }
 
+// checkUsernameAllowed не даёт занять зарезервированный handle. Текущий handle пользователя
+// (в том числе сгенерированный при регистрации) можно сохранить повторно
+func (uc *UserUsecase) checkUsernameAllowed(ctx context.Context, userID uuid.UUID, username string) error {
+	if !HandleModels.IsReserved(username) {
+		return nil
+	}
+
+	user, err := uc.userrepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return errs.ErrUserNotFound
+	}
+	if HandleModels.Normalize(user.Username) == HandleModels.Normalize(username) {
+		return nil
+	}
+
+	return errs.ErrUsernameReserved
+}
+
+// HandlePhoneChanged сбрасывает кэши профиля в других сервисах после смены номера
+func (uc *UserUsecase) HandlePhoneChanged(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserUsecase.HandlePhoneChanged"
+
+	if _, err := uc.userrepo.GetUserByID(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.publishProfileChanged(ctx, userID)
+
+	return nil
+}
+
+// UpdateLastSeen запоминает, когда пользователь последний раз был в сети
+func (uc *UserUsecase) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	const op = "UserUsecase.UpdateLastSeen"
+
+	if err := uc.userrepo.UpdateLastSeen(ctx, userID, at); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IndexUser добавляет актуальный профиль пользователя в индекс глобального поиска
+func (uc *UserUsecase) IndexUser(ctx context.Context, userID uuid.UUID) error {
+	const op = "UserUsecase.IndexUser"
+
+	if uc.searchrepo == nil {
+		return nil
+	}
+
+	user, err := uc.userrepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := uc.searchrepo.IndexUser(ctx, user.ID.String(), user.Username, user.Name); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (uc *UserUsecase) ReindexAllUsers(ctx context.Context) error {
+	const op = "UserUsecase.ReindexAllUsers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if uc.searchrepo == nil {
+		logger.Warn("elasticsearch client is nil, skipping reindexing")
+		return nil
+	}
+
+	users, err := uc.userrepo.GetAllUsers(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to get all users from database")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	failed := 0
+	for _, user := range users {
+		if err := uc.searchrepo.IndexUser(ctx, user.ID.String(), user.Username, user.Name); err != nil {
+			logger.WithError(err).WithField("user_id", user.ID).Warn("failed to index user")
+			failed++
+		}
+	}
+
+	logger.WithField("indexed", len(users)-failed).WithField("failed", failed).Info("reindexing completed")
+
+	if failed > 0 {
+		return fmt.Errorf("%s: reindexing completed with %d failures out of %d users", op, failed, len(users))
+	}
+
+	return nil
+}
+
+// SearchUsers ищет людей по префиксу username и имени среди всех пользователей.
+// Контакты и участники общих групп идут выше, заблокированные в любую сторону и сам viewerID не попадают в выдачу,
+// скрытые настройками приватности поля очищаются
+func (uc *UserUsecase) SearchUsers(ctx context.Context, viewerID uuid.UUID, query string, offset, limit int) (*UserDto.SearchUsersResult, error) {
+	const op = "UserUsecase.SearchUsers"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	query = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(query), "@"))
+	if utf8.RuneCountInString(query) < UserModels.MinSearchQueryLength {
+		return nil, fmt.Errorf("%s: query is too short: %w", op, errs.ErrInvalidInput)
+	}
+
+	if limit == 0 {
+		limit = UserModels.DefaultSearchLimit
+	}
+	if limit > UserModels.MaxSearchLimit {
+		limit = UserModels.MaxSearchLimit
+	}
+	if offset < 0 || limit < 0 || offset+limit > UserModels.MaxSearchWindow {
+		return nil, fmt.Errorf("%s: invalid pagination: %w", op, errs.ErrInvalidInput)
+	}
+
+	if uc.searchrepo == nil {
+		logger.Warn("elasticsearch not available, returning empty results")
+		return &UserDto.SearchUsersResult{Users: []*UserDto.User{}}, nil
+	}
+
+	params := userES.SearchParams{
+		ExcludeIDs: []string{viewerID.String()},
+		Offset:     offset,
+		Limit:      limit,
+	}
+
+	if uc.contactrepo != nil {
+		contacts, err := uc.contactrepo.GetContactsByUserID(ctx, viewerID)
+		if err != nil {
+			logger.WithError(err).Error("could not get contacts")
+			return nil, err
+		}
+		for _, contact := range contacts {
+			params.ContactIDs = append(params.ContactIDs, contact.ContactUserID.String())
+		}
+	}
+
+	if uc.groupPeers != nil {
+		// Без участников групп поиск работает, только ранжирование становится грубее
+		peers, err := uc.groupPeers.GetGroupPeers(ctx, viewerID)
+		if err != nil {
+			logger.WithError(err).Warn("could not get group peers, ranking without them")
+		}
+		for _, peerID := range peers {
+			params.PeerIDs = append(params.PeerIDs, peerID.String())
+		}
+	}
+
+	foundIDs, total, err := uc.searchrepo.SearchUsers(ctx, query, params)
+	if err != nil {
+		logger.WithError(err).Error("could not search users")
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(foundIDs))
+	for _, id := range foundIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			logger.WithError(err).Warnf("skipping invalid user id %s from search index", id)
+			continue
+		}
+		ids = append(ids, parsed)
+	}
+
+	ids, err = uc.withoutBlocked(ctx, viewerID, ids)
+	if err != nil {
+		logger.WithError(err).Error("could not get blocked peers")
+		return nil, err
+	}
+
+	users, err := uc.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyPrivacy(ctx, users...); err != nil {
+		logger.WithError(err).Error("could not apply privacy settings")
+		return nil, err
+	}
+
+	return &UserDto.SearchUsersResult{Users: users, Total: total}, nil
+}
+
+// withoutBlocked убирает из peerIDs тех, с кем у userID есть блокировка в любую сторону
+func (uc *UserUsecase) withoutBlocked(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if uc.blockrepo == nil || len(peerIDs) == 0 {
+		return peerIDs, nil
+	}
+
+	blocked, err := uc.blockrepo.GetBlockedPeers(ctx, userID, peerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocked) == 0 {
+		return peerIDs, nil
+	}
+
+	blockedSet := make(map[uuid.UUID]struct{}, len(blocked))
+	for _, id := range blocked {
+		blockedSet[id] = struct{}{}
+	}
+
+	allowed := make([]uuid.UUID, 0, len(peerIDs))
+	for _, id := range peerIDs {
+		if _, ok := blockedSet[id]; !ok {
+			allowed = append(allowed, id)
+		}
+	}
+
+	return allowed, nil
+}
+
+// publishProfileChanged оповещает о смене профиля, чтобы другие сервисы сбросили кэш.
+// Ошибка не прерывает операцию: устаревшая запись в кэше истечёт по TTL
+func (uc *UserUsecase) publishProfileChanged(ctx context.Context, userID uuid.UUID) {
+	if uc.profileEvents == nil {
+		return
+	}
+
+	if err := uc.profileEvents.PublishProfileChanged(ctx, userID); err != nil {
+		domains.GetLogger(ctx).WithError(err).Warnf("could not publish profile change of user %s", userID)
+	}
+}
+
 func (uc *UserUsecase) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID) (map[string]*string, error) {
 	const op = "UserUsecase.GetUserAvatars"
 
@@ -160,7 +601,19 @@ func (uc *UserUsecase) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID)
 		return nil, err
 	}
 
+	access, err := uc.viewerAccess(ctx, userIDs)
+	if err != nil {
+		logger.WithError(err).Error("Failed to load privacy settings")
+		return nil, err
+	}
+
 	for userID, attachmentID := range avatarsIDs {
+		if access != nil {
+			if ownerID, err := uuid.Parse(userID); err == nil && !access.Allows(ownerID, PrivacyModels.SettingAvatar) {
+				continue
+			}
+		}
+
 		url, err := uc.fileStorage.GetOne(ctx, &attachmentID)
 		if err != nil {
 			avatars[userID] = nil
@@ -173,3 +626,34 @@ func (uc *UserUsecase) GetUserAvatars(ctx context.Context, userIDs []uuid.UUID)
 	logger.WithField("avatars_count", len(avatars)).Info("Usecase operation completed successfully: user avatars retrieved")
 	return avatars, nil
 }
+
+// viewerAccess загружает настройки приватности владельцев для пользователя из подписи запроса.
+// Возвращает nil, если скрывать нечего: вызов от внутреннего сервиса без пользователя или приватность не подключена.
+// Вызов без подписи пользователя и сервиса видит профили как посторонний
+func (uc *UserUsecase) viewerAccess(ctx context.Context, ownerIDs []uuid.UUID) (*PrivacyModels.Access, error) {
+	if viewerID, ok := identity.UserIDFromContext(ctx); ok {
+		return privacyUsecase.ViewerAccess(ctx, uc.privacyrepo, uc.contactrepo, viewerID, ownerIDs)
+	}
+
+	if _, ok := identity.ServiceFromContext(ctx); ok {
+		return nil, nil
+	}
+
+	return privacyUsecase.ViewerAccess(ctx, uc.privacyrepo, nil, uuid.Nil, ownerIDs)
+}
+
+// applyPrivacy очищает поля профилей, которые владельцы скрыли от пользователя из подписи запроса
+func (uc *UserUsecase) applyPrivacy(ctx context.Context, users ...*UserDto.User) error {
+	ownerIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		ownerIDs = append(ownerIDs, user.ID)
+	}
+
+	access, err := uc.viewerAccess(ctx, ownerIDs)
+	if err != nil {
+		return err
+	}
+
+	privacyUsecase.HideFields(access, users...)
+	return nil
+}
@@ -1,17 +1,30 @@
 package user
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"image"
+	"image/png"
 	"testing"
 	"time"
 
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/identity"
+	AvatarModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
+	ContactModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/contact"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	PrivacyModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/privacy"
 	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	userES "github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/elasticsearch/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
+	UserDto "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/user"
 	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestUserUsecase_GetUserById_Success(t *testing.T) {
@@ -20,7 +33,7 @@ func TestUserUsecase_GetUserById_Success(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -37,6 +50,7 @@ func TestUserUsecase_GetUserById_Success(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(&UserModels.Profile{}, nil)
 
 	result, err := uc.GetUserById(ctx, userID)
 
@@ -56,7 +70,7 @@ func TestUserUsecase_GetUserById_NotFound(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -76,7 +90,7 @@ func TestUserUsecase_GetUserById_AvatarError(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -93,6 +107,7 @@ func TestUserUsecase_GetUserById_AvatarError(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(user, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(&UserModels.Profile{}, nil)
 
 	result, err := uc.GetUserById(ctx, userID)
 
@@ -101,13 +116,84 @@ func TestUserUsecase_GetUserById_AvatarError(t *testing.T) {
 	assert.Equal(t, userID, result.ID)
 }
 
+func TestUserUsecase_GetUserById_Profile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+	birthday := time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC)
+	lastSeenAt := time.Now().Add(-time.Minute)
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, Name: "Test User"}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(&UserModels.Profile{
+		Status:     &UserModels.Status{Emoji: "🌴", Text: "в отпуске", ExpiresAt: &expiresAt},
+		Birthday:   &birthday,
+		Links:      []UserModels.Link{{Title: "GitHub", URL: "https://github.com/undefined"}},
+		LastSeenAt: &lastSeenAt,
+	}, nil)
+
+	result, err := uc.GetUserById(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &UserDto.StatusDTO{Emoji: "🌴", Text: "в отпуске", ExpiresAt: &expiresAt}, result.Status)
+	assert.Equal(t, "1995-03-14", *result.Birthday)
+	assert.Equal(t, []UserDto.LinkDTO{{Title: "GitHub", URL: "https://github.com/undefined"}}, result.Links)
+	assert.Equal(t, &lastSeenAt, result.LastSeenAt)
+}
+
+func TestUserUsecase_GetUserById_ExpiredStatusHidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	expiredAt := time.Now().Add(-time.Minute)
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(&UserModels.Profile{
+		Status: &UserModels.Status{Text: "на встрече", ExpiresAt: &expiredAt},
+	}, nil)
+
+	result, err := uc.GetUserById(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Status)
+}
+
+func TestUserUsecase_GetUserById_ProfileError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(nil, errors.New("database error"))
+
+	result, err := uc.GetUserById(ctx, userID)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
 func TestUserUsecase_GetUserByPhone_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	phone := "+79998887766"
@@ -141,12 +227,12 @@ func TestUserUsecase_GetUserByPhone_NotFound(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	phone := "+79998887766"
 
-	mockRepo.EXPECT().GetUserByPhone(ctx, phone).Return(nil, errors.New("user not found"))
+	mockRepo.EXPECT().GetUserByPhone(ctx, phone).Return(nil, errs.ErrUserNotFound)
 
 	result, err := uc.GetUserByPhone(ctx, phone)
 
@@ -161,7 +247,7 @@ func TestUserUsecase_GetUserByUsername_Success(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	username := "test_user"
@@ -195,7 +281,7 @@ func TestUserUsecase_GetUserByUsername_NotFound(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	username := "nonexistent_user"
@@ -209,23 +295,90 @@ func TestUserUsecase_GetUserByUsername_NotFound(t *testing.T) {
 	assert.Equal(t, errs.ErrUserNotFound, err)
 }
 
+func TestUserUsecase_ResolveUsername_Redirected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	user := &UserModels.User{
+		ID:          uuid.New(),
+		Name:        "Test User",
+		Username:    "new_handle",
+		AccountType: UserModels.UserAccount,
+	}
+
+	mockRepo.EXPECT().ResolveUsername(ctx, "old_handle").Return(user, true, nil)
+
+	result, redirected, err := uc.ResolveUsername(ctx, "old_handle")
+
+	assert.NoError(t, err)
+	assert.True(t, redirected)
+	assert.Equal(t, user.ID, result.ID)
+	assert.Equal(t, "new_handle", result.Username)
+}
+
+func TestUserUsecase_ResolveUsername_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	mockRepo.EXPECT().ResolveUsername(ctx, "ghost").Return(nil, false, errs.ErrUserNotFound)
+
+	result, redirected, err := uc.ResolveUsername(ctx, "ghost")
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+	assert.False(t, redirected)
+	assert.Nil(t, result)
+}
+
+// testAvatarPNG возвращает PNG 200x200: из него получаются копии 200 и 160 пикселей
+func testAvatarPNG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 200, 200))); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
 func TestUserUsecase_UploadUserAvatar_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
-	data := []byte("fake avatar data")
-	filename := "avatar.jpg"
-	contentType := "image/jpeg"
+	data := testAvatarPNG(t)
+	filename := "avatar.png"
+	contentType := "image/png"
 	expectedURL := "https://example.com/avatar.jpg"
 
-	mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).Return(expectedURL, nil)
-	mockRepo.EXPECT().UpdateUserAvatar(ctx, userID, gomock.Any(), int64(len(data))).Return(nil)
+	var avatarID uuid.UUID
+	gomock.InOrder(
+		mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, file minio.FileData, objectID uuid.UUID) (string, error) {
+				assert.Equal(t, AvatarModels.ContentType, file.ContentType)
+				avatarID = objectID
+				return expectedURL, nil
+			}),
+		mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ minio.FileData, objectID uuid.UUID) (string, error) {
+				assert.Equal(t, AvatarModels.VariantID(avatarID, 160), objectID)
+				return "https://example.com/avatar_160.jpg", nil
+			}),
+	)
+	mockRepo.EXPECT().UpdateUserAvatar(ctx, userID, gomock.Any(), gomock.Any(), []int{200, 160}).Return(nil)
 
 	result, err := uc.UploadUserAvatar(ctx, userID, data, filename, contentType)
 
@@ -233,19 +386,55 @@ func TestUserUsecase_UploadUserAvatar_Success(t *testing.T) {
 	assert.Equal(t, expectedURL, result)
 }
 
+func TestUserUsecase_UploadUserAvatar_InvalidImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
+
+	result, err := uc.UploadUserAvatar(context.Background(), uuid.New(), []byte("fake avatar data"), "avatar.jpg", "image/jpeg")
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+	assert.Empty(t, result)
+}
+
+func TestUserUsecase_UploadUserAvatar_PublishesProfileChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, mockFileStorage, mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	data := testAvatarPNG(t)
+
+	mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).Return("https://example.com/avatar.jpg", nil).Times(2)
+	mockRepo.EXPECT().UpdateUserAvatar(ctx, userID, gomock.Any(), gomock.Any(), []int{200, 160}).Return(nil)
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(nil)
+
+	_, err := uc.UploadUserAvatar(ctx, userID, data, "avatar.png", "image/png")
+
+	assert.NoError(t, err)
+}
+
 func TestUserUsecase_UploadUserAvatar_FileStorageError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
-	data := []byte("fake avatar data")
-	filename := "avatar.jpg"
-	contentType := "image/jpeg"
+	data := testAvatarPNG(t)
+	filename := "avatar.png"
+	contentType := "image/png"
 
 	mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).Return("", errors.New("storage error"))
 
@@ -262,17 +451,19 @@ func TestUserUsecase_UploadUserAvatar_UpdateError(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
-	data := []byte("fake avatar data")
-	filename := "avatar.jpg"
-	contentType := "image/jpeg"
+	data := testAvatarPNG(t)
+	filename := "avatar.png"
+	contentType := "image/png"
 	expectedURL := "https://example.com/avatar.jpg"
 
-	mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).Return(expectedURL, nil)
-	mockRepo.EXPECT().UpdateUserAvatar(ctx, userID, gomock.Any(), int64(len(data))).Return(errors.New("database error"))
+	mockFileStorage.EXPECT().CreateOne(ctx, gomock.Any(), gomock.Any()).Return(expectedURL, nil).Times(2)
+	mockRepo.EXPECT().UpdateUserAvatar(ctx, userID, gomock.Any(), gomock.Any(), []int{200, 160}).Return(errors.New("database error"))
+	// Загруженные копии удаляются, чтобы не оставлять файлы без записи в БД
+	mockFileStorage.EXPECT().DeleteOne(ctx, gomock.Any()).Return(nil).Times(2)
 
 	result, err := uc.UploadUserAvatar(ctx, userID, data, filename, contentType)
 
@@ -281,13 +472,184 @@ func TestUserUsecase_UploadUserAvatar_UpdateError(t *testing.T) {
 	assert.Contains(t, err.Error(), "database error")
 }
 
+func TestUserUsecase_GetUserAvatarHistory_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	ownerID := uuid.New()
+	current := &AvatarModels.Avatar{ID: uuid.New(), Sizes: []int{640, 160}, IsCurrent: true}
+	legacy := &AvatarModels.Avatar{ID: uuid.New()}
+
+	mockRepo.EXPECT().GetUserAvatarHistory(ctx, ownerID).Return([]*AvatarModels.Avatar{current, legacy}, nil)
+	mockFileStorage.EXPECT().GetOne(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, objectID *uuid.UUID) (string, error) {
+			return "https://example.com/" + objectID.String(), nil
+		}).AnyTimes()
+
+	result, err := uc.GetUserAvatarHistory(ctx, ownerID)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.True(t, result[0].IsCurrent)
+	assert.Equal(t, "https://example.com/"+current.ID.String(), result[0].URL)
+	assert.Equal(t, "https://example.com/"+AvatarModels.VariantID(current.ID, 160).String(), result[0].Sizes[160])
+	assert.Equal(t, "https://example.com/"+legacy.ID.String(), result[1].URL)
+	assert.Empty(t, result[1].Sizes)
+}
+
+func TestUserUsecase_GetUserAvatarHistory_HiddenByPrivacy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockPrivacy := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, mockPrivacy, mockContactRepo, nil, nil, nil)
+
+	viewerID, ownerID := uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+
+	mockPrivacy.EXPECT().GetRules(ctx, []uuid.UUID{ownerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		ownerID: {PrivacyModels.SettingAvatar: {Setting: PrivacyModels.SettingAvatar, Visibility: PrivacyModels.VisibilityNobody}},
+	}, nil)
+	mockContactRepo.EXPECT().GetContactOwners(ctx, viewerID, []uuid.UUID{ownerID}).Return(nil, nil)
+
+	result, err := uc.GetUserAvatarHistory(ctx, ownerID)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestUserUsecase_DeleteUserAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, mockFileStorage, mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mockRepo.EXPECT().DeleteUserAvatar(ctx, userID, avatarID).Return([]int{640, 320, 160}, nil)
+	mockFileStorage.EXPECT().DeleteOne(ctx, avatarID).Return(nil)
+	mockFileStorage.EXPECT().DeleteOne(ctx, AvatarModels.VariantID(avatarID, 320)).Return(nil)
+	mockFileStorage.EXPECT().DeleteOne(ctx, AvatarModels.VariantID(avatarID, 160)).Return(errors.New("storage error"))
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(nil)
+
+	err := uc.DeleteUserAvatar(ctx, userID, avatarID)
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_DeleteUserAvatar_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mockRepo.EXPECT().DeleteUserAvatar(ctx, userID, avatarID).Return(nil, errs.ErrNotFound)
+
+	err := uc.DeleteUserAvatar(ctx, userID, avatarID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestUserUsecase_SetCurrentUserAvatar_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, nil, mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	avatarID := uuid.New()
+
+	mockRepo.EXPECT().SetCurrentUserAvatar(ctx, userID, avatarID).Return(nil)
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(nil)
+
+	err := uc.SetCurrentUserAvatar(ctx, userID, avatarID)
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_HandlePhoneChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, nil, mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID}, nil)
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(nil)
+
+	err := uc.HandlePhoneChanged(ctx, userID)
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_HandlePhoneChanged_UserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, nil, mocks.NewMockProfileEventPublisher(ctrl), nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(nil, errs.ErrUserNotFound)
+
+	err := uc.HandlePhoneChanged(ctx, userID)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+}
+
+func TestUserUsecase_UpdateLastSeen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	at := time.Now()
+
+	mockRepo.EXPECT().UpdateLastSeen(ctx, userID, at).Return(errs.ErrUserNotFound)
+
+	err := uc.UpdateLastSeen(ctx, userID, at)
+
+	assert.ErrorIs(t, err, errs.ErrUserNotFound)
+}
+
 func TestUserUsecase_UpdateUserInfo_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -295,9 +657,90 @@ func TestUserUsecase_UpdateUserInfo_Success(t *testing.T) {
 	username := "new_username"
 	bio := "New bio"
 
-	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, &name, &username, &bio).Return(nil)
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name, Username: &username, Bio: &bio}).Return(nil)
+
+	err := uc.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name, Username: &username, Bio: &bio})
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_UpdateUserInfo_ReservedUsername(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	username := "Official_News"
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, Username: "user_1700000000"}, nil)
+
+	err := uc.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Username: &username})
+
+	assert.ErrorIs(t, err, errs.ErrUsernameReserved)
+}
+
+func TestUserUsecase_UpdateUserInfo_KeepsGeneratedUsername(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, nil, nil)
 
-	err := uc.UpdateUserInfo(ctx, userID, &name, &username, &bio)
+	ctx := context.Background()
+	userID := uuid.New()
+	username := "user_1700000000"
+	update := UserModels.InfoUpdate{Username: &username}
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, Username: username}, nil)
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, update).Return(nil)
+
+	err := uc.UpdateUserInfo(ctx, userID, update)
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_UpdateUserInfo_PublishErrorIgnored(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, mockFileStorage, mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	name := "New Name"
+
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name}).Return(nil)
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(errors.New("chats service unavailable"))
+
+	err := uc.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name})
+
+	assert.NoError(t, err)
+}
+
+func TestUserUsecase_UpdateUserInfo_PublishesStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockEvents := mocks.NewMockProfileEventPublisher(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), mockEvents, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	status := UserModels.Status{Emoji: "🌴", Text: "в отпуске"}
+	update := UserModels.InfoUpdate{Status: &status}
+
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, update).Return(nil)
+	mockEvents.EXPECT().PublishProfileChanged(ctx, userID).Return(nil)
+	mockEvents.EXPECT().PublishStatusChanged(ctx, userID, status).Return(errors.New("chats service unavailable"))
+
+	err := uc.UpdateUserInfo(ctx, userID, update)
 
 	assert.NoError(t, err)
 }
@@ -308,27 +751,70 @@ func TestUserUsecase_UpdateUserInfo_Error(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
 	name := "New Name"
 
-	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, &name, nil, nil).Return(errors.New("database error"))
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name}).Return(errors.New("database error"))
 
-	err := uc.UpdateUserInfo(ctx, userID, &name, nil, nil)
+	err := uc.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Name: &name})
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "database error")
 }
 
+func TestUserUsecase_GetUsersByIDs_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mockRepo.EXPECT().GetUsersByIDs(ctx, userIDs).Return(map[uuid.UUID]*UserModels.User{
+		userIDs[0]: {ID: userIDs[0], Name: "First", PasswordHash: "hash"},
+	}, nil)
+
+	result, err := uc.GetUsersByIDs(ctx, userIDs)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, userIDs[0], result[0].ID)
+	assert.Equal(t, "First", result[0].Name)
+	assert.Empty(t, result[0].PasswordHash)
+}
+
+func TestUserUsecase_GetUsersByIDs_RepositoryError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	userIDs := []uuid.UUID{uuid.New()}
+
+	mockRepo.EXPECT().GetUsersByIDs(ctx, userIDs).Return(nil, errors.New("database error"))
+
+	result, err := uc.GetUsersByIDs(ctx, userIDs)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
 func TestUserUsecase_GetUserAvatars_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID1 := uuid.New()
@@ -366,7 +852,7 @@ func TestUserUsecase_GetUserAvatars_RepositoryError(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userIDs := []uuid.UUID{uuid.New(), uuid.New()}
@@ -386,7 +872,7 @@ func TestUserUsecase_GetUserAvatars_FileStorageError(t *testing.T) {
 
 	mockRepo := mocks.NewMockUserRepository(ctrl)
 	mockFileStorage := mocks.NewMockFileStorage(ctrl)
-	uc := New(mockRepo, mockFileStorage)
+	uc := New(mockRepo, mockFileStorage, nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -406,3 +892,291 @@ func TestUserUsecase_GetUserAvatars_FileStorageError(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Nil(t, result[userID.String()])
 }
+
+// viewerContext возвращает контекст запроса, подписанного от имени viewerID, как его видит user_service
+func viewerContext(t *testing.T, viewerID uuid.UUID) context.Context {
+	signer := identity.NewSigner(&config.IdentityConfig{Secret: "secret", TTL: time.Minute})
+	return signedContext(t, signer, metadata.Pairs(identity.MetadataKey, signer.Sign(viewerID)))
+}
+
+// serviceContext возвращает контекст межсервисного вызова без пользователя
+func serviceContext(t *testing.T) context.Context {
+	signer := identity.NewSigner(&config.IdentityConfig{Secret: "secret", TTL: time.Minute})
+	return signedContext(t, signer, metadata.Pairs(identity.ServiceMetadataKey, signer.SignService("chats")))
+}
+
+func signedContext(t *testing.T, signer *identity.Signer, md metadata.MD) context.Context {
+	var handlerCtx context.Context
+	_, err := identity.UnaryServerInterceptor(signer, true)(metadata.NewIncomingContext(context.Background(), md), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/user.UserService/GetUserById"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			handlerCtx = ctx
+			return nil, nil
+		})
+	assert.NoError(t, err)
+
+	return handlerCtx
+}
+
+func TestUserUsecase_GetUserById_PrivacyHidesFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mockPrivacyRepo, mockContactRepo, nil, nil, nil)
+
+	viewerID, ownerID := uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+	bio := "secret bio"
+
+	birthday := time.Date(1995, 3, 14, 0, 0, 0, 0, time.UTC)
+	lastSeenAt := time.Now().Add(-time.Hour)
+
+	mockRepo.EXPECT().GetUserByID(ctx, ownerID).Return(&UserModels.User{ID: ownerID, PhoneNumber: "+79998887766", Name: "Owner", Bio: &bio}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, ownerID).Return(&UserModels.Profile{Birthday: &birthday, LastSeenAt: &lastSeenAt}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{ownerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		ownerID: {
+			PrivacyModels.SettingBio:      {Setting: PrivacyModels.SettingBio, Visibility: PrivacyModels.VisibilityNobody},
+			PrivacyModels.SettingLastSeen: {Setting: PrivacyModels.SettingLastSeen, Visibility: PrivacyModels.VisibilityContacts},
+		},
+	}, nil)
+	// Зритель не в контактах владельца: номер и день рождения по умолчанию скрыты
+	mockContactRepo.EXPECT().GetContactOwners(ctx, viewerID, []uuid.UUID{ownerID}).Return(nil, nil)
+
+	result, err := uc.GetUserById(ctx, ownerID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Owner", result.Name)
+	assert.Empty(t, result.PhoneNumber)
+	assert.Nil(t, result.Bio)
+	assert.Nil(t, result.Birthday)
+	assert.Nil(t, result.LastSeenAt)
+}
+
+func TestUserUsecase_GetUserByUsername_PrivacyContact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mockPrivacyRepo, mockContactRepo, nil, nil, nil)
+
+	viewerID, ownerID := uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+
+	mockRepo.EXPECT().GetUserByUsername(ctx, "owner").Return(&UserModels.User{ID: ownerID, PhoneNumber: "+79998887766"}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{ownerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{}, nil)
+	mockContactRepo.EXPECT().GetContactOwners(ctx, viewerID, []uuid.UUID{ownerID}).Return([]uuid.UUID{ownerID}, nil)
+
+	result, err := uc.GetUserByUsername(ctx, "owner")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+79998887766", result.PhoneNumber)
+}
+
+func TestUserUsecase_GetUserById_PrivacyAnonymousViewer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	// Без подписи зритель посторонний: контакты не запрашиваются
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mockPrivacyRepo, mocks.NewMockContactRepository(ctrl), nil, nil, nil)
+
+	ownerID := uuid.New()
+	ctx := context.Background()
+	bio := "bio"
+
+	mockRepo.EXPECT().GetUserByID(ctx, ownerID).Return(&UserModels.User{ID: ownerID, PhoneNumber: "+79998887766", Bio: &bio}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, ownerID).Return(&UserModels.Profile{}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{ownerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		ownerID: {PrivacyModels.SettingBio: {Setting: PrivacyModels.SettingBio, Visibility: PrivacyModels.VisibilityContacts}},
+	}, nil)
+
+	result, err := uc.GetUserById(ctx, ownerID)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.PhoneNumber)
+	assert.Nil(t, result.Bio)
+}
+
+func TestUserUsecase_GetUserById_PrivacyServiceCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	// Внутренний сервис без пользователя получает профиль целиком: обращений к настройкам нет
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockPrivacyRepository(ctrl), mocks.NewMockContactRepository(ctrl), nil, nil, nil)
+
+	ownerID := uuid.New()
+	ctx := serviceContext(t)
+
+	mockRepo.EXPECT().GetUserByID(ctx, ownerID).Return(&UserModels.User{ID: ownerID, PhoneNumber: "+79998887766"}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, ownerID).Return(&UserModels.Profile{}, nil)
+
+	result, err := uc.GetUserById(ctx, ownerID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+79998887766", result.PhoneNumber)
+}
+
+func TestUserUsecase_GetUserById_PrivacyOwnProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	// Свой профиль не проверяется: обращений к настройкам нет
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mocks.NewMockPrivacyRepository(ctrl), mocks.NewMockContactRepository(ctrl), nil, nil, nil)
+
+	userID := uuid.New()
+	ctx := viewerContext(t, userID)
+
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, PhoneNumber: "+79998887766"}, nil)
+	mockRepo.EXPECT().GetUserProfile(ctx, userID).Return(&UserModels.Profile{}, nil)
+
+	result, err := uc.GetUserById(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "+79998887766", result.PhoneNumber)
+}
+
+func TestUserUsecase_GetUserAvatars_PrivacyHidesAvatar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockFileStorage := mocks.NewMockFileStorage(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	uc := New(mockRepo, mockFileStorage, nil, mockPrivacyRepo, mockContactRepo, nil, nil, nil)
+
+	viewerID, publicID, hiddenID := uuid.New(), uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+	userIDs := []uuid.UUID{publicID, hiddenID}
+	publicAvatar, hiddenAvatar := uuid.New(), uuid.New()
+
+	mockRepo.EXPECT().GetUserAvatars(ctx, userIDs).Return(map[string]uuid.UUID{
+		publicID.String(): publicAvatar,
+		hiddenID.String(): hiddenAvatar,
+	}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, userIDs).Return(map[uuid.UUID]PrivacyModels.UserRules{
+		hiddenID: {PrivacyModels.SettingAvatar: {Setting: PrivacyModels.SettingAvatar, Visibility: PrivacyModels.VisibilityContacts}},
+	}, nil)
+	mockContactRepo.EXPECT().GetContactOwners(ctx, viewerID, userIDs).Return(nil, nil)
+	mockFileStorage.EXPECT().GetOne(ctx, &publicAvatar).Return("https://example.com/public.jpg", nil)
+
+	result, err := uc.GetUserAvatars(ctx, userIDs)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/public.jpg", *result[publicID.String()])
+	assert.Contains(t, result, hiddenID.String())
+	assert.Nil(t, result[hiddenID.String()])
+}
+
+// stubUserSearch отдаёт заранее заданную выдачу и запоминает параметры запроса
+type stubUserSearch struct {
+	results []string
+	total   int
+	query   string
+	params  userES.SearchParams
+	indexed map[string]string
+}
+
+func (s *stubUserSearch) CreateIndex(ctx context.Context) error { return nil }
+
+func (s *stubUserSearch) IndexUser(ctx context.Context, userID, username, name string) error {
+	if s.indexed == nil {
+		s.indexed = make(map[string]string)
+	}
+	s.indexed[userID] = username
+	return nil
+}
+
+func (s *stubUserSearch) SearchUsers(ctx context.Context, query string, params userES.SearchParams) ([]string, int, error) {
+	s.query = query
+	s.params = params
+	return s.results, s.total, nil
+}
+
+func TestUserUsecase_SearchUsers_RanksAndFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	mockPrivacyRepo := mocks.NewMockPrivacyRepository(ctrl)
+	mockContactRepo := mocks.NewMockContactRepository(ctrl)
+	mockBlockRepo := mocks.NewMockBlockRepository(ctrl)
+	mockPeers := mocks.NewMockGroupPeersProvider(ctrl)
+
+	viewerID, contactID, peerID, blockedID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	ctx := viewerContext(t, viewerID)
+
+	search := &stubUserSearch{results: []string{contactID.String(), peerID.String(), blockedID.String()}, total: 3}
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, mockPrivacyRepo, mockContactRepo, mockBlockRepo, search, mockPeers)
+
+	mockContactRepo.EXPECT().GetContactsByUserID(ctx, viewerID).Return([]*ContactModels.Contact{{UserID: viewerID, ContactUserID: contactID}}, nil)
+	mockPeers.EXPECT().GetGroupPeers(ctx, viewerID).Return([]uuid.UUID{peerID}, nil)
+	mockBlockRepo.EXPECT().GetBlockedPeers(ctx, viewerID, []uuid.UUID{contactID, peerID, blockedID}).Return([]uuid.UUID{blockedID}, nil)
+	mockRepo.EXPECT().GetUsersByIDs(ctx, []uuid.UUID{contactID, peerID}).Return(map[uuid.UUID]*UserModels.User{
+		contactID: {ID: contactID, Name: "Contact", PhoneNumber: "+79990000001"},
+		peerID:    {ID: peerID, Name: "Peer", PhoneNumber: "+79990000002"},
+	}, nil)
+	mockPrivacyRepo.EXPECT().GetRules(ctx, []uuid.UUID{contactID, peerID}).Return(map[uuid.UUID]PrivacyModels.UserRules{}, nil)
+	// Номер по умолчанию виден только тем, кто есть в контактах владельца
+	mockContactRepo.EXPECT().GetContactOwners(ctx, viewerID, []uuid.UUID{contactID, peerID}).Return([]uuid.UUID{contactID}, nil)
+
+	result, err := uc.SearchUsers(ctx, viewerID, " @Ivan ", 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Ivan", search.query)
+	assert.Equal(t, []string{viewerID.String()}, search.params.ExcludeIDs)
+	assert.Equal(t, []string{contactID.String()}, search.params.ContactIDs)
+	assert.Equal(t, []string{peerID.String()}, search.params.PeerIDs)
+	assert.Equal(t, UserModels.DefaultSearchLimit, search.params.Limit)
+	assert.Equal(t, 3, result.Total)
+	assert.Len(t, result.Users, 2)
+	assert.Equal(t, contactID, result.Users[0].ID)
+	assert.Equal(t, "+79990000001", result.Users[0].PhoneNumber)
+	assert.Empty(t, result.Users[1].PhoneNumber)
+}
+
+func TestUserUsecase_SearchUsers_InvalidInput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := New(mocks.NewMockUserRepository(ctrl), mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, &stubUserSearch{}, nil)
+	viewerID := uuid.New()
+
+	_, err := uc.SearchUsers(context.Background(), viewerID, "@a", 0, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+
+	_, err = uc.SearchUsers(context.Background(), viewerID, "ivan", -1, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+
+	_, err = uc.SearchUsers(context.Background(), viewerID, "ivan", UserModels.MaxSearchWindow, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidInput)
+}
+
+func TestUserUsecase_UpdateUserInfo_IndexesProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockUserRepository(ctrl)
+	search := &stubUserSearch{}
+	uc := New(mockRepo, mocks.NewMockFileStorage(ctrl), nil, nil, nil, nil, search, nil)
+
+	ctx := context.Background()
+	userID := uuid.New()
+	username := "new_name"
+
+	mockRepo.EXPECT().UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Username: &username}).Return(nil)
+	mockRepo.EXPECT().GetUserByID(ctx, userID).Return(&UserModels.User{ID: userID, Username: username, Name: "Name"}, nil)
+
+	err := uc.UpdateUserInfo(ctx, userID, UserModels.InfoUpdate{Username: &username})
+
+	assert.NoError(t, err)
+	assert.Equal(t, username, search.indexed[userID.String()])
+}
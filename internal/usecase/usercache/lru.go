@@ -0,0 +1,99 @@
+package usercache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/google/uuid"
+)
+
+// lru - потокобезопасный LRU с ограничением времени жизни записи
+type lru struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List // от недавно использованных к давно использованным
+	entries map[uuid.UUID]*list.Element
+	now     func() time.Time
+}
+
+type lruEntry struct {
+	user      *UserModels.User
+	expiresAt time.Time
+}
+
+func newLRU(size int, ttl time.Duration) *lru {
+	return &lru{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[uuid.UUID]*list.Element, size),
+		now:     time.Now,
+	}
+}
+
+func (c *lru) get(id uuid.UUID) (*UserModels.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if c.now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, id)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return cloneUser(entry.user), true
+}
+
+func (c *lru) set(user *UserModels.User) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry{user: cloneUser(user), expiresAt: c.now().Add(c.ttl)}
+
+	if elem, ok := c.entries[user.ID]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[user.ID] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).user.ID)
+	}
+}
+
+func (c *lru) remove(ids ...uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range ids {
+		if elem, ok := c.entries[id]; ok {
+			c.order.Remove(elem)
+			delete(c.entries, id)
+		}
+	}
+}
+
+// cloneUser копирует профиль без хеша пароля, чтобы вызывающий код не мог изменить запись в кэше
+func cloneUser(user *UserModels.User) *UserModels.User {
+	clone := *user
+	clone.PasswordHash = ""
+	if user.Bio != nil {
+		bio := *user.Bio
+		clone.Bio = &bio
+	}
+	return &clone
+}
@@ -0,0 +1,29 @@
+package usercache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	lookupLocalHit = "local_hit"
+	lookupRedisHit = "redis_hit"
+	lookupMiss     = "miss"
+)
+
+var (
+	lookupsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "chats_user_cache_lookups_total",
+			Help: "Total number of user profile lookups by cache result",
+		},
+		[]string{"result"},
+	)
+
+	invalidationsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "chats_user_cache_invalidations_total",
+			Help: "Total number of user profiles invalidated by profile change events",
+		},
+	)
+)
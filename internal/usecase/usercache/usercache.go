@@ -0,0 +1,228 @@
+package usercache
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	interfaceUser "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/user"
+	"github.com/google/uuid"
+)
+
+// пауза перед повторной подпиской на канал сброса после ошибки Redis
+const resubscribeDelay = time.Second
+
+// CachedUserClient - кэш профилей перед клиентом user_service.
+// Первый уровень - LRU в памяти процесса, второй (необязательный) - Redis, общий для реплик.
+// Поиск по телефону и username идёт в user_service напрямую: кэш адресуется по id
+type CachedUserClient struct {
+	client interfaceUser.UserClient
+	store  interfaceUser.UserCacheStore
+	local  *lru
+}
+
+// New создаёт кэш; store может быть nil - тогда используется только память процесса
+func New(client interfaceUser.UserClient, store interfaceUser.UserCacheStore, conf *config.UserCacheConfig) *CachedUserClient {
+	return &CachedUserClient{
+		client: client,
+		store:  store,
+		local:  newLRU(conf.Size, conf.TTL),
+	}
+}
+
+func (c *CachedUserClient) GetUserByID(ctx context.Context, id uuid.UUID) (*UserModels.User, error) {
+	if user, ok := c.local.get(id); ok {
+		lookupsTotal.WithLabelValues(lookupLocalHit).Inc()
+		return user, nil
+	}
+
+	if users := c.fromStore(ctx, []uuid.UUID{id}); users[id] != nil {
+		return users[id], nil
+	}
+
+	lookupsTotal.WithLabelValues(lookupMiss).Inc()
+	user, err := c.client.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.remember(ctx, []*UserModels.User{user})
+	return cloneUser(user), nil
+}
+
+// GetUsersByIDs отдаёт найденные в кэше профили, а за остальными ходит в user_service одним запросом
+func (c *CachedUserClient) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*UserModels.User, error) {
+	users := make(map[uuid.UUID]*UserModels.User, len(ids))
+
+	missing := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, seen := users[id]; seen {
+			continue
+		}
+		if user, ok := c.local.get(id); ok {
+			lookupsTotal.WithLabelValues(lookupLocalHit).Inc()
+			users[id] = user
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	if len(missing) == 0 {
+		return users, nil
+	}
+
+	stored := c.fromStore(ctx, missing)
+	remaining := make([]uuid.UUID, 0, len(missing))
+	for _, id := range missing {
+		if user, ok := stored[id]; ok {
+			users[id] = user
+			continue
+		}
+		remaining = append(remaining, id)
+	}
+
+	if len(remaining) == 0 {
+		return users, nil
+	}
+
+	lookupsTotal.WithLabelValues(lookupMiss).Add(float64(len(remaining)))
+	fetched, err := c.client.GetUsersByIDs(ctx, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	toRemember := make([]*UserModels.User, 0, len(fetched))
+	for id, user := range fetched {
+		users[id] = cloneUser(user)
+		toRemember = append(toRemember, user)
+	}
+	c.remember(ctx, toRemember)
+
+	return users, nil
+}
+
+func (c *CachedUserClient) GetUsersNames(ctx context.Context, usersIds []uuid.UUID) ([]string, error) {
+	users, err := c.GetUsersByIDs(ctx, usersIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return UserModels.NamesByIDs(usersIds, users), nil
+}
+
+func (c *CachedUserClient) GetUserByPhone(ctx context.Context, phone string) (*UserModels.User, error) {
+	return c.client.GetUserByPhone(ctx, phone)
+}
+
+func (c *CachedUserClient) GetUserByUsername(ctx context.Context, username string) (*UserModels.User, error) {
+	return c.client.GetUserByUsername(ctx, username)
+}
+
+// GetBlockedPeers не кэшируется: блокировка должна действовать сразу
+func (c *CachedUserClient) GetBlockedPeers(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return c.client.GetBlockedPeers(ctx, userID, peerIDs)
+}
+
+// GetGroupAddDenied не кэшируется по той же причине: настройки приватности применяются сразу
+func (c *CachedUserClient) GetGroupAddDenied(ctx context.Context, userID uuid.UUID, peerIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return c.client.GetGroupAddDenied(ctx, userID, peerIDs)
+}
+
+// GetContactAliases не кэшируется: имена контактов у каждого зрителя свои
+func (c *CachedUserClient) GetContactAliases(ctx context.Context, userID uuid.UUID, contactUserIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	return c.client.GetContactAliases(ctx, userID, contactUserIDs)
+}
+
+// UpdateLastSeen не касается кэша: время последнего визита в закэшированный профиль не входит
+func (c *CachedUserClient) UpdateLastSeen(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	return c.client.UpdateLastSeen(ctx, userID, at)
+}
+
+// Invalidate сбрасывает профили в памяти и в Redis; остальные реплики узнают о сбросе через Redis
+func (c *CachedUserClient) Invalidate(ctx context.Context, ids ...uuid.UUID) error {
+	const op = "CachedUserClient.Invalidate"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	invalidationsTotal.Add(float64(len(ids)))
+	c.local.remove(ids...)
+
+	if c.store == nil {
+		return nil
+	}
+
+	if err := c.store.DeleteUsers(ctx, ids); err != nil {
+		logger.WithError(err).Error("failed to invalidate shared user cache")
+		return err
+	}
+
+	return nil
+}
+
+// Run слушает сбросы от других реплик до отмены ctx. Без Redis сразу возвращается
+func (c *CachedUserClient) Run(ctx context.Context) {
+	const op = "CachedUserClient.Run"
+	logger := domains.GetLogger(ctx).WithField("operation", op)
+
+	if c.store == nil {
+		return
+	}
+
+	for {
+		err := c.store.SubscribeInvalidations(ctx, func(ids []uuid.UUID) {
+			c.local.remove(ids...)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Warn("user cache invalidation subscription failed, retrying")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+// fromStore читает профили из Redis и прогревает ими память процесса.
+// Ошибка Redis не фатальна - профили будут запрошены у user_service
+func (c *CachedUserClient) fromStore(ctx context.Context, ids []uuid.UUID) map[uuid.UUID]*UserModels.User {
+	const op = "CachedUserClient.fromStore"
+
+	if c.store == nil {
+		return nil
+	}
+
+	users, err := c.store.GetUsers(ctx, ids)
+	if err != nil {
+		domains.GetLogger(ctx).WithField("operation", op).WithError(err).Warn("shared user cache unavailable")
+		return nil
+	}
+
+	for _, user := range users {
+		lookupsTotal.WithLabelValues(lookupRedisHit).Inc()
+		c.local.set(user)
+	}
+
+	return users
+}
+
+func (c *CachedUserClient) remember(ctx context.Context, users []*UserModels.User) {
+	const op = "CachedUserClient.remember"
+
+	for _, user := range users {
+		c.local.set(user)
+	}
+
+	if c.store == nil || len(users) == 0 {
+		return
+	}
+
+	if err := c.store.SetUsers(ctx, users); err != nil {
+		domains.GetLogger(ctx).WithField("operation", op).WithError(err).Warn("failed to store users in shared cache")
+	}
+}
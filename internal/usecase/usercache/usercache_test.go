@@ -0,0 +1,205 @@
+package usercache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/config"
+	UserModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/user"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *config.UserCacheConfig {
+	return &config.UserCacheConfig{Size: 2, TTL: time.Minute}
+}
+
+func TestCachedUserClient_GetUserByID_CachesResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	cache := New(client, nil, testConfig())
+
+	ctx := context.Background()
+	user := &UserModels.User{ID: uuid.New(), Name: "User", PasswordHash: "hash"}
+
+	client.EXPECT().GetUserByID(ctx, user.ID).Return(user, nil).Times(1)
+
+	first, err := cache.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	second, err := cache.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "User", second.Name)
+	assert.Empty(t, first.PasswordHash)
+
+	// изменение полученного профиля не портит запись в кэше
+	first.Name = "Changed"
+	third, err := cache.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "User", third.Name)
+}
+
+func TestCachedUserClient_GetUserByID_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	cache := New(client, nil, testConfig())
+
+	ctx := context.Background()
+	id := uuid.New()
+
+	client.EXPECT().GetUserByID(ctx, id).Return(nil, errors.New("not found")).Times(2)
+
+	_, err := cache.GetUserByID(ctx, id)
+	assert.Error(t, err)
+	// ошибки не кэшируются
+	_, err = cache.GetUserByID(ctx, id)
+	assert.Error(t, err)
+}
+
+func TestCachedUserClient_GetUsersByIDs_FetchesOnlyMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	cache := New(client, nil, &config.UserCacheConfig{Size: 10, TTL: time.Minute})
+
+	ctx := context.Background()
+	cached := &UserModels.User{ID: uuid.New(), Name: "Cached"}
+	fresh := &UserModels.User{ID: uuid.New(), Name: "Fresh"}
+	unknown := uuid.New()
+
+	client.EXPECT().GetUserByID(ctx, cached.ID).Return(cached, nil)
+	_, err := cache.GetUserByID(ctx, cached.ID)
+	require.NoError(t, err)
+
+	client.EXPECT().GetUsersByIDs(ctx, []uuid.UUID{fresh.ID, unknown}).
+		Return(map[uuid.UUID]*UserModels.User{fresh.ID: fresh}, nil)
+
+	names, err := cache.GetUsersNames(ctx, []uuid.UUID{cached.ID, fresh.ID, unknown})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Cached", "Fresh", UserModels.UnknownUserName}, names)
+}
+
+func TestCachedUserClient_UsesSharedStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	store := mocks.NewMockUserCacheStore(ctrl)
+	cache := New(client, store, testConfig())
+
+	ctx := context.Background()
+	stored := &UserModels.User{ID: uuid.New(), Name: "Stored"}
+	fetched := &UserModels.User{ID: uuid.New(), Name: "Fetched"}
+
+	store.EXPECT().GetUsers(ctx, []uuid.UUID{stored.ID, fetched.ID}).
+		Return(map[uuid.UUID]*UserModels.User{stored.ID: stored}, nil)
+	client.EXPECT().GetUsersByIDs(ctx, []uuid.UUID{fetched.ID}).
+		Return(map[uuid.UUID]*UserModels.User{fetched.ID: fetched}, nil)
+	store.EXPECT().SetUsers(ctx, []*UserModels.User{fetched}).Return(nil)
+
+	users, err := cache.GetUsersByIDs(ctx, []uuid.UUID{stored.ID, fetched.ID})
+	require.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	// повторный запрос обслуживается памятью процесса
+	users, err = cache.GetUsersByIDs(ctx, []uuid.UUID{stored.ID, fetched.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "Stored", users[stored.ID].Name)
+}
+
+func TestCachedUserClient_StoreErrorFallsBackToService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	store := mocks.NewMockUserCacheStore(ctrl)
+	cache := New(client, store, testConfig())
+
+	ctx := context.Background()
+	user := &UserModels.User{ID: uuid.New(), Name: "User"}
+
+	store.EXPECT().GetUsers(ctx, []uuid.UUID{user.ID}).Return(nil, errors.New("redis down"))
+	client.EXPECT().GetUserByID(ctx, user.ID).Return(user, nil)
+	store.EXPECT().SetUsers(ctx, gomock.Any()).Return(errors.New("redis down"))
+
+	got, err := cache.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "User", got.Name)
+}
+
+func TestCachedUserClient_Invalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserClient(ctrl)
+	store := mocks.NewMockUserCacheStore(ctrl)
+	cache := New(client, store, testConfig())
+
+	ctx := context.Background()
+	user := &UserModels.User{ID: uuid.New(), Name: "Old"}
+	cache.local.set(user)
+
+	store.EXPECT().DeleteUsers(ctx, []uuid.UUID{user.ID}).Return(nil)
+	require.NoError(t, cache.Invalidate(ctx, user.ID))
+
+	store.EXPECT().GetUsers(ctx, []uuid.UUID{user.ID}).Return(map[uuid.UUID]*UserModels.User{}, nil)
+	client.EXPECT().GetUserByID(ctx, user.ID).Return(&UserModels.User{ID: user.ID, Name: "New"}, nil)
+	store.EXPECT().SetUsers(ctx, gomock.Any()).Return(nil)
+
+	got, err := cache.GetUserByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "New", got.Name)
+}
+
+func TestCachedUserClient_Run_AppliesRemoteInvalidations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mocks.NewMockUserCacheStore(ctrl)
+	cache := New(mocks.NewMockUserClient(ctrl), store, testConfig())
+
+	user := &UserModels.User{ID: uuid.New(), Name: "User"}
+	cache.local.set(user)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	store.EXPECT().SubscribeInvalidations(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, handler func([]uuid.UUID)) error {
+			handler([]uuid.UUID{user.ID})
+			cancel()
+			return nil
+		})
+
+	cache.Run(ctx)
+
+	_, ok := cache.local.get(user.ID)
+	assert.False(t, ok)
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newLRU(2, time.Minute)
+	first := &UserModels.User{ID: uuid.New()}
+	second := &UserModels.User{ID: uuid.New()}
+	third := &UserModels.User{ID: uuid.New()}
+
+	cache.set(first)
+	cache.set(second)
+	_, _ = cache.get(first.ID)
+	cache.set(third)
+
+	_, ok := cache.get(second.ID)
+	assert.False(t, ok)
+	_, ok = cache.get(first.ID)
+	assert.True(t, ok)
+	_, ok = cache.get(third.ID)
+	assert.True(t, ok)
+}
+
+func TestLRU_Expiration(t *testing.T) {
+	cache := newLRU(2, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	user := &UserModels.User{ID: uuid.New()}
+	cache.set(user)
+
+	now = now.Add(2 * time.Minute)
+	_, ok := cache.get(user.ID)
+	assert.False(t, ok)
+}
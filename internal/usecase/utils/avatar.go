@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+
+	AvatarModels "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/avatar"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/repository/minio"
+	dtoUtils "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/utils"
+	interfaceFileStorage "github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/interface/storage"
+	"github.com/google/uuid"
+)
+
+const avatarJPEGQuality = 85
+
+// AvatarVariant - квадратная копия аватарки одного размера
+type AvatarVariant struct {
+	Size int
+	Data []byte
+}
+
+// ProcessAvatar проверяет загруженное изображение, обрезает его по центру до квадрата
+// и возвращает JPEG-копии размеров AvatarModels.Sizes по убыванию. Изображение не растягивается:
+// если исходник меньше самого крупного размера, первой копией становится квадрат исходной стороны
+func ProcessAvatar(data []byte) ([]AvatarVariant, error) {
+	if len(data) == 0 || len(data) > AvatarModels.MaxFileSize {
+		return nil, fmt.Errorf("%w: avatar size must be between 1 and %d bytes", errs.ErrInvalidImage, AvatarModels.MaxFileSize)
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errs.ErrInvalidImage, err)
+	}
+	if format != "jpeg" && format != "png" {
+		return nil, fmt.Errorf("%w: unsupported format %s", errs.ErrInvalidImage, format)
+	}
+	if cfg.Width*cfg.Height > AvatarModels.MaxPixels {
+		return nil, fmt.Errorf("%w: image is too large", errs.ErrInvalidImage)
+	}
+	if min(cfg.Width, cfg.Height) < AvatarModels.MinSide {
+		return nil, fmt.Errorf("%w: image side must be at least %d pixels", errs.ErrInvalidImage, AvatarModels.MinSide)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errs.ErrInvalidImage, err)
+	}
+
+	square := cropSquare(img)
+	side := square.Bounds().Dx()
+
+	variants := make([]AvatarVariant, 0, len(AvatarModels.Sizes))
+	for _, size := range AvatarModels.Sizes {
+		if size > side {
+			if len(variants) > 0 {
+				continue
+			}
+			size = side
+		}
+		if len(variants) > 0 && variants[len(variants)-1].Size == size {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resizeSquare(square, size), &jpeg.Options{Quality: avatarJPEGQuality}); err != nil {
+			return nil, fmt.Errorf("could not encode avatar: %w", err)
+		}
+		variants = append(variants, AvatarVariant{Size: size, Data: buf.Bytes()})
+	}
+
+	return variants, nil
+}
+
+// AvatarVariantSizes возвращает стороны копий в том же порядке
+func AvatarVariantSizes(variants []AvatarVariant) []int {
+	sizes := make([]int, len(variants))
+	for i, variant := range variants {
+		sizes[i] = variant.Size
+	}
+	return sizes
+}
+
+// StoreAvatarVariants сохраняет копии аватарки в хранилище и возвращает url самой крупной.
+// Если сохранить не удалось, уже загруженные копии удаляются
+func StoreAvatarVariants(ctx context.Context, fileStorage interfaceFileStorage.FileStorage, avatarID uuid.UUID, variants []AvatarVariant) (string, error) {
+	avatar := AvatarModels.Avatar{ID: avatarID, Sizes: AvatarVariantSizes(variants)}
+	objectIDs := avatar.ObjectIDs()
+
+	var mainURL string
+	for i, variant := range variants {
+		file := minio.FileData{
+			Name:        fmt.Sprintf("avatar_%s_%d.jpg", avatarID, variant.Size),
+			Data:        variant.Data,
+			ContentType: AvatarModels.ContentType,
+		}
+
+		url, err := fileStorage.CreateOne(ctx, file, objectIDs[variant.Size])
+		if err != nil {
+			if i > 0 {
+				DeleteAvatarObjects(ctx, fileStorage, AvatarModels.Avatar{ID: avatarID, Sizes: avatar.Sizes[:i]})
+			}
+			return "", err
+		}
+		if i == 0 {
+			mainURL = url
+		}
+	}
+
+	return mainURL, nil
+}
+
+// DeleteAvatarObjects удаляет из хранилища все копии аватарки. Ошибки только логируются:
+// запись в БД к этому моменту уже удалена, а осиротевший файл ни на что не влияет
+func DeleteAvatarObjects(ctx context.Context, fileStorage interfaceFileStorage.FileStorage, avatar AvatarModels.Avatar) {
+	objectIDs := avatar.ObjectIDs()
+	if len(objectIDs) == 0 {
+		objectIDs = map[int]uuid.UUID{0: avatar.ID}
+	}
+
+	for _, objectID := range objectIDs {
+		if err := fileStorage.DeleteOne(ctx, objectID); err != nil {
+			domains.GetLogger(ctx).WithError(err).Warningf("could not delete avatar file %s", objectID)
+		}
+	}
+}
+
+// ConvertAvatarToDTO преобразует аватарку из истории в DTO со ссылками на все размеры
+func ConvertAvatarToDTO(ctx context.Context, avatar *AvatarModels.Avatar, fileStorage interfaceFileStorage.FileStorage) dtoUtils.AvatarDTO {
+	logger := domains.GetLogger(ctx)
+
+	result := dtoUtils.AvatarDTO{
+		ID:        avatar.ID,
+		IsCurrent: avatar.IsCurrent,
+		CreatedAt: avatar.CreatedAt,
+	}
+
+	url, err := fileStorage.GetOne(ctx, &avatar.ID)
+	if err != nil {
+		logger.WithError(err).Warningf("could not get url of avatar %s", avatar.ID)
+	}
+	result.URL = url
+
+	objectIDs := avatar.ObjectIDs()
+	if len(objectIDs) == 0 {
+		return result
+	}
+
+	result.Sizes = make(map[int]string, len(objectIDs))
+	for size, objectID := range objectIDs {
+		sizeURL, err := fileStorage.GetOne(ctx, &objectID)
+		if err != nil {
+			logger.WithError(err).Warningf("could not get url of avatar %s size %d", avatar.ID, size)
+			continue
+		}
+		result.Sizes[size] = sizeURL
+	}
+
+	return result
+}
+
+// cropSquare вырезает центральный квадрат и переносит его на белый фон,
+// чтобы прозрачные области PNG не стали чёрными в JPEG
+func cropSquare(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	offset := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+
+	dst := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, offset, draw.Over)
+	return dst
+}
+
+// resizeSquare уменьшает квадрат усреднением по площади: каждый пиксель результата -
+// среднее соответствующего блока исходника
+func resizeSquare(src *image.RGBA, size int) *image.RGBA {
+	side := src.Bounds().Dx()
+	if size == side {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0, y1 := y*side/size, (y+1)*side/size
+		for x := 0; x < size; x++ {
+			x0, x1 := x*side/size, (x+1)*side/size
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
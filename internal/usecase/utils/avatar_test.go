@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func decodedSide(t *testing.T, data []byte) (int, int) {
+	t.Helper()
+
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	return cfg.Width, cfg.Height
+}
+
+func TestProcessAvatar_CropsAndResizes(t *testing.T) {
+	variants, err := ProcessAvatar(encodePNG(t, 1000, 700))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{640, 320, 160}, AvatarVariantSizes(variants))
+	for _, variant := range variants {
+		width, height := decodedSide(t, variant.Data)
+		assert.Equal(t, variant.Size, width)
+		assert.Equal(t, variant.Size, height)
+	}
+}
+
+func TestProcessAvatar_DoesNotUpscale(t *testing.T) {
+	variants, err := ProcessAvatar(encodePNG(t, 300, 500))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{300, 160}, AvatarVariantSizes(variants))
+	width, height := decodedSide(t, variants[0].Data)
+	assert.Equal(t, 300, width)
+	assert.Equal(t, 300, height)
+}
+
+func TestProcessAvatar_ExactSize(t *testing.T) {
+	variants, err := ProcessAvatar(encodePNG(t, 160, 160))
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{160}, AvatarVariantSizes(variants))
+}
+
+func TestProcessAvatar_TooSmall(t *testing.T) {
+	_, err := ProcessAvatar(encodePNG(t, 99, 400))
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+}
+
+func TestProcessAvatar_NotAnImage(t *testing.T) {
+	_, err := ProcessAvatar([]byte("definitely not an image"))
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+}
+
+func TestProcessAvatar_Empty(t *testing.T) {
+	_, err := ProcessAvatar(nil)
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+}
+
+func TestProcessAvatar_UnsupportedFormat(t *testing.T) {
+	img := image.NewPaletted(image.Rect(0, 0, 200, 200), color.Palette{color.White, color.Black})
+	var buf bytes.Buffer
+	require.NoError(t, gif.Encode(&buf, img, nil))
+
+	_, err := ProcessAvatar(buf.Bytes())
+
+	assert.ErrorIs(t, err, errs.ErrInvalidImage)
+}
+
+func TestResizeSquare_AveragesBlocks(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, color.RGBA{R: 0, A: 255})
+	src.Set(1, 0, color.RGBA{R: 100, A: 255})
+	src.Set(0, 1, color.RGBA{R: 200, A: 255})
+	src.Set(1, 1, color.RGBA{R: 100, A: 255})
+
+	dst := resizeSquare(src, 1)
+
+	assert.Equal(t, color.RGBA{R: 100, A: 255}, dst.RGBAAt(0, 0))
+}
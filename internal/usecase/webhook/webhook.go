@@ -0,0 +1,333 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/bot"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/models/domains"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/go-park-mail-ru/2025_2_Undefined/internal/usecase/message"
+	"github.com/google/uuid"
+)
+
+const (
+	EventMessageMention = "message.mention"
+
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+
+	QueueSize      = 1000
+	WorkersCount   = 4
+	MaxAttempts    = 5
+	InitialBackoff = time.Second
+	MaxBackoff     = 30 * time.Second
+	RequestTimeout = 10 * time.Second
+	DialTimeout    = 5 * time.Second
+)
+
+var (
+	errPermanent = errors.New("webhook rejected the request")
+	// errForbiddenAddress - адрес webhook ведёт во внутреннюю сеть
+	errForbiddenAddress = errors.New("webhook address is not public")
+)
+
+type WebhookRepository interface {
+	GetChatWebhooks(ctx context.Context, chatID uuid.UUID) ([]models.Webhook, error)
+}
+
+// Payload тело запроса, которое получает webhook бота
+type Payload struct {
+	Event   string                `json:"event"`
+	BotID   uuid.UUID             `json:"bot_id"`
+	Message dtoMessage.MessageDTO `json:"message"`
+}
+
+// Dispatcher асинхронно доставляет сообщения с упоминанием бота на его webhook
+type Dispatcher struct {
+	repo           WebhookRepository
+	client         *http.Client
+	queue          chan dtoMessage.MessageDTO
+	initialBackoff time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// mu защищает закрытие очереди от одновременной постановки сообщений
+	mu      sync.RWMutex
+	stopped bool
+}
+
+// NewDispatcher создает диспетчер. Без client используется NewHTTPClient
+func NewDispatcher(repo WebhookRepository, client *http.Client) *Dispatcher {
+	return newDispatcher(repo, client, InitialBackoff)
+}
+
+func newDispatcher(repo WebhookRepository, client *http.Client, initialBackoff time.Duration) *Dispatcher {
+	if client == nil {
+		client = NewHTTPClient()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		repo:           repo,
+		client:         client,
+		queue:          make(chan dtoMessage.MessageDTO, QueueSize),
+		initialBackoff: initialBackoff,
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
+	for i := 0; i < WorkersCount; i++ {
+		d.wg.Add(1)
+		go d.worker()
+	}
+
+	return d
+}
+
+// DispatchMessage ставит сообщение в очередь доставки, не блокируя отправителя
+func (d *Dispatcher) DispatchMessage(ctx context.Context, msg dtoMessage.MessageDTO) {
+	const op = "Dispatcher.DispatchMessage"
+
+	if !strings.Contains(msg.Text, "@") {
+		return
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.stopped {
+		domains.GetLogger(ctx).WithField("operation", op).
+			Warnf("webhook dispatcher is stopped, message %s dropped", msg.ID)
+		return
+	}
+
+	select {
+	case d.queue <- msg:
+	default:
+		domains.GetLogger(ctx).WithField("operation", op).
+			Warnf("webhook queue is full, message %s dropped", msg.ID)
+	}
+}
+
+// Stop перестает принимать сообщения и доставляет уже поставленные в очередь.
+// Если ctx истекает раньше, незавершенные доставки прерываются
+func (d *Dispatcher) Stop(ctx context.Context) {
+	const op = "Dispatcher.Stop"
+
+	d.mu.Lock()
+	if !d.stopped {
+		d.stopped = true
+		close(d.queue)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		domains.GetLogger(ctx).WithField("operation", op).
+			Warnf("webhook queue was not drained in time, %d messages dropped", len(d.queue))
+		d.cancel()
+		<-done
+	}
+	d.cancel()
+}
+
+func (d *Dispatcher) worker() {
+	defer d.wg.Done()
+
+	for msg := range d.queue {
+		d.process(msg)
+	}
+}
+
+func (d *Dispatcher) process(msg dtoMessage.MessageDTO) {
+	const op = "Dispatcher.process"
+
+	logger := domains.GetLogger(d.ctx).WithField("operation", op).WithField("message_id", msg.ID.String())
+
+	webhooks, err := d.repo.GetChatWebhooks(d.ctx, msg.ChatID)
+	if err != nil {
+		logger.WithError(err).Error("could not get chat webhooks")
+		return
+	}
+
+	mentions := message.ParseMentions(msg.Text)
+
+	for _, webhook := range webhooks {
+		// Бот не получает собственные сообщения
+		if msg.SenderID != nil && *msg.SenderID == webhook.BotID {
+			continue
+		}
+
+		if !slices.ContainsFunc(mentions, func(username string) bool {
+			return strings.EqualFold(username, webhook.Username)
+		}) {
+			continue
+		}
+
+		if err := d.deliver(webhook, Payload{Event: EventMessageMention, BotID: webhook.BotID, Message: msg}); err != nil {
+			logger.WithError(err).Warnf("webhook delivery to bot %s failed", webhook.BotID)
+		}
+	}
+}
+
+// deliver отправляет payload с повторами и экспоненциальной задержкой
+func (d *Dispatcher) deliver(webhook models.Webhook, payload Payload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	backoff := d.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err = d.send(webhook, payload.Event, body)
+		if err == nil || errors.Is(err, errPermanent) || attempt >= MaxAttempts {
+			return err
+		}
+
+		select {
+		case <-d.ctx.Done():
+			return d.ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, MaxBackoff)
+	}
+}
+
+func (d *Dispatcher) send(webhook models.Webhook, event string, body []byte) error {
+	ctx, cancel := context.WithTimeout(d.ctx, RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errPermanent, err)
+	}
+	if req.URL.Scheme != "https" {
+		return fmt.Errorf("%w: webhook url must use https", errPermanent)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		if errors.Is(err, errForbiddenAddress) {
+			return fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	default:
+		return fmt.Errorf("%w: status %d", errPermanent, resp.StatusCode)
+	}
+}
+
+// NewHTTPClient возвращает клиент для доставки webhook. Адрес проверяется в момент
+// подключения, уже после разрешения имени, поэтому подмена DNS не уводит запрос во внутреннюю сеть.
+// Перенаправления не выполняются, прокси из окружения не используется
+func NewHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: DialTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("%w: %s", errForbiddenAddress, host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: RequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: DialTimeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Диапазоны, которые не покрываются методами net.IP: CGNAT (RFC 6598) и сеть для
+// тестирования производительности (RFC 2544)
+var nonPublicNets = []*net.IPNet{
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("198.18.0.0/15"),
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// IsPublicIP сообщает, что адрес доступен из интернета: не loopback, не частная сеть,
+// не link-local (в том числе метаданные облака 169.254.169.254), не CGNAT и не 0.0.0.0
+func IsPublicIP(ip net.IP) bool {
+	for _, network := range nonPublicNets {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// Sign возвращает подпись запроса: sha256=hex(HMAC-SHA256(secret, timestamp + "." + body))
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
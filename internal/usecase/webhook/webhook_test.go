@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	models "github.com/go-park-mail-ru/2025_2_Undefined/internal/models/bot"
+	dtoMessage "github.com/go-park-mail-ru/2025_2_Undefined/internal/transport/dto/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) GetChatWebhooks(ctx context.Context, chatID uuid.UUID) ([]models.Webhook, error) {
+	args := m.Called(ctx, chatID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Webhook), args.Error(1)
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", "1700000000", []byte(`{"a":1}`))
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.Equal(t, signature, Sign("secret", "1700000000", []byte(`{"a":1}`)))
+	assert.NotEqual(t, signature, Sign("other", "1700000000", []byte(`{"a":1}`)))
+}
+
+func TestDispatcher_DeliversMentionWithRetry(t *testing.T) {
+	var calls atomic.Int32
+	received := make(chan Payload, 1)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, Sign("secret", r.Header.Get(TimestampHeader), body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, EventMessageMention, r.Header.Get(EventHeader))
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var payload Payload
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		received <- payload
+	}))
+	defer server.Close()
+
+	chatID := uuid.New()
+	botID := uuid.New()
+	senderID := uuid.New()
+
+	mockRepo := new(MockWebhookRepository)
+	mockRepo.On("GetChatWebhooks", mock.Anything, chatID).Return([]models.Webhook{
+		{BotID: botID, Username: "ci_bot", URL: server.URL, Secret: "secret"},
+	}, nil)
+
+	d := newDispatcher(mockRepo, server.Client(), time.Millisecond)
+	defer d.Stop(context.Background())
+
+	msg := dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, SenderID: &senderID, Text: "@ci_bot deploy"}
+	d.DispatchMessage(context.Background(), msg)
+
+	select {
+	case payload := <-received:
+		assert.Equal(t, botID, payload.BotID)
+		assert.Equal(t, msg.ID, payload.Message.ID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestDispatcher_DoesNotRetryClientError(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	d := newDispatcher(new(MockWebhookRepository), server.Client(), time.Millisecond)
+	defer d.Stop(context.Background())
+
+	err := d.deliver(models.Webhook{BotID: uuid.New(), URL: server.URL, Secret: "secret"}, Payload{Event: EventMessageMention})
+
+	assert.ErrorIs(t, err, errPermanent)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDispatcher_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d := newDispatcher(new(MockWebhookRepository), server.Client(), time.Millisecond)
+	defer d.Stop(context.Background())
+
+	err := d.deliver(models.Webhook{BotID: uuid.New(), URL: server.URL, Secret: "secret"}, Payload{Event: EventMessageMention})
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(MaxAttempts), calls.Load())
+}
+
+func TestDispatcher_SkipsOwnMessagesAndMissingMentions(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	chatID := uuid.New()
+	botID := uuid.New()
+
+	mockRepo := new(MockWebhookRepository)
+	mockRepo.On("GetChatWebhooks", mock.Anything, chatID).Return([]models.Webhook{
+		{BotID: botID, Username: "ci_bot", URL: server.URL, Secret: "secret"},
+	}, nil)
+
+	d := newDispatcher(mockRepo, server.Client(), time.Millisecond)
+	defer d.Stop(context.Background())
+
+	d.process(dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, SenderID: &botID, Text: "@ci_bot done"})
+	d.process(dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, Text: "@other_bot done"})
+	d.process(dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, Text: "@ci_bot2 deploy"})
+	d.process(dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, Text: "mail me at a@ci_bot"})
+
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestDispatcher_StopDrainsQueue(t *testing.T) {
+	const messages = 20
+
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	chatID := uuid.New()
+	mockRepo := new(MockWebhookRepository)
+	mockRepo.On("GetChatWebhooks", mock.Anything, chatID).Return([]models.Webhook{
+		{BotID: uuid.New(), Username: "ci_bot", URL: server.URL, Secret: "secret"},
+	}, nil)
+
+	d := newDispatcher(mockRepo, server.Client(), time.Millisecond)
+	for i := 0; i < messages; i++ {
+		d.DispatchMessage(context.Background(), dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, Text: "@ci_bot ping"})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	d.Stop(ctx)
+
+	assert.Equal(t, int32(messages), calls.Load())
+
+	// После остановки сообщения не принимаются, а повторная остановка безопасна
+	d.DispatchMessage(context.Background(), dtoMessage.MessageDTO{ID: uuid.New(), ChatID: chatID, Text: "@ci_bot ping"})
+	d.Stop(context.Background())
+	assert.Equal(t, int32(messages), calls.Load())
+}
+
+func TestDispatcher_RejectsInternalAddress(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	// Клиент по умолчанию не подключается к 127.0.0.1, даже если адрес попал в базу
+	d := newDispatcher(new(MockWebhookRepository), nil, time.Millisecond)
+	defer d.Stop(context.Background())
+
+	err := d.deliver(models.Webhook{BotID: uuid.New(), URL: server.URL, Secret: "secret"}, Payload{Event: EventMessageMention})
+
+	assert.ErrorIs(t, err, errPermanent)
+	assert.ErrorContains(t, err, errForbiddenAddress.Error())
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestDispatcher_RejectsPlainHTTP(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	d := newDispatcher(new(MockWebhookRepository), server.Client(), time.Millisecond)
+	defer d.Stop(context.Background())
+
+	err := d.deliver(models.Webhook{BotID: uuid.New(), URL: server.URL, Secret: "secret"}, Payload{Event: EventMessageMention})
+
+	assert.ErrorIs(t, err, errPermanent)
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestNewHTTPClient_RejectsInternalAddresses(t *testing.T) {
+	client := NewHTTPClient()
+
+	for _, target := range []string{
+		"https://127.0.0.1/hook",
+		"https://[::1]/hook",
+		"https://10.0.0.1/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://0.0.0.0/hook",
+		"https://localhost/hook",
+	} {
+		t.Run(target, func(t *testing.T) {
+			resp, err := client.Post(target, "application/json", nil)
+			if resp != nil {
+				resp.Body.Close()
+			}
+			assert.ErrorIs(t, err, errForbiddenAddress)
+		})
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "8.8.8.8", want: true},
+		{ip: "2001:4860:4860::8888", want: true},
+		{ip: "127.0.0.1", want: false},
+		{ip: "::1", want: false},
+		{ip: "10.1.2.3", want: false},
+		{ip: "172.16.0.1", want: false},
+		{ip: "192.168.1.1", want: false},
+		{ip: "fd00::1", want: false},
+		{ip: "169.254.169.254", want: false},
+		{ip: "fe80::1", want: false},
+		{ip: "0.0.0.0", want: false},
+		{ip: "::", want: false},
+		{ip: "::ffff:127.0.0.1", want: false},
+		{ip: "100.64.0.1", want: false},
+		{ip: "100.127.255.254", want: false},
+		{ip: "100.128.0.1", want: true},
+		{ip: "198.18.0.1", want: false},
+		{ip: "198.19.255.254", want: false},
+		{ip: "::ffff:100.64.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicIP(net.ParseIP(tt.ip)))
+		})
+	}
+}